@@ -216,6 +216,10 @@ controllers:
     {{- if .Values.config.controllers.shoot.dnsEntryTTLSeconds }}
     dnsEntryTTLSeconds: {{ .Values.config.controllers.shoot.dnsEntryTTLSeconds }}
     {{- end }}
+    {{- if .Values.config.controllers.shoot.secretsStorageBackend }}
+    secretsStorageBackend:
+{{ toYaml .Values.config.controllers.shoot.secretsStorageBackend | indent 6 }}
+    {{- end }}
   shootCare:
     concurrentSyncs: {{ required ".Values.config.controllers.shootCare.concurrentSyncs is required" .Values.config.controllers.shootCare.concurrentSyncs }}
     syncPeriod: {{ required ".Values.config.controllers.shootCare.syncPeriod is required" .Values.config.controllers.shootCare.syncPeriod }}
@@ -307,6 +311,10 @@ server:
     {{- end }}
     port: {{ required ".Values.config.server.metrics.port is required" .Values.config.server.metrics.port }}
   {{- end }}
+  {{- if .Values.config.server.wakeUpProxy }}
+  wakeUpProxy:
+{{ toYaml .Values.config.server.wakeUpProxy | indent 4 }}
+  {{- end }}
 {{- if .Values.config.debugging }}
 debugging:
   enableProfiling: {{ .Values.config.debugging.enableProfiling | default false }}
@@ -340,6 +348,14 @@ etcdConfig:
 exposureClassHandlers:
 {{ toYaml .Values.config.exposureClassHandlers }}
 {{- end }}
+{{- if .Values.config.helmChartCache }}
+helmChartCache:
+{{ toYaml .Values.config.helmChartCache | indent 2 }}
+{{- end }}
+{{- if .Values.config.selfHostedShoot }}
+selfHostedShoot:
+{{ toYaml .Values.config.selfHostedShoot | indent 2 }}
+{{- end }}
 {{- if .Values.nodeToleration }}
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
@@ -832,6 +832,9 @@ func ComputeExpectedGardenletConfiguration(
 			DefaultNotReadyTolerationSeconds:    ptr.To[int64](60),
 			DefaultUnreachableTolerationSeconds: ptr.To[int64](60),
 		},
+		HelmChartCache: &gardenletconfigv1alpha1.HelmChartCacheConfiguration{
+			MaxSize: ptr.To(resource.MustParse("128Mi")),
+		},
 	}
 
 	if hasGardenClientConnectionKubeconfig {
@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/rest"
 	"k8s.io/component-base/version/verflag"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -48,6 +49,7 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/bootstrap"
 	"github.com/gardener/gardener/pkg/nodeagent/controller"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/debug"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -77,6 +79,7 @@ func NewCommand() *cobra.Command {
 	opts.addFlags(flags)
 
 	cmd.AddCommand(getBootstrapCommand(opts))
+	cmd.AddCommand(getStatusCommand())
 	return cmd
 }
 
@@ -148,6 +151,8 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *n
 		leaseCacheOptions.Field = fields.SelectorFromSet(fields.Set{metav1.ObjectNameField: gardenerutils.NodeAgentLeaseName(nodeName)})
 	}
 
+	reconcileErrors := debug.NewReconcileErrors(clock.RealClock{})
+
 	log.Info("Setting up manager")
 	mgr, err := manager.New(restConfig, manager.Options{
 		Logger:                  log,
@@ -195,6 +200,18 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *n
 		}
 	}
 
+	log.Info("Adding debugging API server to manager")
+	debugServer := &debug.Server{
+		Log:             log.WithName("debugging-api"),
+		FS:              fs,
+		SocketPath:      nodeagentconfigv1alpha1.DebugSocketPath,
+		TokenConfig:     cfg.Controllers.Token,
+		ReconcileErrors: reconcileErrors,
+	}
+	if err := mgr.Add(debugServer); err != nil {
+		return fmt.Errorf("failed adding debugging API server to manager: %w", err)
+	}
+
 	log.Info("Adding runnables to manager")
 	if err := mgr.Add(&controllerutils.ControlledRunner{
 		Manager: mgr,
@@ -203,7 +220,7 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *n
 		},
 		ActualRunnables: []manager.Runnable{
 			manager.RunnableFunc(func(ctx context.Context) error {
-				return controller.AddToManager(ctx, cancel, mgr, cfg, hostName, machineName, nodeName)
+				return controller.AddToManager(ctx, cancel, mgr, cfg, hostName, machineName, nodeName, debugServer)
 			}),
 		},
 	}); err != nil {
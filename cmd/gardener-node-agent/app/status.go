@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	"github.com/gardener/gardener/pkg/nodeagent/debug"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+func getStatusCommand() *cobra.Command {
+	var (
+		socketPath string
+		output     string
+		dryRun     bool
+		reconcile  bool
+	)
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the status of the " + Name + " running on this node",
+		Long: `Show the status of the ` + Name + ` running on this node via its local debugging API. This requires root
+privileges. The status contains the checksums of the applied and the desired operating system config, the pending
+changes of files and units, the states of the units, the last errors of the controllers and the status of the synced
+access tokens.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if output != outputText && output != outputJSON && output != outputYAML {
+				return fmt.Errorf("unsupported output format %q, must be one of %s, %s, %s", output, outputText, outputJSON, outputYAML)
+			}
+
+			var (
+				ctx    = cmd.Context()
+				out    = cmd.OutOrStdout()
+				client = debug.NewClient(socketPath)
+			)
+
+			switch {
+			case reconcile:
+				if err := client.TriggerReconciliation(ctx); err != nil {
+					return err
+				}
+				_, err := fmt.Fprintln(out, "Triggered reconciliation of the operating system config.")
+				return err
+
+			case dryRun:
+				result, err := client.DryRun(ctx)
+				if err != nil {
+					return err
+				}
+				return printOutput(out, output, result, func(w io.Writer) { printDryRunResult(w, result) })
+
+			default:
+				status, err := client.Status(ctx)
+				if err != nil {
+					return err
+				}
+				return printOutput(out, output, status, func(w io.Writer) { printStatus(w, status) })
+			}
+		},
+	}
+
+	flags := statusCmd.Flags()
+	flags.StringVar(&socketPath, "socket", nodeagentconfigv1alpha1.DebugSocketPath, "Path of the Unix socket of the debugging API.")
+	flags.StringVarP(&output, "output", "o", outputText, "Output format, one of text, json, yaml.")
+	flags.BoolVar(&dryRun, "dry-run", false, "Show the changes which the next reconciliation of the operating system config would apply to the node without applying them.")
+	flags.BoolVar(&reconcile, "reconcile", false, "Trigger an immediate reconciliation of the operating system config.")
+	statusCmd.MarkFlagsMutuallyExclusive("dry-run", "reconcile")
+
+	return statusCmd
+}
+
+func printOutput(out io.Writer, output string, obj any, printText func(io.Writer)) error {
+	switch output {
+	case outputJSON:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(obj)
+	case outputYAML:
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	printText(w)
+	return w.Flush()
+}
+
+func printStatus(w io.Writer, status *debug.Status) {
+	if status.OperatingSystemConfig != nil {
+		printDryRunResult(w, status.OperatingSystemConfig)
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintln(w, "Units:")
+	if len(status.Units) == 0 {
+		fmt.Fprintln(w, "  <none>")
+	}
+	for _, unit := range status.Units {
+		fmt.Fprintf(w, "  %s\t%s\n", unit.Name, unit.ActiveState)
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "Controller errors:")
+	if len(status.Controllers) == 0 {
+		fmt.Fprintln(w, "  <none>")
+	}
+	controllerNames := make([]string, 0, len(status.Controllers))
+	for name := range status.Controllers {
+		controllerNames = append(controllerNames, name)
+	}
+	sort.Strings(controllerNames)
+	for _, name := range controllerNames {
+		controller := status.Controllers[name]
+		fmt.Fprintf(w, "  %s\t%d error(s)\t%s\n", name, controller.Errors, formatReconcileError(controller.LastError))
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "Access tokens:")
+	if len(status.Tokens) == 0 {
+		fmt.Fprintln(w, "  <none>")
+	}
+	for _, token := range status.Tokens {
+		lastUpdate := "not synced"
+		if token.LastUpdateTime != nil {
+			lastUpdate = "updated " + token.LastUpdateTime.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", token.SecretName, token.Path, lastUpdate, formatReconcileError(token.LastError))
+	}
+
+	if len(status.Errors) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Errors while collecting the status:")
+		for _, err := range status.Errors {
+			fmt.Fprintf(w, "  %s\n", err)
+		}
+	}
+}
+
+func formatReconcileError(reconcileError *debug.ReconcileError) string {
+	if reconcileError == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%s %s: %s", reconcileError.Time.Format(time.RFC3339), reconcileError.Object, reconcileError.Message)
+}
+
+func printDryRunResult(w io.Writer, result *operatingsystemconfig.DryRunResult) {
+	fmt.Fprintln(w, "Operating system config:")
+	fmt.Fprintf(w, "  Applied checksum:\t%s\n", valueOrNone(result.AppliedChecksum))
+	fmt.Fprintf(w, "  Desired checksum:\t%s\n", valueOrNone(result.DesiredChecksum))
+
+	switch {
+	case result.RolledBack:
+		fmt.Fprintln(w, "  The desired operating system config was rolled back and is not applied again until it changes.")
+	case result.AppliedChecksum == result.DesiredChecksum:
+		fmt.Fprintln(w, "  The desired operating system config is applied.")
+	case result.InPlaceUpdateRequired:
+		fmt.Fprintln(w, "  The node is drained and updated in-place before the changes are applied.")
+	}
+
+	changes := result.Changes
+	fmt.Fprintln(w, "  Pending changes:")
+	var hasChanges bool
+	printList := func(title string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		hasChanges = true
+		fmt.Fprintf(w, "    %s:\t%s\n", title, strings.Join(items, ", "))
+	}
+
+	printList("Files to write", changes.ChangedFiles)
+	printList("Files to delete", changes.DeletedFiles)
+	var changedUnits []string
+	for _, unit := range changes.ChangedUnits {
+		var details []string
+		if len(unit.ChangedDropIns) > 0 {
+			details = append(details, "drop-ins to write: "+strings.Join(unit.ChangedDropIns, ", "))
+		}
+		if len(unit.DeletedDropIns) > 0 {
+			details = append(details, "drop-ins to delete: "+strings.Join(unit.DeletedDropIns, ", "))
+		}
+		if len(details) > 0 {
+			changedUnits = append(changedUnits, fmt.Sprintf("%s (%s)", unit.Name, strings.Join(details, "; ")))
+			continue
+		}
+		changedUnits = append(changedUnits, unit.Name)
+	}
+	printList("Units to write", changedUnits)
+	printList("Units to delete", changes.DeletedUnits)
+	var unitCommands []string
+	for _, command := range changes.UnitCommands {
+		unitCommands = append(unitCommands, fmt.Sprintf("%s %s", command.Command, command.Name))
+	}
+	printList("Unit commands", unitCommands)
+	if changes.ContainerdConfigFileChanged {
+		printList("Containerd", []string{"configuration file changes, containerd is restarted"})
+	}
+	printList("Registries to configure", changes.DesiredContainerdRegistries)
+	printList("Registries to remove", changes.DeletedContainerdRegistries)
+	if changes.MustRestartNodeAgent {
+		printList("Restarts", []string{Name + " restarts itself"})
+	}
+	if !hasChanges {
+		fmt.Fprintln(w, "    <none>")
+	}
+
+	if len(result.DriftedPaths) > 0 {
+		fmt.Fprintf(w, "  Drifted files and units:\t%s\n", strings.Join(result.DriftedPaths, ", "))
+	}
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
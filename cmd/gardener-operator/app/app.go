@@ -43,6 +43,7 @@ import (
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
 	"github.com/gardener/gardener/pkg/operator/controller"
 	"github.com/gardener/gardener/pkg/operator/webhook"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // Name is a const for the name of this component.
@@ -76,6 +77,10 @@ func NewCommand() *cobra.Command {
 func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *operatorconfigv1alpha1.OperatorConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
+	if err := oci.ConfigureCache(helmChartCacheOptions(cfg.HelmChartCache)); err != nil {
+		return fmt.Errorf("failed configuring Helm chart cache: %w", err)
+	}
+
 	log.Info("Getting rest config")
 	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
 		cfg.RuntimeClientConnection.Kubeconfig = kubeconfig
@@ -284,3 +289,24 @@ func getCurrentCABundle(webhookConfig client.Object) []byte {
 
 	return nil
 }
+
+func helmChartCacheOptions(cfg *operatorconfigv1alpha1.HelmChartCacheConfiguration) oci.CacheOptions {
+	opts := oci.CacheOptions{MaxSizeBytes: oci.DefaultCacheMaxSizeBytes}
+	if cfg == nil {
+		return opts
+	}
+
+	if cfg.MaxSize != nil {
+		opts.MaxSizeBytes = cfg.MaxSize.Value()
+	}
+	if cfg.TagTTL != nil {
+		opts.TagTTL = cfg.TagTTL.Duration
+	}
+	opts.Directory = ptr.Deref(cfg.Directory, "")
+	opts.DirectoryMaxSizeBytes = oci.DefaultCacheDirectoryMaxSizeBytes
+	if cfg.DirectoryMaxSize != nil {
+		opts.DirectoryMaxSizeBytes = cfg.DirectoryMaxSize.Value()
+	}
+
+	return opts
+}
@@ -62,6 +62,7 @@ func NewCommand() *cobra.Command {
 	verflag.AddFlags(flags)
 	opts.addFlags(flags)
 
+	cmd.AddCommand(getExplainCommand(opts))
 	return cmd
 }
 
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/cmd/utils/initrun"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+type explainOptions struct {
+	kubeconfig string
+	shoot      string
+	shootFile  string
+	output     string
+}
+
+func (o *explainOptions) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.kubeconfig, "kubeconfig", o.kubeconfig, "Path to a kubeconfig for the garden cluster. Defaults to the client connection of the configuration.")
+	fs.StringVar(&o.shoot, "shoot", o.shoot, "Key (<namespace>/<name>) of an existing shoot to explain the scheduling decision for.")
+	fs.StringVar(&o.shootFile, "shoot-file", o.shootFile, "Path to a shoot manifest to explain the scheduling decision for. The shoot does not need to exist.")
+	fs.StringVarP(&o.output, "output", "o", "yaml", "Output format of the report, either 'yaml' or 'json'.")
+}
+
+func (o *explainOptions) validate() error {
+	if (o.shoot == "") == (o.shootFile == "") {
+		return errors.New("exactly one of --shoot or --shoot-file must be specified")
+	}
+	if o.shoot != "" && len(strings.Split(o.shoot, "/")) != 2 {
+		return errors.New("--shoot must have the format <namespace>/<name>")
+	}
+	if o.output != "yaml" && o.output != "json" {
+		return fmt.Errorf("unsupported output format %q, must be either 'yaml' or 'json'", o.output)
+	}
+	return nil
+}
+
+func getExplainCommand(opts *options) *cobra.Command {
+	explainOpts := &explainOptions{}
+
+	explainCmd := &cobra.Command{
+		Use:   "explain",
+		Short: "Explain the scheduling decision for a shoot without scheduling it",
+		Long: `Explain runs the scheduling for an existing shoot or a proposed shoot manifest against the current state of
+the garden cluster without persisting anything. It prints a report containing the filter rejecting each unsuitable seed
+and the ranking of the remaining seeds with their scores.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := explainOpts.validate(); err != nil {
+				return err
+			}
+
+			log, err := initrun.InitRun(cmd, opts, Name)
+			if err != nil {
+				return err
+			}
+			return explain(cmd.Context(), log, cmd.OutOrStdout(), opts.config, explainOpts)
+		},
+	}
+
+	flags := explainCmd.Flags()
+	opts.addFlags(flags)
+	explainOpts.addFlags(flags)
+
+	return explainCmd
+}
+
+func explain(ctx context.Context, log logr.Logger, out io.Writer, cfg *schedulerconfigv1alpha1.SchedulerConfiguration, opts *explainOptions) error {
+	clientConnection := cfg.ClientConnection.DeepCopy()
+	if opts.kubeconfig != "" {
+		clientConnection.Kubeconfig = opts.kubeconfig
+	}
+
+	restCfg, err := kubernetes.RESTConfigFromClientConnectionConfiguration(clientConnection, nil, kubernetes.AuthTokenFile)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(restCfg, client.Options{Scheme: kubernetes.GardenScheme})
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	shootToExplain := &gardencorev1beta1.Shoot{}
+	if opts.shootFile != "" {
+		data, err := os.ReadFile(opts.shootFile)
+		if err != nil {
+			return fmt.Errorf("failed reading shoot file: %w", err)
+		}
+		if err := runtime.DecodeInto(kubernetes.GardenCodec.UniversalDecoder(gardencorev1beta1.SchemeGroupVersion), data, shootToExplain); err != nil {
+			return fmt.Errorf("failed decoding shoot: %w", err)
+		}
+	} else {
+		namespace, name, _ := strings.Cut(opts.shoot, "/")
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, shootToExplain); err != nil {
+			return fmt.Errorf("failed reading shoot: %w", err)
+		}
+	}
+
+	report, err := (&shoot.Reconciler{
+		Client:          c,
+		Config:          cfg.Schedulers.Shoot,
+		GardenNamespace: v1beta1constants.GardenNamespace,
+	}).Explain(ctx, log, shootToExplain)
+	if err != nil {
+		return fmt.Errorf("failed explaining scheduling decision: %w", err)
+	}
+
+	var output []byte
+	if opts.output == "json" {
+		output, err = json.MarshalIndent(report, "", "  ")
+		output = append(output, '\n')
+	} else {
+		output, err = yaml.Marshal(report)
+	}
+	if err != nil {
+		return fmt.Errorf("failed marshalling report: %w", err)
+	}
+
+	_, err = out.Write(output)
+	return err
+}
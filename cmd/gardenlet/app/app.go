@@ -61,10 +61,12 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrap"
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrap/certificate"
 	"github.com/gardener/gardener/pkg/gardenlet/controller"
+	"github.com/gardener/gardener/pkg/gardenlet/wakeupproxy"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // Name is a const for the name of this component.
@@ -98,6 +100,10 @@ func NewCommand() *cobra.Command {
 func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *gardenletconfigv1alpha1.GardenletConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
+	if err := oci.ConfigureCache(helmChartCacheOptions(cfg.HelmChartCache)); err != nil {
+		return fmt.Errorf("failed configuring Helm chart cache: %w", err)
+	}
+
 	if kubeconfig := os.Getenv("GARDEN_KUBECONFIG"); kubeconfig != "" {
 		cfg.GardenClientConnection.Kubeconfig = kubeconfig
 	}
@@ -163,8 +169,11 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
 		return err
 	}
-	if err := mgr.AddHealthzCheck("periodic-health", gardenerhealthz.CheckerFunc(healthManager)); err != nil {
-		return err
+	// The periodic health is renewed by the seed controllers which do not run for self-hosted shoots.
+	if cfg.SelfHostedShoot == nil {
+		if err := mgr.AddHealthzCheck("periodic-health", gardenerhealthz.CheckerFunc(healthManager)); err != nil {
+			return err
+		}
 	}
 	if err := mgr.AddReadyzCheck("seed-informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
 		return err
@@ -221,6 +230,10 @@ func (g *garden) Start(ctx context.Context) error {
 		return err
 	}
 
+	if g.config.SelfHostedShoot != nil {
+		return g.startForSelfHostedShoot(ctx, log, gardenRESTConfig)
+	}
+
 	log.Info("Setting up cluster object for garden")
 	gardenCluster, err := cluster.New(gardenRESTConfig, func(opts *cluster.Options) {
 		opts.Scheme = kubernetes.GardenScheme
@@ -398,6 +411,25 @@ func (g *garden) Start(ctx context.Context) error {
 		}))
 	}
 
+	if wakeUpProxyConfig := g.config.Server.WakeUpProxy; wakeUpProxyConfig != nil {
+		log.Info("Setting up cluster object for wake-up proxy")
+		wakeUpProxySeedCluster, err := wakeupproxy.NewSeedCluster(g.mgr.GetConfig(), log.WithName("wake-up-proxy"))
+		if err != nil {
+			return fmt.Errorf("failed creating seed cluster object for wake-up proxy: %w", err)
+		}
+
+		runnables = append(runnables, wakeUpProxySeedCluster, &wakeupproxy.Server{
+			Log:               log.WithName("wake-up-proxy"),
+			SeedReader:        wakeUpProxySeedCluster.GetClient(),
+			GardenClient:      gardenCluster.GetClient(),
+			Recorder:          gardenCluster.GetEventRecorderFor("wake-up-proxy"),
+			Clock:             clock.RealClock{},
+			BindAddress:       wakeUpProxyConfig.BindAddress,
+			RetryAfter:        wakeUpProxyConfig.RetryAfter.Duration,
+			MinWakeUpInterval: wakeUpProxyConfig.MinWakeUpInterval.Duration,
+		})
+	}
+
 	if err := controllerutils.AddAllRunnables(g.mgr, runnables...); err != nil {
 		return err
 	}
@@ -555,3 +587,24 @@ func addAllFieldIndexes(ctx context.Context, i client.FieldIndexer) error {
 
 	return nil
 }
+
+func helmChartCacheOptions(cfg *gardenletconfigv1alpha1.HelmChartCacheConfiguration) oci.CacheOptions {
+	opts := oci.CacheOptions{MaxSizeBytes: oci.DefaultCacheMaxSizeBytes}
+	if cfg == nil {
+		return opts
+	}
+
+	if cfg.MaxSize != nil {
+		opts.MaxSizeBytes = cfg.MaxSize.Value()
+	}
+	if cfg.TagTTL != nil {
+		opts.TagTTL = cfg.TagTTL.Duration
+	}
+	opts.Directory = ptr.Deref(cfg.Directory, "")
+	opts.DirectoryMaxSizeBytes = oci.DefaultCacheDirectoryMaxSizeBytes
+	if cfg.DirectoryMaxSize != nil {
+		opts.DirectoryMaxSizeBytes = cfg.DirectoryMaxSize.Value()
+	}
+
+	return opts
+}
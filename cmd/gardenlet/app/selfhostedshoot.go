@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/controller"
+)
+
+// startForSelfHostedShoot starts gardenlet for a self-hosted shoot cluster which was connected to the garden cluster via
+// `gardenadm connect`. In this mode, gardenlet runs in the self-hosted shoot cluster, does not register a Seed, and is
+// only permitted to access the Shoot it is responsible for and its ShootState.
+func (g *garden) startForSelfHostedShoot(ctx context.Context, log logr.Logger, gardenRESTConfig *rest.Config) error {
+	shootKey := client.ObjectKey{Namespace: g.config.SelfHostedShoot.Namespace, Name: g.config.SelfHostedShoot.Name}
+	log = log.WithValues("shoot", shootKey)
+
+	log.Info("Setting up cluster object for garden")
+	gardenCluster, err := cluster.New(gardenRESTConfig, func(opts *cluster.Options) {
+		opts.Scheme = kubernetes.GardenScheme
+		opts.Logger = log
+
+		opts.Client.Cache = &client.CacheOptions{
+			DisableFor: []client.Object{
+				&corev1.Event{},
+				&eventsv1.Event{},
+			},
+		}
+
+		opts.NewCache = func(config *rest.Config, opts cache.Options) (cache.Cache, error) {
+			// gardenlet should watch only the shoot it is responsible for.
+			opts.DefaultNamespaces = map[string]cache.Config{shootKey.Namespace: {}}
+			opts.ByObject = map[client.Object]cache.ByObject{
+				&gardencorev1beta1.Shoot{}: {
+					Field: fields.SelectorFromSet(fields.Set{metav1.ObjectNameField: shootKey.Name}),
+				},
+			}
+
+			return kubernetes.AggregatorCacheFunc(
+				kubernetes.NewRuntimeCache,
+				map[client.Object]cache.NewCacheFunc{
+					// Gardenlet does not have the required RBAC permissions for listing/watching ShootStates, hence, we
+					// need to watch them individually with the help of a SingleObject cache.
+					&gardencorev1beta1.ShootState{}: kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.ShootState{}),
+				},
+				kubernetes.GardenScheme,
+			)(config, opts)
+		}
+
+		opts.MapperProvider = apiutil.NewDynamicRESTMapper
+	})
+	if err != nil {
+		return fmt.Errorf("failed creating garden cluster object: %w", err)
+	}
+
+	log.Info("Adding garden cluster to manager")
+	if err := g.mgr.Add(gardenCluster); err != nil {
+		return fmt.Errorf("failed adding garden cluster to manager: %w", err)
+	}
+
+	waitForSyncCtx, waitForSyncCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer waitForSyncCancel()
+
+	log.V(1).Info("Waiting for cache to be synced")
+	if !gardenCluster.GetCache().WaitForCacheSync(waitForSyncCtx) {
+		return fmt.Errorf("failed waiting for cache to be synced")
+	}
+
+	log.Info("Checking that the self-hosted Shoot exists in the garden cluster")
+	if err := gardenCluster.GetAPIReader().Get(ctx, shootKey, &gardencorev1beta1.Shoot{}); err != nil {
+		return fmt.Errorf("failed reading self-hosted shoot %s: %w", shootKey, err)
+	}
+
+	log.Info("Adding controllers to manager")
+	if err := controller.AddToManagerForSelfHostedShoot(g.mgr, gardenCluster, g.mgr, g.config); err != nil {
+		return fmt.Errorf("failed adding controllers to manager: %w", err)
+	}
+
+	return nil
+}
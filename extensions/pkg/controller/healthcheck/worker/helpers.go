@@ -6,8 +6,11 @@ package worker
 
 import (
 	"fmt"
+	"slices"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 )
@@ -35,3 +38,19 @@ func getDesiredMachineCount(machineDeployments []machinev1alpha1.MachineDeployme
 	}
 	return desiredMachines
 }
+
+func checkNodesWithoutProblems(nodes []corev1.Node, problemConditionTypes []corev1.NodeConditionType) error {
+	for _, node := range nodes {
+		if metav1.HasAnnotation(node.ObjectMeta, AnnotationKeyNotManagedByMCM) && node.Annotations[AnnotationKeyNotManagedByMCM] == "1" {
+			continue
+		}
+
+		for _, condition := range node.Status.Conditions {
+			if condition.Status == corev1.ConditionTrue && slices.Contains(problemConditionTypes, condition.Type) {
+				return fmt.Errorf("node %q has problem %s: %s", node.Name, condition.Type, condition.Message)
+			}
+		}
+	}
+
+	return nil
+}
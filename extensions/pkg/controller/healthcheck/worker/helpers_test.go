@@ -8,6 +8,8 @@ import (
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("health", func() {
@@ -94,4 +96,45 @@ var _ = Describe("health", func() {
 			Expect(err).ToNot(Succeed())
 		})
 	})
+
+	Describe("#checkNodesWithoutProblems", func() {
+		var nodes []corev1.Node
+
+		BeforeEach(func() {
+			nodes = []corev1.Node{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+					Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+						{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+						{Type: "KernelProblem", Status: corev1.ConditionFalse},
+					}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+					Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+						{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+						{Type: "KernelProblem", Status: corev1.ConditionTrue, Message: "The kernel reported 1 hung task(s)."},
+					}},
+				},
+			}
+		})
+
+		It("should succeed if no problem condition types are configured", func() {
+			Expect(checkNodesWithoutProblems(nodes, nil)).To(Succeed())
+		})
+
+		It("should succeed if no node has a problem", func() {
+			Expect(checkNodesWithoutProblems(nodes, []corev1.NodeConditionType{"DataVolumePressure"})).To(Succeed())
+		})
+
+		It("should fail if a node has a problem", func() {
+			Expect(checkNodesWithoutProblems(nodes, []corev1.NodeConditionType{"DataVolumePressure", "KernelProblem"})).To(MatchError(`node "node-2" has problem KernelProblem: The kernel reported 1 hung task(s).`))
+		})
+
+		It("should ignore nodes which are not managed by machine-controller-manager", func() {
+			nodes[1].Annotations = map[string]string{AnnotationKeyNotManagedByMCM: "1"}
+
+			Expect(checkNodesWithoutProblems(nodes, []corev1.NodeConditionType{"KernelProblem"})).To(Succeed())
+		})
+	})
 })
@@ -40,6 +40,9 @@ type DefaultHealthChecker struct {
 	scaleUpProgressingThreshold *time.Duration
 	// scaleDownProgressingThreshold is the progressing threshold when the health check detects a scale-down situation.
 	scaleDownProgressingThreshold *time.Duration
+	// nodeProblemConditionTypes are the types of the node conditions which indicate a problem of the node if their
+	// status is True.
+	nodeProblemConditionTypes []corev1.NodeConditionType
 }
 
 // NewNodesChecker is a health check function which performs certain checks about the nodes registered in the cluster.
@@ -66,6 +69,13 @@ func (h *DefaultHealthChecker) WithScaleDownProgressingThreshold(d time.Duration
 	return h
 }
 
+// WithNodeProblemConditionTypes sets the types of the node conditions which indicate a problem of the node if their
+// status is True, e.g., the conditions reported by the node problem checks of gardener-node-agent.
+func (h *DefaultHealthChecker) WithNodeProblemConditionTypes(conditionTypes ...corev1.NodeConditionType) *DefaultHealthChecker {
+	h.nodeProblemConditionTypes = conditionTypes
+	return h
+}
+
 // InjectSeedClient injects the seed client.
 func (h *DefaultHealthChecker) InjectSeedClient(seedClient client.Client) {
 	h.seedClient = seedClient
@@ -157,5 +167,13 @@ func (h *DefaultHealthChecker) Check(ctx context.Context, request types.Namespac
 		}, nil
 	}
 
+	if err := checkNodesWithoutProblems(nodeList.Items, h.nodeProblemConditionTypes); err != nil {
+		h.logger.Error(err, "Health check failed")
+		return &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: err.Error(),
+		}, nil
+	}
+
 	return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
 }
@@ -14,14 +14,15 @@ import (
 
 // Actuator acts upon OperatingSystemConfig resources.
 type Actuator interface {
-	// Reconcile the operating system config.
-	Reconcile(context.Context, logr.Logger, *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error)
+	// Reconcile the operating system config. Besides the user data and the additional units and files, it returns the
+	// configuration for in-place updates (only relevant for OperatingSystemConfigs with `.spec.inPlaceUpdates` set).
+	Reconcile(context.Context, logr.Logger, *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, *extensionsv1alpha1.InPlaceUpdatesStatus, error)
 	// Delete the operating system config.
 	Delete(context.Context, logr.Logger, *extensionsv1alpha1.OperatingSystemConfig) error
 	// ForceDelete forcefully deletes the operating system config.
 	ForceDelete(context.Context, logr.Logger, *extensionsv1alpha1.OperatingSystemConfig) error
 	// Restore the operating system config.
-	Restore(context.Context, logr.Logger, *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error)
+	Restore(context.Context, logr.Logger, *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, *extensionsv1alpha1.InPlaceUpdatesStatus, error)
 	// Migrate the operating system config.
 	Migrate(context.Context, logr.Logger, *extensionsv1alpha1.OperatingSystemConfig) error
 }
@@ -118,6 +118,15 @@ func WorkerPoolHashV1(pool extensionsv1alpha1.WorkerPool, cluster *extensionscon
 		pool.MachineImage.Name + pool.MachineImage.Version,
 	}
 
+	// Nodes of worker pools with in-place update strategy are updated by gardener-node-agent, hence version changes
+	// must not lead to a replacement of the machines.
+	if v1beta1helper.IsUpdateStrategyInPlace(pool.UpdateStrategy) {
+		data = []string{
+			pool.MachineType,
+			pool.MachineImage.Name,
+		}
+	}
+
 	if pool.Volume != nil {
 		data = append(data, pool.Volume.Size)
 
@@ -298,6 +298,30 @@ var _ = Describe("Machines", func() {
 				c.Shoot.Spec.SystemComponents = &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true}}
 			})
 		})
+
+		Context("worker pool with in-place update strategy", func() {
+			BeforeEach(func() {
+				p.UpdateStrategy = ptr.To(gardencorev1beta1.AutoInPlaceUpdate)
+
+				var err error
+				hash, err = WorkerPoolHash(p, c, additionalDataV1, additionalDataV2)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should not change the hash when changing the versions", func() {
+				p.MachineImage.Version = "new-version"
+				p.KubernetesVersion = ptr.To("1.3.3")
+				c.Shoot.Spec.Kubernetes.Version = "1.3.3"
+
+				Expect(WorkerPoolHash(p, c, additionalDataV1, additionalDataV2)).To(Equal(hash))
+			})
+
+			It("should change the hash when changing the machine image name", func() {
+				p.MachineImage.Name = "new-image"
+
+				Expect(WorkerPoolHash(p, c, additionalDataV1, additionalDataV2)).NotTo(Equal(hash))
+			})
+		})
 	})
 
 	Describe("#WorkerPoolHashV2", func() {
@@ -61,6 +61,9 @@ type CloudProfileSpec struct {
 	VolumeTypes []VolumeType
 	// Bastion contains machine and image properties
 	Bastion *Bastion
+	// VersionRolloutStrategy configures staged rollouts of new Kubernetes and machine image versions to the Shoots
+	// referencing this profile via automatic updates during their maintenance time windows.
+	VersionRolloutStrategy *VersionRolloutStrategy
 }
 
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
@@ -133,6 +136,9 @@ type MachineType struct {
 	Usable *bool
 	// Architecture is the CPU architecture of this machine type.
 	Architecture *string
+	// CostUnits is the abstract price of a single machine of this type. It is used to determine the cost units allocated
+	// by shoot clusters, e.g., for quotas.
+	CostUnits *resource.Quantity
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -242,3 +248,30 @@ const (
 	// UpdateStrategyMajor indicates that auto-updates are performed always to the overall latest version.
 	UpdateStrategyMajor MachineImageUpdateStrategy = "major"
 )
+
+// VersionRolloutStrategy configures staged rollouts of new versions to Shoots via automatic updates.
+type VersionRolloutStrategy struct {
+	// Waves is the ordered list of rollout waves. A Shoot belongs to the first wave it matches. Shoots which do not
+	// match any wave belong to an implicit last wave.
+	Waves []VersionRolloutWave
+	// SoakDuration is the duration for which the Shoots of a wave must have been healthy on a new version before the
+	// Shoots of the next wave are automatically updated to this version.
+	SoakDuration *metav1.Duration
+	// MaxUnhealthyPercentage is the percentage of unhealthy Shoots among all Shoots already running a new version above
+	// which the rollout of this version is halted.
+	MaxUnhealthyPercentage *int32
+}
+
+// VersionRolloutWave is a wave of a staged version rollout. A Shoot matches a wave if it matches all of the
+// configured criteria.
+type VersionRolloutWave struct {
+	// Name is the name of the wave.
+	Name string
+	// ShootSelector is an optional label selector for the Shoots of this wave.
+	ShootSelector *metav1.LabelSelector
+	// Purposes is an optional list of Shoot purposes of this wave.
+	Purposes []ShootPurpose
+	// Percentage optionally restricts the wave to the given percentage of all Shoots. The Shoots are selected
+	// deterministically based on their UID.
+	Percentage *int32
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenanceFreeze blocks automatic changes to Shoots during configured time windows, e.g., during company-wide change
+// freezes or holidays. While a freeze is active for a Shoot, its maintenance and its hibernation schedules are skipped.
+type MaintenanceFreeze struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec contains the specification of this maintenance freeze.
+	Spec MaintenanceFreezeSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenanceFreezeList is a collection of MaintenanceFreezes.
+type MaintenanceFreezeList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of MaintenanceFreezes.
+	Items []MaintenanceFreeze
+}
+
+// MaintenanceFreezeSpec is the specification of a MaintenanceFreeze.
+type MaintenanceFreezeSpec struct {
+	// Windows is a list of time windows during which the freeze is active.
+	Windows []MaintenanceFreezeWindow
+	// ShootSelector is an optional label selector for the Shoots the freeze applies to. If it is not set, the freeze
+	// applies to all Shoots.
+	ShootSelector *metav1.LabelSelector
+	// ProjectSelector is an optional label selector for the Projects whose Shoots the freeze applies to. If it is not
+	// set, the freeze applies to the Shoots of all Projects.
+	ProjectSelector *metav1.LabelSelector
+}
+
+// MaintenanceFreezeWindow is a time window during which a MaintenanceFreeze is active.
+type MaintenanceFreezeWindow struct {
+	// Begin is the point in time when the freeze begins.
+	Begin metav1.Time
+	// End is the point in time when the freeze ends.
+	End metav1.Time
+	// Reason is an optional human-readable explanation of the freeze, e.g., the name of the holiday. It is included in
+	// the events recorded for skipped operations.
+	Reason *string
+}
@@ -6,6 +6,7 @@ package core
 
 import (
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -73,6 +74,64 @@ type ProjectStatus struct {
 	StaleAutoDeleteTimestamp *metav1.Time
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	LastActivityTimestamp *metav1.Time
+	// Usage contains the resources consumed by the shoot clusters of this project in the current accounting period.
+	Usage *ProjectUsage
+	// LastPeriodUsage contains the resources consumed by the shoot clusters of this project in the previous accounting
+	// period.
+	LastPeriodUsage *ProjectUsage
+}
+
+// ProjectUsage contains the resources consumed by the shoot clusters of a project, accumulated over an accounting
+// period.
+type ProjectUsage struct {
+	// PeriodStartTime is the start of the accounting period, i.e., the beginning of the current month (UTC).
+	PeriodStartTime metav1.Time
+	// LastUpdateTime is the time when the usage was last accumulated.
+	LastUpdateTime metav1.Time
+	// Machines contains the machine hours per machine type.
+	Machines []ProjectMachineUsage
+	// Shoots contains the hours the shoot clusters were awake or hibernated.
+	Shoots ProjectShootUsage
+	// ControlPlanes contains the hours of awake control planes per high availability mode.
+	ControlPlanes []ProjectControlPlaneUsage
+	// Storage contains the gibibyte hours of the worker node volumes per volume class.
+	Storage []ProjectStorageUsage
+	// CostUnits is the sum of the cost units of all machines.
+	CostUnits *resource.Quantity
+}
+
+// ProjectMachineUsage contains the usage of a machine type.
+type ProjectMachineUsage struct {
+	// Type is the name of the machine type.
+	Type string
+	// Hours is the number of machine hours.
+	Hours resource.Quantity
+	// CostUnits is the number of machine hours multiplied with the cost units of the machine type in the CloudProfile.
+	CostUnits *resource.Quantity
+}
+
+// ProjectShootUsage contains the hours the shoot clusters were awake or hibernated.
+type ProjectShootUsage struct {
+	// AwakeHours is the number of hours the shoot clusters were awake.
+	AwakeHours resource.Quantity
+	// HibernatedHours is the number of hours the shoot clusters were hibernated.
+	HibernatedHours resource.Quantity
+}
+
+// ProjectControlPlaneUsage contains the usage of control planes with a certain high availability mode.
+type ProjectControlPlaneUsage struct {
+	// HighAvailability is the failure tolerance type of the control planes, i.e., `none`, `node`, or `zone`.
+	HighAvailability string
+	// Hours is the number of control plane hours.
+	Hours resource.Quantity
+}
+
+// ProjectStorageUsage contains the usage of worker node volumes with a certain volume class.
+type ProjectStorageUsage struct {
+	// Class is the volume class.
+	Class string
+	// GibibyteHours is the volume size in gibibytes multiplied with the number of hours.
+	GibibyteHours resource.Quantity
 }
 
 // ProjectMember is a member of a project.
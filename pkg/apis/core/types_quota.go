@@ -19,6 +19,8 @@ type Quota struct {
 	metav1.ObjectMeta
 	// Spec defines the Quota constraints.
 	Spec QuotaSpec
+	// Status contains the current usage of the Quota.
+	Status QuotaStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -42,6 +44,14 @@ type QuotaSpec struct {
 	Scope corev1.ObjectReference
 }
 
+// QuotaStatus is the status of a Quota.
+type QuotaStatus struct {
+	// Used is the amount of the constrained resources which is currently allocated by the shoot clusters referencing
+	// the Quota. For project-scoped Quotas, it is the highest amount allocated within a single project since the limits
+	// apply to each project separately.
+	Used corev1.ResourceList
+}
+
 const (
 	// QuotaMetricCPU is the constraint for the amount of CPUs
 	QuotaMetricCPU corev1.ResourceName = corev1.ResourceCPU
@@ -55,4 +65,13 @@ const (
 	QuotaMetricStoragePremium corev1.ResourceName = corev1.ResourceStorage + ".premium"
 	// QuotaMetricLoadbalancer is the constraint for the amount of loadbalancers
 	QuotaMetricLoadbalancer corev1.ResourceName = "loadbalancer"
+	// QuotaMetricShoots is the constraint for the amount of shoot clusters
+	QuotaMetricShoots corev1.ResourceName = "shoots"
+	// QuotaMetricNodes is the constraint for the maximum amount of nodes of all worker pools
+	QuotaMetricNodes corev1.ResourceName = "nodes"
+	// QuotaMetricControlPlanesHighAvailability is the constraint for the amount of highly available control planes
+	QuotaMetricControlPlanesHighAvailability corev1.ResourceName = "controlplanes.highavailability"
+	// QuotaMetricCostUnits is the constraint for the amount of cost units derived from the prices of the machine types
+	// in the CloudProfile
+	QuotaMetricCostUnits corev1.ResourceName = "costunits"
 )
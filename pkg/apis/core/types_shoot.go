@@ -160,6 +160,16 @@ type ShootStatus struct {
 	EncryptedResources []string
 	// Networking contains information about cluster networking such as CIDRs.
 	Networking *NetworkingStatus
+	// WorkerPools contains the number of nodes per worker pool as last observed by gardenlet.
+	WorkerPools []WorkerPoolStatus
+}
+
+// WorkerPoolStatus contains the observed state of a worker pool.
+type WorkerPoolStatus struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Nodes is the number of nodes of the worker pool.
+	Nodes int32
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -453,6 +463,9 @@ type Hibernation struct {
 	Enabled *bool
 	// Schedules determine the hibernation schedules.
 	Schedules []HibernationSchedule
+	// WakeUpOnDemand specifies whether the Shoot shall be woken up when its API server is requested while it is
+	// hibernated. The caller receives a response asking it to retry the request later.
+	WakeUpOnDemand *bool
 }
 
 // HibernationSchedule determines the hibernation schedule of a Shoot.
@@ -465,6 +478,9 @@ type HibernationSchedule struct {
 	End *string
 	// Location is the time location in which both start and shall be evaluated.
 	Location *string
+	// Exceptions is a list of dates (format `YYYY-MM-DD`) on which the Shoot will not be hibernated by this schedule.
+	// The dates are evaluated in the time location of the schedule. Wake-ups still happen on these dates.
+	Exceptions []string
 }
 
 // Kubernetes contains the version and configuration variables for the Shoot control plane.
@@ -1244,8 +1260,20 @@ type Worker struct {
 	ClusterAutoscaler *ClusterAutoscalerOptions
 	// Priority (or weight) is the importance by which this worker pool will be scaled by cluster autoscaling.
 	Priority *int32
+	// UpdateStrategy specifies the machine update strategy for the worker pool.
+	UpdateStrategy *MachineUpdateStrategy
 }
 
+// MachineUpdateStrategy specifies the machine update strategy for the worker pool.
+type MachineUpdateStrategy string
+
+const (
+	// AutoRollingUpdate represents a machine update strategy where nodes are replaced during the update process.
+	AutoRollingUpdate MachineUpdateStrategy = "AutoRollingUpdate"
+	// AutoInPlaceUpdate represents a machine update strategy where nodes are updated in-place without being replaced.
+	AutoInPlaceUpdate MachineUpdateStrategy = "AutoInPlaceUpdate"
+)
+
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
 type ClusterAutoscalerOptions struct {
 	// ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed.
@@ -1451,6 +1479,12 @@ const (
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventMaintenanceFrozen indicates that a maintenance or hibernation operation was skipped because of an
+	// active maintenance freeze.
+	ShootEventMaintenanceFrozen = "MaintenanceFrozen"
+	// ShootEventVersionRolloutDeferred indicates that an automatic version update was deferred because of the version
+	// rollout strategy of the CloudProfile.
+	ShootEventVersionRolloutDeferred = "VersionRolloutDeferred"
 )
 
 const (
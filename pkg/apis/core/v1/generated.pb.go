@@ -13,6 +13,7 @@ import (
 	io "io"
 
 	proto "github.com/gogo/protobuf/proto"
+	v12 "k8s.io/api/core/v1"
 	v11 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 
 	math "math"
@@ -144,11 +145,40 @@ func (m *OCIRepository) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OCIRepository proto.InternalMessageInfo
 
+func (m *OCIVerification) Reset()      { *m = OCIVerification{} }
+func (*OCIVerification) ProtoMessage() {}
+func (*OCIVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b216bec51effd5c, []int{4}
+}
+func (m *OCIVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCIVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OCIVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIVerification.Merge(m, src)
+}
+func (m *OCIVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIVerification proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ControllerDeployment)(nil), "github.com.gardener.gardener.pkg.apis.core.v1.ControllerDeployment")
 	proto.RegisterType((*ControllerDeploymentList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1.ControllerDeploymentList")
 	proto.RegisterType((*HelmControllerDeployment)(nil), "github.com.gardener.gardener.pkg.apis.core.v1.HelmControllerDeployment")
 	proto.RegisterType((*OCIRepository)(nil), "github.com.gardener.gardener.pkg.apis.core.v1.OCIRepository")
+	proto.RegisterType((*OCIVerification)(nil), "github.com.gardener.gardener.pkg.apis.core.v1.OCIVerification")
 }
 
 func init() {
@@ -156,44 +186,51 @@ func init() {
 }
 
 var fileDescriptor_9b216bec51effd5c = []byte{
-	// 587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0x8d, 0x93, 0xb6, 0x5f, 0xbe, 0x6d, 0x8b, 0xc0, 0xe2, 0x60, 0x7a, 0xb0, 0xab, 0x9c, 0x7a,
-	0xc9, 0x9a, 0x54, 0x08, 0x71, 0x00, 0x0e, 0x4e, 0x25, 0x5a, 0x04, 0x44, 0xda, 0x22, 0x0e, 0x08,
-	0x09, 0x36, 0xce, 0xc4, 0x36, 0xb1, 0xbd, 0xd6, 0x7a, 0x13, 0xc8, 0x8d, 0x9f, 0xc0, 0x95, 0x13,
-	0x7f, 0x27, 0xc7, 0x1e, 0x7b, 0x32, 0xc4, 0xfc, 0x11, 0xb4, 0x1b, 0x37, 0x76, 0x68, 0x2a, 0xc8,
-	0x6d, 0xfd, 0x66, 0xde, 0x9b, 0xf7, 0x66, 0x37, 0x41, 0x4f, 0xbc, 0x40, 0xf8, 0xe3, 0x3e, 0x76,
-	0x59, 0x64, 0x7b, 0x94, 0x0f, 0x20, 0x06, 0x5e, 0x1e, 0x92, 0x91, 0x67, 0xd3, 0x24, 0x48, 0x6d,
-	0x97, 0x71, 0xb0, 0x27, 0x1d, 0xdb, 0x93, 0x30, 0x15, 0x30, 0xc0, 0x09, 0x67, 0x82, 0xe9, 0xed,
-	0x92, 0x8e, 0xaf, 0x58, 0xe5, 0x21, 0x19, 0x79, 0x58, 0xd2, 0xb1, 0xa4, 0xe3, 0x49, 0xe7, 0xa0,
-	0x5d, 0x9d, 0xc6, 0x3c, 0x66, 0x2b, 0x95, 0xfe, 0x78, 0xa8, 0xbe, 0xd4, 0x87, 0x3a, 0x2d, 0xd4,
-	0x0f, 0x4e, 0x47, 0x8f, 0x52, 0x1c, 0x30, 0x69, 0x01, 0x3e, 0x0b, 0x88, 0xd3, 0x80, 0xc5, 0x69,
-	0x5b, 0x2a, 0x02, 0x9f, 0x54, 0xed, 0xad, 0x34, 0xac, 0xf1, 0x79, 0xf0, 0xa0, 0x54, 0x8a, 0xa8,
-	0xeb, 0x07, 0x31, 0xf0, 0x69, 0x49, 0x8f, 0x40, 0xd0, 0x75, 0x2c, 0xfb, 0x26, 0x16, 0x1f, 0xc7,
-	0x22, 0x88, 0xe0, 0x1a, 0xe1, 0xe1, 0xdf, 0x08, 0xa9, 0xeb, 0x43, 0x44, 0xff, 0xe4, 0xb5, 0x7e,
-	0x68, 0xe8, 0x6e, 0x97, 0xc5, 0x82, 0xb3, 0x30, 0x04, 0x7e, 0x02, 0x49, 0xc8, 0xa6, 0x11, 0xc4,
-	0x42, 0xff, 0x80, 0x9a, 0xd2, 0xdc, 0x80, 0x0a, 0x6a, 0x68, 0x87, 0xda, 0xd1, 0xee, 0xf1, 0x7d,
-	0xbc, 0x98, 0x81, 0xab, 0x33, 0xca, 0x4d, 0xcb, 0x6e, 0x3c, 0xe9, 0xe0, 0x5e, 0xff, 0x23, 0xb8,
-	0xe2, 0x25, 0x08, 0xea, 0xe8, 0xb3, 0xcc, 0xaa, 0xe5, 0x99, 0x85, 0x4a, 0x8c, 0x2c, 0x55, 0x75,
-	0x40, 0x5b, 0x3e, 0x84, 0x91, 0x51, 0x57, 0xea, 0xcf, 0xf0, 0x46, 0x17, 0x8a, 0x4f, 0x21, 0x8c,
-	0xd6, 0x19, 0x77, 0x9a, 0x79, 0x66, 0x6d, 0xc9, 0x2a, 0x51, 0xf2, 0xad, 0x5c, 0x43, 0xc6, 0xba,
-	0xc6, 0x17, 0x41, 0x2a, 0xf4, 0x77, 0xd7, 0x52, 0xe2, 0x7f, 0x4b, 0x29, 0xd9, 0x2a, 0xe3, 0xed,
-	0x22, 0x63, 0xf3, 0x0a, 0xa9, 0x24, 0xf4, 0xd1, 0x76, 0x20, 0x20, 0x4a, 0x8d, 0xfa, 0x61, 0xe3,
-	0x68, 0xf7, 0xb8, 0xbb, 0x61, 0xc4, 0xb5, 0xf1, 0xf6, 0x8b, 0x79, 0xdb, 0x67, 0x52, 0x99, 0x2c,
-	0x06, 0xb4, 0xbe, 0xd7, 0x91, 0x71, 0xd3, 0x46, 0xf4, 0x23, 0xd4, 0xe4, 0xf4, 0x53, 0xd7, 0xa7,
-	0x5c, 0xa8, 0x90, 0x7b, 0xce, 0x9e, 0x34, 0x4c, 0x0a, 0x8c, 0x2c, 0xab, 0x7a, 0x1f, 0xed, 0x4c,
-	0x68, 0x38, 0x86, 0xb4, 0xb8, 0x94, 0xa7, 0x95, 0x65, 0x94, 0xcf, 0xfc, 0xfd, 0xf2, 0x77, 0x50,
-	0x7a, 0x5e, 0x69, 0x90, 0xe6, 0x9f, 0x9f, 0xf7, 0x5e, 0x39, 0x28, 0xcf, 0xac, 0x9d, 0x37, 0x4a,
-	0x91, 0x14, 0xca, 0xfa, 0x18, 0xed, 0x33, 0x37, 0x20, 0x90, 0xb0, 0x34, 0x10, 0x8c, 0x4f, 0x8d,
-	0x86, 0x1a, 0xf5, 0x78, 0xc3, 0xe5, 0xf4, 0xba, 0x67, 0xa5, 0x86, 0x73, 0x27, 0xcf, 0xac, 0xfd,
-	0x15, 0x88, 0xac, 0x4e, 0x69, 0x7d, 0xd3, 0xd0, 0x6a, 0x83, 0x7e, 0x0f, 0x35, 0x38, 0x0c, 0xd5,
-	0x46, 0xfe, 0x77, 0xfe, 0xcb, 0x33, 0xab, 0x41, 0x60, 0x48, 0x24, 0xa6, 0x63, 0x84, 0x78, 0x69,
-	0xb0, 0xae, 0x3a, 0x6e, 0xc9, 0x87, 0x5c, 0xd1, 0xaf, 0x74, 0x48, 0x29, 0x41, 0x3d, 0x95, 0xa4,
-	0x90, 0x7a, 0x4d, 0x3d, 0x22, 0x31, 0xbd, 0x85, 0x76, 0x06, 0x81, 0x07, 0xa9, 0x30, 0xb6, 0x54,
-	0x55, 0xad, 0xe4, 0x44, 0x21, 0xa4, 0xa8, 0x38, 0xe7, 0xb3, 0xb9, 0x59, 0xbb, 0x98, 0x9b, 0xb5,
-	0xcb, 0xb9, 0x59, 0xfb, 0x92, 0x9b, 0xda, 0x2c, 0x37, 0xb5, 0x8b, 0xdc, 0xd4, 0x2e, 0x73, 0x53,
-	0xfb, 0x99, 0x9b, 0xda, 0xd7, 0x5f, 0x66, 0xed, 0x6d, 0x7b, 0xa3, 0x3f, 0xcc, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xd9, 0x86, 0x04, 0xdb, 0x60, 0x05, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x6d, 0xda, 0xad, 0x14, 0xaf, 0x85, 0x91, 0x71, 0x08, 0x43, 0x4a, 0xa7, 0x9e, 0x7a, 0x69,
+	0xca, 0x26, 0x84, 0x38, 0x00, 0x87, 0x74, 0x12, 0x1b, 0x0c, 0x36, 0x79, 0x68, 0x07, 0x84, 0x04,
+	0x6e, 0xfa, 0x35, 0x35, 0x4b, 0xe2, 0xc8, 0x71, 0x3b, 0x7a, 0xe3, 0xcc, 0x89, 0x7f, 0xc0, 0xdf,
+	0xd9, 0x71, 0xc7, 0x9d, 0x0a, 0x0b, 0xff, 0x80, 0x5f, 0x80, 0xec, 0x66, 0x4d, 0xb2, 0x75, 0x82,
+	0xde, 0x9c, 0xe7, 0xef, 0xbd, 0xf7, 0xbd, 0xcf, 0xb6, 0x82, 0x9e, 0xbb, 0x54, 0x0c, 0x86, 0x5d,
+	0xcb, 0x61, 0x7e, 0xdb, 0x25, 0xbc, 0x07, 0x01, 0xf0, 0x74, 0x11, 0x1e, 0xbb, 0x6d, 0x12, 0xd2,
+	0xa8, 0xed, 0x30, 0x0e, 0xed, 0xd1, 0x66, 0xdb, 0x95, 0x30, 0x11, 0xd0, 0xb3, 0x42, 0xce, 0x04,
+	0xd3, 0x5b, 0x29, 0xdd, 0xba, 0x64, 0xa5, 0x8b, 0xf0, 0xd8, 0xb5, 0x24, 0xdd, 0x92, 0x74, 0x6b,
+	0xb4, 0xb9, 0xde, 0xca, 0xba, 0x31, 0x97, 0xb5, 0x95, 0x4a, 0x77, 0xd8, 0x57, 0x5f, 0xea, 0x43,
+	0xad, 0xa6, 0xea, 0xeb, 0x8d, 0xe3, 0xa7, 0x91, 0x45, 0x99, 0x6c, 0xe1, 0xa6, 0x0e, 0xd6, 0x77,
+	0xd2, 0x1a, 0xf8, 0x22, 0x20, 0x88, 0x28, 0x0b, 0xa2, 0x96, 0x74, 0x05, 0x3e, 0xca, 0x46, 0xc8,
+	0x15, 0xcc, 0x53, 0x7a, 0x9c, 0x2a, 0xf9, 0xc4, 0x19, 0xd0, 0x00, 0xf8, 0x38, 0xa5, 0xfb, 0x20,
+	0xc8, 0x3c, 0x56, 0xfb, 0x26, 0x16, 0x1f, 0x06, 0x82, 0xfa, 0x70, 0x8d, 0xf0, 0xe4, 0x5f, 0x84,
+	0xc8, 0x19, 0x80, 0x4f, 0xae, 0xf2, 0x1a, 0x3f, 0x35, 0x74, 0xbf, 0xc3, 0x02, 0xc1, 0x99, 0xe7,
+	0x01, 0xdf, 0x86, 0xd0, 0x63, 0x63, 0x1f, 0x02, 0xa1, 0x7f, 0x42, 0x15, 0xd9, 0x5c, 0x8f, 0x08,
+	0x62, 0x68, 0x1b, 0x5a, 0x73, 0x65, 0xeb, 0x91, 0x35, 0xf5, 0xb0, 0xb2, 0x1e, 0xe9, 0x69, 0xc8,
+	0x6a, 0x6b, 0xb4, 0x69, 0xed, 0x77, 0x3f, 0x83, 0x23, 0xde, 0x80, 0x20, 0xb6, 0x7e, 0x3a, 0xa9,
+	0x17, 0xe2, 0x49, 0x1d, 0xa5, 0x18, 0x9e, 0xa9, 0xea, 0x80, 0x96, 0x06, 0xe0, 0xf9, 0x46, 0x51,
+	0xa9, 0xbf, 0xb4, 0x16, 0x3a, 0x74, 0x6b, 0x07, 0x3c, 0x7f, 0x5e, 0xe3, 0x76, 0x25, 0x9e, 0xd4,
+	0x97, 0xe4, 0x2e, 0x56, 0xf2, 0x8d, 0x58, 0x43, 0xc6, 0xbc, 0xc2, 0x3d, 0x1a, 0x09, 0xfd, 0xc3,
+	0xb5, 0x94, 0xd6, 0xff, 0xa5, 0x94, 0x6c, 0x95, 0x71, 0x35, 0xc9, 0x58, 0xb9, 0x44, 0x32, 0x09,
+	0x07, 0x68, 0x99, 0x0a, 0xf0, 0x23, 0xa3, 0xb8, 0x51, 0x6a, 0xae, 0x6c, 0x75, 0x16, 0x8c, 0x38,
+	0x37, 0x5e, 0x2d, 0xf1, 0x5b, 0xde, 0x95, 0xca, 0x78, 0x6a, 0xd0, 0xf8, 0x51, 0x44, 0xc6, 0x4d,
+	0x13, 0xd1, 0x9b, 0xa8, 0xc2, 0xc9, 0x49, 0x67, 0x40, 0xb8, 0x50, 0x21, 0xab, 0x76, 0x55, 0x36,
+	0x8c, 0x13, 0x0c, 0xcf, 0x76, 0xf5, 0x2e, 0x2a, 0x8f, 0x88, 0x37, 0x84, 0x28, 0x39, 0x94, 0x17,
+	0x99, 0x61, 0xa4, 0xd7, 0xfc, 0xe3, 0xec, 0x1d, 0xa4, 0x3d, 0xe7, 0x0a, 0x64, 0xf3, 0xaf, 0x0e,
+	0xf7, 0xdf, 0xda, 0x28, 0x9e, 0xd4, 0xcb, 0x47, 0x4a, 0x11, 0x27, 0xca, 0xfa, 0x10, 0xd5, 0x98,
+	0x43, 0x31, 0x84, 0x2c, 0xa2, 0x82, 0xf1, 0xb1, 0x51, 0x52, 0x56, 0xcf, 0x16, 0x1c, 0xce, 0x7e,
+	0x67, 0x37, 0xd5, 0xb0, 0xef, 0xc5, 0x93, 0x7a, 0x2d, 0x07, 0xe1, 0xbc, 0x4b, 0xe3, 0x4f, 0x11,
+	0xe5, 0x0b, 0xf4, 0x07, 0xa8, 0xc4, 0xa1, 0xaf, 0x26, 0x72, 0xdb, 0xbe, 0x15, 0x4f, 0xea, 0x25,
+	0x0c, 0x7d, 0x2c, 0x31, 0xdd, 0x42, 0x88, 0xa7, 0x0d, 0x16, 0x55, 0xc5, 0x1d, 0x79, 0x91, 0x33,
+	0xfa, 0x88, 0xe7, 0xa4, 0x04, 0x71, 0x8d, 0x52, 0x2a, 0xf5, 0x8e, 0xb8, 0x58, 0x62, 0x7a, 0x03,
+	0x95, 0x7b, 0xd4, 0x85, 0x48, 0x18, 0x4b, 0x6a, 0x57, 0x8d, 0x64, 0x5b, 0x21, 0x38, 0xd9, 0xd1,
+	0x09, 0xaa, 0x85, 0x43, 0xcf, 0x3b, 0x04, 0x87, 0x83, 0xc0, 0xd0, 0x37, 0x96, 0xd5, 0x48, 0x9a,
+	0x99, 0xe9, 0xcf, 0x72, 0xef, 0x31, 0x87, 0x78, 0xd3, 0xf7, 0x84, 0xa1, 0x0f, 0x1c, 0x02, 0x07,
+	0xa6, 0xf1, 0x0f, 0xb2, 0x12, 0x38, 0xaf, 0xa8, 0x0b, 0x54, 0x1d, 0x01, 0xa7, 0x7d, 0xea, 0x10,
+	0x41, 0x59, 0x60, 0x94, 0x93, 0xf3, 0x5d, 0x78, 0xe8, 0x47, 0x19, 0x15, 0x7b, 0x35, 0x9e, 0xd4,
+	0xab, 0x59, 0x04, 0xe7, 0x5c, 0x1a, 0xdf, 0x34, 0x74, 0xf7, 0x0a, 0x47, 0x3f, 0x41, 0x6b, 0xe1,
+	0xb0, 0xeb, 0x51, 0xe7, 0x35, 0x8c, 0xa3, 0x34, 0xb2, 0xb6, 0x60, 0xe4, 0x87, 0xc9, 0x3b, 0x58,
+	0x3b, 0xb8, 0x2e, 0x86, 0xe7, 0x39, 0xd8, 0x87, 0xa7, 0x17, 0x66, 0xe1, 0xec, 0xc2, 0x2c, 0x9c,
+	0x5f, 0x98, 0x85, 0xaf, 0xb1, 0xa9, 0x9d, 0xc6, 0xa6, 0x76, 0x16, 0x9b, 0xda, 0x79, 0x6c, 0x6a,
+	0xbf, 0x62, 0x53, 0xfb, 0xfe, 0xdb, 0x2c, 0xbc, 0x6f, 0x2d, 0xf4, 0xeb, 0xfa, 0x3b, 0x00, 0xe5,
+	0x2e, 0x31, 0xed, 0xea, 0x06, 0x00, 0x00,
 }
 
 func (m *ControllerDeployment) Marshal() (dAtA []byte, err error) {
@@ -362,6 +399,30 @@ func (m *OCIRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Verification != nil {
+		{
+			size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PullSecretRef != nil {
+		{
+			size, err := m.PullSecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Digest != nil {
 		i -= len(*m.Digest)
 		copy(dAtA[i:], *m.Digest)
@@ -393,6 +454,39 @@ func (m *OCIRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OCIVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PublicKeysSecretRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenerated(v)
 	base := offset
@@ -479,6 +573,25 @@ func (m *OCIRepository) Size() (n int) {
 		l = len(*m.Digest)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.PullSecretRef != nil {
+		l = m.PullSecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Verification != nil {
+		l = m.Verification.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *OCIVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PublicKeysSecretRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -536,6 +649,18 @@ func (this *OCIRepository) String() string {
 		`Repository:` + valueToStringGenerated(this.Repository) + `,`,
 		`Tag:` + valueToStringGenerated(this.Tag) + `,`,
 		`Digest:` + valueToStringGenerated(this.Digest) + `,`,
+		`PullSecretRef:` + strings.Replace(fmt.Sprintf("%v", this.PullSecretRef), "LocalObjectReference", "v12.LocalObjectReference", 1) + `,`,
+		`Verification:` + strings.Replace(this.Verification.String(), "OCIVerification", "OCIVerification", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCIVerification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OCIVerification{`,
+		`PublicKeysSecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.PublicKeysSecretRef), "LocalObjectReference", "v12.LocalObjectReference", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1101,6 +1226,161 @@ func (m *OCIRepository) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Digest = &s
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullSecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullSecretRef == nil {
+				m.PullSecretRef = &v12.LocalObjectReference{}
+			}
+			if err := m.PullSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Verification == nil {
+				m.Verification = &OCIVerification{}
+			}
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCIVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCIVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCIVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeysSecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PublicKeysSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
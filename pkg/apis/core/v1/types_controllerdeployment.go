@@ -7,6 +7,7 @@ package v1
 import (
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -65,6 +66,14 @@ type OCIRepository struct {
 	// The value should be in the format 'sha256:<HASH>'.
 	// +optional
 	Digest *string `json:"digest,omitempty" protobuf:"bytes,4,opt,name=digest"`
+	// PullSecretRef is a reference to a secret containing the credentials for pulling the artifact. The secret must
+	// either be of type `kubernetes.io/dockerconfigjson` or contain the keys `username` and `password`, and it must be
+	// located in the `garden` namespace of the cluster pulling the artifact.
+	// +optional
+	PullSecretRef *corev1.LocalObjectReference `json:"pullSecretRef,omitempty" protobuf:"bytes,5,opt,name=pullSecretRef"`
+	// Verification configures the verification of the artifact's signature before it is used.
+	// +optional
+	Verification *OCIVerification `json:"verification,omitempty" protobuf:"bytes,6,opt,name=verification"`
 }
 
 // GetURL returns the fully-qualified OCIRepository URL of the artifact.
@@ -82,3 +91,11 @@ func (o *OCIRepository) GetURL() string {
 	}
 	return strings.TrimPrefix(ref, "oci://")
 }
+
+// OCIVerification configures the verification of signatures of OCI artifacts.
+type OCIVerification struct {
+	// PublicKeysSecretRef is a reference to a secret containing PEM-encoded public keys in its data. The artifact must be
+	// signed with cosign using a private key belonging to at least one of them. The secret must be located in the
+	// `garden` namespace of the cluster pulling the artifact.
+	PublicKeysSecretRef corev1.LocalObjectReference `json:"publicKeysSecretRef" protobuf:"bytes,1,opt,name=publicKeysSecretRef"`
+}
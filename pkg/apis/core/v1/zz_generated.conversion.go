@@ -13,6 +13,7 @@ import (
 	unsafe "unsafe"
 
 	core "github.com/gardener/gardener/pkg/apis/core"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -55,6 +56,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OCIVerification)(nil), (*core.OCIVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_OCIVerification_To_core_OCIVerification(a.(*OCIVerification), b.(*core.OCIVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.OCIVerification)(nil), (*OCIVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_OCIVerification_To_v1_OCIVerification(a.(*core.OCIVerification), b.(*OCIVerification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*core.ControllerDeployment)(nil), (*ControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_core_ControllerDeployment_To_v1_ControllerDeployment(a.(*core.ControllerDeployment), b.(*ControllerDeployment), scope)
 	}); err != nil {
@@ -153,6 +164,8 @@ func autoConvert_v1_OCIRepository_To_core_OCIRepository(in *OCIRepository, out *
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
+	out.PullSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
+	out.Verification = (*core.OCIVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -166,6 +179,8 @@ func autoConvert_core_OCIRepository_To_v1_OCIRepository(in *core.OCIRepository,
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
+	out.PullSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
+	out.Verification = (*OCIVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -173,3 +188,23 @@ func autoConvert_core_OCIRepository_To_v1_OCIRepository(in *core.OCIRepository,
 func Convert_core_OCIRepository_To_v1_OCIRepository(in *core.OCIRepository, out *OCIRepository, s conversion.Scope) error {
 	return autoConvert_core_OCIRepository_To_v1_OCIRepository(in, out, s)
 }
+
+func autoConvert_v1_OCIVerification_To_core_OCIVerification(in *OCIVerification, out *core.OCIVerification, s conversion.Scope) error {
+	out.PublicKeysSecretRef = in.PublicKeysSecretRef
+	return nil
+}
+
+// Convert_v1_OCIVerification_To_core_OCIVerification is an autogenerated conversion function.
+func Convert_v1_OCIVerification_To_core_OCIVerification(in *OCIVerification, out *core.OCIVerification, s conversion.Scope) error {
+	return autoConvert_v1_OCIVerification_To_core_OCIVerification(in, out, s)
+}
+
+func autoConvert_core_OCIVerification_To_v1_OCIVerification(in *core.OCIVerification, out *OCIVerification, s conversion.Scope) error {
+	out.PublicKeysSecretRef = in.PublicKeysSecretRef
+	return nil
+}
+
+// Convert_core_OCIVerification_To_v1_OCIVerification is an autogenerated conversion function.
+func Convert_core_OCIVerification_To_v1_OCIVerification(in *core.OCIVerification, out *OCIVerification, s conversion.Scope) error {
+	return autoConvert_core_OCIVerification_To_v1_OCIVerification(in, out, s)
+}
@@ -123,6 +123,9 @@ const (
 	// DeploymentNameGardenlet is a constant for the name of a Kubernetes deployment object that contains
 	// the Gardenlet pod.
 	DeploymentNameGardenlet = "gardenlet"
+	// GardenletWakeUpProxyPort is the port on which the wake-up proxy of gardenlet serves. The istio ingress gateways
+	// are allowed to send requests to this port of gardenlet.
+	GardenletWakeUpProxyPort = 2730
 	// DeploymentNameGardenerOperator is a constant for the name of a Kubernetes deployment object that contains
 	// the gardener-operator pod.
 	DeploymentNameGardenerOperator = "gardener-operator"
@@ -211,6 +214,9 @@ const (
 	// GardenerTimestamp is a constant for an annotation on a resource that describes the timestamp when a reconciliation has been requested.
 	// It is only used to guarantee an update event for watching clients in case the operation-annotation is already present.
 	GardenerTimestamp = "gardener.cloud/timestamp"
+	// AnnotationShootStateRestoredFromRevision is a constant for an annotation on a ShootState which contains the name
+	// of the revision from which its secrets and extension states were restored last.
+	AnnotationShootStateRestoredFromRevision = "shootstate.gardener.cloud/restored-from-revision"
 	// GardenerOperationMigrate is a constant for the value of the operation annotation describing a migration
 	// operation.
 	GardenerOperationMigrate = "migrate"
@@ -408,6 +414,14 @@ const (
 	// (comma-separated) when the certificate authorities or service account signing key credentials rotation is in
 	// WaitingForWorkersRollout phase.
 	OperationRotateRolloutWorkers = "rotate-rollout-workers"
+	// ShootOperationRestoreShootStateRevision is a constant for an annotation on a Shoot triggering the restoration of
+	// the secrets and extension states in its ShootState from the given revision (`restore-shootstate-revision=<name>`)
+	// when the control plane is restored on a seed.
+	ShootOperationRestoreShootStateRevision = "restore-shootstate-revision"
+	// ShootStateRevisionNameInfix separates the name of the shoot and the unix timestamp in the names of ShootState
+	// revisions (`<shoot-name>--rev-<unix-timestamp>`). Shoot names must not contain consecutive hyphens, hence revisions
+	// cannot clash with the ShootStates of other shoots.
+	ShootStateRevisionNameInfix = "--rev-"
 	// SeedOperationRenewGardenAccessSecrets is a constant for an annotation on a Seed indicating that
 	// all garden access secrets on the seed shall be renewed.
 	SeedOperationRenewGardenAccessSecrets = "renew-garden-access-secrets" // #nosec G101 -- No credential.
@@ -433,6 +447,10 @@ const (
 	LabelSeedProvider = "seed.gardener.cloud/provider"
 	// LabelShootProvider is used to identify the shoot provider.
 	LabelShootProvider = "shoot.gardener.cloud/provider"
+	// LabelShootSelfHosted is used to identify self-hosted shoots, i.e., autonomous shoot clusters whose control plane
+	// runs on dedicated worker nodes of the shoot cluster itself instead of a seed cluster. Such shoots are never
+	// scheduled to a seed.
+	LabelShootSelfHosted = "shoot.gardener.cloud/self-hosted"
 	// LabelShootProviderPrefix is used to prefix label that indicates the provider type.
 	// The label key is in the form provider.shoot.gardener.cloud/<type>.
 	LabelShootProviderPrefix = "provider.shoot.gardener.cloud/"
@@ -695,6 +713,13 @@ const (
 	// Note that changing this value only applies to new nodes. Existing nodes which already computed their individual
 	// delays will not recompute it.
 	AnnotationShootCloudConfigExecutionMaxDelaySeconds = "shoot.gardener.cloud/cloud-config-execution-max-delay-seconds"
+	// AnnotationShootWakeUpOnDemandUnauthenticated is a key for an annotation on a Shoot resource whose value must be
+	// set to "true" in order to allow waking up the hibernated shoot on demand with requests which do not authenticate
+	// with a client certificate but carry the 'X-Gardener-Wake-Up: true' header.
+	AnnotationShootWakeUpOnDemandUnauthenticated = "shoot.gardener.cloud/wake-up-on-demand-unauthenticated"
+	// AnnotationShootLastWakeUpOnDemand is a key for an annotation on a Shoot resource containing the time (RFC3339) of
+	// its last wake-up on demand. It is maintained by gardenlet for rate-limiting the wake-ups on demand.
+	AnnotationShootLastWakeUpOnDemand = "shoot.gardener.cloud/last-wake-up-on-demand"
 
 	// AnnotationAuthenticationIssuer is the key for an annotation applied to a Shoot which specifies
 	// if the shoot's issuer is managed by Gardener.
@@ -5,6 +5,9 @@
 package v1beta1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -50,3 +53,14 @@ func SetDefaults_VolumeType(obj *VolumeType) {
 		obj.Usable = ptr.To(true)
 	}
 }
+
+// SetDefaults_VersionRolloutStrategy sets default values for VersionRolloutStrategy objects.
+func SetDefaults_VersionRolloutStrategy(obj *VersionRolloutStrategy) {
+	if obj.SoakDuration == nil {
+		obj.SoakDuration = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+
+	if obj.MaxUnhealthyPercentage == nil {
+		obj.MaxUnhealthyPercentage = ptr.To[int32](20)
+	}
+}
@@ -5,9 +5,13 @@
 package v1beta1_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
@@ -68,4 +72,27 @@ var _ = Describe("CloudProfile defaulting", func() {
 			Expect(volumeType.Usable).To(PointTo(BeTrue()))
 		})
 	})
+
+	Describe("VersionRolloutStrategy defaulting", func() {
+		It("should correctly default VersionRolloutStrategy", func() {
+			obj.Spec.VersionRolloutStrategy = &VersionRolloutStrategy{}
+
+			SetObjectDefaults_CloudProfile(obj)
+
+			Expect(obj.Spec.VersionRolloutStrategy.SoakDuration).To(PointTo(Equal(metav1.Duration{Duration: 24 * time.Hour})))
+			Expect(obj.Spec.VersionRolloutStrategy.MaxUnhealthyPercentage).To(PointTo(Equal(int32(20))))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.Spec.VersionRolloutStrategy = &VersionRolloutStrategy{
+				SoakDuration:           &metav1.Duration{Duration: time.Hour},
+				MaxUnhealthyPercentage: ptr.To[int32](0),
+			}
+
+			SetObjectDefaults_CloudProfile(obj)
+
+			Expect(obj.Spec.VersionRolloutStrategy.SoakDuration).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.Spec.VersionRolloutStrategy.MaxUnhealthyPercentage).To(PointTo(Equal(int32(0))))
+		})
+	})
 })
@@ -2871,10 +2871,122 @@ func (m *MaintenanceAutoUpdate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MaintenanceAutoUpdate proto.InternalMessageInfo
 
+func (m *MaintenanceFreeze) Reset()      { *m = MaintenanceFreeze{} }
+func (*MaintenanceFreeze) ProtoMessage() {}
+func (*MaintenanceFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+}
+func (m *MaintenanceFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MaintenanceFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceFreeze.Merge(m, src)
+}
+func (m *MaintenanceFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceFreeze proto.InternalMessageInfo
+
+func (m *MaintenanceFreezeList) Reset()      { *m = MaintenanceFreezeList{} }
+func (*MaintenanceFreezeList) ProtoMessage() {}
+func (*MaintenanceFreezeList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
+}
+func (m *MaintenanceFreezeList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceFreezeList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MaintenanceFreezeList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceFreezeList.Merge(m, src)
+}
+func (m *MaintenanceFreezeList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceFreezeList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceFreezeList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceFreezeList proto.InternalMessageInfo
+
+func (m *MaintenanceFreezeSpec) Reset()      { *m = MaintenanceFreezeSpec{} }
+func (*MaintenanceFreezeSpec) ProtoMessage() {}
+func (*MaintenanceFreezeSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
+}
+func (m *MaintenanceFreezeSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceFreezeSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MaintenanceFreezeSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceFreezeSpec.Merge(m, src)
+}
+func (m *MaintenanceFreezeSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceFreezeSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceFreezeSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceFreezeSpec proto.InternalMessageInfo
+
+func (m *MaintenanceFreezeWindow) Reset()      { *m = MaintenanceFreezeWindow{} }
+func (*MaintenanceFreezeWindow) ProtoMessage() {}
+func (*MaintenanceFreezeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+}
+func (m *MaintenanceFreezeWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceFreezeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MaintenanceFreezeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceFreezeWindow.Merge(m, src)
+}
+func (m *MaintenanceFreezeWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceFreezeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceFreezeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceFreezeWindow proto.InternalMessageInfo
+
 func (m *MaintenanceTimeWindow) Reset()      { *m = MaintenanceTimeWindow{} }
 func (*MaintenanceTimeWindow) ProtoMessage() {}
 func (*MaintenanceTimeWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
 }
 func (m *MaintenanceTimeWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2902,7 +3014,7 @@ var xxx_messageInfo_MaintenanceTimeWindow proto.InternalMessageInfo
 func (m *MemorySwapConfiguration) Reset()      { *m = MemorySwapConfiguration{} }
 func (*MemorySwapConfiguration) ProtoMessage() {}
 func (*MemorySwapConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
 }
 func (m *MemorySwapConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2930,7 +3042,7 @@ var xxx_messageInfo_MemorySwapConfiguration proto.InternalMessageInfo
 func (m *Monitoring) Reset()      { *m = Monitoring{} }
 func (*Monitoring) ProtoMessage() {}
 func (*Monitoring) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
 }
 func (m *Monitoring) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2958,7 +3070,7 @@ var xxx_messageInfo_Monitoring proto.InternalMessageInfo
 func (m *NamedResourceReference) Reset()      { *m = NamedResourceReference{} }
 func (*NamedResourceReference) ProtoMessage() {}
 func (*NamedResourceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
 }
 func (m *NamedResourceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2986,7 +3098,7 @@ var xxx_messageInfo_NamedResourceReference proto.InternalMessageInfo
 func (m *NamespacedCloudProfile) Reset()      { *m = NamespacedCloudProfile{} }
 func (*NamespacedCloudProfile) ProtoMessage() {}
 func (*NamespacedCloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
 }
 func (m *NamespacedCloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3014,7 +3126,7 @@ var xxx_messageInfo_NamespacedCloudProfile proto.InternalMessageInfo
 func (m *NamespacedCloudProfileList) Reset()      { *m = NamespacedCloudProfileList{} }
 func (*NamespacedCloudProfileList) ProtoMessage() {}
 func (*NamespacedCloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
 }
 func (m *NamespacedCloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3042,7 +3154,7 @@ var xxx_messageInfo_NamespacedCloudProfileList proto.InternalMessageInfo
 func (m *NamespacedCloudProfileSpec) Reset()      { *m = NamespacedCloudProfileSpec{} }
 func (*NamespacedCloudProfileSpec) ProtoMessage() {}
 func (*NamespacedCloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
 }
 func (m *NamespacedCloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3070,7 +3182,7 @@ var xxx_messageInfo_NamespacedCloudProfileSpec proto.InternalMessageInfo
 func (m *NamespacedCloudProfileStatus) Reset()      { *m = NamespacedCloudProfileStatus{} }
 func (*NamespacedCloudProfileStatus) ProtoMessage() {}
 func (*NamespacedCloudProfileStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
 }
 func (m *NamespacedCloudProfileStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3098,7 +3210,7 @@ var xxx_messageInfo_NamespacedCloudProfileStatus proto.InternalMessageInfo
 func (m *Networking) Reset()      { *m = Networking{} }
 func (*Networking) ProtoMessage() {}
 func (*Networking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
 }
 func (m *Networking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3126,7 +3238,7 @@ var xxx_messageInfo_Networking proto.InternalMessageInfo
 func (m *NetworkingStatus) Reset()      { *m = NetworkingStatus{} }
 func (*NetworkingStatus) ProtoMessage() {}
 func (*NetworkingStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
 }
 func (m *NetworkingStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3154,7 +3266,7 @@ var xxx_messageInfo_NetworkingStatus proto.InternalMessageInfo
 func (m *NginxIngress) Reset()      { *m = NginxIngress{} }
 func (*NginxIngress) ProtoMessage() {}
 func (*NginxIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
 }
 func (m *NginxIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3182,7 +3294,7 @@ var xxx_messageInfo_NginxIngress proto.InternalMessageInfo
 func (m *NodeLocalDNS) Reset()      { *m = NodeLocalDNS{} }
 func (*NodeLocalDNS) ProtoMessage() {}
 func (*NodeLocalDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
 }
 func (m *NodeLocalDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3210,7 +3322,7 @@ var xxx_messageInfo_NodeLocalDNS proto.InternalMessageInfo
 func (m *OCIRepository) Reset()      { *m = OCIRepository{} }
 func (*OCIRepository) ProtoMessage() {}
 func (*OCIRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
 }
 func (m *OCIRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3235,10 +3347,38 @@ func (m *OCIRepository) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OCIRepository proto.InternalMessageInfo
 
+func (m *OCIVerification) Reset()      { *m = OCIVerification{} }
+func (*OCIVerification) ProtoMessage() {}
+func (*OCIVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+}
+func (m *OCIVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCIVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OCIVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIVerification.Merge(m, src)
+}
+func (m *OCIVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIVerification proto.InternalMessageInfo
+
 func (m *OIDCConfig) Reset()      { *m = OIDCConfig{} }
 func (*OIDCConfig) ProtoMessage() {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3266,7 +3406,7 @@ var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
 func (m *ObservabilityRotation) Reset()      { *m = ObservabilityRotation{} }
 func (*ObservabilityRotation) ProtoMessage() {}
 func (*ObservabilityRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
 }
 func (m *ObservabilityRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3294,7 +3434,7 @@ var xxx_messageInfo_ObservabilityRotation proto.InternalMessageInfo
 func (m *OpenIDConnectClientAuthentication) Reset()      { *m = OpenIDConnectClientAuthentication{} }
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
 func (*OpenIDConnectClientAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
 }
 func (m *OpenIDConnectClientAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3322,7 +3462,7 @@ var xxx_messageInfo_OpenIDConnectClientAuthentication proto.InternalMessageInfo
 func (m *PendingWorkersRollout) Reset()      { *m = PendingWorkersRollout{} }
 func (*PendingWorkersRollout) ProtoMessage() {}
 func (*PendingWorkersRollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
 }
 func (m *PendingWorkersRollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3350,7 +3490,7 @@ var xxx_messageInfo_PendingWorkersRollout proto.InternalMessageInfo
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3375,10 +3515,38 @@ func (m *Project) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Project proto.InternalMessageInfo
 
+func (m *ProjectControlPlaneUsage) Reset()      { *m = ProjectControlPlaneUsage{} }
+func (*ProjectControlPlaneUsage) ProtoMessage() {}
+func (*ProjectControlPlaneUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
+}
+func (m *ProjectControlPlaneUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectControlPlaneUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectControlPlaneUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectControlPlaneUsage.Merge(m, src)
+}
+func (m *ProjectControlPlaneUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectControlPlaneUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectControlPlaneUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectControlPlaneUsage proto.InternalMessageInfo
+
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3403,10 +3571,38 @@ func (m *ProjectList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 
+func (m *ProjectMachineUsage) Reset()      { *m = ProjectMachineUsage{} }
+func (*ProjectMachineUsage) ProtoMessage() {}
+func (*ProjectMachineUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+}
+func (m *ProjectMachineUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectMachineUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectMachineUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectMachineUsage.Merge(m, src)
+}
+func (m *ProjectMachineUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectMachineUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectMachineUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectMachineUsage proto.InternalMessageInfo
+
 func (m *ProjectMember) Reset()      { *m = ProjectMember{} }
 func (*ProjectMember) ProtoMessage() {}
 func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
 }
 func (m *ProjectMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3431,10 +3627,38 @@ func (m *ProjectMember) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProjectMember proto.InternalMessageInfo
 
+func (m *ProjectShootUsage) Reset()      { *m = ProjectShootUsage{} }
+func (*ProjectShootUsage) ProtoMessage() {}
+func (*ProjectShootUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+}
+func (m *ProjectShootUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectShootUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectShootUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectShootUsage.Merge(m, src)
+}
+func (m *ProjectShootUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectShootUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectShootUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectShootUsage proto.InternalMessageInfo
+
 func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage() {}
 func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
 }
 func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3462,7 +3686,7 @@ var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3487,10 +3711,38 @@ func (m *ProjectStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 
+func (m *ProjectStorageUsage) Reset()      { *m = ProjectStorageUsage{} }
+func (*ProjectStorageUsage) ProtoMessage() {}
+func (*ProjectStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
+}
+func (m *ProjectStorageUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectStorageUsage.Merge(m, src)
+}
+func (m *ProjectStorageUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectStorageUsage proto.InternalMessageInfo
+
 func (m *ProjectTolerations) Reset()      { *m = ProjectTolerations{} }
 func (*ProjectTolerations) ProtoMessage() {}
 func (*ProjectTolerations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
 }
 func (m *ProjectTolerations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3515,10 +3767,38 @@ func (m *ProjectTolerations) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProjectTolerations proto.InternalMessageInfo
 
+func (m *ProjectUsage) Reset()      { *m = ProjectUsage{} }
+func (*ProjectUsage) ProtoMessage() {}
+func (*ProjectUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+}
+func (m *ProjectUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectUsage.Merge(m, src)
+}
+func (m *ProjectUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectUsage proto.InternalMessageInfo
+
 func (m *Provider) Reset()      { *m = Provider{} }
 func (*Provider) ProtoMessage() {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3546,7 +3826,7 @@ var xxx_messageInfo_Provider proto.InternalMessageInfo
 func (m *Quota) Reset()      { *m = Quota{} }
 func (*Quota) ProtoMessage() {}
 func (*Quota) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
 }
 func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3574,7 +3854,7 @@ var xxx_messageInfo_Quota proto.InternalMessageInfo
 func (m *QuotaList) Reset()      { *m = QuotaList{} }
 func (*QuotaList) ProtoMessage() {}
 func (*QuotaList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
 }
 func (m *QuotaList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3602,7 +3882,7 @@ var xxx_messageInfo_QuotaList proto.InternalMessageInfo
 func (m *QuotaSpec) Reset()      { *m = QuotaSpec{} }
 func (*QuotaSpec) ProtoMessage() {}
 func (*QuotaSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
 }
 func (m *QuotaSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3627,10 +3907,38 @@ func (m *QuotaSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 
+func (m *QuotaStatus) Reset()      { *m = QuotaStatus{} }
+func (*QuotaStatus) ProtoMessage() {}
+func (*QuotaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+}
+func (m *QuotaStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaStatus.Merge(m, src)
+}
+func (m *QuotaStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaStatus proto.InternalMessageInfo
+
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3658,7 +3966,7 @@ var xxx_messageInfo_Region proto.InternalMessageInfo
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3686,7 +3994,7 @@ var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3714,7 +4022,7 @@ var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3742,7 +4050,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3770,7 +4078,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3798,7 +4106,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3826,7 +4134,7 @@ var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3854,7 +4162,7 @@ var xxx_messageInfo_Seed proto.InternalMessageInfo
 func (m *SeedBackup) Reset()      { *m = SeedBackup{} }
 func (*SeedBackup) ProtoMessage() {}
 func (*SeedBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
 }
 func (m *SeedBackup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3882,7 +4190,7 @@ var xxx_messageInfo_SeedBackup proto.InternalMessageInfo
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3910,7 +4218,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3938,7 +4246,7 @@ var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3966,7 +4274,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3994,7 +4302,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4022,7 +4330,7 @@ var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4050,7 +4358,7 @@ var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4078,7 +4386,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4106,7 +4414,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4134,7 +4442,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4164,7 +4472,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4192,7 +4500,7 @@ var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMes
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4220,7 +4528,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServices proto.InternalMessageInfo
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4248,7 +4556,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4276,7 +4584,7 @@ var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4304,7 +4612,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4332,7 +4640,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4360,7 +4668,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4388,7 +4696,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4416,7 +4724,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4444,7 +4752,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4472,7 +4780,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4500,7 +4808,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4528,7 +4836,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4556,7 +4864,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4584,7 +4892,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4612,7 +4920,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4640,7 +4948,7 @@ var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4668,7 +4976,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4696,7 +5004,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4724,7 +5032,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4752,7 +5060,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4780,7 +5088,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4808,7 +5116,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4836,7 +5144,7 @@ var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4864,7 +5172,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4892,7 +5200,7 @@ var xxx_messageInfo_ShootState proto.InternalMessageInfo
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4920,7 +5228,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4948,7 +5256,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4976,7 +5284,7 @@ var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5004,7 +5312,7 @@ var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 func (m *StructuredAuthentication) Reset()      { *m = StructuredAuthentication{} }
 func (*StructuredAuthentication) ProtoMessage() {}
 func (*StructuredAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
 }
 func (m *StructuredAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5032,7 +5340,7 @@ var xxx_messageInfo_StructuredAuthentication proto.InternalMessageInfo
 func (m *StructuredAuthorization) Reset()      { *m = StructuredAuthorization{} }
 func (*StructuredAuthorization) ProtoMessage() {}
 func (*StructuredAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
 }
 func (m *StructuredAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5060,7 +5368,7 @@ var xxx_messageInfo_StructuredAuthorization proto.InternalMessageInfo
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5088,7 +5396,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5113,10 +5421,66 @@ func (m *Toleration) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Toleration proto.InternalMessageInfo
 
+func (m *VersionRolloutStrategy) Reset()      { *m = VersionRolloutStrategy{} }
+func (*VersionRolloutStrategy) ProtoMessage() {}
+func (*VersionRolloutStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
+}
+func (m *VersionRolloutStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionRolloutStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VersionRolloutStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionRolloutStrategy.Merge(m, src)
+}
+func (m *VersionRolloutStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionRolloutStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionRolloutStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionRolloutStrategy proto.InternalMessageInfo
+
+func (m *VersionRolloutWave) Reset()      { *m = VersionRolloutWave{} }
+func (*VersionRolloutWave) ProtoMessage() {}
+func (*VersionRolloutWave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
+}
+func (m *VersionRolloutWave) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionRolloutWave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VersionRolloutWave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionRolloutWave.Merge(m, src)
+}
+func (m *VersionRolloutWave) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionRolloutWave) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionRolloutWave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionRolloutWave proto.InternalMessageInfo
+
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5144,7 +5508,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5172,7 +5536,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5200,7 +5564,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5228,7 +5592,7 @@ var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5256,7 +5620,7 @@ var xxx_messageInfo_Worker proto.InternalMessageInfo
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5281,10 +5645,38 @@ func (m *WorkerKubernetes) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 
+func (m *WorkerPoolStatus) Reset()      { *m = WorkerPoolStatus{} }
+func (*WorkerPoolStatus) ProtoMessage() {}
+func (*WorkerPoolStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
+}
+func (m *WorkerPoolStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerPoolStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkerPoolStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerPoolStatus.Merge(m, src)
+}
+func (m *WorkerPoolStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerPoolStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerPoolStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerPoolStatus proto.InternalMessageInfo
+
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{201}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5312,7 +5704,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{202}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5445,6 +5837,10 @@ func init() {
 	proto.RegisterType((*MachineTypeStorage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineTypeStorage")
 	proto.RegisterType((*Maintenance)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Maintenance")
 	proto.RegisterType((*MaintenanceAutoUpdate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceAutoUpdate")
+	proto.RegisterType((*MaintenanceFreeze)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreeze")
+	proto.RegisterType((*MaintenanceFreezeList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreezeList")
+	proto.RegisterType((*MaintenanceFreezeSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreezeSpec")
+	proto.RegisterType((*MaintenanceFreezeWindow)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreezeWindow")
 	proto.RegisterType((*MaintenanceTimeWindow)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceTimeWindow")
 	proto.RegisterType((*MemorySwapConfiguration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MemorySwapConfiguration")
 	proto.RegisterType((*Monitoring)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Monitoring")
@@ -5459,6 +5855,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NginxIngress.ConfigEntry")
 	proto.RegisterType((*NodeLocalDNS)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NodeLocalDNS")
 	proto.RegisterType((*OCIRepository)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OCIRepository")
+	proto.RegisterType((*OCIVerification)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OCIVerification")
 	proto.RegisterType((*OIDCConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OIDCConfig")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OIDCConfig.RequiredClaimsEntry")
 	proto.RegisterType((*ObservabilityRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ObservabilityRotation")
@@ -5466,16 +5863,23 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OpenIDConnectClientAuthentication.ExtraConfigEntry")
 	proto.RegisterType((*PendingWorkersRollout)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.PendingWorkersRollout")
 	proto.RegisterType((*Project)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Project")
+	proto.RegisterType((*ProjectControlPlaneUsage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectControlPlaneUsage")
 	proto.RegisterType((*ProjectList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectList")
+	proto.RegisterType((*ProjectMachineUsage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectMachineUsage")
 	proto.RegisterType((*ProjectMember)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectMember")
+	proto.RegisterType((*ProjectShootUsage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectShootUsage")
 	proto.RegisterType((*ProjectSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectSpec")
 	proto.RegisterType((*ProjectStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectStatus")
+	proto.RegisterType((*ProjectStorageUsage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectStorageUsage")
 	proto.RegisterType((*ProjectTolerations)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectTolerations")
+	proto.RegisterType((*ProjectUsage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectUsage")
 	proto.RegisterType((*Provider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Provider")
 	proto.RegisterType((*Quota)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Quota")
 	proto.RegisterType((*QuotaList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaList")
 	proto.RegisterType((*QuotaSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec.MetricsEntry")
+	proto.RegisterType((*QuotaStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus.UsedEntry")
 	proto.RegisterType((*Region)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region.LabelsEntry")
 	proto.RegisterType((*ResourceData)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceData")
@@ -5536,6 +5940,8 @@ func init() {
 	proto.RegisterType((*StructuredAuthorization)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.StructuredAuthorization")
 	proto.RegisterType((*SystemComponents)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SystemComponents")
 	proto.RegisterType((*Toleration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Toleration")
+	proto.RegisterType((*VersionRolloutStrategy)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VersionRolloutStrategy")
+	proto.RegisterType((*VersionRolloutWave)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VersionRolloutWave")
 	proto.RegisterType((*VerticalPodAutoscaler)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VerticalPodAutoscaler")
 	proto.RegisterType((*Volume)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Volume")
 	proto.RegisterType((*VolumeType)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VolumeType")
@@ -5545,6 +5951,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.SysctlsEntry")
 	proto.RegisterType((*WorkerKubernetes)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerKubernetes")
+	proto.RegisterType((*WorkerPoolStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerPoolStatus")
 	proto.RegisterType((*WorkerSystemComponents)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerSystemComponents")
 	proto.RegisterType((*WorkersSettings)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkersSettings")
 }
@@ -5554,850 +5961,905 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 13485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x70, 0x25, 0xd9,
-	0x55, 0x18, 0xee, 0x7e, 0xfa, 0x3e, 0xfa, 0x18, 0xe9, 0xce, 0x97, 0x56, 0xbb, 0x3b, 0x6f, 0xdc,
-	0xbb, 0xeb, 0xdf, 0x2e, 0x6b, 0x6b, 0xd8, 0x65, 0xed, 0xf5, 0xce, 0xb2, 0xbb, 0x96, 0x9e, 0x34,
-	0x33, 0xcf, 0x23, 0x69, 0xe4, 0xf3, 0x34, 0xbb, 0xcb, 0x02, 0x0b, 0xad, 0xf7, 0xae, 0x9e, 0x7a,
-	0xa7, 0x5f, 0xf7, 0xdb, 0xee, 0x7e, 0x1a, 0x69, 0xd6, 0xfe, 0x19, 0xfb, 0xc7, 0x87, 0x6d, 0x30,
-	0x05, 0xfc, 0x48, 0x5c, 0xb6, 0xa1, 0x30, 0xa1, 0x80, 0x24, 0x24, 0x4e, 0x8a, 0x14, 0xa9, 0x02,
-	0x92, 0xaa, 0x84, 0x54, 0x82, 0x4d, 0x01, 0x45, 0x01, 0xa9, 0x98, 0x4a, 0x10, 0xb1, 0x42, 0x20,
-	0x55, 0x49, 0x51, 0xa9, 0x50, 0x09, 0xc5, 0x84, 0x82, 0xd4, 0xfd, 0xe8, 0xee, 0xdb, 0x5f, 0x4f,
-	0x4f, 0xfd, 0x24, 0xd9, 0x1b, 0xf8, 0x4b, 0x7a, 0xf7, 0xdc, 0x7b, 0xce, 0xfd, 0xea, 0x73, 0xcf,
-	0x39, 0xf7, 0xdc, 0x73, 0x60, 0xb1, 0x69, 0xfa, 0xdb, 0x9d, 0xcd, 0xf9, 0xba, 0xd3, 0xba, 0xd2,
-	0x34, 0xdc, 0x06, 0xb5, 0xa9, 0x1b, 0xfd, 0xd3, 0xbe, 0xd3, 0xbc, 0x62, 0xb4, 0x4d, 0xef, 0x4a,
-	0xdd, 0x71, 0xe9, 0x95, 0x9d, 0xa7, 0x36, 0xa9, 0x6f, 0x3c, 0x75, 0xa5, 0xc9, 0x60, 0x86, 0x4f,
-	0x1b, 0xf3, 0x6d, 0xd7, 0xf1, 0x1d, 0xf2, 0x74, 0x84, 0x63, 0x3e, 0x68, 0x1a, 0xfd, 0xd3, 0xbe,
-	0xd3, 0x9c, 0x67, 0x38, 0xe6, 0x19, 0x8e, 0x79, 0x89, 0x63, 0xee, 0x3d, 0x2a, 0x5d, 0xa7, 0xe9,
-	0x5c, 0xe1, 0xa8, 0x36, 0x3b, 0x5b, 0xfc, 0x17, 0xff, 0xc1, 0xff, 0x13, 0x24, 0xe6, 0x9e, 0xb8,
-	0xf3, 0x7e, 0x6f, 0xde, 0x74, 0x58, 0x67, 0xae, 0x18, 0x1d, 0xdf, 0xf1, 0xea, 0x86, 0x65, 0xda,
-	0xcd, 0x2b, 0x3b, 0xa9, 0xde, 0xcc, 0xe9, 0x4a, 0x55, 0xd9, 0xed, 0xae, 0x75, 0xdc, 0x4d, 0xa3,
-	0x9e, 0x55, 0xe7, 0x46, 0x54, 0x87, 0xee, 0xfa, 0xd4, 0xf6, 0x4c, 0xc7, 0xf6, 0xde, 0xc3, 0x46,
-	0x42, 0xdd, 0x1d, 0x75, 0x6e, 0x62, 0x15, 0xb2, 0x30, 0x3d, 0x13, 0x61, 0x6a, 0x19, 0xf5, 0x6d,
-	0xd3, 0xa6, 0xee, 0x5e, 0xd0, 0xfc, 0x8a, 0x4b, 0x3d, 0xa7, 0xe3, 0xd6, 0xe9, 0x91, 0x5a, 0x79,
-	0x57, 0x5a, 0xd4, 0x37, 0xb2, 0x68, 0x5d, 0xc9, 0x6b, 0xe5, 0x76, 0x6c, 0xdf, 0x6c, 0xa5, 0xc9,
-	0xbc, 0xef, 0xb0, 0x06, 0x5e, 0x7d, 0x9b, 0xb6, 0x8c, 0x54, 0xbb, 0x6f, 0xca, 0x6b, 0xd7, 0xf1,
-	0x4d, 0xeb, 0x8a, 0x69, 0xfb, 0x9e, 0xef, 0x26, 0x1b, 0xe9, 0x9f, 0xd2, 0x60, 0x7a, 0x61, 0xbd,
-	0x5a, 0xe3, 0x33, 0xb8, 0xe2, 0x34, 0x9b, 0xa6, 0xdd, 0x24, 0x4f, 0xc2, 0xd8, 0x0e, 0x75, 0x37,
-	0x1d, 0xcf, 0xf4, 0xf7, 0x66, 0xb5, 0xcb, 0xda, 0xe3, 0x43, 0x8b, 0x93, 0x07, 0xfb, 0xe5, 0xb1,
-	0x97, 0x83, 0x42, 0x8c, 0xe0, 0xa4, 0x0a, 0x67, 0xb7, 0x7d, 0xbf, 0xbd, 0x50, 0xaf, 0x53, 0xcf,
-	0x0b, 0x6b, 0xcc, 0x96, 0x78, 0xb3, 0x8b, 0x07, 0xfb, 0xe5, 0xb3, 0x37, 0x36, 0x36, 0xd6, 0x13,
-	0x60, 0xcc, 0x6a, 0xa3, 0xff, 0xbc, 0x06, 0x33, 0x61, 0x67, 0x90, 0xbe, 0xd9, 0xa1, 0x9e, 0xef,
-	0x11, 0x84, 0x0b, 0x2d, 0x63, 0x77, 0xcd, 0xb1, 0x57, 0x3b, 0xbe, 0xe1, 0x9b, 0x76, 0xb3, 0x6a,
-	0x6f, 0x59, 0x66, 0x73, 0xdb, 0x97, 0x5d, 0x9b, 0x3b, 0xd8, 0x2f, 0x5f, 0x58, 0xcd, 0xac, 0x81,
-	0x39, 0x2d, 0x59, 0xa7, 0x5b, 0xc6, 0x6e, 0x0a, 0xa1, 0xd2, 0xe9, 0xd5, 0x34, 0x18, 0xb3, 0xda,
-	0xe8, 0xef, 0x85, 0x19, 0x31, 0x0e, 0xa4, 0x9e, 0xef, 0x9a, 0x75, 0xdf, 0x74, 0x6c, 0x72, 0x19,
-	0x06, 0x6d, 0xa3, 0x45, 0x79, 0x0f, 0xc7, 0x16, 0x27, 0xbe, 0xb4, 0x5f, 0x7e, 0xc7, 0xc1, 0x7e,
-	0x79, 0x70, 0xcd, 0x68, 0x51, 0xe4, 0x10, 0xfd, 0x7f, 0x95, 0xe0, 0xa1, 0x54, 0xbb, 0x57, 0x4c,
-	0x7f, 0xfb, 0x56, 0x9b, 0xfd, 0xe7, 0x91, 0x1f, 0xd4, 0x60, 0xc6, 0x48, 0x56, 0xe0, 0x08, 0xc7,
-	0x9f, 0x5e, 0x9e, 0x3f, 0xfa, 0x07, 0x3e, 0x9f, 0xa2, 0xb6, 0xf8, 0x80, 0xec, 0x57, 0x7a, 0x00,
-	0x98, 0x26, 0x4d, 0x3e, 0xa1, 0xc1, 0x88, 0x23, 0x3a, 0x37, 0x5b, 0xba, 0x3c, 0xf0, 0xf8, 0xf8,
-	0xd3, 0xdf, 0x7e, 0x2c, 0xdd, 0x50, 0x06, 0x3d, 0x2f, 0xff, 0x2e, 0xdb, 0xbe, 0xbb, 0xb7, 0x78,
-	0x46, 0x76, 0x6f, 0x44, 0x96, 0x62, 0x40, 0x7e, 0xee, 0x2a, 0x4c, 0xa8, 0x35, 0xc9, 0x34, 0x0c,
-	0xdc, 0xa1, 0x62, 0xab, 0x8e, 0x21, 0xfb, 0x97, 0x9c, 0x83, 0xa1, 0x1d, 0xc3, 0xea, 0x50, 0xbe,
-	0xa4, 0x63, 0x28, 0x7e, 0x5c, 0x2d, 0xbd, 0x5f, 0xd3, 0x9f, 0x86, 0xa1, 0x85, 0x46, 0xc3, 0xb1,
-	0xc9, 0x13, 0x30, 0x42, 0x6d, 0x63, 0xd3, 0xa2, 0x0d, 0xde, 0x70, 0x34, 0xa2, 0xb7, 0x2c, 0x8a,
-	0x31, 0x80, 0xeb, 0x7f, 0xab, 0x04, 0xc3, 0xbc, 0x91, 0x47, 0x7e, 0x44, 0x83, 0xb3, 0x77, 0x3a,
-	0x9b, 0xd4, 0xb5, 0xa9, 0x4f, 0xbd, 0x25, 0xc3, 0xdb, 0xde, 0x74, 0x0c, 0xb7, 0x21, 0x17, 0xe6,
-	0x7a, 0x91, 0x19, 0xb9, 0x99, 0x46, 0x27, 0xf6, 0x60, 0x06, 0x00, 0xb3, 0x88, 0x93, 0x1d, 0x98,
-	0xb0, 0x9b, 0xa6, 0xbd, 0x5b, 0xb5, 0x9b, 0x2e, 0xf5, 0x3c, 0x3e, 0xe8, 0xf1, 0xa7, 0x3f, 0x50,
-	0xa4, 0x33, 0x6b, 0x0a, 0x9e, 0xc5, 0xe9, 0x83, 0xfd, 0xf2, 0x84, 0x5a, 0x82, 0x31, 0x3a, 0xfa,
-	0x5f, 0x6a, 0x70, 0x66, 0xa1, 0xd1, 0x32, 0x3d, 0xc6, 0x69, 0xd7, 0xad, 0x4e, 0xd3, 0xec, 0x61,
-	0xeb, 0x93, 0x0f, 0xc1, 0x70, 0xdd, 0xb1, 0xb7, 0xcc, 0xa6, 0xec, 0xe7, 0x7b, 0xe6, 0x05, 0xe7,
-	0x9a, 0x57, 0x39, 0x17, 0xef, 0x9e, 0xe4, 0x78, 0xf3, 0x68, 0xdc, 0x5d, 0x0e, 0x18, 0xfa, 0x22,
-	0x1c, 0xec, 0x97, 0x87, 0x2b, 0x1c, 0x01, 0x4a, 0x44, 0xe4, 0x71, 0x18, 0x6d, 0x98, 0x9e, 0x58,
-	0xcc, 0x01, 0xbe, 0x98, 0x13, 0x07, 0xfb, 0xe5, 0xd1, 0x25, 0x59, 0x86, 0x21, 0x94, 0xac, 0xc0,
-	0x39, 0x36, 0x83, 0xa2, 0x5d, 0x8d, 0xd6, 0x5d, 0xea, 0xb3, 0xae, 0xcd, 0x0e, 0xf2, 0xee, 0xce,
-	0x1e, 0xec, 0x97, 0xcf, 0xdd, 0xcc, 0x80, 0x63, 0x66, 0x2b, 0xfd, 0x1a, 0x8c, 0x2e, 0x58, 0xd4,
-	0x65, 0x0c, 0x81, 0x5c, 0x85, 0x29, 0xda, 0x32, 0x4c, 0x0b, 0x69, 0x9d, 0x9a, 0x3b, 0xd4, 0xf5,
-	0x66, 0xb5, 0xcb, 0x03, 0x8f, 0x8f, 0x2d, 0x92, 0x83, 0xfd, 0xf2, 0xd4, 0x72, 0x0c, 0x82, 0x89,
-	0x9a, 0xfa, 0xc7, 0x34, 0x18, 0x5f, 0xe8, 0x34, 0x4c, 0x5f, 0x8c, 0x8b, 0xb8, 0x30, 0x6e, 0xb0,
-	0x9f, 0xeb, 0x8e, 0x65, 0xd6, 0xf7, 0xe4, 0xe6, 0x7a, 0xa9, 0xd0, 0xe7, 0x16, 0xa1, 0x59, 0x3c,
-	0x73, 0xb0, 0x5f, 0x1e, 0x57, 0x0a, 0x50, 0x25, 0xa2, 0x6f, 0x83, 0x0a, 0x23, 0xdf, 0x02, 0x13,
-	0x62, 0xb8, 0xab, 0x46, 0x1b, 0xe9, 0x96, 0xec, 0xc3, 0x23, 0xca, 0x5a, 0x05, 0x84, 0xe6, 0x6f,
-	0x6d, 0xbe, 0x41, 0xeb, 0x3e, 0xd2, 0x2d, 0xea, 0x52, 0xbb, 0x4e, 0xc5, 0xb6, 0xa9, 0x28, 0x8d,
-	0x31, 0x86, 0x4a, 0xff, 0xff, 0x35, 0x78, 0x78, 0xa1, 0xe3, 0x6f, 0x3b, 0xae, 0x79, 0x8f, 0xba,
-	0xd1, 0x74, 0x87, 0x18, 0xc8, 0x8b, 0x30, 0x65, 0x84, 0x15, 0xd6, 0xa2, 0xed, 0x74, 0x41, 0x6e,
-	0xa7, 0xa9, 0x85, 0x18, 0x14, 0x13, 0xb5, 0xc9, 0xd3, 0x00, 0x5e, 0xb4, 0xb6, 0x9c, 0x07, 0x2c,
-	0x12, 0xd9, 0x16, 0x94, 0x55, 0x55, 0x6a, 0xe9, 0x7f, 0xc0, 0x8e, 0xc2, 0x1d, 0xc3, 0xb4, 0x8c,
-	0x4d, 0xd3, 0x32, 0xfd, 0xbd, 0xd7, 0x1c, 0x9b, 0xf6, 0xb0, 0x9b, 0x6f, 0xc3, 0xc5, 0x8e, 0x6d,
-	0x88, 0x76, 0x16, 0x5d, 0x15, 0xfb, 0x77, 0x63, 0xaf, 0x4d, 0x05, 0x97, 0x1c, 0x5b, 0x7c, 0xf0,
-	0x60, 0xbf, 0x7c, 0xf1, 0x76, 0x76, 0x15, 0xcc, 0x6b, 0xcb, 0x4e, 0x3d, 0x05, 0xf4, 0xb2, 0x63,
-	0x75, 0x5a, 0x12, 0xeb, 0x00, 0xc7, 0xca, 0x4f, 0xbd, 0xdb, 0x99, 0x35, 0x30, 0xa7, 0xa5, 0xfe,
-	0xa5, 0x12, 0x4c, 0x2c, 0x1a, 0xf5, 0x3b, 0x9d, 0xf6, 0x62, 0xa7, 0x7e, 0x87, 0xfa, 0xe4, 0x3b,
-	0x61, 0x94, 0x89, 0x2d, 0x0d, 0xc3, 0x37, 0xe4, 0xfa, 0x7e, 0x63, 0xee, 0xb7, 0xc8, 0xb7, 0x16,
-	0xab, 0x1d, 0xad, 0xf8, 0x2a, 0xf5, 0x8d, 0x68, 0x5a, 0xa3, 0x32, 0x0c, 0xb1, 0x92, 0x2d, 0x18,
-	0xf4, 0xda, 0xb4, 0x2e, 0xbf, 0xf4, 0xa5, 0x22, 0x3b, 0x58, 0xed, 0x71, 0xad, 0x4d, 0xeb, 0xd1,
-	0x2a, 0xb0, 0x5f, 0xc8, 0xf1, 0x13, 0x1b, 0x86, 0x3d, 0xdf, 0xf0, 0x3b, 0x1e, 0xff, 0xfc, 0xc7,
-	0x9f, 0xbe, 0xd6, 0x37, 0x25, 0x8e, 0x6d, 0x71, 0x4a, 0xd2, 0x1a, 0x16, 0xbf, 0x51, 0x52, 0xd1,
-	0xff, 0x9d, 0x06, 0xd3, 0x6a, 0xf5, 0x15, 0xd3, 0xf3, 0xc9, 0xb7, 0xa5, 0xa6, 0x73, 0xbe, 0xb7,
-	0xe9, 0x64, 0xad, 0xf9, 0x64, 0x4e, 0x4b, 0x72, 0xa3, 0x41, 0x89, 0x32, 0x95, 0x14, 0x86, 0x4c,
-	0x9f, 0xb6, 0x82, 0xc3, 0xf7, 0x03, 0xfd, 0x8e, 0x70, 0x71, 0x52, 0x12, 0x1b, 0xaa, 0x32, 0xb4,
-	0x28, 0xb0, 0xeb, 0xdf, 0x09, 0xe7, 0xd4, 0x5a, 0xeb, 0xae, 0xb3, 0x63, 0x36, 0xa8, 0xcb, 0xbe,
-	0x04, 0x7f, 0xaf, 0x9d, 0xfa, 0x12, 0xd8, 0xce, 0x42, 0x0e, 0x21, 0xef, 0x82, 0x61, 0x97, 0x36,
-	0x99, 0x94, 0x22, 0x3e, 0xb8, 0x70, 0xee, 0x90, 0x97, 0xa2, 0x84, 0xea, 0xff, 0xb3, 0x14, 0x9f,
-	0x3b, 0xb6, 0x8c, 0x64, 0x07, 0x46, 0xdb, 0x92, 0x94, 0x9c, 0xbb, 0x1b, 0xfd, 0x0e, 0x30, 0xe8,
-	0x7a, 0x34, 0xab, 0x41, 0x09, 0x86, 0xb4, 0x88, 0x09, 0x53, 0xc1, 0xff, 0x95, 0x3e, 0x0e, 0x25,
-	0xce, 0xe4, 0xd7, 0x63, 0x88, 0x30, 0x81, 0x98, 0x6c, 0xc0, 0x98, 0x60, 0x37, 0x8c, 0x9d, 0x0e,
-	0xe4, 0xb3, 0xd3, 0x5a, 0x50, 0x49, 0xb2, 0xd3, 0x19, 0xd9, 0xfd, 0xb1, 0x10, 0x80, 0x11, 0x22,
-	0x76, 0xf4, 0x79, 0x94, 0x36, 0x94, 0x43, 0x8c, 0x1f, 0x7d, 0x35, 0x59, 0x86, 0x21, 0x54, 0xff,
-	0xc2, 0x20, 0x90, 0xf4, 0x16, 0x57, 0x67, 0x40, 0x94, 0xc8, 0xf9, 0xef, 0x67, 0x06, 0xe4, 0xd7,
-	0x92, 0x40, 0x4c, 0xee, 0xc1, 0xa4, 0x65, 0x78, 0xfe, 0xad, 0x36, 0xd3, 0x41, 0x82, 0x8d, 0x32,
-	0xfe, 0xf4, 0x42, 0x91, 0x95, 0x5e, 0x51, 0x11, 0x2d, 0xce, 0x1c, 0xec, 0x97, 0x27, 0x63, 0x45,
-	0x18, 0x27, 0x45, 0xde, 0x80, 0x31, 0x56, 0xb0, 0xec, 0xba, 0x8e, 0x2b, 0x67, 0xff, 0x85, 0xa2,
-	0x74, 0x39, 0x12, 0xa1, 0x13, 0x85, 0x3f, 0x31, 0x42, 0x4f, 0x3e, 0x08, 0xc4, 0xd9, 0xe4, 0x5a,
-	0x69, 0xe3, 0xba, 0x50, 0xb8, 0xd8, 0x60, 0xd9, 0xea, 0x0c, 0x2c, 0xce, 0xc9, 0xd5, 0x24, 0xb7,
-	0x52, 0x35, 0x30, 0xa3, 0x15, 0xb9, 0x03, 0x24, 0x54, 0xda, 0xc2, 0x0d, 0x30, 0x3b, 0xd4, 0xfb,
-	0xf6, 0xb9, 0xc0, 0x88, 0x5d, 0x4f, 0xa1, 0xc0, 0x0c, 0xb4, 0xfa, 0xbf, 0x2e, 0xc1, 0xb8, 0xd8,
-	0x22, 0x42, 0xb0, 0x3e, 0xf9, 0x03, 0x82, 0xc6, 0x0e, 0x88, 0x4a, 0xf1, 0x6f, 0x9e, 0x77, 0x38,
-	0xf7, 0x7c, 0x68, 0x25, 0xce, 0x87, 0xe5, 0x7e, 0x09, 0x75, 0x3f, 0x1e, 0xfe, 0xad, 0x06, 0x67,
-	0x94, 0xda, 0xa7, 0x70, 0x3a, 0x34, 0xe2, 0xa7, 0xc3, 0x4b, 0x7d, 0x8e, 0x2f, 0xe7, 0x70, 0x70,
-	0x62, 0xc3, 0xe2, 0x8c, 0xfb, 0x69, 0x80, 0x4d, 0xce, 0x4e, 0x14, 0x31, 0x2d, 0x5c, 0xf2, 0xc5,
-	0x10, 0x82, 0x4a, 0xad, 0x18, 0xcf, 0x2a, 0x75, 0xe5, 0x59, 0xff, 0x79, 0x00, 0x66, 0x52, 0xd3,
-	0x9e, 0xe6, 0x23, 0xda, 0xd7, 0x88, 0x8f, 0x94, 0xbe, 0x16, 0x7c, 0x64, 0xa0, 0x10, 0x1f, 0xe9,
-	0xf9, 0x9c, 0x20, 0x2e, 0x90, 0x96, 0xd9, 0x14, 0xcd, 0x6a, 0xbe, 0xe1, 0xfa, 0x1b, 0x66, 0x8b,
-	0x4a, 0x8e, 0xf3, 0x0d, 0xbd, 0x6d, 0x59, 0xd6, 0x42, 0x30, 0x9e, 0xd5, 0x14, 0x26, 0xcc, 0xc0,
-	0xae, 0xff, 0x7f, 0x25, 0x18, 0x59, 0x34, 0x3c, 0xde, 0xd3, 0x8f, 0xc0, 0x84, 0x44, 0x5d, 0x6d,
-	0x19, 0x4d, 0xda, 0x8f, 0x6a, 0x2d, 0x51, 0xae, 0x2a, 0xe8, 0x84, 0x76, 0xa2, 0x96, 0x60, 0x8c,
-	0x1c, 0xd9, 0x83, 0xf1, 0x56, 0x24, 0x89, 0xcb, 0x25, 0xbe, 0xd6, 0x3f, 0x75, 0x86, 0x4d, 0xa8,
-	0x60, 0x4a, 0x01, 0xaa, 0xb4, 0xf4, 0xd7, 0xe1, 0x6c, 0x46, 0x8f, 0x7b, 0x50, 0x42, 0x1e, 0x83,
-	0x11, 0xa6, 0x47, 0x46, 0xb2, 0xd7, 0xf8, 0xc1, 0x7e, 0x79, 0xe4, 0x65, 0x51, 0x84, 0x01, 0x4c,
-	0x7f, 0x1f, 0x13, 0x00, 0x92, 0x7d, 0xea, 0xc5, 0x58, 0x35, 0x04, 0x50, 0x59, 0x40, 0xc7, 0x17,
-	0x5b, 0xe9, 0x25, 0x18, 0x6a, 0x6f, 0x1b, 0x5e, 0xd0, 0xe2, 0x89, 0x80, 0x55, 0xac, 0xb3, 0xc2,
-	0xfb, 0xfb, 0xe5, 0xd9, 0x8a, 0x4b, 0x1b, 0xd4, 0xf6, 0x4d, 0xc3, 0xf2, 0x82, 0x46, 0x1c, 0x86,
-	0xa2, 0x1d, 0xdb, 0x61, 0x6c, 0x93, 0x57, 0x9c, 0x56, 0xdb, 0xa2, 0x0c, 0xca, 0x77, 0x58, 0xa9,
-	0xd8, 0x0e, 0x5b, 0x49, 0x61, 0xc2, 0x0c, 0xec, 0x01, 0xcd, 0xaa, 0x6d, 0xfa, 0xa6, 0x11, 0xd2,
-	0x1c, 0x28, 0x4e, 0x33, 0x8e, 0x09, 0x33, 0xb0, 0x93, 0x4f, 0x69, 0x30, 0x17, 0x2f, 0xbe, 0x66,
-	0xda, 0xa6, 0xb7, 0x4d, 0x1b, 0x9c, 0xf8, 0xe0, 0x91, 0x89, 0x5f, 0x3a, 0xd8, 0x2f, 0xcf, 0xad,
-	0xe4, 0x62, 0xc4, 0x2e, 0xd4, 0xc8, 0xa7, 0x35, 0x78, 0x30, 0x31, 0x2f, 0xae, 0xd9, 0x6c, 0x52,
-	0x57, 0xf6, 0xe6, 0xe8, 0x1f, 0x78, 0xf9, 0x60, 0xbf, 0xfc, 0xe0, 0x4a, 0x3e, 0x4a, 0xec, 0x46,
-	0x8f, 0xfc, 0xa4, 0x06, 0x17, 0xda, 0xd4, 0x6e, 0x98, 0x76, 0xf3, 0x15, 0xc7, 0xbd, 0x43, 0x5d,
-	0x0f, 0x1d, 0xcb, 0x72, 0x3a, 0xbe, 0x37, 0x3b, 0xcc, 0xcf, 0xb0, 0x6a, 0x91, 0x6f, 0x6e, 0x3d,
-	0x0b, 0xe3, 0xe2, 0x25, 0xb9, 0x45, 0x2f, 0x64, 0x82, 0x3d, 0xcc, 0xe9, 0x88, 0xfe, 0x2b, 0x1a,
-	0x0c, 0x54, 0xb0, 0x4a, 0x9e, 0x8c, 0x7d, 0x22, 0x17, 0xd5, 0x4f, 0xe4, 0xfe, 0x7e, 0x79, 0xa4,
-	0x82, 0x55, 0xe5, 0x63, 0xfc, 0xb4, 0x06, 0x33, 0x75, 0xc7, 0xf6, 0x0d, 0x36, 0x77, 0x28, 0x64,
-	0xe5, 0xe0, 0x5c, 0x2e, 0xa4, 0x01, 0x57, 0x12, 0xc8, 0x22, 0xc3, 0x6d, 0x12, 0xe2, 0x61, 0x9a,
-	0xb2, 0xfe, 0x15, 0x0d, 0x26, 0x2a, 0x96, 0xd3, 0x69, 0xac, 0xbb, 0xce, 0x96, 0x69, 0xd1, 0xb7,
-	0x87, 0xda, 0xaf, 0xf6, 0x38, 0x4f, 0xac, 0xe3, 0x6a, 0xb8, 0x5a, 0xf1, 0x6d, 0xa2, 0x86, 0xab,
-	0x5d, 0xce, 0x91, 0xb4, 0xbe, 0x15, 0xce, 0xab, 0xb5, 0x22, 0xd3, 0xd8, 0x65, 0x18, 0xbc, 0x63,
-	0xda, 0x8d, 0x24, 0xb7, 0xbe, 0x69, 0xda, 0x0d, 0xe4, 0x90, 0x90, 0x9f, 0x97, 0x72, 0xf9, 0xf9,
-	0x9f, 0x8f, 0xc4, 0xa7, 0x8d, 0x0b, 0x72, 0x8f, 0xc3, 0x68, 0xdd, 0x58, 0xec, 0xd8, 0x0d, 0x2b,
-	0x3c, 0x0a, 0xd8, 0x14, 0x54, 0x16, 0x44, 0x19, 0x86, 0x50, 0x72, 0x0f, 0x20, 0xb2, 0x42, 0xf7,
-	0x73, 0x40, 0x46, 0x06, 0xee, 0x1a, 0xf5, 0x7d, 0xd3, 0x6e, 0x7a, 0xd1, 0xbe, 0x8a, 0x60, 0xa8,
-	0x50, 0x23, 0x1f, 0x81, 0x49, 0xf5, 0xb4, 0x16, 0xe6, 0xb0, 0x82, 0xcb, 0x10, 0x13, 0x0b, 0xce,
-	0x4b, 0xc2, 0x93, 0x6a, 0xa9, 0x87, 0x71, 0x6a, 0x64, 0x2f, 0x94, 0x4d, 0x84, 0x31, 0x6e, 0xb0,
-	0xb8, 0xb4, 0xad, 0x8a, 0x05, 0xe7, 0x24, 0xf1, 0x89, 0x98, 0x71, 0x30, 0x46, 0x2a, 0xc3, 0x52,
-	0x31, 0x74, 0x52, 0x96, 0x0a, 0x0a, 0x23, 0xc2, 0x56, 0x13, 0xb0, 0xe2, 0xab, 0x45, 0x06, 0x28,
-	0xcc, 0x3e, 0xd1, 0xb5, 0x8a, 0xf8, 0xed, 0x61, 0x80, 0x9b, 0xec, 0xc0, 0x04, 0x13, 0x3a, 0x6b,
-	0xd4, 0xa2, 0x75, 0xdf, 0x71, 0x67, 0x47, 0x8a, 0x5f, 0x5b, 0xd4, 0x14, 0x3c, 0x42, 0xc2, 0x53,
-	0x4b, 0x30, 0x46, 0x27, 0x34, 0x65, 0x8d, 0xe6, 0x9a, 0xb2, 0x3a, 0x30, 0xbe, 0xa3, 0x98, 0x5c,
-	0xc7, 0xf8, 0x24, 0xbc, 0x58, 0xa4, 0x63, 0x91, 0xfd, 0x75, 0xf1, 0xac, 0x24, 0x34, 0xae, 0xda,
-	0x6a, 0x55, 0x3a, 0x64, 0x13, 0x46, 0x36, 0x85, 0x7c, 0x36, 0x0b, 0x7c, 0x2e, 0x9e, 0xef, 0x43,
-	0xec, 0x14, 0x32, 0xa0, 0xfc, 0x81, 0x01, 0x62, 0xfd, 0xc7, 0x27, 0x60, 0xa6, 0x62, 0x75, 0x3c,
-	0x9f, 0xba, 0x0b, 0xf2, 0xde, 0x9e, 0xba, 0xe4, 0xe3, 0x1a, 0x5c, 0xe0, 0xff, 0x2e, 0x39, 0x77,
-	0xed, 0x25, 0x6a, 0x19, 0x7b, 0x0b, 0x5b, 0xac, 0x46, 0xa3, 0x71, 0x34, 0x16, 0xba, 0xd4, 0x91,
-	0x8a, 0x14, 0xb7, 0x4f, 0xd7, 0x32, 0x31, 0x62, 0x0e, 0x25, 0xf2, 0xfd, 0x1a, 0x3c, 0x90, 0x01,
-	0x5a, 0xa2, 0x16, 0xf5, 0x03, 0xf1, 0xf0, 0xa8, 0xfd, 0x78, 0xf8, 0x60, 0xbf, 0xfc, 0x40, 0x2d,
-	0x0f, 0x29, 0xe6, 0xd3, 0x23, 0x3f, 0xa8, 0xc1, 0x5c, 0x06, 0xf4, 0x9a, 0x61, 0x5a, 0x1d, 0x37,
-	0x90, 0x1c, 0x8f, 0xda, 0x1d, 0x2e, 0xc0, 0xd5, 0x72, 0xb1, 0x62, 0x17, 0x8a, 0xe4, 0xa3, 0x70,
-	0x3e, 0x84, 0xde, 0xb6, 0x6d, 0x4a, 0x1b, 0x31, 0x39, 0xf2, 0xa8, 0x5d, 0x79, 0xe0, 0x60, 0xbf,
-	0x7c, 0xbe, 0x96, 0x85, 0x10, 0xb3, 0xe9, 0x90, 0x26, 0x3c, 0x1c, 0x01, 0x7c, 0xd3, 0x32, 0xef,
-	0x09, 0x51, 0x77, 0xdb, 0xa5, 0xde, 0xb6, 0x63, 0x35, 0x38, 0x43, 0xd2, 0x16, 0xdf, 0x79, 0xb0,
-	0x5f, 0x7e, 0xb8, 0xd6, 0xad, 0x22, 0x76, 0xc7, 0x43, 0x1a, 0x30, 0xe1, 0xd5, 0x0d, 0xbb, 0x6a,
-	0xfb, 0xd4, 0xdd, 0x31, 0xac, 0xd9, 0xe1, 0x42, 0x03, 0x14, 0x6c, 0x40, 0xc1, 0x83, 0x31, 0xac,
-	0xe4, 0xfd, 0x30, 0x4a, 0x77, 0xdb, 0x86, 0xdd, 0xa0, 0x82, 0xf5, 0x8c, 0x2d, 0x3e, 0xc4, 0x0e,
-	0xbc, 0x65, 0x59, 0x76, 0x7f, 0xbf, 0x3c, 0x11, 0xfc, 0xbf, 0xea, 0x34, 0x28, 0x86, 0xb5, 0xc9,
-	0x87, 0xe1, 0x1c, 0x77, 0x2c, 0x68, 0x50, 0xce, 0x48, 0xbd, 0x40, 0x9b, 0x18, 0x2d, 0xd4, 0x4f,
-	0x7e, 0xe9, 0xb8, 0x9a, 0x81, 0x0f, 0x33, 0xa9, 0xb0, 0x65, 0x68, 0x19, 0xbb, 0xd7, 0x5d, 0xa3,
-	0x4e, 0xb7, 0x3a, 0xd6, 0x06, 0x75, 0x5b, 0xa6, 0x2d, 0xd4, 0x69, 0x5a, 0x77, 0xec, 0x06, 0x63,
-	0x57, 0xda, 0xe3, 0x43, 0x62, 0x19, 0x56, 0xbb, 0x55, 0xc4, 0xee, 0x78, 0xc8, 0x33, 0x30, 0x61,
-	0x36, 0x6d, 0xc7, 0xa5, 0x1b, 0x86, 0x69, 0xfb, 0xde, 0x2c, 0xf0, 0x9b, 0x27, 0x3e, 0xad, 0x55,
-	0xa5, 0x1c, 0x63, 0xb5, 0xc8, 0x0e, 0x10, 0x9b, 0xde, 0x5d, 0x77, 0x1a, 0x7c, 0x0b, 0xdc, 0x6e,
-	0xf3, 0x8d, 0x3c, 0x3b, 0x5e, 0x68, 0x6a, 0xb8, 0xb2, 0xb5, 0x96, 0xc2, 0x86, 0x19, 0x14, 0xc8,
-	0x35, 0x20, 0x2d, 0x63, 0x77, 0xb9, 0xd5, 0xf6, 0xf7, 0x16, 0x3b, 0xd6, 0x1d, 0xc9, 0x35, 0x26,
-	0xf8, 0x5c, 0x08, 0x53, 0x44, 0x0a, 0x8a, 0x19, 0x2d, 0x88, 0x01, 0x0f, 0x8a, 0xf1, 0x2c, 0x19,
-	0xb4, 0xe5, 0xd8, 0x1e, 0xf5, 0x3d, 0x65, 0x93, 0xce, 0x4e, 0xf2, 0xeb, 0x65, 0xae, 0xfa, 0x54,
-	0xf3, 0xab, 0x61, 0x37, 0x1c, 0x71, 0x07, 0x9b, 0xa9, 0x43, 0x1c, 0x6c, 0x9e, 0x85, 0x49, 0xcf,
-	0x37, 0x5c, 0xbf, 0xd3, 0x96, 0xcb, 0x70, 0x86, 0x2f, 0x03, 0xb7, 0x54, 0xd5, 0x54, 0x00, 0xc6,
-	0xeb, 0xb1, 0xe5, 0x13, 0xe6, 0x48, 0xd9, 0x6e, 0x3a, 0x5a, 0xbe, 0x9a, 0x52, 0x8e, 0xb1, 0x5a,
-	0xfa, 0xff, 0x18, 0x84, 0xd9, 0xd4, 0xf9, 0x10, 0x38, 0xa5, 0x1c, 0xca, 0x01, 0xb4, 0x63, 0xe2,
-	0x00, 0x6d, 0xb8, 0x1c, 0x56, 0xb8, 0xde, 0xee, 0x64, 0xd2, 0x2a, 0x71, 0x5a, 0x8f, 0x1e, 0xec,
-	0x97, 0x2f, 0xd7, 0x0e, 0xa9, 0x8b, 0x87, 0x62, 0xcb, 0xe7, 0xae, 0x03, 0xa7, 0xc4, 0x5d, 0x3f,
-	0x0c, 0xe7, 0x14, 0x80, 0x4b, 0x8d, 0xc6, 0x5e, 0x1f, 0xdc, 0x9d, 0x33, 0x95, 0x5a, 0x06, 0x3e,
-	0xcc, 0xa4, 0x92, 0xcb, 0xd2, 0x86, 0x4e, 0x83, 0xa5, 0xe9, 0xfb, 0x03, 0x30, 0x56, 0x71, 0xec,
-	0x86, 0xc9, 0x3f, 0x8f, 0xa7, 0x62, 0x57, 0x8d, 0x0f, 0xab, 0xf2, 0xd9, 0xfd, 0xfd, 0xf2, 0x64,
-	0x58, 0x51, 0x11, 0xd8, 0x9e, 0x0b, 0xed, 0xfb, 0x42, 0xeb, 0x79, 0x67, 0xdc, 0x30, 0x7f, 0x7f,
-	0xbf, 0x7c, 0x26, 0x6c, 0x16, 0xb7, 0xd5, 0x33, 0x7e, 0x65, 0x19, 0x9e, 0xbf, 0xe1, 0x1a, 0xb6,
-	0x67, 0xf6, 0x61, 0x18, 0x0a, 0x0d, 0xb2, 0x2b, 0x29, 0x6c, 0x98, 0x41, 0x81, 0xbc, 0x01, 0x53,
-	0xac, 0xf4, 0x76, 0xbb, 0x61, 0xf8, 0xb4, 0xa0, 0x3d, 0x28, 0xf4, 0x87, 0x58, 0x89, 0x61, 0xc2,
-	0x04, 0x66, 0x71, 0x35, 0x6b, 0x78, 0x8e, 0xcd, 0xd7, 0x33, 0x76, 0x35, 0xcb, 0x4a, 0x51, 0x42,
-	0xc9, 0x13, 0x30, 0xd2, 0xa2, 0x9e, 0x67, 0x34, 0x29, 0x3f, 0x73, 0xc7, 0x22, 0xe1, 0x7d, 0x55,
-	0x14, 0x63, 0x00, 0x27, 0xef, 0x86, 0xa1, 0xba, 0xd3, 0xa0, 0xde, 0xec, 0x08, 0x67, 0x2b, 0x8c,
-	0xc3, 0x0e, 0x55, 0x58, 0xc1, 0xfd, 0xfd, 0xf2, 0x18, 0x37, 0x5f, 0xb3, 0x5f, 0x28, 0x2a, 0xe9,
-	0x3f, 0xc1, 0x14, 0xf5, 0x84, 0x65, 0xa2, 0x87, 0x2b, 0xe5, 0xd3, 0xbb, 0x9d, 0xd5, 0x3f, 0xa3,
-	0xc1, 0x04, 0xeb, 0xa1, 0xeb, 0x58, 0xeb, 0x96, 0x61, 0x53, 0xf2, 0xbd, 0x1a, 0x4c, 0x6f, 0x9b,
-	0xcd, 0x6d, 0xd5, 0x27, 0x44, 0x0a, 0xc3, 0x85, 0x0c, 0x1a, 0x37, 0x12, 0xb8, 0x16, 0xcf, 0x1d,
-	0xec, 0x97, 0xa7, 0x93, 0xa5, 0x98, 0xa2, 0xa9, 0x7f, 0xb2, 0x04, 0xe7, 0x64, 0xcf, 0x2c, 0x26,
-	0x9d, 0xb6, 0x2d, 0x67, 0xaf, 0x45, 0xed, 0xd3, 0x70, 0xdf, 0x08, 0x56, 0xa8, 0x94, 0xbb, 0x42,
-	0xad, 0xd4, 0x0a, 0x0d, 0x14, 0x59, 0xa1, 0x70, 0x23, 0x1f, 0xb2, 0x4a, 0x7f, 0xac, 0xc1, 0x6c,
-	0xd6, 0x5c, 0x9c, 0x82, 0xe1, 0xa7, 0x15, 0x37, 0xfc, 0xdc, 0x28, 0x6a, 0xc9, 0x4b, 0x76, 0x3d,
-	0xc7, 0x00, 0xf4, 0x47, 0x25, 0xb8, 0x10, 0x55, 0xaf, 0xda, 0x9e, 0x6f, 0x58, 0x96, 0x10, 0x1f,
-	0x4e, 0x7e, 0xdd, 0xdb, 0x31, 0xfb, 0xdd, 0x5a, 0x7f, 0x43, 0x55, 0xfb, 0x9e, 0x7b, 0x41, 0xbb,
-	0x9b, 0xb8, 0xa0, 0x5d, 0x3f, 0x46, 0x9a, 0xdd, 0xef, 0x6a, 0xff, 0xab, 0x06, 0x73, 0xd9, 0x0d,
-	0x4f, 0x61, 0x53, 0x39, 0xf1, 0x4d, 0xf5, 0xc1, 0xe3, 0x1b, 0x75, 0xce, 0xb6, 0xfa, 0xf9, 0x52,
-	0xde, 0x68, 0xb9, 0x11, 0x70, 0x0b, 0xce, 0xb8, 0xb4, 0x69, 0x7a, 0xbe, 0xbc, 0x49, 0x3c, 0x9a,
-	0xe3, 0x5f, 0x60, 0x18, 0x3f, 0x83, 0x71, 0x1c, 0x98, 0x44, 0x4a, 0xd6, 0x60, 0xc4, 0xa3, 0xb4,
-	0xc1, 0xf0, 0x97, 0x7a, 0xc7, 0x1f, 0x9e, 0x46, 0x35, 0xd1, 0x16, 0x03, 0x24, 0xe4, 0xdb, 0x60,
-	0xb2, 0x11, 0x7e, 0x51, 0x87, 0xf8, 0xd7, 0x24, 0xb1, 0x72, 0x49, 0x7a, 0x49, 0x6d, 0x8d, 0x71,
-	0x64, 0xfa, 0x5f, 0x68, 0xf0, 0x50, 0xb7, 0xbd, 0x45, 0xde, 0x04, 0xa8, 0x07, 0xe2, 0x85, 0xf0,
-	0xfb, 0x2c, 0x78, 0x2b, 0x1c, 0x0a, 0x29, 0xd1, 0x07, 0x1a, 0x16, 0x79, 0xa8, 0x10, 0xc9, 0x70,
-	0xdb, 0x29, 0x9d, 0x90, 0xdb, 0x8e, 0xfe, 0xdf, 0x34, 0x95, 0x15, 0xa9, 0x6b, 0xfb, 0x76, 0x63,
-	0x45, 0x6a, 0xdf, 0x73, 0x2f, 0x15, 0x7e, 0xa7, 0x04, 0x97, 0xb3, 0x9b, 0x28, 0x67, 0xef, 0x07,
-	0x60, 0xb8, 0x2d, 0x9c, 0x73, 0x07, 0xf8, 0xd9, 0xf8, 0x38, 0xe3, 0x2c, 0xc2, 0x75, 0xf6, 0xfe,
-	0x7e, 0x79, 0x2e, 0x8b, 0xd1, 0x4b, 0xa7, 0x5b, 0xd9, 0x8e, 0x98, 0x09, 0xeb, 0xa7, 0x90, 0xfe,
-	0xbe, 0xa9, 0x47, 0xe6, 0x62, 0x6c, 0x52, 0xab, 0x67, 0x83, 0xe7, 0xc7, 0x34, 0x98, 0x8a, 0xed,
-	0x68, 0x6f, 0x76, 0x88, 0xef, 0xd1, 0x42, 0x1e, 0x13, 0xb1, 0x4f, 0x25, 0x3a, 0xb9, 0x63, 0xc5,
-	0x1e, 0x26, 0x08, 0x26, 0xd8, 0xac, 0x3a, 0xab, 0x6f, 0x3b, 0x36, 0xab, 0x76, 0x3e, 0x87, 0xcd,
-	0xfe, 0x58, 0x29, 0x6f, 0xb4, 0x9c, 0xcd, 0xde, 0x85, 0xb1, 0xe0, 0x99, 0x51, 0xc0, 0x2e, 0xae,
-	0xf5, 0xdb, 0x27, 0x81, 0x2e, 0xf2, 0x16, 0x0c, 0x4a, 0x3c, 0x8c, 0x68, 0x91, 0xef, 0xd6, 0x00,
-	0xa2, 0x85, 0x91, 0x1f, 0xd5, 0xc6, 0xf1, 0x4d, 0x87, 0x22, 0xd6, 0x4c, 0xb1, 0x4f, 0x5a, 0xd9,
-	0x14, 0x0a, 0x5d, 0xfd, 0xcf, 0x07, 0x80, 0xa4, 0xfb, 0xde, 0xdb, 0xdd, 0xd6, 0x21, 0x02, 0xe9,
-	0x0b, 0x70, 0xa6, 0x69, 0x39, 0x9b, 0x86, 0x65, 0xed, 0xc9, 0x77, 0x1c, 0xf2, 0x45, 0xc0, 0x59,
-	0x76, 0x30, 0x5d, 0x8f, 0x83, 0x30, 0x59, 0x97, 0xb4, 0x61, 0xda, 0xa5, 0x75, 0xc7, 0xae, 0x9b,
-	0x16, 0x57, 0x9d, 0x9c, 0x8e, 0x5f, 0x50, 0x03, 0xe7, 0xe2, 0x3d, 0x26, 0x70, 0x61, 0x0a, 0x3b,
-	0x79, 0x0c, 0x46, 0xda, 0xae, 0xd9, 0x32, 0xdc, 0x3d, 0xae, 0x9c, 0x8d, 0x0a, 0xbb, 0xfd, 0xba,
-	0x28, 0xc2, 0x00, 0x46, 0x3e, 0x0c, 0x63, 0x96, 0xb9, 0x45, 0xeb, 0x7b, 0x75, 0x8b, 0x4a, 0x83,
-	0xe8, 0xad, 0xe3, 0xd9, 0x32, 0x2b, 0x01, 0x5a, 0xe9, 0x89, 0x14, 0xfc, 0xc4, 0x88, 0x20, 0xa9,
-	0xc2, 0xd9, 0xbb, 0xfc, 0x6e, 0xdc, 0xa2, 0x9e, 0x57, 0xeb, 0xb4, 0xdb, 0x8e, 0xeb, 0xd3, 0x06,
-	0x37, 0x9b, 0x8e, 0x8a, 0xc7, 0x2a, 0xaf, 0xa4, 0xc1, 0x98, 0xd5, 0x46, 0xff, 0x54, 0x09, 0x1e,
-	0xec, 0xd2, 0x09, 0x82, 0xec, 0xdb, 0x90, 0x73, 0x24, 0x77, 0xc2, 0x33, 0x62, 0x3f, 0xcb, 0xc2,
-	0xfb, 0xfb, 0xe5, 0x47, 0xba, 0x20, 0xa8, 0xb1, 0xad, 0x48, 0x9b, 0x7b, 0x18, 0xa1, 0x21, 0x55,
-	0x18, 0x6e, 0x44, 0xb7, 0x08, 0x63, 0x8b, 0x4f, 0x31, 0x6e, 0x2d, 0xec, 0x7d, 0xbd, 0x62, 0x93,
-	0x08, 0xc8, 0x0a, 0x8c, 0x08, 0xff, 0x25, 0x2a, 0x39, 0xff, 0xd3, 0x5c, 0x3d, 0x16, 0x45, 0xbd,
-	0x22, 0x0b, 0x50, 0xe8, 0x7f, 0xa6, 0xc1, 0x48, 0xc5, 0x71, 0xe9, 0xd2, 0x5a, 0x8d, 0xec, 0xc1,
-	0xb8, 0xf2, 0x92, 0x52, 0x72, 0xc1, 0x82, 0x6c, 0x81, 0x63, 0x5c, 0x88, 0xb0, 0x05, 0x6f, 0x3f,
-	0xc2, 0x02, 0x54, 0x69, 0x91, 0x37, 0xd9, 0x9c, 0xdf, 0x75, 0x4d, 0x9f, 0x11, 0xee, 0xe7, 0xd2,
-	0x5e, 0x10, 0xc6, 0x00, 0x97, 0xd8, 0x51, 0xe1, 0x4f, 0x8c, 0xa8, 0xe8, 0xeb, 0x8c, 0x03, 0x24,
-	0xbb, 0x49, 0xae, 0xc2, 0x60, 0xcb, 0x69, 0x04, 0xeb, 0xfe, 0xae, 0xe0, 0xfb, 0x5e, 0x75, 0x1a,
-	0x6c, 0x6e, 0x2f, 0xa4, 0x5b, 0x70, 0xcb, 0x3c, 0x6f, 0xa3, 0xaf, 0xc1, 0x74, 0x92, 0x3e, 0xb9,
-	0x0a, 0x53, 0x75, 0xa7, 0xd5, 0x72, 0xec, 0x5a, 0x67, 0x6b, 0xcb, 0xdc, 0xa5, 0xb1, 0x47, 0x39,
-	0x95, 0x18, 0x04, 0x13, 0x35, 0xf5, 0xcf, 0x6b, 0x30, 0xc0, 0xd6, 0x45, 0x87, 0xe1, 0x86, 0xd3,
-	0x32, 0x4c, 0x5b, 0xf6, 0x8a, 0x3f, 0x40, 0x5a, 0xe2, 0x25, 0x28, 0x21, 0xa4, 0x0d, 0x63, 0x81,
-	0xd0, 0xd4, 0x97, 0x0b, 0xe6, 0xd2, 0x5a, 0x2d, 0x74, 0x5b, 0x0f, 0x39, 0x79, 0x50, 0xe2, 0x61,
-	0x44, 0x44, 0x37, 0x60, 0x66, 0x69, 0xad, 0x56, 0xb5, 0xeb, 0x56, 0xa7, 0x41, 0x97, 0x77, 0xf9,
-	0x1f, 0xc6, 0x4b, 0x4c, 0x51, 0x22, 0xc7, 0xc9, 0x79, 0x89, 0xac, 0x84, 0x01, 0x8c, 0x55, 0xa3,
-	0xa2, 0x85, 0x7c, 0xa3, 0xc2, 0xab, 0x49, 0x24, 0x18, 0xc0, 0xf4, 0xaf, 0x94, 0x60, 0x5c, 0xe9,
-	0x10, 0xb1, 0x60, 0x44, 0x0c, 0xd7, 0xeb, 0xe7, 0x1d, 0x62, 0xaa, 0xd7, 0x82, 0xba, 0x98, 0x50,
-	0x0f, 0x03, 0x12, 0x2a, 0x5f, 0x2c, 0x75, 0xe1, 0x8b, 0xf3, 0xb1, 0xa7, 0x3e, 0xe2, 0x93, 0x9c,
-	0xca, 0x7f, 0xe6, 0x43, 0x1e, 0x92, 0x27, 0x88, 0xf0, 0x81, 0x1c, 0x4d, 0x9c, 0x1e, 0x5b, 0x30,
-	0x74, 0xcf, 0xb1, 0xa9, 0x27, 0xed, 0x9e, 0xc7, 0x34, 0xc0, 0x31, 0x26, 0x1f, 0xbc, 0xc6, 0xf0,
-	0xa2, 0x40, 0xaf, 0xff, 0xa4, 0x06, 0xb0, 0x64, 0xf8, 0x86, 0xb8, 0x0a, 0xee, 0xc1, 0xc3, 0xef,
-	0xa1, 0xd8, 0xc1, 0x37, 0x9a, 0x7a, 0x7a, 0x31, 0xe8, 0x99, 0xf7, 0x82, 0xe1, 0x87, 0x02, 0xb5,
-	0xc0, 0x5e, 0x33, 0xef, 0x51, 0xe4, 0x70, 0xf2, 0x24, 0x8c, 0x51, 0xbb, 0xee, 0xee, 0xb5, 0x19,
-	0xf3, 0x1e, 0xe4, 0xb3, 0xca, 0xbf, 0xd0, 0xe5, 0xa0, 0x10, 0x23, 0xb8, 0xfe, 0x14, 0xc4, 0xb5,
-	0xa2, 0x1e, 0x1c, 0x05, 0xff, 0x52, 0x83, 0x8b, 0x4b, 0x1d, 0xc3, 0x5a, 0x68, 0xb3, 0x8d, 0x6a,
-	0x58, 0xd7, 0x1c, 0x71, 0x9b, 0xca, 0x54, 0x85, 0x77, 0xc3, 0x68, 0x20, 0x87, 0x48, 0x0c, 0xa1,
-	0xc4, 0x16, 0x30, 0x4a, 0x0c, 0x6b, 0x10, 0x03, 0x46, 0xbd, 0x40, 0x32, 0x2e, 0xf5, 0x21, 0x19,
-	0x07, 0x24, 0x42, 0xc9, 0x38, 0x44, 0x4b, 0x10, 0x2e, 0xc8, 0x0f, 0xa2, 0x46, 0xdd, 0x1d, 0xb3,
-	0x4e, 0x17, 0xea, 0x75, 0xa7, 0x63, 0xfb, 0x9e, 0x14, 0x18, 0xf8, 0x15, 0x76, 0x35, 0xb3, 0x06,
-	0xe6, 0xb4, 0xd4, 0xbf, 0x3a, 0x08, 0x0f, 0x2c, 0x6f, 0x54, 0x96, 0xe4, 0x84, 0x9a, 0x8e, 0x7d,
-	0x93, 0xee, 0xfd, 0x8d, 0xe3, 0xe4, 0xdf, 0x38, 0x4e, 0x1e, 0x9f, 0xe3, 0xa4, 0xfe, 0x12, 0x4c,
-	0x47, 0xdb, 0x4b, 0x7a, 0xec, 0x3c, 0x99, 0x54, 0x28, 0xc6, 0x82, 0xa3, 0x37, 0xad, 0x04, 0xe8,
-	0xf7, 0x35, 0x98, 0x5e, 0xde, 0x6d, 0x9b, 0x2e, 0x7f, 0x20, 0x28, 0x7c, 0x83, 0xc9, 0x13, 0x91,
-	0x0b, 0xb1, 0x16, 0x37, 0xfd, 0x27, 0xdd, 0x88, 0xc9, 0x16, 0x4c, 0x51, 0xde, 0x9c, 0x4b, 0xfc,
-	0x86, 0x5f, 0x64, 0x07, 0x8a, 0x57, 0xb1, 0x31, 0x2c, 0x98, 0xc0, 0x4a, 0x6a, 0x30, 0x55, 0xb7,
-	0x0c, 0xcf, 0x33, 0xb7, 0xcc, 0x7a, 0xe4, 0xfa, 0x3e, 0xb6, 0xf8, 0x24, 0x3f, 0xbc, 0x63, 0x90,
-	0xfb, 0xfb, 0xe5, 0xf3, 0xb2, 0x9f, 0x71, 0x00, 0x26, 0x50, 0xe8, 0x9f, 0x2d, 0xc1, 0xe4, 0xf2,
-	0x6e, 0xdb, 0xf1, 0x3a, 0x2e, 0xe5, 0x55, 0x4f, 0xc1, 0x86, 0xf1, 0x04, 0x8c, 0x6c, 0x1b, 0x76,
-	0xc3, 0xa2, 0xae, 0xe4, 0xdf, 0xe1, 0xdc, 0xde, 0x10, 0xc5, 0x18, 0xc0, 0xc9, 0x5b, 0x00, 0x5e,
-	0x7d, 0x9b, 0x36, 0x3a, 0x5c, 0x06, 0x14, 0x5f, 0xd9, 0xcd, 0x22, 0xa7, 0x50, 0x6c, 0x8c, 0xb5,
-	0x10, 0xa5, 0x3c, 0x1b, 0xc3, 0xdf, 0xa8, 0x90, 0xd3, 0x7f, 0x4f, 0x83, 0x99, 0x58, 0xbb, 0x53,
-	0x50, 0xcd, 0xb7, 0xe2, 0xaa, 0xf9, 0x42, 0xdf, 0x63, 0xcd, 0xd1, 0xc8, 0x3f, 0x51, 0x82, 0x8b,
-	0x39, 0x73, 0x92, 0x72, 0x44, 0xd3, 0x4e, 0xc9, 0x11, 0xad, 0x03, 0xe3, 0xbe, 0x63, 0xc9, 0x17,
-	0x1a, 0xc1, 0x0c, 0x14, 0x72, 0x33, 0xdb, 0x08, 0xd1, 0x44, 0x6e, 0x66, 0x51, 0x99, 0x87, 0x2a,
-	0x1d, 0xfd, 0x57, 0x34, 0x18, 0x0b, 0x2d, 0x80, 0x5f, 0x57, 0xb7, 0x70, 0xbd, 0x3f, 0xe4, 0xd7,
-	0x7f, 0xbd, 0x04, 0x17, 0x42, 0xdc, 0x01, 0x9b, 0xab, 0xf9, 0x8c, 0x6f, 0x1c, 0x6e, 0x46, 0x78,
-	0x28, 0xe6, 0x22, 0x3b, 0x9a, 0x7e, 0x4d, 0xd1, 0xee, 0xb8, 0x6d, 0xc7, 0x0b, 0x04, 0x2a, 0x21,
-	0x79, 0x8a, 0x22, 0x0c, 0x60, 0x64, 0x0d, 0x86, 0x3c, 0x46, 0x4f, 0x1e, 0x47, 0x47, 0x9c, 0x0d,
-	0x2e, 0x13, 0xf2, 0xfe, 0xa2, 0x40, 0x43, 0xde, 0x52, 0x79, 0xf8, 0x50, 0x71, 0x43, 0x15, 0x1b,
-	0x49, 0x23, 0x14, 0xa9, 0xd2, 0xcf, 0x48, 0x33, 0xcf, 0x84, 0x15, 0x98, 0x96, 0x7e, 0x66, 0x62,
-	0xdb, 0xd8, 0x75, 0x4a, 0xde, 0x1f, 0xdb, 0x19, 0x8f, 0x26, 0xee, 0xe1, 0xcf, 0x25, 0xeb, 0x47,
-	0x3b, 0x46, 0xf7, 0x60, 0xf4, 0xba, 0xec, 0x24, 0x99, 0x83, 0x92, 0x19, 0xac, 0x05, 0x48, 0x1c,
-	0xa5, 0xea, 0x12, 0x96, 0xcc, 0x1e, 0x5c, 0x95, 0xd5, 0x63, 0x69, 0xa0, 0xfb, 0xb1, 0xa4, 0xff,
-	0x61, 0x09, 0xce, 0x05, 0x54, 0x83, 0x31, 0x2e, 0xc9, 0x5b, 0xcc, 0x43, 0xa4, 0xeb, 0xc3, 0xcd,
-	0x4a, 0xb7, 0x60, 0x90, 0x33, 0xc0, 0x42, 0xb7, 0x9b, 0x21, 0x42, 0xd6, 0x1d, 0xe4, 0x88, 0xc8,
-	0x87, 0x61, 0xd8, 0x62, 0xa2, 0x6a, 0xe0, 0x43, 0x5c, 0xc8, 0x08, 0x97, 0x35, 0x5c, 0x21, 0x01,
-	0xcb, 0x18, 0x2a, 0xe1, 0xa5, 0x97, 0x28, 0x44, 0x49, 0x73, 0xee, 0x39, 0x18, 0x57, 0xaa, 0x1d,
-	0x29, 0x80, 0xca, 0xe7, 0x4b, 0x30, 0x7b, 0x83, 0x5a, 0xad, 0xcc, 0x2b, 0xe9, 0x32, 0x0c, 0xd5,
-	0xb7, 0x0d, 0x57, 0xc4, 0xe6, 0x99, 0x10, 0x9b, 0xbc, 0xc2, 0x0a, 0x50, 0x94, 0x93, 0x4d, 0x18,
-	0xe6, 0xa8, 0x82, 0xeb, 0x8a, 0x17, 0x95, 0x99, 0x8c, 0x82, 0x36, 0x7d, 0x47, 0x18, 0xd5, 0x29,
-	0x1a, 0x78, 0xac, 0x02, 0x3b, 0x5e, 0x3e, 0x58, 0xbb, 0xb5, 0x26, 0x94, 0xf1, 0x97, 0x39, 0x46,
-	0x94, 0x98, 0xc9, 0x3d, 0x98, 0x74, 0xea, 0x26, 0xd2, 0xb6, 0xe3, 0x99, 0xbe, 0xe3, 0xee, 0xc9,
-	0x45, 0x2b, 0x74, 0xb4, 0xdc, 0xaa, 0x54, 0x23, 0x44, 0xe2, 0xaa, 0x28, 0x56, 0x84, 0x71, 0x52,
-	0xfa, 0x17, 0x35, 0x18, 0xbf, 0x61, 0x6e, 0x52, 0x57, 0xb8, 0xd2, 0x71, 0x55, 0x3b, 0x16, 0x65,
-	0x66, 0x3c, 0x2b, 0xc2, 0x0c, 0xd9, 0x85, 0x31, 0x79, 0x0e, 0x87, 0x4f, 0x45, 0xae, 0x17, 0x73,
-	0x32, 0x08, 0x49, 0xcb, 0xf3, 0x4d, 0x7d, 0x3f, 0x1e, 0x50, 0xc0, 0x88, 0x98, 0xfe, 0x16, 0x9c,
-	0xcd, 0x68, 0xc4, 0x16, 0x92, 0x7b, 0x93, 0xc9, 0x8f, 0x26, 0xe0, 0x56, 0x6c, 0x21, 0x79, 0x39,
-	0x79, 0x00, 0x06, 0xa8, 0xdd, 0x90, 0x5f, 0xcc, 0xc8, 0xc1, 0x7e, 0x79, 0x60, 0xd9, 0x6e, 0x20,
-	0x2b, 0x63, 0x4c, 0xdc, 0x72, 0x62, 0x12, 0x1b, 0x67, 0xe2, 0x2b, 0xb2, 0x0c, 0x43, 0x28, 0x77,
-	0x0b, 0x49, 0x7a, 0x40, 0x30, 0xe1, 0x7f, 0x7a, 0x2b, 0xc1, 0x5b, 0xfa, 0x71, 0xbc, 0x48, 0xf2,
-	0xa9, 0xc5, 0x59, 0x39, 0x21, 0x29, 0x8e, 0x87, 0x29, 0xba, 0xfa, 0x2f, 0x0d, 0xc2, 0xc3, 0x37,
-	0x1c, 0xd7, 0xbc, 0xe7, 0xd8, 0xbe, 0x61, 0xad, 0x3b, 0x8d, 0xc8, 0x29, 0x4e, 0x1e, 0x59, 0xdf,
-	0xa3, 0xc1, 0xc5, 0x7a, 0xbb, 0x23, 0x94, 0x87, 0xc0, 0xaf, 0x6c, 0x9d, 0xba, 0xa6, 0x53, 0xd4,
-	0x77, 0x9a, 0x47, 0x0c, 0xa9, 0xac, 0xdf, 0xce, 0x42, 0x89, 0x79, 0xb4, 0xb8, 0x0b, 0x77, 0xc3,
-	0xb9, 0x6b, 0xf3, 0xce, 0xd5, 0x7c, 0x3e, 0x9b, 0xf7, 0xa2, 0x45, 0x28, 0xe8, 0xc2, 0xbd, 0x94,
-	0x89, 0x11, 0x73, 0x28, 0x91, 0x8f, 0xc2, 0x79, 0x53, 0x74, 0x0e, 0xa9, 0xd1, 0x30, 0x6d, 0xea,
-	0x79, 0xc2, 0xff, 0xb3, 0x0f, 0x1f, 0xe5, 0x6a, 0x16, 0x42, 0xcc, 0xa6, 0x43, 0x5e, 0x07, 0xf0,
-	0xf6, 0xec, 0xba, 0x9c, 0xff, 0x62, 0xde, 0x6b, 0x42, 0x44, 0x0e, 0xb1, 0xa0, 0x82, 0x91, 0x29,
-	0x5a, 0x7e, 0xb8, 0x29, 0x87, 0xb9, 0x07, 0x22, 0x57, 0xb4, 0xa2, 0x3d, 0x14, 0xc1, 0xf5, 0x7f,
-	0xa0, 0xc1, 0x88, 0x8c, 0x95, 0x44, 0xde, 0x95, 0xb0, 0x22, 0x86, 0x9c, 0x39, 0x61, 0x49, 0xdc,
-	0xe3, 0x57, 0xc9, 0x92, 0xb3, 0x4a, 0x26, 0x59, 0xc8, 0x0c, 0x25, 0x09, 0x47, 0x6c, 0x3a, 0x76,
-	0xa5, 0x1c, 0x98, 0xa8, 0x15, 0x62, 0xfa, 0x17, 0x34, 0x98, 0x49, 0xb5, 0xea, 0x41, 0x9a, 0x3a,
-	0x45, 0x2f, 0xad, 0xdf, 0x19, 0x84, 0x29, 0xee, 0xc0, 0x6d, 0x1b, 0x96, 0x30, 0xf0, 0x9d, 0x82,
-	0xfa, 0xf6, 0x24, 0x8c, 0x99, 0xad, 0x56, 0xc7, 0x67, 0xac, 0x5a, 0xde, 0xd1, 0xf0, 0x35, 0xaf,
-	0x06, 0x85, 0x18, 0xc1, 0x89, 0x2d, 0x05, 0x05, 0xc1, 0xc4, 0x57, 0x8a, 0xad, 0x9c, 0x3a, 0xc0,
-	0x79, 0x76, 0xa8, 0x8b, 0xd3, 0x3c, 0x4b, 0x8e, 0xf8, 0x5e, 0x0d, 0xc0, 0xf3, 0x5d, 0xd3, 0x6e,
-	0xb2, 0x42, 0x29, 0x4c, 0xe0, 0x31, 0x90, 0xad, 0x85, 0x48, 0x05, 0xf1, 0x28, 0x7e, 0x52, 0x08,
-	0x40, 0x85, 0x32, 0x59, 0x90, 0x32, 0x94, 0xe0, 0xf8, 0xef, 0x49, 0x48, 0x8b, 0x0f, 0xa7, 0x83,
-	0x40, 0xca, 0x48, 0x15, 0x91, 0x90, 0x35, 0xf7, 0x2c, 0x8c, 0x85, 0xf4, 0x0e, 0x93, 0x49, 0x26,
-	0x14, 0x99, 0x64, 0xee, 0x05, 0x38, 0x93, 0xe8, 0xee, 0x91, 0x44, 0x9a, 0x7f, 0xaf, 0x01, 0x89,
-	0x8f, 0xfe, 0x14, 0x14, 0xdf, 0x66, 0x5c, 0xf1, 0x5d, 0xec, 0x7f, 0xc9, 0x72, 0x34, 0xdf, 0x9f,
-	0x9d, 0x01, 0x1e, 0x4a, 0x2e, 0x0c, 0xad, 0x28, 0x0f, 0x2e, 0x76, 0xce, 0x46, 0x2f, 0xeb, 0xe4,
-	0x97, 0xdb, 0xc7, 0x39, 0x7b, 0x33, 0x81, 0x2b, 0x3a, 0x67, 0x93, 0x10, 0x4c, 0xd1, 0x25, 0x9f,
-	0xd4, 0x60, 0xda, 0x88, 0x87, 0x92, 0x0b, 0x66, 0xa6, 0x50, 0x50, 0x90, 0x44, 0x58, 0xba, 0xa8,
-	0x2f, 0x09, 0x80, 0x87, 0x29, 0xb2, 0xe4, 0x19, 0x98, 0x30, 0xda, 0xe6, 0x42, 0xa7, 0x61, 0x32,
-	0xc5, 0x29, 0x88, 0xb8, 0xc5, 0x95, 0xf9, 0x85, 0xf5, 0x6a, 0x58, 0x8e, 0xb1, 0x5a, 0x61, 0xcc,
-	0x36, 0x39, 0x91, 0x83, 0x7d, 0xc6, 0x6c, 0x93, 0x73, 0x18, 0xc5, 0x6c, 0x93, 0x53, 0xa7, 0x12,
-	0x21, 0x36, 0x80, 0x63, 0x36, 0xea, 0x92, 0xe4, 0xb0, 0x94, 0xa8, 0x8b, 0x88, 0xb9, 0xd5, 0xa5,
-	0x8a, 0xa4, 0xc8, 0x4f, 0xbf, 0xe8, 0x37, 0x2a, 0x14, 0xc8, 0x67, 0x34, 0x98, 0x94, 0xbc, 0x5b,
-	0xd2, 0x1c, 0xe1, 0x4b, 0xf4, 0x5a, 0xd1, 0xfd, 0x92, 0xd8, 0x93, 0xf3, 0xa8, 0x22, 0x17, 0x7c,
-	0x27, 0x7c, 0x98, 0x19, 0x83, 0x61, 0xbc, 0x1f, 0xe4, 0x6f, 0x6b, 0x70, 0xce, 0x8b, 0x19, 0xe3,
-	0x65, 0x07, 0x47, 0x8b, 0x07, 0x93, 0xaa, 0x65, 0xe0, 0x93, 0x8e, 0xf5, 0x19, 0x10, 0xcc, 0xa4,
-	0xcf, 0xc4, 0xb2, 0x33, 0x77, 0x0d, 0xbf, 0xbe, 0x5d, 0x31, 0xea, 0xdb, 0xfc, 0x2e, 0x46, 0x3c,
-	0xd0, 0x29, 0xb8, 0xaf, 0x5f, 0x89, 0xa3, 0x12, 0x5e, 0x0d, 0x89, 0x42, 0x4c, 0x12, 0x24, 0x0e,
-	0x8c, 0xba, 0x32, 0x9e, 0xaa, 0x7c, 0x59, 0x58, 0x2c, 0x84, 0x68, 0x32, 0x38, 0xab, 0x10, 0xec,
-	0x83, 0x5f, 0x18, 0x12, 0x21, 0x4d, 0x78, 0x58, 0xa8, 0x36, 0x0b, 0xb6, 0x63, 0xef, 0xb5, 0x9c,
-	0x8e, 0xb7, 0xd0, 0xf1, 0xb7, 0xa9, 0xed, 0x07, 0x96, 0xdc, 0x71, 0x7e, 0x8c, 0xf2, 0x87, 0x22,
-	0xcb, 0xdd, 0x2a, 0x62, 0x77, 0x3c, 0xe4, 0x55, 0x18, 0xa5, 0x3b, 0xd4, 0xf6, 0x37, 0x36, 0x56,
-	0xf8, 0x5b, 0x9f, 0xa3, 0x4b, 0x7b, 0x7c, 0x08, 0xcb, 0x12, 0x07, 0x86, 0xd8, 0xc8, 0x1d, 0x18,
-	0xb1, 0x44, 0x40, 0x5c, 0xfe, 0xe6, 0xa7, 0x20, 0x53, 0x4c, 0x06, 0xd7, 0x15, 0xfa, 0x9f, 0xfc,
-	0x81, 0x01, 0x05, 0xd2, 0x86, 0xcb, 0x0d, 0xba, 0x65, 0x74, 0x2c, 0x7f, 0xcd, 0xf1, 0x91, 0xbf,
-	0xca, 0x08, 0x0d, 0x76, 0xc1, 0xb3, 0xae, 0x29, 0x1e, 0xf7, 0x85, 0xbf, 0x77, 0x59, 0x3a, 0xa4,
-	0x2e, 0x1e, 0x8a, 0x8d, 0xec, 0xc1, 0x23, 0xb2, 0x0e, 0x7f, 0x06, 0x52, 0xdf, 0x66, 0xb3, 0x9c,
-	0x26, 0x7a, 0x86, 0x13, 0xfd, 0x7f, 0x0e, 0xf6, 0xcb, 0x8f, 0x2c, 0x1d, 0x5e, 0x1d, 0x7b, 0xc1,
-	0xc9, 0x3d, 0xeb, 0x69, 0xe2, 0x06, 0x63, 0x76, 0xba, 0xf8, 0x1c, 0x27, 0x6f, 0x43, 0x84, 0xeb,
-	0x4d, 0xb2, 0x14, 0x53, 0x34, 0xc9, 0xcf, 0x68, 0x30, 0xeb, 0xf9, 0x6e, 0xa7, 0xee, 0x77, 0x5c,
-	0xda, 0x48, 0xec, 0xd0, 0x19, 0xde, 0xa1, 0x42, 0x02, 0x5c, 0x2d, 0x07, 0x27, 0x7f, 0x60, 0x38,
-	0x9b, 0x07, 0xc5, 0xdc, 0xbe, 0x90, 0xbf, 0xa3, 0xc1, 0xc5, 0x38, 0x90, 0xa9, 0xa4, 0xa2, 0x9f,
-	0xa4, 0xf8, 0x1d, 0x41, 0x2d, 0x1b, 0xa5, 0x50, 0x40, 0x73, 0x80, 0x98, 0xd7, 0x91, 0xb9, 0x0f,
-	0x00, 0x49, 0xb3, 0xef, 0xc3, 0xe4, 0xb0, 0x51, 0x55, 0x0e, 0xfb, 0xdc, 0x10, 0x3c, 0xc8, 0x4e,
-	0x85, 0x48, 0xfb, 0x58, 0x35, 0x6c, 0xa3, 0xf9, 0xf5, 0x29, 0xb1, 0x7c, 0x51, 0x83, 0x8b, 0xdb,
-	0xd9, 0x96, 0x01, 0xa9, 0xff, 0x7c, 0xa8, 0x90, 0x05, 0xa7, 0x9b, 0xb1, 0x41, 0x30, 0xcc, 0xae,
-	0x55, 0x30, 0xaf, 0x53, 0xe4, 0x03, 0x30, 0x6d, 0x3b, 0x0d, 0x5a, 0xa9, 0x2e, 0xe1, 0xaa, 0xe1,
-	0xdd, 0xa9, 0x05, 0x0e, 0x03, 0x43, 0xe2, 0x7b, 0x59, 0x4b, 0xc0, 0x30, 0x55, 0x9b, 0xec, 0x00,
-	0x69, 0x3b, 0x8d, 0xe5, 0x1d, 0x11, 0xb8, 0xb9, 0x3f, 0xf7, 0x38, 0x7e, 0x1d, 0xbc, 0x9e, 0xc2,
-	0x86, 0x19, 0x14, 0xb8, 0x69, 0x83, 0x75, 0x66, 0xd5, 0xb1, 0x4d, 0xdf, 0x71, 0xf9, 0x93, 0xd5,
-	0xbe, 0x34, 0x7c, 0x6e, 0xda, 0x58, 0xcb, 0xc4, 0x88, 0x39, 0x94, 0xf4, 0xff, 0xae, 0xc1, 0x19,
-	0xb6, 0x2d, 0xd6, 0x5d, 0x67, 0x77, 0xef, 0xeb, 0x71, 0x43, 0x3e, 0x21, 0x7d, 0xa7, 0x84, 0x49,
-	0xee, 0xbc, 0xe2, 0x37, 0x35, 0xc6, 0xfb, 0x1c, 0xb9, 0x4a, 0xa9, 0x56, 0xc9, 0x81, 0x7c, 0xab,
-	0xa4, 0xfe, 0x99, 0x92, 0xd0, 0x1c, 0x02, 0xab, 0xe0, 0xd7, 0xe5, 0x77, 0xf8, 0x2c, 0x4c, 0xb2,
-	0xb2, 0x55, 0x63, 0x77, 0x7d, 0xe9, 0x65, 0xc7, 0x0a, 0x5e, 0x00, 0x72, 0x53, 0xed, 0x4d, 0x15,
-	0x80, 0xf1, 0x7a, 0xe4, 0x2a, 0x8c, 0xb4, 0x45, 0xfc, 0x13, 0xa9, 0xb3, 0x5e, 0x16, 0x0e, 0x46,
-	0xbc, 0xe8, 0xfe, 0x7e, 0x79, 0x26, 0xba, 0x21, 0x0c, 0xa2, 0xb0, 0x04, 0x0d, 0xf4, 0xbf, 0x3a,
-	0x0b, 0x1c, 0xb9, 0x45, 0xfd, 0xaf, 0xc7, 0x39, 0x79, 0x0a, 0xc6, 0xeb, 0xed, 0x4e, 0xe5, 0x5a,
-	0xed, 0x43, 0x1d, 0x87, 0xdb, 0x22, 0x78, 0x7c, 0x73, 0xa6, 0x4a, 0x54, 0xd6, 0x6f, 0x07, 0xc5,
-	0xa8, 0xd6, 0x61, 0xdc, 0xa1, 0xde, 0xee, 0x48, 0x7e, 0xbb, 0xae, 0xba, 0xb6, 0x73, 0xee, 0x50,
-	0x59, 0xbf, 0x1d, 0x83, 0x61, 0xaa, 0x36, 0xf9, 0x28, 0x4c, 0x50, 0xf9, 0xe1, 0xde, 0x30, 0xdc,
-	0x86, 0xe4, 0x0b, 0xd5, 0xa2, 0x83, 0x0f, 0xa7, 0x36, 0xe0, 0x06, 0x42, 0x03, 0x5b, 0x56, 0x48,
-	0x60, 0x8c, 0x20, 0xf9, 0x56, 0x78, 0x20, 0xf8, 0xcd, 0x56, 0xd9, 0x69, 0x24, 0x19, 0xc5, 0x90,
-	0x08, 0x07, 0xb1, 0x9c, 0x57, 0x09, 0xf3, 0xdb, 0x93, 0x9f, 0xd3, 0xe0, 0x42, 0x08, 0x35, 0x6d,
-	0xb3, 0xd5, 0x69, 0x21, 0xad, 0x5b, 0x86, 0xd9, 0x92, 0x7a, 0xd7, 0x2b, 0xc7, 0x36, 0xd0, 0x38,
-	0x7a, 0xc1, 0xac, 0xb2, 0x61, 0x98, 0xd3, 0x25, 0xf2, 0x05, 0x0d, 0x2e, 0x07, 0xa0, 0x75, 0x97,
-	0x7a, 0x5e, 0xc7, 0xa5, 0xd1, 0xfb, 0x53, 0x39, 0x25, 0x23, 0x85, 0x78, 0x27, 0x17, 0x40, 0x97,
-	0x0f, 0xc1, 0x8d, 0x87, 0x52, 0x57, 0xb7, 0x4b, 0xcd, 0xd9, 0xf2, 0xa5, 0xa2, 0x76, 0x52, 0xdb,
-	0x85, 0x91, 0xc0, 0x18, 0x41, 0xf2, 0x8f, 0x34, 0xb8, 0xa8, 0x16, 0xa8, 0xbb, 0x45, 0x68, 0x68,
-	0xaf, 0x1e, 0x5b, 0x67, 0x12, 0xf8, 0x85, 0x84, 0x95, 0x03, 0xc4, 0xbc, 0x5e, 0x31, 0xb6, 0xdd,
-	0xe2, 0x1b, 0x53, 0x68, 0x71, 0x43, 0x82, 0x6d, 0x8b, 0xbd, 0xea, 0x61, 0x00, 0x23, 0xcf, 0xc0,
-	0x44, 0xdb, 0x69, 0xac, 0x9b, 0x0d, 0x6f, 0xc5, 0x6c, 0x99, 0x3e, 0xd7, 0xb5, 0x06, 0xc4, 0x74,
-	0xac, 0x3b, 0x8d, 0xf5, 0xea, 0x92, 0x28, 0xc7, 0x58, 0x2d, 0x32, 0x0f, 0xb0, 0x65, 0x98, 0x56,
-	0xed, 0xae, 0xd1, 0xbe, 0x15, 0x84, 0x39, 0xe0, 0xb6, 0x80, 0x6b, 0x61, 0x29, 0x2a, 0x35, 0xd8,
-	0xfa, 0x31, 0xbe, 0x83, 0x54, 0x84, 0x9a, 0xe4, 0xea, 0xc9, 0x71, 0xac, 0x5f, 0x80, 0x50, 0x74,
-	0xf8, 0xa6, 0x42, 0x02, 0x63, 0x04, 0xc9, 0xf7, 0x68, 0x30, 0xe5, 0xed, 0x79, 0x3e, 0x6d, 0x85,
-	0x7d, 0x38, 0x73, 0xdc, 0x7d, 0xe0, 0x36, 0xe9, 0x5a, 0x8c, 0x08, 0x26, 0x88, 0xf2, 0x80, 0x11,
-	0x2d, 0xa3, 0x49, 0xaf, 0x57, 0x6e, 0x98, 0xcd, 0xed, 0x30, 0xa2, 0xc0, 0x3a, 0x75, 0xeb, 0xd4,
-	0xf6, 0xb9, 0x62, 0x33, 0x24, 0x03, 0x46, 0xe4, 0x57, 0xc3, 0x6e, 0x38, 0xc8, 0xeb, 0x30, 0x27,
-	0xc1, 0x2b, 0xce, 0xdd, 0x14, 0x85, 0x19, 0x4e, 0x81, 0xbb, 0xb8, 0x55, 0x73, 0x6b, 0x61, 0x17,
-	0x0c, 0xa4, 0x0a, 0x67, 0x3d, 0xea, 0xf2, 0x2b, 0x25, 0x11, 0xe9, 0x6a, 0xbd, 0x63, 0x59, 0x1e,
-	0x57, 0x2d, 0xa4, 0x7b, 0x7f, 0x2d, 0x0d, 0xc6, 0xac, 0x36, 0xe4, 0x85, 0xf0, 0x05, 0xe1, 0x1e,
-	0x2b, 0xf8, 0xd0, 0x7a, 0x6d, 0xf6, 0x2c, 0xef, 0xdf, 0x59, 0xe5, 0x61, 0x60, 0x00, 0xc2, 0x64,
-	0x5d, 0x76, 0x9a, 0x07, 0x45, 0x8b, 0x1d, 0xd7, 0xf3, 0x67, 0xcf, 0xf1, 0xc6, 0xfc, 0x34, 0x47,
-	0x15, 0x80, 0xf1, 0x7a, 0xe4, 0x2a, 0x4c, 0x79, 0xb4, 0x5e, 0x77, 0x5a, 0x6d, 0xa9, 0xa7, 0xce,
-	0x9e, 0xe7, 0xbd, 0x17, 0x2b, 0x18, 0x83, 0x60, 0xa2, 0x26, 0xd9, 0x83, 0xb3, 0x61, 0xd8, 0xbc,
-	0x15, 0xa7, 0xb9, 0x6a, 0xec, 0x72, 0xe1, 0xf8, 0xc2, 0xe1, 0xfc, 0x71, 0x3e, 0xf0, 0xa0, 0x98,
-	0xff, 0x50, 0xc7, 0xb0, 0x7d, 0xd3, 0xdf, 0x13, 0xd3, 0x55, 0x49, 0xa3, 0xc3, 0x2c, 0x1a, 0x64,
-	0x05, 0xce, 0x25, 0x8a, 0xaf, 0x99, 0x16, 0xf5, 0x66, 0x2f, 0xf2, 0x61, 0x73, 0x63, 0x53, 0x25,
-	0x03, 0x8e, 0x99, 0xad, 0xc8, 0x2d, 0x38, 0xdf, 0x76, 0x1d, 0x9f, 0xd6, 0xfd, 0x9b, 0x4c, 0x20,
-	0xb0, 0xe4, 0x00, 0xbd, 0xd9, 0x59, 0x3e, 0x17, 0xfc, 0x3a, 0x6d, 0x3d, 0xab, 0x02, 0x66, 0xb7,
-	0x23, 0x9f, 0xd3, 0xe0, 0x92, 0xe7, 0xbb, 0xd4, 0x68, 0x99, 0x76, 0xb3, 0xe2, 0xd8, 0x36, 0xe5,
-	0x8c, 0xa9, 0xda, 0x88, 0x5e, 0xc7, 0x3c, 0x50, 0xe8, 0x14, 0xd1, 0x0f, 0xf6, 0xcb, 0x97, 0x6a,
-	0x5d, 0x31, 0xe3, 0x21, 0x94, 0xc9, 0x5b, 0x00, 0x2d, 0xda, 0x72, 0xdc, 0x3d, 0xc6, 0x91, 0x66,
-	0xe7, 0x8a, 0xeb, 0xc1, 0xab, 0x21, 0x16, 0xf1, 0xf9, 0xc7, 0x2e, 0x02, 0x23, 0x20, 0x2a, 0xe4,
-	0xf4, 0xfd, 0x12, 0x9c, 0xcf, 0x64, 0xf5, 0xec, 0x0b, 0x10, 0xf5, 0x16, 0x82, 0x24, 0x0c, 0xf2,
-	0xee, 0x8c, 0x7f, 0x01, 0xab, 0x71, 0x10, 0x26, 0xeb, 0x32, 0x41, 0x8c, 0x7f, 0xa9, 0xd7, 0x6a,
-	0x51, 0xfb, 0x52, 0x24, 0x88, 0x55, 0x13, 0x30, 0x4c, 0xd5, 0x26, 0x15, 0x98, 0x91, 0x65, 0x55,
-	0xa6, 0xcb, 0x78, 0xd7, 0x5c, 0x1a, 0x88, 0xb8, 0x4c, 0x2b, 0x98, 0xa9, 0x26, 0x81, 0x98, 0xae,
-	0xcf, 0x46, 0xc1, 0x7e, 0xa8, 0xbd, 0x18, 0x8c, 0x46, 0xb1, 0x16, 0x07, 0x61, 0xb2, 0x6e, 0xa0,
-	0x6c, 0xc6, 0xba, 0x30, 0x14, 0x8d, 0x62, 0x2d, 0x01, 0xc3, 0x54, 0x6d, 0xfd, 0x3f, 0x0c, 0xc2,
-	0x23, 0x3d, 0x88, 0x47, 0xa4, 0x95, 0x3d, 0xdd, 0x47, 0xff, 0x70, 0x7b, 0x5b, 0x9e, 0x76, 0xce,
-	0xf2, 0x1c, 0x9d, 0x5e, 0xaf, 0xcb, 0xe9, 0xe5, 0x2d, 0xe7, 0xd1, 0x49, 0xf6, 0xbe, 0xfc, 0xad,
-	0xec, 0xe5, 0x2f, 0x38, 0xab, 0x87, 0x6e, 0x97, 0x76, 0xce, 0x76, 0x29, 0x38, 0xab, 0x3d, 0x6c,
-	0xaf, 0xdf, 0x1f, 0x84, 0x47, 0x7b, 0x11, 0xd5, 0x0a, 0xee, 0xaf, 0x0c, 0x96, 0x77, 0xa2, 0xfb,
-	0x2b, 0xef, 0x01, 0xe2, 0x09, 0xee, 0xaf, 0x0c, 0x92, 0x27, 0xbd, 0xbf, 0xf2, 0x66, 0xf5, 0xa4,
-	0xf6, 0x57, 0xde, 0xac, 0xf6, 0xb0, 0xbf, 0xfe, 0x34, 0x79, 0x3e, 0x84, 0xf2, 0x62, 0x15, 0x06,
-	0xea, 0xed, 0x4e, 0x41, 0x26, 0xc5, 0x3d, 0xad, 0x2a, 0xeb, 0xb7, 0x91, 0xe1, 0x20, 0x08, 0xc3,
-	0x62, 0xff, 0x14, 0x64, 0x41, 0xdc, 0x7b, 0x4e, 0x6c, 0x49, 0x94, 0x98, 0xd8, 0x54, 0xd1, 0xf6,
-	0x36, 0x6d, 0x51, 0xd7, 0xb0, 0x6a, 0xbe, 0xe3, 0x1a, 0xcd, 0xa2, 0xdc, 0x46, 0x98, 0xe1, 0x13,
-	0xb8, 0x30, 0x85, 0x9d, 0x4d, 0x48, 0xdb, 0x6c, 0x14, 0xe4, 0x2f, 0x7c, 0x42, 0xd6, 0xab, 0x4b,
-	0xc8, 0x70, 0xe8, 0x5f, 0x1e, 0x05, 0x25, 0x72, 0x2c, 0xf9, 0x94, 0x06, 0x33, 0xf5, 0x64, 0x30,
-	0xb3, 0x7e, 0x9c, 0x6a, 0x52, 0x91, 0xd1, 0xc4, 0x96, 0x4f, 0x15, 0x63, 0x9a, 0x2c, 0xf9, 0x2e,
-	0x4d, 0x58, 0xaa, 0xc2, 0x2b, 0x21, 0x39, 0xad, 0xd7, 0x8f, 0xe9, 0xf2, 0x34, 0x32, 0x79, 0x45,
-	0xf7, 0x74, 0x71, 0x82, 0xe4, 0x0b, 0x1a, 0x9c, 0xbf, 0x93, 0x65, 0x60, 0x97, 0x93, 0x7f, 0xab,
-	0x68, 0x57, 0x72, 0x2c, 0xf6, 0x42, 0xe2, 0xcc, 0xac, 0x80, 0xd9, 0x1d, 0x09, 0x67, 0x29, 0xb4,
-	0x39, 0xca, 0xef, 0xb4, 0xf0, 0x2c, 0x25, 0x8c, 0x97, 0xd1, 0x2c, 0x85, 0x00, 0x8c, 0x13, 0x24,
-	0x6d, 0x18, 0xbb, 0x13, 0x18, 0x7a, 0xa5, 0x71, 0xa7, 0x52, 0x94, 0xba, 0x62, 0x2d, 0x16, 0x4e,
-	0x43, 0x61, 0x21, 0x46, 0x44, 0xc8, 0x36, 0x8c, 0xdc, 0x11, 0xbc, 0x42, 0x1a, 0x65, 0x16, 0xfa,
-	0x56, 0x61, 0x85, 0x6d, 0x40, 0x16, 0x61, 0x80, 0x5e, 0xf5, 0xa7, 0x1e, 0x3d, 0xe4, 0x99, 0xcf,
-	0xe7, 0x34, 0x38, 0xbf, 0x43, 0x5d, 0xdf, 0xac, 0x27, 0xaf, 0x37, 0xc6, 0x8a, 0xab, 0xd9, 0x2f,
-	0x67, 0x21, 0x14, 0xdb, 0x24, 0x13, 0x84, 0xd9, 0x5d, 0x60, 0x4a, 0xb7, 0xb0, 0x52, 0xd7, 0x7c,
-	0xc3, 0x37, 0xeb, 0x1b, 0xce, 0x1d, 0x6a, 0x47, 0x99, 0xe4, 0xb8, 0x79, 0x44, 0x46, 0x69, 0x5c,
-	0xce, 0xaf, 0x86, 0xdd, 0x70, 0xe8, 0x7f, 0xa4, 0x41, 0xca, 0xd6, 0x4a, 0x7e, 0x48, 0x83, 0x89,
-	0x2d, 0x6a, 0xf8, 0x1d, 0x97, 0x5e, 0x37, 0xfc, 0x30, 0x7a, 0xc3, 0xcb, 0xc7, 0x61, 0xe2, 0x9d,
-	0xbf, 0xa6, 0x20, 0x16, 0xce, 0x0f, 0x61, 0x60, 0x68, 0x15, 0x84, 0xb1, 0x1e, 0xcc, 0xbd, 0x04,
-	0x33, 0xa9, 0x86, 0x47, 0xba, 0x76, 0xfb, 0xe7, 0x1a, 0x64, 0xe5, 0x9a, 0x24, 0xaf, 0xc3, 0x90,
-	0xd1, 0x68, 0x84, 0x69, 0x9a, 0x9e, 0x2b, 0xe6, 0x87, 0xd3, 0x50, 0x83, 0x64, 0xf0, 0x9f, 0x28,
-	0xd0, 0x92, 0x6b, 0x40, 0x8c, 0xd8, 0x3d, 0xe7, 0x6a, 0xf4, 0xf4, 0x9b, 0x5f, 0x0f, 0x2d, 0xa4,
-	0xa0, 0x98, 0xd1, 0x42, 0xff, 0x84, 0x06, 0x24, 0x1d, 0x4a, 0x9c, 0xb8, 0x30, 0x2a, 0xb7, 0x72,
-	0xb0, 0x4a, 0x4b, 0x05, 0x1f, 0x17, 0xc5, 0x5e, 0xca, 0x45, 0x4e, 0x5d, 0xb2, 0xc0, 0xc3, 0x90,
-	0x8e, 0xfe, 0x17, 0x1a, 0x44, 0xa9, 0x5c, 0xc8, 0x7b, 0x61, 0xbc, 0x41, 0xbd, 0xba, 0x6b, 0xb6,
-	0xfd, 0xe8, 0x5d, 0x5d, 0xf8, 0x3e, 0x67, 0x29, 0x02, 0xa1, 0x5a, 0x8f, 0xe8, 0x30, 0xec, 0x1b,
-	0xde, 0x9d, 0xea, 0x92, 0xd4, 0xfb, 0xf8, 0x29, 0xbd, 0xc1, 0x4b, 0x50, 0x42, 0xa2, 0xf0, 0x7b,
-	0x03, 0x3d, 0x84, 0xdf, 0x23, 0x5b, 0xc7, 0x10, 0x6b, 0x90, 0x1c, 0x1e, 0x67, 0x50, 0xff, 0xe9,
-	0x12, 0x9c, 0x61, 0x55, 0x56, 0x0d, 0xd3, 0xf6, 0xa9, 0xcd, 0x5f, 0x91, 0x14, 0x9c, 0x84, 0x26,
-	0x4c, 0xfa, 0xb1, 0x67, 0x96, 0x47, 0x7f, 0x63, 0x18, 0x7a, 0x0e, 0xc5, 0x1f, 0x57, 0xc6, 0xf1,
-	0x92, 0xe7, 0x82, 0x67, 0x3c, 0x42, 0x43, 0x7e, 0x24, 0xd8, 0xaa, 0xfc, 0x6d, 0xce, 0x7d, 0xf9,
-	0x66, 0x35, 0xcc, 0xff, 0x13, 0x7b, 0xb1, 0xf3, 0x2c, 0x4c, 0x4a, 0x87, 0x71, 0x11, 0x47, 0x51,
-	0x6a, 0xc8, 0xfc, 0x84, 0xb9, 0xa6, 0x02, 0x30, 0x5e, 0x4f, 0xff, 0xed, 0x12, 0xc4, 0xb3, 0x0c,
-	0x15, 0x9d, 0xa5, 0x74, 0x10, 0xc9, 0xd2, 0x89, 0x05, 0x91, 0x7c, 0x37, 0x4f, 0xd1, 0x27, 0x32,
-	0xcc, 0x8a, 0x7b, 0x63, 0x35, 0xb1, 0x9e, 0xc8, 0x0f, 0x1b, 0xd6, 0x88, 0xa6, 0x75, 0xf0, 0xc8,
-	0xd3, 0xfa, 0x5e, 0xe9, 0x49, 0x3a, 0x14, 0x0b, 0xe5, 0x19, 0x78, 0x92, 0xce, 0xc4, 0x1a, 0x2a,
-	0x8f, 0x8e, 0xd6, 0xe0, 0x9d, 0x2b, 0x8e, 0xd1, 0x58, 0x34, 0x2c, 0xb6, 0xef, 0x5c, 0xe9, 0xa3,
-	0xe5, 0xf1, 0x13, 0x76, 0xdd, 0x75, 0x7c, 0xa7, 0xee, 0x58, 0xec, 0xfc, 0x33, 0x2c, 0xcb, 0xb9,
-	0x9b, 0xce, 0xfa, 0xbb, 0x20, 0x8a, 0x31, 0x80, 0xeb, 0x5f, 0xd6, 0x60, 0x44, 0xc6, 0xe3, 0xef,
-	0xe1, 0x91, 0xdc, 0x16, 0x0c, 0x71, 0x2d, 0xa7, 0x1f, 0xe9, 0xb2, 0xb6, 0xed, 0x38, 0x7e, 0x2c,
-	0x2b, 0x01, 0x7f, 0x77, 0x21, 0xb2, 0x14, 0x09, 0xf4, 0xdc, 0x39, 0xd1, 0xad, 0x6f, 0x9b, 0x3e,
-	0xe5, 0x3e, 0x18, 0x72, 0xd7, 0x0a, 0xe7, 0x44, 0xa5, 0x1c, 0x63, 0xb5, 0xf4, 0xcf, 0x0f, 0xc2,
-	0x65, 0x89, 0x38, 0x25, 0x72, 0x85, 0x0c, 0x73, 0x0f, 0xce, 0xca, 0xbd, 0xb2, 0xe4, 0x1a, 0x66,
-	0x78, 0xbf, 0x5f, 0x4c, 0xdb, 0x95, 0x59, 0xb4, 0x53, 0xe8, 0x30, 0x8b, 0x86, 0x08, 0x3f, 0xcb,
-	0x8b, 0x6f, 0x50, 0xc3, 0xf2, 0xb7, 0x03, 0xda, 0xa5, 0x7e, 0xc2, 0xcf, 0xa6, 0xf1, 0x61, 0x26,
-	0x15, 0xee, 0x5f, 0x20, 0x01, 0x15, 0x97, 0x1a, 0xaa, 0x73, 0x43, 0x1f, 0x4f, 0x27, 0x56, 0x33,
-	0x31, 0x62, 0x0e, 0x25, 0x6e, 0x36, 0x34, 0x76, 0xb9, 0x15, 0x02, 0xa9, 0xef, 0x9a, 0x3c, 0xbb,
-	0x44, 0x68, 0x38, 0x5f, 0x8d, 0x83, 0x30, 0x59, 0x97, 0x5c, 0x85, 0x29, 0xee, 0xaf, 0x11, 0x85,
-	0xa1, 0x1b, 0x8a, 0x22, 0x9d, 0xac, 0xc5, 0x20, 0x98, 0xa8, 0xa9, 0x7f, 0xac, 0x04, 0x13, 0x47,
-	0xcc, 0x38, 0xd5, 0x51, 0x0e, 0xd7, 0x3e, 0xde, 0x2b, 0xa9, 0x54, 0x7b, 0x38, 0x5f, 0xc9, 0xab,
-	0x30, 0xd5, 0xe1, 0x1c, 0x29, 0x08, 0xa5, 0x23, 0xf7, 0xff, 0x37, 0xb2, 0x51, 0xde, 0x8e, 0x41,
-	0xee, 0xef, 0x97, 0xe7, 0x54, 0xf4, 0x71, 0x28, 0x26, 0xf0, 0xe8, 0x9f, 0x1e, 0x80, 0xb3, 0x19,
-	0xbd, 0xe1, 0xf7, 0xfa, 0x34, 0x21, 0x02, 0xf4, 0x73, 0xaf, 0x9f, 0x12, 0x27, 0xc2, 0x7b, 0xfd,
-	0x24, 0x04, 0x53, 0x74, 0xc9, 0xcb, 0x30, 0x50, 0x77, 0x4d, 0x39, 0xe1, 0xcf, 0x16, 0x52, 0x60,
-	0xb1, 0xba, 0x38, 0x2e, 0x29, 0x0e, 0x54, 0xb0, 0x8a, 0x0c, 0x21, 0x3b, 0xc8, 0x54, 0x76, 0x11,
-	0x48, 0x15, 0xfc, 0x20, 0x53, 0xb9, 0x8a, 0x87, 0xf1, 0x7a, 0xe4, 0x55, 0x98, 0x95, 0x9a, 0x45,
-	0xf0, 0xfa, 0xde, 0xb1, 0x3d, 0x9f, 0x7d, 0xd9, 0xbe, 0x64, 0xfc, 0xdc, 0xe5, 0xed, 0x66, 0x4e,
-	0x1d, 0xcc, 0x6d, 0xad, 0xff, 0xc9, 0x00, 0xa8, 0x89, 0xd2, 0xc8, 0x6a, 0x3f, 0x56, 0x93, 0x68,
-	0xc4, 0x81, 0xe5, 0x64, 0x15, 0x06, 0x9a, 0xed, 0x4e, 0x41, 0xb3, 0x49, 0x88, 0xee, 0x3a, 0x43,
-	0xd7, 0x6c, 0x77, 0xc8, 0xcb, 0xa1, 0x21, 0xa6, 0x98, 0xa9, 0x24, 0x7c, 0x0d, 0x94, 0x30, 0xc6,
-	0x04, 0x1f, 0xe2, 0x60, 0xee, 0x87, 0xd8, 0x82, 0x11, 0x4f, 0x5a, 0x69, 0x86, 0x8a, 0x47, 0x8c,
-	0x52, 0x66, 0x5a, 0x5a, 0x65, 0x84, 0xfe, 0x18, 0x18, 0x6d, 0x02, 0x1a, 0x4c, 0x36, 0xed, 0xf0,
-	0x17, 0xd8, 0x5c, 0x31, 0x1e, 0x15, 0xb2, 0xe9, 0x6d, 0x5e, 0x82, 0x12, 0x92, 0x3a, 0xa2, 0x46,
-	0x7a, 0x3a, 0xa2, 0xbe, 0xaf, 0x04, 0x24, 0xdd, 0x0d, 0xf2, 0x08, 0x0c, 0xf1, 0x08, 0x0e, 0x92,
-	0x17, 0x85, 0x9a, 0x04, 0x7f, 0xc3, 0x8f, 0x02, 0x46, 0x6a, 0x32, 0xfe, 0x4d, 0xb1, 0xe5, 0xe4,
-	0x8e, 0x31, 0x92, 0x9e, 0x12, 0x2c, 0xe7, 0x72, 0xec, 0x41, 0x4b, 0xd6, 0x99, 0x7f, 0x1b, 0x46,
-	0x5a, 0xa6, 0xcd, 0xef, 0x0a, 0x8b, 0x19, 0xaf, 0xc4, 0xfd, 0xbd, 0x40, 0x81, 0x01, 0x2e, 0xfd,
-	0xf7, 0x4b, 0x6c, 0xeb, 0x47, 0x12, 0xf4, 0x1e, 0x80, 0xd1, 0xf1, 0x1d, 0xc1, 0xc0, 0xe4, 0x17,
-	0x50, 0x2d, 0xb6, 0xca, 0x21, 0xd2, 0x85, 0x10, 0xa1, 0xb8, 0xe5, 0x8a, 0x7e, 0xa3, 0x42, 0x8c,
-	0x91, 0xf6, 0xcd, 0x16, 0x7d, 0xc5, 0xb4, 0x1b, 0xce, 0x5d, 0x39, 0xbd, 0xfd, 0x92, 0xde, 0x08,
-	0x11, 0x0a, 0xd2, 0xd1, 0x6f, 0x54, 0x88, 0x31, 0xd6, 0xc2, 0x15, 0x71, 0x9b, 0xa7, 0xa7, 0x92,
-	0x7d, 0x13, 0x89, 0xd9, 0xa4, 0xd3, 0x1a, 0x67, 0x2d, 0x95, 0x9c, 0x3a, 0x98, 0xdb, 0x5a, 0xff,
-	0x39, 0x0d, 0xce, 0x67, 0x4e, 0x05, 0xb9, 0x0e, 0x33, 0x91, 0x2f, 0x95, 0xca, 0xec, 0x47, 0xa3,
-	0x9c, 0x6b, 0x37, 0x93, 0x15, 0x30, 0xdd, 0x86, 0x54, 0x43, 0x51, 0x4a, 0x3d, 0x4c, 0xa4, 0x23,
-	0x96, 0x2a, 0x1a, 0xa9, 0x60, 0xcc, 0x6a, 0xa3, 0x7f, 0x6b, 0xac, 0xb3, 0xd1, 0x64, 0xb1, 0x2f,
-	0x63, 0x93, 0x36, 0xc3, 0x07, 0x85, 0xe1, 0x97, 0xb1, 0xc8, 0x0a, 0x51, 0xc0, 0xc8, 0xc3, 0xea,
-	0x33, 0xdd, 0x90, 0x6f, 0x05, 0x4f, 0x75, 0xf5, 0xef, 0x80, 0x8b, 0x39, 0x97, 0x9f, 0x64, 0x09,
-	0x26, 0xbc, 0xbb, 0x46, 0x7b, 0x91, 0x6e, 0x1b, 0x3b, 0xa6, 0x0c, 0x8a, 0x21, 0x7c, 0xe4, 0x26,
-	0x6a, 0x4a, 0xf9, 0xfd, 0xc4, 0x6f, 0x8c, 0xb5, 0xd2, 0x7d, 0x00, 0xe9, 0x4b, 0x69, 0xda, 0x4d,
-	0xb2, 0x05, 0xa3, 0x86, 0x45, 0x5d, 0x3f, 0x8a, 0x6f, 0xf7, 0xcd, 0x85, 0x8c, 0x0a, 0x12, 0x87,
-	0xf0, 0xdd, 0x0f, 0x7e, 0x61, 0x88, 0x5b, 0xff, 0x7b, 0x1a, 0x5c, 0xc8, 0x0e, 0x83, 0xd0, 0x83,
-	0x68, 0xd3, 0x82, 0x71, 0x37, 0x6a, 0x26, 0x37, 0xfd, 0xfb, 0xd4, 0x48, 0xc2, 0x4a, 0xe8, 0x3c,
-	0x26, 0xf6, 0x55, 0x5c, 0xc7, 0x0b, 0x56, 0x3e, 0x19, 0x5c, 0x38, 0x54, 0xe1, 0x94, 0x9e, 0xa0,
-	0x8a, 0x9f, 0x07, 0xfa, 0x66, 0xd4, 0xbd, 0xb6, 0x51, 0xa7, 0x8d, 0x53, 0x4e, 0xd4, 0x77, 0x0c,
-	0xd1, 0x75, 0xb3, 0xfb, 0x7e, 0xb2, 0x81, 0xbe, 0x73, 0x68, 0x1e, 0x1e, 0xe8, 0x3b, 0xbb, 0xe1,
-	0xdb, 0x24, 0x02, 0x6d, 0x76, 0xe7, 0x73, 0x5e, 0xfd, 0x7d, 0x7a, 0x38, 0x6f, 0xb4, 0x47, 0xcc,
-	0xf6, 0xb7, 0x73, 0x82, 0xd9, 0xfe, 0xa6, 0xfe, 0x26, 0xd3, 0x5f, 0x46, 0xa6, 0xbf, 0x44, 0xf6,
-	0xb9, 0xe1, 0x53, 0xca, 0x3e, 0xf7, 0x26, 0x0c, 0xb7, 0x0d, 0x97, 0xda, 0xc1, 0x1d, 0x44, 0xb5,
-	0xdf, 0xd4, 0x96, 0x11, 0x17, 0x0c, 0x3f, 0xc9, 0x75, 0x4e, 0x00, 0x25, 0xa1, 0x8c, 0x97, 0xe3,
-	0xa3, 0x27, 0xf5, 0x72, 0xfc, 0xcf, 0x34, 0x78, 0xa8, 0x1b, 0xdb, 0xe0, 0x8a, 0x5e, 0x3d, 0xf1,
-	0x99, 0xf4, 0xa3, 0xe8, 0xa5, 0xb8, 0x61, 0xa8, 0xe8, 0x25, 0x21, 0x98, 0xa2, 0x9b, 0x93, 0xf9,
-	0xbb, 0x54, 0x24, 0xf3, 0xb7, 0xfe, 0x4b, 0x25, 0x80, 0x35, 0xea, 0xdf, 0x75, 0xdc, 0x3b, 0xec,
-	0x0c, 0x7e, 0x28, 0x66, 0xca, 0x1a, 0xfd, 0xda, 0xc5, 0x7a, 0x7a, 0x08, 0x06, 0xdb, 0x4e, 0xc3,
-	0x93, 0xf2, 0x35, 0xef, 0x08, 0xf7, 0x63, 0xe5, 0xa5, 0xa4, 0x0c, 0x43, 0xfc, 0x32, 0x5d, 0xaa,
-	0x3e, 0xdc, 0x10, 0xb6, 0xc6, 0x0a, 0x50, 0x94, 0x8b, 0x84, 0xe6, 0xc2, 0xc4, 0x27, 0x2d, 0x85,
-	0x32, 0xa1, 0xb9, 0x28, 0xc3, 0x10, 0x4a, 0xae, 0x02, 0x98, 0xed, 0x6b, 0x46, 0xcb, 0xb4, 0x4c,
-	0xf9, 0x39, 0x8d, 0x71, 0x0b, 0x0d, 0x54, 0xd7, 0x83, 0xd2, 0xfb, 0xfb, 0xe5, 0x51, 0xf9, 0x6b,
-	0x0f, 0x95, 0xda, 0xfa, 0x17, 0x35, 0x98, 0x8e, 0x26, 0x4f, 0x6e, 0x95, 0xa0, 0xe7, 0x22, 0xd0,
-	0x5e, 0x6e, 0xcf, 0x45, 0x6c, 0xd5, 0xee, 0x3d, 0x17, 0x8a, 0x76, 0x5e, 0xcf, 0x9f, 0x82, 0x71,
-	0x2a, 0xe2, 0x31, 0x54, 0x97, 0x50, 0xf0, 0xa0, 0x31, 0xa1, 0xae, 0x2c, 0x47, 0xc5, 0xa8, 0xd6,
-	0xd1, 0xff, 0x72, 0x00, 0x26, 0xd6, 0x9a, 0xa6, 0xbd, 0x1b, 0x04, 0x9e, 0x08, 0x6f, 0x71, 0xb4,
-	0x93, 0xb9, 0xc5, 0x79, 0x15, 0x66, 0x2d, 0xd5, 0xec, 0x2a, 0x04, 0x1b, 0xc3, 0x6e, 0x86, 0x33,
-	0xc0, 0xe5, 0xf4, 0x95, 0x9c, 0x3a, 0x98, 0xdb, 0x9a, 0xf8, 0x30, 0x5c, 0x0f, 0x72, 0xca, 0x14,
-	0x0e, 0xa6, 0xa0, 0xce, 0xc5, 0xbc, 0xfa, 0xae, 0x38, 0xe4, 0x49, 0x72, 0x7b, 0x4a, 0x5a, 0xe4,
-	0xe3, 0x1a, 0x9c, 0xa7, 0xbb, 0xe2, 0x5d, 0xfd, 0x86, 0x6b, 0x6c, 0x6d, 0x99, 0x75, 0xf9, 0x1c,
-	0x42, 0xec, 0xc4, 0x95, 0x83, 0xfd, 0xf2, 0xf9, 0xe5, 0xac, 0x0a, 0xf7, 0xf7, 0xcb, 0x57, 0x32,
-	0xc3, 0x1c, 0xf0, 0xd5, 0xcc, 0x6c, 0x82, 0xd9, 0xa4, 0xe6, 0x9e, 0x83, 0xf1, 0x23, 0x3c, 0xa2,
-	0x8b, 0x05, 0x33, 0xf8, 0xe5, 0x12, 0x4c, 0xb0, 0xed, 0xb6, 0xe2, 0xd4, 0x0d, 0x6b, 0x69, 0xad,
-	0x46, 0x9e, 0x48, 0x86, 0x20, 0x0a, 0x4d, 0xde, 0xa9, 0x30, 0x44, 0x2b, 0x70, 0x6e, 0xcb, 0x71,
-	0xeb, 0x74, 0xa3, 0xb2, 0xbe, 0xe1, 0x48, 0xa7, 0x86, 0xa5, 0xb5, 0x9a, 0xd4, 0x5b, 0xb8, 0x59,
-	0xf5, 0x5a, 0x06, 0x1c, 0x33, 0x5b, 0x91, 0x5b, 0x70, 0x3e, 0x2a, 0xbf, 0xdd, 0x16, 0xde, 0x9c,
-	0x0c, 0xdd, 0x40, 0xe4, 0x8d, 0x7a, 0x2d, 0xab, 0x02, 0x66, 0xb7, 0x23, 0x06, 0x3c, 0x28, 0xe3,
-	0xbf, 0x5d, 0x73, 0xdc, 0xbb, 0x86, 0xdb, 0x88, 0xa3, 0x1d, 0x8c, 0x2e, 0x7d, 0x97, 0xf2, 0xab,
-	0x61, 0x37, 0x1c, 0xfa, 0x67, 0x35, 0x88, 0x07, 0x78, 0x22, 0x0f, 0xc0, 0x80, 0x2b, 0xd3, 0xa0,
-	0xc8, 0x40, 0x47, 0x4c, 0x84, 0x67, 0x65, 0x64, 0x1e, 0xc0, 0x8d, 0xa2, 0x4c, 0x95, 0xa2, 0xd8,
-	0xc3, 0x4a, 0x7c, 0x28, 0xa5, 0x06, 0x43, 0xe5, 0x1b, 0x4d, 0xc9, 0xf0, 0x38, 0xaa, 0x0d, 0xa3,
-	0x89, 0xac, 0x8c, 0x07, 0x99, 0x36, 0x9b, 0xd4, 0x0b, 0xcc, 0x66, 0x22, 0xc8, 0x34, 0x2f, 0x41,
-	0x09, 0xd1, 0x7f, 0x6c, 0x18, 0x94, 0x87, 0xf9, 0x47, 0x10, 0xe1, 0x7e, 0x4a, 0x83, 0x73, 0x75,
-	0xcb, 0xa4, 0xb6, 0x9f, 0x78, 0xe3, 0x2a, 0x78, 0xfb, 0xed, 0x42, 0x11, 0x03, 0xda, 0xd4, 0xae,
-	0x2e, 0x49, 0xc7, 0xdc, 0x4a, 0x06, 0x72, 0xe9, 0xbc, 0x9c, 0x01, 0xc1, 0xcc, 0xce, 0xf0, 0xf1,
-	0xf0, 0xf2, 0xea, 0x92, 0x1a, 0x36, 0xaa, 0x22, 0xcb, 0x30, 0x84, 0x32, 0xb6, 0xd8, 0x74, 0x9d,
-	0x4e, 0xdb, 0xab, 0xf0, 0xf7, 0x37, 0x62, 0xc6, 0x38, 0x5b, 0xbc, 0x1e, 0x15, 0xa3, 0x5a, 0x87,
-	0x3c, 0x03, 0x13, 0xe2, 0xe7, 0xba, 0x4b, 0xb7, 0xcc, 0x5d, 0x79, 0x62, 0x70, 0x9b, 0xd4, 0x75,
-	0xa5, 0x1c, 0x63, 0xb5, 0x78, 0xe4, 0x17, 0xcf, 0xeb, 0x50, 0xf7, 0x36, 0xae, 0xc8, 0x8c, 0x68,
-	0x22, 0xf2, 0x4b, 0x50, 0x88, 0x11, 0x9c, 0xfc, 0x88, 0x06, 0x53, 0x2e, 0x7d, 0xb3, 0x63, 0xba,
-	0x4c, 0xbe, 0x30, 0xcc, 0x96, 0x27, 0xa3, 0x23, 0x60, 0x7f, 0x11, 0x19, 0xe6, 0x31, 0x86, 0x54,
-	0x70, 0xaf, 0xf0, 0xd2, 0x2e, 0x0e, 0xc4, 0x44, 0x0f, 0xd8, 0x54, 0x79, 0x66, 0xd3, 0x36, 0xed,
-	0xe6, 0x82, 0xd5, 0xf4, 0x66, 0x47, 0xa3, 0x13, 0xa4, 0x16, 0x15, 0xa3, 0x5a, 0x87, 0x3c, 0x0b,
-	0x93, 0x1d, 0x8f, 0xf1, 0xa4, 0x16, 0x15, 0xf3, 0x3b, 0x16, 0xdd, 0x6a, 0xde, 0x56, 0x01, 0x18,
-	0xaf, 0x47, 0xae, 0xc2, 0x54, 0x50, 0x20, 0x67, 0x19, 0x44, 0x3c, 0x6a, 0x6e, 0x9c, 0x8f, 0x41,
-	0x30, 0x51, 0x73, 0x6e, 0x01, 0xce, 0x66, 0x0c, 0xf3, 0x48, 0x8c, 0xef, 0xaf, 0x34, 0x38, 0x2f,
-	0x44, 0xa2, 0x20, 0x97, 0x5a, 0x10, 0x77, 0x39, 0x3b, 0x84, 0xb1, 0x76, 0xa2, 0x21, 0x8c, 0xbf,
-	0x06, 0xa1, 0x9a, 0xf5, 0x9f, 0x2d, 0xc1, 0x3b, 0x0f, 0xfd, 0x2e, 0xc9, 0x8f, 0x6b, 0x30, 0x4e,
-	0x77, 0x7d, 0xd7, 0x08, 0x1f, 0x29, 0xb2, 0x4d, 0xba, 0x75, 0x22, 0x4c, 0x60, 0x7e, 0x39, 0x22,
-	0x24, 0x36, 0x6e, 0xa8, 0x87, 0x28, 0x10, 0x54, 0xfb, 0xc3, 0x58, 0xa1, 0x88, 0xd7, 0xae, 0xba,
-	0x3f, 0x88, 0x08, 0x37, 0x28, 0x21, 0x73, 0x2f, 0xc2, 0x74, 0x12, 0xf3, 0x91, 0xf6, 0xca, 0x3f,
-	0xd4, 0xe0, 0xfc, 0x3a, 0xb5, 0x1b, 0xa6, 0xdd, 0x14, 0x89, 0x2b, 0x3c, 0x69, 0x1c, 0xec, 0xc1,
-	0x3e, 0x94, 0xbd, 0x9b, 0x4a, 0x27, 0xb9, 0x9b, 0xf4, 0x5f, 0x2c, 0xc1, 0xc8, 0xba, 0xeb, 0xbc,
-	0x41, 0xeb, 0xa7, 0x11, 0xf0, 0xca, 0x88, 0x59, 0x85, 0x0a, 0xe9, 0xbc, 0xb2, 0xb3, 0xb9, 0x66,
-	0x20, 0x33, 0x61, 0x06, 0x5a, 0xe8, 0x87, 0x48, 0x77, 0xbb, 0xcf, 0x6f, 0x68, 0x30, 0x2e, 0x6b,
-	0x9e, 0x82, 0xa1, 0xe7, 0x3b, 0xe3, 0x86, 0x9e, 0xe7, 0xfb, 0x18, 0x57, 0x8e, 0x65, 0xe7, 0x73,
-	0x1a, 0x4c, 0xca, 0x1a, 0xab, 0xb4, 0xb5, 0x49, 0x5d, 0x72, 0x0d, 0x46, 0xbc, 0x0e, 0x5f, 0x48,
-	0x39, 0xa0, 0x07, 0x55, 0x6b, 0xa5, 0xbb, 0x69, 0xd4, 0x59, 0xf7, 0x6b, 0xa2, 0x8a, 0x92, 0x45,
-	0x4d, 0x14, 0x60, 0xd0, 0x98, 0xed, 0x7d, 0xd7, 0xb1, 0x52, 0x61, 0x50, 0xd1, 0xb1, 0x28, 0x72,
-	0x08, 0xd3, 0x6d, 0xd8, 0xdf, 0x40, 0x6f, 0xe1, 0xba, 0x0d, 0x03, 0x7b, 0x28, 0xca, 0xf5, 0x2f,
-	0x0e, 0x85, 0x93, 0xcd, 0x15, 0xd9, 0x1b, 0x30, 0x56, 0x77, 0xa9, 0xe1, 0xd3, 0xc6, 0xe2, 0x5e,
-	0x2f, 0x9d, 0xe3, 0xc7, 0x6b, 0x25, 0x68, 0x81, 0x51, 0x63, 0x76, 0x92, 0xa9, 0x1e, 0x32, 0xa5,
-	0xe8, 0xd0, 0xcf, 0xf5, 0x8e, 0xf9, 0x66, 0x18, 0x72, 0xee, 0xda, 0xa1, 0xa3, 0x6d, 0x57, 0xc2,
-	0x7c, 0x28, 0xb7, 0x58, 0x6d, 0x14, 0x8d, 0xd4, 0x30, 0xc0, 0x83, 0x5d, 0xc2, 0x00, 0x5b, 0x30,
-	0xd2, 0xe2, 0xcb, 0xd0, 0x57, 0x52, 0xad, 0xd8, 0x82, 0xaa, 0x69, 0x57, 0x39, 0x66, 0x0c, 0x48,
-	0x30, 0x89, 0xc4, 0x0e, 0xac, 0x18, 0xaa, 0x44, 0x12, 0x9a, 0x36, 0x30, 0x82, 0x93, 0xbd, 0x78,
-	0x7c, 0xe9, 0x91, 0xe2, 0xb6, 0x3b, 0xd9, 0x3d, 0x25, 0xa4, 0xb4, 0x98, 0xfa, 0xbc, 0x18, 0xd3,
-	0xe4, 0x67, 0x34, 0xb8, 0xd8, 0xc8, 0xce, 0x04, 0xc1, 0x85, 0x90, 0x82, 0x2f, 0xb5, 0x72, 0x92,
-	0x4b, 0x2c, 0x96, 0xe5, 0x84, 0xe5, 0x65, 0x9f, 0xc0, 0xbc, 0xce, 0xe8, 0xdf, 0x3f, 0x18, 0x7e,
-	0x4d, 0x52, 0xbb, 0xcf, 0xb6, 0xbd, 0x68, 0x45, 0x6c, 0x2f, 0xe4, 0x9b, 0x82, 0x8c, 0x0f, 0xa5,
-	0x58, 0x2e, 0xe3, 0x30, 0xe3, 0xc3, 0x84, 0x24, 0x1d, 0xcb, 0xf2, 0xd0, 0x81, 0xb3, 0x9e, 0x6f,
-	0x58, 0xb4, 0x66, 0xca, 0x0b, 0x1f, 0xcf, 0x37, 0x5a, 0xed, 0x02, 0x29, 0x17, 0xc4, 0xcb, 0xcd,
-	0x34, 0x2a, 0xcc, 0xc2, 0x4f, 0xbe, 0x9b, 0x47, 0xc3, 0x31, 0x2c, 0x7e, 0x21, 0x26, 0x92, 0x23,
-	0x45, 0xc4, 0x8f, 0xee, 0x2f, 0x28, 0x63, 0xdd, 0x64, 0xe3, 0xc3, 0x5c, 0x4a, 0xe4, 0x2d, 0x38,
-	0xcf, 0x0e, 0xc0, 0x85, 0xba, 0x6f, 0xee, 0x98, 0xfe, 0x5e, 0xd4, 0x85, 0xa3, 0xe7, 0x59, 0xe0,
-	0x1a, 0xe6, 0x4a, 0x16, 0x32, 0xcc, 0xa6, 0xa1, 0xff, 0xa9, 0x06, 0x24, 0xbd, 0xd7, 0x89, 0x05,
-	0xa3, 0x8d, 0xe0, 0x29, 0xa5, 0x76, 0x2c, 0x51, 0xda, 0xc3, 0x23, 0x24, 0x7c, 0x81, 0x19, 0x52,
-	0x20, 0x0e, 0x8c, 0xdd, 0xdd, 0x36, 0x7d, 0x6a, 0x99, 0x9e, 0x7f, 0x4c, 0x41, 0xe1, 0xc3, 0x18,
-	0xc0, 0xaf, 0x04, 0x88, 0x31, 0xa2, 0xa1, 0xff, 0xc0, 0x20, 0x8c, 0x86, 0x59, 0x7e, 0x0e, 0x77,
-	0x75, 0xeb, 0x00, 0xa9, 0x2b, 0x99, 0x92, 0xfb, 0xb1, 0x13, 0x72, 0x19, 0xa8, 0x92, 0x42, 0x86,
-	0x19, 0x04, 0xc8, 0x5b, 0x70, 0xce, 0xb4, 0xb7, 0x5c, 0x23, 0x8c, 0x3f, 0xd4, 0x4f, 0xc2, 0x61,
-	0xae, 0x9c, 0x56, 0x33, 0xd0, 0x61, 0x26, 0x11, 0x42, 0x61, 0x44, 0x24, 0x33, 0x0b, 0x6e, 0x02,
-	0xae, 0x16, 0x8a, 0xde, 0xc6, 0x51, 0x44, 0xec, 0x3d, 0x90, 0x3d, 0x03, 0xdc, 0x22, 0x5a, 0x9c,
-	0xf8, 0x3f, 0xb8, 0x24, 0x91, 0xfb, 0xbe, 0x52, 0x9c, 0x5e, 0x74, 0xdf, 0x22, 0xa2, 0xc5, 0xc5,
-	0x0b, 0x31, 0x49, 0x50, 0xff, 0x35, 0x0d, 0x86, 0x44, 0x50, 0x90, 0x93, 0x17, 0x35, 0xbf, 0x23,
-	0x26, 0x6a, 0x16, 0xca, 0x99, 0xca, 0xbb, 0x9a, 0x9b, 0xcd, 0xf3, 0xcb, 0x1a, 0x8c, 0xf1, 0x1a,
-	0xa7, 0x20, 0xfb, 0xbd, 0x1e, 0x97, 0xfd, 0x9e, 0x2b, 0x3c, 0x9a, 0x1c, 0xc9, 0xef, 0xd7, 0x06,
-	0xe4, 0x58, 0xb8, 0x68, 0x55, 0x85, 0xb3, 0xf2, 0x91, 0xd1, 0x8a, 0xb9, 0x45, 0xd9, 0x16, 0x5f,
-	0x32, 0xf6, 0x84, 0x9f, 0xcc, 0x90, 0x7c, 0x85, 0x9e, 0x06, 0x63, 0x56, 0x1b, 0xf2, 0xcb, 0x1a,
-	0x13, 0x62, 0x7c, 0xd7, 0xac, 0xf7, 0x75, 0x41, 0x19, 0xf6, 0x6d, 0x7e, 0x55, 0x20, 0x13, 0x2a,
-	0xdf, 0xed, 0x48, 0x9a, 0xe1, 0xa5, 0xf7, 0xf7, 0xcb, 0xe5, 0x0c, 0x3b, 0x69, 0x94, 0x2e, 0xcf,
-	0xf3, 0x3f, 0xfe, 0x07, 0x5d, 0xab, 0x70, 0x6d, 0x2c, 0xe8, 0x31, 0xb9, 0x01, 0x43, 0x5e, 0xdd,
-	0x69, 0xd3, 0xa3, 0x24, 0xfd, 0x0d, 0x27, 0xb8, 0xc6, 0x5a, 0xa2, 0x40, 0x30, 0xf7, 0x06, 0x4c,
-	0xa8, 0x3d, 0xcf, 0x50, 0x29, 0x97, 0x54, 0x95, 0xf2, 0xc8, 0x0e, 0x3f, 0xaa, 0x0a, 0xfa, 0xbb,
-	0x03, 0x30, 0x8c, 0xb4, 0x29, 0x53, 0x70, 0x1c, 0xa2, 0x73, 0x9a, 0x41, 0x5e, 0xb2, 0x52, 0xf1,
-	0x87, 0x0c, 0x6a, 0x90, 0xf5, 0xd7, 0x1c, 0x5b, 0x99, 0x03, 0x35, 0x35, 0x19, 0xb1, 0xc3, 0xc4,
-	0x04, 0x03, 0xc5, 0x13, 0x93, 0x8a, 0x81, 0xf5, 0x92, 0x8a, 0x80, 0xfc, 0xb0, 0x06, 0xc4, 0xa8,
-	0xd7, 0xa9, 0xe7, 0x21, 0xf5, 0xd8, 0xdc, 0x0b, 0x61, 0x55, 0x70, 0xd9, 0x62, 0x61, 0x2a, 0x93,
-	0xd8, 0x22, 0xb1, 0x2d, 0x05, 0xf2, 0x30, 0x83, 0x78, 0x3f, 0xe9, 0x11, 0x7e, 0x53, 0x83, 0x89,
-	0x58, 0xf6, 0x89, 0x56, 0x64, 0x3f, 0x2e, 0xee, 0x46, 0x12, 0xb8, 0xcf, 0x3f, 0xd8, 0xa5, 0x92,
-	0xb0, 0x49, 0xdf, 0x0a, 0xe3, 0x4f, 0x1f, 0x4f, 0xa2, 0x0a, 0xfd, 0x33, 0x1a, 0x5c, 0x08, 0x06,
-	0x14, 0x0f, 0x34, 0x4a, 0x1e, 0x87, 0x51, 0xa3, 0x6d, 0x72, 0xfb, 0xa9, 0x6a, 0x81, 0x5e, 0x58,
-	0xaf, 0xf2, 0x32, 0x0c, 0xa1, 0xb1, 0xe4, 0x6f, 0xa5, 0x43, 0x93, 0xbf, 0x3d, 0xa6, 0xa4, 0xb3,
-	0x1b, 0x8a, 0x64, 0x97, 0x90, 0xb0, 0x70, 0xd0, 0xd3, 0xdf, 0x07, 0x63, 0xb5, 0xda, 0x0d, 0xb1,
-	0xa4, 0x47, 0xb8, 0xe5, 0xd0, 0x3f, 0x39, 0x00, 0x93, 0x32, 0x62, 0xb2, 0xc9, 0x4d, 0x40, 0xa7,
-	0x70, 0xce, 0x6d, 0xc0, 0x98, 0x30, 0x5d, 0x1d, 0x92, 0xf2, 0xbc, 0x16, 0x54, 0x4a, 0x66, 0x6d,
-	0x09, 0x01, 0x18, 0x21, 0x22, 0x37, 0x61, 0xf8, 0x4d, 0xc6, 0x73, 0x83, 0x6f, 0xb5, 0x27, 0xd6,
-	0x17, 0x7e, 0x88, 0x9c, 0x5d, 0x7b, 0x28, 0x51, 0x10, 0x8f, 0xbf, 0xef, 0xe0, 0x42, 0x60, 0x3f,
-	0xb1, 0xbb, 0x62, 0x33, 0x1b, 0x26, 0xb3, 0x9c, 0x90, 0xcf, 0x44, 0xf8, 0x2f, 0x0c, 0x09, 0xf1,
-	0x94, 0x53, 0xb1, 0x16, 0x6f, 0x93, 0x94, 0x53, 0xb1, 0x3e, 0xe7, 0x1c, 0xd7, 0xcf, 0xc1, 0xf9,
-	0xcc, 0xc9, 0x38, 0x5c, 0xc4, 0xd6, 0xff, 0x71, 0x09, 0x06, 0x6b, 0x94, 0x36, 0x4e, 0x61, 0x67,
-	0xbe, 0x1e, 0x93, 0xc0, 0xbe, 0xb9, 0x70, 0xd2, 0xab, 0x3c, 0x4b, 0xdf, 0x56, 0xc2, 0xd2, 0xf7,
-	0x62, 0x61, 0x0a, 0xdd, 0xcd, 0x7c, 0x3f, 0x51, 0x02, 0x60, 0xd5, 0x16, 0x8d, 0xfa, 0x1d, 0xc1,
-	0x71, 0xc2, 0xdd, 0x9c, 0x48, 0x37, 0x99, 0xde, 0x86, 0xa7, 0xe9, 0xf6, 0xa0, 0xc3, 0xb0, 0xcb,
-	0x4f, 0x47, 0x79, 0xc9, 0xc5, 0xcd, 0xdb, 0xe2, 0xbc, 0x44, 0x09, 0x89, 0x73, 0x8b, 0xc1, 0x63,
-	0xe2, 0x16, 0xfa, 0x2e, 0x8c, 0xb0, 0x09, 0x5a, 0x5a, 0xab, 0x91, 0x96, 0x32, 0x3b, 0xa5, 0xe2,
-	0xfa, 0x85, 0x44, 0x77, 0xe8, 0x57, 0xfe, 0x49, 0x0d, 0xce, 0x24, 0xea, 0xf6, 0xa0, 0x67, 0x9e,
-	0x08, 0xcf, 0xd4, 0x7f, 0x55, 0x83, 0x51, 0xd6, 0x97, 0x53, 0x60, 0x34, 0xdf, 0x1e, 0x67, 0x34,
-	0xef, 0x2f, 0x3a, 0xc5, 0x39, 0xfc, 0xe5, 0x8f, 0x4b, 0xc0, 0xb3, 0xcb, 0x49, 0xff, 0x14, 0xc5,
-	0xf3, 0x44, 0xcb, 0xf1, 0x99, 0xb9, 0x2c, 0x1d, 0x57, 0x12, 0x06, 0x5e, 0xc5, 0x79, 0xe5, 0xdd,
-	0x31, 0xdf, 0x94, 0xd8, 0x67, 0x93, 0xe1, 0x9f, 0x72, 0x0f, 0x26, 0xbd, 0x6d, 0xc7, 0xf1, 0xc3,
-	0x38, 0x53, 0x83, 0xc5, 0x8d, 0xf9, 0xfc, 0xf1, 0x5b, 0x30, 0x14, 0x71, 0xdb, 0x58, 0x53, 0x71,
-	0x63, 0x9c, 0x14, 0x99, 0x07, 0xd8, 0xb4, 0x9c, 0xfa, 0x1d, 0xe1, 0x1a, 0x23, 0x1e, 0x3b, 0xf1,
-	0xcb, 0xf7, 0xc5, 0xb0, 0x14, 0x95, 0x1a, 0x7d, 0x79, 0x01, 0xfd, 0xa1, 0x26, 0x66, 0xfa, 0x08,
-	0x9b, 0xf7, 0x14, 0x39, 0xca, 0xbb, 0x12, 0x1c, 0x25, 0xe4, 0x90, 0x09, 0xae, 0x52, 0x0e, 0x94,
-	0x88, 0xc1, 0xc8, 0x78, 0x1f, 0xcb, 0x4a, 0xfc, 0x8b, 0x72, 0x98, 0x61, 0x82, 0xc2, 0x36, 0x4c,
-	0x5a, 0x6a, 0x3e, 0x5d, 0xf9, 0x8d, 0x14, 0x4a, 0xc5, 0x1b, 0xba, 0x5d, 0xc6, 0x8a, 0x31, 0x4e,
-	0x80, 0x3c, 0x0b, 0x93, 0xc1, 0xe8, 0x84, 0xf7, 0x63, 0x29, 0x7a, 0x89, 0xb4, 0xae, 0x02, 0x30,
-	0x5e, 0x4f, 0xff, 0x6c, 0x09, 0x1e, 0x16, 0x7d, 0xe7, 0x56, 0x8c, 0x25, 0xda, 0xa6, 0x76, 0x83,
-	0xda, 0xf5, 0x3d, 0x2e, 0xb3, 0x36, 0x9c, 0x26, 0x79, 0x0b, 0x86, 0xef, 0x52, 0xda, 0x08, 0xaf,
-	0x03, 0x5e, 0x29, 0x9e, 0xdf, 0x31, 0x87, 0xc4, 0x2b, 0x1c, 0xbd, 0xe0, 0xe8, 0xe2, 0x7f, 0x94,
-	0x24, 0x19, 0xf1, 0xb6, 0xeb, 0x6c, 0x86, 0xa2, 0xd5, 0xf1, 0x13, 0x5f, 0xe7, 0xe8, 0x05, 0x71,
-	0xf1, 0x3f, 0x4a, 0x92, 0xfa, 0x3a, 0x3c, 0xd2, 0x43, 0xd3, 0xa3, 0x88, 0xd0, 0x87, 0x61, 0x14,
-	0xa3, 0x3f, 0x0a, 0xc6, 0xdf, 0xd3, 0xe0, 0x51, 0x05, 0xe5, 0xf2, 0x2e, 0x93, 0xea, 0x2b, 0x46,
-	0xdb, 0xa8, 0x33, 0xbd, 0x99, 0xc7, 0xce, 0x39, 0x52, 0x46, 0xb5, 0x4f, 0x6a, 0x30, 0x22, 0x3c,
-	0xba, 0x02, 0xf6, 0xfb, 0x7a, 0x9f, 0x53, 0x9e, 0xdb, 0xa5, 0x20, 0x55, 0x47, 0x30, 0x36, 0xf1,
-	0xdb, 0xc3, 0x80, 0xbe, 0xfe, 0x6f, 0x86, 0xe0, 0x1b, 0x7a, 0x47, 0x44, 0xfe, 0x50, 0x4b, 0x66,
-	0xf3, 0x1d, 0x7f, 0xba, 0x75, 0xb2, 0x9d, 0x0f, 0x2d, 0x2b, 0x52, 0x59, 0x7f, 0x25, 0x95, 0x2c,
-	0xf2, 0x98, 0x8c, 0x36, 0xd1, 0xc0, 0xc8, 0xdf, 0xd7, 0x60, 0x82, 0x1d, 0x4b, 0xb5, 0x28, 0xcf,
-	0x37, 0x1b, 0x69, 0xfb, 0x84, 0x47, 0xba, 0xa6, 0x90, 0x4c, 0x04, 0xd9, 0x50, 0x41, 0x18, 0xeb,
-	0x1b, 0xb9, 0x1d, 0xbf, 0x4a, 0x13, 0xea, 0xd6, 0xa5, 0x2c, 0x69, 0xe4, 0x28, 0xa9, 0x58, 0xe7,
-	0x2c, 0x98, 0x8a, 0xcf, 0xfc, 0x49, 0x9a, 0x9c, 0xe6, 0x5e, 0x82, 0x99, 0xd4, 0xe8, 0x8f, 0x64,
-	0xdc, 0xf8, 0xd1, 0x21, 0x28, 0x2b, 0x53, 0x9d, 0xf5, 0xdc, 0x9e, 0x7c, 0x5e, 0x83, 0x71, 0xc3,
-	0xb6, 0xa5, 0xef, 0x4d, 0xb0, 0x7f, 0x1b, 0x7d, 0xae, 0x6a, 0x16, 0xa9, 0xf9, 0x85, 0x88, 0x4c,
-	0xc2, 0xb9, 0x44, 0x81, 0xa0, 0xda, 0x9b, 0x2e, 0xde, 0x9d, 0xa5, 0x53, 0xf3, 0xee, 0x24, 0x1f,
-	0x09, 0x0e, 0x62, 0xb1, 0x8d, 0x5e, 0x3d, 0x81, 0xb9, 0xe1, 0xe7, 0x7a, 0x8e, 0x85, 0xef, 0x07,
-	0x35, 0x7e, 0xc8, 0x46, 0x51, 0x11, 0xe4, 0x99, 0x54, 0xc8, 0x0f, 0xf0, 0xd0, 0x90, 0x0b, 0xe1,
-	0xd9, 0x1d, 0x15, 0x61, 0x9c, 0xfc, 0xdc, 0x8b, 0x30, 0x9d, 0x5c, 0xca, 0x23, 0x6d, 0xcb, 0x7f,
-	0x39, 0x18, 0x3b, 0x3b, 0x72, 0xe7, 0xa3, 0x07, 0x43, 0xeb, 0x17, 0x12, 0xbb, 0x57, 0xf0, 0x24,
-	0xf3, 0xa4, 0x56, 0xe8, 0x78, 0xb7, 0xf0, 0xc0, 0xe9, 0x6d, 0xe1, 0xff, 0xeb, 0xf6, 0xd0, 0x22,
-	0x9c, 0x57, 0x16, 0x4c, 0x49, 0x0e, 0xfe, 0x04, 0x8c, 0xec, 0x98, 0x9e, 0x19, 0xc4, 0x7d, 0x54,
-	0x64, 0x98, 0x97, 0x45, 0x31, 0x06, 0x70, 0x7d, 0x25, 0xc6, 0x1d, 0x37, 0x9c, 0xb6, 0x63, 0x39,
-	0xcd, 0xbd, 0x85, 0xbb, 0x86, 0x4b, 0xd1, 0xe9, 0xf8, 0x12, 0x5b, 0xaf, 0x12, 0xd1, 0x2a, 0x5c,
-	0x56, 0xb0, 0x65, 0x46, 0xc7, 0x3a, 0x0a, 0xba, 0xdf, 0x18, 0x09, 0x84, 0x7b, 0x19, 0xee, 0xe3,
-	0x17, 0x34, 0x78, 0x80, 0xe6, 0x1d, 0x96, 0x52, 0xd2, 0x7f, 0xf5, 0xa4, 0x0e, 0x63, 0x19, 0x89,
-	0x3f, 0x0f, 0x8c, 0xf9, 0x3d, 0x23, 0x7b, 0xb1, 0x14, 0xf9, 0xa5, 0x7e, 0x2c, 0x95, 0x19, 0xeb,
-	0xdd, 0x2d, 0x41, 0x3e, 0xf9, 0x49, 0x0d, 0xce, 0x59, 0x19, 0x9b, 0x55, 0x6e, 0xfe, 0xda, 0x09,
-	0xb0, 0x09, 0x71, 0x53, 0x9d, 0x05, 0xc1, 0xcc, 0xae, 0x90, 0x9f, 0xce, 0x0d, 0xdb, 0x26, 0x2e,
-	0x92, 0x37, 0xfa, 0xec, 0xe4, 0x71, 0x45, 0x70, 0xfb, 0xac, 0x06, 0xa4, 0x91, 0x52, 0x1c, 0xa4,
-	0x93, 0xd2, 0x87, 0x8e, 0x5d, 0x3d, 0x12, 0xae, 0x06, 0xe9, 0x72, 0xcc, 0xe8, 0x04, 0x5f, 0x67,
-	0x3f, 0xe3, 0xf3, 0x95, 0xcf, 0xd3, 0xfa, 0x5d, 0xe7, 0x2c, 0xce, 0x20, 0xd6, 0x39, 0x0b, 0x82,
-	0x99, 0x5d, 0xd1, 0x7f, 0x6f, 0x44, 0xd8, 0xb1, 0xf8, 0x5d, 0xf0, 0x26, 0x0c, 0x6f, 0x72, 0xbb,
-	0xa7, 0xfc, 0x6e, 0x0b, 0x1b, 0x59, 0x85, 0xf5, 0x54, 0x68, 0x91, 0xe2, 0x7f, 0x94, 0x98, 0xc9,
-	0x6b, 0x30, 0xd0, 0xb0, 0x83, 0x17, 0xa0, 0xcf, 0xf7, 0x61, 0x2e, 0x8c, 0xde, 0xa1, 0x2f, 0xad,
-	0xd5, 0x90, 0x21, 0x25, 0x36, 0x8c, 0xda, 0xd2, 0xf4, 0x23, 0xb5, 0xf3, 0x0f, 0x14, 0x25, 0x10,
-	0x9a, 0x90, 0x42, 0xc3, 0x55, 0x50, 0x82, 0x21, 0x0d, 0x46, 0x2f, 0x71, 0xd7, 0x51, 0x98, 0x5e,
-	0x68, 0xfc, 0xec, 0x66, 0x5f, 0xa6, 0x30, 0xec, 0x1b, 0xa6, 0xed, 0x07, 0xaf, 0x39, 0x5f, 0x28,
-	0x4a, 0x6d, 0x83, 0x61, 0x89, 0x2c, 0x3c, 0xfc, 0xa7, 0x87, 0x12, 0x39, 0xcf, 0xae, 0xce, 0x5f,
-	0x74, 0xca, 0xcf, 0xa8, 0xf0, 0x36, 0x10, 0x8f, 0x44, 0x65, 0x76, 0x75, 0xfe, 0x3f, 0x4a, 0xcc,
-	0xe4, 0x0d, 0x18, 0xf5, 0x02, 0xd7, 0x94, 0xd1, 0xfe, 0xa6, 0x2e, 0xf4, 0x4b, 0x91, 0xef, 0xdf,
-	0xa4, 0x43, 0x4a, 0x88, 0x9f, 0x6c, 0xc2, 0x88, 0x29, 0x9e, 0x6e, 0xc9, 0x98, 0x93, 0xcf, 0xf7,
-	0x91, 0x09, 0x59, 0x18, 0x0a, 0xe4, 0x0f, 0x0c, 0x10, 0xe7, 0xdd, 0x3f, 0xc3, 0xd7, 0xf0, 0xfe,
-	0x59, 0xff, 0x0d, 0x10, 0x77, 0x19, 0xd2, 0x23, 0x71, 0x0b, 0x46, 0x03, 0x92, 0xfd, 0x84, 0x4d,
-	0xb8, 0x2e, 0xc1, 0x62, 0xba, 0x83, 0x5f, 0x18, 0xe2, 0x26, 0x95, 0xac, 0xf0, 0x17, 0x51, 0x3a,
-	0xa9, 0xde, 0x42, 0x5f, 0xbc, 0xc9, 0x13, 0x58, 0x07, 0x41, 0xa8, 0x06, 0x8a, 0x6f, 0xf7, 0x30,
-	0x40, 0x55, 0x2c, 0x71, 0x75, 0x10, 0xc3, 0x4a, 0x21, 0x92, 0xe3, 0xb1, 0x39, 0x58, 0xc8, 0x63,
-	0xf3, 0x05, 0x38, 0x23, 0x3d, 0x64, 0xaa, 0x0d, 0xca, 0x35, 0x68, 0xf9, 0x56, 0x88, 0xfb, 0x4e,
-	0x55, 0xe2, 0x20, 0x4c, 0xd6, 0x25, 0xff, 0x42, 0x83, 0xd1, 0xba, 0x14, 0x5a, 0xe4, 0xb7, 0xbe,
-	0xd2, 0xdf, 0x85, 0xd7, 0x7c, 0x20, 0x03, 0x09, 0xfd, 0xe0, 0xe5, 0x80, 0xcb, 0x04, 0xc5, 0xc7,
-	0x64, 0x98, 0x09, 0x7b, 0x4d, 0x7e, 0x9d, 0xa9, 0x40, 0x16, 0xcf, 0xd1, 0xcf, 0x03, 0xfd, 0x88,
-	0x47, 0x4c, 0xb7, 0xfa, 0x1c, 0xc5, 0x42, 0x84, 0x51, 0x0c, 0xe4, 0x5b, 0x42, 0x45, 0x27, 0x82,
-	0x1c, 0xd3, 0x58, 0xd4, 0xee, 0x93, 0xbf, 0xab, 0xc1, 0xa3, 0xe2, 0xe5, 0x58, 0x85, 0xc9, 0x21,
-	0x5b, 0x66, 0xdd, 0xf0, 0xa9, 0x88, 0xb5, 0x15, 0x3c, 0xaf, 0x10, 0xfe, 0xa5, 0xa3, 0x47, 0xf6,
-	0x2f, 0x7d, 0xfc, 0x60, 0xbf, 0xfc, 0x68, 0xa5, 0x07, 0xdc, 0xd8, 0x53, 0x0f, 0xc8, 0x3d, 0x98,
-	0xb4, 0xd4, 0xe0, 0x86, 0x92, 0xe9, 0x15, 0xba, 0x4e, 0x89, 0x45, 0x49, 0x14, 0xfa, 0x53, 0xac,
-	0x08, 0xe3, 0xa4, 0xe6, 0xee, 0xc0, 0x64, 0x6c, 0xa3, 0x9d, 0xa8, 0x21, 0xca, 0x86, 0xe9, 0xe4,
-	0x7e, 0x38, 0x51, 0x5f, 0xab, 0x9b, 0x30, 0x16, 0x1e, 0x9e, 0xe4, 0x61, 0x85, 0x50, 0x24, 0x8a,
-	0xdc, 0xa4, 0x7b, 0x82, 0x6a, 0x39, 0xa6, 0x22, 0x8a, 0x5b, 0x92, 0x97, 0x59, 0x81, 0x44, 0xa8,
-	0xff, 0x96, 0xbc, 0x25, 0xd9, 0xa0, 0xad, 0xb6, 0x65, 0xf8, 0xf4, 0xed, 0x7f, 0x47, 0xaf, 0xff,
-	0x17, 0x4d, 0x9c, 0x37, 0xe2, 0xa8, 0x27, 0x06, 0x8c, 0xb7, 0x44, 0x92, 0x0d, 0x1e, 0xdb, 0x4a,
-	0x2b, 0x1e, 0x55, 0x6b, 0x35, 0x42, 0x83, 0x2a, 0x4e, 0x72, 0x17, 0xc6, 0x02, 0xe1, 0x28, 0x30,
-	0xb2, 0x5c, 0xeb, 0x4f, 0x58, 0x09, 0xe5, 0xb0, 0xf0, 0xfa, 0x37, 0x28, 0xf1, 0x30, 0xa2, 0xa5,
-	0x1b, 0x40, 0xd2, 0x6d, 0x98, 0x1e, 0x1d, 0xbc, 0xf5, 0xd0, 0xe2, 0x61, 0xb1, 0x53, 0xef, 0x3d,
-	0x02, 0x1b, 0x52, 0x29, 0xcf, 0x86, 0xa4, 0xff, 0x4a, 0x09, 0x32, 0x33, 0x44, 0x13, 0x1d, 0x86,
-	0xc5, 0x73, 0x51, 0x49, 0x84, 0x8b, 0x57, 0xe2, 0x2d, 0x29, 0x4a, 0x08, 0xb9, 0x25, 0x8c, 0x3b,
-	0x76, 0x83, 0x87, 0xa3, 0x8e, 0xb8, 0x84, 0xfa, 0x68, 0x7a, 0x39, 0xab, 0x02, 0x66, 0xb7, 0x23,
-	0x3b, 0x40, 0x5a, 0xc6, 0x6e, 0x12, 0x5b, 0x1f, 0x49, 0x3b, 0x57, 0x53, 0xd8, 0x30, 0x83, 0x02,
-	0x3b, 0x48, 0x99, 0x64, 0xd3, 0xf6, 0x69, 0x43, 0x0c, 0x31, 0xb8, 0xa4, 0xe5, 0x07, 0xe9, 0x42,
-	0x1c, 0x84, 0xc9, 0xba, 0xfa, 0xf7, 0x0c, 0xc3, 0x03, 0xf1, 0x49, 0x64, 0x5f, 0x68, 0xf0, 0xa2,
-	0xf3, 0xa5, 0xe0, 0x5d, 0x85, 0x98, 0xc8, 0x27, 0x92, 0xef, 0x2a, 0x66, 0x2b, 0x2e, 0xe5, 0x47,
-	0xb2, 0x61, 0x79, 0x41, 0xa3, 0xd8, 0x1b, 0x8b, 0xaf, 0xc1, 0xf3, 0xcc, 0x9c, 0x87, 0x83, 0x03,
-	0x27, 0xfa, 0x0c, 0xf5, 0x53, 0x1a, 0xcc, 0xc5, 0x8b, 0xaf, 0x99, 0xb6, 0xe9, 0x6d, 0xcb, 0xa0,
-	0xca, 0x47, 0x7f, 0xd6, 0xc1, 0xd3, 0x8c, 0xad, 0xe4, 0x62, 0xc4, 0x2e, 0xd4, 0xc8, 0xa7, 0x35,
-	0x78, 0x30, 0x31, 0x2f, 0xb1, 0x10, 0xcf, 0x47, 0x7f, 0xe1, 0xc1, 0x1f, 0xfb, 0xaf, 0xe4, 0xa3,
-	0xc4, 0x6e, 0xf4, 0x98, 0x9a, 0x7f, 0xa1, 0x9d, 0xf5, 0x0a, 0x34, 0x50, 0xd3, 0x0a, 0x99, 0x95,
-	0x32, 0xdf, 0x95, 0x2e, 0x5e, 0x92, 0x5b, 0xf4, 0x42, 0x26, 0xd8, 0xc3, 0x9c, 0x8e, 0xe8, 0xff,
-	0xa4, 0x04, 0x43, 0xdc, 0x0f, 0xe2, 0xed, 0xe1, 0x8c, 0xcf, 0xbb, 0x9a, 0xeb, 0x0b, 0xd6, 0x4c,
-	0xf8, 0x82, 0xbd, 0x54, 0x9c, 0x44, 0x77, 0x67, 0xb0, 0x6f, 0x81, 0x0b, 0xbc, 0xda, 0x42, 0x83,
-	0x1b, 0x9f, 0x3c, 0xda, 0x58, 0x68, 0x34, 0xb8, 0xba, 0x77, 0xf8, 0x15, 0xc0, 0xc3, 0x30, 0xd0,
-	0x71, 0xad, 0x64, 0xc8, 0xbc, 0xdb, 0xb8, 0x82, 0xac, 0x5c, 0xff, 0x94, 0x06, 0xd3, 0x1c, 0xb7,
-	0xc2, 0x62, 0xc8, 0x0e, 0x8c, 0xba, 0x92, 0xcd, 0xc8, 0xb5, 0x59, 0x29, 0x3c, 0xb4, 0x0c, 0xd6,
-	0x25, 0xf3, 0xec, 0xcb, 0x5f, 0x18, 0xd2, 0xd2, 0xbf, 0x32, 0x0c, 0xb3, 0x79, 0x8d, 0xc8, 0x8f,
-	0x68, 0x70, 0xa1, 0x1e, 0x49, 0x9c, 0x32, 0x5b, 0xb7, 0x6f, 0x4a, 0x07, 0xa1, 0x82, 0xe6, 0x81,
-	0xca, 0x42, 0xd8, 0x2b, 0x1e, 0xe6, 0xb8, 0x92, 0x49, 0x01, 0x73, 0x28, 0x93, 0xb7, 0x44, 0x38,
-	0xb1, 0xba, 0xea, 0x13, 0x73, 0xb3, 0xf0, 0x5c, 0x29, 0xb9, 0x1c, 0x82, 0x4e, 0x85, 0x31, 0xc5,
-	0x64, 0xb9, 0x42, 0x8e, 0x11, 0xf7, 0xbc, 0xed, 0x9b, 0x74, 0xaf, 0x6d, 0x98, 0x81, 0x1b, 0x48,
-	0x71, 0xe2, 0xb5, 0xda, 0x0d, 0x89, 0x2a, 0x4e, 0x5c, 0x29, 0x57, 0xc8, 0x91, 0x8f, 0x6b, 0x30,
-	0xe9, 0xa8, 0xf1, 0x09, 0xfa, 0xf1, 0xb2, 0xcd, 0x0c, 0x74, 0x20, 0xc4, 0xfc, 0x38, 0x28, 0x4e,
-	0x92, 0xed, 0x89, 0x19, 0x2f, 0x79, 0xac, 0x4a, 0xc6, 0xbb, 0x5a, 0x4c, 0x00, 0xcb, 0x39, 0xa3,
-	0x85, 0xc9, 0x20, 0x0d, 0x4e, 0x93, 0xe7, 0x9d, 0xa2, 0x7e, 0xbd, 0x11, 0xa5, 0xec, 0x67, 0x9d,
-	0x1a, 0x2e, 0xde, 0xa9, 0xe5, 0x8d, 0xca, 0x52, 0x0c, 0x59, 0xbc, 0x53, 0x69, 0x70, 0x9a, 0xbc,
-	0xfe, 0xb1, 0x12, 0x5c, 0xcc, 0xd9, 0x63, 0x7f, 0x6d, 0x02, 0x4a, 0x7c, 0x59, 0x83, 0x31, 0x3e,
-	0x07, 0x6f, 0x93, 0xc7, 0x53, 0xbc, 0xaf, 0x39, 0xde, 0x92, 0xbf, 0xaa, 0xc1, 0x4c, 0x2a, 0xc0,
-	0x7e, 0x4f, 0x4f, 0x6f, 0x4e, 0xcd, 0x91, 0xef, 0xb1, 0x28, 0x39, 0xcf, 0x40, 0xf4, 0xe2, 0x3c,
-	0x99, 0x98, 0x47, 0x7f, 0x05, 0x26, 0x63, 0xce, 0x92, 0x4a, 0x3c, 0xb2, 0xac, 0x48, 0x6a, 0x6a,
-	0xb8, 0xb1, 0x52, 0xb7, 0x40, 0x69, 0xd1, 0x96, 0x4f, 0x73, 0xb6, 0xbf, 0x3e, 0x5b, 0x9e, 0xc8,
-	0x2d, 0xcf, 0xef, 0x55, 0x5e, 0x87, 0x61, 0x1e, 0xe5, 0x2c, 0x38, 0x31, 0xaf, 0x16, 0x8e, 0x9e,
-	0xe6, 0x09, 0x6d, 0x4f, 0xfc, 0x8f, 0x12, 0x2b, 0xcf, 0xba, 0xae, 0xc4, 0xfe, 0x5b, 0x8b, 0x14,
-	0xcb, 0x73, 0xc9, 0x48, 0x81, 0x7c, 0x4b, 0xa6, 0x6a, 0x13, 0x14, 0xb7, 0x32, 0xe2, 0x2c, 0x2b,
-	0x14, 0x12, 0x7e, 0x69, 0xad, 0x26, 0x82, 0x51, 0x85, 0xb7, 0x31, 0x6f, 0x02, 0xd0, 0x60, 0xe3,
-	0x06, 0x2f, 0xb1, 0x5e, 0x28, 0x16, 0xec, 0x3e, 0xdc, 0xfe, 0x81, 0xe0, 0x19, 0x16, 0x79, 0xa8,
-	0x10, 0x21, 0x2e, 0x8c, 0x6f, 0x9b, 0x9b, 0xd4, 0xb5, 0x85, 0x0c, 0x35, 0x54, 0x5c, 0x3c, 0xbc,
-	0x11, 0xa1, 0x11, 0x36, 0x08, 0xa5, 0x00, 0x55, 0x22, 0xc4, 0x8d, 0x45, 0x36, 0x1d, 0x2e, 0x2e,
-	0x12, 0x45, 0x76, 0xf1, 0x68, 0x9c, 0x39, 0x51, 0x4d, 0x6d, 0x00, 0x3b, 0x0c, 0x27, 0xd8, 0xcf,
-	0x2d, 0x4d, 0x14, 0x94, 0x50, 0x08, 0x1d, 0xd1, 0x6f, 0x54, 0x28, 0xb0, 0x79, 0x6d, 0x45, 0xd1,
-	0xa3, 0xa5, 0x8d, 0xf3, 0xa5, 0x3e, 0x23, 0x78, 0x4b, 0xdb, 0x4e, 0x54, 0x80, 0x2a, 0x11, 0x36,
-	0xc6, 0x56, 0x18, 0xf3, 0x59, 0xda, 0x30, 0x0b, 0x8d, 0x31, 0x8a, 0x1c, 0x2d, 0x53, 0xf1, 0x86,
-	0xbf, 0x51, 0xa1, 0x40, 0xde, 0x50, 0x2e, 0xf3, 0xa0, 0xb8, 0x85, 0xac, 0xa7, 0x8b, 0xbc, 0xf7,
-	0x46, 0x86, 0xa2, 0x71, 0xfe, 0x9d, 0x3e, 0xa8, 0x18, 0x89, 0x78, 0x2c, 0x6c, 0xc6, 0x3b, 0x52,
-	0x46, 0xa3, 0xc8, 0x45, 0x7b, 0xa2, 0xab, 0x8b, 0x76, 0x85, 0x49, 0x67, 0xca, 0x93, 0x21, 0xce,
-	0x10, 0x26, 0xa3, 0x1b, 0x98, 0x5a, 0x12, 0x88, 0xe9, 0xfa, 0x82, 0xe1, 0xd3, 0x06, 0x6f, 0x3b,
-	0xa5, 0x32, 0x7c, 0x51, 0x86, 0x21, 0x94, 0xec, 0xc0, 0x84, 0xa7, 0xf8, 0x7b, 0xcb, 0xfc, 0xe9,
-	0x7d, 0xdc, 0xe7, 0x49, 0x5f, 0x6f, 0x1e, 0x57, 0x4d, 0x2d, 0xc1, 0x18, 0x1d, 0xf2, 0x96, 0xea,
-	0xe0, 0x3a, 0xdd, 0x5f, 0x44, 0xe4, 0x74, 0x8c, 0xef, 0xc8, 0x02, 0x18, 0xfa, 0x56, 0xaa, 0x7e,
-	0xa7, 0x9d, 0xb8, 0x2b, 0xe7, 0xcc, 0xb1, 0x04, 0x58, 0x38, 0xd4, 0xd5, 0x93, 0x2d, 0x2d, 0xdd,
-	0x6d, 0x3b, 0x5e, 0xc7, 0xa5, 0x3c, 0x77, 0x01, 0x5f, 0x1e, 0x12, 0x2d, 0xed, 0x72, 0x12, 0x88,
-	0xe9, 0xfa, 0xe4, 0x7b, 0x35, 0x98, 0x16, 0xe9, 0xe7, 0xd9, 0xb1, 0xe5, 0xd8, 0xd4, 0xf6, 0x3d,
-	0x9e, 0x5f, 0xbd, 0xe0, 0x9b, 0xe0, 0x5a, 0x02, 0x97, 0x38, 0x76, 0x92, 0xa5, 0x98, 0xa2, 0xc9,
-	0x76, 0x8e, 0x1a, 0xa2, 0x81, 0xa7, 0x69, 0x2f, 0xb8, 0x73, 0xd4, 0xf0, 0x0f, 0x62, 0xe7, 0xa8,
-	0x25, 0x18, 0xa3, 0x43, 0x9e, 0x85, 0x49, 0x2f, 0x48, 0xd4, 0xc8, 0x67, 0xf0, 0x7c, 0x14, 0x9c,
-	0xae, 0xa6, 0x02, 0x30, 0x5e, 0x8f, 0x7c, 0x14, 0x26, 0xd4, 0xb3, 0x53, 0x26, 0x77, 0x3f, 0xc6,
-	0x18, 0xc7, 0xa2, 0xe7, 0x2a, 0x28, 0x46, 0x90, 0x20, 0x5c, 0xa8, 0x47, 0x4a, 0xba, 0xfa, 0x7d,
-	0x5f, 0xe4, 0x43, 0x10, 0xca, 0x74, 0x66, 0x0d, 0xcc, 0x69, 0x49, 0x7e, 0x2c, 0xfb, 0xee, 0x7a,
-	0x96, 0x6f, 0xe9, 0xf5, 0x63, 0xb9, 0xbb, 0x7e, 0xc5, 0xf4, 0xb7, 0x6f, 0xb5, 0x45, 0xc8, 0x9f,
-	0xa3, 0x5e, 0x63, 0xff, 0xae, 0x06, 0x10, 0x5a, 0x6b, 0x4e, 0xe3, 0x9e, 0xa4, 0x11, 0x33, 0x60,
-	0x2d, 0xf6, 0x65, 0x5d, 0xca, 0x0d, 0x61, 0xaf, 0xff, 0x8e, 0x06, 0x53, 0x51, 0xb5, 0x53, 0x50,
-	0x8d, 0xea, 0x71, 0xd5, 0xe8, 0xc5, 0xfe, 0xc6, 0x95, 0xa3, 0x1f, 0xfd, 0xef, 0x92, 0x3a, 0x2a,
-	0x2e, 0xfd, 0xee, 0xc4, 0xfc, 0x0e, 0x18, 0xe9, 0x1b, 0xfd, 0xf8, 0x1d, 0xa8, 0xcf, 0xe2, 0xa3,
-	0xf1, 0x66, 0xf8, 0x21, 0xfc, 0xbf, 0x31, 0xf9, 0xb3, 0x8f, 0x80, 0x14, 0xa1, 0xb0, 0x19, 0x90,
-	0x16, 0x13, 0x70, 0x98, 0x30, 0xfa, 0xa6, 0x7a, 0x3c, 0xf5, 0x11, 0x76, 0x3e, 0x36, 0xe0, 0xae,
-	0x87, 0x92, 0xfe, 0x7d, 0x67, 0x60, 0x5c, 0x31, 0x6c, 0x26, 0xbc, 0x28, 0xb4, 0xd3, 0xf0, 0xa2,
-	0xf0, 0x61, 0xbc, 0x1e, 0xe6, 0x5f, 0x0a, 0xa6, 0xbd, 0x4f, 0x9a, 0xe1, 0xb1, 0x18, 0x65, 0x76,
-	0xf2, 0x50, 0x25, 0xc3, 0x84, 0xb7, 0x70, 0x8f, 0x0d, 0x1c, 0x83, 0x6f, 0x4b, 0xb7, 0x7d, 0xf5,
-	0x0c, 0x40, 0x20, 0xff, 0xd3, 0x86, 0x0c, 0x17, 0x1c, 0x3e, 0xfe, 0xa8, 0x7a, 0x37, 0x42, 0x18,
-	0x2a, 0xf5, 0xd2, 0xb7, 0xf2, 0x43, 0xa7, 0x76, 0x2b, 0xcf, 0xb6, 0x81, 0x15, 0xa4, 0x13, 0xed,
-	0xcb, 0x77, 0x2c, 0x4c, 0x4a, 0x1a, 0x6d, 0x83, 0xb0, 0xc8, 0x43, 0x85, 0x48, 0x8e, 0x33, 0xcd,
-	0x48, 0x21, 0x67, 0x9a, 0x0e, 0x9c, 0x75, 0xa9, 0xef, 0xee, 0x55, 0xf6, 0xea, 0x3c, 0xce, 0xbe,
-	0xeb, 0x73, 0x0d, 0x7e, 0xb4, 0x58, 0x24, 0x33, 0x4c, 0xa3, 0xc2, 0x2c, 0xfc, 0x31, 0x01, 0x78,
-	0xac, 0xab, 0x00, 0xfc, 0x5e, 0x18, 0xf7, 0x69, 0x7d, 0xdb, 0x36, 0xeb, 0x86, 0x55, 0x5d, 0x92,
-	0xf1, 0x6a, 0x23, 0x59, 0x2e, 0x02, 0xa1, 0x5a, 0x8f, 0x2c, 0xc2, 0x40, 0xc7, 0x6c, 0x48, 0x0d,
-	0xe0, 0x1b, 0xc3, 0x2b, 0x82, 0xea, 0xd2, 0xfd, 0xfd, 0xf2, 0x3b, 0x23, 0xef, 0x94, 0x70, 0x54,
-	0x57, 0xda, 0x77, 0x9a, 0x57, 0xfc, 0xbd, 0x36, 0xf5, 0xe6, 0x6f, 0x57, 0x97, 0x90, 0x35, 0xce,
-	0x72, 0x34, 0x9a, 0x38, 0x82, 0xa3, 0xd1, 0x67, 0x35, 0x38, 0x6b, 0x24, 0x6f, 0x37, 0xa8, 0x37,
-	0x3b, 0x59, 0x9c, 0x5b, 0x66, 0xdf, 0x98, 0x2c, 0x3e, 0x28, 0xc7, 0x77, 0x76, 0x21, 0x4d, 0x0e,
-	0xb3, 0xfa, 0x40, 0x5c, 0x20, 0x2d, 0xb3, 0x19, 0x66, 0xf6, 0x94, 0xab, 0x3e, 0x55, 0xcc, 0x6e,
-	0xb3, 0x9a, 0xc2, 0x84, 0x19, 0xd8, 0xc9, 0x5d, 0x18, 0x57, 0x84, 0x24, 0xa9, 0xc9, 0x2c, 0x1d,
-	0xc7, 0x25, 0x8c, 0xd0, 0x76, 0xd5, 0x0b, 0x16, 0x95, 0x52, 0x78, 0xc3, 0xaa, 0x98, 0x19, 0xe4,
-	0x2d, 0x23, 0x1f, 0xf5, 0x74, 0xf1, 0x1b, 0xd6, 0x6c, 0x8c, 0xd8, 0x85, 0x1a, 0x8f, 0x1f, 0x66,
-	0xc5, 0x13, 0xf0, 0xce, 0xce, 0x14, 0x7f, 0xdf, 0x9f, 0xc8, 0xe5, 0x2b, 0xb6, 0x66, 0xa2, 0x10,
-	0x93, 0x04, 0xc9, 0x35, 0x20, 0x54, 0x98, 0xd2, 0x23, 0xe5, 0xcc, 0x9b, 0x25, 0x61, 0xa2, 0x62,
-	0xb2, 0x9c, 0x82, 0x62, 0x46, 0x0b, 0xe2, 0xc7, 0x6c, 0x25, 0x7d, 0x68, 0x39, 0xc9, 0x04, 0x0e,
-	0xdd, 0x2c, 0x26, 0xfa, 0x6f, 0x6b, 0xd2, 0xbc, 0x7a, 0x8a, 0xfe, 0x3d, 0x27, 0x7d, 0xf1, 0xaa,
-	0xbf, 0x02, 0xb3, 0xb5, 0x20, 0xa2, 0x5d, 0x23, 0x11, 0x0f, 0xfa, 0x79, 0x98, 0x14, 0xd7, 0x1b,
-	0xab, 0x46, 0x7b, 0x2d, 0xb2, 0x85, 0x87, 0xef, 0xb5, 0x2b, 0x2a, 0x10, 0xe3, 0x75, 0xf5, 0xaf,
-	0x6a, 0x70, 0x31, 0x8e, 0xd9, 0x71, 0xcd, 0x7b, 0xfd, 0x23, 0x26, 0x9f, 0xd0, 0x60, 0x3c, 0xba,
-	0xb9, 0x0b, 0xc4, 0x91, 0x42, 0xef, 0x02, 0x82, 0x5e, 0x51, 0x57, 0xb9, 0xca, 0x49, 0x67, 0xe8,
-	0x8a, 0x80, 0x1e, 0xaa, 0xa4, 0xf5, 0x3f, 0xd1, 0x20, 0xa5, 0x12, 0x93, 0x4d, 0x18, 0x61, 0x44,
-	0x96, 0xd6, 0x6a, 0x72, 0x4f, 0x3c, 0x5f, 0x4c, 0x52, 0xe2, 0x28, 0x84, 0xa1, 0x5f, 0xfe, 0xc0,
-	0x00, 0x31, 0x53, 0xb2, 0x6d, 0x25, 0x93, 0x83, 0xdc, 0x1e, 0x85, 0x44, 0x51, 0x35, 0x23, 0x84,
-	0x50, 0x55, 0xd5, 0x12, 0x8c, 0xd1, 0xd1, 0x57, 0x00, 0x22, 0x33, 0x46, 0xdf, 0xfe, 0x72, 0xff,
-	0xec, 0x0c, 0x9c, 0xef, 0xf7, 0xf5, 0x12, 0x4f, 0xd1, 0x4b, 0x77, 0xcc, 0xba, 0xbf, 0xb0, 0xe5,
-	0x53, 0xf7, 0xd6, 0xad, 0xd5, 0x8d, 0x6d, 0x97, 0x7a, 0xdb, 0x8e, 0xd5, 0x28, 0x98, 0x23, 0x98,
-	0xab, 0xdb, 0xcb, 0x99, 0x18, 0x31, 0x87, 0x12, 0x37, 0xe1, 0xec, 0x08, 0xe5, 0x16, 0x99, 0x1e,
-	0xd1, 0x71, 0x3d, 0x5f, 0x06, 0xa9, 0x12, 0x26, 0x9c, 0x24, 0x10, 0xd3, 0xf5, 0x93, 0x48, 0x56,
-	0xcc, 0x96, 0x29, 0x92, 0x3e, 0x68, 0x69, 0x24, 0x1c, 0x88, 0xe9, 0xfa, 0x2a, 0x12, 0xb1, 0x52,
-	0x8c, 0xd1, 0x0f, 0xa5, 0x91, 0x84, 0x40, 0x4c, 0xd7, 0x27, 0x0d, 0x78, 0xc8, 0xa5, 0x75, 0xa7,
-	0xd5, 0xa2, 0x76, 0x43, 0x64, 0xd3, 0x37, 0xdc, 0xa6, 0x69, 0x5f, 0x73, 0x0d, 0x5e, 0x91, 0x5b,
-	0xc4, 0x35, 0x9e, 0xf1, 0xef, 0x21, 0xec, 0x52, 0x0f, 0xbb, 0x62, 0x21, 0x2d, 0x38, 0x23, 0x52,
-	0xed, 0xba, 0x55, 0xdb, 0xa7, 0xee, 0x8e, 0x61, 0x49, 0xb3, 0xf7, 0x51, 0x57, 0x8c, 0x1f, 0x3e,
-	0xb7, 0xe3, 0xa8, 0x30, 0x89, 0x9b, 0xec, 0x31, 0x91, 0x53, 0x76, 0x47, 0x21, 0x39, 0x5a, 0x3c,
-	0x89, 0x35, 0xa6, 0xd1, 0x61, 0x16, 0x0d, 0x52, 0x85, 0xb3, 0xbe, 0xe1, 0x36, 0xa9, 0x5f, 0x59,
-	0xbf, 0xbd, 0x4e, 0xdd, 0x3a, 0xe3, 0xb1, 0x96, 0x90, 0x40, 0x35, 0x81, 0x6a, 0x23, 0x0d, 0xc6,
-	0xac, 0x36, 0xe4, 0xa3, 0xf0, 0x58, 0x7c, 0x52, 0x57, 0x9c, 0xbb, 0xd4, 0x5d, 0x74, 0x3a, 0x76,
-	0x23, 0x8e, 0x1c, 0x38, 0xf2, 0x27, 0x0e, 0xf6, 0xcb, 0x8f, 0x61, 0x2f, 0x0d, 0xb0, 0x37, 0xbc,
-	0xe9, 0x0e, 0xdc, 0x6e, 0xb7, 0x33, 0x3b, 0x30, 0x9e, 0xd7, 0x81, 0x9c, 0x06, 0xd8, 0x1b, 0x5e,
-	0x82, 0x70, 0x41, 0x4c, 0x8c, 0xc8, 0x4f, 0xa9, 0x50, 0x9c, 0xe0, 0x14, 0xf9, 0xf7, 0xbb, 0x91,
-	0x59, 0x03, 0x73, 0x5a, 0xb2, 0x33, 0xe5, 0xf1, 0xbc, 0xe1, 0xa7, 0xc8, 0x4c, 0x72, 0x32, 0xef,
-	0x3e, 0xd8, 0x2f, 0x3f, 0x8e, 0x3d, 0xb6, 0xc1, 0x9e, 0xb1, 0x67, 0x74, 0x25, 0x9a, 0x88, 0x54,
-	0x57, 0xa6, 0xf2, 0xba, 0x92, 0xdf, 0x06, 0x7b, 0xc6, 0x4e, 0xbe, 0x5f, 0x83, 0x07, 0xea, 0xed,
-	0xce, 0x0d, 0xd3, 0xf3, 0x9d, 0xa6, 0x6b, 0xb4, 0x96, 0x68, 0xdd, 0xd8, 0xbb, 0x61, 0x58, 0x5b,
-	0x2b, 0xe6, 0x16, 0x95, 0x82, 0xf4, 0x51, 0x3f, 0x1c, 0xfe, 0xba, 0xb3, 0xb2, 0x7e, 0x3b, 0x1b,
-	0x29, 0xe6, 0xd3, 0x23, 0x3f, 0xaa, 0xc1, 0x43, 0x22, 0xf9, 0x71, 0x4e, 0x87, 0xa6, 0x0b, 0x75,
-	0x88, 0x73, 0xb1, 0xd5, 0x2e, 0x78, 0xb1, 0x2b, 0x55, 0x3e, 0x49, 0xa2, 0xc2, 0x42, 0xb3, 0xe9,
-	0xd2, 0x26, 0xc7, 0x1a, 0x72, 0x97, 0x99, 0xe2, 0x93, 0xb4, 0x9a, 0x87, 0x14, 0xf3, 0xe9, 0x91,
-	0x37, 0xe0, 0x52, 0x2e, 0xb0, 0xe2, 0x74, 0x6c, 0x9f, 0x5f, 0x2c, 0x0c, 0x2c, 0xea, 0x07, 0xfb,
-	0xe5, 0x4b, 0xab, 0x5d, 0x6b, 0xe2, 0x21, 0x98, 0xf4, 0xcf, 0x6a, 0x20, 0x9f, 0x80, 0x91, 0x87,
-	0x62, 0xce, 0x12, 0xa3, 0x09, 0x47, 0x89, 0x20, 0xb1, 0x5c, 0x29, 0x33, 0xb1, 0xdc, 0xbb, 0x94,
-	0x98, 0x8e, 0x63, 0x91, 0x38, 0x2c, 0x30, 0x2b, 0x59, 0x97, 0x9f, 0x84, 0xb1, 0x50, 0x15, 0x90,
-	0x26, 0x1a, 0x1e, 0xe0, 0x3e, 0xd2, 0x19, 0x22, 0xb8, 0xfe, 0x9b, 0x1a, 0x40, 0x94, 0xcf, 0xb0,
-	0xb7, 0x5c, 0xd1, 0x87, 0xfa, 0x6f, 0x2b, 0x39, 0xae, 0x07, 0x72, 0x73, 0x5c, 0x9f, 0x50, 0xea,
-	0xe7, 0x5f, 0xd0, 0xe0, 0x4c, 0x3c, 0xc8, 0xa6, 0x47, 0x1e, 0x83, 0x11, 0x19, 0x1a, 0x5c, 0xc6,
-	0xf6, 0xe5, 0x4d, 0x65, 0x1c, 0x2c, 0x0c, 0x60, 0xf1, 0x3b, 0xb5, 0x3e, 0x6c, 0xa6, 0xd9, 0xb1,
-	0x3e, 0x0f, 0x31, 0x5f, 0xfe, 0xab, 0x19, 0x18, 0x16, 0x9e, 0xab, 0x4c, 0x52, 0xcb, 0x88, 0xff,
-	0x71, 0xb3, 0x78, 0xf8, 0xea, 0x22, 0x31, 0x12, 0xd4, 0xdc, 0x58, 0xa5, 0xae, 0xb9, 0xb1, 0x50,
-	0xa4, 0xd4, 0xef, 0xc3, 0x7f, 0xa2, 0x82, 0x55, 0xe1, 0x3f, 0x11, 0xa6, 0xd3, 0xf7, 0x63, 0x8e,
-	0x05, 0x83, 0xc5, 0x15, 0x57, 0x31, 0x01, 0x8a, 0x7b, 0xc1, 0x54, 0x57, 0xd7, 0x82, 0x20, 0x70,
-	0xef, 0x50, 0xf1, 0xf7, 0x14, 0x72, 0xca, 0x7b, 0x09, 0xdc, 0x1b, 0x7c, 0x48, 0xc3, 0xb9, 0x1f,
-	0xd2, 0x16, 0x8c, 0xc8, 0x4f, 0x41, 0x8a, 0x7c, 0xcf, 0xf7, 0x91, 0x3e, 0x55, 0x49, 0x8a, 0x21,
-	0x0a, 0x30, 0x40, 0xce, 0xf4, 0x88, 0x96, 0xb1, 0x6b, 0xb6, 0x3a, 0x2d, 0x2e, 0xe7, 0x0d, 0xa9,
-	0x55, 0x79, 0x31, 0x06, 0x70, 0x5e, 0x55, 0x3c, 0x43, 0xe1, 0x72, 0x99, 0x5a, 0x55, 0x14, 0x63,
-	0x00, 0x27, 0xaf, 0xc1, 0x68, 0xcb, 0xd8, 0xad, 0x75, 0xdc, 0x26, 0x95, 0x6e, 0x05, 0xf9, 0x6a,
-	0x7f, 0xc7, 0x37, 0xad, 0x79, 0xd3, 0xf6, 0x3d, 0xdf, 0x9d, 0xaf, 0xda, 0xfe, 0x2d, 0xb7, 0xe6,
-	0xbb, 0x61, 0x82, 0xea, 0x55, 0x89, 0x05, 0x43, 0x7c, 0xc4, 0x82, 0xa9, 0x96, 0xb1, 0x7b, 0xdb,
-	0x36, 0x44, 0x4c, 0x66, 0x29, 0x47, 0x15, 0xa1, 0xc0, 0xfd, 0xca, 0x56, 0x63, 0xb8, 0x30, 0x81,
-	0x3b, 0xc3, 0x85, 0x6d, 0xe2, 0xa4, 0x5c, 0xd8, 0x16, 0xc2, 0x87, 0xce, 0xc2, 0x10, 0xf9, 0x40,
-	0x66, 0x88, 0xa4, 0xae, 0x8f, 0x98, 0x5f, 0x0f, 0x1f, 0x31, 0x4f, 0x15, 0xf7, 0xb9, 0xea, 0xf2,
-	0x80, 0xb9, 0x03, 0xe3, 0x0d, 0xc3, 0x37, 0x44, 0xa9, 0x37, 0x7b, 0xa6, 0xf8, 0x9d, 0xda, 0x52,
-	0x88, 0x26, 0x62, 0x49, 0x51, 0x99, 0x87, 0x2a, 0x1d, 0x72, 0x0b, 0xce, 0xb3, 0x8f, 0xd5, 0xa2,
-	0x7e, 0x54, 0x85, 0x5b, 0x45, 0xa6, 0xf9, 0xf7, 0xc3, 0x1f, 0xf6, 0xdc, 0xcc, 0xaa, 0x80, 0xd9,
-	0xed, 0xa2, 0x70, 0x7e, 0x33, 0xd9, 0xe1, 0xfc, 0xc8, 0x0f, 0x64, 0x39, 0x0b, 0x10, 0x3e, 0xa7,
-	0x1f, 0x2c, 0xce, 0x1b, 0x0a, 0xbb, 0x0c, 0xfc, 0x53, 0x0d, 0x66, 0xe5, 0x2e, 0x93, 0x17, 0xfc,
-	0x16, 0x75, 0x57, 0x0d, 0xdb, 0x68, 0x52, 0x57, 0x5a, 0xf7, 0x36, 0xfa, 0xe0, 0x0f, 0x29, 0x9c,
-	0xe1, 0xeb, 0xf2, 0x47, 0x0f, 0xf6, 0xcb, 0x97, 0x0f, 0xab, 0x85, 0xb9, 0x7d, 0x23, 0x2e, 0x8c,
-	0x78, 0x7b, 0x5e, 0xdd, 0xb7, 0xbc, 0xd9, 0x73, 0x7c, 0xb3, 0x5c, 0xef, 0x83, 0xb3, 0xd6, 0x04,
-	0x26, 0xc1, 0x5a, 0xa3, 0x54, 0x4c, 0xa2, 0x14, 0x03, 0x42, 0xe4, 0x87, 0x35, 0x98, 0x91, 0x26,
-	0x7f, 0x25, 0x82, 0xc7, 0xf9, 0xe2, 0x4f, 0x0b, 0x2a, 0x49, 0x64, 0xc1, 0xa5, 0x3e, 0xb7, 0x17,
-	0xa4, 0xa0, 0x98, 0xa6, 0xce, 0x0e, 0xd5, 0xb6, 0x6b, 0x3a, 0xae, 0xe9, 0xef, 0x71, 0xf7, 0x89,
-	0xa1, 0x20, 0xde, 0xab, 0x28, 0xc3, 0x10, 0xda, 0x6f, 0x30, 0x9e, 0x3e, 0xe2, 0xaf, 0xcf, 0x5d,
-	0x85, 0x09, 0x75, 0x8a, 0x8f, 0x14, 0x03, 0xe8, 0xa7, 0x34, 0x98, 0x4e, 0x1e, 0xb9, 0x64, 0x1b,
-	0x46, 0xe4, 0xf7, 0x27, 0x0d, 0x7d, 0x0b, 0x45, 0x5d, 0x04, 0x2d, 0x2a, 0x1f, 0x02, 0x0a, 0x09,
-	0x4e, 0x16, 0x61, 0x80, 0x5e, 0x75, 0xff, 0x2d, 0x75, 0x71, 0xff, 0x7d, 0x01, 0x2e, 0x64, 0x7f,
-	0x89, 0x4c, 0xfe, 0x35, 0x2c, 0xcb, 0xb9, 0x2b, 0xad, 0x69, 0x51, 0xbe, 0x5e, 0x56, 0x88, 0x02,
-	0xa6, 0x7f, 0x04, 0x92, 0x19, 0x40, 0xc8, 0x1b, 0x30, 0xe6, 0x79, 0xdb, 0xc2, 0xa9, 0x43, 0x0e,
-	0xb2, 0x98, 0x0d, 0x3a, 0x88, 0xc6, 0x2e, 0x44, 0xf6, 0xf0, 0x27, 0x46, 0xe8, 0x17, 0x5f, 0xfd,
-	0xd2, 0x57, 0x2f, 0xbd, 0xe3, 0xb7, 0xbe, 0x7a, 0xe9, 0x1d, 0x5f, 0xf9, 0xea, 0xa5, 0x77, 0x7c,
-	0xd7, 0xc1, 0x25, 0xed, 0x4b, 0x07, 0x97, 0xb4, 0xdf, 0x3a, 0xb8, 0xa4, 0x7d, 0xe5, 0xe0, 0x92,
-	0xf6, 0x1f, 0x0f, 0x2e, 0x69, 0x3f, 0xf4, 0x9f, 0x2e, 0xbd, 0xe3, 0xb5, 0xa7, 0x23, 0xea, 0x57,
-	0x02, 0xa2, 0xd1, 0x3f, 0xed, 0x3b, 0xcd, 0x2b, 0x8c, 0x7a, 0xf0, 0xfa, 0x9b, 0x53, 0xff, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x59, 0x31, 0xc8, 0x3d, 0x3a, 0x0b, 0x01, 0x00,
+	// 14366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x70, 0x64, 0xd9,
+	0x59, 0x18, 0xee, 0xdb, 0x7a, 0x7f, 0x7a, 0x8c, 0x74, 0xe6, 0xd5, 0x3b, 0xbb, 0x3b, 0x1a, 0xdf,
+	0xb5, 0xfd, 0xdb, 0xc5, 0xb6, 0x86, 0x5d, 0xfc, 0x5c, 0xb3, 0xde, 0x95, 0x5a, 0x9a, 0x19, 0x79,
+	0xa4, 0x19, 0xf9, 0x6b, 0x69, 0x67, 0x6d, 0x60, 0xe1, 0xaa, 0xfb, 0xa8, 0x75, 0x57, 0xdd, 0xf7,
+	0xf6, 0xde, 0x7b, 0x5b, 0x8f, 0xb5, 0xfd, 0x33, 0xf0, 0x03, 0x7e, 0xb6, 0xc1, 0x14, 0x21, 0x24,
+	0x8e, 0x6d, 0x08, 0x26, 0x14, 0x21, 0x01, 0x42, 0x12, 0x52, 0xa4, 0x0a, 0x48, 0x2a, 0x09, 0x55,
+	0x09, 0x26, 0x81, 0x14, 0x05, 0xa4, 0x62, 0x2a, 0x41, 0xc4, 0x0a, 0x81, 0xbc, 0x2a, 0x2f, 0x2a,
+	0xa1, 0x32, 0x49, 0x41, 0xea, 0xbc, 0xee, 0x3d, 0xf7, 0xd5, 0x6a, 0xdd, 0x96, 0x64, 0x6f, 0xe0,
+	0x2f, 0xa9, 0xcf, 0xe3, 0xfb, 0xce, 0xeb, 0x7e, 0xe7, 0x3b, 0xdf, 0x13, 0x16, 0x1a, 0x76, 0xb0,
+	0xdd, 0xd9, 0x9c, 0xab, 0xb9, 0xad, 0x9b, 0x0d, 0xcb, 0xab, 0x53, 0x87, 0x7a, 0xd1, 0x3f, 0xed,
+	0x9d, 0xc6, 0x4d, 0xab, 0x6d, 0xfb, 0x37, 0x6b, 0xae, 0x47, 0x6f, 0xee, 0x3e, 0xbd, 0x49, 0x03,
+	0xeb, 0xe9, 0x9b, 0x0d, 0x56, 0x67, 0x05, 0xb4, 0x3e, 0xd7, 0xf6, 0xdc, 0xc0, 0x25, 0xcf, 0x44,
+	0x30, 0xe6, 0x54, 0xd7, 0xe8, 0x9f, 0xf6, 0x4e, 0x63, 0x8e, 0xc1, 0x98, 0x63, 0x30, 0xe6, 0x24,
+	0x8c, 0x6b, 0x6f, 0xd7, 0xf1, 0xba, 0x0d, 0xf7, 0x26, 0x07, 0xb5, 0xd9, 0xd9, 0xe2, 0xbf, 0xf8,
+	0x0f, 0xfe, 0x9f, 0x40, 0x71, 0xed, 0xa9, 0x9d, 0xf7, 0xf8, 0x73, 0xb6, 0xcb, 0x06, 0x73, 0xd3,
+	0xea, 0x04, 0xae, 0x5f, 0xb3, 0x9a, 0xb6, 0xd3, 0xb8, 0xb9, 0x9b, 0x1a, 0xcd, 0x35, 0x53, 0x6b,
+	0x2a, 0x87, 0xdd, 0xb5, 0x8d, 0xb7, 0x69, 0xd5, 0xb2, 0xda, 0xdc, 0x89, 0xda, 0xd0, 0xfd, 0x80,
+	0x3a, 0xbe, 0xed, 0x3a, 0xfe, 0xdb, 0xd9, 0x4c, 0xa8, 0xb7, 0xab, 0xaf, 0x4d, 0xac, 0x41, 0x16,
+	0xa4, 0x77, 0x44, 0x90, 0x5a, 0x56, 0x6d, 0xdb, 0x76, 0xa8, 0x77, 0xa0, 0xba, 0xdf, 0xf4, 0xa8,
+	0xef, 0x76, 0xbc, 0x1a, 0x3d, 0x51, 0x2f, 0xff, 0x66, 0x8b, 0x06, 0x56, 0x16, 0xae, 0x9b, 0x79,
+	0xbd, 0xbc, 0x8e, 0x13, 0xd8, 0xad, 0x34, 0x9a, 0x77, 0x1d, 0xd7, 0xc1, 0xaf, 0x6d, 0xd3, 0x96,
+	0x95, 0xea, 0xf7, 0x75, 0x79, 0xfd, 0x3a, 0x81, 0xdd, 0xbc, 0x69, 0x3b, 0x81, 0x1f, 0x78, 0xc9,
+	0x4e, 0xe6, 0xa7, 0x0c, 0x98, 0x9e, 0x5f, 0x5b, 0xae, 0xf2, 0x15, 0x5c, 0x71, 0x1b, 0x0d, 0xdb,
+	0x69, 0x90, 0xb7, 0xc2, 0xd8, 0x2e, 0xf5, 0x36, 0x5d, 0xdf, 0x0e, 0x0e, 0xca, 0xc6, 0x0d, 0xe3,
+	0xc9, 0xa1, 0x85, 0xc9, 0xa3, 0xc3, 0xd9, 0xb1, 0x17, 0x55, 0x21, 0x46, 0xf5, 0x64, 0x19, 0x2e,
+	0x6e, 0x07, 0x41, 0x7b, 0xbe, 0x56, 0xa3, 0xbe, 0x1f, 0xb6, 0x28, 0x97, 0x78, 0xb7, 0xab, 0x47,
+	0x87, 0xb3, 0x17, 0xef, 0xac, 0xaf, 0xaf, 0x25, 0xaa, 0x31, 0xab, 0x8f, 0xf9, 0x33, 0x06, 0xcc,
+	0x84, 0x83, 0x41, 0xfa, 0x6a, 0x87, 0xfa, 0x81, 0x4f, 0x10, 0xae, 0xb4, 0xac, 0xfd, 0x7b, 0xae,
+	0xb3, 0xda, 0x09, 0xac, 0xc0, 0x76, 0x1a, 0xcb, 0xce, 0x56, 0xd3, 0x6e, 0x6c, 0x07, 0x72, 0x68,
+	0xd7, 0x8e, 0x0e, 0x67, 0xaf, 0xac, 0x66, 0xb6, 0xc0, 0x9c, 0x9e, 0x6c, 0xd0, 0x2d, 0x6b, 0x3f,
+	0x05, 0x50, 0x1b, 0xf4, 0x6a, 0xba, 0x1a, 0xb3, 0xfa, 0x98, 0xef, 0x84, 0x19, 0x31, 0x0f, 0xa4,
+	0x7e, 0xe0, 0xd9, 0xb5, 0xc0, 0x76, 0x1d, 0x72, 0x03, 0x06, 0x1d, 0xab, 0x45, 0xf9, 0x08, 0xc7,
+	0x16, 0x26, 0xbe, 0x78, 0x38, 0xfb, 0x86, 0xa3, 0xc3, 0xd9, 0xc1, 0x7b, 0x56, 0x8b, 0x22, 0xaf,
+	0x31, 0xff, 0x47, 0x09, 0x1e, 0x4b, 0xf5, 0x7b, 0x60, 0x07, 0xdb, 0xf7, 0xdb, 0xec, 0x3f, 0x9f,
+	0x7c, 0xaf, 0x01, 0x33, 0x56, 0xb2, 0x01, 0x07, 0x38, 0xfe, 0xcc, 0xd2, 0xdc, 0xc9, 0x3f, 0xf0,
+	0xb9, 0x14, 0xb6, 0x85, 0x47, 0xe4, 0xb8, 0xd2, 0x13, 0xc0, 0x34, 0x6a, 0xf2, 0x09, 0x03, 0x46,
+	0x5c, 0x31, 0xb8, 0x72, 0xe9, 0xc6, 0xc0, 0x93, 0xe3, 0xcf, 0x7c, 0xd3, 0xa9, 0x0c, 0x43, 0x9b,
+	0xf4, 0x9c, 0xfc, 0xbb, 0xe4, 0x04, 0xde, 0xc1, 0xc2, 0x05, 0x39, 0xbc, 0x11, 0x59, 0x8a, 0x0a,
+	0xfd, 0xb5, 0x67, 0x61, 0x42, 0x6f, 0x49, 0xa6, 0x61, 0x60, 0x87, 0x8a, 0xa3, 0x3a, 0x86, 0xec,
+	0x5f, 0x72, 0x09, 0x86, 0x76, 0xad, 0x66, 0x87, 0xf2, 0x2d, 0x1d, 0x43, 0xf1, 0xe3, 0xd9, 0xd2,
+	0x7b, 0x0c, 0xf3, 0x19, 0x18, 0x9a, 0xaf, 0xd7, 0x5d, 0x87, 0x3c, 0x05, 0x23, 0xd4, 0xb1, 0x36,
+	0x9b, 0xb4, 0xce, 0x3b, 0x8e, 0x46, 0xf8, 0x96, 0x44, 0x31, 0xaa, 0x7a, 0xf3, 0xcf, 0x95, 0x60,
+	0x98, 0x77, 0xf2, 0xc9, 0xf7, 0x1b, 0x70, 0x71, 0xa7, 0xb3, 0x49, 0x3d, 0x87, 0x06, 0xd4, 0x5f,
+	0xb4, 0xfc, 0xed, 0x4d, 0xd7, 0xf2, 0xea, 0x72, 0x63, 0x6e, 0x17, 0x59, 0x91, 0xbb, 0x69, 0x70,
+	0xe2, 0x0c, 0x66, 0x54, 0x60, 0x16, 0x72, 0xb2, 0x0b, 0x13, 0x4e, 0xc3, 0x76, 0xf6, 0x97, 0x9d,
+	0x86, 0x47, 0x7d, 0x9f, 0x4f, 0x7a, 0xfc, 0x99, 0x17, 0x8a, 0x0c, 0xe6, 0x9e, 0x06, 0x67, 0x61,
+	0xfa, 0xe8, 0x70, 0x76, 0x42, 0x2f, 0xc1, 0x18, 0x1e, 0xf3, 0x8f, 0x0c, 0xb8, 0x30, 0x5f, 0x6f,
+	0xd9, 0x3e, 0xa3, 0xb4, 0x6b, 0xcd, 0x4e, 0xc3, 0xee, 0xe1, 0xe8, 0x93, 0x0f, 0xc2, 0x70, 0xcd,
+	0x75, 0xb6, 0xec, 0x86, 0x1c, 0xe7, 0xdb, 0xe7, 0x04, 0xe5, 0x9a, 0xd3, 0x29, 0x17, 0x1f, 0x9e,
+	0xa4, 0x78, 0x73, 0x68, 0xed, 0x2d, 0x29, 0x82, 0xbe, 0x00, 0x47, 0x87, 0xb3, 0xc3, 0x15, 0x0e,
+	0x00, 0x25, 0x20, 0xf2, 0x24, 0x8c, 0xd6, 0x6d, 0x5f, 0x6c, 0xe6, 0x00, 0xdf, 0xcc, 0x89, 0xa3,
+	0xc3, 0xd9, 0xd1, 0x45, 0x59, 0x86, 0x61, 0x2d, 0x59, 0x81, 0x4b, 0x6c, 0x05, 0x45, 0xbf, 0x2a,
+	0xad, 0x79, 0x34, 0x60, 0x43, 0x2b, 0x0f, 0xf2, 0xe1, 0x96, 0x8f, 0x0e, 0x67, 0x2f, 0xdd, 0xcd,
+	0xa8, 0xc7, 0xcc, 0x5e, 0xe6, 0x2d, 0x18, 0x9d, 0x6f, 0x52, 0x8f, 0x11, 0x04, 0xf2, 0x2c, 0x4c,
+	0xd1, 0x96, 0x65, 0x37, 0x91, 0xd6, 0xa8, 0xbd, 0x4b, 0x3d, 0xbf, 0x6c, 0xdc, 0x18, 0x78, 0x72,
+	0x6c, 0x81, 0x1c, 0x1d, 0xce, 0x4e, 0x2d, 0xc5, 0x6a, 0x30, 0xd1, 0xd2, 0xfc, 0x36, 0x03, 0xc6,
+	0xe7, 0x3b, 0x75, 0x3b, 0x10, 0xf3, 0x22, 0x1e, 0x8c, 0x5b, 0xec, 0xe7, 0x9a, 0xdb, 0xb4, 0x6b,
+	0x07, 0xf2, 0x70, 0x3d, 0x5f, 0xe8, 0x73, 0x8b, 0xc0, 0x2c, 0x5c, 0x38, 0x3a, 0x9c, 0x1d, 0xd7,
+	0x0a, 0x50, 0x47, 0x62, 0x6e, 0x83, 0x5e, 0x47, 0x3e, 0x04, 0x13, 0x62, 0xba, 0xab, 0x56, 0x1b,
+	0xe9, 0x96, 0x1c, 0xc3, 0x13, 0xda, 0x5e, 0x29, 0x44, 0x73, 0xf7, 0x37, 0x5f, 0xa1, 0xb5, 0x00,
+	0xe9, 0x16, 0xf5, 0xa8, 0x53, 0xa3, 0xe2, 0xd8, 0x54, 0xb4, 0xce, 0x18, 0x03, 0x65, 0xfe, 0x59,
+	0x03, 0x1e, 0x9f, 0xef, 0x04, 0xdb, 0xae, 0x67, 0xbf, 0x46, 0xbd, 0x68, 0xb9, 0x43, 0x08, 0xe4,
+	0xfd, 0x30, 0x65, 0x85, 0x0d, 0xee, 0x45, 0xc7, 0xe9, 0x8a, 0x3c, 0x4e, 0x53, 0xf3, 0xb1, 0x5a,
+	0x4c, 0xb4, 0x26, 0xcf, 0x00, 0xf8, 0xd1, 0xde, 0x72, 0x1a, 0xb0, 0x40, 0x64, 0x5f, 0xd0, 0x76,
+	0x55, 0x6b, 0x65, 0xfe, 0x0e, 0xbb, 0x0a, 0x77, 0x2d, 0xbb, 0x69, 0x6d, 0xda, 0x4d, 0x3b, 0x38,
+	0xf8, 0xb0, 0xeb, 0xd0, 0x1e, 0x4e, 0xf3, 0x06, 0x5c, 0xed, 0x38, 0x96, 0xe8, 0xd7, 0xa4, 0xab,
+	0xe2, 0xfc, 0xae, 0x1f, 0xb4, 0xa9, 0xa0, 0x92, 0x63, 0x0b, 0x8f, 0x1e, 0x1d, 0xce, 0x5e, 0xdd,
+	0xc8, 0x6e, 0x82, 0x79, 0x7d, 0xd9, 0xad, 0xa7, 0x55, 0xbd, 0xe8, 0x36, 0x3b, 0x2d, 0x09, 0x75,
+	0x80, 0x43, 0xe5, 0xb7, 0xde, 0x46, 0x66, 0x0b, 0xcc, 0xe9, 0x69, 0x7e, 0xb1, 0x04, 0x13, 0x0b,
+	0x56, 0x6d, 0xa7, 0xd3, 0x5e, 0xe8, 0xd4, 0x76, 0x68, 0x40, 0xbe, 0x05, 0x46, 0x19, 0xdb, 0x52,
+	0xb7, 0x02, 0x4b, 0xee, 0xef, 0xd7, 0xe6, 0x7e, 0x8b, 0xfc, 0x68, 0xb1, 0xd6, 0xd1, 0x8e, 0xaf,
+	0xd2, 0xc0, 0x8a, 0x96, 0x35, 0x2a, 0xc3, 0x10, 0x2a, 0xd9, 0x82, 0x41, 0xbf, 0x4d, 0x6b, 0xf2,
+	0x4b, 0x5f, 0x2c, 0x72, 0x82, 0xf5, 0x11, 0x57, 0xdb, 0xb4, 0x16, 0xed, 0x02, 0xfb, 0x85, 0x1c,
+	0x3e, 0x71, 0x60, 0xd8, 0x0f, 0xac, 0xa0, 0xe3, 0xf3, 0xcf, 0x7f, 0xfc, 0x99, 0x5b, 0x7d, 0x63,
+	0xe2, 0xd0, 0x16, 0xa6, 0x24, 0xae, 0x61, 0xf1, 0x1b, 0x25, 0x16, 0xf3, 0x9f, 0x1b, 0x30, 0xad,
+	0x37, 0x5f, 0xb1, 0xfd, 0x80, 0x7c, 0x63, 0x6a, 0x39, 0xe7, 0x7a, 0x5b, 0x4e, 0xd6, 0x9b, 0x2f,
+	0xe6, 0xb4, 0x44, 0x37, 0xaa, 0x4a, 0xb4, 0xa5, 0xa4, 0x30, 0x64, 0x07, 0xb4, 0xa5, 0x2e, 0xdf,
+	0x17, 0xfa, 0x9d, 0xe1, 0xc2, 0xa4, 0x44, 0x36, 0xb4, 0xcc, 0xc0, 0xa2, 0x80, 0x6e, 0x7e, 0x0b,
+	0x5c, 0xd2, 0x5b, 0xad, 0x79, 0xee, 0xae, 0x5d, 0xa7, 0x1e, 0xfb, 0x12, 0x82, 0x83, 0x76, 0xea,
+	0x4b, 0x60, 0x27, 0x0b, 0x79, 0x0d, 0x79, 0x0b, 0x0c, 0x7b, 0xb4, 0xc1, 0xb8, 0x14, 0xf1, 0xc1,
+	0x85, 0x6b, 0x87, 0xbc, 0x14, 0x65, 0xad, 0xf9, 0xdf, 0x4b, 0xf1, 0xb5, 0x63, 0xdb, 0x48, 0x76,
+	0x61, 0xb4, 0x2d, 0x51, 0xc9, 0xb5, 0xbb, 0xd3, 0xef, 0x04, 0xd5, 0xd0, 0xa3, 0x55, 0x55, 0x25,
+	0x18, 0xe2, 0x22, 0x36, 0x4c, 0xa9, 0xff, 0x2b, 0x7d, 0x5c, 0x4a, 0x9c, 0xc8, 0xaf, 0xc5, 0x00,
+	0x61, 0x02, 0x30, 0x59, 0x87, 0x31, 0x41, 0x6e, 0x18, 0x39, 0x1d, 0xc8, 0x27, 0xa7, 0x55, 0xd5,
+	0x48, 0x92, 0xd3, 0x19, 0x39, 0xfc, 0xb1, 0xb0, 0x02, 0x23, 0x40, 0xec, 0xea, 0xf3, 0x29, 0xad,
+	0x6b, 0x97, 0x18, 0xbf, 0xfa, 0xaa, 0xb2, 0x0c, 0xc3, 0x5a, 0xf3, 0x0b, 0x83, 0x40, 0xd2, 0x47,
+	0x5c, 0x5f, 0x01, 0x51, 0x52, 0x36, 0xfa, 0x5e, 0x01, 0xf9, 0xb5, 0x24, 0x00, 0x93, 0xd7, 0x60,
+	0xb2, 0x69, 0xf9, 0xc1, 0xfd, 0x36, 0xf5, 0xac, 0x40, 0x1d, 0x94, 0xf1, 0x67, 0xe6, 0x8b, 0xec,
+	0xf4, 0x8a, 0x0e, 0x68, 0x61, 0xe6, 0xe8, 0x70, 0x76, 0x32, 0x56, 0x84, 0x71, 0x54, 0xe4, 0x15,
+	0x18, 0x63, 0x05, 0x4b, 0x9e, 0xe7, 0x7a, 0x72, 0xf5, 0x9f, 0x2b, 0x8a, 0x97, 0x03, 0x11, 0x6f,
+	0xa2, 0xf0, 0x27, 0x46, 0xe0, 0xc9, 0x07, 0x80, 0xb8, 0x9b, 0xfc, 0x55, 0x5a, 0xbf, 0x4d, 0x1d,
+	0x35, 0x59, 0xb6, 0x3b, 0x03, 0x0b, 0xd7, 0xe4, 0x6e, 0x92, 0xfb, 0xa9, 0x16, 0x98, 0xd1, 0x8b,
+	0xec, 0x00, 0x09, 0x1f, 0x6d, 0xe1, 0x01, 0x28, 0x0f, 0xf5, 0x7e, 0x7c, 0xae, 0x30, 0x64, 0xb7,
+	0x53, 0x20, 0x30, 0x03, 0xac, 0xf9, 0x0f, 0x4b, 0x30, 0x2e, 0x8e, 0x88, 0x60, 0xac, 0xcf, 0xfe,
+	0x82, 0xa0, 0xb1, 0x0b, 0xa2, 0x52, 0xfc, 0x9b, 0xe7, 0x03, 0xce, 0xbd, 0x1f, 0x5a, 0x89, 0xfb,
+	0x61, 0xa9, 0x5f, 0x44, 0xdd, 0xaf, 0x87, 0x7f, 0x66, 0xc0, 0x05, 0xad, 0xf5, 0x39, 0xdc, 0x0e,
+	0xf5, 0xf8, 0xed, 0xf0, 0x7c, 0x9f, 0xf3, 0xcb, 0xb9, 0x1c, 0xdc, 0xd8, 0xb4, 0x38, 0xe1, 0x7e,
+	0x06, 0x60, 0x93, 0x93, 0x13, 0x8d, 0x4d, 0x0b, 0xb7, 0x7c, 0x21, 0xac, 0x41, 0xad, 0x55, 0x8c,
+	0x66, 0x95, 0xba, 0xd2, 0xac, 0x7f, 0x33, 0x00, 0x33, 0xa9, 0x65, 0x4f, 0xd3, 0x11, 0xe3, 0x2b,
+	0x44, 0x47, 0x4a, 0x5f, 0x09, 0x3a, 0x32, 0x50, 0x88, 0x8e, 0xf4, 0x7c, 0x4f, 0x10, 0x0f, 0x48,
+	0xcb, 0x6e, 0x88, 0x6e, 0xd5, 0xc0, 0xf2, 0x82, 0x75, 0xbb, 0x45, 0x25, 0xc5, 0xf9, 0x9a, 0xde,
+	0x8e, 0x2c, 0xeb, 0x21, 0x08, 0xcf, 0x6a, 0x0a, 0x12, 0x66, 0x40, 0x37, 0xff, 0xbf, 0x12, 0x8c,
+	0x2c, 0x58, 0x3e, 0x1f, 0xe9, 0xc7, 0x60, 0x42, 0x82, 0x5e, 0x6e, 0x59, 0x0d, 0xda, 0xcf, 0xd3,
+	0x5a, 0x82, 0x5c, 0xd5, 0xc0, 0x89, 0xd7, 0x89, 0x5e, 0x82, 0x31, 0x74, 0xe4, 0x00, 0xc6, 0x5b,
+	0x11, 0x27, 0x5e, 0x2e, 0xf5, 0xc3, 0x4f, 0xea, 0xd8, 0x19, 0x34, 0xf1, 0x04, 0xd3, 0x0a, 0x50,
+	0xc7, 0x65, 0xbe, 0x0c, 0x17, 0x33, 0x46, 0xdc, 0xc3, 0x23, 0xe4, 0xcd, 0x30, 0xc2, 0xde, 0x91,
+	0x11, 0xef, 0x35, 0xce, 0xe4, 0x18, 0x2f, 0x8a, 0x22, 0x54, 0x75, 0xe6, 0xbb, 0x80, 0xc4, 0xe1,
+	0x33, 0xac, 0xbd, 0x08, 0xab, 0x86, 0x00, 0x2a, 0xf3, 0xe8, 0x06, 0xe2, 0x28, 0x3d, 0x0f, 0x43,
+	0xed, 0x6d, 0xcb, 0x57, 0x3d, 0x9e, 0x52, 0xa4, 0x62, 0x8d, 0x15, 0x3e, 0x3c, 0x9c, 0x2d, 0x57,
+	0x3c, 0x5a, 0xa7, 0x4e, 0x60, 0x5b, 0x4d, 0x5f, 0x75, 0xe2, 0x75, 0x28, 0xfa, 0xb1, 0x13, 0xc6,
+	0x0e, 0x79, 0xc5, 0x6d, 0xb5, 0x9b, 0x94, 0xd5, 0xf2, 0x13, 0x56, 0x2a, 0x76, 0xc2, 0x56, 0x52,
+	0x90, 0x30, 0x03, 0xba, 0xc2, 0xb9, 0xec, 0xd8, 0x81, 0x6d, 0x85, 0x38, 0x07, 0x8a, 0xe3, 0x8c,
+	0x43, 0xc2, 0x0c, 0xe8, 0xe4, 0x53, 0x06, 0x5c, 0x8b, 0x17, 0xdf, 0xb2, 0x1d, 0xdb, 0xdf, 0xa6,
+	0xf5, 0x75, 0x5b, 0x7e, 0x86, 0x27, 0x43, 0x7e, 0xfd, 0xe8, 0x70, 0xf6, 0xda, 0x4a, 0x2e, 0x44,
+	0xec, 0x82, 0x8d, 0x7c, 0xda, 0x80, 0x47, 0x13, 0xeb, 0xe2, 0xd9, 0x8d, 0x06, 0xf5, 0x68, 0xbd,
+	0xe0, 0x07, 0x3e, 0x7b, 0x74, 0x38, 0xfb, 0xe8, 0x4a, 0x3e, 0x48, 0xec, 0x86, 0x8f, 0xfc, 0x88,
+	0x01, 0x57, 0xda, 0xd4, 0xa9, 0xdb, 0x4e, 0xe3, 0x81, 0xeb, 0xed, 0x30, 0xb1, 0x88, 0xdb, 0x6c,
+	0xba, 0x9d, 0xc0, 0x2f, 0x0f, 0xf3, 0x3b, 0x6c, 0xb9, 0xc8, 0x37, 0xb7, 0x96, 0x05, 0x71, 0xe1,
+	0xba, 0x3c, 0xa2, 0x57, 0x32, 0xab, 0x7d, 0xcc, 0x19, 0x88, 0xf9, 0x8b, 0x06, 0x0c, 0x54, 0x70,
+	0x99, 0xbc, 0x35, 0xf6, 0x89, 0x5c, 0xd5, 0x3f, 0x91, 0x87, 0x87, 0xb3, 0x23, 0x15, 0x5c, 0xd6,
+	0x3e, 0xc6, 0x4f, 0x1b, 0x30, 0x53, 0x73, 0x9d, 0xc0, 0x62, 0x6b, 0x87, 0x82, 0x57, 0x56, 0xf7,
+	0x72, 0xa1, 0x17, 0x70, 0x25, 0x01, 0x2c, 0x12, 0xdc, 0x26, 0x6b, 0x7c, 0x4c, 0x63, 0x36, 0xbf,
+	0x64, 0xc0, 0x44, 0xa5, 0xe9, 0x76, 0xea, 0x6b, 0x9e, 0xbb, 0x65, 0x37, 0xe9, 0xeb, 0xe3, 0xd9,
+	0xaf, 0x8f, 0x38, 0x8f, 0xad, 0xe3, 0xcf, 0x70, 0xbd, 0xe1, 0xeb, 0xe4, 0x19, 0xae, 0x0f, 0x39,
+	0x87, 0xd3, 0xfa, 0x06, 0xb8, 0xac, 0xb7, 0x8a, 0x44, 0x63, 0x37, 0x60, 0x70, 0xc7, 0x76, 0xea,
+	0x49, 0x6a, 0x7d, 0xd7, 0x76, 0xea, 0xc8, 0x6b, 0x42, 0x7a, 0x5e, 0xca, 0xa5, 0xe7, 0x9f, 0x1b,
+	0x8b, 0x2f, 0x1b, 0x67, 0xe4, 0x9e, 0x84, 0xd1, 0x9a, 0xb5, 0xd0, 0x71, 0xea, 0xcd, 0xf0, 0x2a,
+	0x60, 0x4b, 0x50, 0x99, 0x17, 0x65, 0x18, 0xd6, 0x92, 0xd7, 0x00, 0x22, 0x29, 0x74, 0x3f, 0x17,
+	0x64, 0x24, 0xe0, 0xae, 0xd2, 0x20, 0xb0, 0x9d, 0x86, 0x1f, 0x9d, 0xab, 0xa8, 0x0e, 0x35, 0x6c,
+	0xe4, 0x63, 0x30, 0xa9, 0xdf, 0xd6, 0x42, 0x1c, 0x56, 0x70, 0x1b, 0x62, 0x6c, 0xc1, 0x65, 0x89,
+	0x78, 0x52, 0x2f, 0xf5, 0x31, 0x8e, 0x8d, 0x1c, 0x84, 0xbc, 0x89, 0x10, 0xc6, 0x0d, 0x16, 0xe7,
+	0xb6, 0x75, 0xb6, 0xe0, 0x92, 0x44, 0x3e, 0x11, 0x13, 0x0e, 0xc6, 0x50, 0x65, 0x48, 0x2a, 0x86,
+	0xce, 0x4a, 0x52, 0x41, 0x61, 0x44, 0xc8, 0x6a, 0x14, 0x29, 0x7e, 0xb6, 0xc8, 0x04, 0x85, 0xd8,
+	0x27, 0x52, 0xab, 0x88, 0xdf, 0x3e, 0x2a, 0xd8, 0x4c, 0x6d, 0xc1, 0x98, 0xce, 0x2a, 0x6d, 0xd2,
+	0x5a, 0xe0, 0x7a, 0xe5, 0x91, 0xe2, 0x6a, 0x8b, 0xaa, 0x06, 0x47, 0x70, 0x78, 0x7a, 0x09, 0xc6,
+	0xf0, 0x84, 0xa2, 0xac, 0xd1, 0x5c, 0x51, 0x56, 0x07, 0xc6, 0x77, 0x35, 0x91, 0xeb, 0x18, 0x5f,
+	0x84, 0xf7, 0x17, 0x19, 0x58, 0x24, 0x7f, 0x5d, 0xb8, 0x28, 0x11, 0x8d, 0xeb, 0xb2, 0x5a, 0x1d,
+	0x0f, 0xd9, 0x84, 0x91, 0x4d, 0xc1, 0x9f, 0x95, 0x81, 0xaf, 0xc5, 0xfb, 0xfa, 0x60, 0x3b, 0x05,
+	0x0f, 0x28, 0x7f, 0xa0, 0x02, 0x4c, 0xfe, 0xa2, 0x01, 0x57, 0x24, 0x3f, 0x28, 0xaf, 0xb9, 0x6a,
+	0xe0, 0x59, 0x01, 0x6d, 0x1c, 0x94, 0xc7, 0x39, 0xce, 0x0f, 0x14, 0x9a, 0x66, 0x26, 0x44, 0x21,
+	0xa5, 0xce, 0xae, 0xc3, 0x9c, 0x51, 0x98, 0x3f, 0x34, 0x01, 0x33, 0x95, 0x66, 0xc7, 0x0f, 0xa8,
+	0x37, 0x2f, 0x0d, 0x0b, 0xa8, 0x47, 0xbe, 0xdd, 0x80, 0x2b, 0xfc, 0xdf, 0x45, 0x77, 0xcf, 0x59,
+	0xa4, 0x4d, 0xeb, 0x60, 0x7e, 0x8b, 0xb5, 0xa8, 0xd7, 0x4f, 0x46, 0xe3, 0x17, 0x3b, 0xf2, 0xa5,
+	0xc7, 0x87, 0x56, 0xcd, 0x84, 0x88, 0x39, 0x98, 0xc8, 0x77, 0x1b, 0xf0, 0x48, 0x46, 0xd5, 0x22,
+	0x6d, 0xd2, 0x40, 0xf1, 0xaf, 0x27, 0x1d, 0xc7, 0xe3, 0x47, 0x87, 0xb3, 0x8f, 0x54, 0xf3, 0x80,
+	0x62, 0x3e, 0x3e, 0xa6, 0x21, 0xbe, 0x96, 0x51, 0x7b, 0xcb, 0xb2, 0x9b, 0x1d, 0x4f, 0xb1, 0xb6,
+	0x27, 0x1d, 0x0e, 0xe7, 0x30, 0xab, 0xb9, 0x50, 0xb1, 0x0b, 0x46, 0xf2, 0x71, 0xb8, 0x1c, 0xd6,
+	0x6e, 0x38, 0x0e, 0xa5, 0xf5, 0x18, 0xa3, 0x7b, 0xd2, 0xa1, 0x3c, 0x72, 0x74, 0x38, 0x7b, 0xb9,
+	0x9a, 0x05, 0x10, 0xb3, 0xf1, 0x90, 0x06, 0x3c, 0x1e, 0x55, 0x04, 0x76, 0xd3, 0x7e, 0x4d, 0xf0,
+	0xe2, 0xdb, 0x1e, 0xf5, 0xb7, 0xdd, 0x66, 0x9d, 0x53, 0x4c, 0x63, 0xe1, 0x8d, 0x47, 0x87, 0xb3,
+	0x8f, 0x57, 0xbb, 0x35, 0xc4, 0xee, 0x70, 0x48, 0x1d, 0x26, 0xfc, 0x9a, 0xe5, 0x2c, 0x3b, 0x01,
+	0xf5, 0x76, 0xad, 0x66, 0x79, 0xb8, 0xd0, 0x04, 0x05, 0x9d, 0xd2, 0xe0, 0x60, 0x0c, 0x2a, 0x79,
+	0x0f, 0x8c, 0xd2, 0xfd, 0xb6, 0xe5, 0xd4, 0xa9, 0xa0, 0x8d, 0x63, 0x0b, 0x8f, 0xb1, 0x1b, 0x79,
+	0x49, 0x96, 0x3d, 0x3c, 0x9c, 0x9d, 0x50, 0xff, 0xaf, 0xba, 0x75, 0x8a, 0x61, 0x6b, 0xf2, 0x51,
+	0xb8, 0xc4, 0x2d, 0x1f, 0xea, 0x94, 0x53, 0x7a, 0x5f, 0x3d, 0x77, 0x46, 0x0b, 0x8d, 0x93, 0x6b,
+	0x45, 0x57, 0x33, 0xe0, 0x61, 0x26, 0x16, 0xb6, 0x0d, 0x2d, 0x6b, 0xff, 0xb6, 0x67, 0xd5, 0xe8,
+	0x56, 0xa7, 0xb9, 0x4e, 0xbd, 0x96, 0xed, 0x88, 0xf7, 0x3e, 0x53, 0xf4, 0xd5, 0x19, 0x3d, 0x65,
+	0x76, 0x16, 0x7c, 0x1b, 0x56, 0xbb, 0x35, 0xc4, 0xee, 0x70, 0xc8, 0x3b, 0x60, 0xc2, 0x6e, 0x38,
+	0xae, 0x47, 0xd7, 0x2d, 0xdb, 0x09, 0xfc, 0x32, 0x70, 0xd5, 0x18, 0x5f, 0xd6, 0x65, 0xad, 0x1c,
+	0x63, 0xad, 0xc8, 0x2e, 0x10, 0x87, 0xee, 0xad, 0xb9, 0x75, 0x7e, 0x04, 0x36, 0xda, 0xfc, 0x20,
+	0x97, 0xc7, 0x0b, 0x2d, 0x0d, 0x7f, 0x0d, 0xde, 0x4b, 0x41, 0xc3, 0x0c, 0x0c, 0xe4, 0x16, 0x90,
+	0x96, 0xb5, 0xbf, 0xd4, 0x6a, 0x07, 0x07, 0x0b, 0x9d, 0xe6, 0x8e, 0xa4, 0x1a, 0x13, 0x7c, 0x2d,
+	0x84, 0xac, 0x24, 0x55, 0x8b, 0x19, 0x3d, 0x88, 0x05, 0x8f, 0x8a, 0xf9, 0x2c, 0x5a, 0xb4, 0xe5,
+	0x3a, 0x3e, 0x0d, 0x7c, 0xed, 0x90, 0x96, 0x27, 0xb9, 0xfe, 0x9b, 0xbf, 0xcd, 0x96, 0xf3, 0x9b,
+	0x61, 0x37, 0x18, 0x71, 0x0b, 0xa0, 0xa9, 0x63, 0x2c, 0x80, 0xde, 0x0d, 0x93, 0x7e, 0x60, 0x79,
+	0x41, 0xa7, 0x2d, 0xb7, 0xe1, 0x02, 0xdf, 0x06, 0x2e, 0x4a, 0xab, 0xea, 0x15, 0x18, 0x6f, 0xc7,
+	0xb6, 0x4f, 0xc8, 0x4b, 0x65, 0xbf, 0xe9, 0x68, 0xfb, 0xaa, 0x5a, 0x39, 0xc6, 0x5a, 0x99, 0xff,
+	0x6d, 0x10, 0xca, 0xa9, 0xfb, 0x41, 0x59, 0xcd, 0x1c, 0x4b, 0x01, 0x8c, 0x53, 0xa2, 0x00, 0x6d,
+	0xb8, 0x11, 0x36, 0xb8, 0xdd, 0xee, 0x64, 0xe2, 0x2a, 0x71, 0x5c, 0x6f, 0x3a, 0x3a, 0x9c, 0xbd,
+	0x51, 0x3d, 0xa6, 0x2d, 0x1e, 0x0b, 0x2d, 0x9f, 0xba, 0x0e, 0x9c, 0x13, 0x75, 0xfd, 0x28, 0x5c,
+	0xd2, 0x2a, 0x3c, 0x6a, 0xd5, 0x0f, 0xfa, 0xa0, 0xee, 0x9c, 0xa8, 0x54, 0x33, 0xe0, 0x61, 0x26,
+	0x96, 0x5c, 0x92, 0x36, 0x74, 0x1e, 0x24, 0xcd, 0x3c, 0x1c, 0x80, 0xb1, 0x8a, 0xeb, 0xd4, 0x6d,
+	0xfe, 0x79, 0x3c, 0x1d, 0xd3, 0x85, 0x3e, 0xae, 0x33, 0x90, 0x0f, 0x0f, 0x67, 0x27, 0xc3, 0x86,
+	0x1a, 0x47, 0xf9, 0xde, 0x50, 0x01, 0x21, 0x9e, 0x65, 0x6f, 0x8c, 0x6b, 0x0e, 0x1e, 0x1e, 0xce,
+	0x5e, 0x08, 0xbb, 0xc5, 0x95, 0x09, 0x8c, 0x5e, 0x31, 0x39, 0xca, 0xba, 0x67, 0x39, 0xbe, 0xdd,
+	0x87, 0xe4, 0x2a, 0x94, 0x18, 0xaf, 0xa4, 0xa0, 0x61, 0x06, 0x06, 0xf2, 0x0a, 0x4c, 0xb1, 0xd2,
+	0x8d, 0x76, 0xdd, 0x0a, 0x68, 0x41, 0x81, 0x55, 0x68, 0xb0, 0xb1, 0x12, 0x83, 0x84, 0x09, 0xc8,
+	0x42, 0x77, 0x6c, 0xf9, 0xae, 0x53, 0x1e, 0x4a, 0xea, 0x8e, 0x2d, 0x5f, 0xe8, 0x8e, 0x2d, 0x5f,
+	0x18, 0x6d, 0xb5, 0xa8, 0xef, 0x33, 0xb1, 0xf0, 0x30, 0x6f, 0x18, 0xbe, 0x2e, 0x56, 0x45, 0x31,
+	0xaa, 0x7a, 0xf2, 0x36, 0x18, 0xaa, 0xb9, 0x75, 0xea, 0x97, 0x47, 0x38, 0x59, 0x61, 0x14, 0x76,
+	0xa8, 0xc2, 0x0a, 0x1e, 0x1e, 0xce, 0x8e, 0x71, 0xf9, 0x3a, 0xfb, 0x85, 0xa2, 0x91, 0xf9, 0xc3,
+	0x4c, 0x92, 0x90, 0x10, 0x9d, 0xf4, 0xa0, 0xf3, 0x3e, 0x3f, 0xf5, 0xb1, 0xf9, 0x19, 0x26, 0xc6,
+	0x71, 0x9d, 0xc0, 0x73, 0x9b, 0x6b, 0x4d, 0xcb, 0xa1, 0xe4, 0xbb, 0x0c, 0x98, 0xde, 0xb6, 0x1b,
+	0xdb, 0xba, 0xd1, 0x4a, 0xd9, 0x28, 0x2e, 0x71, 0xb9, 0x93, 0x80, 0xb5, 0x70, 0xe9, 0xe8, 0x70,
+	0x76, 0x3a, 0x59, 0x8a, 0x29, 0x9c, 0xe6, 0x27, 0x4b, 0x70, 0x49, 0x8e, 0xac, 0xc9, 0xb8, 0xd3,
+	0x76, 0xd3, 0x3d, 0x68, 0x51, 0xe7, 0x3c, 0xec, 0x4b, 0xd4, 0x0e, 0x95, 0x72, 0x77, 0xa8, 0x95,
+	0xda, 0xa1, 0x81, 0x22, 0x3b, 0x14, 0x1e, 0xe4, 0x63, 0x76, 0xe9, 0xf7, 0x0d, 0x28, 0x67, 0xad,
+	0xc5, 0x39, 0x48, 0xa6, 0x5a, 0x71, 0xc9, 0xd4, 0x9d, 0xa2, 0xa2, 0xc6, 0xe4, 0xd0, 0x73, 0x24,
+	0x54, 0xbf, 0x57, 0x82, 0x2b, 0x51, 0xf3, 0x65, 0xc7, 0x0f, 0xac, 0x66, 0x53, 0xb0, 0x0f, 0x67,
+	0xbf, 0xef, 0xed, 0x98, 0x80, 0xf1, 0x5e, 0x7f, 0x53, 0xd5, 0xc7, 0x9e, 0xab, 0x41, 0xde, 0x4f,
+	0x68, 0x90, 0xd7, 0x4e, 0x11, 0x67, 0x77, 0x65, 0xf2, 0x7f, 0x30, 0xe0, 0x5a, 0x76, 0xc7, 0x73,
+	0x38, 0x54, 0x6e, 0xfc, 0x50, 0x7d, 0xe0, 0xf4, 0x66, 0x9d, 0x73, 0xac, 0x7e, 0xa6, 0x94, 0x37,
+	0x5b, 0x2e, 0xa5, 0xdc, 0x82, 0x0b, 0x1e, 0x6d, 0xd8, 0x7e, 0x20, 0x55, 0x9d, 0x27, 0xb3, 0x4c,
+	0x54, 0x92, 0xfb, 0x0b, 0x18, 0x87, 0x81, 0x49, 0xa0, 0xe4, 0x1e, 0x8c, 0x30, 0x99, 0x11, 0x83,
+	0x5f, 0xea, 0x1d, 0x7e, 0x78, 0x1b, 0x55, 0x45, 0x5f, 0x54, 0x40, 0xc8, 0x37, 0xc2, 0x64, 0x3d,
+	0xfc, 0xa2, 0x8e, 0x31, 0x00, 0x4a, 0x42, 0xe5, 0x9c, 0xf4, 0xa2, 0xde, 0x1b, 0xe3, 0xc0, 0xcc,
+	0xff, 0x6d, 0xc0, 0x63, 0xdd, 0xce, 0x16, 0x79, 0x15, 0xa0, 0xa6, 0xd8, 0x0b, 0x61, 0x98, 0x5a,
+	0x50, 0x6d, 0x1d, 0x32, 0x29, 0xd1, 0x07, 0x1a, 0x16, 0xf9, 0xa8, 0x21, 0xc9, 0xb0, 0x2b, 0x2a,
+	0x9d, 0x91, 0x5d, 0x91, 0xf9, 0x1f, 0x0d, 0x9d, 0x14, 0xe9, 0x7b, 0xfb, 0x7a, 0x23, 0x45, 0xfa,
+	0xd8, 0x73, 0xb5, 0x1e, 0xbf, 0x51, 0x82, 0x1b, 0xd9, 0x5d, 0xb4, 0xbb, 0xf7, 0x05, 0x18, 0x6e,
+	0x0b, 0xeb, 0xe1, 0x01, 0x7e, 0x37, 0x3e, 0xc9, 0x28, 0x8b, 0xb0, 0xed, 0x7d, 0x78, 0x38, 0x7b,
+	0x2d, 0x8b, 0xd0, 0x8b, 0x5a, 0x94, 0xfd, 0x88, 0x9d, 0x10, 0xcf, 0x0a, 0xee, 0xef, 0xeb, 0x7a,
+	0x24, 0x2e, 0xd6, 0x26, 0x6d, 0xf6, 0x2c, 0x91, 0xfd, 0x36, 0x03, 0xa6, 0x62, 0x27, 0xda, 0x2f,
+	0x0f, 0xdd, 0x18, 0x28, 0x6a, 0xd2, 0x11, 0xfb, 0x54, 0xa2, 0x9b, 0x3b, 0x56, 0xec, 0x63, 0x02,
+	0x61, 0x82, 0xcc, 0xea, 0xab, 0xfa, 0xba, 0x23, 0xb3, 0xfa, 0xe0, 0x73, 0xc8, 0xec, 0x0f, 0x96,
+	0xf2, 0x66, 0xcb, 0xc9, 0xec, 0x1e, 0x8c, 0x29, 0x3f, 0x28, 0x45, 0x2e, 0x6e, 0xf5, 0x3b, 0x26,
+	0x01, 0x2e, 0x32, 0x67, 0x54, 0x25, 0x3e, 0x46, 0xb8, 0xc8, 0x77, 0x18, 0x00, 0xd1, 0xc6, 0xc8,
+	0x8f, 0x6a, 0xfd, 0xf4, 0x96, 0x43, 0x63, 0x6b, 0xa6, 0xd8, 0x27, 0x1d, 0xfd, 0x46, 0x0d, 0xaf,
+	0xf9, 0x3f, 0x07, 0x80, 0xa4, 0xc7, 0xde, 0x9b, 0xf2, 0xed, 0x18, 0x86, 0xf4, 0x39, 0xb8, 0xd0,
+	0x68, 0xba, 0x9b, 0x56, 0xb3, 0x79, 0x20, 0x1d, 0x4d, 0xa4, 0xcb, 0xc2, 0x45, 0x76, 0x31, 0xdd,
+	0x8e, 0x57, 0x61, 0xb2, 0x2d, 0x69, 0xc3, 0xb4, 0xc7, 0xc4, 0x5f, 0x35, 0xbb, 0xc9, 0x9f, 0x4e,
+	0x6e, 0x27, 0x28, 0xf8, 0x02, 0xe7, 0xec, 0x3d, 0x26, 0x60, 0x61, 0x0a, 0x3a, 0x33, 0x2e, 0x69,
+	0x7b, 0x76, 0xcb, 0xf2, 0x0e, 0xf8, 0xe3, 0x6c, 0x54, 0x28, 0x16, 0xd6, 0x44, 0x11, 0xaa, 0x3a,
+	0xf2, 0x51, 0x18, 0x6b, 0xda, 0x5b, 0xb4, 0x76, 0x50, 0x6b, 0x52, 0x29, 0x10, 0xbd, 0x7f, 0x3a,
+	0x47, 0x66, 0x45, 0x81, 0x95, 0xa6, 0x52, 0xea, 0x27, 0x46, 0x08, 0x99, 0x47, 0xd7, 0x1e, 0x57,
+	0xde, 0x37, 0xa9, 0xef, 0x57, 0x3b, 0xed, 0xb6, 0xeb, 0x05, 0xb4, 0xce, 0xc5, 0xa6, 0xa3, 0xc2,
+	0x9b, 0xe6, 0x41, 0xba, 0x1a, 0xb3, 0xfa, 0x98, 0x9f, 0x2a, 0xc1, 0xa3, 0x5d, 0x06, 0x41, 0x10,
+	0xc6, 0xc2, 0x35, 0x92, 0x27, 0xe1, 0x1d, 0xe2, 0x3c, 0xcb, 0xc2, 0x87, 0x87, 0xb3, 0x4f, 0x74,
+	0x01, 0x10, 0x6a, 0x40, 0x22, 0x30, 0x64, 0x19, 0x86, 0xeb, 0x91, 0x16, 0x61, 0x6c, 0xe1, 0x69,
+	0x46, 0xad, 0x85, 0xbc, 0xaf, 0x57, 0x68, 0x12, 0x00, 0x59, 0x81, 0x11, 0x61, 0x60, 0x45, 0x25,
+	0xe5, 0x7f, 0x86, 0x3f, 0x8f, 0x45, 0x51, 0xaf, 0xc0, 0x14, 0x08, 0xf3, 0x0f, 0x0d, 0x18, 0xa9,
+	0x30, 0x39, 0xe1, 0xbd, 0x2a, 0xb3, 0x8c, 0xd2, 0x5c, 0x3d, 0x25, 0x15, 0x2c, 0x48, 0x16, 0x38,
+	0xc4, 0xf9, 0x08, 0x9a, 0x72, 0x4e, 0x09, 0x0b, 0x50, 0xc7, 0x45, 0x5e, 0x65, 0x6b, 0xbe, 0xe7,
+	0xd9, 0x01, 0x43, 0xdc, 0x8f, 0x55, 0x81, 0x40, 0x8c, 0x0a, 0x96, 0x38, 0x51, 0xe1, 0x4f, 0x8c,
+	0xb0, 0x98, 0x6b, 0x40, 0x64, 0x6b, 0x6d, 0x54, 0xe4, 0x59, 0x18, 0x6c, 0xb9, 0x75, 0xb5, 0xef,
+	0x6f, 0x51, 0xdf, 0x37, 0x93, 0xbf, 0x3f, 0x3c, 0x9c, 0xbd, 0x92, 0xee, 0xc1, 0x6a, 0x90, 0xf7,
+	0x31, 0xef, 0xc1, 0xb4, 0xac, 0x0f, 0x11, 0x32, 0xaf, 0xa1, 0x9a, 0xdb, 0x6a, 0xb9, 0x4e, 0xb5,
+	0xb3, 0xb5, 0x65, 0xef, 0xd3, 0x98, 0xd7, 0x50, 0x25, 0x56, 0x83, 0x89, 0x96, 0xe6, 0xe7, 0x0d,
+	0x18, 0x60, 0xfb, 0x62, 0xc2, 0x70, 0xdd, 0x6d, 0x59, 0xb6, 0x23, 0x47, 0xc5, 0x3d, 0xa4, 0x16,
+	0x79, 0x09, 0xca, 0x1a, 0xd2, 0x86, 0x31, 0xc5, 0x34, 0xf5, 0x65, 0x23, 0xba, 0x78, 0xaf, 0x1a,
+	0xda, 0xd5, 0x87, 0x94, 0x5c, 0x95, 0xf8, 0x18, 0x21, 0x31, 0x2d, 0x98, 0x59, 0xbc, 0x57, 0x5d,
+	0x76, 0x6a, 0xcd, 0x4e, 0x9d, 0x2e, 0xed, 0xf3, 0x3f, 0x8c, 0x96, 0xd8, 0xa2, 0x44, 0xce, 0x93,
+	0xd3, 0x12, 0xd9, 0x08, 0x55, 0x1d, 0x6b, 0x46, 0x45, 0x8f, 0x72, 0x29, 0x6a, 0x26, 0x81, 0xa0,
+	0xaa, 0x33, 0xbf, 0x54, 0x82, 0x71, 0x6d, 0x40, 0xa4, 0x09, 0x23, 0x62, 0xba, 0x7e, 0x3f, 0x8e,
+	0x92, 0xa9, 0x51, 0x0b, 0xec, 0x62, 0x41, 0x7d, 0x54, 0x28, 0x74, 0xba, 0x58, 0xea, 0x42, 0x17,
+	0xe7, 0x62, 0xbe, 0x48, 0xe2, 0x93, 0x9c, 0xca, 0xf7, 0x43, 0x22, 0x8f, 0xc9, 0x1b, 0x44, 0x18,
+	0x69, 0x8e, 0x26, 0x6e, 0x8f, 0x2d, 0x18, 0x7a, 0xcd, 0x75, 0xa8, 0x5f, 0x1e, 0x3a, 0xcd, 0x09,
+	0x8e, 0x31, 0xfe, 0x80, 0x39, 0x3c, 0xf9, 0x28, 0xc0, 0x9b, 0x3f, 0x62, 0x00, 0x2c, 0x5a, 0x81,
+	0x25, 0x74, 0xd5, 0x3d, 0x98, 0x20, 0x3e, 0x16, 0xbb, 0xf8, 0x46, 0x53, 0xbe, 0x21, 0x83, 0xbe,
+	0xfd, 0x9a, 0x9a, 0x7e, 0xc8, 0x50, 0x0b, 0xe8, 0x55, 0xfb, 0x35, 0x8a, 0xbc, 0x9e, 0x29, 0x1e,
+	0xa8, 0x53, 0xf3, 0x0e, 0xda, 0x8c, 0x78, 0x0f, 0xf2, 0x55, 0xe5, 0x5f, 0xe8, 0x92, 0x2a, 0xc4,
+	0xa8, 0xde, 0x7c, 0x1a, 0xe2, 0xaf, 0xa2, 0x1e, 0x2c, 0x19, 0xff, 0xc8, 0x80, 0xab, 0x8b, 0x1d,
+	0xab, 0x39, 0xdf, 0x66, 0x07, 0xd5, 0x6a, 0xde, 0x72, 0x85, 0x36, 0x95, 0x3d, 0x15, 0xde, 0x06,
+	0xa3, 0x8a, 0x0f, 0x91, 0x10, 0x42, 0x8e, 0x4d, 0x11, 0x4a, 0x0c, 0x5b, 0x10, 0x8b, 0xd9, 0xd3,
+	0x4a, 0xce, 0xb8, 0xd4, 0x07, 0x67, 0xac, 0x50, 0xa8, 0x12, 0x0c, 0xc1, 0x32, 0x1f, 0x30, 0xf9,
+	0x41, 0x30, 0x97, 0x68, 0xbb, 0x46, 0xe7, 0x6b, 0x35, 0xb7, 0xc3, 0x34, 0x25, 0x82, 0x61, 0xe0,
+	0x2a, 0xec, 0xe5, 0xcc, 0x16, 0x98, 0xd3, 0xd3, 0xfc, 0xf2, 0x20, 0x3c, 0xb2, 0xb4, 0x5e, 0x59,
+	0x94, 0x0b, 0x6a, 0xbb, 0xce, 0x5d, 0x7a, 0xf0, 0xa7, 0x96, 0x9d, 0x7f, 0x6a, 0xd9, 0x79, 0x7a,
+	0x96, 0x9d, 0xe6, 0xf3, 0x30, 0x1d, 0x1d, 0x2f, 0x69, 0x52, 0xf4, 0xd6, 0xe4, 0x83, 0x62, 0x4c,
+	0x5d, 0xbd, 0xe9, 0x47, 0x80, 0xf9, 0xd0, 0x80, 0xe9, 0xa5, 0xfd, 0xb6, 0xed, 0x71, 0x0f, 0x46,
+	0x61, 0x26, 0xc2, 0x44, 0xff, 0xca, 0xc6, 0xd9, 0x88, 0x8b, 0xfe, 0x93, 0x76, 0xce, 0x64, 0x0b,
+	0xa6, 0x28, 0xef, 0xce, 0x39, 0x7e, 0x2b, 0x28, 0x72, 0x02, 0x85, 0xdb, 0x6e, 0x0c, 0x0a, 0x26,
+	0xa0, 0x92, 0x2a, 0x4c, 0xd5, 0x9a, 0x96, 0xef, 0xdb, 0x5b, 0x76, 0x2d, 0xb2, 0xcd, 0x1f, 0x5b,
+	0x78, 0x2b, 0xbf, 0xbc, 0x63, 0x35, 0x0f, 0x0f, 0x67, 0x2f, 0xcb, 0x71, 0xc6, 0x2b, 0x30, 0x01,
+	0xc2, 0xfc, 0x6c, 0x09, 0x26, 0x97, 0xf6, 0xdb, 0xae, 0xdf, 0xf1, 0x28, 0x6f, 0x7a, 0x0e, 0x32,
+	0x8c, 0xa7, 0x60, 0x64, 0xdb, 0x62, 0xb6, 0x7d, 0x5e, 0xb9, 0x14, 0x5f, 0xdb, 0x3b, 0xa2, 0x18,
+	0x55, 0x3d, 0xf9, 0x08, 0x00, 0x0b, 0x40, 0x51, 0xef, 0x70, 0x1e, 0x50, 0x7c, 0x65, 0x77, 0x8b,
+	0xdc, 0x42, 0xb1, 0x39, 0x56, 0x43, 0x90, 0xf2, 0x6e, 0x0c, 0x7f, 0xa3, 0x86, 0xce, 0xfc, 0x2d,
+	0x03, 0x66, 0x62, 0xfd, 0xce, 0xe1, 0x69, 0xbe, 0x15, 0x7f, 0x9a, 0xcf, 0xf7, 0x3d, 0xd7, 0x9c,
+	0x17, 0xf9, 0x27, 0x4a, 0x70, 0x35, 0x67, 0x4d, 0x52, 0x96, 0x72, 0xc6, 0x39, 0x59, 0xca, 0x75,
+	0x60, 0x3c, 0x70, 0x9b, 0xd2, 0x85, 0x44, 0xad, 0x40, 0x21, 0x3b, 0xb8, 0xf5, 0x10, 0x4c, 0x64,
+	0x07, 0x17, 0x95, 0xf9, 0xa8, 0xe3, 0x61, 0x66, 0xd7, 0x63, 0xa1, 0x04, 0xf0, 0xab, 0x4a, 0x0b,
+	0xd7, 0x7b, 0xa4, 0x01, 0xf3, 0x57, 0x4a, 0x70, 0x25, 0x84, 0xad, 0xc8, 0x1c, 0x13, 0x58, 0xf6,
+	0x22, 0x46, 0x78, 0x2c, 0x66, 0xc3, 0x3b, 0x9a, 0x76, 0xf7, 0x68, 0x77, 0xbc, 0xb6, 0xeb, 0x2b,
+	0x86, 0x4a, 0x70, 0x9e, 0xa2, 0x08, 0x55, 0x1d, 0xb9, 0x07, 0x43, 0x3e, 0xc3, 0x57, 0x1e, 0x2c,
+	0xb2, 0x1a, 0x9c, 0x27, 0xe4, 0xe3, 0x45, 0x01, 0x86, 0x7c, 0x44, 0xa7, 0xe1, 0x43, 0xc5, 0x05,
+	0x55, 0x6c, 0x26, 0xf5, 0x90, 0xa5, 0x4a, 0xfb, 0xb9, 0x66, 0xde, 0x09, 0x2b, 0x30, 0x2d, 0xed,
+	0xcc, 0xc4, 0xb1, 0x61, 0xb6, 0xd0, 0xef, 0x89, 0x9d, 0x8c, 0x37, 0x25, 0xf4, 0xf0, 0x97, 0x92,
+	0xed, 0xa3, 0x13, 0x63, 0xfa, 0x30, 0x7a, 0x5b, 0x0e, 0x92, 0x5c, 0x83, 0x92, 0xad, 0xf6, 0x02,
+	0x24, 0x8c, 0xd2, 0xf2, 0x22, 0x96, 0xec, 0x1e, 0x6c, 0xa9, 0xf5, 0x6b, 0x69, 0xa0, 0xfb, 0xb5,
+	0x64, 0xfe, 0x6e, 0x09, 0x2e, 0x29, 0xac, 0x6a, 0x8e, 0x8b, 0x52, 0x8b, 0x79, 0x0c, 0x77, 0x7d,
+	0xbc, 0x58, 0xe9, 0x3e, 0x0c, 0x72, 0x02, 0x58, 0x48, 0xbb, 0x19, 0x02, 0x64, 0xc3, 0x41, 0x0e,
+	0x88, 0x7c, 0x14, 0x86, 0x9b, 0x8c, 0x55, 0x55, 0x46, 0xce, 0x85, 0x84, 0x70, 0x59, 0xd3, 0x15,
+	0x1c, 0xb0, 0x0c, 0xf2, 0x12, 0x2a, 0xbd, 0x44, 0x21, 0x4a, 0x9c, 0xd7, 0xde, 0x0b, 0xe3, 0x5a,
+	0xb3, 0x13, 0x45, 0x78, 0xf9, 0x7c, 0x09, 0xca, 0x77, 0x68, 0xb3, 0x95, 0xa9, 0x92, 0x9e, 0x85,
+	0xa1, 0xda, 0xb6, 0xe5, 0x89, 0xe0, 0x41, 0x13, 0xe2, 0x90, 0x57, 0x58, 0x01, 0x8a, 0x72, 0xb2,
+	0x09, 0xc3, 0x1c, 0x94, 0x52, 0x57, 0xbc, 0x5f, 0x5b, 0xc9, 0x28, 0xaa, 0xd4, 0x37, 0x87, 0x61,
+	0xa7, 0xa2, 0x89, 0xc7, 0x1a, 0xb0, 0xeb, 0xe5, 0x03, 0xd5, 0xfb, 0xf7, 0xc4, 0x63, 0xfc, 0x45,
+	0x0e, 0x11, 0x25, 0x64, 0xe6, 0xbf, 0xe8, 0xd6, 0x6c, 0xa4, 0x6d, 0xd7, 0xb7, 0x03, 0xd7, 0x3b,
+	0x90, 0x9b, 0x56, 0xe8, 0x6a, 0xb9, 0x5f, 0x59, 0x8e, 0x00, 0x09, 0x55, 0x51, 0xac, 0x08, 0xe3,
+	0xa8, 0xcc, 0x7f, 0x6f, 0xc0, 0xf8, 0x1d, 0x7b, 0x93, 0x7a, 0xc2, 0x94, 0x8e, 0x3f, 0xb5, 0x63,
+	0x61, 0x70, 0xc6, 0xb3, 0x42, 0xe0, 0x90, 0x7d, 0x18, 0x93, 0xf7, 0x70, 0xe8, 0xcb, 0x72, 0xbb,
+	0x98, 0x91, 0x41, 0x88, 0x5a, 0xde, 0x6f, 0xba, 0x83, 0xbb, 0xc2, 0x80, 0x11, 0x32, 0x26, 0x21,
+	0xd9, 0xb3, 0x76, 0xe8, 0x46, 0xfb, 0xbe, 0xb3, 0x48, 0x5b, 0x96, 0xa3, 0xe8, 0x2e, 0xa7, 0xd6,
+	0x0f, 0x62, 0x35, 0x98, 0x68, 0x69, 0xfe, 0xa4, 0x01, 0x17, 0x33, 0x30, 0xb2, 0x53, 0xc0, 0x4d,
+	0xd1, 0xe4, 0x17, 0xa7, 0x48, 0x1d, 0x3b, 0x05, 0xbc, 0x9c, 0x3c, 0x02, 0x03, 0xd4, 0xa9, 0xcb,
+	0xcf, 0x6d, 0xe4, 0xe8, 0x70, 0x76, 0x60, 0xc9, 0xa9, 0x23, 0x2b, 0x63, 0x37, 0x40, 0xd3, 0x8d,
+	0xb1, 0x7b, 0xfc, 0x06, 0x58, 0x91, 0x65, 0x18, 0xd6, 0xb2, 0x97, 0x3f, 0xdd, 0xaf, 0x51, 0x19,
+	0x33, 0x69, 0x90, 0x33, 0xbd, 0x9c, 0xbb, 0x59, 0x0a, 0x4b, 0x51, 0x6b, 0xc1, 0x6d, 0x50, 0x92,
+	0xe6, 0x16, 0xec, 0xa5, 0x31, 0xbd, 0x95, 0x20, 0x64, 0xfd, 0x58, 0x79, 0x24, 0x89, 0xe2, 0x42,
+	0x59, 0xae, 0x7e, 0x8a, 0xbc, 0x62, 0x0a, 0xaf, 0xf9, 0xf3, 0x83, 0xf0, 0xf8, 0x1d, 0x16, 0x67,
+	0xc5, 0x75, 0x02, 0xab, 0xb9, 0xe6, 0xd6, 0x23, 0x0b, 0x3c, 0x79, 0x3f, 0x7e, 0xa7, 0x01, 0x57,
+	0x6b, 0xed, 0x8e, 0x78, 0xa9, 0x28, 0x23, 0xb6, 0x35, 0xea, 0xd9, 0x6e, 0x51, 0x43, 0x6d, 0x1e,
+	0x3f, 0xa5, 0xb2, 0xb6, 0x91, 0x05, 0x12, 0xf3, 0x70, 0x71, 0x7b, 0xf1, 0xba, 0xbb, 0xe7, 0xf0,
+	0xc1, 0x55, 0x03, 0xbe, 0x9a, 0xaf, 0x45, 0x9b, 0x56, 0xd0, 0x5e, 0x7c, 0x31, 0x13, 0x22, 0xe6,
+	0x60, 0x62, 0x26, 0x7b, 0xb6, 0x18, 0x1c, 0x52, 0xab, 0x6e, 0x3b, 0xd4, 0xf7, 0x85, 0xb1, 0x69,
+	0x1f, 0x06, 0xd1, 0xcb, 0x59, 0x00, 0x31, 0x1b, 0x0f, 0x79, 0x19, 0xc0, 0x3f, 0x70, 0x6a, 0x72,
+	0xfd, 0x8b, 0x99, 0xca, 0x09, 0x7e, 0x3c, 0x84, 0x82, 0x1a, 0x44, 0xf6, 0xaa, 0x0b, 0xc2, 0x43,
+	0x39, 0xcc, 0xcd, 0x1d, 0xf9, 0xab, 0x2e, 0x3a, 0x43, 0x51, 0xbd, 0xf9, 0x53, 0x06, 0x8c, 0xc8,
+	0xc8, 0x51, 0xcc, 0xde, 0x2b, 0x26, 0xb2, 0x0c, 0xaf, 0x81, 0x84, 0xd8, 0xf2, 0x80, 0xeb, 0xad,
+	0x25, 0x19, 0x97, 0x14, 0xb9, 0x90, 0xcc, 0x4b, 0x22, 0x8e, 0xee, 0x84, 0x98, 0xfe, 0x5a, 0x96,
+	0xa1, 0x86, 0xcc, 0xfc, 0x82, 0x01, 0x33, 0xa9, 0x5e, 0x3d, 0xb0, 0x6e, 0xe7, 0x68, 0x12, 0xf6,
+	0x1b, 0x83, 0x30, 0xc5, 0xad, 0xc5, 0x1d, 0xab, 0x29, 0xa4, 0x89, 0xe7, 0xf0, 0x56, 0x7c, 0x2b,
+	0x8c, 0xd9, 0xad, 0x56, 0x27, 0x60, 0xf7, 0x82, 0x54, 0x08, 0xf1, 0x3d, 0x5f, 0x56, 0x85, 0x18,
+	0xd5, 0x13, 0x47, 0x72, 0x25, 0xe2, 0xc6, 0x58, 0x29, 0xb6, 0x73, 0xfa, 0x04, 0xe7, 0x18, 0x07,
+	0x21, 0x58, 0x87, 0x2c, 0xa6, 0xe5, 0xbb, 0x0c, 0x00, 0x3f, 0xf0, 0x6c, 0xa7, 0xc1, 0x0a, 0x25,
+	0xe7, 0x82, 0xa7, 0x80, 0xb6, 0x1a, 0x02, 0x15, 0xc8, 0xa3, 0x68, 0x52, 0x61, 0x05, 0x6a, 0x98,
+	0xc9, 0xbc, 0x64, 0xd8, 0xc4, 0x0d, 0xf1, 0xf6, 0x04, 0x6b, 0xfa, 0x78, 0x3a, 0x24, 0xa6, 0x8c,
+	0xdb, 0x11, 0x71, 0x74, 0xd7, 0xde, 0x0d, 0x63, 0x21, 0xbe, 0xe3, 0x18, 0xa0, 0x09, 0x8d, 0x01,
+	0xba, 0xf6, 0x1c, 0x5c, 0x48, 0x0c, 0xf7, 0x44, 0xfc, 0xd3, 0xbf, 0x30, 0x80, 0xc4, 0x67, 0x7f,
+	0x0e, 0xaf, 0xec, 0x46, 0xfc, 0x95, 0xbd, 0xd0, 0xff, 0x96, 0xe5, 0x3c, 0xb3, 0x7f, 0x7c, 0x06,
+	0x78, 0x60, 0xbd, 0x30, 0xd0, 0xa4, 0xbc, 0xb8, 0xd8, 0x3d, 0x1b, 0xf9, 0x19, 0xca, 0x2f, 0xb7,
+	0x8f, 0x7b, 0xf6, 0x6e, 0x02, 0x56, 0x74, 0xcf, 0x26, 0x6b, 0x30, 0x85, 0x97, 0x7c, 0xd2, 0x80,
+	0x69, 0x2b, 0x1e, 0x58, 0x4f, 0xad, 0x4c, 0xa1, 0x10, 0x29, 0x89, 0x20, 0x7d, 0xd1, 0x58, 0x12,
+	0x15, 0x3e, 0xa6, 0xd0, 0x32, 0x2b, 0x7d, 0xab, 0x6d, 0xb3, 0xd0, 0x70, 0xec, 0x95, 0xa6, 0xe2,
+	0x8f, 0x71, 0xc9, 0xc1, 0xfc, 0xda, 0x72, 0x58, 0x8e, 0xb1, 0x56, 0x61, 0x04, 0x3b, 0xb9, 0x90,
+	0x83, 0x7d, 0x46, 0xb0, 0x93, 0x6b, 0x18, 0x45, 0xb0, 0x93, 0x4b, 0xa7, 0x23, 0x21, 0x0e, 0x80,
+	0x6b, 0xd7, 0x6b, 0x12, 0xe5, 0xb0, 0x64, 0xdf, 0x8b, 0xf0, 0xd4, 0xcb, 0x8b, 0x15, 0x89, 0x91,
+	0xdf, 0x7e, 0xd1, 0x6f, 0xd4, 0x30, 0x90, 0xcf, 0x18, 0x30, 0x29, 0x69, 0xb7, 0xc4, 0x39, 0xc2,
+	0xb7, 0xe8, 0xc3, 0x45, 0xcf, 0x4b, 0xe2, 0x4c, 0xce, 0xa1, 0x0e, 0x5c, 0xd0, 0x9d, 0xd0, 0x4d,
+	0x35, 0x56, 0x87, 0xf1, 0x71, 0x90, 0x3f, 0x6f, 0xc0, 0x25, 0x3f, 0x26, 0xf9, 0x97, 0x03, 0x1c,
+	0x2d, 0x1e, 0x5a, 0xab, 0x9a, 0x01, 0x4f, 0x5a, 0xf1, 0x67, 0xd4, 0x60, 0x26, 0x7e, 0xc6, 0x96,
+	0x5d, 0xd8, 0xb3, 0x82, 0xda, 0x76, 0xc5, 0xaa, 0x6d, 0x73, 0xc5, 0x8f, 0xf0, 0x06, 0x2a, 0x78,
+	0xae, 0x1f, 0xc4, 0x41, 0x09, 0x13, 0x8a, 0x44, 0x21, 0x26, 0x11, 0x12, 0x97, 0x29, 0x7a, 0x44,
+	0x74, 0xd9, 0x32, 0x14, 0x67, 0x29, 0x52, 0xa1, 0x6a, 0xc5, 0x43, 0x40, 0xfd, 0xc2, 0x10, 0x09,
+	0xf3, 0x4a, 0x11, 0xef, 0xa8, 0x79, 0xc7, 0x75, 0x0e, 0x5a, 0x6e, 0xc7, 0x67, 0xf1, 0x0b, 0xa9,
+	0x13, 0x28, 0xb1, 0xf1, 0x38, 0xbf, 0x46, 0xb9, 0x57, 0xca, 0x52, 0xb7, 0x86, 0xd8, 0x1d, 0x0e,
+	0x79, 0x09, 0x46, 0xe9, 0x2e, 0x75, 0x82, 0xf5, 0xf5, 0x95, 0xf2, 0xc4, 0x49, 0x68, 0x74, 0xc8,
+	0xed, 0xf1, 0x29, 0x2c, 0x49, 0x18, 0x18, 0x42, 0x23, 0x3b, 0x30, 0xd2, 0x14, 0xe1, 0x81, 0xcb,
+	0x93, 0xc5, 0x89, 0x62, 0x32, 0xd4, 0xb0, 0x78, 0x6c, 0xca, 0x1f, 0xa8, 0x30, 0x30, 0xe7, 0x9a,
+	0x3a, 0xdd, 0xb2, 0x3a, 0xcd, 0xe0, 0x9e, 0x1b, 0x20, 0x77, 0x01, 0x09, 0xa5, 0x83, 0xca, 0x87,
+	0x6c, 0x8a, 0x47, 0xc1, 0xe1, 0xce, 0x35, 0x8b, 0xc7, 0xb4, 0xc5, 0x63, 0xa1, 0x91, 0x03, 0x78,
+	0x42, 0xb6, 0xe1, 0x3e, 0x27, 0xb5, 0x6d, 0xb6, 0xca, 0x69, 0xa4, 0x17, 0x38, 0xd2, 0xff, 0xe7,
+	0xe8, 0x70, 0xf6, 0x89, 0xc5, 0xe3, 0x9b, 0x63, 0x2f, 0x30, 0xb9, 0x19, 0x3f, 0x4d, 0xa8, 0x4b,
+	0xca, 0xd3, 0xc5, 0xd7, 0x38, 0xa9, 0x7a, 0x11, 0x76, 0x3e, 0xc9, 0x52, 0x4c, 0xe1, 0x24, 0x7f,
+	0xd9, 0x80, 0xb2, 0x1f, 0x78, 0x9d, 0x5a, 0xd0, 0xf1, 0x68, 0x3d, 0x71, 0x42, 0x67, 0x6e, 0x18,
+	0x45, 0x19, 0xb8, 0x6a, 0x0e, 0x4c, 0xee, 0xcd, 0x58, 0xce, 0xab, 0xc5, 0xdc, 0xb1, 0x90, 0xbf,
+	0x64, 0xc0, 0xd5, 0x78, 0x25, 0x7b, 0x92, 0x8a, 0x71, 0x92, 0xe2, 0x0a, 0x89, 0x6a, 0x36, 0x48,
+	0xf1, 0x00, 0xcd, 0xa9, 0xc4, 0xbc, 0x81, 0x5c, 0x7b, 0x01, 0x48, 0x9a, 0x7c, 0x1f, 0xc7, 0x87,
+	0x8d, 0xea, 0x7c, 0xd8, 0xe7, 0x86, 0xe0, 0x51, 0x76, 0x2b, 0x44, 0xaf, 0x8f, 0x55, 0xcb, 0xb1,
+	0x1a, 0x5f, 0x9d, 0x1c, 0xcb, 0x4f, 0x1b, 0x70, 0x75, 0x3b, 0x5b, 0x32, 0x20, 0xdf, 0x3f, 0x1f,
+	0x2c, 0x24, 0x2e, 0xea, 0x26, 0x6c, 0x10, 0x04, 0xb3, 0x6b, 0x13, 0xcc, 0x1b, 0x14, 0x79, 0x01,
+	0xa6, 0x1d, 0xb7, 0x4e, 0x2b, 0xcb, 0x8b, 0xb8, 0x6a, 0xf9, 0x3b, 0x55, 0x65, 0x9d, 0x30, 0x24,
+	0xbe, 0x97, 0x7b, 0x89, 0x3a, 0x4c, 0xb5, 0x66, 0x7e, 0x59, 0x6d, 0xb7, 0xbe, 0xb4, 0x2b, 0xc2,
+	0x58, 0xf7, 0x67, 0x8b, 0xc7, 0x75, 0xcf, 0x6b, 0x29, 0x68, 0x98, 0x81, 0x81, 0x8b, 0x36, 0xd8,
+	0x60, 0x56, 0x5d, 0xc7, 0x0e, 0x5c, 0x8f, 0xfb, 0xc7, 0xf6, 0xf5, 0xc2, 0xe7, 0xa2, 0x8d, 0x7b,
+	0x99, 0x10, 0x31, 0x07, 0x93, 0xf9, 0x5f, 0x0c, 0xb8, 0xc0, 0x8e, 0xc5, 0x9a, 0xe7, 0xee, 0x1f,
+	0x7c, 0x35, 0x1e, 0xc8, 0xa7, 0xa4, 0xa1, 0x96, 0x10, 0xe1, 0x5d, 0xd6, 0x8c, 0xb4, 0xc6, 0xf8,
+	0x98, 0x23, 0xbb, 0x2c, 0x5d, 0x04, 0x3a, 0x90, 0x2f, 0x02, 0x35, 0x3f, 0x53, 0x12, 0x2f, 0x07,
+	0x25, 0x45, 0xfc, 0xaa, 0xfc, 0x0e, 0xdf, 0x0d, 0x93, 0xac, 0x6c, 0xd5, 0xda, 0x5f, 0x5b, 0x7c,
+	0xd1, 0x6d, 0x2a, 0x77, 0x43, 0x2e, 0x17, 0xbe, 0xab, 0x57, 0x60, 0xbc, 0x1d, 0x79, 0x96, 0x59,
+	0x33, 0xf1, 0x68, 0x30, 0xf2, 0xcd, 0x7a, 0x43, 0x58, 0x33, 0xf1, 0xa2, 0x87, 0x87, 0xb3, 0x33,
+	0x91, 0x3a, 0x52, 0x16, 0xa2, 0xea, 0x60, 0xfe, 0xf1, 0x45, 0xe0, 0xc0, 0x9b, 0x34, 0xf8, 0x6a,
+	0x5c, 0x93, 0xa7, 0x61, 0xbc, 0xd6, 0xee, 0x54, 0x6e, 0x55, 0x3f, 0xd8, 0x71, 0xb9, 0x2c, 0x82,
+	0x47, 0x7b, 0x67, 0x4f, 0x89, 0xca, 0xda, 0x86, 0x2a, 0x46, 0xbd, 0x0d, 0xa3, 0x0e, 0xb5, 0x76,
+	0x47, 0xd2, 0xdb, 0x35, 0xdd, 0x8e, 0x9e, 0x53, 0x87, 0xca, 0xda, 0x46, 0xac, 0x0e, 0x53, 0xad,
+	0xc9, 0xc7, 0x61, 0x82, 0xca, 0x0f, 0xf7, 0x0e, 0x0b, 0x10, 0x2f, 0xe8, 0xc2, 0x72, 0xd1, 0xc9,
+	0x87, 0x4b, 0xab, 0xa8, 0x81, 0x78, 0x81, 0x2d, 0x69, 0x28, 0x30, 0x86, 0x90, 0x7c, 0x03, 0x3c,
+	0xa2, 0x7e, 0xb3, 0x5d, 0x76, 0xeb, 0x49, 0x42, 0x31, 0x24, 0x62, 0x4f, 0x2c, 0xe5, 0x35, 0xc2,
+	0xfc, 0xfe, 0xe4, 0x27, 0x0d, 0xb8, 0x12, 0xd6, 0xda, 0x8e, 0xdd, 0xea, 0xb4, 0x90, 0xd6, 0x9a,
+	0x96, 0xdd, 0x92, 0xef, 0xae, 0x07, 0xa7, 0x36, 0xd1, 0x38, 0x78, 0x41, 0xac, 0xb2, 0xeb, 0x30,
+	0x67, 0x48, 0xe4, 0x0b, 0x06, 0xdc, 0x50, 0x55, 0x6b, 0x1e, 0xf5, 0x99, 0x8a, 0x3d, 0x72, 0x76,
+	0x95, 0x4b, 0x32, 0x52, 0x88, 0x76, 0x72, 0x06, 0x74, 0xe9, 0x18, 0xd8, 0x78, 0x2c, 0x76, 0xfd,
+	0xb8, 0x54, 0xdd, 0xad, 0xa0, 0x3c, 0x7a, 0xa6, 0xc7, 0x85, 0xa1, 0xc0, 0x18, 0x42, 0xf2, 0xd7,
+	0x0d, 0xb8, 0xaa, 0x17, 0xe8, 0xa7, 0x45, 0xbc, 0xd0, 0x5e, 0x3a, 0xb5, 0xc1, 0x24, 0xe0, 0x0b,
+	0x0e, 0x2b, 0xa7, 0x12, 0xf3, 0x46, 0xc5, 0xc8, 0x76, 0x8b, 0x1f, 0x4c, 0xf1, 0x8a, 0x1b, 0x12,
+	0x64, 0x5b, 0x9c, 0x55, 0x1f, 0x55, 0x1d, 0x93, 0x5f, 0xb4, 0xdd, 0xfa, 0x9a, 0x5d, 0xf7, 0x57,
+	0xec, 0x96, 0x1d, 0xf0, 0xb7, 0xd6, 0x80, 0x58, 0x8e, 0x35, 0xb7, 0xbe, 0xb6, 0xbc, 0x28, 0xca,
+	0x31, 0xd6, 0x8a, 0xe9, 0x6e, 0x98, 0xf6, 0xa3, 0xba, 0x67, 0xb5, 0xef, 0xab, 0x98, 0x0a, 0x5c,
+	0x16, 0x70, 0x2b, 0x2c, 0x45, 0xad, 0x05, 0xdb, 0x3f, 0x46, 0x77, 0x90, 0x8a, 0xc0, 0x9b, 0xe5,
+	0xa9, 0x53, 0xda, 0x3f, 0x05, 0x50, 0x0c, 0xf8, 0xae, 0x86, 0x02, 0x63, 0x08, 0x99, 0xe2, 0x65,
+	0xca, 0x3f, 0xf0, 0x03, 0xda, 0x0a, 0xc7, 0x70, 0xe1, 0xb4, 0xc7, 0xc0, 0x65, 0xd2, 0xd5, 0x18,
+	0x12, 0x4c, 0x20, 0xe5, 0xd1, 0x29, 0x5a, 0x56, 0x83, 0xde, 0xae, 0x30, 0x55, 0x56, 0x18, 0xbe,
+	0x60, 0x8d, 0x7a, 0x35, 0xe6, 0xd0, 0x31, 0xcd, 0x77, 0x4a, 0x44, 0xa7, 0xc8, 0x6f, 0x86, 0xdd,
+	0x60, 0x90, 0x97, 0xe1, 0x9a, 0xac, 0x5e, 0x71, 0xf7, 0x52, 0x18, 0x66, 0x38, 0x06, 0x6e, 0x4f,
+	0xb7, 0x9c, 0xdb, 0x0a, 0xbb, 0x40, 0x60, 0xbe, 0x04, 0x3e, 0xf5, 0xb8, 0x4a, 0x49, 0xc4, 0xfd,
+	0x5a, 0xeb, 0x34, 0x9b, 0x7e, 0x99, 0x44, 0xbe, 0x04, 0xd5, 0x74, 0x35, 0x66, 0xf5, 0x61, 0xce,
+	0x1e, 0xd2, 0xb3, 0xf0, 0x80, 0x15, 0x7c, 0x70, 0xad, 0x5a, 0xbe, 0xc8, 0xc7, 0x77, 0x51, 0xf3,
+	0x42, 0x54, 0x55, 0x98, 0x6c, 0xcb, 0x6e, 0x73, 0x55, 0xb4, 0xd0, 0xf1, 0xfc, 0xa0, 0x7c, 0x89,
+	0x77, 0xe6, 0xb7, 0x39, 0xea, 0x15, 0x18, 0x6f, 0xc7, 0x94, 0xa6, 0x3e, 0xad, 0xd5, 0xdc, 0x56,
+	0x5b, 0xbe, 0x53, 0xcb, 0x97, 0x23, 0xa5, 0x69, 0x35, 0x56, 0x83, 0x89, 0x96, 0xe4, 0x00, 0x2e,
+	0x86, 0x41, 0x04, 0x57, 0xdc, 0xc6, 0xaa, 0xb5, 0xcf, 0x99, 0xe3, 0x2b, 0xc7, 0xd3, 0xc7, 0x39,
+	0x65, 0xae, 0x31, 0xf7, 0xc1, 0x8e, 0xe5, 0x04, 0xcc, 0x87, 0x9c, 0x2f, 0x57, 0x25, 0x0d, 0x0e,
+	0xb3, 0x70, 0xb0, 0xec, 0x1c, 0x89, 0xe2, 0x5b, 0x36, 0x53, 0x38, 0x5f, 0xe5, 0xd3, 0xe6, 0xc2,
+	0xa6, 0x4a, 0x46, 0x3d, 0x66, 0xf6, 0x22, 0xf7, 0xe1, 0x72, 0xdb, 0x73, 0x03, 0x5a, 0x0b, 0xee,
+	0x52, 0xcf, 0xa1, 0x4d, 0x39, 0x41, 0xbf, 0x5c, 0xe6, 0x6b, 0xc1, 0xd5, 0x69, 0x6b, 0x59, 0x0d,
+	0x30, 0xbb, 0x1f, 0xf9, 0x9c, 0x01, 0xd7, 0xfd, 0xc0, 0xa3, 0x56, 0xcb, 0x76, 0x1a, 0x15, 0xd7,
+	0x71, 0x28, 0x27, 0x4c, 0xcb, 0xf5, 0xc8, 0x15, 0xe7, 0x91, 0x42, 0xb7, 0x88, 0x79, 0x74, 0x38,
+	0x7b, 0xbd, 0xda, 0x15, 0x32, 0x1e, 0x83, 0x99, 0x19, 0xe6, 0xb5, 0x68, 0xcb, 0xf5, 0x0e, 0x18,
+	0x45, 0x2a, 0x5f, 0x2b, 0xfe, 0x0e, 0x5e, 0x0d, 0xa1, 0x88, 0xcf, 0x3f, 0xa6, 0x08, 0x8c, 0x2a,
+	0x51, 0x43, 0x67, 0x1e, 0x96, 0xe0, 0x72, 0x26, 0xa9, 0x67, 0x5f, 0x80, 0x68, 0x37, 0xaf, 0x52,
+	0x52, 0x48, 0xdd, 0x19, 0xff, 0x02, 0x56, 0xe3, 0x55, 0x98, 0x6c, 0xcb, 0x18, 0x31, 0xfe, 0xa5,
+	0xde, 0xaa, 0x46, 0xfd, 0x4b, 0x11, 0x23, 0xb6, 0x9c, 0xa8, 0xc3, 0x54, 0x6b, 0x52, 0x81, 0x19,
+	0x59, 0xb6, 0xcc, 0xde, 0x32, 0xfe, 0x2d, 0x8f, 0x2a, 0x16, 0x97, 0xbd, 0x0a, 0x66, 0x96, 0x93,
+	0x95, 0x98, 0x6e, 0xcf, 0x66, 0xc1, 0x7e, 0xe8, 0xa3, 0x18, 0x8c, 0x66, 0x71, 0x2f, 0x5e, 0x85,
+	0xc9, 0xb6, 0xea, 0xb1, 0x19, 0x1b, 0xc2, 0x50, 0x34, 0x8b, 0x7b, 0x89, 0x3a, 0x4c, 0xb5, 0x36,
+	0xff, 0xe5, 0x20, 0x3c, 0xd1, 0x03, 0x7b, 0x44, 0x5a, 0xd9, 0xcb, 0x7d, 0xf2, 0x0f, 0xb7, 0xb7,
+	0xed, 0x69, 0xe7, 0x6c, 0xcf, 0xc9, 0xf1, 0xf5, 0xba, 0x9d, 0x7e, 0xde, 0x76, 0x9e, 0x1c, 0x65,
+	0xef, 0xdb, 0xdf, 0xca, 0xde, 0xfe, 0x82, 0xab, 0x7a, 0xec, 0x71, 0x69, 0xe7, 0x1c, 0x97, 0x82,
+	0xab, 0xda, 0xc3, 0xf1, 0xfa, 0xed, 0x41, 0x78, 0x53, 0x2f, 0xac, 0x5a, 0xc1, 0xf3, 0x95, 0x41,
+	0xf2, 0xce, 0xf4, 0x7c, 0xe5, 0x79, 0x3b, 0x9e, 0xe1, 0xf9, 0xca, 0x40, 0x79, 0xd6, 0xe7, 0x2b,
+	0x6f, 0x55, 0xcf, 0xea, 0x7c, 0xe5, 0xad, 0x6a, 0x0f, 0xe7, 0xeb, 0x0f, 0x92, 0xf7, 0x43, 0xc8,
+	0x2f, 0x2e, 0xc3, 0x40, 0xad, 0xdd, 0x29, 0x48, 0xa4, 0xb8, 0x65, 0x56, 0x65, 0x6d, 0x03, 0x19,
+	0x0c, 0x82, 0x30, 0x2c, 0xce, 0x4f, 0x41, 0x12, 0xc4, 0x4d, 0xf5, 0xc4, 0x91, 0x44, 0x09, 0x89,
+	0x2d, 0x15, 0x6d, 0x6f, 0xd3, 0x16, 0xf5, 0xac, 0x66, 0x35, 0x70, 0x3d, 0xab, 0x51, 0x94, 0xda,
+	0x08, 0x31, 0x7c, 0x02, 0x16, 0xa6, 0xa0, 0xb3, 0x05, 0x69, 0xdb, 0xf5, 0xf2, 0x60, 0xf1, 0x05,
+	0x59, 0x5b, 0x5e, 0x44, 0x06, 0xc3, 0xfc, 0xe5, 0x51, 0xd0, 0xe2, 0xe8, 0x32, 0xa1, 0xcc, 0x4c,
+	0x2d, 0x19, 0x39, 0xad, 0x1f, 0xa3, 0x9a, 0x54, 0x18, 0x36, 0x71, 0xe4, 0x53, 0xc5, 0x98, 0x46,
+	0x4b, 0xbe, 0xd5, 0x10, 0x92, 0xaa, 0x50, 0x25, 0x24, 0x97, 0xf5, 0xf6, 0x29, 0x29, 0x4f, 0x23,
+	0x91, 0x57, 0x58, 0x81, 0x71, 0x84, 0x4c, 0x2c, 0x70, 0x79, 0x27, 0x4b, 0xc0, 0x5e, 0x1e, 0x2c,
+	0xee, 0xbe, 0xdc, 0x45, 0x62, 0x2f, 0x38, 0xce, 0xcc, 0x06, 0x98, 0x3d, 0x90, 0x70, 0x95, 0x42,
+	0x99, 0x63, 0x79, 0xa8, 0xbf, 0x55, 0x4a, 0x08, 0x2f, 0xa3, 0x55, 0x0a, 0x2b, 0x30, 0x8e, 0x90,
+	0x79, 0x8e, 0xee, 0x28, 0x41, 0x6f, 0x79, 0xb8, 0xb8, 0xae, 0x36, 0x21, 0x2d, 0x16, 0x46, 0x43,
+	0x61, 0x21, 0x46, 0x48, 0xc8, 0x36, 0x8c, 0xec, 0x08, 0x5a, 0x51, 0x1e, 0x29, 0x6e, 0x18, 0x1b,
+	0x23, 0x37, 0x42, 0x36, 0x20, 0x8b, 0x50, 0x81, 0xd7, 0x8d, 0xb7, 0x47, 0x8f, 0xf1, 0x29, 0xfa,
+	0x9c, 0x01, 0x97, 0x77, 0xa9, 0x17, 0xd8, 0xb5, 0xa4, 0x7a, 0x63, 0xac, 0xf8, 0x33, 0xfb, 0xc5,
+	0x2c, 0x80, 0xe2, 0x98, 0x64, 0x56, 0x61, 0xf6, 0x10, 0xd8, 0xa3, 0x5b, 0x48, 0xa9, 0xab, 0x81,
+	0x15, 0xd8, 0xb5, 0x75, 0x77, 0x87, 0x3a, 0x51, 0x5e, 0xbd, 0x32, 0x44, 0x21, 0x21, 0x97, 0xf2,
+	0x9b, 0x61, 0x37, 0x18, 0xe6, 0xef, 0x19, 0x90, 0x92, 0xb5, 0x92, 0xef, 0x33, 0x60, 0x62, 0x8b,
+	0x5a, 0x41, 0xc7, 0xa3, 0xb7, 0xad, 0x20, 0x0c, 0x15, 0xf1, 0xe2, 0x69, 0x88, 0x78, 0xe7, 0x6e,
+	0x69, 0x80, 0x85, 0xf1, 0x43, 0x18, 0x26, 0x5b, 0xaf, 0xc2, 0xd8, 0x08, 0xae, 0x3d, 0x0f, 0x33,
+	0xa9, 0x8e, 0x27, 0x52, 0xbb, 0xfd, 0x5d, 0x03, 0xb2, 0x32, 0x6f, 0x92, 0x97, 0x61, 0xc8, 0x62,
+	0x39, 0x40, 0x25, 0xc1, 0x7c, 0x6f, 0x31, 0x3b, 0x9c, 0xba, 0x1e, 0x91, 0x83, 0xff, 0x44, 0x01,
+	0x96, 0x85, 0x07, 0xb5, 0x62, 0x7a, 0xce, 0xd5, 0xc8, 0xcf, 0x9c, 0xab, 0x87, 0xe6, 0x53, 0xb5,
+	0x98, 0xd1, 0xc3, 0xfc, 0x84, 0x01, 0x24, 0x1d, 0x58, 0x9d, 0x78, 0x30, 0x2a, 0x8f, 0xb2, 0xda,
+	0xa5, 0xc5, 0x82, 0x9e, 0x4c, 0x31, 0xb7, 0xbc, 0xc8, 0xa8, 0x4b, 0x16, 0xf8, 0x18, 0xe2, 0x61,
+	0x61, 0x89, 0xa2, 0xc4, 0x36, 0xe4, 0x9d, 0x30, 0x5e, 0xa7, 0x7e, 0xcd, 0xb3, 0xdb, 0x41, 0xe4,
+	0xc4, 0x17, 0x3a, 0x03, 0x2d, 0x46, 0x55, 0xa8, 0xb7, 0x63, 0xde, 0xed, 0x81, 0xe5, 0xef, 0x2c,
+	0x2f, 0xca, 0x77, 0x1f, 0xbf, 0xa5, 0xd7, 0x79, 0x09, 0xca, 0x9a, 0x28, 0xd6, 0xdf, 0x40, 0x0f,
+	0xb1, 0xfe, 0x98, 0x7b, 0x60, 0xdf, 0x81, 0x0d, 0xc9, 0xf1, 0x41, 0x0d, 0xcd, 0x1f, 0x2b, 0xc1,
+	0x05, 0xd6, 0x64, 0xd5, 0xb2, 0x9d, 0x80, 0x3a, 0xdc, 0x65, 0xa5, 0xe0, 0x22, 0x34, 0x60, 0x32,
+	0x88, 0xf9, 0x74, 0x9e, 0xdc, 0xa1, 0x31, 0xb4, 0x1c, 0x8a, 0x7b, 0x72, 0xc6, 0xe1, 0x92, 0xf7,
+	0x2a, 0x9f, 0x21, 0xf1, 0x42, 0x7e, 0x42, 0x1d, 0x55, 0xee, 0x08, 0xf4, 0x50, 0x3a, 0xc8, 0x86,
+	0xd9, 0x90, 0x62, 0xee, 0x41, 0xef, 0x86, 0x49, 0x69, 0x30, 0x2e, 0x82, 0x36, 0xca, 0x17, 0x32,
+	0xbf, 0x61, 0x6e, 0xe9, 0x15, 0x18, 0x6f, 0x67, 0xfe, 0x7a, 0x09, 0xe2, 0x39, 0x97, 0x8a, 0xae,
+	0x52, 0x3a, 0x62, 0x65, 0xe9, 0xcc, 0x22, 0x56, 0xbe, 0x8d, 0x27, 0x2c, 0x14, 0xf9, 0x76, 0x85,
+	0xde, 0x58, 0x4f, 0x33, 0xc8, 0xcb, 0x31, 0x6c, 0x11, 0x2d, 0xeb, 0xe0, 0x89, 0x97, 0xf5, 0x9d,
+	0xd2, 0x92, 0x74, 0x28, 0x16, 0x37, 0x54, 0x59, 0x92, 0xce, 0xc4, 0x3a, 0x6a, 0x1e, 0x4e, 0xf7,
+	0xe0, 0x8d, 0x2b, 0xae, 0x55, 0x5f, 0xb0, 0x9a, 0xec, 0xdc, 0x79, 0xd2, 0x46, 0xcb, 0xe7, 0x37,
+	0x2c, 0x13, 0x7a, 0xb9, 0x35, 0xb7, 0xc9, 0xee, 0x3f, 0xab, 0xd9, 0x74, 0xf7, 0xd2, 0x39, 0x90,
+	0xe7, 0x45, 0x31, 0xaa, 0x7a, 0xf3, 0x97, 0x0d, 0x18, 0x91, 0xd9, 0x09, 0x7a, 0xf0, 0xc8, 0x63,
+	0x4e, 0x93, 0x3c, 0x79, 0x53, 0x1f, 0xdc, 0x65, 0x75, 0xdb, 0x75, 0x83, 0x58, 0x8e, 0x06, 0xee,
+	0xa7, 0xc1, 0xff, 0x45, 0x01, 0x9e, 0x1b, 0x27, 0x7a, 0xb5, 0x6d, 0x3b, 0xa0, 0xdc, 0x06, 0x43,
+	0x9e, 0x5a, 0x61, 0x9c, 0xa8, 0x95, 0x63, 0xac, 0x95, 0xf9, 0xf9, 0x41, 0xb8, 0x21, 0x01, 0xa7,
+	0x58, 0xae, 0x90, 0x60, 0x1e, 0xb0, 0x1c, 0xe1, 0xbc, 0xcd, 0xa2, 0x67, 0xd9, 0xa1, 0x7e, 0xbf,
+	0xd8, 0x6b, 0x57, 0xe6, 0x14, 0x4f, 0x81, 0xc3, 0x2c, 0x1c, 0x22, 0xd6, 0x2d, 0x2f, 0xbe, 0x43,
+	0xad, 0x66, 0xb0, 0xad, 0x70, 0x97, 0xfa, 0x89, 0x75, 0x9b, 0x86, 0x87, 0x99, 0x58, 0xb8, 0x7d,
+	0x81, 0xac, 0xa8, 0x78, 0xd4, 0xd2, 0x8d, 0x1b, 0xfa, 0x70, 0x9d, 0x58, 0xcd, 0x84, 0x88, 0x39,
+	0x98, 0xb8, 0xd8, 0xd0, 0xda, 0xe7, 0x52, 0x08, 0xa4, 0x81, 0x67, 0xf3, 0x5c, 0x1b, 0xa1, 0xe0,
+	0x7c, 0x35, 0x5e, 0x85, 0xc9, 0xb6, 0x4c, 0xfe, 0xcd, 0xed, 0x35, 0xa2, 0x98, 0x77, 0x43, 0x51,
+	0x58, 0x95, 0x7b, 0xb1, 0x1a, 0x4c, 0xb4, 0x34, 0xbf, 0xad, 0x04, 0x13, 0x27, 0xcc, 0xbf, 0xd5,
+	0xd1, 0x2e, 0xd7, 0x3e, 0x9c, 0xa3, 0x74, 0xac, 0x3d, 0xdc, 0xaf, 0xe4, 0x25, 0x98, 0xea, 0x70,
+	0x8a, 0x14, 0xa6, 0x70, 0x10, 0xe7, 0xff, 0x6b, 0xd9, 0x2c, 0x37, 0x62, 0x35, 0x2c, 0xe6, 0x9b,
+	0x0e, 0x3e, 0x5e, 0x8b, 0x09, 0x38, 0xe6, 0xa7, 0x07, 0xe0, 0x62, 0xc6, 0x68, 0xb8, 0x5e, 0x9f,
+	0x26, 0x58, 0x80, 0x7e, 0xf4, 0xfa, 0x29, 0x76, 0x22, 0xd4, 0xeb, 0x27, 0x6b, 0x30, 0x85, 0x97,
+	0xbc, 0x08, 0x03, 0x35, 0xcf, 0x96, 0x0b, 0xfe, 0xee, 0x42, 0x0f, 0x58, 0x5c, 0x5e, 0x18, 0x97,
+	0x18, 0x59, 0xa2, 0x27, 0x64, 0x00, 0xd9, 0x45, 0xa6, 0x93, 0x0b, 0xc5, 0x55, 0xf0, 0x8b, 0x4c,
+	0xa7, 0x2a, 0x3e, 0xc6, 0xdb, 0x91, 0x97, 0xa0, 0x2c, 0x5f, 0x16, 0xca, 0xd5, 0xdf, 0x75, 0xfc,
+	0x80, 0x7d, 0xd9, 0x81, 0x24, 0xfc, 0xdc, 0xe4, 0xed, 0x6e, 0x4e, 0x1b, 0xcc, 0xed, 0x6d, 0xfe,
+	0x93, 0x41, 0xd0, 0xd3, 0xc6, 0x91, 0xd5, 0x7e, 0xa4, 0x26, 0xd1, 0x8c, 0x95, 0xe4, 0x64, 0x15,
+	0x06, 0x1a, 0xed, 0x4e, 0xb9, 0xd4, 0x1f, 0xb8, 0xdb, 0x0c, 0x5c, 0xa3, 0xdd, 0x21, 0x2f, 0x86,
+	0x82, 0x98, 0x62, 0xa2, 0x92, 0xd0, 0x1b, 0x28, 0x21, 0x8c, 0x51, 0x1f, 0xe2, 0x60, 0xee, 0x87,
+	0xd8, 0x82, 0x11, 0x5f, 0x4a, 0x69, 0x86, 0x8a, 0x87, 0xa7, 0xd2, 0x56, 0x5a, 0x4a, 0x65, 0xc4,
+	0xfb, 0x51, 0xfe, 0x40, 0x85, 0x83, 0xf1, 0xa6, 0x1d, 0xee, 0xee, 0xcd, 0x1f, 0xc6, 0xa3, 0x82,
+	0x37, 0xdd, 0xe0, 0x25, 0x28, 0x6b, 0x52, 0x57, 0xd4, 0x48, 0x2f, 0x57, 0x14, 0xf9, 0x06, 0x18,
+	0xab, 0xb9, 0x7e, 0xb0, 0xe1, 0xd8, 0x81, 0x5f, 0x1e, 0x2d, 0xb4, 0x8a, 0xfc, 0x81, 0x5d, 0x51,
+	0x40, 0x30, 0x82, 0x67, 0xfe, 0xff, 0x25, 0x20, 0xe9, 0x39, 0x92, 0x27, 0x60, 0x88, 0xc7, 0xa2,
+	0x90, 0x84, 0x2e, 0x7c, 0xa6, 0xf0, 0x68, 0x04, 0x28, 0xea, 0x48, 0x55, 0x46, 0xf2, 0x29, 0x76,
+	0x56, 0xb8, 0xd5, 0x8d, 0xc4, 0xa7, 0x85, 0xfd, 0xb9, 0x11, 0xf3, 0x96, 0xc9, 0x62, 0x28, 0x36,
+	0x58, 0x54, 0x33, 0x87, 0x75, 0x29, 0x28, 0x19, 0x13, 0xc6, 0x01, 0x02, 0x04, 0x2a, 0x58, 0xe6,
+	0x6f, 0x97, 0x60, 0x5c, 0x67, 0xcf, 0x0f, 0x00, 0xac, 0x4e, 0xe0, 0x0a, 0xea, 0x58, 0x36, 0x8a,
+	0xbf, 0xec, 0x35, 0xa0, 0xf3, 0x21, 0x40, 0xa1, 0x42, 0x8b, 0x7e, 0xa3, 0x86, 0x8c, 0xa1, 0x0e,
+	0xec, 0x16, 0x7d, 0x60, 0x3b, 0x75, 0x77, 0xaf, 0x5c, 0x3a, 0x15, 0xd4, 0xeb, 0x21, 0x40, 0x81,
+	0x3a, 0xfa, 0x8d, 0x1a, 0x32, 0x46, 0xb7, 0xf8, 0x2b, 0xdf, 0xe1, 0x99, 0xc0, 0xe4, 0xd8, 0x44,
+	0x5a, 0x1e, 0x69, 0x11, 0xc7, 0xe9, 0x56, 0x25, 0xa7, 0x0d, 0xe6, 0xf6, 0x66, 0x0e, 0xb8, 0x97,
+	0x33, 0x97, 0x82, 0xdc, 0x86, 0x99, 0xc8, 0x50, 0x4b, 0xbf, 0x49, 0x46, 0xa3, 0xf4, 0x76, 0x77,
+	0x93, 0x0d, 0x30, 0xdd, 0x87, 0x69, 0xeb, 0x5b, 0xe9, 0x9b, 0x4a, 0x5a, 0x79, 0xe9, 0x7c, 0x97,
+	0x5e, 0x8d, 0x59, 0x7d, 0xcc, 0x23, 0x03, 0x66, 0xb4, 0xd1, 0x32, 0xc9, 0xf5, 0x6b, 0xe7, 0x91,
+	0x2e, 0x6f, 0x27, 0x16, 0x42, 0xb6, 0xdf, 0x4d, 0x17, 0xc3, 0xce, 0x8d, 0x1e, 0xfb, 0xe5, 0xf8,
+	0x96, 0x88, 0xd6, 0xe7, 0xe0, 0xe1, 0xf5, 0x4a, 0xdc, 0xc3, 0x6b, 0xe9, 0x54, 0x66, 0x99, 0xe3,
+	0xe4, 0xf5, 0x5f, 0x4b, 0x19, 0x73, 0x94, 0x79, 0xe6, 0x47, 0xf6, 0xf8, 0xa1, 0x57, 0x52, 0x90,
+	0xbb, 0xa7, 0x32, 0x0e, 0xf9, 0x91, 0x85, 0xcf, 0x27, 0xf1, 0xdb, 0x47, 0x85, 0x8c, 0x34, 0x61,
+	0xd2, 0x67, 0xef, 0x99, 0xea, 0x29, 0xc4, 0x0c, 0x13, 0x99, 0x55, 0x74, 0x68, 0x18, 0x07, 0x4e,
+	0x3c, 0xb8, 0xd0, 0xf6, 0x5c, 0x76, 0xd2, 0x42, 0x7c, 0x03, 0xc5, 0xf1, 0x71, 0x96, 0x7b, 0x2d,
+	0x0e, 0x0f, 0x93, 0x08, 0x58, 0x6c, 0x9e, 0xab, 0x39, 0xeb, 0x42, 0xee, 0xc3, 0xd0, 0x26, 0x6d,
+	0xd8, 0x8a, 0x55, 0x3c, 0xc9, 0x7b, 0x3c, 0xdc, 0xe0, 0x05, 0x06, 0x00, 0x05, 0x1c, 0xa6, 0x24,
+	0x51, 0xfe, 0xf9, 0x27, 0x03, 0x17, 0x32, 0x2b, 0xa1, 0x3f, 0xbf, 0x19, 0xa6, 0x9e, 0x18, 0x88,
+	0xe4, 0x4b, 0xf1, 0xb4, 0x13, 0x2c, 0x1b, 0x63, 0x26, 0x55, 0x65, 0x57, 0x66, 0x34, 0xb1, 0xb1,
+	0x9c, 0xc1, 0x3e, 0xae, 0x07, 0x13, 0x48, 0x0d, 0xc0, 0xfc, 0x66, 0xb8, 0x9a, 0x63, 0x72, 0x41,
+	0x16, 0x61, 0xc2, 0xdf, 0xb3, 0xda, 0x0b, 0x74, 0xdb, 0xda, 0xb5, 0x65, 0xdc, 0x1f, 0x61, 0x99,
+	0x3b, 0x51, 0xd5, 0xca, 0x1f, 0x26, 0x7e, 0x63, 0xac, 0x97, 0x19, 0x00, 0x48, 0x0b, 0x6e, 0xe6,
+	0x5c, 0xb3, 0x05, 0xa3, 0x56, 0x93, 0x7a, 0x41, 0x14, 0xc2, 0xf3, 0xeb, 0x0b, 0x89, 0x32, 0x25,
+	0x0c, 0xe1, 0x31, 0xa4, 0x7e, 0x61, 0x08, 0xdb, 0xfc, 0xab, 0x06, 0x5c, 0xc9, 0x8e, 0xf4, 0xd2,
+	0xc3, 0x83, 0xaa, 0x05, 0xe3, 0x5e, 0xd4, 0x4d, 0xee, 0xf3, 0xbb, 0xb4, 0x7d, 0x9e, 0xd3, 0xa2,
+	0x83, 0xb2, 0xcd, 0xad, 0x78, 0xae, 0xaf, 0xae, 0x84, 0x64, 0xfc, 0xf4, 0x50, 0x70, 0xa4, 0x8d,
+	0x04, 0x75, 0xf8, 0x3c, 0x97, 0x01, 0xc3, 0xee, 0xb7, 0xad, 0x1a, 0xad, 0x9f, 0x73, 0xb2, 0xd4,
+	0x53, 0x08, 0x20, 0x9e, 0x3d, 0xf6, 0xb3, 0xcd, 0x65, 0x90, 0x83, 0xf3, 0xf8, 0x5c, 0x06, 0xd9,
+	0x1d, 0x5f, 0x27, 0x41, 0xb6, 0xb3, 0x07, 0x9f, 0x73, 0x0d, 0x7d, 0x7a, 0x38, 0x6f, 0xb6, 0x27,
+	0xcc, 0xb8, 0xba, 0x7b, 0x86, 0x19, 0x57, 0xa7, 0xfe, 0x34, 0xdb, 0x6a, 0x46, 0xb6, 0xd5, 0x44,
+	0x06, 0xd0, 0xe1, 0x73, 0xca, 0x00, 0xfa, 0x2a, 0x0c, 0xb7, 0x2d, 0x8f, 0x99, 0xb1, 0x8e, 0x14,
+	0xe7, 0x05, 0x33, 0x13, 0x07, 0x47, 0x9f, 0xe4, 0x1a, 0x47, 0x80, 0x12, 0x51, 0x46, 0xbc, 0x8a,
+	0xd1, 0xb3, 0x8a, 0x57, 0xf1, 0x87, 0x06, 0x3c, 0xd6, 0x8d, 0x6c, 0x70, 0xf1, 0x52, 0x2d, 0xf1,
+	0x99, 0xf4, 0x23, 0x5e, 0x4a, 0x51, 0xc3, 0x50, 0xbc, 0x94, 0xac, 0xc1, 0x14, 0x5e, 0xf2, 0x01,
+	0x20, 0xee, 0xa6, 0xb0, 0x52, 0xb9, 0xcd, 0x70, 0x08, 0x07, 0xc3, 0x12, 0x37, 0x1f, 0x0f, 0x73,
+	0x69, 0xdd, 0x4f, 0xb5, 0xc0, 0x8c, 0x5e, 0xe6, 0xcf, 0x97, 0x00, 0xee, 0xd1, 0x80, 0x85, 0x1b,
+	0x67, 0x77, 0xf0, 0x63, 0x31, 0x01, 0xfa, 0xe8, 0x57, 0x2e, 0x9c, 0xdd, 0x63, 0x30, 0xd8, 0x76,
+	0xeb, 0xe2, 0x1e, 0x90, 0x03, 0xe1, 0xd6, 0xf3, 0xbc, 0x94, 0x85, 0x49, 0xe2, 0x26, 0x3c, 0x52,
+	0xe0, 0xc2, 0xc5, 0xef, 0x4c, 0x78, 0xea, 0xa3, 0x28, 0x67, 0x14, 0x4c, 0xba, 0x79, 0xfb, 0xe5,
+	0xa1, 0x88, 0x82, 0x29, 0x65, 0x03, 0x86, 0xb5, 0xe4, 0x59, 0x00, 0xbb, 0x7d, 0xcb, 0x6a, 0xd9,
+	0x4d, 0x5b, 0x7e, 0x4e, 0x63, 0x5c, 0x2e, 0x0c, 0xcb, 0x6b, 0xaa, 0xf4, 0xe1, 0xe1, 0xec, 0xa8,
+	0xfc, 0x75, 0x80, 0x5a, 0x6b, 0xf3, 0xa7, 0x0d, 0x98, 0x8e, 0x16, 0x4f, 0x1e, 0x15, 0x35, 0x72,
+	0x11, 0x4b, 0x34, 0x77, 0xe4, 0x22, 0x7c, 0x74, 0xf7, 0x91, 0x0b, 0xf1, 0x5e, 0xde, 0xc8, 0x9f,
+	0x86, 0x71, 0x2a, 0xa2, 0xc0, 0x2c, 0x2f, 0xa2, 0x0a, 0xe3, 0xc4, 0xe5, 0x18, 0x4b, 0x51, 0x31,
+	0xea, 0x6d, 0xcc, 0x3f, 0x1a, 0x80, 0x89, 0x7b, 0x0d, 0xdb, 0xd9, 0x57, 0xe1, 0x6e, 0x42, 0xdd,
+	0xb1, 0x71, 0x36, 0xba, 0xe3, 0x97, 0xa0, 0xdc, 0xd4, 0x95, 0x3d, 0x82, 0xb1, 0xb1, 0x9c, 0x46,
+	0xb8, 0x02, 0xfc, 0x01, 0xbf, 0x92, 0xd3, 0x06, 0x73, 0x7b, 0x93, 0x00, 0x86, 0x6b, 0x2a, 0x6d,
+	0x56, 0xe1, 0x10, 0x2e, 0xfa, 0x5a, 0xcc, 0xe9, 0xd1, 0x0c, 0x42, 0x9a, 0x24, 0x8f, 0xa7, 0xc4,
+	0xc5, 0x54, 0x10, 0x97, 0xe9, 0xbe, 0x88, 0xe6, 0xb1, 0xee, 0x59, 0x5b, 0x5b, 0x76, 0x4d, 0x3a,
+	0x61, 0x89, 0x93, 0xb8, 0xc2, 0x2c, 0x24, 0x96, 0xb2, 0x1a, 0x3c, 0x3c, 0x9c, 0xbd, 0x99, 0x19,
+	0x5c, 0x85, 0xef, 0x66, 0x66, 0x17, 0xcc, 0x46, 0xc5, 0x42, 0xd0, 0x9d, 0xc0, 0x75, 0x37, 0x16,
+	0x42, 0xe5, 0x17, 0x4a, 0x30, 0xc1, 0x8e, 0x1b, 0x0b, 0x0a, 0xd6, 0x64, 0x21, 0xda, 0x9f, 0x4a,
+	0x46, 0x59, 0x0b, 0x5f, 0x8a, 0xa9, 0x48, 0x6b, 0x2b, 0x70, 0x69, 0xcb, 0xf5, 0x6a, 0x74, 0xbd,
+	0xb2, 0xb6, 0xee, 0x4a, 0x53, 0xaa, 0xc5, 0x7b, 0x55, 0x29, 0xd0, 0xe0, 0xca, 0x9c, 0x5b, 0x19,
+	0xf5, 0x98, 0xd9, 0x8b, 0xd9, 0xc0, 0x47, 0xe5, 0x1b, 0x6d, 0x61, 0x43, 0xce, 0xc0, 0x0d, 0x44,
+	0x36, 0xf0, 0xb7, 0xb2, 0x1a, 0x60, 0x76, 0x3f, 0x66, 0x6a, 0x22, 0x43, 0x5c, 0xde, 0x72, 0xbd,
+	0x3d, 0xcb, 0xab, 0xc7, 0xc1, 0x0e, 0x46, 0xa6, 0x26, 0x8b, 0xf9, 0xcd, 0xb0, 0x1b, 0x0c, 0xf3,
+	0x0f, 0x4b, 0x10, 0x8f, 0x61, 0xc7, 0xc2, 0xb1, 0x79, 0x32, 0xd3, 0x93, 0x0c, 0xc7, 0xc6, 0x58,
+	0x78, 0x56, 0xc6, 0x1c, 0x75, 0xbc, 0xb0, 0xa1, 0x7c, 0x63, 0x71, 0x96, 0x26, 0xea, 0x8e, 0xe0,
+	0xc5, 0x40, 0x05, 0x56, 0xa3, 0x3c, 0x10, 0x81, 0x5a, 0xb7, 0x1a, 0xc8, 0xca, 0x78, 0x1c, 0x7d,
+	0xbb, 0x41, 0x7d, 0x25, 0xac, 0x17, 0x71, 0xf4, 0x79, 0x09, 0xca, 0x1a, 0x62, 0xc1, 0x64, 0xbb,
+	0xd3, 0x94, 0x21, 0x66, 0xd8, 0xd3, 0x44, 0x88, 0x99, 0x9f, 0xcc, 0xca, 0xe3, 0xc4, 0x77, 0x3f,
+	0x33, 0x99, 0xd3, 0x9a, 0x0e, 0x02, 0xe3, 0x10, 0x19, 0xd7, 0xb3, 0x4b, 0xbd, 0x28, 0xa6, 0x70,
+	0x1f, 0x36, 0x57, 0xf7, 0x2b, 0xcb, 0x2f, 0x6a, 0xa0, 0x84, 0xd4, 0x59, 0x2f, 0xc1, 0x18, 0x2a,
+	0xf3, 0x53, 0x06, 0x5c, 0x48, 0xf4, 0x21, 0x7b, 0x70, 0xb1, 0xdd, 0xd9, 0x6c, 0xda, 0xb5, 0xbb,
+	0xf4, 0xc0, 0x8f, 0xe6, 0x6d, 0x9c, 0x70, 0xde, 0x8f, 0xca, 0x03, 0x7f, 0x71, 0x2d, 0x0d, 0x0c,
+	0xb3, 0x30, 0x98, 0x3f, 0x38, 0x0c, 0x5a, 0xe4, 0x95, 0x13, 0x70, 0xcb, 0x3f, 0x6a, 0xc0, 0xa5,
+	0x5a, 0xd3, 0xa6, 0x4e, 0x90, 0x08, 0x62, 0x20, 0xae, 0xd1, 0x8d, 0x42, 0x2b, 0xd9, 0xa6, 0xce,
+	0xf2, 0xa2, 0xf4, 0xbc, 0xa8, 0x64, 0x00, 0x97, 0xde, 0x29, 0x19, 0x35, 0x98, 0x39, 0x18, 0x3e,
+	0x1f, 0x5e, 0xbe, 0xbc, 0xa8, 0xc7, 0x11, 0xac, 0xc8, 0x32, 0x0c, 0x6b, 0xd9, 0x0d, 0xd4, 0xf0,
+	0xdc, 0x4e, 0xdb, 0xaf, 0x70, 0x07, 0x4b, 0x71, 0x38, 0xf9, 0x0d, 0x74, 0x3b, 0x2a, 0x46, 0xbd,
+	0x0d, 0x53, 0x3a, 0x88, 0x9f, 0x6b, 0x1e, 0xdd, 0xb2, 0xf7, 0xe5, 0xe5, 0xcc, 0xb7, 0xff, 0xb6,
+	0x56, 0x8e, 0xb1, 0x56, 0x3c, 0xb4, 0x97, 0xef, 0x77, 0xa8, 0xb7, 0x81, 0x2b, 0x32, 0xbf, 0xa6,
+	0x08, 0xed, 0xa5, 0x0a, 0x31, 0xaa, 0x27, 0xdf, 0x6f, 0xc0, 0x14, 0x8b, 0x70, 0x62, 0x7b, 0x8c,
+	0x95, 0xb3, 0xec, 0x96, 0x5f, 0x1e, 0x29, 0x1e, 0x6e, 0x2b, 0xda, 0xe8, 0x39, 0x8c, 0x01, 0x15,
+	0x17, 0x45, 0x68, 0x95, 0x11, 0xaf, 0xc4, 0xc4, 0x08, 0xd8, 0x52, 0xf9, 0x76, 0xc3, 0xb1, 0x9d,
+	0xc6, 0x7c, 0xb3, 0xc1, 0x14, 0x27, 0xe1, 0x65, 0x5d, 0x8d, 0x8a, 0x51, 0x6f, 0xc3, 0xb4, 0x7d,
+	0x1d, 0x9f, 0x91, 0xff, 0x16, 0x15, 0xeb, 0x3b, 0x16, 0x99, 0xad, 0x6c, 0xe8, 0x15, 0x18, 0x6f,
+	0xc7, 0x74, 0xcc, 0xaa, 0x40, 0xae, 0x32, 0xf0, 0x9e, 0x9c, 0xef, 0xda, 0x88, 0xd5, 0x60, 0xa2,
+	0xe5, 0xb5, 0x79, 0xb8, 0x98, 0x31, 0xcd, 0x13, 0xdd, 0x31, 0x7f, 0x6c, 0xc0, 0x65, 0xc1, 0x7d,
+	0xaa, 0xcc, 0x9c, 0x2a, 0x8a, 0x7f, 0x76, 0x40, 0x7c, 0xe3, 0x4c, 0x03, 0xe2, 0x7f, 0x05, 0x02,
+	0xff, 0x9b, 0x3f, 0x5e, 0x82, 0x37, 0x1e, 0xfb, 0x5d, 0x92, 0x1f, 0x32, 0x60, 0x9c, 0xee, 0x07,
+	0x9e, 0x15, 0x7a, 0xa1, 0xb3, 0x43, 0xba, 0x75, 0x26, 0x44, 0x60, 0x6e, 0x29, 0x42, 0x24, 0x0e,
+	0x6e, 0xf8, 0xe4, 0xd3, 0x6a, 0x50, 0x1f, 0x0f, 0xbb, 0x75, 0x44, 0xf6, 0x0f, 0xdd, 0xbe, 0x4d,
+	0x52, 0x41, 0x59, 0x73, 0xed, 0xfd, 0x2c, 0x1e, 0x7e, 0x1c, 0xf2, 0x89, 0xce, 0xca, 0x5f, 0x33,
+	0xe0, 0xf2, 0x1a, 0x75, 0xea, 0xb6, 0xd3, 0x10, 0x69, 0x90, 0x7c, 0xa9, 0xa0, 0xe9, 0x41, 0x14,
+	0x97, 0x7d, 0x9a, 0x4a, 0x67, 0x79, 0x9a, 0xcc, 0x9f, 0x2b, 0xc1, 0x88, 0x14, 0x38, 0x9f, 0x83,
+	0x00, 0xce, 0x8a, 0x09, 0xe0, 0x0a, 0x89, 0x17, 0x94, 0x74, 0x3c, 0x4f, 0xe2, 0x66, 0x27, 0x24,
+	0x6e, 0xf3, 0xfd, 0x20, 0xe9, 0x2e, 0x62, 0xfb, 0x59, 0x03, 0xca, 0xb2, 0xa5, 0x9e, 0x2e, 0x78,
+	0x83, 0x27, 0x45, 0x5e, 0xcc, 0x49, 0x19, 0x3c, 0x16, 0xbd, 0x8c, 0x8f, 0x4f, 0xf8, 0x4b, 0xaa,
+	0x30, 0xb4, 0xed, 0x76, 0x3c, 0xbf, 0xa0, 0x12, 0x38, 0x7c, 0xc3, 0xdc, 0x61, 0x40, 0x50, 0xc0,
+	0x32, 0x7f, 0xd5, 0x80, 0x71, 0x39, 0xee, 0x73, 0x90, 0x05, 0x7e, 0x4b, 0x5c, 0x16, 0xf8, 0xbe,
+	0x3e, 0xf6, 0x23, 0x47, 0xf8, 0xf7, 0xef, 0x0c, 0xb8, 0x28, 0x5b, 0x48, 0x39, 0x93, 0xd8, 0x82,
+	0xe3, 0x8d, 0xe7, 0xce, 0x62, 0x79, 0xe3, 0x06, 0x05, 0x03, 0xa7, 0x6c, 0x50, 0xf0, 0x39, 0x03,
+	0x26, 0xd5, 0x5c, 0x69, 0x6b, 0x93, 0x7a, 0xe4, 0x16, 0x8c, 0xf8, 0x1d, 0xfe, 0xb1, 0xc9, 0xcd,
+	0x7b, 0x54, 0x43, 0x36, 0xe7, 0x6d, 0x5a, 0x35, 0xb6, 0x55, 0x55, 0xd1, 0x44, 0xcb, 0x9b, 0x2a,
+	0x0a, 0x50, 0x75, 0x66, 0xab, 0xe5, 0xb9, 0xcd, 0x54, 0xe0, 0x73, 0x74, 0x9b, 0x14, 0x79, 0x0d,
+	0x7b, 0xea, 0xb3, 0xbf, 0xea, 0x19, 0xcf, 0x9f, 0xfa, 0xac, 0xda, 0x47, 0x51, 0xce, 0x22, 0x5e,
+	0xcf, 0xa8, 0x4f, 0x87, 0x69, 0xc9, 0xc4, 0x36, 0x6c, 0x02, 0x58, 0x2c, 0x5a, 0x34, 0x5f, 0xa4,
+	0x82, 0x86, 0x34, 0x21, 0x59, 0x99, 0x0f, 0x21, 0xa1, 0x06, 0x95, 0xbc, 0x0a, 0x17, 0xb6, 0x65,
+	0xf4, 0x69, 0x5a, 0xbf, 0xd3, 0xc7, 0x96, 0x86, 0x79, 0x6b, 0xef, 0xc4, 0xc1, 0x61, 0x12, 0xbe,
+	0xf9, 0xd3, 0x43, 0xe1, 0x57, 0xc4, 0x85, 0x58, 0x77, 0x60, 0xac, 0xe6, 0x51, 0x56, 0xbf, 0x70,
+	0xd0, 0xcb, 0x4e, 0x88, 0x3d, 0x56, 0x3d, 0x30, 0xea, 0xcc, 0x58, 0x2b, 0xdd, 0x26, 0xb7, 0x14,
+	0x71, 0xa1, 0xb9, 0xf6, 0xb8, 0x5f, 0x0f, 0x43, 0xee, 0x9e, 0x13, 0xba, 0xf6, 0x74, 0x45, 0xcc,
+	0xf7, 0xed, 0x3e, 0x6b, 0x8d, 0xa2, 0x93, 0x9e, 0xe5, 0x60, 0xb0, 0x4b, 0x96, 0x83, 0x26, 0x4b,
+	0x09, 0xcf, 0xce, 0x5c, 0x5f, 0x39, 0x43, 0x63, 0xa7, 0x57, 0xcf, 0x2a, 0xcf, 0x21, 0xa3, 0x42,
+	0xc1, 0x58, 0x64, 0x47, 0x49, 0x30, 0x75, 0x16, 0x39, 0x14, 0x6b, 0x62, 0x54, 0xcf, 0x12, 0xe6,
+	0xe9, 0xe9, 0x33, 0x46, 0x8a, 0xcb, 0xed, 0xe5, 0xf0, 0xb4, 0x8c, 0x19, 0x62, 0xe9, 0xf3, 0x52,
+	0x68, 0xb0, 0x60, 0x6e, 0x57, 0xeb, 0xd9, 0x89, 0xae, 0xca, 0xa3, 0xc5, 0x15, 0xdf, 0x39, 0xb9,
+	0xb3, 0x16, 0x66, 0xe5, 0x82, 0xe5, 0x25, 0xd7, 0xc2, 0xbc, 0xc1, 0x98, 0xff, 0x79, 0x28, 0x24,
+	0x1d, 0x52, 0xb2, 0x97, 0x2d, 0x77, 0x35, 0x8a, 0xc8, 0x5d, 0xc9, 0xd7, 0xa9, 0x84, 0x56, 0xe2,
+	0xb8, 0x3e, 0x9e, 0x4c, 0x68, 0x35, 0x21, 0x51, 0xc7, 0x92, 0x58, 0x75, 0xe0, 0xa2, 0x1f, 0xb0,
+	0x60, 0xde, 0xb6, 0x54, 0xf6, 0xfa, 0x81, 0xd5, 0x6a, 0x17, 0xc8, 0x28, 0x25, 0x62, 0x45, 0xa4,
+	0x41, 0x61, 0x16, 0x7c, 0x96, 0xfa, 0xb4, 0xcc, 0xcb, 0x99, 0x95, 0x0c, 0x5f, 0x1f, 0x0d, 0xf9,
+	0xc9, 0x3d, 0x14, 0x64, 0x74, 0xbd, 0x6c, 0x78, 0x98, 0x8b, 0x89, 0x7c, 0x04, 0x2e, 0x33, 0x8e,
+	0x6c, 0xbe, 0x16, 0xd8, 0xbb, 0x76, 0x70, 0x10, 0x0d, 0xe1, 0xe4, 0x69, 0xa4, 0xb8, 0x74, 0x69,
+	0x25, 0x0b, 0x18, 0x66, 0xe3, 0x20, 0x16, 0x0c, 0x75, 0x18, 0x79, 0x96, 0x42, 0x8f, 0x17, 0xfa,
+	0xf8, 0x56, 0x38, 0x99, 0x17, 0x64, 0x85, 0xff, 0x8b, 0x02, 0x32, 0xf9, 0x38, 0x5c, 0x60, 0xb8,
+	0x85, 0x3f, 0x33, 0xaf, 0x29, 0x8f, 0x9c, 0x12, 0x32, 0x6e, 0x28, 0xb1, 0x12, 0x07, 0x8e, 0x49,
+	0x6c, 0xe6, 0x8f, 0x47, 0x8c, 0x81, 0xb4, 0x84, 0xe3, 0xe5, 0xbd, 0x99, 0xdf, 0xed, 0xc0, 0x64,
+	0xc3, 0xde, 0xb4, 0x37, 0x0f, 0x02, 0xda, 0xcf, 0x85, 0x12, 0xea, 0xdf, 0x6e, 0xeb, 0xc0, 0x30,
+	0x0e, 0xdb, 0xfc, 0x03, 0x03, 0x48, 0x9a, 0xf2, 0x90, 0x26, 0x8c, 0xd6, 0x55, 0x28, 0x0d, 0xe3,
+	0x54, 0x52, 0x02, 0x85, 0x9c, 0x5a, 0x18, 0x81, 0x23, 0xc4, 0x40, 0x5c, 0x18, 0xdb, 0xdb, 0xb6,
+	0x03, 0xda, 0xb4, 0xfd, 0xe0, 0x94, 0x32, 0x10, 0x85, 0x09, 0x27, 0x1e, 0x28, 0xc0, 0x18, 0xe1,
+	0x30, 0x7f, 0x6a, 0x18, 0x26, 0xf4, 0x6d, 0x65, 0xde, 0xd9, 0x6d, 0xbe, 0x7f, 0x3c, 0x45, 0x44,
+	0xc1, 0xc7, 0x74, 0x78, 0x85, 0xaf, 0xc5, 0x41, 0x61, 0x12, 0xf6, 0xb9, 0x7a, 0xb1, 0x74, 0x60,
+	0x54, 0x82, 0x52, 0xba, 0xdd, 0xdb, 0xfd, 0xdc, 0x9e, 0x1a, 0x9f, 0x1b, 0xed, 0xa9, 0x2c, 0xf5,
+	0x31, 0x44, 0x45, 0x5a, 0x30, 0xcc, 0x0d, 0x96, 0x7c, 0x49, 0xd7, 0x96, 0xfa, 0x79, 0x0e, 0x85,
+	0x3c, 0x9d, 0xf6, 0x24, 0xe2, 0xc0, 0x51, 0x22, 0x61, 0xe1, 0xb4, 0x27, 0x6b, 0xda, 0x5b, 0x48,
+	0x71, 0x0a, 0x2b, 0x7d, 0xa0, 0x4d, 0xbd, 0xad, 0xa2, 0x6f, 0x4a, 0xaf, 0xf2, 0x31, 0x8e, 0x99,
+	0x78, 0x91, 0x85, 0xf2, 0x70, 0xdf, 0x0b, 0xae, 0xd3, 0x0f, 0x8d, 0x89, 0x4e, 0x9a, 0x29, 0xc7,
+	0x78, 0xff, 0x91, 0x53, 0xe6, 0xfd, 0xbf, 0x67, 0x10, 0x46, 0xc3, 0x0c, 0xac, 0xc7, 0x3f, 0x6e,
+	0x3a, 0x40, 0xf4, 0x05, 0xe9, 0x47, 0xc1, 0xc9, 0x25, 0x0a, 0x95, 0x14, 0x30, 0xcc, 0x40, 0x40,
+	0x3e, 0x02, 0x97, 0x6c, 0x67, 0xcb, 0xb3, 0xc2, 0x70, 0xad, 0x15, 0xa5, 0xd5, 0x2a, 0x80, 0x98,
+	0x8b, 0x7a, 0x97, 0x33, 0xc0, 0x61, 0x26, 0x12, 0x42, 0x61, 0x44, 0x24, 0x9a, 0x56, 0x26, 0x0c,
+	0xcf, 0x16, 0x0a, 0x76, 0xcd, 0x41, 0x68, 0x36, 0x86, 0x02, 0x24, 0x2a, 0xd8, 0x22, 0xb8, 0xb6,
+	0xf8, 0x5f, 0x59, 0x77, 0x94, 0x87, 0x8a, 0x2b, 0x0f, 0x1e, 0xc4, 0x41, 0xc9, 0xe0, 0xda, 0xf1,
+	0x42, 0x4c, 0x22, 0x34, 0xff, 0x56, 0x09, 0x86, 0x44, 0x0c, 0xc5, 0xb3, 0x17, 0xdc, 0x7c, 0x73,
+	0x4c, 0x70, 0xf3, 0x5c, 0x91, 0x49, 0xf2, 0xa1, 0xe6, 0x8a, 0x6d, 0x1a, 0x09, 0xb1, 0xcd, 0xf3,
+	0xc5, 0x51, 0x74, 0x17, 0xda, 0xfc, 0xb2, 0x01, 0x63, 0xbc, 0xdd, 0x39, 0x88, 0x3e, 0x5e, 0x8e,
+	0x8b, 0x3e, 0xde, 0x5b, 0x78, 0x4e, 0x39, 0x82, 0x8f, 0x7f, 0x3c, 0x20, 0xe7, 0xc2, 0x1f, 0xa0,
+	0xcb, 0x70, 0x51, 0x06, 0x7f, 0x60, 0x59, 0xc6, 0xd9, 0xb7, 0xb4, 0x68, 0x1d, 0x08, 0x1e, 0x67,
+	0x48, 0x46, 0x07, 0x4b, 0x57, 0x63, 0x56, 0x1f, 0xf2, 0x0b, 0x06, 0x7b, 0xea, 0x05, 0x9e, 0x5d,
+	0xeb, 0xcb, 0x84, 0x2b, 0x1c, 0xdb, 0xdc, 0xaa, 0x00, 0x26, 0x24, 0xb5, 0x1b, 0xd1, 0x9b, 0x8f,
+	0x97, 0x3e, 0x3c, 0x9c, 0x9d, 0xcd, 0xd0, 0x24, 0x47, 0x39, 0xd3, 0xfd, 0xe0, 0xdb, 0x7f, 0xa7,
+	0x6b, 0x13, 0x2e, 0x44, 0x55, 0x23, 0x26, 0x77, 0x60, 0xc8, 0xaf, 0xb9, 0x6d, 0x15, 0x3e, 0xe4,
+	0x89, 0x2c, 0xcd, 0x59, 0x52, 0x69, 0x16, 0x2e, 0x70, 0x95, 0xf5, 0x44, 0x01, 0xe0, 0xda, 0x2b,
+	0x30, 0xa1, 0x8f, 0x3c, 0x43, 0x12, 0xbc, 0xa8, 0x4b, 0x82, 0x4f, 0x4c, 0xec, 0x75, 0xc9, 0xf1,
+	0x4f, 0x94, 0x60, 0x5c, 0x3b, 0xc0, 0xe4, 0x6f, 0x1a, 0x30, 0xd8, 0xf1, 0xb9, 0x1a, 0x7b, 0xa0,
+	0xa8, 0x7d, 0x92, 0x06, 0x6f, 0x6e, 0xc3, 0xa7, 0x75, 0xb1, 0xfe, 0xa8, 0xbe, 0x3f, 0x56, 0x74,
+	0x4a, 0x8b, 0xcf, 0x87, 0x7a, 0xad, 0x01, 0x63, 0x21, 0x9a, 0x33, 0x5d, 0xac, 0xdf, 0x1c, 0x80,
+	0x61, 0xa4, 0x0d, 0x99, 0xb4, 0xf2, 0x18, 0xb9, 0xba, 0xad, 0x32, 0x79, 0x97, 0x8a, 0x7b, 0xe3,
+	0xeb, 0x52, 0x59, 0x96, 0xbe, 0x3b, 0x3a, 0x30, 0x7a, 0x32, 0x6f, 0xe2, 0x84, 0xa9, 0xfc, 0x04,
+	0x8f, 0x57, 0x48, 0x04, 0x21, 0x26, 0xd6, 0x4b, 0xf2, 0x3e, 0xf2, 0x67, 0x0c, 0x20, 0x56, 0xad,
+	0xc6, 0x5c, 0xa0, 0xa9, 0xcf, 0x0e, 0x6a, 0x94, 0x01, 0xad, 0x68, 0xae, 0x85, 0x24, 0xb4, 0x48,
+	0x12, 0x90, 0xaa, 0xf2, 0x31, 0x03, 0x79, 0x3f, 0x09, 0x05, 0xff, 0xa9, 0x01, 0x13, 0xb1, 0x7c,
+	0x8d, 0xad, 0xc8, 0x1c, 0xa1, 0xb8, 0x55, 0xb2, 0xf2, 0x01, 0x7f, 0xb4, 0x4b, 0x23, 0x61, 0xe2,
+	0x70, 0x3f, 0x4c, 0xa2, 0x74, 0x3a, 0xa9, 0x1d, 0xcd, 0xcf, 0x18, 0x70, 0x45, 0x4d, 0x28, 0x9e,
+	0x2d, 0x83, 0x69, 0xa5, 0xad, 0xb6, 0xcd, 0x75, 0xc4, 0xba, 0x96, 0x7d, 0x7e, 0x6d, 0x99, 0x97,
+	0x61, 0x58, 0x1b, 0x4b, 0x97, 0x5e, 0x3a, 0x36, 0x5d, 0xfa, 0x9b, 0xb5, 0x04, 0xf0, 0x43, 0xd1,
+	0x03, 0x2c, 0x44, 0x2c, 0x1c, 0xc1, 0xcc, 0x77, 0xc1, 0x58, 0xb5, 0x7a, 0x47, 0x6c, 0xe9, 0x09,
+	0x8c, 0x66, 0xcc, 0x4f, 0x0e, 0xc0, 0xa4, 0x4c, 0xfb, 0x63, 0x73, 0x35, 0xd7, 0x39, 0x70, 0x1f,
+	0xeb, 0x30, 0xe6, 0x87, 0xe6, 0x10, 0xa5, 0x7c, 0xa2, 0x1e, 0x5a, 0x34, 0x24, 0xf3, 0x9c, 0x86,
+	0x15, 0x18, 0x01, 0x22, 0x77, 0x61, 0xf8, 0x55, 0x46, 0x1f, 0xd5, 0xb7, 0xda, 0xd3, 0x3d, 0x11,
+	0x7e, 0x88, 0x9c, 0xb4, 0xfa, 0x28, 0x41, 0x10, 0x9f, 0x07, 0x29, 0xe0, 0xac, 0x79, 0x3f, 0x01,
+	0xa8, 0x63, 0x2b, 0xab, 0x78, 0x7d, 0x71, 0x30, 0xd4, 0x2f, 0x0c, 0x11, 0xf1, 0x24, 0xcd, 0xb1,
+	0x1e, 0xaf, 0x93, 0x24, 0xcd, 0xb1, 0x31, 0xe7, 0xf0, 0x36, 0xef, 0x85, 0xcb, 0x99, 0x8b, 0x71,
+	0xfc, 0xc3, 0xc7, 0xfc, 0x1b, 0x25, 0x18, 0x64, 0xa9, 0x96, 0xcf, 0xe1, 0x64, 0xbe, 0x1c, 0xe3,
+	0x8b, 0xbf, 0xbe, 0x70, 0x9a, 0xe8, 0x3c, 0xb6, 0x78, 0x2b, 0xc1, 0x16, 0xbf, 0xbf, 0x30, 0x86,
+	0xee, 0x5c, 0xf1, 0x0f, 0x97, 0x00, 0x58, 0xb3, 0x05, 0xab, 0xb6, 0x23, 0x28, 0x4e, 0x78, 0x9a,
+	0x8d, 0x38, 0xc5, 0x49, 0x1f, 0xc3, 0xf3, 0xb4, 0xa2, 0xe5, 0x2e, 0x44, 0x0d, 0x3b, 0xe9, 0x42,
+	0xd4, 0xb0, 0x85, 0x0b, 0x11, 0xfb, 0x1b, 0xa7, 0x16, 0x83, 0xa7, 0x44, 0x2d, 0xcc, 0x7d, 0x18,
+	0x61, 0x0b, 0xc4, 0x0c, 0xf3, 0x5a, 0xda, 0xea, 0x94, 0x8a, 0xbf, 0xfa, 0x24, 0xb8, 0x63, 0xbf,
+	0xf2, 0x4f, 0x1a, 0x70, 0x21, 0xd1, 0xb6, 0x87, 0xd7, 0xff, 0x99, 0xd0, 0x4c, 0xf3, 0x97, 0x0c,
+	0x18, 0x65, 0x63, 0x39, 0x07, 0x42, 0xf3, 0x4d, 0x71, 0x42, 0xf3, 0x9e, 0xa2, 0x4b, 0x9c, 0x43,
+	0x5f, 0x7e, 0xbf, 0x04, 0x3c, 0x1f, 0xbb, 0x34, 0x77, 0xd6, 0x0c, 0x99, 0x8d, 0x1c, 0x13, 0xec,
+	0x1b, 0xd2, 0x0e, 0x3a, 0xa1, 0x20, 0xd5, 0x6c, 0xa1, 0xdf, 0x16, 0x33, 0x75, 0x8e, 0x7d, 0x36,
+	0x19, 0xe6, 0xce, 0xaf, 0x49, 0x47, 0xc5, 0x30, 0x58, 0xf2, 0x60, 0x71, 0x83, 0x05, 0x2e, 0x86,
+	0x53, 0x53, 0xd1, 0xdc, 0x16, 0x15, 0x6c, 0x8c, 0xa3, 0x62, 0xb6, 0x9c, 0x9b, 0x4d, 0xb7, 0xb6,
+	0x23, 0x2c, 0xad, 0x87, 0xa2, 0x84, 0xb9, 0x0b, 0x61, 0x29, 0x6a, 0x2d, 0xfa, 0x32, 0x2a, 0xff,
+	0x5d, 0x43, 0xac, 0xf4, 0x09, 0x0e, 0xef, 0x39, 0x52, 0x94, 0xb7, 0x24, 0x28, 0x4a, 0x48, 0x21,
+	0x13, 0x54, 0x65, 0x56, 0x3d, 0x22, 0x06, 0x23, 0xe5, 0xb7, 0xce, 0xfa, 0x9b, 0x3f, 0x27, 0xa7,
+	0x19, 0xba, 0x86, 0xb6, 0x61, 0xb2, 0xa9, 0x7b, 0x77, 0x96, 0x8d, 0xe2, 0x8e, 0xa1, 0xa1, 0xc4,
+	0x33, 0x56, 0x8c, 0x71, 0x04, 0xcc, 0xc0, 0x4e, 0xcd, 0x4e, 0x38, 0xd3, 0x94, 0xa2, 0x70, 0x1a,
+	0x6b, 0x7a, 0x05, 0xc6, 0xdb, 0x99, 0x9f, 0x2d, 0xc1, 0xe3, 0x62, 0xec, 0x5c, 0xb6, 0xb4, 0x48,
+	0xdb, 0xd4, 0xa9, 0x53, 0xa7, 0x76, 0xc0, 0x79, 0xd6, 0xba, 0xcb, 0xa4, 0x7a, 0xc3, 0x7b, 0x94,
+	0xd6, 0x43, 0x0d, 0xf3, 0x83, 0xc2, 0x17, 0x51, 0x1e, 0x8a, 0x07, 0x1c, 0xbc, 0xa0, 0xe8, 0xe2,
+	0x7f, 0x94, 0x28, 0x19, 0xf2, 0xb6, 0xe7, 0x6e, 0x86, 0xac, 0xd5, 0xe9, 0x23, 0x5f, 0xe3, 0xe0,
+	0x05, 0x72, 0xf1, 0x3f, 0x4a, 0x94, 0xe6, 0x1a, 0x3c, 0xd1, 0x43, 0xd7, 0x93, 0xb0, 0xd0, 0xc7,
+	0x41, 0x14, 0xb3, 0x3f, 0x09, 0xc4, 0xdf, 0x32, 0xe0, 0x4d, 0x1a, 0x48, 0x96, 0xf5, 0xda, 0xf7,
+	0x2b, 0x56, 0xdb, 0xaa, 0xb1, 0x77, 0x33, 0x0f, 0x00, 0x7b, 0xa2, 0x1c, 0xe4, 0x9f, 0x34, 0x60,
+	0x44, 0x38, 0x08, 0x28, 0xf2, 0xfb, 0x72, 0x9f, 0x4b, 0x9e, 0x3b, 0x24, 0x95, 0x6f, 0x52, 0xcd,
+	0x4d, 0xfc, 0xf6, 0x51, 0xe1, 0x37, 0xff, 0xd1, 0x10, 0x7c, 0x4d, 0xef, 0x80, 0xc8, 0xef, 0x1a,
+	0x30, 0xa6, 0xde, 0x42, 0x4a, 0x69, 0xd6, 0x3a, 0xdb, 0xc1, 0x87, 0x92, 0x10, 0xf9, 0x58, 0x7f,
+	0xa0, 0xae, 0xd0, 0xb0, 0xfc, 0x94, 0x84, 0x2c, 0xd1, 0xc4, 0xc8, 0x4f, 0x18, 0x30, 0xc1, 0xae,
+	0x25, 0xcd, 0xcb, 0x9d, 0xcd, 0xb4, 0x7d, 0xc6, 0x33, 0xbd, 0xa7, 0xa1, 0x4c, 0x44, 0x8a, 0xd4,
+	0xab, 0x30, 0x36, 0x36, 0xb2, 0x11, 0xb7, 0xce, 0x10, 0xcf, 0xad, 0xeb, 0x59, 0xdc, 0x88, 0xa6,
+	0x3a, 0x0c, 0xed, 0x39, 0xf3, 0x2c, 0x2f, 0xae, 0x35, 0x61, 0x2a, 0xbe, 0xf2, 0x67, 0x29, 0x72,
+	0x62, 0xe1, 0x2e, 0x53, 0xb3, 0x3f, 0x91, 0x70, 0xe3, 0x07, 0x86, 0x60, 0x56, 0x5b, 0xea, 0xac,
+	0x98, 0x71, 0xe4, 0xf3, 0x06, 0x8c, 0x5b, 0x8e, 0x23, 0xed, 0x8b, 0xd5, 0xf9, 0xad, 0xf7, 0xb9,
+	0xab, 0x59, 0xa8, 0xe6, 0xe6, 0x23, 0x34, 0x09, 0x03, 0x5a, 0xad, 0x06, 0xf5, 0xd1, 0x74, 0x71,
+	0x16, 0x2a, 0x9d, 0x9b, 0xb3, 0x10, 0xf9, 0x98, 0xba, 0x88, 0xc5, 0x31, 0x7a, 0xe9, 0x0c, 0xd6,
+	0x86, 0xdf, 0xeb, 0x39, 0x12, 0xbe, 0xef, 0x35, 0xf8, 0x25, 0x1b, 0x85, 0xf6, 0x2b, 0x0f, 0x16,
+	0xf7, 0x75, 0x38, 0x36, 0x6e, 0x60, 0x78, 0x77, 0x47, 0x45, 0x18, 0x47, 0xcf, 0x2c, 0x96, 0x93,
+	0x5b, 0x79, 0xa2, 0x63, 0xf9, 0x0f, 0x06, 0x63, 0x77, 0x47, 0xee, 0x7a, 0xf4, 0x20, 0x68, 0xfd,
+	0x42, 0xe2, 0xf4, 0x0a, 0x9a, 0x64, 0x9f, 0xd5, 0x0e, 0x9d, 0xee, 0x11, 0x1e, 0x38, 0xbf, 0x23,
+	0xfc, 0x7f, 0xdd, 0x19, 0x5a, 0x80, 0xcb, 0xda, 0x86, 0x45, 0xf9, 0xeb, 0x78, 0xd8, 0x67, 0xdb,
+	0xb7, 0x55, 0xf2, 0x02, 0x8d, 0x87, 0x79, 0x51, 0x14, 0xa3, 0xaa, 0x37, 0x57, 0x62, 0xd4, 0x71,
+	0xdd, 0x6d, 0xbb, 0x4d, 0xb7, 0x71, 0x30, 0xbf, 0x67, 0x79, 0x14, 0xdd, 0x4e, 0x20, 0xa1, 0xf5,
+	0xca, 0x11, 0xad, 0xc2, 0x0d, 0x0d, 0x5a, 0x66, 0x88, 0xe7, 0x93, 0x80, 0xfb, 0xd5, 0x11, 0x98,
+	0xd0, 0xe0, 0xf9, 0xe4, 0x67, 0x0d, 0x78, 0x84, 0xe6, 0x5d, 0x96, 0x92, 0xd3, 0x7f, 0xe9, 0xac,
+	0x2e, 0x63, 0x99, 0x4e, 0x2e, 0xaf, 0x1a, 0xf3, 0x47, 0xc6, 0x62, 0x5f, 0xf9, 0xe1, 0xf6, 0xf4,
+	0x13, 0x06, 0x29, 0x73, 0xbf, 0xc5, 0x1b, 0x32, 0xfa, 0x8d, 0x1a, 0x32, 0xf2, 0x23, 0x06, 0x5c,
+	0x6a, 0x66, 0x1c, 0x56, 0x79, 0xf8, 0xab, 0x67, 0x40, 0x26, 0x84, 0xfd, 0x40, 0x56, 0x0d, 0x66,
+	0x0e, 0x85, 0xfc, 0x58, 0x6e, 0xec, 0x71, 0xa1, 0xde, 0x5f, 0xef, 0x73, 0x90, 0xa7, 0x15, 0x86,
+	0xfc, 0xb3, 0x06, 0x90, 0x7a, 0xea, 0xe1, 0x50, 0x1e, 0x29, 0x9e, 0xff, 0xb5, 0xeb, 0x8b, 0x44,
+	0x18, 0x80, 0xa4, 0xcb, 0x31, 0x63, 0x10, 0x7c, 0x9f, 0x83, 0x8c, 0xcf, 0xb7, 0x3c, 0x7a, 0x2a,
+	0xfb, 0x9c, 0x45, 0x19, 0xc4, 0x3e, 0x67, 0xd5, 0x60, 0xe6, 0x50, 0xcc, 0xdf, 0x1a, 0x11, 0x72,
+	0x2c, 0xae, 0x38, 0xdf, 0x84, 0xe1, 0x4d, 0x2e, 0xf7, 0x2c, 0x1b, 0xfd, 0x09, 0x59, 0x85, 0xf4,
+	0x54, 0xbc, 0x22, 0xc5, 0xff, 0x28, 0x21, 0x93, 0x0f, 0xc3, 0x40, 0xdd, 0x51, 0x36, 0x84, 0xef,
+	0xeb, 0x43, 0x5c, 0x18, 0x85, 0x35, 0x62, 0xde, 0xbd, 0x0c, 0x28, 0x71, 0x60, 0xd4, 0x91, 0xa2,
+	0x9f, 0xf2, 0x40, 0x71, 0x03, 0x4b, 0x5d, 0x1a, 0x16, 0x09, 0xae, 0x54, 0x09, 0x86, 0x38, 0x18,
+	0xbe, 0x84, 0xae, 0xa3, 0x30, 0xbe, 0x50, 0xf8, 0xd9, 0x4d, 0xbe, 0x4c, 0x59, 0x5c, 0x72, 0xdb,
+	0x09, 0x54, 0x70, 0x90, 0xe7, 0x8a, 0x62, 0x5b, 0x67, 0x50, 0x22, 0x09, 0x0f, 0xff, 0xe9, 0xa3,
+	0x04, 0xce, 0x8e, 0x81, 0x08, 0x10, 0x52, 0x1e, 0xe9, 0xef, 0x18, 0x88, 0x98, 0x23, 0xe2, 0x18,
+	0x88, 0xff, 0x51, 0x42, 0x26, 0xaf, 0x30, 0x09, 0xa1, 0x34, 0x18, 0x1a, 0xed, 0x6f, 0xe9, 0x42,
+	0x6b, 0x21, 0x19, 0x4e, 0x41, 0xfc, 0xc2, 0x10, 0x3e, 0xd9, 0x84, 0x11, 0x5b, 0x44, 0x02, 0x28,
+	0x8f, 0x15, 0x3f, 0x76, 0x32, 0x98, 0x80, 0x10, 0x14, 0xc8, 0x1f, 0xa8, 0x00, 0xe7, 0xe9, 0x9f,
+	0xe1, 0x2b, 0xa8, 0x7f, 0x36, 0x7f, 0x15, 0x84, 0x2e, 0x43, 0xda, 0x51, 0x6c, 0xc1, 0xa8, 0x42,
+	0xd9, 0x4f, 0x14, 0xae, 0xdb, 0xb2, 0x5a, 0x2c, 0xb7, 0xfa, 0x85, 0x21, 0x6c, 0x96, 0xfd, 0x2c,
+	0x1d, 0x66, 0x31, 0xca, 0x89, 0xdc, 0x5b, 0x88, 0xc5, 0x57, 0x01, 0x6a, 0x51, 0x24, 0xe5, 0x81,
+	0xe2, 0xc7, 0x3d, 0x8c, 0xb2, 0x1c, 0x29, 0xb0, 0xc2, 0x22, 0x1f, 0x35, 0x24, 0x39, 0x4e, 0x00,
+	0x83, 0x85, 0x9c, 0x00, 0x9e, 0x83, 0x0b, 0xd2, 0x9c, 0x68, 0xb9, 0x4e, 0xf9, 0x0b, 0x5a, 0xfa,
+	0x43, 0x73, 0x8b, 0xb6, 0x4a, 0xbc, 0x0a, 0x93, 0x6d, 0xc9, 0xdf, 0x37, 0x98, 0xe7, 0xb9, 0x60,
+	0x5a, 0xca, 0xc3, 0xc5, 0x0d, 0x47, 0xa3, 0xdd, 0x9f, 0x53, 0x3c, 0x90, 0x78, 0x1f, 0xbc, 0xa8,
+	0xa8, 0x8c, 0x2a, 0x3e, 0x25, 0xc1, 0x4c, 0x38, 0x6a, 0xf2, 0x2b, 0xec, 0x09, 0xd4, 0x6c, 0xba,
+	0x35, 0x2b, 0xe0, 0xd1, 0x6a, 0x85, 0xa3, 0xf6, 0xfd, 0x3e, 0x67, 0x31, 0x1f, 0x41, 0x14, 0x13,
+	0xf9, 0x50, 0xf8, 0xd0, 0x89, 0x6a, 0x4e, 0x69, 0x2e, 0xfa, 0xf0, 0xc9, 0x5f, 0x31, 0xe0, 0x4d,
+	0xc2, 0x3b, 0xbe, 0x42, 0xbd, 0x40, 0x84, 0x2a, 0xa0, 0x22, 0x60, 0xb4, 0x72, 0x21, 0x15, 0x2e,
+	0x0b, 0xa3, 0x27, 0x36, 0x9c, 0x7e, 0xf2, 0xe8, 0x70, 0xf6, 0x4d, 0x95, 0x1e, 0x60, 0x63, 0x4f,
+	0x23, 0x60, 0xea, 0x94, 0xa6, 0x1e, 0xa1, 0xbf, 0x3c, 0x56, 0x5c, 0x9d, 0x12, 0x0b, 0xf5, 0x2f,
+	0xde, 0x4f, 0xb1, 0x22, 0x8c, 0xa3, 0xba, 0xb6, 0x03, 0x93, 0xb1, 0x83, 0x76, 0xa6, 0x82, 0x28,
+	0x07, 0xa6, 0x93, 0xe7, 0xe1, 0x4c, 0x6d, 0xad, 0xee, 0xc2, 0x58, 0x78, 0x79, 0x92, 0xc7, 0x35,
+	0x44, 0x11, 0x2b, 0x72, 0x97, 0x1e, 0x08, 0xac, 0xb3, 0xb1, 0x27, 0xa2, 0xd0, 0x92, 0xbc, 0xc8,
+	0x0a, 0x24, 0x40, 0xf3, 0xd7, 0xa4, 0x96, 0x64, 0x9d, 0xb6, 0xda, 0x4d, 0x2b, 0xa0, 0xaf, 0x7f,
+	0x1d, 0xbd, 0xf9, 0x6f, 0x0d, 0x71, 0xdf, 0x88, 0xab, 0x9e, 0x58, 0x30, 0xde, 0x12, 0x99, 0x22,
+	0x79, 0x0c, 0x65, 0xa3, 0x78, 0xf4, 0xe6, 0xd5, 0x08, 0x0c, 0xea, 0x30, 0xc9, 0x1e, 0x8c, 0x29,
+	0xe6, 0x48, 0x09, 0x59, 0x6e, 0xf5, 0xc7, 0xac, 0x84, 0x7c, 0x58, 0xa8, 0xfe, 0x55, 0x25, 0x3e,
+	0x46, 0xb8, 0x4c, 0x0b, 0x48, 0xba, 0x0f, 0x7b, 0x47, 0x2b, 0xf7, 0x41, 0x23, 0x9e, 0xdb, 0x29,
+	0xe5, 0x42, 0xa8, 0x64, 0x48, 0xa5, 0x3c, 0x19, 0x92, 0xf9, 0x8b, 0x25, 0xb8, 0x24, 0x9f, 0x63,
+	0xf3, 0xb5, 0x9a, 0xdb, 0x71, 0x82, 0x48, 0xf5, 0x2f, 0x42, 0x62, 0x48, 0x24, 0x9c, 0xbd, 0x12,
+	0xf1, 0x32, 0x50, 0xd6, 0xb0, 0x18, 0x3c, 0x4c, 0xe2, 0xe2, 0xd4, 0x79, 0x4e, 0xa5, 0x88, 0x4a,
+	0xe8, 0x31, 0x78, 0x96, 0xb2, 0x1a, 0x60, 0x76, 0x3f, 0xb2, 0x0b, 0xa4, 0x65, 0xed, 0x27, 0xa1,
+	0x15, 0xcb, 0x18, 0xc8, 0xdf, 0x50, 0xab, 0x29, 0x68, 0x98, 0x81, 0x81, 0x5d, 0xa4, 0x8c, 0xb3,
+	0x69, 0x07, 0xb4, 0x2e, 0xa6, 0xa8, 0x94, 0xb4, 0xfc, 0x22, 0x9d, 0x8f, 0x57, 0x61, 0xb2, 0xad,
+	0xf9, 0x9d, 0xc3, 0xf0, 0x48, 0x7c, 0x11, 0xd9, 0x17, 0xaa, 0xa2, 0x56, 0x3c, 0xaf, 0x5c, 0xf5,
+	0xc4, 0x42, 0x3e, 0x95, 0x74, 0xd5, 0x2b, 0x57, 0x3c, 0xca, 0xaf, 0x64, 0xab, 0xe9, 0xab, 0x4e,
+	0x31, 0xb7, 0xbd, 0xaf, 0x40, 0x08, 0x8a, 0x9c, 0xe0, 0x08, 0x03, 0x67, 0x1a, 0x6a, 0xe3, 0x53,
+	0x06, 0x5c, 0x8b, 0x17, 0xdf, 0xb2, 0x1d, 0xdb, 0xdf, 0x96, 0x99, 0x81, 0x4e, 0xee, 0x29, 0xc8,
+	0x73, 0x65, 0xaf, 0xe4, 0x42, 0xc4, 0x2e, 0xd8, 0xc8, 0xa7, 0x0d, 0x78, 0x34, 0xb1, 0x2e, 0xb1,
+	0x3c, 0x45, 0x27, 0x77, 0x1a, 0xe4, 0xb1, 0xa3, 0x56, 0xf2, 0x41, 0x62, 0x37, 0x7c, 0xec, 0x99,
+	0x7f, 0xa5, 0x9d, 0x15, 0xe9, 0x42, 0x3d, 0xd3, 0x0a, 0x89, 0x95, 0x32, 0x63, 0x67, 0x2c, 0x5c,
+	0x97, 0x47, 0xf4, 0x4a, 0x66, 0xb5, 0x8f, 0x39, 0x03, 0xe1, 0x2e, 0x12, 0xdc, 0x0e, 0xe2, 0xf5,
+	0xe1, 0x22, 0xc1, 0x87, 0x7a, 0xb6, 0x2e, 0x12, 0x02, 0x45, 0x77, 0x63, 0xb0, 0x0f, 0xc1, 0x15,
+	0xde, 0x6c, 0xbe, 0xce, 0x85, 0x4f, 0x3e, 0xad, 0xcf, 0xd7, 0xeb, 0xfc, 0xb9, 0x77, 0xbc, 0x0a,
+	0xe0, 0x71, 0x18, 0xe8, 0x78, 0xcd, 0x64, 0x04, 0x66, 0x16, 0xd0, 0x88, 0x95, 0xb3, 0xb0, 0x57,
+	0xd3, 0x1c, 0xb6, 0x46, 0x62, 0xc8, 0x2e, 0x8c, 0x7a, 0x92, 0xcc, 0xc8, 0xbd, 0x59, 0x29, 0x3c,
+	0xb5, 0x0c, 0xd2, 0x25, 0x5e, 0x6c, 0xea, 0x17, 0x86, 0xb8, 0xcc, 0x2f, 0x0d, 0x43, 0x39, 0xaf,
+	0x13, 0x0b, 0xba, 0x74, 0xa5, 0x16, 0x71, 0x9c, 0x2c, 0xfa, 0x8c, 0xeb, 0xd9, 0x81, 0x4d, 0xfd,
+	0x7e, 0xa4, 0x44, 0x95, 0xf9, 0x70, 0x54, 0x3c, 0x57, 0x4f, 0x25, 0x13, 0x03, 0xe6, 0x60, 0x66,
+	0x99, 0xc7, 0x77, 0xa2, 0x64, 0x83, 0xa5, 0xe2, 0x99, 0xc7, 0xf9, 0xb4, 0xb5, 0x84, 0x84, 0x6a,
+	0x50, 0x61, 0x88, 0x5a, 0x59, 0xae, 0xa1, 0x63, 0xc8, 0x7d, 0x7f, 0xfb, 0x2e, 0x3d, 0x68, 0x5b,
+	0xb6, 0x32, 0x03, 0x29, 0x8e, 0xbc, 0x5a, 0xbd, 0x23, 0x41, 0xc5, 0x91, 0x6b, 0xe5, 0x1a, 0x3a,
+	0xa6, 0xb7, 0x99, 0x74, 0xf5, 0x18, 0x4c, 0xfd, 0x58, 0xd9, 0x66, 0x06, 0x73, 0x12, 0x6c, 0x7e,
+	0xbc, 0x2a, 0x8e, 0x92, 0x9d, 0x89, 0x19, 0x3f, 0x79, 0xad, 0x4a, 0xc2, 0xbb, 0x5a, 0x8c, 0x01,
+	0xcb, 0xb9, 0xa3, 0x85, 0xc8, 0x20, 0x5d, 0x9d, 0x46, 0xcf, 0x07, 0x45, 0x83, 0x5a, 0x7d, 0xc9,
+	0xa9, 0x79, 0x07, 0x3c, 0x1a, 0x04, 0x1b, 0xd4, 0x70, 0xf1, 0x41, 0x2d, 0xad, 0x57, 0x16, 0x63,
+	0xc0, 0xe2, 0x83, 0x4a, 0x57, 0xa7, 0xd1, 0xb3, 0xcc, 0x4e, 0x57, 0x73, 0xce, 0xd8, 0x9f, 0x98,
+	0xa0, 0x59, 0xcc, 0xd3, 0x8c, 0xaf, 0xc1, 0xeb, 0xc4, 0xd3, 0x8c, 0x8f, 0x35, 0xc7, 0x5a, 0xf2,
+	0x97, 0x98, 0xa5, 0x79, 0x32, 0x4b, 0x5c, 0x4f, 0xae, 0x37, 0xe7, 0x66, 0xc8, 0xf7, 0xe6, 0x28,
+	0xc3, 0xec, 0x40, 0x14, 0xc4, 0x24, 0x99, 0x5d, 0xd6, 0x7c, 0x00, 0x93, 0x31, 0x63, 0x49, 0x2d,
+	0xbc, 0x6d, 0x56, 0x60, 0x5e, 0x3d, 0x7a, 0x6d, 0xa9, 0x5b, 0xdc, 0xdd, 0xe8, 0xc8, 0xa7, 0x29,
+	0xdb, 0x9f, 0x9c, 0x23, 0x4f, 0xe4, 0x91, 0xe7, 0x7a, 0x95, 0x97, 0x61, 0x98, 0x07, 0xcd, 0x55,
+	0x37, 0xe6, 0xb3, 0x85, 0x83, 0xf1, 0xfa, 0xe2, 0xb5, 0x27, 0xfe, 0x47, 0x09, 0x95, 0xbc, 0x10,
+	0x0f, 0x61, 0x7d, 0x2f, 0x7a, 0x58, 0x5e, 0x4a, 0x06, 0x9e, 0xe6, 0x47, 0x32, 0xd5, 0x9a, 0xa0,
+	0xd0, 0xca, 0x88, 0xbb, 0xac, 0x50, 0x5e, 0x33, 0xa6, 0x91, 0x19, 0x89, 0x69, 0x63, 0x5e, 0x05,
+	0xa0, 0xea, 0xe0, 0x2a, 0x4f, 0xac, 0xe7, 0x8a, 0x65, 0x6c, 0x0b, 0x8f, 0xbf, 0x62, 0x3c, 0xc3,
+	0x22, 0x1f, 0x35, 0x24, 0xc4, 0x83, 0x71, 0x15, 0x9d, 0x88, 0x1d, 0xff, 0xa1, 0xe2, 0xec, 0xe1,
+	0x9d, 0x08, 0x8c, 0x90, 0x41, 0x68, 0x05, 0xa8, 0x23, 0x21, 0x5e, 0x2c, 0x50, 0xfe, 0x70, 0x71,
+	0x96, 0x28, 0x92, 0x8b, 0x47, 0xf3, 0xcc, 0x09, 0x92, 0xef, 0x00, 0x38, 0x61, 0x74, 0xea, 0x7e,
+	0xb4, 0x34, 0x51, 0x8c, 0x6b, 0xc1, 0x74, 0x44, 0xbf, 0x51, 0xc3, 0xc0, 0xd6, 0xb5, 0x15, 0x25,
+	0x23, 0x29, 0x8f, 0x16, 0x5f, 0x57, 0x2d, 0xa7, 0x89, 0x94, 0xed, 0x44, 0x05, 0xa8, 0x23, 0x61,
+	0x73, 0x6c, 0x85, 0x29, 0x44, 0xca, 0x63, 0xc5, 0xe7, 0x18, 0x25, 0x22, 0x11, 0x73, 0x8c, 0x7e,
+	0xa3, 0x86, 0x81, 0x69, 0xa4, 0x42, 0x65, 0x1e, 0x14, 0x97, 0x90, 0xf5, 0xa4, 0xc8, 0x7b, 0x67,
+	0x24, 0x28, 0x1a, 0xe7, 0xdf, 0xe9, 0xa3, 0x9a, 0x90, 0x88, 0xa7, 0x56, 0x61, 0xb4, 0x23, 0x25,
+	0x34, 0x8a, 0x4c, 0xb4, 0x27, 0xba, 0x9a, 0x68, 0x57, 0x60, 0x46, 0x78, 0x2a, 0x48, 0x97, 0x21,
+	0x4e, 0x10, 0x26, 0x23, 0x0d, 0x4c, 0x35, 0x59, 0x89, 0xe9, 0xf6, 0x82, 0xe0, 0xd3, 0x3a, 0xef,
+	0x3b, 0xa5, 0x13, 0x7c, 0x51, 0x86, 0x61, 0x2d, 0xd9, 0x85, 0x09, 0x5f, 0xb3, 0xf7, 0x2e, 0x5f,
+	0xe8, 0x57, 0x9f, 0x27, 0xe0, 0x88, 0xd8, 0xb1, 0x7a, 0x09, 0xc6, 0xf0, 0x90, 0x8f, 0xe8, 0x06,
+	0xae, 0xd3, 0xfd, 0x25, 0xd8, 0x48, 0xa7, 0x8c, 0x89, 0x24, 0x80, 0xaa, 0xca, 0xd7, 0xed, 0x4e,
+	0x3b, 0x71, 0x53, 0xce, 0x99, 0x53, 0x89, 0x12, 0x73, 0xac, 0xa9, 0x27, 0xdb, 0x5a, 0xba, 0xdf,
+	0x76, 0x7d, 0x16, 0xe9, 0xa1, 0x69, 0xf9, 0x3e, 0xdf, 0x1e, 0x12, 0x6d, 0xed, 0x52, 0xb2, 0x12,
+	0xd3, 0xed, 0xc9, 0x77, 0x19, 0x30, 0xed, 0x1f, 0xf8, 0x01, 0x6d, 0xb1, 0x6b, 0xcb, 0x75, 0x28,
+	0x53, 0x29, 0x5f, 0x2c, 0x9e, 0xf3, 0xa0, 0x9a, 0x80, 0x25, 0xae, 0x9d, 0x64, 0x29, 0xa6, 0x70,
+	0xb2, 0x93, 0xa3, 0x07, 0xce, 0x28, 0x5f, 0x2a, 0x7e, 0x72, 0xf4, 0xa0, 0x1c, 0xe2, 0xe4, 0xe8,
+	0x25, 0x18, 0xc3, 0xc3, 0xfc, 0x03, 0xa4, 0x3d, 0x0e, 0xf5, 0xf8, 0x0a, 0x5e, 0x8e, 0x02, 0xf0,
+	0x56, 0xf5, 0x0a, 0x8c, 0xb7, 0x23, 0x1f, 0x87, 0x09, 0xfd, 0xee, 0x2c, 0x5f, 0x39, 0xed, 0x94,
+	0x19, 0x62, 0xe4, 0x7a, 0x55, 0x0c, 0x21, 0x41, 0xb8, 0x52, 0x8b, 0x1e, 0xe9, 0xfa, 0xf7, 0x7d,
+	0x95, 0x4f, 0x41, 0x3c, 0xa6, 0x33, 0x5b, 0x60, 0x4e, 0x4f, 0xf2, 0x83, 0xd9, 0xba, 0xeb, 0xf2,
+	0x8d, 0x81, 0xa2, 0x89, 0x7a, 0x52, 0x0a, 0xea, 0x07, 0x76, 0xb0, 0x7d, 0x9f, 0x3f, 0x8a, 0xfc,
+	0x13, 0xab, 0xb1, 0x7f, 0x93, 0xa9, 0x15, 0x94, 0xb4, 0xe6, 0x3c, 0xf4, 0x24, 0xf5, 0x98, 0x00,
+	0x6b, 0xa1, 0x2f, 0xe9, 0x52, 0x7e, 0x52, 0xbc, 0xdf, 0x30, 0x60, 0x2a, 0x6a, 0x76, 0x0e, 0x4f,
+	0xa3, 0x5a, 0xfc, 0x69, 0xf4, 0xfe, 0xfe, 0xe6, 0x95, 0xf3, 0x3e, 0xfa, 0x5f, 0x25, 0x7d, 0x56,
+	0x32, 0xff, 0x9d, 0x6e, 0x77, 0xc0, 0x50, 0xdf, 0xe9, 0xc7, 0xee, 0x40, 0x77, 0x8b, 0x8f, 0xe6,
+	0x9b, 0x61, 0x87, 0xf0, 0xff, 0xc6, 0xf8, 0xcf, 0x3e, 0xa2, 0x77, 0x84, 0xcc, 0xa6, 0x42, 0x2d,
+	0x16, 0xe0, 0x38, 0x66, 0xf4, 0x55, 0xfd, 0x7a, 0xea, 0x23, 0x8b, 0x51, 0x6c, 0xc2, 0x5d, 0x2f,
+	0x25, 0xf3, 0x3b, 0xa6, 0x61, 0x5c, 0x13, 0x6c, 0x26, 0xac, 0x28, 0x8c, 0xf3, 0xb0, 0xa2, 0x08,
+	0x60, 0xbc, 0x16, 0x26, 0x11, 0x56, 0xcb, 0xde, 0x27, 0xce, 0xf0, 0x5a, 0x8c, 0xd2, 0x13, 0xfb,
+	0xa8, 0xa3, 0x61, 0xcc, 0x5b, 0x78, 0xc6, 0x06, 0x4e, 0xc1, 0xb6, 0xa5, 0xdb, 0xb9, 0x7a, 0x07,
+	0x40, 0x14, 0x02, 0x55, 0x66, 0x9f, 0x08, 0x9d, 0x3f, 0x96, 0xfd, 0x28, 0x5e, 0x2a, 0x6a, 0xed,
+	0xd2, 0x5a, 0xf9, 0xa1, 0x73, 0xd3, 0xca, 0xb3, 0x63, 0xc0, 0x0a, 0x96, 0x3c, 0xcf, 0xf5, 0xfa,
+	0xb2, 0x1d, 0x5b, 0x51, 0x50, 0xa2, 0x63, 0x10, 0x16, 0xf9, 0xa8, 0x21, 0xc9, 0x31, 0xa6, 0x19,
+	0x29, 0x64, 0x4c, 0xd3, 0x81, 0x8b, 0x1e, 0x0d, 0xbc, 0x83, 0xca, 0x41, 0xad, 0x49, 0xc3, 0xa0,
+	0x75, 0x05, 0x2c, 0x2d, 0x78, 0xec, 0x1f, 0x4c, 0x83, 0xc2, 0x2c, 0xf8, 0x31, 0x06, 0x78, 0xac,
+	0x2b, 0x03, 0xfc, 0x4e, 0x18, 0x0f, 0x68, 0x6d, 0xdb, 0x61, 0xe6, 0xa9, 0xcb, 0x8b, 0x32, 0x26,
+	0x7f, 0xc4, 0xcb, 0x45, 0x55, 0xa8, 0xb7, 0x23, 0x0b, 0x30, 0xd0, 0xb1, 0xeb, 0xf2, 0x05, 0xf0,
+	0xb5, 0xa1, 0x8a, 0x60, 0x79, 0xf1, 0xe1, 0xe1, 0xec, 0x1b, 0x23, 0xeb, 0x94, 0x70, 0x56, 0x37,
+	0xdb, 0x3b, 0x8d, 0x9b, 0x01, 0xf3, 0x53, 0x9c, 0xdb, 0x58, 0x5e, 0x44, 0xd6, 0x39, 0xcb, 0xd0,
+	0x68, 0xe2, 0x04, 0x86, 0x46, 0x9f, 0x35, 0xe0, 0xa2, 0x95, 0xd4, 0x6e, 0x50, 0xbf, 0x3c, 0x59,
+	0x9c, 0x5a, 0x66, 0x6b, 0x4c, 0xa2, 0x64, 0x1c, 0xf3, 0x69, 0x74, 0x98, 0x35, 0x06, 0x26, 0xb7,
+	0x69, 0xd9, 0x0d, 0x71, 0x06, 0xa2, 0x5d, 0x9f, 0x2a, 0x26, 0xb7, 0x59, 0x4d, 0x41, 0xc2, 0x0c,
+	0xe8, 0x64, 0x0f, 0xc6, 0x35, 0x26, 0xa9, 0x7c, 0xa1, 0x0f, 0x9e, 0x38, 0xa1, 0x4f, 0x11, 0xaf,
+	0x5d, 0xad, 0x00, 0x75, 0x4c, 0xa1, 0x86, 0x55, 0x13, 0x33, 0x48, 0x2d, 0x23, 0x9f, 0xf5, 0x74,
+	0x71, 0x0d, 0x6b, 0x36, 0x44, 0xec, 0x82, 0x8d, 0x47, 0x75, 0x63, 0xd5, 0xda, 0xdb, 0xbc, 0x3c,
+	0x53, 0xdc, 0xbf, 0x7f, 0x25, 0x0e, 0x2a, 0x8a, 0x59, 0xaa, 0x15, 0x62, 0x12, 0x21, 0xb9, 0x05,
+	0x84, 0x0a, 0x51, 0x7a, 0xf4, 0x38, 0xf3, 0xcb, 0x84, 0x2b, 0xff, 0xf9, 0x96, 0x2e, 0xa5, 0x6a,
+	0x31, 0xa3, 0x07, 0x09, 0x62, 0xb2, 0x92, 0x3e, 0x5e, 0x39, 0xc9, 0x7c, 0x60, 0x5d, 0x25, 0x26,
+	0x1f, 0x81, 0xf1, 0x3d, 0xae, 0x83, 0x5d, 0x73, 0xdd, 0xa6, 0x5f, 0xbe, 0x54, 0x3c, 0xe0, 0xd2,
+	0x83, 0x10, 0x8c, 0x44, 0x1b, 0x12, 0x96, 0xa8, 0xc6, 0x47, 0x1d, 0x9b, 0xf9, 0xeb, 0x86, 0x94,
+	0xed, 0x9e, 0xa3, 0x71, 0xd1, 0x59, 0x6b, 0x7d, 0xcd, 0x07, 0x50, 0xae, 0xaa, 0x20, 0x87, 0xf5,
+	0x44, 0xc2, 0x8d, 0xf7, 0xf1, 0x60, 0x9b, 0x5b, 0x76, 0x63, 0xd5, 0x6a, 0xdf, 0x8b, 0x04, 0xf1,
+	0x7a, 0x78, 0xcc, 0xa8, 0x12, 0xe3, 0x6d, 0x59, 0x76, 0xea, 0xab, 0x71, 0xc8, 0xae, 0x67, 0xbf,
+	0xd6, 0x3f, 0x60, 0xf2, 0x09, 0x03, 0xc6, 0x23, 0xb5, 0xa1, 0xe2, 0x85, 0x0a, 0x39, 0x25, 0xa8,
+	0x51, 0x51, 0x4f, 0xd3, 0x23, 0xa5, 0xb3, 0xcd, 0x46, 0x95, 0x3e, 0xea, 0xa8, 0xcd, 0xff, 0xc4,
+	0xd4, 0xcd, 0xc9, 0xd7, 0xf7, 0x26, 0xf3, 0x6d, 0xf6, 0x28, 0xcb, 0xa1, 0x65, 0x14, 0xb7, 0x8b,
+	0xae, 0x08, 0x10, 0x42, 0xcb, 0x20, 0x7f, 0xa0, 0x02, 0xcc, 0x5e, 0xf8, 0x8e, 0x96, 0x95, 0x4c,
+	0x1e, 0x8f, 0x42, 0x7c, 0xb0, 0x9e, 0xdd, 0x4c, 0xbc, 0x93, 0xf5, 0x12, 0x8c, 0xe1, 0x31, 0x57,
+	0x00, 0x22, 0x19, 0x4a, 0xdf, 0xc6, 0x7a, 0x7f, 0xaf, 0x04, 0x57, 0x94, 0x02, 0x45, 0x98, 0x54,
+	0x54, 0x03, 0xcf, 0x0a, 0x68, 0xe3, 0x80, 0xec, 0xc0, 0xd0, 0x9e, 0xb5, 0x1b, 0x7a, 0x58, 0x17,
+	0x32, 0x3f, 0x8b, 0x83, 0x7e, 0x60, 0xed, 0x6a, 0xaf, 0x2b, 0xf6, 0xcb, 0x47, 0x81, 0x83, 0xd4,
+	0x61, 0xc2, 0x77, 0xad, 0x1d, 0x65, 0x49, 0xd5, 0x8b, 0x49, 0x63, 0x86, 0xfd, 0x95, 0x90, 0xab,
+	0x69, 0x70, 0x30, 0x06, 0x95, 0xc9, 0x18, 0x5a, 0xd6, 0xfe, 0x86, 0xb3, 0x4d, 0xad, 0x66, 0xb0,
+	0x7d, 0xb0, 0x46, 0xbd, 0x1a, 0x75, 0x02, 0xab, 0xa1, 0x42, 0x69, 0x71, 0x19, 0xc3, 0x6a, 0x66,
+	0x0b, 0xcc, 0xe9, 0x69, 0x7e, 0x6f, 0x09, 0x48, 0x7a, 0x9a, 0x3d, 0x28, 0xce, 0xce, 0x37, 0x8b,
+	0xf9, 0x7b, 0x60, 0x54, 0x0a, 0x5b, 0x55, 0x72, 0x87, 0xc7, 0xb8, 0x08, 0x57, 0x96, 0xa5, 0x44,
+	0xb3, 0x61, 0x6b, 0x16, 0x48, 0xa4, 0x1d, 0x2d, 0xd4, 0x20, 0x5f, 0x28, 0x7e, 0x41, 0x68, 0x8b,
+	0xa3, 0xb5, 0x30, 0xff, 0xce, 0x05, 0xb8, 0xdc, 0xaf, 0x37, 0x1e, 0xbb, 0xa8, 0xaf, 0xd0, 0x5d,
+	0xbb, 0x16, 0xcc, 0x6f, 0x05, 0xd4, 0xbb, 0x7f, 0x7f, 0x75, 0x7d, 0xdb, 0xa3, 0xfe, 0xb6, 0xdb,
+	0xac, 0x17, 0x3c, 0x1a, 0x7c, 0x6b, 0x97, 0x32, 0x21, 0x62, 0x0e, 0x26, 0x2e, 0x92, 0xdc, 0x15,
+	0xc2, 0x1a, 0xb4, 0x02, 0xba, 0xd0, 0xf1, 0xfc, 0x40, 0x9e, 0x14, 0x21, 0x92, 0x4c, 0x56, 0x62,
+	0xba, 0x7d, 0x12, 0xc8, 0x8a, 0xdd, 0xb2, 0x45, 0x4e, 0x3c, 0x23, 0x0d, 0x84, 0x57, 0x62, 0xba,
+	0xbd, 0x0e, 0x44, 0x7c, 0xfc, 0x8c, 0x71, 0x19, 0x4a, 0x03, 0x09, 0x2b, 0x31, 0xdd, 0x9e, 0xd4,
+	0xe1, 0x31, 0x8f, 0xd6, 0xdc, 0x56, 0x8b, 0x3a, 0x75, 0xbe, 0x28, 0xab, 0x96, 0xd7, 0xb0, 0x9d,
+	0x5b, 0x9e, 0x55, 0x0b, 0x73, 0xe3, 0x19, 0x3c, 0x21, 0xfa, 0x63, 0xd8, 0xa5, 0x1d, 0x76, 0x85,
+	0xc2, 0x02, 0x7c, 0x77, 0x78, 0x4c, 0x6c, 0x6f, 0xd9, 0x09, 0xa8, 0xb7, 0x6b, 0x35, 0xcb, 0x23,
+	0x85, 0x76, 0x8c, 0x33, 0x53, 0x1b, 0x71, 0x50, 0x98, 0x84, 0x4d, 0x0e, 0xe0, 0x62, 0x38, 0x1c,
+	0x0d, 0xe5, 0x68, 0x21, 0x94, 0xf2, 0x19, 0x95, 0x02, 0x87, 0x59, 0x38, 0x58, 0x34, 0xd6, 0xc0,
+	0xf2, 0x1a, 0x34, 0xa8, 0xac, 0x6d, 0xc8, 0x6f, 0xc1, 0x6e, 0x8a, 0x17, 0x95, 0x21, 0x40, 0xad,
+	0xa7, 0xab, 0x31, 0xab, 0x0f, 0xf9, 0x38, 0xbc, 0x39, 0xbe, 0xa8, 0x2b, 0xee, 0x1e, 0xf5, 0x16,
+	0xdc, 0x8e, 0x53, 0x8f, 0x03, 0x07, 0x0e, 0xfc, 0xa9, 0xa3, 0xc3, 0xd9, 0x37, 0x63, 0x2f, 0x1d,
+	0xb0, 0x37, 0xb8, 0xe9, 0x01, 0x6c, 0xb4, 0xdb, 0x99, 0x03, 0x18, 0xcf, 0x1b, 0x40, 0x4e, 0x07,
+	0xec, 0x0d, 0x2e, 0x23, 0xcd, 0x62, 0x61, 0x44, 0xfa, 0x7e, 0x0d, 0xe3, 0x04, 0xc7, 0xc8, 0xbf,
+	0xdf, 0xf5, 0xcc, 0x16, 0x98, 0xd3, 0x93, 0xb1, 0x29, 0x4f, 0xe6, 0x4d, 0x3f, 0x85, 0x66, 0x92,
+	0xa3, 0x79, 0xdb, 0xd1, 0xe1, 0xec, 0x93, 0xd8, 0x63, 0x1f, 0xec, 0x19, 0x7a, 0xc6, 0x50, 0xa2,
+	0x85, 0x48, 0x0d, 0x65, 0x2a, 0x6f, 0x28, 0xf9, 0x7d, 0xb0, 0x67, 0xe8, 0xe4, 0xbb, 0x0d, 0x78,
+	0xa4, 0xd6, 0xee, 0xdc, 0xb1, 0xfd, 0xc0, 0x6d, 0x78, 0x56, 0x6b, 0x91, 0xd6, 0xac, 0x83, 0x3b,
+	0x56, 0x73, 0x8b, 0xc5, 0x07, 0x2e, 0x5f, 0x28, 0xf4, 0xe1, 0x70, 0x6f, 0xe5, 0xca, 0xda, 0x46,
+	0x36, 0x50, 0xcc, 0xc7, 0x47, 0x7e, 0xc0, 0x80, 0xc7, 0x5a, 0x7c, 0x88, 0x39, 0x03, 0x9a, 0x2e,
+	0x34, 0x20, 0x4e, 0xc5, 0x56, 0xbb, 0xc0, 0xc5, 0xae, 0x58, 0xf9, 0x22, 0x89, 0x06, 0xf3, 0x8d,
+	0x86, 0x47, 0x1b, 0x1c, 0x6a, 0x48, 0x5d, 0x66, 0x8a, 0x2f, 0xd2, 0x6a, 0x1e, 0x50, 0xcc, 0xc7,
+	0x47, 0x5e, 0x81, 0xeb, 0xb9, 0x95, 0x15, 0x66, 0x25, 0xc6, 0x15, 0x65, 0x03, 0x0b, 0xe6, 0xd1,
+	0xe1, 0xec, 0xf5, 0xd5, 0xae, 0x2d, 0xf1, 0x18, 0x48, 0xe6, 0x67, 0x0d, 0x90, 0x2e, 0x8d, 0xcc,
+	0x6e, 0x46, 0xe3, 0x61, 0x46, 0x13, 0xfc, 0x8b, 0xca, 0xbb, 0x5d, 0xca, 0xcc, 0xbb, 0xfd, 0x16,
+	0x2d, 0x46, 0xe9, 0x58, 0xf4, 0xc2, 0x12, 0x90, 0xa3, 0x20, 0xa5, 0x2c, 0x07, 0x50, 0xf8, 0xb4,
+	0x95, 0x22, 0x47, 0x1e, 0x1e, 0x3f, 0x7a, 0x03, 0x47, 0xf5, 0x2c, 0x78, 0x2c, 0x44, 0xe9, 0xde,
+	0x7b, 0x4b, 0xf2, 0x71, 0xac, 0x3f, 0x02, 0x73, 0x3b, 0xe8, 0xf0, 0x04, 0xba, 0xd2, 0x87, 0x80,
+	0x1b, 0xa2, 0x6c, 0xf0, 0x12, 0x94, 0x35, 0x64, 0x03, 0x46, 0x5a, 0xb6, 0xc3, 0xdd, 0x3d, 0x06,
+	0x0b, 0xb9, 0x7b, 0xf0, 0x47, 0xc4, 0xaa, 0x00, 0x81, 0x0a, 0x16, 0xcb, 0x2f, 0x77, 0x21, 0x1e,
+	0x34, 0xd6, 0x67, 0x56, 0x4e, 0x32, 0x5f, 0x87, 0x0c, 0xec, 0xcd, 0xbb, 0xca, 0xb8, 0x6e, 0xa8,
+	0xea, 0xe2, 0x3a, 0xe2, 0x3e, 0x74, 0x00, 0xd9, 0xb1, 0x6b, 0x8f, 0x11, 0xc7, 0xff, 0x05, 0x02,
+	0xc3, 0xe2, 0x91, 0xce, 0x38, 0xb5, 0x8c, 0x78, 0x36, 0x77, 0x8b, 0x0b, 0x04, 0x8a, 0xc4, 0xfc,
+	0xd0, 0xf3, 0xd9, 0x96, 0xba, 0xe6, 0xb3, 0x45, 0x18, 0xa8, 0x79, 0x76, 0x3f, 0xf6, 0x40, 0x15,
+	0x5c, 0x16, 0xf6, 0x40, 0x15, 0x5c, 0x46, 0x06, 0x8c, 0x09, 0x62, 0x34, 0x43, 0x99, 0xc1, 0xe2,
+	0x82, 0x18, 0xb1, 0x00, 0x9a, 0xb9, 0xcc, 0x54, 0x57, 0x53, 0x19, 0x15, 0x88, 0x7a, 0xa8, 0xf8,
+	0x03, 0x4d, 0x2e, 0x79, 0x2f, 0x81, 0xa8, 0xd5, 0x87, 0x34, 0x9c, 0xfb, 0x21, 0x6d, 0xc1, 0x88,
+	0xfc, 0x14, 0xca, 0x23, 0xc5, 0x9f, 0xdd, 0xd2, 0xfe, 0x50, 0xcb, 0x1b, 0x26, 0x0a, 0x50, 0x01,
+	0x67, 0xef, 0x88, 0x96, 0xb5, 0xcf, 0x7c, 0xa5, 0x38, 0x9f, 0x37, 0xa4, 0x37, 0xe5, 0xc5, 0xa8,
+	0xea, 0x79, 0x53, 0xe1, 0x56, 0x55, 0x1e, 0x4b, 0x34, 0x15, 0xc5, 0xa8, 0xea, 0xc9, 0x87, 0x59,
+	0xfa, 0x96, 0xfd, 0x6a, 0xc7, 0x6b, 0xd0, 0x32, 0x1c, 0x23, 0x49, 0xea, 0x04, 0x76, 0x73, 0x8e,
+	0xe9, 0x67, 0x02, 0x6f, 0x6e, 0xd9, 0x09, 0xee, 0x7b, 0xd5, 0x80, 0x9b, 0xe1, 0x4c, 0x88, 0x1c,
+	0x2d, 0x02, 0x0a, 0x86, 0xf0, 0x48, 0x13, 0xa6, 0xf8, 0xf3, 0xd1, 0x12, 0x31, 0xc6, 0x25, 0x1f,
+	0x55, 0x04, 0x03, 0xb7, 0x93, 0x5c, 0x8d, 0xc1, 0xc2, 0x04, 0xec, 0x0c, 0x93, 0xcc, 0x89, 0xb3,
+	0x32, 0xc9, 0x9c, 0x0f, 0x1d, 0xf7, 0x85, 0x60, 0xfd, 0x91, 0xcc, 0x90, 0x5f, 0x5d, 0x9d, 0xf2,
+	0x5f, 0x0e, 0x9d, 0xf2, 0xa7, 0x8a, 0xdb, 0x10, 0x76, 0x71, 0xc8, 0xef, 0xc0, 0x78, 0xdd, 0x0a,
+	0x2c, 0x51, 0xca, 0x24, 0xdf, 0x85, 0x75, 0xc4, 0x8b, 0x21, 0x98, 0x88, 0x24, 0x45, 0x65, 0x3e,
+	0xea, 0x78, 0x98, 0xa3, 0x1a, 0xfb, 0x58, 0x9b, 0x34, 0x88, 0x9a, 0x70, 0x41, 0xdb, 0x34, 0xff,
+	0x7e, 0xb8, 0xa3, 0xda, 0xdd, 0xac, 0x06, 0x98, 0xdd, 0x2f, 0x0a, 0x4f, 0x39, 0x93, 0x1d, 0x9e,
+	0x92, 0x7c, 0x4f, 0x96, 0xf1, 0x0b, 0xb9, 0x61, 0x14, 0xbd, 0x19, 0x04, 0x6d, 0x28, 0x6c, 0x02,
+	0xf3, 0xb7, 0x0d, 0x28, 0xcb, 0x53, 0x26, 0x0d, 0x56, 0x9a, 0xd4, 0x5b, 0xb5, 0x1c, 0xab, 0x41,
+	0xbd, 0xf2, 0xc5, 0xe2, 0xb1, 0x56, 0x56, 0x73, 0x60, 0x86, 0xd1, 0x12, 0xde, 0x74, 0x74, 0x38,
+	0x7b, 0xe3, 0xb8, 0x56, 0x98, 0x3b, 0x36, 0x9e, 0x55, 0xe8, 0xc0, 0xaf, 0x05, 0xa1, 0x74, 0xfb,
+	0x76, 0x1f, 0x94, 0xb5, 0x2a, 0x20, 0x09, 0xd2, 0x1a, 0x65, 0x15, 0x12, 0xa5, 0xa8, 0x10, 0xb1,
+	0x28, 0x0b, 0x33, 0x52, 0x85, 0xa5, 0x45, 0xa4, 0xb9, 0x5c, 0xdc, 0x55, 0xa6, 0x92, 0x04, 0xa6,
+	0x8c, 0x54, 0xb8, 0xbc, 0x20, 0x55, 0x8b, 0x69, 0xec, 0xec, 0x52, 0x6d, 0x7b, 0xb6, 0xeb, 0x31,
+	0xd5, 0xdb, 0x15, 0x4e, 0x3c, 0x65, 0xfc, 0x62, 0x51, 0x86, 0x61, 0x2d, 0xa9, 0xc2, 0x94, 0x78,
+	0x97, 0x2b, 0xe1, 0xa1, 0xb4, 0xd9, 0x79, 0x2b, 0xcf, 0xde, 0x1d, 0xab, 0x79, 0x78, 0x38, 0x7b,
+	0x59, 0xa5, 0xb6, 0x8a, 0x55, 0x60, 0x02, 0x44, 0xbf, 0x11, 0xab, 0xfa, 0x48, 0x52, 0x70, 0xed,
+	0x59, 0x98, 0xd0, 0xf7, 0xed, 0x24, 0x7d, 0xcd, 0x1f, 0x35, 0x60, 0x3a, 0x79, 0x8f, 0x93, 0x6d,
+	0x18, 0x91, 0x1f, 0x75, 0xd9, 0x28, 0xae, 0x1d, 0x97, 0xe4, 0x42, 0xc6, 0xd3, 0xe4, 0x6c, 0xa1,
+	0x2c, 0x42, 0x05, 0x5e, 0xb7, 0x91, 0x2f, 0x75, 0xb1, 0x91, 0xff, 0x90, 0x1a, 0x64, 0xa4, 0x7e,
+	0xe9, 0x41, 0x64, 0xf9, 0x84, 0x0a, 0xa0, 0x5c, 0xe2, 0xc7, 0x21, 0x64, 0xb8, 0xf5, 0x20, 0xca,
+	0xe6, 0x73, 0x70, 0x25, 0x9b, 0x72, 0xb0, 0xee, 0x2c, 0xf2, 0xc0, 0x9e, 0x94, 0xfe, 0x85, 0xdd,
+	0x99, 0xaf, 0xfb, 0x1e, 0x8a, 0x3a, 0xf3, 0x63, 0x90, 0xcc, 0x8b, 0x44, 0x5e, 0x81, 0x31, 0xdf,
+	0xdf, 0x16, 0x46, 0x55, 0x72, 0xfd, 0x8a, 0xa9, 0x61, 0x54, 0x36, 0x04, 0xf1, 0xc4, 0x08, 0x7f,
+	0x62, 0x04, 0x7e, 0xe1, 0xa5, 0x2f, 0x7e, 0xf9, 0xfa, 0x1b, 0x7e, 0xed, 0xcb, 0xd7, 0xdf, 0xf0,
+	0xa5, 0x2f, 0x5f, 0x7f, 0xc3, 0xb7, 0x1e, 0x5d, 0x37, 0xbe, 0x78, 0x74, 0xdd, 0xf8, 0xb5, 0xa3,
+	0xeb, 0xc6, 0x97, 0x8e, 0xae, 0x1b, 0xff, 0xea, 0xe8, 0xba, 0xf1, 0x7d, 0xff, 0xfa, 0xfa, 0x1b,
+	0x3e, 0xfc, 0x4c, 0x84, 0xfd, 0xa6, 0x42, 0x1a, 0xfd, 0xc3, 0xb4, 0xd9, 0x0c, 0xbb, 0x8a, 0xbe,
+	0xc0, 0xb1, 0xff, 0x9f, 0x01, 0x00, 0xd3, 0x14, 0x44, 0xa9, 0x8d, 0x1e, 0x01, 0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -7725,6 +8187,18 @@ func (m *CloudProfileSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VersionRolloutStrategy != nil {
+		{
+			size, err := m.VersionRolloutStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Bastion != nil {
 		{
 			size, err := m.Bastion.MarshalToSizedBuffer(dAtA[:i])
@@ -9874,6 +10348,16 @@ func (m *Hibernation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WakeUpOnDemand != nil {
+		i--
+		if *m.WakeUpOnDemand {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Schedules) > 0 {
 		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -9921,6 +10405,15 @@ func (m *HibernationSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Exceptions) > 0 {
+		for iNdEx := len(m.Exceptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Exceptions[iNdEx])
+			copy(dAtA[i:], m.Exceptions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Exceptions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Location != nil {
 		i -= len(*m.Location)
 		copy(dAtA[i:], *m.Location)
@@ -11934,6 +12427,18 @@ func (m *MachineType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CostUnits != nil {
+		{
+			size, err := m.CostUnits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Architecture != nil {
 		i -= len(*m.Architecture)
 		copy(dAtA[i:], *m.Architecture)
@@ -12156,105 +12661,7 @@ func (m *MaintenanceAutoUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MaintenanceTimeWindow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MaintenanceTimeWindow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MaintenanceTimeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.End)
-	copy(dAtA[i:], m.End)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.End)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Begin)
-	copy(dAtA[i:], m.Begin)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Begin)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MemorySwapConfiguration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MemorySwapConfiguration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MemorySwapConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SwapBehavior != nil {
-		i -= len(*m.SwapBehavior)
-		copy(dAtA[i:], *m.SwapBehavior)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.SwapBehavior)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Monitoring) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Monitoring) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Monitoring) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Alerting != nil {
-		{
-			size, err := m.Alerting.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *NamedResourceReference) Marshal() (dAtA []byte, err error) {
+func (m *MaintenanceFreeze) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -12264,64 +12671,363 @@ func (m *NamedResourceReference) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NamedResourceReference) MarshalTo(dAtA []byte) (int, error) {
+func (m *MaintenanceFreeze) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NamedResourceReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MaintenanceFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.ResourceRef.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *NamespacedCloudProfile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *NamespacedCloudProfile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NamespacedCloudProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceFreezeList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceFreezeList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceFreezeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceFreezeSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceFreezeSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceFreezeSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProjectSelector != nil {
+		{
+			size, err := m.ProjectSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShootSelector != nil {
+		{
+			size, err := m.ShootSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceFreezeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceFreezeWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceFreezeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != nil {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Begin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceTimeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceTimeWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceTimeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.End)
+	copy(dAtA[i:], m.End)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.End)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Begin)
+	copy(dAtA[i:], m.Begin)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Begin)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MemorySwapConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemorySwapConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemorySwapConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapBehavior != nil {
+		i -= len(*m.SwapBehavior)
+		copy(dAtA[i:], *m.SwapBehavior)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.SwapBehavior)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Monitoring) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Monitoring) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Monitoring) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Alerting != nil {
+		{
+			size, err := m.Alerting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamedResourceReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamedResourceReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamedResourceReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ResourceRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespacedCloudProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespacedCloudProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespacedCloudProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -12819,6 +13525,30 @@ func (m *OCIRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Verification != nil {
+		{
+			size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PullSecretRef != nil {
+		{
+			size, err := m.PullSecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Digest != nil {
 		i -= len(*m.Digest)
 		copy(dAtA[i:], *m.Digest)
@@ -12850,6 +13580,39 @@ func (m *OCIRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OCIVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PublicKeysSecretRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *OIDCConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13161,6 +13924,44 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectControlPlaneUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectControlPlaneUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectControlPlaneUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Hours.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.HighAvailability)
+	copy(dAtA[i:], m.HighAvailability)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HighAvailability)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13208,6 +14009,56 @@ func (m *ProjectList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectMachineUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectMachineUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectMachineUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CostUnits != nil {
+		{
+			size, err := m.CostUnits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Hours.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13255,6 +14106,49 @@ func (m *ProjectMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectShootUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectShootUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectShootUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HibernatedHours.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AwakeHours.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13383,6 +14277,30 @@ func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastPeriodUsage != nil {
+		{
+			size, err := m.LastPeriodUsage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.LastActivityTimestamp != nil {
 		{
 			size, err := m.LastActivityTimestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -13430,6 +14348,44 @@ func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectStorageUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectStorageUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectStorageUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GibibyteHours.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Class)
+	copy(dAtA[i:], m.Class)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Class)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectTolerations) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13481,6 +14437,113 @@ func (m *ProjectTolerations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CostUnits != nil {
+		{
+			size, err := m.CostUnits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ControlPlanes) > 0 {
+		for iNdEx := len(m.ControlPlanes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ControlPlanes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Shoots.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Machines) > 0 {
+		for iNdEx := len(m.Machines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Machines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.LastUpdateTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PeriodStartTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Provider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13579,6 +14642,16 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -13716,6 +14789,58 @@ func (m *QuotaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[k8s_io_api_core_v1.ResourceName(keysForUsed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Region) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16528,6 +17653,22 @@ func (m *ShootStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WorkerPools) > 0 {
+		for iNdEx := len(m.WorkerPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorkerPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
 	if m.Networking != nil {
 		{
 			size, err := m.Networking.MarshalToSizedBuffer(dAtA[:i])
@@ -16928,6 +18069,114 @@ func (m *Toleration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VersionRolloutStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionRolloutStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionRolloutStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUnhealthyPercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxUnhealthyPercentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SoakDuration != nil {
+		{
+			size, err := m.SoakDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Waves) > 0 {
+		for iNdEx := len(m.Waves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Waves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionRolloutWave) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionRolloutWave) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionRolloutWave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Percentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Percentage))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Purposes) > 0 {
+		for iNdEx := len(m.Purposes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Purposes[iNdEx])
+			copy(dAtA[i:], m.Purposes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Purposes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ShootSelector != nil {
+		{
+			size, err := m.ShootSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *VerticalPodAutoscaler) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -17270,6 +18519,15 @@ func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpdateStrategy != nil {
+		i -= len(*m.UpdateStrategy)
+		copy(dAtA[i:], *m.UpdateStrategy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.UpdateStrategy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.Priority != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
 		i--
@@ -17586,6 +18844,37 @@ func (m *WorkerKubernetes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkerPoolStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerPoolStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerPoolStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Nodes))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkerSystemComponents) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -18200,6 +19489,10 @@ func (m *CloudProfileSpec) Size() (n int) {
 		l = m.Bastion.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.VersionRolloutStrategy != nil {
+		l = m.VersionRolloutStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18986,6 +20279,9 @@ func (m *Hibernation) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.WakeUpOnDemand != nil {
+		n += 2
+	}
 	return n
 }
 
@@ -19007,6 +20303,12 @@ func (m *HibernationSchedule) Size() (n int) {
 		l = len(*m.Location)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Exceptions) > 0 {
+		for _, s := range m.Exceptions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19762,6 +21064,10 @@ func (m *MachineType) Size() (n int) {
 		l = len(*m.Architecture)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.CostUnits != nil {
+		l = m.CostUnits.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19819,6 +21125,76 @@ func (m *MaintenanceAutoUpdate) Size() (n int) {
 	return n
 }
 
+func (m *MaintenanceFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MaintenanceFreezeList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MaintenanceFreezeSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ShootSelector != nil {
+		l = m.ShootSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ProjectSelector != nil {
+		l = m.ProjectSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MaintenanceFreezeWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Begin.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.End.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *MaintenanceTimeWindow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20094,6 +21470,25 @@ func (m *OCIRepository) Size() (n int) {
 		l = len(*m.Digest)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.PullSecretRef != nil {
+		l = m.PullSecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Verification != nil {
+		l = m.Verification.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *OCIVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PublicKeysSecretRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20220,6 +21615,19 @@ func (m *Project) Size() (n int) {
 	return n
 }
 
+func (m *ProjectControlPlaneUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HighAvailability)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Hours.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ProjectList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20237,6 +21645,23 @@ func (m *ProjectList) Size() (n int) {
 	return n
 }
 
+func (m *ProjectMachineUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Hours.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CostUnits != nil {
+		l = m.CostUnits.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ProjectMember) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20256,6 +21681,19 @@ func (m *ProjectMember) Size() (n int) {
 	return n
 }
 
+func (m *ProjectShootUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AwakeHours.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.HibernatedHours.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ProjectSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20322,6 +21760,27 @@ func (m *ProjectStatus) Size() (n int) {
 		l = m.LastActivityTimestamp.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LastPeriodUsage != nil {
+		l = m.LastPeriodUsage.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ProjectStorageUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Class)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.GibibyteHours.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20346,6 +21805,43 @@ func (m *ProjectTolerations) Size() (n int) {
 	return n
 }
 
+func (m *ProjectUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PeriodStartTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastUpdateTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Machines) > 0 {
+		for _, e := range m.Machines {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Shoots.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ControlPlanes) > 0 {
+		for _, e := range m.ControlPlanes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.CostUnits != nil {
+		l = m.CostUnits.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Provider) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20385,6 +21881,8 @@ func (m *Quota) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20428,6 +21926,24 @@ func (m *QuotaSpec) Size() (n int) {
 	return n
 }
 
+func (m *QuotaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *Region) Size() (n int) {
 	if m == nil {
 		return 0
@@ -21530,6 +23046,12 @@ func (m *ShootStatus) Size() (n int) {
 		l = m.Networking.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if len(m.WorkerPools) > 0 {
+		for _, e := range m.WorkerPools {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -21606,6 +23128,52 @@ func (m *Toleration) Size() (n int) {
 	return n
 }
 
+func (m *VersionRolloutStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Waves) > 0 {
+		for _, e := range m.Waves {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.SoakDuration != nil {
+		l = m.SoakDuration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxUnhealthyPercentage != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxUnhealthyPercentage))
+	}
+	return n
+}
+
+func (m *VersionRolloutWave) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ShootSelector != nil {
+		l = m.ShootSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Purposes) > 0 {
+		for _, s := range m.Purposes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Percentage != nil {
+		n += 1 + sovGenerated(uint64(*m.Percentage))
+	}
+	return n
+}
+
 func (m *VerticalPodAutoscaler) Size() (n int) {
 	if m == nil {
 		return 0
@@ -21834,6 +23402,10 @@ func (m *Worker) Size() (n int) {
 	if m.Priority != nil {
 		n += 2 + sovGenerated(uint64(*m.Priority))
 	}
+	if m.UpdateStrategy != nil {
+		l = len(*m.UpdateStrategy)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -21854,6 +23426,18 @@ func (m *WorkerKubernetes) Size() (n int) {
 	return n
 }
 
+func (m *WorkerPoolStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Nodes))
+	return n
+}
+
 func (m *WorkerSystemComponents) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22283,6 +23867,7 @@ func (this *CloudProfileSpec) String() string {
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`VolumeTypes:` + repeatedStringForVolumeTypes + `,`,
 		`Bastion:` + strings.Replace(this.Bastion.String(), "Bastion", "Bastion", 1) + `,`,
+		`VersionRolloutStrategy:` + strings.Replace(this.VersionRolloutStrategy.String(), "VersionRolloutStrategy", "VersionRolloutStrategy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22822,6 +24407,7 @@ func (this *Hibernation) String() string {
 	s := strings.Join([]string{`&Hibernation{`,
 		`Enabled:` + valueToStringGenerated(this.Enabled) + `,`,
 		`Schedules:` + repeatedStringForSchedules + `,`,
+		`WakeUpOnDemand:` + valueToStringGenerated(this.WakeUpOnDemand) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22834,6 +24420,7 @@ func (this *HibernationSchedule) String() string {
 		`Start:` + valueToStringGenerated(this.Start) + `,`,
 		`End:` + valueToStringGenerated(this.End) + `,`,
 		`Location:` + valueToStringGenerated(this.Location) + `,`,
+		`Exceptions:` + fmt.Sprintf("%v", this.Exceptions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23289,6 +24876,7 @@ func (this *MachineType) String() string {
 		`Storage:` + strings.Replace(this.Storage.String(), "MachineTypeStorage", "MachineTypeStorage", 1) + `,`,
 		`Usable:` + valueToStringGenerated(this.Usable) + `,`,
 		`Architecture:` + valueToStringGenerated(this.Architecture) + `,`,
+		`CostUnits:` + strings.Replace(fmt.Sprintf("%v", this.CostUnits), "Quantity", "resource.Quantity", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23329,6 +24917,62 @@ func (this *MaintenanceAutoUpdate) String() string {
 	}, "")
 	return s
 }
+func (this *MaintenanceFreeze) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceFreeze{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MaintenanceFreezeSpec", "MaintenanceFreezeSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MaintenanceFreezeList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]MaintenanceFreeze{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MaintenanceFreeze", "MaintenanceFreeze", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MaintenanceFreezeList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MaintenanceFreezeSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWindows := "[]MaintenanceFreezeWindow{"
+	for _, f := range this.Windows {
+		repeatedStringForWindows += strings.Replace(strings.Replace(f.String(), "MaintenanceFreezeWindow", "MaintenanceFreezeWindow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWindows += "}"
+	s := strings.Join([]string{`&MaintenanceFreezeSpec{`,
+		`Windows:` + repeatedStringForWindows + `,`,
+		`ShootSelector:` + strings.Replace(fmt.Sprintf("%v", this.ShootSelector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
+		`ProjectSelector:` + strings.Replace(fmt.Sprintf("%v", this.ProjectSelector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MaintenanceFreezeWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceFreezeWindow{`,
+		`Begin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Begin), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`End:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.End), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + valueToStringGenerated(this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MaintenanceTimeWindow) String() string {
 	if this == nil {
 		return "nil"
@@ -23514,6 +25158,18 @@ func (this *OCIRepository) String() string {
 		`Repository:` + valueToStringGenerated(this.Repository) + `,`,
 		`Tag:` + valueToStringGenerated(this.Tag) + `,`,
 		`Digest:` + valueToStringGenerated(this.Digest) + `,`,
+		`PullSecretRef:` + strings.Replace(fmt.Sprintf("%v", this.PullSecretRef), "LocalObjectReference", "v1.LocalObjectReference", 1) + `,`,
+		`Verification:` + strings.Replace(this.Verification.String(), "OCIVerification", "OCIVerification", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCIVerification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OCIVerification{`,
+		`PublicKeysSecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.PublicKeysSecretRef), "LocalObjectReference", "v1.LocalObjectReference", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23602,6 +25258,17 @@ func (this *Project) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectControlPlaneUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectControlPlaneUsage{`,
+		`HighAvailability:` + fmt.Sprintf("%v", this.HighAvailability) + `,`,
+		`Hours:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Hours), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectList) String() string {
 	if this == nil {
 		return "nil"
@@ -23618,6 +25285,18 @@ func (this *ProjectList) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectMachineUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectMachineUsage{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Hours:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Hours), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`CostUnits:` + strings.Replace(fmt.Sprintf("%v", this.CostUnits), "Quantity", "resource.Quantity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectMember) String() string {
 	if this == nil {
 		return "nil"
@@ -23630,6 +25309,17 @@ func (this *ProjectMember) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectShootUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectShootUsage{`,
+		`AwakeHours:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AwakeHours), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`HibernatedHours:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.HibernatedHours), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -23667,6 +25357,19 @@ func (this *ProjectStatus) String() string {
 		`StaleSinceTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleSinceTimestamp), "Time", "v11.Time", 1) + `,`,
 		`StaleAutoDeleteTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleAutoDeleteTimestamp), "Time", "v11.Time", 1) + `,`,
 		`LastActivityTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastActivityTimestamp), "Time", "v11.Time", 1) + `,`,
+		`Usage:` + strings.Replace(this.Usage.String(), "ProjectUsage", "ProjectUsage", 1) + `,`,
+		`LastPeriodUsage:` + strings.Replace(this.LastPeriodUsage.String(), "ProjectUsage", "ProjectUsage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectStorageUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectStorageUsage{`,
+		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
+		`GibibyteHours:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GibibyteHours), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23692,6 +25395,37 @@ func (this *ProjectTolerations) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMachines := "[]ProjectMachineUsage{"
+	for _, f := range this.Machines {
+		repeatedStringForMachines += strings.Replace(strings.Replace(f.String(), "ProjectMachineUsage", "ProjectMachineUsage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMachines += "}"
+	repeatedStringForControlPlanes := "[]ProjectControlPlaneUsage{"
+	for _, f := range this.ControlPlanes {
+		repeatedStringForControlPlanes += strings.Replace(strings.Replace(f.String(), "ProjectControlPlaneUsage", "ProjectControlPlaneUsage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForControlPlanes += "}"
+	repeatedStringForStorage := "[]ProjectStorageUsage{"
+	for _, f := range this.Storage {
+		repeatedStringForStorage += strings.Replace(strings.Replace(f.String(), "ProjectStorageUsage", "ProjectStorageUsage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForStorage += "}"
+	s := strings.Join([]string{`&ProjectUsage{`,
+		`PeriodStartTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.PeriodStartTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Machines:` + repeatedStringForMachines + `,`,
+		`Shoots:` + strings.Replace(strings.Replace(this.Shoots.String(), "ProjectShootUsage", "ProjectShootUsage", 1), `&`, ``, 1) + `,`,
+		`ControlPlanes:` + repeatedStringForControlPlanes + `,`,
+		`Storage:` + repeatedStringForStorage + `,`,
+		`CostUnits:` + strings.Replace(fmt.Sprintf("%v", this.CostUnits), "Quantity", "resource.Quantity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Provider) String() string {
 	if this == nil {
 		return "nil"
@@ -23718,6 +25452,7 @@ func (this *Quota) String() string {
 	s := strings.Join([]string{`&Quota{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "QuotaSpec", "QuotaSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "QuotaStatus", "QuotaStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23760,6 +25495,26 @@ func (this *QuotaSpec) String() string {
 	}, "")
 	return s
 }
+func (this *QuotaStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&QuotaStatus{`,
+		`Used:` + mapStringForUsed + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Region) String() string {
 	if this == nil {
 		return "nil"
@@ -24544,6 +26299,11 @@ func (this *ShootStatus) String() string {
 		repeatedStringForAdvertisedAddresses += strings.Replace(strings.Replace(f.String(), "ShootAdvertisedAddress", "ShootAdvertisedAddress", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForAdvertisedAddresses += "}"
+	repeatedStringForWorkerPools := "[]WorkerPoolStatus{"
+	for _, f := range this.WorkerPools {
+		repeatedStringForWorkerPools += strings.Replace(strings.Replace(f.String(), "WorkerPoolStatus", "WorkerPoolStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWorkerPools += "}"
 	s := strings.Join([]string{`&ShootStatus{`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`Constraints:` + repeatedStringForConstraints + `,`,
@@ -24564,6 +26324,7 @@ func (this *ShootStatus) String() string {
 		`LastMaintenance:` + strings.Replace(this.LastMaintenance.String(), "LastMaintenance", "LastMaintenance", 1) + `,`,
 		`EncryptedResources:` + fmt.Sprintf("%v", this.EncryptedResources) + `,`,
 		`Networking:` + strings.Replace(this.Networking.String(), "NetworkingStatus", "NetworkingStatus", 1) + `,`,
+		`WorkerPools:` + repeatedStringForWorkerPools + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24627,6 +26388,36 @@ func (this *Toleration) String() string {
 	}, "")
 	return s
 }
+func (this *VersionRolloutStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWaves := "[]VersionRolloutWave{"
+	for _, f := range this.Waves {
+		repeatedStringForWaves += strings.Replace(strings.Replace(f.String(), "VersionRolloutWave", "VersionRolloutWave", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWaves += "}"
+	s := strings.Join([]string{`&VersionRolloutStrategy{`,
+		`Waves:` + repeatedStringForWaves + `,`,
+		`SoakDuration:` + strings.Replace(fmt.Sprintf("%v", this.SoakDuration), "Duration", "v11.Duration", 1) + `,`,
+		`MaxUnhealthyPercentage:` + valueToStringGenerated(this.MaxUnhealthyPercentage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersionRolloutWave) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersionRolloutWave{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ShootSelector:` + strings.Replace(fmt.Sprintf("%v", this.ShootSelector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
+		`Purposes:` + fmt.Sprintf("%v", this.Purposes) + `,`,
+		`Percentage:` + valueToStringGenerated(this.Percentage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *VerticalPodAutoscaler) String() string {
 	if this == nil {
 		return "nil"
@@ -24763,6 +26554,7 @@ func (this *Worker) String() string {
 		`Sysctls:` + mapStringForSysctls + `,`,
 		`ClusterAutoscaler:` + strings.Replace(this.ClusterAutoscaler.String(), "ClusterAutoscalerOptions", "ClusterAutoscalerOptions", 1) + `,`,
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
+		`UpdateStrategy:` + valueToStringGenerated(this.UpdateStrategy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24778,6 +26570,17 @@ func (this *WorkerKubernetes) String() string {
 	}, "")
 	return s
 }
+func (this *WorkerPoolStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkerPoolStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Nodes:` + fmt.Sprintf("%v", this.Nodes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WorkerSystemComponents) String() string {
 	if this == nil {
 		return "nil"
@@ -28938,6 +30741,42 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionRolloutStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionRolloutStrategy == nil {
+				m.VersionRolloutStrategy = &VersionRolloutStrategy{}
+			}
+			if err := m.VersionRolloutStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34910,6 +36749,27 @@ func (m *Hibernation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WakeUpOnDemand", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.WakeUpOnDemand = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35059,6 +36919,38 @@ func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Location = &s
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exceptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exceptions = append(m.Exceptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -41195,6 +43087,42 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Architecture = &s
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CostUnits == nil {
+				m.CostUnits = &resource.Quantity{}
+			}
+			if err := m.CostUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -41636,7 +43564,7 @@ func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceFreeze) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41659,17 +43587,17 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceTimeWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceFreeze: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41679,29 +43607,30 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Begin = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41711,23 +43640,24 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.End = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41750,7 +43680,7 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceFreezeList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41773,17 +43703,17 @@ func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemorySwapConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceFreezeList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemorySwapConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceFreezeList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapBehavior", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41793,78 +43723,28 @@ func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := SwapBehavior(dAtA[iNdEx:postIndex])
-			m.SwapBehavior = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Monitoring) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Monitoring: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Monitoring: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alerting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41891,10 +43771,8 @@ func (m *Monitoring) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Alerting == nil {
-				m.Alerting = &Alerting{}
-			}
-			if err := m.Alerting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, MaintenanceFreeze{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -41919,7 +43797,7 @@ func (m *Monitoring) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceFreezeSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41942,17 +43820,17 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamedResourceReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceFreezeSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamedResourceReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceFreezeSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41962,27 +43840,29 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Windows = append(m.Windows, MaintenanceFreezeWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42009,63 +43889,16 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.ShootSelector == nil {
+				m.ShootSelector = &v11.LabelSelector{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ShootSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NamespacedCloudProfile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespacedCloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42092,13 +43925,66 @@ func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProjectSelector == nil {
+				m.ProjectSelector = &v11.LabelSelector{}
+			}
+			if err := m.ProjectSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceFreezeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceFreezeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceFreezeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42125,13 +44011,13 @@ func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Begin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42158,10 +44044,43 @@ func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -42183,7 +44102,7 @@ func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42206,17 +44125,17 @@ func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespacedCloudProfileList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceTimeWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespacedCloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42226,30 +44145,29 @@ func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Begin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42259,25 +44177,23 @@ func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, NamespacedCloudProfile{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.End = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42300,7 +44216,7 @@ func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
+func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42323,15 +44239,15 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespacedCloudProfileSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemorySwapConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespacedCloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemorySwapConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapBehavior", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42359,12 +44275,62 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.CABundle = &s
+			s := SwapBehavior(dAtA[iNdEx:postIndex])
+			m.SwapBehavior = &s
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Monitoring) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Monitoring: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Monitoring: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alerting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42391,18 +44357,68 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kubernetes == nil {
-				m.Kubernetes = &KubernetesSettings{}
+			if m.Alerting == nil {
+				m.Alerting = &Alerting{}
 			}
-			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Alerting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamedResourceReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamedResourceReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineImages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42412,29 +44428,27 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MachineImages = append(m.MachineImages, MachineImage{})
-			if err := m.MachineImages[len(m.MachineImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42461,14 +44475,63 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MachineTypes = append(m.MachineTypes, MachineType{})
-			if err := m.MachineTypes[len(m.MachineTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespacedCloudProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespacedCloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42495,14 +44558,13 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeTypes = append(m.VolumeTypes, VolumeType{})
-			if err := m.VolumeTypes[len(m.VolumeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42529,13 +44591,13 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42562,10 +44624,7 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
-			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -42590,7 +44649,7 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespacedCloudProfileStatus) Unmarshal(dAtA []byte) error {
+func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42613,15 +44672,15 @@ func (m *NamespacedCloudProfileStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespacedCloudProfileStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespacedCloudProfileList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespacedCloudProfileStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespacedCloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloudProfileSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42648,15 +44707,15 @@ func (m *NamespacedCloudProfileStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CloudProfileSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			m.ObservedGeneration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42666,11 +44725,26 @@ func (m *NamespacedCloudProfileStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, NamespacedCloudProfile{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -42692,7 +44766,7 @@ func (m *NamespacedCloudProfileStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Networking) Unmarshal(dAtA []byte) error {
+func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42715,15 +44789,15 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Networking: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespacedCloudProfileSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Networking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespacedCloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42752,11 +44826,11 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Type = &s
+			m.CABundle = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42783,18 +44857,18 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
+			if m.Kubernetes == nil {
+				m.Kubernetes = &KubernetesSettings{}
 			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42804,30 +44878,31 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Pods = &s
+			m.MachineImages = append(m.MachineImages, MachineImage{})
+			if err := m.MachineImages[len(m.MachineImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineTypes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42837,30 +44912,31 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Nodes = &s
+			m.MachineTypes = append(m.MachineTypes, MachineType{})
+			if err := m.MachineTypes[len(m.MachineTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTypes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42870,30 +44946,31 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Services = &s
+			m.VolumeTypes = append(m.VolumeTypes, VolumeType{})
+			if err := m.VolumeTypes[len(m.VolumeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPFamilies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42903,23 +44980,60 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IPFamilies = append(m.IPFamilies, IPFamily(dAtA[iNdEx:postIndex]))
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42942,7 +45056,7 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkingStatus) Unmarshal(dAtA []byte) error {
+func (m *NamespacedCloudProfileStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42965,15 +45079,367 @@ func (m *NetworkingStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkingStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespacedCloudProfileStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespacedCloudProfileStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudProfileSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CloudProfileSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Networking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Networking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Networking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Type = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pods = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Nodes = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Services = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPFamilies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IPFamilies = append(m.IPFamilies, IPFamily(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkingStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkingStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43689,6 +46155,161 @@ func (m *OCIRepository) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Digest = &s
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullSecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullSecretRef == nil {
+				m.PullSecretRef = &v1.LocalObjectReference{}
+			}
+			if err := m.PullSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Verification == nil {
+				m.Verification = &OCIVerification{}
+			}
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCIVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCIVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCIVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeysSecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PublicKeysSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44785,7 +47406,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectList) Unmarshal(dAtA []byte) error {
+func (m *ProjectControlPlaneUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44808,17 +47429,17 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectControlPlaneUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectControlPlaneUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighAvailability", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44828,28 +47449,27 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HighAvailability = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hours", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44876,8 +47496,7 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Project{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Hours.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -44902,7 +47521,7 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectMember) Unmarshal(dAtA []byte) error {
+func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44925,15 +47544,15 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44960,15 +47579,15 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44978,55 +47597,25 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, Project{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -45049,7 +47638,7 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
+func (m *ProjectMachineUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45072,51 +47661,465 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectMachineUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectMachineUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CreatedBy == nil {
-				m.CreatedBy = &v14.Subject{}
-			}
-			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Hours.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CostUnits == nil {
+				m.CostUnits = &resource.Quantity{}
+			}
+			if err := m.CostUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectShootUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectShootUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectShootUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwakeHours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AwakeHours.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HibernatedHours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HibernatedHours.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedBy == nil {
+				m.CreatedBy = &v14.Subject{}
+			}
+			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45555,66 +48558,538 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastActivityTimestamp == nil {
-				m.LastActivityTimestamp = &v11.Time{}
-			}
-			if err := m.LastActivityTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastActivityTimestamp == nil {
+				m.LastActivityTimestamp = &v11.Time{}
+			}
+			if err := m.LastActivityTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &ProjectUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPeriodUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastPeriodUsage == nil {
+				m.LastPeriodUsage = &ProjectUsage{}
+			}
+			if err := m.LastPeriodUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectStorageUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectStorageUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectStorageUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Class = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GibibyteHours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GibibyteHours.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectTolerations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectTolerations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Defaults = append(m.Defaults, Toleration{})
+			if err := m.Defaults[len(m.Defaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, Toleration{})
+			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Machines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Machines = append(m.Machines, ProjectMachineUsage{})
+			if err := m.Machines[len(m.Machines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shoots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shoots.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlanes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControlPlanes = append(m.ControlPlanes, ProjectControlPlaneUsage{})
+			if err := m.ControlPlanes[len(m.ControlPlanes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectTolerations: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectTolerations: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45641,14 +49116,14 @@ func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Defaults = append(m.Defaults, Toleration{})
-			if err := m.Defaults[len(m.Defaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Storage = append(m.Storage, ProjectStorageUsage{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CostUnits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45675,8 +49150,10 @@ func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, Toleration{})
-			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CostUnits == nil {
+				m.CostUnits = &resource.Quantity{}
+			}
+			if err := m.CostUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -46020,6 +49497,39 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -46390,6 +49900,185 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuotaStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Used == nil {
+				m.Used = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Used[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Region) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -55255,6 +58944,40 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerPools = append(m.WorkerPools, WorkerPoolStatus{})
+			if err := m.WorkerPools[len(m.WorkerPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55497,17 +59220,370 @@ func (m *StructuredAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StructuredAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: StructuredAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StructuredAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubeconfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kubeconfigs = append(m.Kubeconfigs, AuthorizerKubeconfigReference{})
+			if err := m.Kubeconfigs[len(m.Kubeconfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SystemComponents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SystemComponents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SystemComponents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreDNS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CoreDNS == nil {
+				m.CoreDNS = &CoreDNS{}
+			}
+			if err := m.CoreDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeLocalDNS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeLocalDNS == nil {
+				m.NodeLocalDNS = &NodeLocalDNS{}
+			}
+			if err := m.NodeLocalDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Toleration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Toleration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Toleration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Value = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionRolloutStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionRolloutStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StructuredAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionRolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waves", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55517,27 +59593,29 @@ func (m *StructuredAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.Waves = append(m.Waves, VersionRolloutWave{})
+			if err := m.Waves[len(m.Waves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kubeconfigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SoakDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55564,11 +59642,33 @@ func (m *StructuredAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kubeconfigs = append(m.Kubeconfigs, AuthorizerKubeconfigReference{})
-			if err := m.Kubeconfigs[len(m.Kubeconfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SoakDuration == nil {
+				m.SoakDuration = &v11.Duration{}
+			}
+			if err := m.SoakDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnhealthyPercentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxUnhealthyPercentage = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55590,7 +59690,7 @@ func (m *StructuredAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SystemComponents) Unmarshal(dAtA []byte) error {
+func (m *VersionRolloutWave) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55613,17 +59713,17 @@ func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SystemComponents: wiretype end group for non-group")
+			return fmt.Errorf("proto: VersionRolloutWave: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SystemComponents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionRolloutWave: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoreDNS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55633,31 +59733,27 @@ func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CoreDNS == nil {
-				m.CoreDNS = &CoreDNS{}
-			}
-			if err := m.CoreDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeLocalDNS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55684,66 +59780,16 @@ func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeLocalDNS == nil {
-				m.NodeLocalDNS = &NodeLocalDNS{}
+			if m.ShootSelector == nil {
+				m.ShootSelector = &v11.LabelSelector{}
 			}
-			if err := m.NodeLocalDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShootSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Toleration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Toleration: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Toleration: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purposes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55771,13 +59817,13 @@ func (m *Toleration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Purposes = append(m.Purposes, ShootPurpose(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55787,25 +59833,12 @@ func (m *Toleration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Value = &s
-			iNdEx = postIndex
+			m.Percentage = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -57728,6 +61761,39 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Priority = &v
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := MachineUpdateStrategy(dAtA[iNdEx:postIndex])
+			m.UpdateStrategy = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -57868,6 +61934,107 @@ func (m *WorkerKubernetes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkerPoolStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerPoolStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerPoolStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			m.Nodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nodes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *WorkerSystemComponents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -24,6 +26,22 @@ func HibernationIsEnabled(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Enabled != nil && *shoot.Spec.Hibernation.Enabled
 }
 
+// HibernationWakeUpOnDemandEnabled checks if the given shoot shall be woken up when its API server is requested while
+// it is hibernated.
+func HibernationWakeUpOnDemandEnabled(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Spec.Hibernation != nil && ptr.Deref(shoot.Spec.Hibernation.WakeUpOnDemand, false)
+}
+
+// ShootStateRevisionToRestore returns the name of the ShootState revision from which the secrets and extension states
+// of the given shoot shall be restored according to its operation annotation.
+func ShootStateRevisionToRestore(shoot *gardencorev1beta1.Shoot) (string, bool) {
+	revision, ok := strings.CutPrefix(shoot.Annotations[v1beta1constants.GardenerOperation], v1beta1constants.ShootOperationRestoreShootStateRevision+"=")
+	if !ok {
+		return "", false
+	}
+	return revision, true
+}
+
 // ShootWantsClusterAutoscaler checks if the given Shoot needs a cluster autoscaler.
 // This is determined by checking whether one of the Shoot workers has a different
 // Maximum than Minimum.
@@ -60,6 +78,13 @@ func ShootUsesUnmanagedDNS(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.Spec.DNS != nil && len(shoot.Spec.DNS.Providers) > 0 && shoot.Spec.DNS.Providers[0].Type != nil && *shoot.Spec.DNS.Providers[0].Type == "unmanaged"
 }
 
+// IsShootSelfHosted returns true if the shoot is marked as self-hosted, i.e., its control plane runs in the shoot
+// cluster itself.
+func IsShootSelfHosted(shoot *gardencorev1beta1.Shoot) bool {
+	selfHosted, _ := strconv.ParseBool(shoot.Labels[v1beta1constants.LabelShootSelfHosted])
+	return selfHosted
+}
+
 // ShootNeedsForceDeletion determines whether a Shoot should be force deleted or not.
 func ShootNeedsForceDeletion(shoot *gardencorev1beta1.Shoot) bool {
 	if shoot == nil {
@@ -566,6 +591,11 @@ func SystemComponentsAllowed(worker *gardencorev1beta1.Worker) bool {
 	return worker.SystemComponents == nil || worker.SystemComponents.Allow
 }
 
+// IsUpdateStrategyInPlace returns true if the given machine update strategy updates the nodes in-place.
+func IsUpdateStrategyInPlace(updateStrategy *gardencorev1beta1.MachineUpdateStrategy) bool {
+	return ptr.Deref(updateStrategy, "") == gardencorev1beta1.AutoInPlaceUpdate
+}
+
 // SumResourceReservations adds together the given *gardencorev1beta1.KubeletConfigReserved values.
 // The func is suitable to calculate the sum of kubeReserved and systemReserved.
 func SumResourceReservations(left, right *gardencorev1beta1.KubeletConfigReserved) *gardencorev1beta1.KubeletConfigReserved {
@@ -47,6 +47,35 @@ var _ = Describe("Helper", func() {
 		}, true),
 	)
 
+	DescribeTable("#HibernationWakeUpOnDemandEnabled",
+		func(shoot *gardencorev1beta1.Shoot, wakeUpOnDemand bool) {
+			Expect(HibernationWakeUpOnDemandEnabled(shoot)).To(Equal(wakeUpOnDemand))
+		},
+		Entry("no hibernation section", &gardencorev1beta1.Shoot{}, false),
+		Entry("hibernation.wakeUpOnDemand = nil", &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{Enabled: &trueVar},
+			},
+		}, false),
+		Entry("hibernation.wakeUpOnDemand = true", &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{Enabled: &trueVar, WakeUpOnDemand: &trueVar},
+			},
+		}, true),
+	)
+
+	DescribeTable("#ShootStateRevisionToRestore",
+		func(annotations map[string]string, expectedRevision string, expectedOK bool) {
+			revision, ok := ShootStateRevisionToRestore(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}})
+			Expect(revision).To(Equal(expectedRevision))
+			Expect(ok).To(Equal(expectedOK))
+		},
+		Entry("no annotations", nil, "", false),
+		Entry("other operation", map[string]string{"gardener.cloud/operation": "reconcile"}, "", false),
+		Entry("restore operation without revision", map[string]string{"gardener.cloud/operation": "restore-shootstate-revision"}, "", false),
+		Entry("restore operation", map[string]string{"gardener.cloud/operation": "restore-shootstate-revision=foo--rev-1700000000"}, "foo--rev-1700000000", true),
+	)
+
 	DescribeTable("#ShootWantsClusterAutoscaler",
 		func(shoot *gardencorev1beta1.Shoot, wantsAutoscaler bool) {
 			actualWantsAutoscaler, err := ShootWantsClusterAutoscaler(shoot)
@@ -184,6 +213,18 @@ var _ = Describe("Helper", func() {
 		Entry("dns providers and unmanaged type", &gardencorev1beta1.DNS{Providers: []gardencorev1beta1.DNSProvider{{Type: &unmanagedType}}}, true),
 	)
 
+	DescribeTable("#IsShootSelfHosted",
+		func(labels map[string]string, expectation bool) {
+			Expect(IsShootSelfHosted(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Labels: labels}})).To(Equal(expectation))
+		},
+
+		Entry("no labels", nil, false),
+		Entry("label not present", map[string]string{"foo": "bar"}, false),
+		Entry("label with invalid value", map[string]string{"shoot.gardener.cloud/self-hosted": "foo"}, false),
+		Entry("label with false value", map[string]string{"shoot.gardener.cloud/self-hosted": "false"}, false),
+		Entry("label with true value", map[string]string{"shoot.gardener.cloud/self-hosted": "true"}, true),
+	)
+
 	DescribeTable("#ShootNeedsForceDeletion",
 		func(shoot *gardencorev1beta1.Shoot, match gomegatypes.GomegaMatcher) {
 			Expect(ShootNeedsForceDeletion(shoot)).To(match)
@@ -1303,6 +1344,15 @@ var _ = Describe("Helper", func() {
 		Entry("systemComponents.allowed = true", &gardencorev1beta1.Worker{SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: true}}, true),
 	)
 
+	DescribeTable("#IsUpdateStrategyInPlace",
+		func(updateStrategy *gardencorev1beta1.MachineUpdateStrategy, expected bool) {
+			Expect(IsUpdateStrategyInPlace(updateStrategy)).To(Equal(expected))
+		},
+		Entry("no update strategy", nil, false),
+		Entry("AutoRollingUpdate", ptr.To(gardencorev1beta1.AutoRollingUpdate), false),
+		Entry("AutoInPlaceUpdate", ptr.To(gardencorev1beta1.AutoInPlaceUpdate), true),
+	)
+
 	DescribeTable("#SumResourceReservations",
 		func(left, right, expected *gardencorev1beta1.KubeletConfigReserved) {
 			actual := SumResourceReservations(left, right)
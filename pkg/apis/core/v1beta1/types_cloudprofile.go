@@ -77,6 +77,10 @@ type CloudProfileSpec struct {
 	// Bastion contains the machine and image properties
 	// +optional
 	Bastion *Bastion `json:"bastion,omitempty" protobuf:"bytes,10,opt,name=bastion"`
+	// VersionRolloutStrategy configures staged rollouts of new Kubernetes and machine image versions to the Shoots
+	// referencing this profile via automatic updates during their maintenance time windows.
+	// +optional
+	VersionRolloutStrategy *VersionRolloutStrategy `json:"versionRolloutStrategy,omitempty" protobuf:"bytes,11,opt,name=versionRolloutStrategy"`
 }
 
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
@@ -163,6 +167,10 @@ type MachineType struct {
 	// Architecture is the CPU architecture of this machine type.
 	// +optional
 	Architecture *string `json:"architecture,omitempty" protobuf:"bytes,7,opt,name=architecture"`
+	// CostUnits is the abstract price of a single machine of this type. It is used to determine the cost units allocated
+	// by shoot clusters, e.g., for quotas.
+	// +optional
+	CostUnits *resource.Quantity `json:"costUnits,omitempty" protobuf:"bytes,8,opt,name=costUnits"`
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -286,3 +294,35 @@ const (
 	// UpdateStrategyMajor indicates that auto-updates are performed always to the overall latest version.
 	UpdateStrategyMajor MachineImageUpdateStrategy = "major"
 )
+
+// VersionRolloutStrategy configures staged rollouts of new versions to Shoots via automatic updates.
+type VersionRolloutStrategy struct {
+	// Waves is the ordered list of rollout waves. A Shoot belongs to the first wave it matches. Shoots which do not
+	// match any wave belong to an implicit last wave.
+	Waves []VersionRolloutWave `json:"waves" protobuf:"bytes,1,rep,name=waves"`
+	// SoakDuration is the duration for which the Shoots of a wave must have been healthy on a new version before the
+	// Shoots of the next wave are automatically updated to this version. Defaults to 24h.
+	// +optional
+	SoakDuration *metav1.Duration `json:"soakDuration,omitempty" protobuf:"bytes,2,opt,name=soakDuration"`
+	// MaxUnhealthyPercentage is the percentage of unhealthy Shoots among all Shoots already running a new version above
+	// which the rollout of this version is halted. Defaults to 20.
+	// +optional
+	MaxUnhealthyPercentage *int32 `json:"maxUnhealthyPercentage,omitempty" protobuf:"varint,3,opt,name=maxUnhealthyPercentage"`
+}
+
+// VersionRolloutWave is a wave of a staged version rollout. A Shoot matches a wave if it matches all of the
+// configured criteria.
+type VersionRolloutWave struct {
+	// Name is the name of the wave.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ShootSelector is an optional label selector for the Shoots of this wave.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty" protobuf:"bytes,2,opt,name=shootSelector"`
+	// Purposes is an optional list of Shoot purposes of this wave.
+	// +optional
+	Purposes []ShootPurpose `json:"purposes,omitempty" protobuf:"bytes,3,rep,name=purposes,casttype=ShootPurpose"`
+	// Percentage optionally restricts the wave to the given percentage of all Shoots. The Shoots are selected
+	// deterministically based on their UID.
+	// +optional
+	Percentage *int32 `json:"percentage,omitempty" protobuf:"varint,4,opt,name=percentage"`
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenanceFreeze blocks automatic changes to Shoots during configured time windows, e.g., during company-wide change
+// freezes or holidays. While a freeze is active for a Shoot, its maintenance and its hibernation schedules are skipped.
+type MaintenanceFreeze struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec contains the specification of this maintenance freeze.
+	Spec MaintenanceFreezeSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenanceFreezeList is a collection of MaintenanceFreezes.
+type MaintenanceFreezeList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of MaintenanceFreezes.
+	Items []MaintenanceFreeze `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// MaintenanceFreezeSpec is the specification of a MaintenanceFreeze.
+type MaintenanceFreezeSpec struct {
+	// Windows is a list of time windows during which the freeze is active.
+	Windows []MaintenanceFreezeWindow `json:"windows" protobuf:"bytes,1,rep,name=windows"`
+	// ShootSelector is an optional label selector for the Shoots the freeze applies to. If it is not set, the freeze
+	// applies to all Shoots.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty" protobuf:"bytes,2,opt,name=shootSelector"`
+	// ProjectSelector is an optional label selector for the Projects whose Shoots the freeze applies to. If it is not
+	// set, the freeze applies to the Shoots of all Projects.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty" protobuf:"bytes,3,opt,name=projectSelector"`
+}
+
+// MaintenanceFreezeWindow is a time window during which a MaintenanceFreeze is active.
+type MaintenanceFreezeWindow struct {
+	// Begin is the point in time when the freeze begins.
+	Begin metav1.Time `json:"begin" protobuf:"bytes,1,opt,name=begin"`
+	// End is the point in time when the freeze ends.
+	End metav1.Time `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// Reason is an optional human-readable explanation of the freeze, e.g., the name of the holiday. It is included in
+	// the events recorded for skipped operations.
+	// +optional
+	Reason *string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+}
@@ -6,6 +6,7 @@ package v1beta1
 
 import (
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -93,6 +94,71 @@ type ProjectStatus struct {
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	// +optional
 	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty" protobuf:"bytes,5,opt,name=lastActivityTimestamp"`
+	// Usage contains the resources consumed by the shoot clusters of this project in the current accounting period.
+	// +optional
+	Usage *ProjectUsage `json:"usage,omitempty" protobuf:"bytes,6,opt,name=usage"`
+	// LastPeriodUsage contains the resources consumed by the shoot clusters of this project in the previous accounting
+	// period.
+	// +optional
+	LastPeriodUsage *ProjectUsage `json:"lastPeriodUsage,omitempty" protobuf:"bytes,7,opt,name=lastPeriodUsage"`
+}
+
+// ProjectUsage contains the resources consumed by the shoot clusters of a project, accumulated over an accounting
+// period.
+type ProjectUsage struct {
+	// PeriodStartTime is the start of the accounting period, i.e., the beginning of the current month (UTC).
+	PeriodStartTime metav1.Time `json:"periodStartTime" protobuf:"bytes,1,opt,name=periodStartTime"`
+	// LastUpdateTime is the time when the usage was last accumulated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,2,opt,name=lastUpdateTime"`
+	// Machines contains the machine hours per machine type.
+	// +optional
+	Machines []ProjectMachineUsage `json:"machines,omitempty" protobuf:"bytes,3,rep,name=machines"`
+	// Shoots contains the hours the shoot clusters were awake or hibernated.
+	Shoots ProjectShootUsage `json:"shoots" protobuf:"bytes,4,opt,name=shoots"`
+	// ControlPlanes contains the hours of awake control planes per high availability mode.
+	// +optional
+	ControlPlanes []ProjectControlPlaneUsage `json:"controlPlanes,omitempty" protobuf:"bytes,5,rep,name=controlPlanes"`
+	// Storage contains the gibibyte hours of the worker node volumes per volume class.
+	// +optional
+	Storage []ProjectStorageUsage `json:"storage,omitempty" protobuf:"bytes,6,rep,name=storage"`
+	// CostUnits is the sum of the cost units of all machines.
+	// +optional
+	CostUnits *resource.Quantity `json:"costUnits,omitempty" protobuf:"bytes,7,opt,name=costUnits"`
+}
+
+// ProjectMachineUsage contains the usage of a machine type.
+type ProjectMachineUsage struct {
+	// Type is the name of the machine type.
+	Type string `json:"type" protobuf:"bytes,1,opt,name=type"`
+	// Hours is the number of machine hours.
+	Hours resource.Quantity `json:"hours" protobuf:"bytes,2,opt,name=hours"`
+	// CostUnits is the number of machine hours multiplied with the cost units of the machine type in the CloudProfile.
+	// +optional
+	CostUnits *resource.Quantity `json:"costUnits,omitempty" protobuf:"bytes,3,opt,name=costUnits"`
+}
+
+// ProjectShootUsage contains the hours the shoot clusters were awake or hibernated.
+type ProjectShootUsage struct {
+	// AwakeHours is the number of hours the shoot clusters were awake.
+	AwakeHours resource.Quantity `json:"awakeHours" protobuf:"bytes,1,opt,name=awakeHours"`
+	// HibernatedHours is the number of hours the shoot clusters were hibernated.
+	HibernatedHours resource.Quantity `json:"hibernatedHours" protobuf:"bytes,2,opt,name=hibernatedHours"`
+}
+
+// ProjectControlPlaneUsage contains the usage of control planes with a certain high availability mode.
+type ProjectControlPlaneUsage struct {
+	// HighAvailability is the failure tolerance type of the control planes, i.e., `none`, `node`, or `zone`.
+	HighAvailability string `json:"highAvailability" protobuf:"bytes,1,opt,name=highAvailability"`
+	// Hours is the number of control plane hours.
+	Hours resource.Quantity `json:"hours" protobuf:"bytes,2,opt,name=hours"`
+}
+
+// ProjectStorageUsage contains the usage of worker node volumes with a certain volume class.
+type ProjectStorageUsage struct {
+	// Class is the volume class.
+	Class string `json:"class" protobuf:"bytes,1,opt,name=class"`
+	// GibibyteHours is the volume size in gibibytes multiplied with the number of hours.
+	GibibyteHours resource.Quantity `json:"gibibyteHours" protobuf:"bytes,2,opt,name=gibibyteHours"`
 }
 
 // ProjectMember is a member of a project.
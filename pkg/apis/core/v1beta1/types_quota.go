@@ -21,6 +21,9 @@ type Quota struct {
 	// Spec defines the Quota constraints.
 	// +optional
 	Spec QuotaSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the current usage of the Quota.
+	// +optional
+	Status QuotaStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -45,3 +48,12 @@ type QuotaSpec struct {
 	// Scope is the scope of the Quota object, either 'project', 'secret' or 'workloadidentity'. This field is immutable.
 	Scope corev1.ObjectReference `json:"scope" protobuf:"bytes,3,opt,name=scope"` // TODO: When graduating the API to v1 consider reworking this field as described in https://github.com/gardener/gardener/issues/9773#issuecomment-2293340267
 }
+
+// QuotaStatus is the status of a Quota.
+type QuotaStatus struct {
+	// Used is the amount of the constrained resources which is currently allocated by the shoot clusters referencing
+	// the Quota. For project-scoped Quotas, it is the highest amount allocated within a single project since the limits
+	// apply to each project separately.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty" protobuf:"bytes,1,rep,name=used,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+}
@@ -212,6 +212,17 @@ type ShootStatus struct {
 	// Networking contains information about cluster networking such as CIDRs.
 	// +optional
 	Networking *NetworkingStatus `json:"networking,omitempty" protobuf:"bytes,19,opt,name=networking"`
+	// WorkerPools contains the number of nodes per worker pool as last observed by gardenlet.
+	// +optional
+	WorkerPools []WorkerPoolStatus `json:"workerPools,omitempty" protobuf:"bytes,20,rep,name=workerPools"`
+}
+
+// WorkerPoolStatus contains the observed state of a worker pool.
+type WorkerPoolStatus struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Nodes is the number of nodes of the worker pool.
+	Nodes int32 `json:"nodes" protobuf:"varint,2,opt,name=nodes"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -560,6 +571,10 @@ type Hibernation struct {
 	// Schedules determine the hibernation schedules.
 	// +optional
 	Schedules []HibernationSchedule `json:"schedules,omitempty" protobuf:"bytes,2,rep,name=schedules"`
+	// WakeUpOnDemand specifies whether the Shoot shall be woken up when its API server is requested while it is
+	// hibernated. The caller receives a response asking it to retry the request later.
+	// +optional
+	WakeUpOnDemand *bool `json:"wakeUpOnDemand,omitempty" protobuf:"varint,3,opt,name=wakeUpOnDemand"`
 }
 
 // HibernationSchedule determines the hibernation schedule of a Shoot.
@@ -575,6 +590,10 @@ type HibernationSchedule struct {
 	// Location is the time location in which both start and shall be evaluated.
 	// +optional
 	Location *string `json:"location,omitempty" protobuf:"bytes,3,opt,name=location"`
+	// Exceptions is a list of dates (format `YYYY-MM-DD`) on which the Shoot will not be hibernated by this schedule.
+	// The dates are evaluated in the time location of the schedule. Wake-ups still happen on these dates.
+	// +optional
+	Exceptions []string `json:"exceptions,omitempty" protobuf:"bytes,4,rep,name=exceptions"`
 }
 
 // Kubernetes contains the version and configuration variables for the Shoot control plane.
@@ -1621,8 +1640,25 @@ type Worker struct {
 	// Priority (or weight) is the importance by which this worker group will be scaled by cluster autoscaling.
 	// +optional
 	Priority *int32 `json:"priority,omitempty" protobuf:"varint,22,opt,name=priority"`
+	// UpdateStrategy specifies the machine update strategy for the worker pool.
+	// Possible values are "AutoRollingUpdate" and "AutoInPlaceUpdate". Defaults to "AutoRollingUpdate" if not set.
+	// With "AutoInPlaceUpdate", Kubernetes version and machine image version updates are performed on the existing
+	// nodes by gardener-node-agent instead of replacing them. The strategy cannot be changed from or to
+	// "AutoInPlaceUpdate" after the worker pool was created.
+	// +optional
+	UpdateStrategy *MachineUpdateStrategy `json:"updateStrategy,omitempty" protobuf:"bytes,23,opt,name=updateStrategy,casttype=MachineUpdateStrategy"`
 }
 
+// MachineUpdateStrategy specifies the machine update strategy for the worker pool.
+type MachineUpdateStrategy string
+
+const (
+	// AutoRollingUpdate represents a machine update strategy where nodes are replaced during the update process.
+	AutoRollingUpdate MachineUpdateStrategy = "AutoRollingUpdate"
+	// AutoInPlaceUpdate represents a machine update strategy where nodes are updated in-place without being replaced.
+	AutoInPlaceUpdate MachineUpdateStrategy = "AutoInPlaceUpdate"
+)
+
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
 type ClusterAutoscalerOptions struct {
 	// ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed.
@@ -1864,6 +1900,18 @@ const (
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventMaintenanceFrozen indicates that a maintenance or hibernation operation was skipped because of an
+	// active maintenance freeze.
+	ShootEventMaintenanceFrozen = "MaintenanceFrozen"
+	// ShootEventVersionRolloutDeferred indicates that an automatic version update was deferred because of the version
+	// rollout strategy of the CloudProfile.
+	ShootEventVersionRolloutDeferred = "VersionRolloutDeferred"
+	// ShootEventShootStateRestored indicates that the secrets and extension states of a Shoot were restored from a
+	// ShootState revision.
+	ShootEventShootStateRestored = "ShootStateRestored"
+	// ShootEventShootStateRestoreFailed indicates that the secrets and extension states of a Shoot could not be
+	// restored from a ShootState revision.
+	ShootEventShootStateRestoreFailed = "ShootStateRestoreFailed"
 )
 
 const (
@@ -1016,6 +1016,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreeze)(nil), (*core.MaintenanceFreeze)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(a.(*MaintenanceFreeze), b.(*core.MaintenanceFreeze), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreeze)(nil), (*MaintenanceFreeze)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(a.(*core.MaintenanceFreeze), b.(*MaintenanceFreeze), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreezeList)(nil), (*core.MaintenanceFreezeList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreezeList_To_core_MaintenanceFreezeList(a.(*MaintenanceFreezeList), b.(*core.MaintenanceFreezeList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreezeList)(nil), (*MaintenanceFreezeList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreezeList_To_v1beta1_MaintenanceFreezeList(a.(*core.MaintenanceFreezeList), b.(*MaintenanceFreezeList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreezeSpec)(nil), (*core.MaintenanceFreezeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreezeSpec_To_core_MaintenanceFreezeSpec(a.(*MaintenanceFreezeSpec), b.(*core.MaintenanceFreezeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreezeSpec)(nil), (*MaintenanceFreezeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreezeSpec_To_v1beta1_MaintenanceFreezeSpec(a.(*core.MaintenanceFreezeSpec), b.(*MaintenanceFreezeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreezeWindow)(nil), (*core.MaintenanceFreezeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreezeWindow_To_core_MaintenanceFreezeWindow(a.(*MaintenanceFreezeWindow), b.(*core.MaintenanceFreezeWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreezeWindow)(nil), (*MaintenanceFreezeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreezeWindow_To_v1beta1_MaintenanceFreezeWindow(a.(*core.MaintenanceFreezeWindow), b.(*MaintenanceFreezeWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceTimeWindow)(nil), (*core.MaintenanceTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(a.(*MaintenanceTimeWindow), b.(*core.MaintenanceTimeWindow), scope)
 	}); err != nil {
@@ -1146,6 +1186,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OCIVerification)(nil), (*core.OCIVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OCIVerification_To_core_OCIVerification(a.(*OCIVerification), b.(*core.OCIVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.OCIVerification)(nil), (*OCIVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_OCIVerification_To_v1beta1_OCIVerification(a.(*core.OCIVerification), b.(*OCIVerification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OIDCConfig)(nil), (*core.OIDCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OIDCConfig_To_core_OIDCConfig(a.(*OIDCConfig), b.(*core.OIDCConfig), scope)
 	}); err != nil {
@@ -1196,6 +1246,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectControlPlaneUsage)(nil), (*core.ProjectControlPlaneUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectControlPlaneUsage_To_core_ProjectControlPlaneUsage(a.(*ProjectControlPlaneUsage), b.(*core.ProjectControlPlaneUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectControlPlaneUsage)(nil), (*ProjectControlPlaneUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectControlPlaneUsage_To_v1beta1_ProjectControlPlaneUsage(a.(*core.ProjectControlPlaneUsage), b.(*ProjectControlPlaneUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectList)(nil), (*core.ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectList_To_core_ProjectList(a.(*ProjectList), b.(*core.ProjectList), scope)
 	}); err != nil {
@@ -1206,6 +1266,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectMachineUsage)(nil), (*core.ProjectMachineUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectMachineUsage_To_core_ProjectMachineUsage(a.(*ProjectMachineUsage), b.(*core.ProjectMachineUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectMachineUsage)(nil), (*ProjectMachineUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectMachineUsage_To_v1beta1_ProjectMachineUsage(a.(*core.ProjectMachineUsage), b.(*ProjectMachineUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectShootUsage)(nil), (*core.ProjectShootUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectShootUsage_To_core_ProjectShootUsage(a.(*ProjectShootUsage), b.(*core.ProjectShootUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectShootUsage)(nil), (*ProjectShootUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectShootUsage_To_v1beta1_ProjectShootUsage(a.(*core.ProjectShootUsage), b.(*ProjectShootUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectStatus)(nil), (*core.ProjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectStatus_To_core_ProjectStatus(a.(*ProjectStatus), b.(*core.ProjectStatus), scope)
 	}); err != nil {
@@ -1216,6 +1296,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectStorageUsage)(nil), (*core.ProjectStorageUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectStorageUsage_To_core_ProjectStorageUsage(a.(*ProjectStorageUsage), b.(*core.ProjectStorageUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectStorageUsage)(nil), (*ProjectStorageUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectStorageUsage_To_v1beta1_ProjectStorageUsage(a.(*core.ProjectStorageUsage), b.(*ProjectStorageUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectTolerations)(nil), (*core.ProjectTolerations)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectTolerations_To_core_ProjectTolerations(a.(*ProjectTolerations), b.(*core.ProjectTolerations), scope)
 	}); err != nil {
@@ -1226,6 +1316,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsage)(nil), (*core.ProjectUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectUsage_To_core_ProjectUsage(a.(*ProjectUsage), b.(*core.ProjectUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectUsage)(nil), (*ProjectUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectUsage_To_v1beta1_ProjectUsage(a.(*core.ProjectUsage), b.(*ProjectUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Provider)(nil), (*core.Provider)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Provider_To_core_Provider(a.(*Provider), b.(*core.Provider), scope)
 	}); err != nil {
@@ -1266,6 +1366,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaStatus)(nil), (*core.QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(a.(*QuotaStatus), b.(*core.QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaStatus)(nil), (*QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(a.(*core.QuotaStatus), b.(*QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Region)(nil), (*core.Region)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Region_To_core_Region(a.(*Region), b.(*core.Region), scope)
 	}); err != nil {
@@ -1796,6 +1906,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VersionRolloutStrategy)(nil), (*core.VersionRolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionRolloutStrategy_To_core_VersionRolloutStrategy(a.(*VersionRolloutStrategy), b.(*core.VersionRolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionRolloutStrategy)(nil), (*VersionRolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionRolloutStrategy_To_v1beta1_VersionRolloutStrategy(a.(*core.VersionRolloutStrategy), b.(*VersionRolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VersionRolloutWave)(nil), (*core.VersionRolloutWave)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionRolloutWave_To_core_VersionRolloutWave(a.(*VersionRolloutWave), b.(*core.VersionRolloutWave), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionRolloutWave)(nil), (*VersionRolloutWave)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionRolloutWave_To_v1beta1_VersionRolloutWave(a.(*core.VersionRolloutWave), b.(*VersionRolloutWave), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscaler)(nil), (*core.VerticalPodAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(a.(*VerticalPodAutoscaler), b.(*core.VerticalPodAutoscaler), scope)
 	}); err != nil {
@@ -1856,6 +1986,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolStatus)(nil), (*core.WorkerPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus(a.(*WorkerPoolStatus), b.(*core.WorkerPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerPoolStatus)(nil), (*WorkerPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus(a.(*core.WorkerPoolStatus), b.(*WorkerPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerSystemComponents)(nil), (*core.WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(a.(*WorkerSystemComponents), b.(*core.WorkerSystemComponents), scope)
 	}); err != nil {
@@ -2625,6 +2765,7 @@ func autoConvert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(in *CloudProf
 	out.Type = in.Type
 	out.VolumeTypes = *(*[]core.VolumeType)(unsafe.Pointer(&in.VolumeTypes))
 	out.Bastion = (*core.Bastion)(unsafe.Pointer(in.Bastion))
+	out.VersionRolloutStrategy = (*core.VersionRolloutStrategy)(unsafe.Pointer(in.VersionRolloutStrategy))
 	return nil
 }
 
@@ -2646,6 +2787,7 @@ func autoConvert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *core.Clou
 	out.Type = in.Type
 	out.VolumeTypes = *(*[]VolumeType)(unsafe.Pointer(&in.VolumeTypes))
 	out.Bastion = (*Bastion)(unsafe.Pointer(in.Bastion))
+	out.VersionRolloutStrategy = (*VersionRolloutStrategy)(unsafe.Pointer(in.VersionRolloutStrategy))
 	return nil
 }
 
@@ -3600,6 +3742,7 @@ func autoConvert_core_HelmControllerDeployment_To_v1beta1_HelmControllerDeployme
 func autoConvert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]core.HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.WakeUpOnDemand = (*bool)(unsafe.Pointer(in.WakeUpOnDemand))
 	return nil
 }
 
@@ -3611,6 +3754,7 @@ func Convert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.
 func autoConvert_core_Hibernation_To_v1beta1_Hibernation(in *core.Hibernation, out *Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.WakeUpOnDemand = (*bool)(unsafe.Pointer(in.WakeUpOnDemand))
 	return nil
 }
 
@@ -3623,6 +3767,7 @@ func autoConvert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(in *Hib
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
 	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.Exceptions = *(*[]string)(unsafe.Pointer(&in.Exceptions))
 	return nil
 }
 
@@ -3635,6 +3780,7 @@ func autoConvert_core_HibernationSchedule_To_v1beta1_HibernationSchedule(in *cor
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
 	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.Exceptions = *(*[]string)(unsafe.Pointer(&in.Exceptions))
 	return nil
 }
 
@@ -4533,6 +4679,7 @@ func autoConvert_v1beta1_MachineType_To_core_MachineType(in *MachineType, out *c
 	out.Storage = (*core.MachineTypeStorage)(unsafe.Pointer(in.Storage))
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.CostUnits = (*resource.Quantity)(unsafe.Pointer(in.CostUnits))
 	return nil
 }
 
@@ -4549,6 +4696,7 @@ func autoConvert_core_MachineType_To_v1beta1_MachineType(in *core.MachineType, o
 	out.Storage = (*MachineTypeStorage)(unsafe.Pointer(in.Storage))
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.CostUnits = (*resource.Quantity)(unsafe.Pointer(in.CostUnits))
 	return nil
 }
 
@@ -4629,6 +4777,102 @@ func Convert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *cor
 	return autoConvert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in *MaintenanceFreeze, out *core.MaintenanceFreeze, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_MaintenanceFreezeSpec_To_core_MaintenanceFreezeSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in *MaintenanceFreeze, out *core.MaintenanceFreeze, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in *core.MaintenanceFreeze, out *MaintenanceFreeze, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_MaintenanceFreezeSpec_To_v1beta1_MaintenanceFreezeSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze is an autogenerated conversion function.
+func Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in *core.MaintenanceFreeze, out *MaintenanceFreeze, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceFreezeList_To_core_MaintenanceFreezeList(in *MaintenanceFreezeList, out *core.MaintenanceFreezeList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.MaintenanceFreeze)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreezeList_To_core_MaintenanceFreezeList is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreezeList_To_core_MaintenanceFreezeList(in *MaintenanceFreezeList, out *core.MaintenanceFreezeList, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreezeList_To_core_MaintenanceFreezeList(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreezeList_To_v1beta1_MaintenanceFreezeList(in *core.MaintenanceFreezeList, out *MaintenanceFreezeList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]MaintenanceFreeze)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_MaintenanceFreezeList_To_v1beta1_MaintenanceFreezeList is an autogenerated conversion function.
+func Convert_core_MaintenanceFreezeList_To_v1beta1_MaintenanceFreezeList(in *core.MaintenanceFreezeList, out *MaintenanceFreezeList, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreezeList_To_v1beta1_MaintenanceFreezeList(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceFreezeSpec_To_core_MaintenanceFreezeSpec(in *MaintenanceFreezeSpec, out *core.MaintenanceFreezeSpec, s conversion.Scope) error {
+	out.Windows = *(*[]core.MaintenanceFreezeWindow)(unsafe.Pointer(&in.Windows))
+	out.ShootSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.ProjectSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreezeSpec_To_core_MaintenanceFreezeSpec is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreezeSpec_To_core_MaintenanceFreezeSpec(in *MaintenanceFreezeSpec, out *core.MaintenanceFreezeSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreezeSpec_To_core_MaintenanceFreezeSpec(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreezeSpec_To_v1beta1_MaintenanceFreezeSpec(in *core.MaintenanceFreezeSpec, out *MaintenanceFreezeSpec, s conversion.Scope) error {
+	out.Windows = *(*[]MaintenanceFreezeWindow)(unsafe.Pointer(&in.Windows))
+	out.ShootSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.ProjectSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_core_MaintenanceFreezeSpec_To_v1beta1_MaintenanceFreezeSpec is an autogenerated conversion function.
+func Convert_core_MaintenanceFreezeSpec_To_v1beta1_MaintenanceFreezeSpec(in *core.MaintenanceFreezeSpec, out *MaintenanceFreezeSpec, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreezeSpec_To_v1beta1_MaintenanceFreezeSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceFreezeWindow_To_core_MaintenanceFreezeWindow(in *MaintenanceFreezeWindow, out *core.MaintenanceFreezeWindow, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreezeWindow_To_core_MaintenanceFreezeWindow is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreezeWindow_To_core_MaintenanceFreezeWindow(in *MaintenanceFreezeWindow, out *core.MaintenanceFreezeWindow, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreezeWindow_To_core_MaintenanceFreezeWindow(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreezeWindow_To_v1beta1_MaintenanceFreezeWindow(in *core.MaintenanceFreezeWindow, out *MaintenanceFreezeWindow, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	return nil
+}
+
+// Convert_core_MaintenanceFreezeWindow_To_v1beta1_MaintenanceFreezeWindow is an autogenerated conversion function.
+func Convert_core_MaintenanceFreezeWindow_To_v1beta1_MaintenanceFreezeWindow(in *core.MaintenanceFreezeWindow, out *MaintenanceFreezeWindow, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreezeWindow_To_v1beta1_MaintenanceFreezeWindow(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
@@ -4946,6 +5190,8 @@ func autoConvert_v1beta1_OCIRepository_To_core_OCIRepository(in *OCIRepository,
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
+	out.PullSecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
+	out.Verification = (*core.OCIVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -4959,6 +5205,8 @@ func autoConvert_core_OCIRepository_To_v1beta1_OCIRepository(in *core.OCIReposit
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
+	out.PullSecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
+	out.Verification = (*OCIVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -4967,6 +5215,26 @@ func Convert_core_OCIRepository_To_v1beta1_OCIRepository(in *core.OCIRepository,
 	return autoConvert_core_OCIRepository_To_v1beta1_OCIRepository(in, out, s)
 }
 
+func autoConvert_v1beta1_OCIVerification_To_core_OCIVerification(in *OCIVerification, out *core.OCIVerification, s conversion.Scope) error {
+	out.PublicKeysSecretRef = in.PublicKeysSecretRef
+	return nil
+}
+
+// Convert_v1beta1_OCIVerification_To_core_OCIVerification is an autogenerated conversion function.
+func Convert_v1beta1_OCIVerification_To_core_OCIVerification(in *OCIVerification, out *core.OCIVerification, s conversion.Scope) error {
+	return autoConvert_v1beta1_OCIVerification_To_core_OCIVerification(in, out, s)
+}
+
+func autoConvert_core_OCIVerification_To_v1beta1_OCIVerification(in *core.OCIVerification, out *OCIVerification, s conversion.Scope) error {
+	out.PublicKeysSecretRef = in.PublicKeysSecretRef
+	return nil
+}
+
+// Convert_core_OCIVerification_To_v1beta1_OCIVerification is an autogenerated conversion function.
+func Convert_core_OCIVerification_To_v1beta1_OCIVerification(in *core.OCIVerification, out *OCIVerification, s conversion.Scope) error {
+	return autoConvert_core_OCIVerification_To_v1beta1_OCIVerification(in, out, s)
+}
+
 func autoConvert_v1beta1_OIDCConfig_To_core_OIDCConfig(in *OIDCConfig, out *core.OIDCConfig, s conversion.Scope) error {
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	out.ClientAuthentication = (*core.OpenIDConnectClientAuthentication)(unsafe.Pointer(in.ClientAuthentication))
@@ -5103,6 +5371,28 @@ func Convert_core_Project_To_v1beta1_Project(in *core.Project, out *Project, s c
 	return autoConvert_core_Project_To_v1beta1_Project(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectControlPlaneUsage_To_core_ProjectControlPlaneUsage(in *ProjectControlPlaneUsage, out *core.ProjectControlPlaneUsage, s conversion.Scope) error {
+	out.HighAvailability = in.HighAvailability
+	out.Hours = in.Hours
+	return nil
+}
+
+// Convert_v1beta1_ProjectControlPlaneUsage_To_core_ProjectControlPlaneUsage is an autogenerated conversion function.
+func Convert_v1beta1_ProjectControlPlaneUsage_To_core_ProjectControlPlaneUsage(in *ProjectControlPlaneUsage, out *core.ProjectControlPlaneUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectControlPlaneUsage_To_core_ProjectControlPlaneUsage(in, out, s)
+}
+
+func autoConvert_core_ProjectControlPlaneUsage_To_v1beta1_ProjectControlPlaneUsage(in *core.ProjectControlPlaneUsage, out *ProjectControlPlaneUsage, s conversion.Scope) error {
+	out.HighAvailability = in.HighAvailability
+	out.Hours = in.Hours
+	return nil
+}
+
+// Convert_core_ProjectControlPlaneUsage_To_v1beta1_ProjectControlPlaneUsage is an autogenerated conversion function.
+func Convert_core_ProjectControlPlaneUsage_To_v1beta1_ProjectControlPlaneUsage(in *core.ProjectControlPlaneUsage, out *ProjectControlPlaneUsage, s conversion.Scope) error {
+	return autoConvert_core_ProjectControlPlaneUsage_To_v1beta1_ProjectControlPlaneUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectList_To_core_ProjectList(in *ProjectList, out *core.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -5145,6 +5435,30 @@ func Convert_core_ProjectList_To_v1beta1_ProjectList(in *core.ProjectList, out *
 	return autoConvert_core_ProjectList_To_v1beta1_ProjectList(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectMachineUsage_To_core_ProjectMachineUsage(in *ProjectMachineUsage, out *core.ProjectMachineUsage, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Hours = in.Hours
+	out.CostUnits = (*resource.Quantity)(unsafe.Pointer(in.CostUnits))
+	return nil
+}
+
+// Convert_v1beta1_ProjectMachineUsage_To_core_ProjectMachineUsage is an autogenerated conversion function.
+func Convert_v1beta1_ProjectMachineUsage_To_core_ProjectMachineUsage(in *ProjectMachineUsage, out *core.ProjectMachineUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectMachineUsage_To_core_ProjectMachineUsage(in, out, s)
+}
+
+func autoConvert_core_ProjectMachineUsage_To_v1beta1_ProjectMachineUsage(in *core.ProjectMachineUsage, out *ProjectMachineUsage, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Hours = in.Hours
+	out.CostUnits = (*resource.Quantity)(unsafe.Pointer(in.CostUnits))
+	return nil
+}
+
+// Convert_core_ProjectMachineUsage_To_v1beta1_ProjectMachineUsage is an autogenerated conversion function.
+func Convert_core_ProjectMachineUsage_To_v1beta1_ProjectMachineUsage(in *core.ProjectMachineUsage, out *ProjectMachineUsage, s conversion.Scope) error {
+	return autoConvert_core_ProjectMachineUsage_To_v1beta1_ProjectMachineUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectMember_To_core_ProjectMember(in *ProjectMember, out *core.ProjectMember, s conversion.Scope) error {
 	out.Subject = in.Subject
 	// WARNING: in.Role requires manual conversion: does not exist in peer-type
@@ -5158,6 +5472,28 @@ func autoConvert_core_ProjectMember_To_v1beta1_ProjectMember(in *core.ProjectMem
 	return nil
 }
 
+func autoConvert_v1beta1_ProjectShootUsage_To_core_ProjectShootUsage(in *ProjectShootUsage, out *core.ProjectShootUsage, s conversion.Scope) error {
+	out.AwakeHours = in.AwakeHours
+	out.HibernatedHours = in.HibernatedHours
+	return nil
+}
+
+// Convert_v1beta1_ProjectShootUsage_To_core_ProjectShootUsage is an autogenerated conversion function.
+func Convert_v1beta1_ProjectShootUsage_To_core_ProjectShootUsage(in *ProjectShootUsage, out *core.ProjectShootUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectShootUsage_To_core_ProjectShootUsage(in, out, s)
+}
+
+func autoConvert_core_ProjectShootUsage_To_v1beta1_ProjectShootUsage(in *core.ProjectShootUsage, out *ProjectShootUsage, s conversion.Scope) error {
+	out.AwakeHours = in.AwakeHours
+	out.HibernatedHours = in.HibernatedHours
+	return nil
+}
+
+// Convert_core_ProjectShootUsage_To_v1beta1_ProjectShootUsage is an autogenerated conversion function.
+func Convert_core_ProjectShootUsage_To_v1beta1_ProjectShootUsage(in *core.ProjectShootUsage, out *ProjectShootUsage, s conversion.Scope) error {
+	return autoConvert_core_ProjectShootUsage_To_v1beta1_ProjectShootUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *core.ProjectSpec, s conversion.Scope) error {
 	out.CreatedBy = (*rbacv1.Subject)(unsafe.Pointer(in.CreatedBy))
 	out.Description = (*string)(unsafe.Pointer(in.Description))
@@ -5208,6 +5544,8 @@ func autoConvert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus,
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.Usage = (*core.ProjectUsage)(unsafe.Pointer(in.Usage))
+	out.LastPeriodUsage = (*core.ProjectUsage)(unsafe.Pointer(in.LastPeriodUsage))
 	return nil
 }
 
@@ -5222,6 +5560,8 @@ func autoConvert_core_ProjectStatus_To_v1beta1_ProjectStatus(in *core.ProjectSta
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.Usage = (*ProjectUsage)(unsafe.Pointer(in.Usage))
+	out.LastPeriodUsage = (*ProjectUsage)(unsafe.Pointer(in.LastPeriodUsage))
 	return nil
 }
 
@@ -5230,6 +5570,28 @@ func Convert_core_ProjectStatus_To_v1beta1_ProjectStatus(in *core.ProjectStatus,
 	return autoConvert_core_ProjectStatus_To_v1beta1_ProjectStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectStorageUsage_To_core_ProjectStorageUsage(in *ProjectStorageUsage, out *core.ProjectStorageUsage, s conversion.Scope) error {
+	out.Class = in.Class
+	out.GibibyteHours = in.GibibyteHours
+	return nil
+}
+
+// Convert_v1beta1_ProjectStorageUsage_To_core_ProjectStorageUsage is an autogenerated conversion function.
+func Convert_v1beta1_ProjectStorageUsage_To_core_ProjectStorageUsage(in *ProjectStorageUsage, out *core.ProjectStorageUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectStorageUsage_To_core_ProjectStorageUsage(in, out, s)
+}
+
+func autoConvert_core_ProjectStorageUsage_To_v1beta1_ProjectStorageUsage(in *core.ProjectStorageUsage, out *ProjectStorageUsage, s conversion.Scope) error {
+	out.Class = in.Class
+	out.GibibyteHours = in.GibibyteHours
+	return nil
+}
+
+// Convert_core_ProjectStorageUsage_To_v1beta1_ProjectStorageUsage is an autogenerated conversion function.
+func Convert_core_ProjectStorageUsage_To_v1beta1_ProjectStorageUsage(in *core.ProjectStorageUsage, out *ProjectStorageUsage, s conversion.Scope) error {
+	return autoConvert_core_ProjectStorageUsage_To_v1beta1_ProjectStorageUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectTolerations_To_core_ProjectTolerations(in *ProjectTolerations, out *core.ProjectTolerations, s conversion.Scope) error {
 	out.Defaults = *(*[]core.Toleration)(unsafe.Pointer(&in.Defaults))
 	out.Whitelist = *(*[]core.Toleration)(unsafe.Pointer(&in.Whitelist))
@@ -5252,6 +5614,42 @@ func Convert_core_ProjectTolerations_To_v1beta1_ProjectTolerations(in *core.Proj
 	return autoConvert_core_ProjectTolerations_To_v1beta1_ProjectTolerations(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectUsage_To_core_ProjectUsage(in *ProjectUsage, out *core.ProjectUsage, s conversion.Scope) error {
+	out.PeriodStartTime = in.PeriodStartTime
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Machines = *(*[]core.ProjectMachineUsage)(unsafe.Pointer(&in.Machines))
+	if err := Convert_v1beta1_ProjectShootUsage_To_core_ProjectShootUsage(&in.Shoots, &out.Shoots, s); err != nil {
+		return err
+	}
+	out.ControlPlanes = *(*[]core.ProjectControlPlaneUsage)(unsafe.Pointer(&in.ControlPlanes))
+	out.Storage = *(*[]core.ProjectStorageUsage)(unsafe.Pointer(&in.Storage))
+	out.CostUnits = (*resource.Quantity)(unsafe.Pointer(in.CostUnits))
+	return nil
+}
+
+// Convert_v1beta1_ProjectUsage_To_core_ProjectUsage is an autogenerated conversion function.
+func Convert_v1beta1_ProjectUsage_To_core_ProjectUsage(in *ProjectUsage, out *core.ProjectUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectUsage_To_core_ProjectUsage(in, out, s)
+}
+
+func autoConvert_core_ProjectUsage_To_v1beta1_ProjectUsage(in *core.ProjectUsage, out *ProjectUsage, s conversion.Scope) error {
+	out.PeriodStartTime = in.PeriodStartTime
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Machines = *(*[]ProjectMachineUsage)(unsafe.Pointer(&in.Machines))
+	if err := Convert_core_ProjectShootUsage_To_v1beta1_ProjectShootUsage(&in.Shoots, &out.Shoots, s); err != nil {
+		return err
+	}
+	out.ControlPlanes = *(*[]ProjectControlPlaneUsage)(unsafe.Pointer(&in.ControlPlanes))
+	out.Storage = *(*[]ProjectStorageUsage)(unsafe.Pointer(&in.Storage))
+	out.CostUnits = (*resource.Quantity)(unsafe.Pointer(in.CostUnits))
+	return nil
+}
+
+// Convert_core_ProjectUsage_To_v1beta1_ProjectUsage is an autogenerated conversion function.
+func Convert_core_ProjectUsage_To_v1beta1_ProjectUsage(in *core.ProjectUsage, out *ProjectUsage, s conversion.Scope) error {
+	return autoConvert_core_ProjectUsage_To_v1beta1_ProjectUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_Provider_To_core_Provider(in *Provider, out *core.Provider, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ControlPlaneConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ControlPlaneConfig))
@@ -5305,6 +5703,9 @@ func autoConvert_v1beta1_Quota_To_core_Quota(in *Quota, out *core.Quota, s conve
 	if err := Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5318,6 +5719,9 @@ func autoConvert_core_Quota_To_v1beta1_Quota(in *core.Quota, out *Quota, s conve
 	if err := Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5372,6 +5776,26 @@ func Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *QuotaS
 	return autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_v1beta1_QuotaStatus_To_core_QuotaStatus is an autogenerated conversion function.
+func Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in, out, s)
+}
+
+func autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_core_QuotaStatus_To_v1beta1_QuotaStatus is an autogenerated conversion function.
+func Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	return autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_Region_To_core_Region(in *Region, out *core.Region, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Zones = *(*[]core.AvailabilityZone)(unsafe.Pointer(&in.Zones))
@@ -6642,6 +7066,7 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.LastMaintenance = (*core.LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
 	out.Networking = (*core.NetworkingStatus)(unsafe.Pointer(in.Networking))
+	out.WorkerPools = *(*[]core.WorkerPoolStatus)(unsafe.Pointer(&in.WorkerPools))
 	return nil
 }
 
@@ -6672,6 +7097,7 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.LastMaintenance = (*LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
 	out.Networking = (*NetworkingStatus)(unsafe.Pointer(in.Networking))
+	out.WorkerPools = *(*[]WorkerPoolStatus)(unsafe.Pointer(&in.WorkerPools))
 	return nil
 }
 
@@ -6792,6 +7218,56 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 	return autoConvert_core_Toleration_To_v1beta1_Toleration(in, out, s)
 }
 
+func autoConvert_v1beta1_VersionRolloutStrategy_To_core_VersionRolloutStrategy(in *VersionRolloutStrategy, out *core.VersionRolloutStrategy, s conversion.Scope) error {
+	out.Waves = *(*[]core.VersionRolloutWave)(unsafe.Pointer(&in.Waves))
+	out.SoakDuration = (*metav1.Duration)(unsafe.Pointer(in.SoakDuration))
+	out.MaxUnhealthyPercentage = (*int32)(unsafe.Pointer(in.MaxUnhealthyPercentage))
+	return nil
+}
+
+// Convert_v1beta1_VersionRolloutStrategy_To_core_VersionRolloutStrategy is an autogenerated conversion function.
+func Convert_v1beta1_VersionRolloutStrategy_To_core_VersionRolloutStrategy(in *VersionRolloutStrategy, out *core.VersionRolloutStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionRolloutStrategy_To_core_VersionRolloutStrategy(in, out, s)
+}
+
+func autoConvert_core_VersionRolloutStrategy_To_v1beta1_VersionRolloutStrategy(in *core.VersionRolloutStrategy, out *VersionRolloutStrategy, s conversion.Scope) error {
+	out.Waves = *(*[]VersionRolloutWave)(unsafe.Pointer(&in.Waves))
+	out.SoakDuration = (*metav1.Duration)(unsafe.Pointer(in.SoakDuration))
+	out.MaxUnhealthyPercentage = (*int32)(unsafe.Pointer(in.MaxUnhealthyPercentage))
+	return nil
+}
+
+// Convert_core_VersionRolloutStrategy_To_v1beta1_VersionRolloutStrategy is an autogenerated conversion function.
+func Convert_core_VersionRolloutStrategy_To_v1beta1_VersionRolloutStrategy(in *core.VersionRolloutStrategy, out *VersionRolloutStrategy, s conversion.Scope) error {
+	return autoConvert_core_VersionRolloutStrategy_To_v1beta1_VersionRolloutStrategy(in, out, s)
+}
+
+func autoConvert_v1beta1_VersionRolloutWave_To_core_VersionRolloutWave(in *VersionRolloutWave, out *core.VersionRolloutWave, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ShootSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.Purposes = *(*[]core.ShootPurpose)(unsafe.Pointer(&in.Purposes))
+	out.Percentage = (*int32)(unsafe.Pointer(in.Percentage))
+	return nil
+}
+
+// Convert_v1beta1_VersionRolloutWave_To_core_VersionRolloutWave is an autogenerated conversion function.
+func Convert_v1beta1_VersionRolloutWave_To_core_VersionRolloutWave(in *VersionRolloutWave, out *core.VersionRolloutWave, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionRolloutWave_To_core_VersionRolloutWave(in, out, s)
+}
+
+func autoConvert_core_VersionRolloutWave_To_v1beta1_VersionRolloutWave(in *core.VersionRolloutWave, out *VersionRolloutWave, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ShootSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.Purposes = *(*[]ShootPurpose)(unsafe.Pointer(&in.Purposes))
+	out.Percentage = (*int32)(unsafe.Pointer(in.Percentage))
+	return nil
+}
+
+// Convert_core_VersionRolloutWave_To_v1beta1_VersionRolloutWave is an autogenerated conversion function.
+func Convert_core_VersionRolloutWave_To_v1beta1_VersionRolloutWave(in *core.VersionRolloutWave, out *VersionRolloutWave, s conversion.Scope) error {
+	return autoConvert_core_VersionRolloutWave_To_v1beta1_VersionRolloutWave(in, out, s)
+}
+
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
@@ -6953,6 +7429,7 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
 	out.ClusterAutoscaler = (*core.ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
+	out.UpdateStrategy = (*core.MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	return nil
 }
 
@@ -6994,6 +7471,7 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
 	out.ClusterAutoscaler = (*ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
+	out.UpdateStrategy = (*MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	return nil
 }
 
@@ -7040,6 +7518,28 @@ func Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in *core.WorkerKu
 	return autoConvert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus(in *WorkerPoolStatus, out *core.WorkerPoolStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Nodes = in.Nodes
+	return nil
+}
+
+// Convert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus is an autogenerated conversion function.
+func Convert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus(in *WorkerPoolStatus, out *core.WorkerPoolStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus(in, out, s)
+}
+
+func autoConvert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus(in *core.WorkerPoolStatus, out *WorkerPoolStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Nodes = in.Nodes
+	return nil
+}
+
+// Convert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus is an autogenerated conversion function.
+func Convert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus(in *core.WorkerPoolStatus, out *WorkerPoolStatus, s conversion.Scope) error {
+	return autoConvert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(in *WorkerSystemComponents, out *core.WorkerSystemComponents, s conversion.Scope) error {
 	out.Allow = in.Allow
 	return nil
@@ -51,6 +51,9 @@ func SetObjectDefaults_CloudProfile(in *CloudProfile) {
 		a := &in.Spec.VolumeTypes[i]
 		SetDefaults_VolumeType(a)
 	}
+	if in.Spec.VersionRolloutStrategy != nil {
+		SetDefaults_VersionRolloutStrategy(in.Spec.VersionRolloutStrategy)
+	}
 }
 
 func SetObjectDefaults_CloudProfileList(in *CloudProfileList) {
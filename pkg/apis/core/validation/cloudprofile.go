@@ -62,6 +62,7 @@ func ValidateCloudProfileSpec(spec *core.CloudProfileSpec, fldPath *field.Path)
 	allErrs = append(allErrs, validateVolumeTypes(spec.VolumeTypes, fldPath.Child("volumeTypes"))...)
 	allErrs = append(allErrs, validateCloudProfileRegions(spec.Regions, fldPath.Child("regions"))...)
 	allErrs = append(allErrs, validateCloudProfileBastion(spec, fldPath.Child("bastion"))...)
+	allErrs = append(allErrs, validateCloudProfileVersionRolloutStrategy(spec.VersionRolloutStrategy, fldPath.Child("versionRolloutStrategy"))...)
 	if spec.SeedSelector != nil {
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.SeedSelector.LabelSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("seedSelector"))...)
 	}
@@ -274,6 +275,66 @@ func validateCloudProfileBastion(spec *core.CloudProfileSpec, fldPath *field.Pat
 	return allErrs
 }
 
+func validateCloudProfileVersionRolloutStrategy(strategy *core.VersionRolloutStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if strategy == nil {
+		return allErrs
+	}
+
+	if len(strategy.Waves) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("waves"), "must provide at least one wave"))
+	}
+
+	waveNames := sets.New[string]()
+	for i, wave := range strategy.Waves {
+		idxPath := fldPath.Child("waves").Index(i)
+
+		if len(wave.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSLabel(wave.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), wave.Name, msg))
+			}
+
+			if waveNames.Has(wave.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), wave.Name))
+			}
+			waveNames.Insert(wave.Name)
+		}
+
+		if wave.ShootSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(wave.ShootSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, idxPath.Child("shootSelector"))...)
+		}
+
+		purposes := sets.New[core.ShootPurpose]()
+		for j, purpose := range wave.Purposes {
+			if !availableShootPurposes.Has(string(purpose)) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("purposes").Index(j), purpose, sets.List(availableShootPurposes)))
+			}
+
+			if purposes.Has(purpose) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("purposes").Index(j), purpose))
+			}
+			purposes.Insert(purpose)
+		}
+
+		if wave.Percentage != nil && (*wave.Percentage < 1 || *wave.Percentage > 100) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("percentage"), *wave.Percentage, "must be between 1 and 100"))
+		}
+	}
+
+	if strategy.SoakDuration != nil && strategy.SoakDuration.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("soakDuration"), strategy.SoakDuration.Duration.String(), "must be non-negative"))
+	}
+
+	if strategy.MaxUnhealthyPercentage != nil && (*strategy.MaxUnhealthyPercentage < 0 || *strategy.MaxUnhealthyPercentage > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnhealthyPercentage"), *strategy.MaxUnhealthyPercentage, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
+
 func validateBastionMachineType(bastionMachineType *core.BastionMachineType, machineTypes []core.MachineType, fldPath *field.Path) (*string, field.ErrorList) {
 	machineIndex := slices.IndexFunc(machineTypes, func(machineType core.MachineType) bool {
 		return machineType.Name == bastionMachineType.Name
@@ -927,6 +927,25 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					errorList := ValidateCloudProfile(cloudProfile)
 					Expect(errorList).To(BeEmpty())
 				})
+
+				It("should allow machine types with cost units", func() {
+					cloudProfile.Spec.MachineTypes = []core.MachineType{*machineType.DeepCopy()}
+					cloudProfile.Spec.MachineTypes[0].CostUnits = ptr.To(resource.MustParse("1.5"))
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid machine types with negative cost units", func() {
+					cloudProfile.Spec.MachineTypes = []core.MachineType{*machineType.DeepCopy()}
+					cloudProfile.Spec.MachineTypes[0].CostUnits = &negativeQuantity
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.machineTypes[0].costUnits"),
+					}))))
+				})
 			})
 
 			Context("regions validation", func() {
@@ -1174,6 +1193,86 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 				})
 			})
 
+			Context("version rollout strategy validation", func() {
+				It("should allow a valid version rollout strategy", func() {
+					cloudProfile.Spec.VersionRolloutStrategy = &core.VersionRolloutStrategy{
+						Waves: []core.VersionRolloutWave{
+							{Name: "canary", Purposes: []core.ShootPurpose{core.ShootPurposeEvaluation, core.ShootPurposeTesting}, Percentage: ptr.To[int32](10)},
+							{Name: "production", ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}},
+						},
+						SoakDuration:           &metav1.Duration{Duration: time.Hour},
+						MaxUnhealthyPercentage: ptr.To[int32](10),
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid a version rollout strategy without waves", func() {
+					cloudProfile.Spec.VersionRolloutStrategy = &core.VersionRolloutStrategy{}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.versionRolloutStrategy.waves"),
+					}))))
+				})
+
+				It("should forbid invalid values", func() {
+					cloudProfile.Spec.VersionRolloutStrategy = &core.VersionRolloutStrategy{
+						Waves: []core.VersionRolloutWave{
+							{Name: "canary", Purposes: []core.ShootPurpose{"foo", core.ShootPurposeTesting, core.ShootPurposeTesting}, Percentage: ptr.To[int32](0)},
+							{Name: "canary", ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "no/slash/allowed"}}},
+							{Name: "Invalid_Name", Percentage: ptr.To[int32](101)},
+							{},
+						},
+						SoakDuration:           &metav1.Duration{Duration: -time.Hour},
+						MaxUnhealthyPercentage: ptr.To[int32](101),
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.versionRolloutStrategy.waves[0].purposes[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.versionRolloutStrategy.waves[0].purposes[2]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.versionRolloutStrategy.waves[0].percentage"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.versionRolloutStrategy.waves[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.versionRolloutStrategy.waves[1].shootSelector.matchLabels"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.versionRolloutStrategy.waves[2].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.versionRolloutStrategy.waves[2].percentage"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.versionRolloutStrategy.waves[3].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.versionRolloutStrategy.soakDuration"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.versionRolloutStrategy.maxUnhealthyPercentage"),
+						})),
+					))
+				})
+			})
+
 			It("should forbid unsupported seed selectors", func() {
 				cloudProfile.Spec.SeedSelector.MatchLabels["foo"] = "no/slash/allowed"
 
@@ -79,6 +79,13 @@ func ValidateOCIRepository(oci *core.OCIRepository, fldPath *field.Path) field.E
 		return allErrs
 	}
 
+	if oci.PullSecretRef != nil && oci.PullSecretRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("pullSecretRef", "name"), "must provide the name of the pull secret"))
+	}
+	if oci.Verification != nil && oci.Verification.PublicKeysSecretRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("verification", "publicKeysSecretRef", "name"), "must provide the name of the secret containing the public keys"))
+	}
+
 	if ptr.Deref(oci.Ref, "") == "" && ptr.Deref(oci.Repository, "") == "" {
 		allErrs = append(allErrs, field.Required(fldPath, "must provide either ref or repository"))
 		return allErrs
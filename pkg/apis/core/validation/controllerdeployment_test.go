@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -192,6 +193,29 @@ var _ = Describe("#ValidateControllerDeployment", func() {
 					"Field": Equal("helm"),
 				}))))
 			})
+
+			It("should allow a pull secret and signature verification", func() {
+				controllerDeployment.Helm.OCIRepository.PullSecretRef = &corev1.LocalObjectReference{Name: "pull-secret"}
+				controllerDeployment.Helm.OCIRepository.Verification = &OCIVerification{PublicKeysSecretRef: corev1.LocalObjectReference{Name: "public-keys"}}
+
+				Expect(ValidateControllerDeployment(controllerDeployment)).To(BeEmpty())
+			})
+
+			It("should require the names of the referenced secrets", func() {
+				controllerDeployment.Helm.OCIRepository.PullSecretRef = &corev1.LocalObjectReference{}
+				controllerDeployment.Helm.OCIRepository.Verification = &OCIVerification{}
+
+				Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("helm.ociRepository.pullSecretRef.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("helm.ociRepository.verification.publicKeysSecretRef.name"),
+					})),
+				))
+			})
 		})
 
 		Context("with ociRepository.ref", func() {
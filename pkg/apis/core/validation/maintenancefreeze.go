@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// maxMaintenanceFreezeReasonLength is the maximum length of the reason of a maintenance freeze window. The reason is
+// included in event messages, hence it is kept short.
+const maxMaintenanceFreezeReasonLength = 256
+
+// ValidateMaintenanceFreeze validates a MaintenanceFreeze object.
+func ValidateMaintenanceFreeze(maintenanceFreeze *core.MaintenanceFreeze) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&maintenanceFreeze.ObjectMeta, false, ValidateName, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateMaintenanceFreezeSpec(&maintenanceFreeze.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateMaintenanceFreezeUpdate validates a MaintenanceFreeze object before an update.
+func ValidateMaintenanceFreezeUpdate(newMaintenanceFreeze, oldMaintenanceFreeze *core.MaintenanceFreeze) field.ErrorList {
+	allErrs := apivalidation.ValidateObjectMetaUpdate(&newMaintenanceFreeze.ObjectMeta, &oldMaintenanceFreeze.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateMaintenanceFreeze(newMaintenanceFreeze)...)
+	return allErrs
+}
+
+// ValidateMaintenanceFreezeSpec validates the specification of a MaintenanceFreeze object.
+func ValidateMaintenanceFreezeSpec(spec *core.MaintenanceFreezeSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Windows) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("windows"), "at least one window must be specified"))
+	}
+
+	for i, window := range spec.Windows {
+		idxPath := fldPath.Child("windows").Index(i)
+
+		if window.Begin.IsZero() {
+			allErrs = append(allErrs, field.Required(idxPath.Child("begin"), "must specify the begin of the window"))
+		}
+		if window.End.IsZero() {
+			allErrs = append(allErrs, field.Required(idxPath.Child("end"), "must specify the end of the window"))
+		}
+		if !window.Begin.IsZero() && !window.End.IsZero() && !window.End.After(window.Begin.Time) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), window.End, "end must be after begin"))
+		}
+		if window.Reason != nil && len(*window.Reason) > maxMaintenanceFreezeReasonLength {
+			allErrs = append(allErrs, field.TooLong(idxPath.Child("reason"), *window.Reason, maxMaintenanceFreezeReasonLength))
+		}
+	}
+
+	if spec.ShootSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ShootSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("shootSelector"))...)
+	}
+	if spec.ProjectSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ProjectSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("projectSelector"))...)
+	}
+
+	return allErrs
+}
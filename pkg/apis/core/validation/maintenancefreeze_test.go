@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("MaintenanceFreeze Validation Tests", func() {
+	var (
+		begin             = metav1.NewTime(time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC))
+		end               = metav1.NewTime(time.Date(2027, 1, 6, 0, 0, 0, 0, time.UTC))
+		maintenanceFreeze *core.MaintenanceFreeze
+	)
+
+	BeforeEach(func() {
+		maintenanceFreeze = &core.MaintenanceFreeze{
+			ObjectMeta: metav1.ObjectMeta{Name: "holidays"},
+			Spec: core.MaintenanceFreezeSpec{
+				Windows: []core.MaintenanceFreezeWindow{{Begin: begin, End: end, Reason: ptr.To("Winter holidays")}},
+				ShootSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"environment": "production"},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateMaintenanceFreeze", func() {
+		It("should not return any errors", func() {
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(BeEmpty())
+		})
+
+		It("should forbid a namespace", func() {
+			maintenanceFreeze.Namespace = "garden"
+
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("metadata.namespace"),
+				})),
+			))
+		})
+
+		It("should require at least one window", func() {
+			maintenanceFreeze.Spec.Windows = nil
+
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.windows"),
+				})),
+			))
+		})
+
+		It("should require begin and end of windows", func() {
+			maintenanceFreeze.Spec.Windows = []core.MaintenanceFreezeWindow{{}}
+
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.windows[0].begin"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.windows[0].end"),
+				})),
+			))
+		})
+
+		It("should forbid windows ending before they begin", func() {
+			maintenanceFreeze.Spec.Windows = append(maintenanceFreeze.Spec.Windows,
+				core.MaintenanceFreezeWindow{Begin: end, End: begin},
+				core.MaintenanceFreezeWindow{Begin: begin, End: begin},
+			)
+
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.windows[1].end"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.windows[2].end"),
+				})),
+			))
+		})
+
+		It("should forbid too long reasons", func() {
+			maintenanceFreeze.Spec.Windows[0].Reason = ptr.To(strings.Repeat("a", 257))
+
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooLong),
+					"Field": Equal("spec.windows[0].reason"),
+				})),
+			))
+		})
+
+		It("should forbid invalid selectors", func() {
+			maintenanceFreeze.Spec.ShootSelector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "environment", Operator: "foo"}}
+			maintenanceFreeze.Spec.ProjectSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "cost-center", Operator: metav1.LabelSelectorOpIn}},
+			}
+
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.shootSelector.matchExpressions[0].operator"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.projectSelector.matchExpressions[0].values"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateMaintenanceFreezeUpdate", func() {
+		It("should allow changing the windows", func() {
+			newMaintenanceFreeze := maintenanceFreeze.DeepCopy()
+			newMaintenanceFreeze.ResourceVersion = "1"
+			maintenanceFreeze.ResourceVersion = "1"
+			newMaintenanceFreeze.Spec.Windows[0].End = metav1.NewTime(end.Add(24 * time.Hour))
+
+			Expect(ValidateMaintenanceFreezeUpdate(newMaintenanceFreeze, maintenanceFreeze)).To(BeEmpty())
+		})
+
+		It("should validate the new object", func() {
+			newMaintenanceFreeze := maintenanceFreeze.DeepCopy()
+			newMaintenanceFreeze.ResourceVersion = "1"
+			maintenanceFreeze.ResourceVersion = "1"
+			newMaintenanceFreeze.Spec.Windows = nil
+
+			Expect(ValidateMaintenanceFreezeUpdate(newMaintenanceFreeze, maintenanceFreeze)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.windows"),
+				})),
+			))
+		})
+	})
+})
@@ -34,6 +34,22 @@ func ValidateQuotaUpdate(newQuota, oldQuota *core.Quota) field.ErrorList {
 	return allErrs
 }
 
+// ValidateQuotaStatusUpdate validates the status field of a Quota object.
+func ValidateQuotaStatusUpdate(newQuota, _ *core.Quota) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	usedFldPath := field.NewPath("status", "used")
+	for k, v := range newQuota.Status.Used {
+		keyPath := usedFldPath.Key(string(k))
+		if !isValidQuotaMetric(k) {
+			allErrs = append(allErrs, field.Invalid(keyPath, v.String(), fmt.Sprintf("%s is no supported quota metric", string(k))))
+		}
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, keyPath)...)
+	}
+
+	return allErrs
+}
+
 // ValidateQuotaSpec validates the specification of a Quota object.
 func ValidateQuotaSpec(quotaSpec *core.QuotaSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -63,7 +79,11 @@ func isValidQuotaMetric(metric corev1.ResourceName) bool {
 		core.QuotaMetricMemory,
 		core.QuotaMetricStorageStandard,
 		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer:
+		core.QuotaMetricLoadbalancer,
+		core.QuotaMetricShoots,
+		core.QuotaMetricNodes,
+		core.QuotaMetricControlPlanesHighAvailability,
+		core.QuotaMetricCostUnits:
 		return true
 	}
 	return false
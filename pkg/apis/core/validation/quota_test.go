@@ -120,6 +120,17 @@ var _ = Describe("Quota Validation Tests ", func() {
 			))
 		})
 
+		It("should allow the resource-based quota metrics", func() {
+			quota.Spec.Metrics["shoots"] = resource.MustParse("10")
+			quota.Spec.Metrics["nodes"] = resource.MustParse("100")
+			quota.Spec.Metrics["controlplanes.highavailability"] = resource.MustParse("2")
+			quota.Spec.Metrics["costunits"] = resource.MustParse("1000")
+
+			errorList := ValidateQuota(quota)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
 		It("should allow quota scope referencing WorkloadIdentity", func() {
 			quota.Spec.Scope = corev1.ObjectReference{
 				Kind:       "WorkloadIdentity",
@@ -130,4 +141,47 @@ var _ = Describe("Quota Validation Tests ", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 	})
+
+	Describe("#ValidateQuotaStatusUpdate", func() {
+		var quota *core.Quota
+
+		BeforeEach(func() {
+			quota = &core.Quota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "quota-1",
+					Namespace: "my-namespace",
+				},
+			}
+		})
+
+		It("should allow valid usages", func() {
+			newQuota := quota.DeepCopy()
+			newQuota.Status.Used = corev1.ResourceList{
+				"cpu":       resource.MustParse("20"),
+				"shoots":    resource.MustParse("2"),
+				"costunits": resource.MustParse("12.5"),
+			}
+
+			Expect(ValidateQuotaStatusUpdate(newQuota, quota)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported metrics and negative usages", func() {
+			newQuota := quota.DeepCopy()
+			newQuota.Status.Used = corev1.ResourceList{
+				"foo":   resource.MustParse("1"),
+				"nodes": resource.MustParse("-1"),
+			}
+
+			Expect(ValidateQuotaStatusUpdate(newQuota, quota)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.used[foo]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.used[nodes]"),
+				})),
+			))
+		})
+	})
 })
@@ -96,6 +96,10 @@ var (
 	availableWorkerCRINames = sets.New(
 		string(core.CRINameContainerD),
 	)
+	availableMachineUpdateStrategies = sets.New(
+		string(core.AutoRollingUpdate),
+		string(core.AutoInPlaceUpdate),
+	)
 	availableClusterAutoscalerExpanderModes = sets.New(
 		string(core.ClusterAutoscalerExpanderLeastWaste),
 		string(core.ClusterAutoscalerExpanderMostPods),
@@ -369,6 +373,12 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 
 		// worker kubernetes versions must not be downgraded and but can skip minor versions
 		allErrs = append(allErrs, ValidateKubernetesVersionUpdate(newKubernetesVersion, oldKubernetesVersion, true, idxPath.Child("kubernetes", "version"))...)
+
+		// nodes of in-place worker pools are not replaced, hence the update strategy must not be switched from or to
+		// in-place updates
+		if isInPlaceUpdateStrategy(oldWorker.UpdateStrategy) != isInPlaceUpdateStrategy(newWorker.UpdateStrategy) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("updateStrategy"), fmt.Sprintf("cannot switch the update strategy from or to %q", core.AutoInPlaceUpdate)))
+		}
 	}
 
 	allErrs = append(allErrs, validateNetworkingUpdate(newSpec.Networking, oldSpec.Networking, fldPath.Child("networking"))...)
@@ -383,6 +393,10 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 	return allErrs
 }
 
+func isInPlaceUpdateStrategy(updateStrategy *core.MachineUpdateStrategy) bool {
+	return ptr.Deref(updateStrategy, "") == core.AutoInPlaceUpdate
+}
+
 func validateWorkerUpdate(newHasWorkers, oldHasWorkers bool, fldPath *field.Path) *field.Error {
 	if oldHasWorkers && !newHasWorkers {
 		return field.Forbidden(fldPath, "cannot switch from a Shoot with workers to a workerless Shoot")
@@ -421,10 +435,28 @@ func ValidateShootStatusUpdate(newStatus, oldStatus core.ShootStatus) field.Erro
 	}
 
 	allErrs = append(allErrs, validateNetworkingStatus(newStatus.Networking, fldPath.Child("networking"))...)
+	allErrs = append(allErrs, validateWorkerPoolStatuses(newStatus.WorkerPools, fldPath.Child("workerPools"))...)
 
 	return allErrs
 }
 
+func validateWorkerPoolStatuses(workerPools []core.WorkerPoolStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+	for i, workerPool := range workerPools {
+		idxPath := fldPath.Index(i)
+		if workerPool.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "field must not be empty"))
+		} else if names.Has(workerPool.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), workerPool.Name))
+		} else {
+			names.Insert(workerPool.Name)
+		}
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(workerPool.Nodes), idxPath.Child("nodes"))...)
+	}
+	return allErrs
+}
+
 // validateAdvertiseAddresses validates kube-apiserver addresses.
 func validateAdvertiseAddresses(addresses []core.ShootAdvertisedAddress, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -1808,6 +1840,10 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 		allErrs = append(allErrs, ValidateClusterAutoscalerOptions(worker.ClusterAutoscaler, fldPath.Child("autoscaler"))...)
 	}
 
+	if worker.UpdateStrategy != nil && !availableMachineUpdateStrategies.Has(string(*worker.UpdateStrategy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("updateStrategy"), *worker.UpdateStrategy, sets.List(availableMachineUpdateStrategies)))
+	}
+
 	return allErrs
 }
 
@@ -2261,6 +2297,36 @@ func ValidateHibernationSchedule(seenSpecs sets.Set[string], schedule *core.Hibe
 	if schedule.Location != nil {
 		allErrs = append(allErrs, ValidateHibernationScheduleLocation(*schedule.Location, fldPath.Child("location"))...)
 	}
+	if len(schedule.Exceptions) > 0 {
+		if schedule.Start == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("exceptions"), "exceptions can only be specified if start is provided"))
+		}
+		allErrs = append(allErrs, ValidateHibernationScheduleExceptions(schedule.Exceptions, fldPath.Child("exceptions"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateHibernationScheduleExceptions validates that the exception dates of a HibernationSchedule are correct.
+func ValidateHibernationScheduleExceptions(exceptions []string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		seen    = sets.New[string]()
+	)
+
+	for i, exception := range exceptions {
+		idxPath := fldPath.Index(i)
+
+		if _, err := time.Parse(time.DateOnly, exception); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, exception, "not a valid date, expected format YYYY-MM-DD"))
+			continue
+		}
+
+		if seen.Has(exception) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, exception))
+		}
+		seen.Insert(exception)
+	}
 
 	return allErrs
 }
@@ -2485,9 +2551,21 @@ func validateShootOperation(operation, maintenanceOperation string, shoot *core.
 	}
 
 	if operation != "" {
-		if !availableShootOperations.Has(operation) && !strings.HasPrefix(operation, v1beta1constants.OperationRotateRolloutWorkers) {
+		if !availableShootOperations.Has(operation) &&
+			!strings.HasPrefix(operation, v1beta1constants.OperationRotateRolloutWorkers) &&
+			!strings.HasPrefix(operation, v1beta1constants.ShootOperationRestoreShootStateRevision) {
 			allErrs = append(allErrs, field.NotSupported(fldPathOp, operation, sets.List(availableShootOperations)))
 		}
+		if strings.HasPrefix(operation, v1beta1constants.ShootOperationRestoreShootStateRevision) {
+			revision, ok := strings.CutPrefix(operation, v1beta1constants.ShootOperationRestoreShootStateRevision+"=")
+			if !ok || len(revision) == 0 {
+				allErrs = append(allErrs, field.Required(fldPathOp, "must provide the name of a ShootState revision via "+v1beta1constants.ShootOperationRestoreShootStateRevision+"=<revision>"))
+			} else if timestamp, ok := strings.CutPrefix(revision, shoot.Name+v1beta1constants.ShootStateRevisionNameInfix); !ok {
+				allErrs = append(allErrs, field.Invalid(fldPathOp, operation, "must reference a ShootState revision of this shoot"))
+			} else if _, err := strconv.ParseUint(timestamp, 10, 64); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPathOp, operation, "must reference a ShootState revision named "+shoot.Name+v1beta1constants.ShootStateRevisionNameInfix+"<unix-timestamp>"))
+			}
+		}
 		if helper.IsShootInHibernation(shoot) &&
 			(forbiddenShootOperationsWhenHibernated.Has(operation) || strings.HasPrefix(operation, v1beta1constants.OperationRotateRolloutWorkers)) {
 			allErrs = append(allErrs, field.Forbidden(fldPathOp, "operation is not permitted when shoot is hibernated or is waking up"))
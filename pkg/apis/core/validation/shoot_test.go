@@ -3349,6 +3349,63 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})
 		})
 
+		Context("worker pool update strategy", func() {
+			It("should allow supported update strategies", func() {
+				shoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.AutoRollingUpdate)
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, *shoot.Spec.Provider.Workers[0].DeepCopy())
+				shoot.Spec.Provider.Workers[1].Name = "in-place"
+				shoot.Spec.Provider.Workers[1].UpdateStrategy = ptr.To(core.AutoInPlaceUpdate)
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid unsupported update strategies", func() {
+				shoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.MachineUpdateStrategy("foo"))
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.provider.workers[0].updateStrategy"),
+				}))))
+			})
+
+			It("should allow setting the rolling update strategy explicitly", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.AutoRollingUpdate)
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should allow adding a worker pool with in-place update strategy", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers = append(newShoot.Spec.Provider.Workers, *newShoot.Spec.Provider.Workers[0].DeepCopy())
+				newShoot.Spec.Provider.Workers[1].Name = "in-place"
+				newShoot.Spec.Provider.Workers[1].UpdateStrategy = ptr.To(core.AutoInPlaceUpdate)
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should forbid switching to the in-place update strategy", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.AutoInPlaceUpdate)
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.provider.workers[0].updateStrategy"),
+				}))))
+			})
+
+			It("should forbid switching from the in-place update strategy", func() {
+				shoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.AutoInPlaceUpdate)
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.AutoRollingUpdate)
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.provider.workers[0].updateStrategy"),
+				}))))
+			})
+		})
+
 		Context("worker pool kubernetes version", func() {
 			It("should forbid worker pool kubernetes version higher than control plane", func() {
 				newShoot := prepareShootForUpdate(shoot)
@@ -5136,6 +5193,70 @@ var _ = Describe("Shoot Validation Tests", func() {
 				})
 			})
 
+			Context("restore ShootState revision", func() {
+				It("should allow restoring a ShootState revision of the shoot", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "restore-shootstate-revision="+shoot.Name+"--rev-1700000000")
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid restoring a ShootState revision without stating the revision", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "restore-shootstate-revision=")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeRequired),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("must provide the name of a ShootState revision via restore-shootstate-revision=<revision>"),
+					}))))
+				})
+
+				It("should forbid restoring a ShootState revision of another shoot", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "restore-shootstate-revision=other--rev-1700000000")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("must reference a ShootState revision of this shoot"),
+					}))))
+				})
+
+				It("should forbid restoring a ShootState of another shoot sharing the name prefix", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "restore-shootstate-revision="+shoot.Name+"--other")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("must reference a ShootState revision of this shoot"),
+					}))))
+				})
+
+				DescribeTable("should forbid restoring a ShootState revision without a valid timestamp",
+					func(suffix string) {
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "restore-shootstate-revision="+shoot.Name+"--rev-"+suffix)
+
+						Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+							"Detail": Equal("must reference a ShootState revision named " + shoot.Name + "--rev-<unix-timestamp>"),
+						}))))
+					},
+
+					Entry("empty", ""),
+					Entry("non-numeric", "foo"),
+					Entry("negative", "-1700000000"),
+					Entry("with further suffix", "1700000000-foo"),
+				)
+
+				It("should forbid restoring a ShootState revision as maintenance operation", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/operation", "restore-shootstate-revision="+shoot.Name+"--rev-1700000000")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("metadata.annotations[maintenance.gardener.cloud/operation]"),
+					}))))
+				})
+			})
+
 			DescribeTable("forbid certain rotation operations when shoot is waking up",
 				func(operation string) {
 					shoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(false)}
@@ -5658,6 +5779,33 @@ var _ = Describe("Shoot Validation Tests", func() {
 				}))
 			})
 		})
+
+		Context("validate shoot worker pool status", func() {
+			It("should allow valid worker pools", func() {
+				newShoot.Status.WorkerPools = []core.WorkerPoolStatus{{Name: "pool-1", Nodes: 3}, {Name: "pool-2"}}
+
+				Expect(ValidateShootStatusUpdate(newShoot.Status, shoot.Status)).To(BeEmpty())
+			})
+
+			It("should forbid empty or duplicate names and negative node counts", func() {
+				newShoot.Status.WorkerPools = []core.WorkerPoolStatus{{Name: "pool-1"}, {Name: "pool-1"}, {Nodes: -1}}
+
+				Expect(ValidateShootStatusUpdate(newShoot.Status, shoot.Status)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("status.workerPools[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("status.workerPools[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("status.workerPools[2].nodes"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateWorker", func() {
@@ -7099,6 +7247,33 @@ var _ = Describe("Shoot Validation Tests", func() {
 						"Field": Equal(field.NewPath("end").String()),
 					})),
 				)),
+			Entry("valid exceptions", sets.New[string](), &core.HibernationSchedule{Start: ptr.To("1 * * * *"), End: ptr.To("2 * * * *"), Exceptions: []string{"2024-12-24", "2024-12-31"}}, BeEmpty()),
+			Entry("exceptions without start", sets.New[string](), &core.HibernationSchedule{End: ptr.To("2 * * * *"), Exceptions: []string{"2024-12-24"}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal(field.NewPath("exceptions").String()),
+			})))),
+		)
+	})
+
+	Describe("#ValidateHibernationScheduleExceptions", func() {
+		DescribeTable("validate hibernation schedule exceptions",
+			func(exceptions []string, matcher gomegatypes.GomegaMatcher) {
+				Expect(ValidateHibernationScheduleExceptions(exceptions, field.NewPath("exceptions"))).To(matcher)
+			},
+			Entry("nil exceptions", nil, BeEmpty()),
+			Entry("valid dates", []string{"2024-02-29", "2025-01-01"}, BeEmpty()),
+			Entry("invalid date", []string{"2025-02-29"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("exceptions[0]"),
+			})))),
+			Entry("wrong format", []string{"2025-01-01", "01/02/2025"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("exceptions[1]"),
+			})))),
+			Entry("duplicate dates", []string{"2025-01-01", "2025-01-01"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("exceptions[1]"),
+			})))),
 		)
 	})
 
@@ -278,6 +278,7 @@ func validateMachineTypes(machineTypes []core.MachineType, fldPath *field.Path)
 		gpuPath := idxPath.Child("gpu")
 		memoryPath := idxPath.Child("memory")
 		archPath := idxPath.Child("architecture")
+		costUnitsPath := idxPath.Child("costUnits")
 
 		if len(machineType.Name) == 0 {
 			allErrs = append(allErrs, field.Required(namePath, "must provide a name"))
@@ -294,6 +295,10 @@ func validateMachineTypes(machineTypes []core.MachineType, fldPath *field.Path)
 		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("memory", machineType.Memory, memoryPath)...)
 		allErrs = append(allErrs, validateMachineTypeArchitecture(machineType.Architecture, archPath)...)
 
+		if machineType.CostUnits != nil {
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("costUnits", *machineType.CostUnits, costUnitsPath)...)
+		}
+
 		if machineType.Storage != nil {
 			allErrs = append(allErrs, validateMachineTypeStorage(*machineType.Storage, idxPath.Child("storage"))...)
 		}
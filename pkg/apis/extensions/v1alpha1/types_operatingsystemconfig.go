@@ -85,6 +85,18 @@ type OperatingSystemConfigSpec struct {
 	// +patchStrategy=merge
 	// +optional
 	Files []File `json:"files,omitempty" patchStrategy:"merge" patchMergeKey:"path"`
+	// InPlaceUpdates contains the configuration for in-place updates. It is only set for worker pools with the
+	// 'AutoInPlaceUpdate' update strategy.
+	// +optional
+	InPlaceUpdates *InPlaceUpdates `json:"inPlaceUpdates,omitempty"`
+}
+
+// InPlaceUpdates contains the configuration for in-place updates.
+type InPlaceUpdates struct {
+	// OperatingSystemVersion is the version of the operating system which should be installed on the machines.
+	OperatingSystemVersion string `json:"operatingSystemVersion"`
+	// KubeletVersion is the version of the kubelet which should be installed on the machines.
+	KubeletVersion string `json:"kubeletVersion"`
 }
 
 // Unit is a unit for the operating system configuration (usually, a systemd unit).
@@ -203,6 +215,27 @@ type OperatingSystemConfigStatus struct {
 	// After Gardener v1.112, this will be only set for OperatingSystemConfigs with purpose 'provision'.
 	// +optional
 	CloudConfig *CloudConfig `json:"cloudConfig,omitempty"`
+	// InPlaceUpdates contains the configuration provided by the extension for in-place updates.
+	// +optional
+	InPlaceUpdates *InPlaceUpdatesStatus `json:"inPlaceUpdates,omitempty"`
+}
+
+// InPlaceUpdatesStatus contains the configuration provided by the extension for in-place updates.
+type InPlaceUpdatesStatus struct {
+	// OSUpdate defines how the operating system of the machines is updated in-place.
+	// +optional
+	OSUpdate *OSUpdate `json:"osUpdate,omitempty"`
+}
+
+// OSUpdate contains the command for updating the operating system of a machine in-place.
+type OSUpdate struct {
+	// Command is the command which updates the operating system to the version in
+	// `.spec.inPlaceUpdates.operatingSystemVersion`. It is executed by gardener-node-agent after the node has been
+	// drained. It must be idempotent, i.e., it must succeed if the operating system is already up-to-date.
+	Command string `json:"command"`
+	// Args are the arguments passed to the command.
+	// +optional
+	Args []string `json:"args,omitempty"`
 }
 
 // CloudConfig contains the generated output for the given operating system
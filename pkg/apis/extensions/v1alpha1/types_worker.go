@@ -167,6 +167,9 @@ type WorkerPool struct {
 	// Priority (or weight) is the importance by which this worker pool will be scaled by cluster autoscaling.
 	// +optional
 	Priority *int32 `json:"priority,omitempty"`
+	// UpdateStrategy specifies the machine update strategy for the worker pool.
+	// +optional
+	UpdateStrategy *gardencorev1beta1.MachineUpdateStrategy `json:"updateStrategy,omitempty"`
 }
 
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
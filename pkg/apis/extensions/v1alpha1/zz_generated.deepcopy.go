@@ -1198,6 +1198,43 @@ func (in *FileContentSecretRef) DeepCopy() *FileContentSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InPlaceUpdates) DeepCopyInto(out *InPlaceUpdates) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InPlaceUpdates.
+func (in *InPlaceUpdates) DeepCopy() *InPlaceUpdates {
+	if in == nil {
+		return nil
+	}
+	out := new(InPlaceUpdates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InPlaceUpdatesStatus) DeepCopyInto(out *InPlaceUpdatesStatus) {
+	*out = *in
+	if in.OSUpdate != nil {
+		in, out := &in.OSUpdate, &out.OSUpdate
+		*out = new(OSUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InPlaceUpdatesStatus.
+func (in *InPlaceUpdatesStatus) DeepCopy() *InPlaceUpdatesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InPlaceUpdatesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Infrastructure) DeepCopyInto(out *Infrastructure) {
 	*out = *in
@@ -1505,6 +1542,27 @@ func (in *NodeTemplate) DeepCopy() *NodeTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSUpdate) DeepCopyInto(out *OSUpdate) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSUpdate.
+func (in *OSUpdate) DeepCopy() *OSUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(OSUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatingSystemConfig) DeepCopyInto(out *OperatingSystemConfig) {
 	*out = *in
@@ -1589,6 +1647,11 @@ func (in *OperatingSystemConfigSpec) DeepCopyInto(out *OperatingSystemConfigSpec
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InPlaceUpdates != nil {
+		in, out := &in.InPlaceUpdates, &out.InPlaceUpdates
+		*out = new(InPlaceUpdates)
+		**out = **in
+	}
 	return
 }
 
@@ -1625,6 +1688,11 @@ func (in *OperatingSystemConfigStatus) DeepCopyInto(out *OperatingSystemConfigSt
 		*out = new(CloudConfig)
 		**out = **in
 	}
+	if in.InPlaceUpdates != nil {
+		in, out := &in.InPlaceUpdates, &out.InPlaceUpdates
+		*out = new(InPlaceUpdatesStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1951,6 +2019,11 @@ func (in *WorkerPool) DeepCopyInto(out *WorkerPool) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(v1beta1.MachineUpdateStrategy)
+		**out = **in
+	}
 	return
 }
 
@@ -260,8 +260,29 @@ type ManagedResourceSpec struct {
 	// resource, should also be deleted when the corresponding StatefulSet is deleted (defaults to false).
 	// +optional
 	DeletePersistentVolumeClaims *bool `json:"deletePersistentVolumeClaims,omitempty"`
+	// ApplyMode specifies how the objects are applied to the target cluster. With 'Update' (default), the objects are
+	// merged with their current state and updated. With 'ServerSideApply', the objects are applied with server-side apply
+	// using a field manager scoped to this ManagedResource.
+	// +kubebuilder:validation:Enum=Update;ServerSideApply
+	// +optional
+	ApplyMode *ApplyMode `json:"applyMode,omitempty"`
+	// ForceConflicts specifies whether fields which are managed by other field managers should be taken over when
+	// applying the objects. Conflicts are reported in the `ResourcesApplied` condition otherwise. Only relevant if
+	// ApplyMode is 'ServerSideApply'. Defaults to false.
+	// +optional
+	ForceConflicts *bool `json:"forceConflicts,omitempty"`
 }
 
+// ApplyMode is a type for the modes of applying the objects of a ManagedResource.
+type ApplyMode string
+
+const (
+	// ApplyModeUpdate is a constant for the apply mode merging the objects with their current state and updating them.
+	ApplyModeUpdate ApplyMode = "Update"
+	// ApplyModeServerSideApply is a constant for the apply mode applying the objects with server-side apply.
+	ApplyModeServerSideApply ApplyMode = "ServerSideApply"
+)
+
 // ManagedResourceStatus is the status of a managed resource.
 type ManagedResourceStatus struct {
 	Conditions []gardencorev1beta1.Condition `json:"conditions,omitempty"`
@@ -301,6 +322,9 @@ const (
 	// ConditionApplyFailed indicates that the `ResourcesApplied` condition is `False`,
 	// because applying the resources failed.
 	ConditionApplyFailed = "ApplyFailed"
+	// ConditionApplyConflict indicates that the `ResourcesApplied` condition is `False`,
+	// because applying the resources with server-side apply caused conflicts with other field managers.
+	ConditionApplyConflict = "ApplyConflict"
 	// ConditionDecodingFailed indicates that the `ResourcesApplied` condition is `False`,
 	// because decoding the resources of the ManagedResource failed.
 	ConditionDecodingFailed = "DecodingFailed"
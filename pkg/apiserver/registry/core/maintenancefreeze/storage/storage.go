@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apiserver/registry/core/maintenancefreeze"
+)
+
+// REST implements a RESTStorage for MaintenanceFreeze.
+type REST struct {
+	*genericregistry.Store
+}
+
+// MaintenanceFreezeStorage implements the storage for MaintenanceFreeze.
+type MaintenanceFreezeStorage struct {
+	MaintenanceFreeze *REST
+}
+
+// NewStorage creates a new MaintenanceFreezeStorage object.
+func NewStorage(optsGetter generic.RESTOptionsGetter) MaintenanceFreezeStorage {
+	return MaintenanceFreezeStorage{
+		MaintenanceFreeze: NewREST(optsGetter),
+	}
+}
+
+// NewREST returns a RESTStorage object that will work with MaintenanceFreeze objects.
+func NewREST(optsGetter generic.RESTOptionsGetter) *REST {
+	maintenanceFreezeStrategy := maintenancefreeze.NewStrategy()
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &core.MaintenanceFreeze{} },
+		NewListFunc:               func() runtime.Object { return &core.MaintenanceFreezeList{} },
+		DefaultQualifiedResource:  core.Resource("maintenancefreezes"),
+		SingularQualifiedResource: core.Resource("maintenancefreeze"),
+		EnableGarbageCollection:   true,
+
+		CreateStrategy: maintenanceFreezeStrategy,
+		UpdateStrategy: maintenanceFreezeStrategy,
+		DeleteStrategy: maintenanceFreezeStrategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+
+	options := &generic.StoreOptions{RESTOptions: optsGetter}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err)
+	}
+	return &REST{store}
+}
+
+// Implement ShortNamesProvider.
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"mfreeze"}
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancefreeze
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+// MaintenanceFreezeStrategy define the strategy for storing maintenancefreezes.
+type MaintenanceFreezeStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy return a storage strategy for maintenancefreezes.
+func NewStrategy() MaintenanceFreezeStrategy {
+	return MaintenanceFreezeStrategy{
+		api.Scheme,
+		names.SimpleNameGenerator,
+	}
+}
+
+// NamespaceScoped indicates if the object is namespaced scoped.
+func (MaintenanceFreezeStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate mutates the object before creation.
+// It is called before Validate.
+func (MaintenanceFreezeStrategy) PrepareForCreate(_ context.Context, _ runtime.Object) {
+}
+
+// PrepareForUpdate allows to modify an object before it get stored.
+// It is called before ValidateUpdate.
+func (MaintenanceFreezeStrategy) PrepareForUpdate(_ context.Context, _, _ runtime.Object) {
+}
+
+// Validate allow to validate the object.
+func (MaintenanceFreezeStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	maintenanceFreeze := obj.(*core.MaintenanceFreeze)
+	return validation.ValidateMaintenanceFreeze(maintenanceFreeze)
+}
+
+// ValidateUpdate validates the update on the object.
+// The old and the new version of the object are passed in.
+func (MaintenanceFreezeStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldMaintenanceFreeze, newMaintenanceFreeze := oldObj.(*core.MaintenanceFreeze), newObj.(*core.MaintenanceFreeze)
+	return validation.ValidateMaintenanceFreezeUpdate(newMaintenanceFreeze, oldMaintenanceFreeze)
+}
+
+// Canonicalize can be used to transform the object into its canonical format.
+func (MaintenanceFreezeStrategy) Canonicalize(_ runtime.Object) {
+}
+
+// AllowCreateOnUpdate indicates if the object can be created via a PUT operation.
+func (MaintenanceFreezeStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate indicates if the object can be updated
+// independently of the resource version.
+func (MaintenanceFreezeStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (MaintenanceFreezeStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (MaintenanceFreezeStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
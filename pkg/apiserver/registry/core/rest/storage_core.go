@@ -25,6 +25,7 @@ import (
 	controllerregistrationstore "github.com/gardener/gardener/pkg/apiserver/registry/core/controllerregistration/storage"
 	exposureclassstore "github.com/gardener/gardener/pkg/apiserver/registry/core/exposureclass/storage"
 	internalsecretstore "github.com/gardener/gardener/pkg/apiserver/registry/core/internalsecret/storage"
+	maintenancefreezestore "github.com/gardener/gardener/pkg/apiserver/registry/core/maintenancefreeze/storage"
 	namespacedcloudprofilestore "github.com/gardener/gardener/pkg/apiserver/registry/core/namespacedcloudprofile/storage"
 	projectstore "github.com/gardener/gardener/pkg/apiserver/registry/core/project/storage"
 	quotastore "github.com/gardener/gardener/pkg/apiserver/registry/core/quota/storage"
@@ -99,12 +100,16 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 
 	storage["internalsecrets"] = internalsecretstore.NewREST(restOptionsGetter)
 
+	maintenanceFreezeStorage := maintenancefreezestore.NewStorage(restOptionsGetter)
+	storage["maintenancefreezes"] = maintenanceFreezeStorage.MaintenanceFreeze
+
 	projectStorage := projectstore.NewStorage(restOptionsGetter)
 	storage["projects"] = projectStorage.Project
 	storage["projects/status"] = projectStorage.Status
 
 	quotaStorage := quotastore.NewStorage(restOptionsGetter)
 	storage["quotas"] = quotaStorage.Quota
+	storage["quotas/status"] = quotaStorage.Status
 
 	secretBindingStorage := secretbindingstore.NewStorage(restOptionsGetter)
 	storage["secretbindings"] = secretBindingStorage.SecretBinding
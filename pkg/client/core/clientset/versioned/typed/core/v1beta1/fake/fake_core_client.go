@@ -48,6 +48,10 @@ func (c *FakeCoreV1beta1) InternalSecrets(namespace string) v1beta1.InternalSecr
 	return &FakeInternalSecrets{c, namespace}
 }
 
+func (c *FakeCoreV1beta1) MaintenanceFreezes() v1beta1.MaintenanceFreezeInterface {
+	return &FakeMaintenanceFreezes{c}
+}
+
 func (c *FakeCoreV1beta1) NamespacedCloudProfiles(namespace string) v1beta1.NamespacedCloudProfileInterface {
 	return &FakeNamespacedCloudProfiles{c, namespace}
 }
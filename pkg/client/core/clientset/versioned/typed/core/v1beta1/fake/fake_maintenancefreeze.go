@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeMaintenanceFreezes implements MaintenanceFreezeInterface
+type FakeMaintenanceFreezes struct {
+	Fake *FakeCoreV1beta1
+}
+
+var maintenancefreezesResource = v1beta1.SchemeGroupVersion.WithResource("maintenancefreezes")
+
+var maintenancefreezesKind = v1beta1.SchemeGroupVersion.WithKind("MaintenanceFreeze")
+
+// Get takes name of the maintenanceFreeze, and returns the corresponding maintenanceFreeze object, and an error if there is any.
+func (c *FakeMaintenanceFreezes) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.MaintenanceFreeze, err error) {
+	emptyResult := &v1beta1.MaintenanceFreeze{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetActionWithOptions(maintenancefreezesResource, name, options), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.MaintenanceFreeze), err
+}
+
+// List takes label and field selectors, and returns the list of MaintenanceFreezes that match those selectors.
+func (c *FakeMaintenanceFreezes) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.MaintenanceFreezeList, err error) {
+	emptyResult := &v1beta1.MaintenanceFreezeList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListActionWithOptions(maintenancefreezesResource, maintenancefreezesKind, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.MaintenanceFreezeList{ListMeta: obj.(*v1beta1.MaintenanceFreezeList).ListMeta}
+	for _, item := range obj.(*v1beta1.MaintenanceFreezeList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested maintenanceFreezes.
+func (c *FakeMaintenanceFreezes) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchActionWithOptions(maintenancefreezesResource, opts))
+}
+
+// Create takes the representation of a maintenanceFreeze and creates it.  Returns the server's representation of the maintenanceFreeze, and an error, if there is any.
+func (c *FakeMaintenanceFreezes) Create(ctx context.Context, maintenanceFreeze *v1beta1.MaintenanceFreeze, opts v1.CreateOptions) (result *v1beta1.MaintenanceFreeze, err error) {
+	emptyResult := &v1beta1.MaintenanceFreeze{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateActionWithOptions(maintenancefreezesResource, maintenanceFreeze, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.MaintenanceFreeze), err
+}
+
+// Update takes the representation of a maintenanceFreeze and updates it. Returns the server's representation of the maintenanceFreeze, and an error, if there is any.
+func (c *FakeMaintenanceFreezes) Update(ctx context.Context, maintenanceFreeze *v1beta1.MaintenanceFreeze, opts v1.UpdateOptions) (result *v1beta1.MaintenanceFreeze, err error) {
+	emptyResult := &v1beta1.MaintenanceFreeze{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateActionWithOptions(maintenancefreezesResource, maintenanceFreeze, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.MaintenanceFreeze), err
+}
+
+// Delete takes name of the maintenanceFreeze and deletes it. Returns an error if one occurs.
+func (c *FakeMaintenanceFreezes) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(maintenancefreezesResource, name, opts), &v1beta1.MaintenanceFreeze{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeMaintenanceFreezes) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionActionWithOptions(maintenancefreezesResource, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.MaintenanceFreezeList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched maintenanceFreeze.
+func (c *FakeMaintenanceFreezes) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.MaintenanceFreeze, err error) {
+	emptyResult := &v1beta1.MaintenanceFreeze{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(maintenancefreezesResource, name, pt, data, opts, subresources...), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.MaintenanceFreeze), err
+}
@@ -62,6 +62,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().ExposureClasses().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("internalsecrets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().InternalSecrets().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("maintenancefreezes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().MaintenanceFreezes().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("namespacedcloudprofiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().NamespacedCloudProfiles().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("projects"):
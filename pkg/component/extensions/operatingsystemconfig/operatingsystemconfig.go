@@ -17,6 +17,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -721,6 +722,11 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		return deployer{}, err
 	}
 
+	inPlaceUpdates, err := inPlaceUpdatesConfig(worker)
+	if err != nil {
+		return deployer{}, err
+	}
+
 	return deployer{
 		client:                  o.client,
 		osc:                     osc,
@@ -749,9 +755,34 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		nodeLocalDNSEnabled:     o.values.NodeLocalDNSEnabled,
 		primaryIPFamily:         o.values.PrimaryIPFamily,
 		taints:                  worker.Taints,
+		inPlaceUpdates:          inPlaceUpdates,
 	}, nil
 }
 
+// inPlaceUpdatesConfig returns the gardener-node-agent configuration for updating the nodes of the given worker pool
+// in-place, or nil if the worker pool does not use the in-place update strategy.
+func inPlaceUpdatesConfig(worker gardencorev1beta1.Worker) (*nodeagentconfigv1alpha1.InPlaceUpdatesConfig, error) {
+	if !v1beta1helper.IsUpdateStrategyInPlace(worker.UpdateStrategy) {
+		return nil, nil
+	}
+
+	maxUnavailable := 1
+	if worker.MaxUnavailable != nil {
+		value, err := intstr.GetScaledValueFromIntOrPercent(worker.MaxUnavailable, int(worker.Maximum), false)
+		if err != nil {
+			return nil, fmt.Errorf("failed computing maxUnavailable of worker pool %q: %w", worker.Name, err)
+		}
+		maxUnavailable = max(value, 1)
+	}
+
+	config := &nodeagentconfigv1alpha1.InPlaceUpdatesConfig{MaxUnavailable: int32(maxUnavailable)} // #nosec G115 -- value is bounded by the worker pool maximum.
+	if worker.MachineControllerManagerSettings != nil && worker.MachineControllerManagerSettings.MachineDrainTimeout != nil {
+		config.DrainTimeout = worker.MachineControllerManagerSettings.MachineDrainTimeout
+	}
+
+	return config, nil
+}
+
 func setDefaultEvictionMemoryAvailable(evictionHard, evictionSoft map[string]string, machineTypes []gardencorev1beta1.MachineType, machineType string) {
 	evictionHardMemoryAvailable, evictionSoftMemoryAvailable := "100Mi", "200Mi"
 
@@ -815,6 +846,7 @@ type deployer struct {
 	nodeMonitorGracePeriod  metav1.Duration
 	primaryIPFamily         gardencorev1beta1.IPFamily
 	taints                  []corev1.Taint
+	inPlaceUpdates          *nodeagentconfigv1alpha1.InPlaceUpdatesConfig
 }
 
 // exposed for testing
@@ -855,6 +887,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		Sysctls:                 d.worker.Sysctls,
 		PreferIPv6:              d.primaryIPFamily == gardencorev1beta1.IPFamilyIPv6,
 		Taints:                  d.taints,
+		InPlaceUpdates:          d.inPlaceUpdates,
 	}
 
 	switch d.purpose {
@@ -906,6 +939,14 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		d.osc.Spec.Units = units
 		d.osc.Spec.Files = files
 
+		d.osc.Spec.InPlaceUpdates = nil
+		if d.inPlaceUpdates != nil && d.purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+			d.osc.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{
+				OperatingSystemVersion: ptr.Deref(d.worker.Machine.Image.Version, ""),
+				KubeletVersion:         d.kubernetesVersion.String(),
+			}
+		}
+
 		if d.worker.CRI != nil {
 			d.osc.Spec.CRIConfig = &extensionsv1alpha1.CRIConfig{
 				Name: extensionsv1alpha1.CRIName(d.worker.CRI.Name),
@@ -957,7 +998,7 @@ func calculateKeyForVersion(
 	switch version {
 	case 1:
 		// TODO(MichaelEischer): Remove KeyV1 after support for Kubernetes 1.30 is dropped
-		return KeyV1(worker.Name, kubernetesVersion, worker.CRI), nil
+		return KeyV1(worker.Name, kubernetesVersion, worker.CRI, worker.UpdateStrategy), nil
 	case 2:
 		return KeyV2(kubernetesVersion, values.CredentialsRotationStatus, worker, values.NodeLocalDNSEnabled, kubeletConfiguration), nil
 	default:
@@ -966,8 +1007,9 @@ func calculateKeyForVersion(
 }
 
 // KeyV1 returns the key that can be used as secret name based on the provided worker name, Kubernetes version and CRI
-// configuration.
-func KeyV1(workerPoolName string, kubernetesVersion *semver.Version, criConfig *gardencorev1beta1.CRI) string {
+// configuration. For worker pools with the in-place update strategy, the Kubernetes version is not considered since it
+// is updated on the existing nodes.
+func KeyV1(workerPoolName string, kubernetesVersion *semver.Version, criConfig *gardencorev1beta1.CRI, updateStrategy *gardencorev1beta1.MachineUpdateStrategy) string {
 	if kubernetesVersion == nil {
 		return ""
 	}
@@ -977,6 +1019,10 @@ func KeyV1(workerPoolName string, kubernetesVersion *semver.Version, criConfig *
 		criName                     gardencorev1beta1.CRIName
 	)
 
+	if v1beta1helper.IsUpdateStrategyInPlace(updateStrategy) {
+		kubernetesMajorMinorVersion = ""
+	}
+
 	if criConfig != nil {
 		criName = criConfig.Name
 	}
@@ -986,7 +1032,8 @@ func KeyV1(workerPoolName string, kubernetesVersion *semver.Version, criConfig *
 
 // KeyV2 returns the key that can be used as secret name based on the provided worker name,
 // Kubernetes version, machine type, image, worker volume, CRI, credentials rotation, node local dns
-// and kubelet configuration.
+// and kubelet configuration. For worker pools with the in-place update strategy, the Kubernetes version and the machine
+// image version are not considered since they are updated on the existing nodes.
 func KeyV2(
 	kubernetesVersion *semver.Version,
 	credentialsRotation *gardencorev1beta1.ShootCredentialsRotation,
@@ -1006,6 +1053,13 @@ func KeyV2(
 		worker.Machine.Image.Name + *worker.Machine.Image.Version,
 	}
 
+	if v1beta1helper.IsUpdateStrategyInPlace(worker.UpdateStrategy) {
+		data = []string{
+			worker.Machine.Type,
+			worker.Machine.Image.Name,
+		}
+	}
+
 	if worker.Volume != nil {
 		data = append(data, worker.Volume.VolumeSize)
 		if worker.Volume.Type != nil {
@@ -197,7 +197,7 @@ var _ = Describe("OperatingSystemConfig", func() {
 					}
 				}
 
-				key := KeyV1(worker.Name, k8sVersion, worker.CRI)
+				key := KeyV1(worker.Name, k8sVersion, worker.CRI, worker.UpdateStrategy)
 
 				imagesCopy := make(map[string]*imagevector.Image, len(images))
 				for imageName, image := range images {
@@ -512,7 +512,7 @@ var _ = Describe("OperatingSystemConfig", func() {
 			) (string, error) {
 				switch oscVersion {
 				case 1:
-					return KeyV1(worker.Name, kubernetesVersion, worker.CRI), nil
+					return KeyV1(worker.Name, kubernetesVersion, worker.CRI, worker.UpdateStrategy), nil
 				case 2:
 					return worker.Name + "-version2", nil
 				default:
@@ -595,6 +595,53 @@ var _ = Describe("OperatingSystemConfig", func() {
 				}
 			})
 
+			It("should configure in-place updates for worker pools with the in-place update strategy", func() {
+				var inPlaceUpdatesConfig *nodeagentconfigv1alpha1.InPlaceUpdatesConfig
+				originalConfigFnCapturingContext := func(cctx components.Context) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+					if cctx.InPlaceUpdates != nil {
+						inPlaceUpdatesConfig = cctx.InPlaceUpdates
+					}
+					return originalConfigFn(cctx)
+				}
+
+				worker := workers[1].DeepCopy()
+				worker.UpdateStrategy = ptr.To(gardencorev1beta1.AutoInPlaceUpdate)
+				worker.Maximum = 10
+				worker.MaxUnavailable = ptr.To(intstr.FromString("20%"))
+				worker.MachineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{
+					MachineDrainTimeout: &metav1.Duration{Duration: time.Hour},
+				}
+
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&InitConfigFn, initConfigFn,
+					&OriginalConfigFn, originalConfigFnCapturingContext,
+					&values.Workers, []gardencorev1beta1.Worker{workers[0], *worker},
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				oscList := &extensionsv1alpha1.OperatingSystemConfigList{}
+				Expect(c.List(ctx, oscList, client.InNamespace(namespace))).To(Succeed())
+				for _, osc := range oscList.Items {
+					if osc.Labels["worker.gardener.cloud/pool"] == worker.Name && osc.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+						Expect(osc.Spec.InPlaceUpdates).To(Equal(&extensionsv1alpha1.InPlaceUpdates{
+							OperatingSystemVersion: "12.34",
+							KubeletVersion:         workerKubernetesVersion,
+						}))
+					} else {
+						Expect(osc.Spec.InPlaceUpdates).To(BeNil())
+					}
+				}
+
+				Expect(inPlaceUpdatesConfig).To(Equal(&nodeagentconfigv1alpha1.InPlaceUpdatesConfig{
+					MaxUnavailable: 2,
+					DrainTimeout:   &metav1.Duration{Duration: time.Hour},
+				}))
+			})
+
 			It("should exclude the bootstrap token file if purpose is not provision", func() {
 				bootstrapTokenFile := extensionsv1alpha1.File{Path: "/var/lib/gardener-node-agent/credentials/bootstrap-token"}
 				initConfigFnWithBootstrapToken := func(worker gardencorev1beta1.Worker, nodeAgentImage string, config *nodeagentconfigv1alpha1.NodeAgentConfiguration) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
@@ -643,7 +690,7 @@ var _ = Describe("OperatingSystemConfig", func() {
 					if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
 						k8sVersion = semver.MustParse(*worker.Kubernetes.Version)
 					}
-					key := KeyV1(worker.Name, k8sVersion, worker.CRI)
+					key := KeyV1(worker.Name, k8sVersion, worker.CRI, worker.UpdateStrategy)
 
 					extensions = append(extensions,
 						gardencorev1beta1.ExtensionResourceState{
@@ -1169,19 +1216,26 @@ var _ = Describe("OperatingSystemConfig", func() {
 		)
 
 		It("should return an empty string", func() {
-			Expect(KeyV1(workerName, nil, nil)).To(BeEmpty())
+			Expect(KeyV1(workerName, nil, nil, nil)).To(BeEmpty())
 		})
 
 		It("should return the expected key", func() {
-			Expect(KeyV1(workerName, semver.MustParse(kubernetesVersion), nil)).To(Equal("gardener-node-agent-" + workerName + "-77ac3"))
+			Expect(KeyV1(workerName, semver.MustParse(kubernetesVersion), nil, nil)).To(Equal("gardener-node-agent-" + workerName + "-77ac3"))
 		})
 
 		It("is different for different worker.cri configurations", func() {
-			containerDKey := KeyV1(workerName, semver.MustParse("1.2.3"), &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD})
-			otherKey := KeyV1(workerName, semver.MustParse("1.2.3"), &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRIName("other")})
+			containerDKey := KeyV1(workerName, semver.MustParse("1.2.3"), &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}, nil)
+			otherKey := KeyV1(workerName, semver.MustParse("1.2.3"), &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRIName("other")}, nil)
 			Expect(containerDKey).NotTo(Equal(otherKey))
 		})
 
+		It("should not consider the Kubernetes version for worker pools with the in-place update strategy", func() {
+			key := KeyV1(workerName, semver.MustParse("1.2.3"), nil, ptr.To(gardencorev1beta1.AutoInPlaceUpdate))
+			Expect(key).To(HavePrefix("gardener-node-agent-" + workerName + "-"))
+			Expect(key).NotTo(Equal(KeyV1(workerName, semver.MustParse("1.2.3"), nil, nil)))
+			Expect(KeyV1(workerName, semver.MustParse("1.3.0"), nil, ptr.To(gardencorev1beta1.AutoInPlaceUpdate))).To(Equal(key))
+		})
+
 		It("should return the expected key for version 1", func() {
 			key, err := CalculateKeyForVersion(1, semver.MustParse(kubernetesVersion), nil,
 				&gardencorev1beta1.Worker{
@@ -1545,5 +1599,35 @@ var _ = Describe("OperatingSystemConfig", func() {
 				}
 			})
 		})
+
+		Context("in-place update strategy", func() {
+			BeforeEach(func() {
+				p.UpdateStrategy = ptr.To(gardencorev1beta1.AutoInPlaceUpdate)
+
+				var err error
+				hash, err = CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should not change the hash value when changing the kubernetes minor version", func() {
+				Expect(CalculateKeyForVersion(2, semver.MustParse("1.3.0"), values, p, kubeletConfig)).To(Equal(hash))
+			})
+
+			It("should not change the hash value when changing the machine image version", func() {
+				p.Machine.Image.Version = ptr.To("new-version")
+				Expect(CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)).To(Equal(hash))
+			})
+
+			It("should change the hash value when changing the machine image name", func() {
+				p.Machine.Image.Name = "new-image"
+				Expect(CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)).NotTo(Equal(hash))
+			})
+
+			It("should not change the hash value for version 1 when changing the kubernetes minor version", func() {
+				hashV1, err := CalculateKeyForVersion(1, kubernetesVersion, values, p, kubeletConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(CalculateKeyForVersion(1, semver.MustParse("1.3.0"), values, p, kubeletConfig)).To(Equal(hashV1))
+			})
+		})
 	})
 })
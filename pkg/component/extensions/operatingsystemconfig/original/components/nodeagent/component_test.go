@@ -89,6 +89,26 @@ WantedBy=multi-user.target`),
 			))
 			Expect(files).To(ConsistOf(expectedFiles))
 		})
+
+		It("should configure in-place updates", func() {
+			inPlaceUpdates := &nodeagentconfigv1alpha1.InPlaceUpdatesConfig{MaxUnavailable: 2}
+
+			config := ComponentConfig("key", kubernetesVersion, apiServerURL, caBundle, nil)
+			config.Controllers.OperatingSystemConfig.InPlaceUpdates = inPlaceUpdates
+			expectedFiles, err := Files(config)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, files, err := component.Config(components.Context{
+				Key:               "key",
+				KubernetesVersion: kubernetesVersion,
+				APIServerURL:      apiServerURL,
+				CABundle:          ptr.To(string(caBundle)),
+				Images:            map[string]*imagevectorutils.Image{"gardener-node-agent": {Repository: ptr.To("gardener-node-agent"), Tag: ptr.To("v1")}},
+				InPlaceUpdates:    inPlaceUpdates,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(ContainElement(expectedFiles[0]))
+		})
 	})
 
 	Describe("#UnitContent", func() {
@@ -40,6 +40,16 @@ func RBACResourcesData(secretNames []string) (map[string][]byte, error) {
 					Resources: []string{"events"},
 					Verbs:     []string{"get", "list", "watch", "create", "patch", "update"},
 				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"pods"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"pods/eviction"},
+					Verbs:     []string{"create"},
+				},
 			},
 		}
 
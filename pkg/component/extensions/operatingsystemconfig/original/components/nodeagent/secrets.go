@@ -35,13 +35,15 @@ func OperatingSystemConfigSecret(
 	// prevent undesired changes of the computed checksum of this object.
 	operatingSystemConfig := &extensionsv1alpha1.OperatingSystemConfig{
 		Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
-			Units:     osc.Spec.Units,
-			Files:     osc.Spec.Files,
-			CRIConfig: osc.Spec.CRIConfig,
+			Units:          osc.Spec.Units,
+			Files:          osc.Spec.Files,
+			CRIConfig:      osc.Spec.CRIConfig,
+			InPlaceUpdates: osc.Spec.InPlaceUpdates,
 		},
 		Status: extensionsv1alpha1.OperatingSystemConfigStatus{
 			ExtensionUnits: osc.Status.ExtensionUnits,
 			ExtensionFiles: osc.Status.ExtensionFiles,
+			InPlaceUpdates: osc.Status.InPlaceUpdates,
 		},
 	}
 
@@ -147,6 +147,28 @@ status:
 			}))
 		})
 
+		It("should include the in-place updates configuration", func() {
+			osc.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{OperatingSystemVersion: "1.2.3", KubeletVersion: "1.31.1"}
+			osc.Status.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdatesStatus{OSUpdate: &extensionsv1alpha1.OSUpdate{Command: "/bin/update", Args: []string{"1.2.3"}}}
+
+			secret, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(secret.Data["osc.yaml"])).To(And(
+				ContainSubstring(`
+  inPlaceUpdates:
+    kubeletVersion: 1.31.1
+    operatingSystemVersion: 1.2.3
+`),
+				ContainSubstring(`
+  inPlaceUpdates:
+    osUpdate:
+      args:
+      - 1.2.3
+      command: /bin/update
+`),
+			))
+		})
+
 		It("should return an error because a referenced secret cannot be found", func() {
 			osc.Spec.Files = append(osc.Spec.Files, extensionsv1alpha1.File{
 				Path: "/non/existing/path",
@@ -95,6 +95,7 @@ var _ = Describe("Worker", func() {
 		worker2Minimum             int32 = 5
 		worker2Maximum             int32 = 6
 		worker2Priority                  = ptr.To(int32(10))
+		worker2UpdateStrategy            = ptr.To(gardencorev1beta1.AutoInPlaceUpdate)
 		worker2MaxSurge                  = intstr.FromInt32(7)
 		worker2MaxUnavailable            = intstr.FromInt32(8)
 		worker2MachineType               = "worker2machinetype"
@@ -228,6 +229,7 @@ var _ = Describe("Worker", func() {
 					MaxSurge:       &worker2MaxSurge,
 					MaxUnavailable: &worker2MaxUnavailable,
 					Priority:       worker2Priority,
+					UpdateStrategy: worker2UpdateStrategy,
 					Machine: gardencorev1beta1.Machine{
 						Type: worker2MachineType,
 						Image: &gardencorev1beta1.ShootMachineImage{
@@ -323,6 +325,7 @@ var _ = Describe("Worker", func() {
 					MaxSurge:       worker2MaxSurge,
 					MaxUnavailable: worker2MaxUnavailable,
 					Priority:       worker2Priority,
+					UpdateStrategy: worker2UpdateStrategy,
 					Labels: map[string]string{
 						"node.kubernetes.io/role":                               "node",
 						"kubernetes.io/arch":                                    *worker2Arch,
@@ -49,6 +49,14 @@ type SNIValues struct {
 	Hosts               []string
 	APIServerProxy      *APIServerProxy
 	IstioIngressGateway IstioIngressGateway
+	WakeUpProxy         *WakeUpProxy
+}
+
+// WakeUpProxy contains values for routing the requests to the proxy which wakes up the hibernated shoot instead of
+// routing them to the kube-apiserver.
+type WakeUpProxy struct {
+	// Host is the fully qualified host name of the service exposing the proxy on the kube-apiserver port.
+	Host string
 }
 
 // APIServerProxy contains values for the APIServer proxy protocol configuration.
@@ -152,7 +160,12 @@ func (s *sni) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, s.client, virtualService, istio.VirtualServiceWithSNIMatch(virtualService, getLabels(), s.valuesFunc().Hosts, gateway.Name, kubeapiserverconstants.Port, hostName)); err != nil {
+	destinationHost := hostName
+	if values.WakeUpProxy != nil {
+		destinationHost = values.WakeUpProxy.Host
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, s.client, virtualService, istio.VirtualServiceWithSNIMatch(virtualService, getLabels(), s.valuesFunc().Hosts, gateway.Name, kubeapiserverconstants.Port, destinationHost)); err != nil {
 		return err
 	}
 
@@ -46,6 +46,7 @@ var _ = Describe("#SNI", func() {
 		hostName         = "kube-apiserver." + namespace + ".svc.cluster.local"
 
 		apiServerProxyValues *APIServerProxy
+		wakeUpProxyValues    *WakeUpProxy
 
 		expectedDestinationRule       *istionetworkingv1beta1.DestinationRule
 		expectedGateway               *istionetworkingv1beta1.Gateway
@@ -185,6 +186,7 @@ var _ = Describe("#SNI", func() {
 					Namespace: istioNamespace,
 					Labels:    istioLabels,
 				},
+				WakeUpProxy: wakeUpProxyValues,
 			}
 			return val
 		})
@@ -248,6 +250,19 @@ var _ = Describe("#SNI", func() {
 				test()
 			})
 		})
+
+		Context("when the requests shall be routed to the wake-up proxy", func() {
+			BeforeEach(func() {
+				wakeUpProxyValues = &WakeUpProxy{Host: "gardenlet.garden.svc.cluster.local"}
+				DeferCleanup(func() { wakeUpProxyValues = nil })
+
+				expectedVirtualService.Spec.Tls[0].Route[0].Destination.Host = "gardenlet.garden.svc.cluster.local"
+			})
+
+			It("should succeed deploying", func() {
+				test()
+			})
+		})
 	})
 
 	It("should succeed destroying", func() {
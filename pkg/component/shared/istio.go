@@ -300,8 +300,9 @@ forNode:
 func commonIstioIngressNetworkPolicyLabels(vpnEnabled bool) map[string]string {
 	labels := map[string]string{
 		v1beta1constants.LabelNetworkPolicyToDNS: v1beta1constants.LabelNetworkPolicyAllowed,
-		gardenerutils.NetworkPolicyLabel(v1beta1constants.IstioSystemNamespace+"-"+istio.IstiodServiceName, istio.IstiodPort):                         v1beta1constants.LabelNetworkPolicyAllowed,
-		gardenerutils.NetworkPolicyLabel(v1beta1constants.GardenNamespace+"-"+nginxingress.GetServiceName(), nginxingress.ServicePortControllerHttps): v1beta1constants.LabelNetworkPolicyAllowed,
+		gardenerutils.NetworkPolicyLabel(v1beta1constants.IstioSystemNamespace+"-"+istio.IstiodServiceName, istio.IstiodPort):                                      v1beta1constants.LabelNetworkPolicyAllowed,
+		gardenerutils.NetworkPolicyLabel(v1beta1constants.GardenNamespace+"-"+nginxingress.GetServiceName(), nginxingress.ServicePortControllerHttps):              v1beta1constants.LabelNetworkPolicyAllowed,
+		gardenerutils.NetworkPolicyLabel(v1beta1constants.GardenNamespace+"-"+v1beta1constants.DeploymentNameGardenlet, v1beta1constants.GardenletWakeUpProxyPort): v1beta1constants.LabelNetworkPolicyAllowed,
 	}
 	if vpnEnabled {
 		labels[gardenerutils.NetworkPolicyLabel(v1beta1constants.LabelNetworkPolicyShootNamespaceAlias+"-"+v1beta1constants.DeploymentNameVPNSeedServer, vpnseedserver.OpenVPNPort)] = v1beta1constants.LabelNetworkPolicyAllowed
@@ -92,6 +92,7 @@ func checkIstio(istioDeploy istio.Interface, testValues istioTestValues) {
 		"networking.gardener.cloud/to-dns":                                     "allowed",
 		"networking.gardener.cloud/to-runtime-apiserver":                       "allowed",
 		"networking.resources.gardener.cloud/to-istio-system-istiod-tcp-15012": "allowed",
+		"networking.resources.gardener.cloud/to-garden-gardenlet-tcp-2730":     "allowed",
 		testValues.kubeAPIServerPolicyLabel:                                    "allowed",
 	}
 
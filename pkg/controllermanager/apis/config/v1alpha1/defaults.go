@@ -102,6 +102,11 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 			Duration: 12 * time.Hour,
 		}
 	}
+	if obj.UsageSyncPeriod == nil {
+		obj.UsageSyncPeriod = &metav1.Duration{
+			Duration: time.Hour,
+		}
+	}
 
 	for i, quota := range obj.Quotas {
 		if quota.ProjectSelector == nil {
@@ -184,6 +189,10 @@ func SetDefaults_QuotaControllerConfiguration(obj *QuotaControllerConfiguration)
 	if obj.ConcurrentSyncs == nil {
 		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
 	}
+
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
 }
 
 // SetDefaults_SecretBindingControllerConfiguration sets defaults for the SecretBindingControllerConfiguration.
@@ -290,6 +299,16 @@ func SetDefaults_ShootMigrationControllerConfiguration(obj *ShootMigrationContro
 	}
 }
 
+// SetDefaults_ShootStateRevisionControllerConfiguration sets defaults for the ShootStateRevisionControllerConfiguration.
+func SetDefaults_ShootStateRevisionControllerConfiguration(obj *ShootStateRevisionControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.MaxRevisions == nil {
+		obj.MaxRevisions = ptr.To(3)
+	}
+}
+
 // SetDefaults_ManagedSeedSetControllerConfiguration sets defaults for the ManagedSeedSetControllerConfiguration.
 func SetDefaults_ManagedSeedSetControllerConfiguration(obj *ManagedSeedSetControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -362,6 +381,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.ShootMigration == nil {
 		obj.ShootMigration = &ShootMigrationControllerConfiguration{}
 	}
+	if obj.ShootStateRevision == nil {
+		obj.ShootStateRevision = &ShootStateRevisionControllerConfiguration{}
+	}
 
 	if obj.ManagedSeedSet == nil {
 		obj.ManagedSeedSet = &ManagedSeedSetControllerConfiguration{
@@ -183,6 +183,9 @@ var _ = Describe("Defaults", func() {
 				StaleSyncPeriod: &metav1.Duration{
 					Duration: 12 * time.Hour,
 				},
+				UsageSyncPeriod: &metav1.Duration{
+					Duration: time.Hour,
+				},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -224,6 +227,9 @@ var _ = Describe("Defaults", func() {
 						StaleSyncPeriod: &metav1.Duration{
 							Duration: 12 * time.Hour,
 						},
+						UsageSyncPeriod: &metav1.Duration{
+							Duration: 30 * time.Minute,
+						},
 					},
 				},
 			}
@@ -423,6 +429,7 @@ var _ = Describe("Defaults", func() {
 		It("should default QuotaControllerConfiguration correctly", func() {
 			expected := &QuotaControllerConfiguration{
 				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: time.Minute},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -434,6 +441,7 @@ var _ = Describe("Defaults", func() {
 				Controllers: ControllerManagerControllerConfiguration{
 					Quota: &QuotaControllerConfiguration{
 						ConcurrentSyncs: ptr.To(10),
+						SyncPeriod:      &metav1.Duration{Duration: 5 * time.Minute},
 					},
 				},
 			}
@@ -774,6 +782,33 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootStateRevisionControllerConfiguration defaulting", func() {
+		It("should default ShootStateRevisionControllerConfiguration correctly", func() {
+			expected := &ShootStateRevisionControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				MaxRevisions:    ptr.To(3),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootStateRevision).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootStateRevision: &ShootStateRevisionControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						MaxRevisions:    ptr.To(0),
+					},
+				},
+			}
+			expected := obj.Controllers.ShootStateRevision.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootStateRevision).To(Equal(expected))
+		})
+	})
+
 	Describe("ManagedSeedSetControllerConfiguration defaulting", func() {
 		It("should default ManagedSeedSetControllerConfiguration correctly if nil", func() {
 			expected := &ManagedSeedSetControllerConfiguration{
@@ -109,6 +109,10 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootMigration defines the configuration of the ShootMigration controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	ShootMigration *ShootMigrationControllerConfiguration `json:"shootMigration,omitempty"`
+	// ShootStateRevision defines the configuration of the ShootStateRevision controller. If unspecified, it is defaulted
+	// with `concurrentSyncs=5` and `maxRevisions=3`.
+	// +optional
+	ShootStateRevision *ShootStateRevisionControllerConfiguration `json:"shootStateRevision,omitempty"`
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	// +optional
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration `json:"managedSeedSet,omitempty"`
@@ -217,6 +221,9 @@ type ProjectControllerConfiguration struct {
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	// +optional
 	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
+	// UsageSyncPeriod is the duration how often the resource usage of Projects is accumulated.
+	// +optional
+	UsageSyncPeriod *metav1.Duration `json:"usageSyncPeriod,omitempty"`
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -235,6 +242,10 @@ type QuotaControllerConfiguration struct {
 	// events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the usage of Quotas is computed and
+	// reported in their status.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
 // SecretBindingControllerConfiguration defines the configuration of the
@@ -403,6 +414,19 @@ type ShootMigrationControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootStateRevisionControllerConfiguration defines the configuration of the
+// ShootStateRevision controller.
+type ShootStateRevisionControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// MaxRevisions is the number of revisions of the ShootState of each Shoot which are kept. Older revisions are
+	// deleted. If set to 0, no revisions are created. Defaults to 3.
+	// +optional
+	MaxRevisions *int `json:"maxRevisions,omitempty"`
+}
+
 // ManagedSeedSetControllerConfiguration defines the configuration of the
 // ManagedSeedSet controller.
 type ManagedSeedSetControllerConfiguration struct {
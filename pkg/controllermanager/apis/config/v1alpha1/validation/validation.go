@@ -5,6 +5,7 @@
 package validation
 
 import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -45,6 +46,10 @@ func validateControllerManagerControllerConfiguration(conf controllermanagerconf
 		allErrs = append(allErrs, validateProjectControllerConfiguration(conf.Project, projectFldPath)...)
 	}
 
+	if conf.ShootStateRevision != nil && conf.ShootStateRevision.MaxRevisions != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*conf.ShootStateRevision.MaxRevisions), fldPath.Child("shootStateRevision", "maxRevisions"))...)
+	}
+
 	return allErrs
 }
 
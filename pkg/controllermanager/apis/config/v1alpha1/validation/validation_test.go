@@ -156,4 +156,27 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 			})
 		})
 	})
+
+	Context("ShootStateRevisionControllerConfiguration", func() {
+		It("should allow keeping no revisions", func() {
+			conf.Controllers.ShootStateRevision = &controllermanagerconfigv1alpha1.ShootStateRevisionControllerConfiguration{
+				MaxRevisions: ptr.To(0),
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid a negative number of revisions", func() {
+			conf.Controllers.ShootStateRevision = &controllermanagerconfigv1alpha1.ShootStateRevisionControllerConfiguration{
+				MaxRevisions: ptr.To(-1),
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootStateRevision.maxRevisions"),
+				})),
+			))
+		})
+	})
 })
@@ -276,6 +276,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootMigrationControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootStateRevision != nil {
+		in, out := &in.ShootStateRevision, &out.ShootStateRevision
+		*out = new(ShootStateRevisionControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedSeedSet != nil {
 		in, out := &in.ManagedSeedSet, &out.ManagedSeedSet
 		*out = new(ManagedSeedSetControllerConfiguration)
@@ -466,6 +471,11 @@ func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerCon
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.UsageSyncPeriod != nil {
+		in, out := &in.UsageSyncPeriod, &out.UsageSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -509,6 +519,11 @@ func (in *QuotaControllerConfiguration) DeepCopyInto(out *QuotaControllerConfigu
 		*out = new(int)
 		**out = **in
 	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -860,6 +875,32 @@ func (in *ShootRetryControllerConfiguration) DeepCopy() *ShootRetryControllerCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootStateRevisionControllerConfiguration) DeepCopyInto(out *ShootStateRevisionControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxRevisions != nil {
+		in, out := &in.MaxRevisions, &out.MaxRevisions
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootStateRevisionControllerConfiguration.
+func (in *ShootStateRevisionControllerConfiguration) DeepCopy() *ShootStateRevisionControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootStateRevisionControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootStatusLabelControllerConfiguration) DeepCopyInto(out *ShootStatusLabelControllerConfiguration) {
 	*out = *in
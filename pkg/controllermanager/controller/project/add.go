@@ -13,6 +13,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/activity"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/project"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/stale"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/usage"
 )
 
 // AddToManager adds all Project controllers to the given manager.
@@ -35,5 +36,11 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding stale reconciler: %w", err)
 	}
 
+	if err := (&usage.Reconciler{
+		Config: *cfg.Controllers.Project,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding usage reconciler: %w", err)
+	}
+
 	return nil
 }
@@ -7,6 +7,7 @@ package stale
 import (
 	"reflect"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -41,7 +42,8 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 }
 
 // ProjectPredicate returns true for 'CREATE' events. For 'UPDATE' events, it returns true when the
-// lastActivityTimestamp in the status is unchanged or the generation is different from the observed generation.
+// lastActivityTimestamp and the usage in the status are unchanged or the generation is different from the observed
+// generation.
 func (r *Reconciler) ProjectPredicate() predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(_ event.CreateEvent) bool {
@@ -57,7 +59,8 @@ func (r *Reconciler) ProjectPredicate() predicate.Predicate {
 				return false
 			}
 
-			return reflect.DeepEqual(project.Status.LastActivityTimestamp, oldProject.Status.LastActivityTimestamp) ||
+			return (reflect.DeepEqual(project.Status.LastActivityTimestamp, oldProject.Status.LastActivityTimestamp) &&
+				apiequality.Semantic.DeepEqual(project.Status.Usage, oldProject.Status.Usage)) ||
 				project.Generation != project.Status.ObservedGeneration
 		},
 		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
@@ -54,6 +54,12 @@ var _ = Describe("Add", func() {
 				Expect(p.Update(event.UpdateEvent{ObjectOld: oldProject, ObjectNew: project})).To(BeFalse())
 			})
 
+			It("should return false when usage changed and observed generation is up-to-date", func() {
+				oldProject := project.DeepCopy()
+				project.Status.Usage = &gardencorev1beta1.ProjectUsage{}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldProject, ObjectNew: project})).To(BeFalse())
+			})
+
 			It("should return true when last activity time of old and new object are equal", func() {
 				Expect(p.Update(event.UpdateEvent{ObjectOld: project, ObjectNew: project})).To(BeTrue())
 			})
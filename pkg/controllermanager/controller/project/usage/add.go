@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"fmt"
+
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsfilters "sigs.k8s.io/controller-runtime/pkg/metrics/filters"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// ControllerName is the name of this controller.
+	ControllerName = "project-usage"
+	// ExportPath is the path of the metrics server under which the resource usage of all Projects is exported. Clients
+	// must authenticate against the garden cluster and be allowed to 'get' this non-resource URL.
+	ExportPath = "/usage/projects"
+)
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
+	filter, err := metricsfilters.WithAuthenticationAndAuthorization(mgr.GetConfig(), mgr.GetHTTPClient())
+	if err != nil {
+		return fmt.Errorf("failed creating authentication and authorization filter for usage export: %w", err)
+	}
+
+	exportHandler, err := filter(mgr.GetLogger().WithName("controller").WithName(ControllerName).WithName("export"), &ExportHandler{Reader: r.Client})
+	if err != nil {
+		return fmt.Errorf("failed wrapping usage export handler with authentication and authorization: %w", err)
+	}
+
+	if err := mgr.AddMetricsServerExtraHandler(ExportPath, exportHandler); err != nil {
+		return fmt.Errorf("failed adding usage export handler: %w", err)
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.Project{}, builder.WithPredicates(r.ProjectPredicate())).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Complete(r)
+}
+
+// ProjectPredicate returns true for 'CREATE' events only. Afterwards, the usage of the Projects is accumulated
+// periodically, hence updates of the Projects (including the ones caused by this controller) are not relevant.
+func (r *Reconciler) ProjectPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(_ event.CreateEvent) bool { return true },
+		UpdateFunc:  func(_ event.UpdateEvent) bool { return false },
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return true },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// FormatJSON is the format for exporting the usage as JSON.
+	FormatJSON = "json"
+	// FormatCSV is the format for exporting the usage as CSV.
+	FormatCSV = "csv"
+)
+
+// csvHeader is the header of the exported CSV. Each row contains one value of the usage of a project.
+var csvHeader = []string{"project", "namespace", "periodStartTime", "lastUpdateTime", "category", "name", "unit", "value"}
+
+// ExportHandler is a http.Handler which exports the resource usage of all Projects for chargeback. The format can be
+// selected with the `format` query parameter, i.e., `json` (default) or `csv`.
+type ExportHandler struct {
+	Reader client.Reader
+}
+
+// Record is the exported usage of a Project.
+type Record struct {
+	// Project is the name of the project.
+	Project string `json:"project"`
+	// Namespace is the namespace of the project.
+	Namespace string `json:"namespace,omitempty"`
+	// Usage is the usage of the project in the current accounting period.
+	Usage gardencorev1beta1.ProjectUsage `json:"usage"`
+	// LastPeriodUsage is the usage of the project in the previous accounting period.
+	LastPeriodUsage *gardencorev1beta1.ProjectUsage `json:"lastPeriodUsage,omitempty"`
+}
+
+// ServeHTTP implements http.Handler.
+func (h *ExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = FormatJSON
+	}
+	if format != FormatJSON && format != FormatCSV {
+		http.Error(w, fmt.Sprintf("unsupported format %q, supported formats are %q and %q", format, FormatJSON, FormatCSV), http.StatusBadRequest)
+		return
+	}
+
+	projectList := &gardencorev1beta1.ProjectList{}
+	if err := h.Reader.List(r.Context(), projectList); err != nil {
+		http.Error(w, fmt.Sprintf("failed listing projects: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	records := make([]Record, 0, len(projectList.Items))
+	for _, project := range projectList.Items {
+		if project.Status.Usage == nil {
+			continue
+		}
+		records = append(records, Record{
+			Project:         project.Name,
+			Namespace:       ptr.Deref(project.Spec.Namespace, ""),
+			Usage:           *project.Status.Usage,
+			LastPeriodUsage: project.Status.LastPeriodUsage,
+		})
+	}
+	slices.SortFunc(records, func(a, b Record) int { return strings.Compare(a.Project, b.Project) })
+
+	var err error
+	switch format {
+	case FormatCSV:
+		w.Header().Set("Content-Type", "text/csv")
+		err = WriteCSV(w, records)
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(records)
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed writing usage: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// WriteCSV writes the given records in CSV format to the given writer. The usage of the previous accounting period
+// precedes the one of the current period if it is known.
+func WriteCSV(w io.Writer, records []Record) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if record.LastPeriodUsage != nil {
+			if err := writeUsage(csvWriter, record, *record.LastPeriodUsage); err != nil {
+				return err
+			}
+		}
+		if err := writeUsage(csvWriter, record, record.Usage); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+func writeUsage(csvWriter *csv.Writer, record Record, usage gardencorev1beta1.ProjectUsage) error {
+	write := func(category, name, unit, value string) error {
+		return csvWriter.Write([]string{
+			record.Project,
+			record.Namespace,
+			usage.PeriodStartTime.UTC().Format(time.RFC3339),
+			usage.LastUpdateTime.UTC().Format(time.RFC3339),
+			category,
+			name,
+			unit,
+			value,
+		})
+	}
+
+	for _, machine := range usage.Machines {
+		if err := write("machine", machine.Type, "hours", formatQuantity(machine.Hours)); err != nil {
+			return err
+		}
+		if machine.CostUnits != nil {
+			if err := write("machine", machine.Type, "costunits", formatQuantity(*machine.CostUnits)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := write("shoot", "awake", "hours", formatQuantity(usage.Shoots.AwakeHours)); err != nil {
+		return err
+	}
+	if err := write("shoot", "hibernated", "hours", formatQuantity(usage.Shoots.HibernatedHours)); err != nil {
+		return err
+	}
+
+	for _, controlPlane := range usage.ControlPlanes {
+		if err := write("controlplane", controlPlane.HighAvailability, "hours", formatQuantity(controlPlane.Hours)); err != nil {
+			return err
+		}
+	}
+
+	for _, storage := range usage.Storage {
+		if err := write("storage", storage.Class, "gibibytehours", formatQuantity(storage.GibibyteHours)); err != nil {
+			return err
+		}
+	}
+
+	if usage.CostUnits != nil {
+		if err := write("total", "costunits", "costunits", formatQuantity(*usage.CostUnits)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatQuantity formats the given quantity as plain decimal number without unit suffixes.
+func formatQuantity(quantity resource.Quantity) string {
+	return strconv.FormatFloat(quantity.AsApproximateFloat64(), 'f', -1, 64)
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/usage"
+)
+
+var _ = Describe("ExportHandler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		handler    http.Handler
+		recorder   *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		handler = &ExportHandler{Reader: fakeClient}
+		recorder = httptest.NewRecorder()
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+			Status: gardencorev1beta1.ProjectStatus{
+				Usage: &gardencorev1beta1.ProjectUsage{
+					PeriodStartTime: metav1.NewTime(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)),
+					LastUpdateTime:  metav1.NewTime(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)),
+					Machines: []gardencorev1beta1.ProjectMachineUsage{
+						{Type: "small", Hours: resource.MustParse("5"), CostUnits: ptr.To(resource.MustParse("7.5"))},
+					},
+					Shoots: gardencorev1beta1.ProjectShootUsage{
+						AwakeHours:      resource.MustParse("12"),
+						HibernatedHours: resource.MustParse("2.5"),
+					},
+					ControlPlanes: []gardencorev1beta1.ProjectControlPlaneUsage{{HighAvailability: "zone", Hours: resource.MustParse("2")}},
+					Storage:       []gardencorev1beta1.ProjectStorageUsage{{Class: "standard", GibibyteHours: resource.MustParse("80")}},
+					CostUnits:     ptr.To(resource.MustParse("7.5")),
+				},
+			},
+		})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "bar"}})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "baz"},
+			Status: gardencorev1beta1.ProjectStatus{
+				Usage: &gardencorev1beta1.ProjectUsage{
+					PeriodStartTime: metav1.NewTime(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)),
+					LastUpdateTime:  metav1.NewTime(time.Date(2026, 10, 1, 1, 0, 0, 0, time.UTC)),
+				},
+				LastPeriodUsage: &gardencorev1beta1.ProjectUsage{
+					PeriodStartTime: metav1.NewTime(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)),
+					LastUpdateTime:  metav1.NewTime(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)),
+					Shoots:          gardencorev1beta1.ProjectShootUsage{AwakeHours: resource.MustParse("720")},
+				},
+			},
+		})).To(Succeed())
+	})
+
+	It("should export the usage as JSON by default", func() {
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ExportPath, nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		var records []Record
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &records)).To(Succeed())
+		Expect(records).To(HaveLen(2))
+		Expect(records[0].Project).To(Equal("baz"))
+		Expect(records[0].LastPeriodUsage.Shoots.AwakeHours.String()).To(Equal("720"))
+		Expect(records[1].Project).To(Equal("foo"))
+		Expect(records[1].Namespace).To(Equal("garden-foo"))
+		Expect(records[1].Usage.Machines[0].Hours.String()).To(Equal("5"))
+		Expect(records[1].LastPeriodUsage).To(BeNil())
+	})
+
+	It("should export the usage as CSV", func() {
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ExportPath+"?format=csv", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("text/csv"))
+		Expect(recorder.Body.String()).To(Equal(`project,namespace,periodStartTime,lastUpdateTime,category,name,unit,value
+baz,,2026-09-01T00:00:00Z,2026-10-01T00:00:00Z,shoot,awake,hours,720
+baz,,2026-09-01T00:00:00Z,2026-10-01T00:00:00Z,shoot,hibernated,hours,0
+baz,,2026-10-01T00:00:00Z,2026-10-01T01:00:00Z,shoot,awake,hours,0
+baz,,2026-10-01T00:00:00Z,2026-10-01T01:00:00Z,shoot,hibernated,hours,0
+foo,garden-foo,2026-10-01T00:00:00Z,2026-10-17T12:00:00Z,machine,small,hours,5
+foo,garden-foo,2026-10-01T00:00:00Z,2026-10-17T12:00:00Z,machine,small,costunits,7.5
+foo,garden-foo,2026-10-01T00:00:00Z,2026-10-17T12:00:00Z,shoot,awake,hours,12
+foo,garden-foo,2026-10-01T00:00:00Z,2026-10-17T12:00:00Z,shoot,hibernated,hours,2.5
+foo,garden-foo,2026-10-01T00:00:00Z,2026-10-17T12:00:00Z,controlplane,zone,hours,2
+foo,garden-foo,2026-10-01T00:00:00Z,2026-10-17T12:00:00Z,storage,standard,gibibytehours,80
+foo,garden-foo,2026-10-01T00:00:00Z,2026-10-17T12:00:00Z,total,costunits,costunits,7.5
+`))
+	})
+
+	It("should reject unsupported formats", func() {
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ExportPath+"?format=xml", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+})
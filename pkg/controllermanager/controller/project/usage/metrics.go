@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	metricsNamespace = "gardener_controller_manager"
+	metricsSubsystem = "project_usage"
+
+	labelProject = "project"
+)
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	machineHours = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "machine_hours",
+			Help:      "Machine hours of the project's shoot clusters per machine type in the current accounting period.",
+		},
+		[]string{labelProject, "machine_type"},
+	)
+
+	machineCostUnits = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "machine_cost_units",
+			Help:      "Cost units of the project's shoot clusters per machine type in the current accounting period.",
+		},
+		[]string{labelProject, "machine_type"},
+	)
+
+	shootHours = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "shoot_hours",
+			Help:      "Hours the project's shoot clusters were awake or hibernated in the current accounting period. The value of the label 'state' can either be 'awake' or 'hibernated'.",
+		},
+		[]string{labelProject, "state"},
+	)
+
+	controlPlaneHours = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "control_plane_hours",
+			Help:      "Hours of the project's awake control planes per high availability mode in the current accounting period.",
+		},
+		[]string{labelProject, "high_availability"},
+	)
+
+	storageGibibyteHours = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "storage_gibibyte_hours",
+			Help:      "Gibibyte hours of the worker node volumes of the project's shoot clusters per volume class in the current accounting period.",
+		},
+		[]string{labelProject, "class"},
+	)
+
+	costUnits = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "cost_units",
+			Help:      "Cost units of all machines of the project's shoot clusters in the current accounting period.",
+		},
+		[]string{labelProject},
+	)
+
+	gaugeVecs = []*prometheus.GaugeVec{machineHours, machineCostUnits, shootHours, controlPlaneHours, storageGibibyteHours, costUnits}
+)
+
+func recordMetrics(projectName string, usage *gardencorev1beta1.ProjectUsage) {
+	deleteMetrics(projectName)
+
+	for _, machine := range usage.Machines {
+		machineHours.WithLabelValues(projectName, machine.Type).Set(machine.Hours.AsApproximateFloat64())
+		if machine.CostUnits != nil {
+			machineCostUnits.WithLabelValues(projectName, machine.Type).Set(machine.CostUnits.AsApproximateFloat64())
+		}
+	}
+
+	shootHours.WithLabelValues(projectName, "awake").Set(usage.Shoots.AwakeHours.AsApproximateFloat64())
+	shootHours.WithLabelValues(projectName, "hibernated").Set(usage.Shoots.HibernatedHours.AsApproximateFloat64())
+
+	for _, controlPlane := range usage.ControlPlanes {
+		controlPlaneHours.WithLabelValues(projectName, controlPlane.HighAvailability).Set(controlPlane.Hours.AsApproximateFloat64())
+	}
+
+	for _, storage := range usage.Storage {
+		storageGibibyteHours.WithLabelValues(projectName, storage.Class).Set(storage.GibibyteHours.AsApproximateFloat64())
+	}
+
+	if usage.CostUnits != nil {
+		costUnits.WithLabelValues(projectName).Set(usage.CostUnits.AsApproximateFloat64())
+	}
+}
+
+func deleteMetrics(projectName string) {
+	for _, gaugeVec := range gaugeVecs {
+		gaugeVec.DeletePartialMatch(prometheus.Labels{labelProject: projectName})
+	}
+}
@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// HighAvailabilityNone is the high availability mode of control planes without failure tolerance.
+const HighAvailabilityNone = "none"
+
+// Reconciler periodically accumulates the resources consumed by the shoot clusters of Projects.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.ProjectControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile periodically accumulates the resources consumed by the shoot clusters of Projects.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			deleteMetrics(request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.Spec.Namespace == nil {
+		return reconcile.Result{RequeueAfter: r.Config.UsageSyncPeriod.Duration}, nil
+	}
+
+	usage, lastPeriodUsage, err := r.accumulateUsage(ctx, log, project)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	patch := client.MergeFrom(project.DeepCopy())
+	project.Status.Usage = usage
+	if lastPeriodUsage != nil {
+		project.Status.LastPeriodUsage = lastPeriodUsage
+	}
+	if err := r.Client.Status().Patch(ctx, project, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed patching usage in project status: %w", err)
+	}
+
+	recordMetrics(project.Name, usage)
+
+	return reconcile.Result{RequeueAfter: r.Config.UsageSyncPeriod.Duration}, nil
+}
+
+// accumulateUsage adds the resources consumed by the shoot clusters of the given Project since the last update to its
+// usage. The current state of the shoot clusters is assumed for the whole time since the last update. The usage is
+// reset at the beginning of each month. In this case, the time until the end of the previous accounting period is
+// added to its usage which is returned as well.
+func (r *Reconciler) accumulateUsage(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project) (*gardencorev1beta1.ProjectUsage, *gardencorev1beta1.ProjectUsage, error) {
+	var (
+		// The time is truncated since metav1.Time is serialized with a precision of seconds only.
+		now             = r.Clock.Now().UTC().Truncate(time.Second)
+		periodStart     = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		usage           = project.Status.Usage.DeepCopy()
+		lastPeriodUsage *gardencorev1beta1.ProjectUsage
+	)
+
+	if usage == nil {
+		return &gardencorev1beta1.ProjectUsage{
+			PeriodStartTime: metav1.NewTime(periodStart),
+			LastUpdateTime:  metav1.NewTime(now),
+		}, nil, nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		return nil, nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	if usage.PeriodStartTime.Time.Before(periodStart) {
+		log.Info("Starting new accounting period", "periodStartTime", periodStart)
+
+		// Usually, the previous period ends at the start of the current one. If the usage was not updated for a longer
+		// time, the periods in between are not accounted.
+		lastPeriodUsage = usage
+		r.accumulateShootsUsage(ctx, log, lastPeriodUsage, shootList.Items, lastPeriodUsage.PeriodStartTime.AddDate(0, 1, 0))
+
+		usage = &gardencorev1beta1.ProjectUsage{
+			PeriodStartTime: metav1.NewTime(periodStart),
+			LastUpdateTime:  metav1.NewTime(periodStart),
+		}
+	}
+
+	r.accumulateShootsUsage(ctx, log, usage, shootList.Items, now)
+	return usage, lastPeriodUsage, nil
+}
+
+// accumulateShootsUsage adds the resources consumed by the given shoot clusters between the last update of the usage
+// and the given time to the usage.
+func (r *Reconciler) accumulateShootsUsage(ctx context.Context, log logr.Logger, usage *gardencorev1beta1.ProjectUsage, shoots []gardencorev1beta1.Shoot, until time.Time) {
+	elapsed := until.Sub(usage.LastUpdateTime.Time)
+	if elapsed <= 0 {
+		return
+	}
+
+	for _, shoot := range shoots {
+		if err := r.accumulateShootUsage(ctx, usage, &shoot, elapsed.Hours()); err != nil {
+			log.Error(err, "Failed accumulating usage of shoot, skipping it", "shoot", client.ObjectKeyFromObject(&shoot))
+		}
+	}
+
+	slices.SortFunc(usage.Machines, func(a, b gardencorev1beta1.ProjectMachineUsage) int { return strings.Compare(a.Type, b.Type) })
+	slices.SortFunc(usage.ControlPlanes, func(a, b gardencorev1beta1.ProjectControlPlaneUsage) int {
+		return strings.Compare(a.HighAvailability, b.HighAvailability)
+	})
+	slices.SortFunc(usage.Storage, func(a, b gardencorev1beta1.ProjectStorageUsage) int { return strings.Compare(a.Class, b.Class) })
+
+	usage.LastUpdateTime = metav1.NewTime(until)
+}
+
+func (r *Reconciler) accumulateShootUsage(ctx context.Context, usage *gardencorev1beta1.ProjectUsage, shoot *gardencorev1beta1.Shoot, hours float64) error {
+	if shoot.Status.IsHibernated {
+		addValue(&usage.Shoots.HibernatedHours, hours)
+		return nil
+	}
+
+	addValue(&usage.Shoots.AwakeHours, hours)
+	addValue(&controlPlaneUsage(usage, highAvailabilityMode(shoot)).Hours, hours)
+
+	if len(shoot.Status.WorkerPools) == 0 {
+		return nil
+	}
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return fmt.Errorf("failed getting cloud profile: %w", err)
+	}
+
+	// The number of nodes per worker pool is observed by gardenlet. Worker pools without observed nodes are not
+	// accounted.
+	for _, workerPool := range shoot.Status.WorkerPools {
+		i := slices.IndexFunc(shoot.Spec.Provider.Workers, func(worker gardencorev1beta1.Worker) bool { return worker.Name == workerPool.Name })
+		if i < 0 || workerPool.Nodes <= 0 {
+			continue
+		}
+		worker := shoot.Spec.Provider.Workers[i]
+
+		var (
+			machineHours = hours * float64(workerPool.Nodes)
+			machine      = machineUsage(usage, worker.Machine.Type)
+			machineType  = v1beta1helper.FindMachineTypeByName(cloudProfile.Spec.MachineTypes, worker.Machine.Type)
+		)
+
+		addValue(&machine.Hours, machineHours)
+
+		if machineType == nil {
+			continue
+		}
+
+		if machineType.CostUnits != nil {
+			if machine.CostUnits == nil {
+				machine.CostUnits = &resource.Quantity{}
+			}
+			if usage.CostUnits == nil {
+				usage.CostUnits = &resource.Quantity{}
+			}
+			machineCostUnits := machineHours * machineType.CostUnits.AsApproximateFloat64()
+			addValue(machine.CostUnits, machineCostUnits)
+			addValue(usage.CostUnits, machineCostUnits)
+		}
+
+		if class, size := workerStorage(worker, machineType, cloudProfile.Spec.VolumeTypes); class != "" {
+			addValue(&storageUsage(usage, class).GibibyteHours, machineHours*size.AsApproximateFloat64()/(1<<30))
+		}
+	}
+
+	return nil
+}
+
+// workerStorage returns the volume class and size of the nodes of the given worker pool. The root volume of the
+// machine type is used if the worker pool has no volume configuration. An empty class is returned if it is unknown.
+func workerStorage(worker gardencorev1beta1.Worker, machineType *gardencorev1beta1.MachineType, volumeTypes []gardencorev1beta1.VolumeType) (string, resource.Quantity) {
+	if worker.Volume == nil {
+		if machineType.Storage != nil && machineType.Storage.StorageSize != nil {
+			return machineType.Storage.Class, *machineType.Storage.StorageSize
+		}
+		return "", resource.Quantity{}
+	}
+
+	size, err := resource.ParseQuantity(worker.Volume.VolumeSize)
+	if err != nil {
+		return "", resource.Quantity{}
+	}
+
+	if machineType.Storage != nil {
+		return machineType.Storage.Class, size
+	}
+
+	for _, volumeType := range volumeTypes {
+		if worker.Volume.Type != nil && volumeType.Name == *worker.Volume.Type {
+			return volumeType.Class, size
+		}
+	}
+
+	return "", resource.Quantity{}
+}
+
+func highAvailabilityMode(shoot *gardencorev1beta1.Shoot) string {
+	if failureToleranceType := v1beta1helper.GetFailureToleranceType(shoot); failureToleranceType != nil {
+		return string(*failureToleranceType)
+	}
+	return HighAvailabilityNone
+}
+
+func machineUsage(usage *gardencorev1beta1.ProjectUsage, machineType string) *gardencorev1beta1.ProjectMachineUsage {
+	for i := range usage.Machines {
+		if usage.Machines[i].Type == machineType {
+			return &usage.Machines[i]
+		}
+	}
+	usage.Machines = append(usage.Machines, gardencorev1beta1.ProjectMachineUsage{Type: machineType})
+	return &usage.Machines[len(usage.Machines)-1]
+}
+
+func controlPlaneUsage(usage *gardencorev1beta1.ProjectUsage, highAvailability string) *gardencorev1beta1.ProjectControlPlaneUsage {
+	for i := range usage.ControlPlanes {
+		if usage.ControlPlanes[i].HighAvailability == highAvailability {
+			return &usage.ControlPlanes[i]
+		}
+	}
+	usage.ControlPlanes = append(usage.ControlPlanes, gardencorev1beta1.ProjectControlPlaneUsage{HighAvailability: highAvailability})
+	return &usage.ControlPlanes[len(usage.ControlPlanes)-1]
+}
+
+func storageUsage(usage *gardencorev1beta1.ProjectUsage, class string) *gardencorev1beta1.ProjectStorageUsage {
+	for i := range usage.Storage {
+		if usage.Storage[i].Class == class {
+			return &usage.Storage[i]
+		}
+	}
+	usage.Storage = append(usage.Storage, gardencorev1beta1.ProjectStorageUsage{Class: class})
+	return &usage.Storage[len(usage.Storage)-1]
+}
+
+// addValue adds the given value to the quantity with a precision of three decimal places.
+func addValue(quantity *resource.Quantity, value float64) {
+	quantity.Add(*resource.NewMilliQuantity(int64(math.Round(value*1000)), resource.DecimalSI))
+}
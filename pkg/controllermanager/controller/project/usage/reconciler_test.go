@@ -0,0 +1,263 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage_test
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/usage"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		now     = time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+		request = reconcile.Request{NamespacedName: client.ObjectKey{Name: "foo"}}
+
+		project      *gardencorev1beta1.Project
+		cloudProfile *gardencorev1beta1.CloudProfile
+		awakeShoot   *gardencorev1beta1.Shoot
+		hibernated   *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Project{}).Build()
+		fakeClock = testclock.NewFakeClock(now)
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: controllermanagerconfigv1alpha1.ProjectControllerConfiguration{UsageSyncPeriod: &metav1.Duration{Duration: time.Hour}},
+			Clock:  fakeClock,
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{
+					{
+						Name:      "small",
+						CostUnits: ptr.To(resource.MustParse("1.5")),
+					},
+					{
+						Name: "large",
+						Storage: &gardencorev1beta1.MachineTypeStorage{
+							Class:       gardencorev1beta1.VolumeClassPremium,
+							StorageSize: ptr.To(resource.MustParse("100Gi")),
+						},
+					},
+				},
+				VolumeTypes: []gardencorev1beta1.VolumeType{
+					{Name: "standard", Class: gardencorev1beta1.VolumeClassStandard},
+				},
+			},
+		}
+
+		awakeShoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "awake", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"},
+				ControlPlane: &gardencorev1beta1.ControlPlane{
+					HighAvailability: &gardencorev1beta1.HighAvailability{
+						FailureTolerance: gardencorev1beta1.FailureTolerance{Type: gardencorev1beta1.FailureToleranceTypeZone},
+					},
+				},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{
+						{
+							Name:    "small",
+							Machine: gardencorev1beta1.Machine{Type: "small"},
+							Minimum: 2,
+							Volume:  &gardencorev1beta1.Volume{Type: ptr.To("standard"), VolumeSize: "20Gi"},
+						},
+						{
+							Name:    "large",
+							Machine: gardencorev1beta1.Machine{Type: "large"},
+							Minimum: 1,
+						},
+						{
+							Name:    "scaled-to-zero",
+							Machine: gardencorev1beta1.Machine{Type: "large"},
+							Minimum: 0,
+						},
+						{
+							Name:    "not-observed",
+							Machine: gardencorev1beta1.Machine{Type: "large"},
+							Minimum: 1,
+						},
+					},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				WorkerPools: []gardencorev1beta1.WorkerPoolStatus{
+					{Name: "small", Nodes: 3},
+					{Name: "large", Nodes: 1},
+					{Name: "scaled-to-zero", Nodes: 0},
+					{Name: "removed", Nodes: 4},
+				},
+			},
+		}
+
+		hibernated = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "hibernated", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{
+						{Name: "small", Machine: gardencorev1beta1.Machine{Type: "small"}, Minimum: 5},
+					},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{IsHibernated: true},
+		}
+
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, awakeShoot)).To(Succeed())
+		Expect(fakeClient.Create(ctx, hibernated)).To(Succeed())
+	})
+
+	It("should do nothing if the project is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should start accounting if the project has no usage yet", func() {
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.Usage).NotTo(BeNil())
+		Expect(project.Status.Usage.PeriodStartTime.UTC()).To(Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(project.Status.Usage.LastUpdateTime.UTC()).To(Equal(now))
+		Expect(project.Status.Usage.Machines).To(BeEmpty())
+	})
+
+	It("should accumulate the usage of the shoots since the last update", func() {
+		project.Status.Usage = &gardencorev1beta1.ProjectUsage{
+			PeriodStartTime: metav1.NewTime(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)),
+			LastUpdateTime:  metav1.NewTime(now.Add(-2 * time.Hour)),
+			Machines: []gardencorev1beta1.ProjectMachineUsage{
+				{Type: "small", Hours: resource.MustParse("1"), CostUnits: ptr.To(resource.MustParse("1.5"))},
+			},
+			Shoots:    gardencorev1beta1.ProjectShootUsage{AwakeHours: resource.MustParse("10")},
+			CostUnits: ptr.To(resource.MustParse("1.5")),
+		}
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		usage := project.Status.Usage
+		Expect(usage.LastUpdateTime.UTC()).To(Equal(now))
+
+		Expect(usage.Machines).To(HaveLen(2))
+		Expect(usage.Machines[0].Type).To(Equal("large"))
+		Expect(usage.Machines[0].Hours.String()).To(Equal("2"))
+		Expect(usage.Machines[0].CostUnits).To(BeNil())
+		Expect(usage.Machines[1].Type).To(Equal("small"))
+		Expect(usage.Machines[1].Hours.String()).To(Equal("7"))
+		Expect(usage.Machines[1].CostUnits.String()).To(Equal("10500m"))
+
+		Expect(usage.Shoots.AwakeHours.String()).To(Equal("12"))
+		Expect(usage.Shoots.HibernatedHours.String()).To(Equal("2"))
+
+		Expect(usage.ControlPlanes).To(HaveLen(1))
+		Expect(usage.ControlPlanes[0].HighAvailability).To(Equal("zone"))
+		Expect(usage.ControlPlanes[0].Hours.String()).To(Equal("2"))
+
+		Expect(usage.Storage).To(HaveLen(2))
+		Expect(usage.Storage[0].Class).To(Equal("premium"))
+		Expect(usage.Storage[0].GibibyteHours.String()).To(Equal("200"))
+		Expect(usage.Storage[1].Class).To(Equal("standard"))
+		Expect(usage.Storage[1].GibibyteHours.String()).To(Equal("120"))
+
+		Expect(usage.CostUnits.String()).To(Equal("10500m"))
+
+		Expect(testutil.GatherAndCompare(runtimemetrics.Registry, strings.NewReader(`
+# HELP gardener_controller_manager_project_usage_cost_units Cost units of all machines of the project's shoot clusters in the current accounting period.
+# TYPE gardener_controller_manager_project_usage_cost_units gauge
+gardener_controller_manager_project_usage_cost_units{project="foo"} 10.5
+# HELP gardener_controller_manager_project_usage_machine_hours Machine hours of the project's shoot clusters per machine type in the current accounting period.
+# TYPE gardener_controller_manager_project_usage_machine_hours gauge
+gardener_controller_manager_project_usage_machine_hours{machine_type="large",project="foo"} 2
+gardener_controller_manager_project_usage_machine_hours{machine_type="small",project="foo"} 7
+`), "gardener_controller_manager_project_usage_cost_units", "gardener_controller_manager_project_usage_machine_hours")).To(Succeed())
+
+		By("Delete project")
+		Expect(fakeClient.Delete(ctx, project)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(testutil.GatherAndCount(runtimemetrics.Registry, "gardener_controller_manager_project_usage_machine_hours")).To(BeZero())
+	})
+
+	It("should account the time until the end of the previous accounting period when a new one starts", func() {
+		fakeClock.SetTime(time.Date(2026, 11, 1, 0, 30, 0, 0, time.UTC))
+
+		project.Status.Usage = &gardencorev1beta1.ProjectUsage{
+			PeriodStartTime: metav1.NewTime(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)),
+			LastUpdateTime:  metav1.NewTime(time.Date(2026, 10, 31, 23, 30, 0, 0, time.UTC)),
+			Shoots:          gardencorev1beta1.ProjectShootUsage{AwakeHours: resource.MustParse("100")},
+		}
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.Usage.PeriodStartTime.UTC()).To(Equal(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(project.Status.Usage.Shoots.AwakeHours.String()).To(Equal("500m"))
+		Expect(project.Status.Usage.Shoots.HibernatedHours.String()).To(Equal("500m"))
+		Expect(project.Status.Usage.Machines[1].Hours.String()).To(Equal("1500m"))
+
+		Expect(project.Status.LastPeriodUsage.PeriodStartTime.UTC()).To(Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(project.Status.LastPeriodUsage.LastUpdateTime.UTC()).To(Equal(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(project.Status.LastPeriodUsage.Shoots.AwakeHours.String()).To(Equal("100500m"))
+		Expect(project.Status.LastPeriodUsage.Shoots.HibernatedHours.String()).To(Equal("500m"))
+		Expect(project.Status.LastPeriodUsage.Machines[1].Hours.String()).To(Equal("1500m"))
+	})
+
+	It("should keep the usage of the previous accounting period", func() {
+		lastPeriodUsage := &gardencorev1beta1.ProjectUsage{
+			PeriodStartTime: metav1.NewTime(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)),
+			LastUpdateTime:  metav1.NewTime(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)),
+			Shoots:          gardencorev1beta1.ProjectShootUsage{AwakeHours: resource.MustParse("720")},
+		}
+		project.Status.LastPeriodUsage = lastPeriodUsage.DeepCopy()
+		project.Status.Usage = &gardencorev1beta1.ProjectUsage{
+			PeriodStartTime: metav1.NewTime(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)),
+			LastUpdateTime:  metav1.NewTime(now.Add(-time.Hour)),
+		}
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.Usage.Shoots.AwakeHours.String()).To(Equal("1"))
+		Expect(project.Status.LastPeriodUsage.Shoots.AwakeHours.String()).To(Equal("720"))
+	})
+})
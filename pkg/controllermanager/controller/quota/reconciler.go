@@ -7,20 +7,27 @@ package quota
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/apis/core/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 // Reconciler reconciles Quota.
@@ -30,7 +37,8 @@ type Reconciler struct {
 	Recorder record.EventRecorder
 }
 
-// Reconcile performs the main reconciliation logic.
+// Reconcile performs the main reconciliation logic. Besides protecting Quotas which are still referenced from deletion,
+// it periodically reports the current usage of Quotas in their status.
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(ctx)
 
@@ -89,5 +97,127 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
-	return reconcile.Result{}, nil
+	used, err := r.computeUsage(ctx, log, quota)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing usage: %w", err)
+	}
+
+	if !apiequality.Semantic.DeepEqual(quota.Status.Used, used) {
+		log.V(1).Info("Updating usage in status")
+		patch := client.MergeFrom(quota.DeepCopy())
+		quota.Status.Used = used
+		if err := r.Client.Status().Patch(ctx, quota, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed updating usage in status: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// computeUsage computes the amount of the resources constrained by the given Quota which are allocated by the Shoots
+// referencing the Quota via their SecretBinding or CredentialsBinding. The amounts are computed in the same way as by
+// the quota admission plugin, i.e., the limits of project-scoped Quotas apply to the Shoots of each project separately.
+// Hence, the highest amounts allocated within a single project are reported for them.
+func (r *Reconciler) computeUsage(ctx context.Context, log logr.Logger, quota *gardencorev1beta1.Quota) (corev1.ResourceList, error) {
+	scope, err := helper.QuotaScope(quota.Spec.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	secretBindings := map[string]sets.Set[string]{}
+	secretBindingList := &gardencorev1beta1.SecretBindingList{}
+	if err := r.Client.List(ctx, secretBindingList); err != nil {
+		return nil, err
+	}
+	for _, binding := range secretBindingList.Items {
+		if referencesQuota(binding.Quotas, quota) {
+			addBinding(secretBindings, binding.Namespace, binding.Name)
+		}
+	}
+
+	credentialsBindings := map[string]sets.Set[string]{}
+	credentialsBindingList := &securityv1alpha1.CredentialsBindingList{}
+	if err := r.Client.List(ctx, credentialsBindingList); err != nil {
+		return nil, err
+	}
+	for _, binding := range credentialsBindingList.Items {
+		if referencesQuota(binding.Quotas, quota) {
+			addBinding(credentialsBindings, binding.Namespace, binding.Name)
+		}
+	}
+
+	allocated := gardenerutils.SumQuotaResources()
+	for _, namespace := range sets.KeySet(secretBindings).Union(sets.KeySet(credentialsBindings)).UnsortedList() {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+
+		allocatedInNamespace := gardenerutils.SumQuotaResources()
+
+		for _, shoot := range shootList.Items {
+			if !secretBindings[namespace].Has(ptr.Deref(shoot.Spec.SecretBindingName, "")) &&
+				!credentialsBindings[namespace].Has(ptr.Deref(shoot.Spec.CredentialsBindingName, "")) {
+				continue
+			}
+
+			shootResources, err := r.shootResources(ctx, &shoot)
+			if err != nil {
+				// The Shoot might reference machine or volume types which were removed from the CloudProfile in the
+				// meantime. It is ignored so that the usage of the other Shoots is still reported.
+				log.Error(err, "Failed computing resources of Shoot, ignoring it", "shoot", client.ObjectKeyFromObject(&shoot))
+				continue
+			}
+
+			allocatedInNamespace = gardenerutils.SumQuotaResources(allocatedInNamespace, shootResources)
+		}
+
+		if scope == "project" {
+			allocated = maxQuotaResources(allocated, allocatedInNamespace)
+		} else {
+			allocated = gardenerutils.SumQuotaResources(allocated, allocatedInNamespace)
+		}
+	}
+
+	used := make(corev1.ResourceList, len(quota.Spec.Metrics))
+	for metric := range quota.Spec.Metrics {
+		if quantity, ok := allocated[metric]; ok {
+			used[metric] = quantity
+		}
+	}
+
+	return used, nil
+}
+
+func (r *Reconciler) shootResources(ctx context.Context, shoot *gardencorev1beta1.Shoot) (corev1.ResourceList, error) {
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading CloudProfile: %w", err)
+	}
+
+	return gardenerutils.ShootQuotaResources(shoot, &cloudProfile.Spec)
+}
+
+// maxQuotaResources returns the maximum of each quantity in the given resource lists.
+func maxQuotaResources(a, b corev1.ResourceList) corev1.ResourceList {
+	result := a.DeepCopy()
+	for name, quantity := range b {
+		if current, ok := result[name]; !ok || quantity.Cmp(current) > 0 {
+			result[name] = quantity
+		}
+	}
+	return result
+}
+
+func referencesQuota(quotaRefs []corev1.ObjectReference, quota *gardencorev1beta1.Quota) bool {
+	return slices.ContainsFunc(quotaRefs, func(quotaRef corev1.ObjectReference) bool {
+		return quotaRef.Name == quota.Name && quotaRef.Namespace == quota.Namespace
+	})
+}
+
+func addBinding(bindings map[string]sets.Set[string], namespace, name string) {
+	if _, ok := bindings[namespace]; !ok {
+		bindings[namespace] = sets.New[string]()
+	}
+	bindings[namespace].Insert(name)
 }
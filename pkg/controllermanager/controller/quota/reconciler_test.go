@@ -6,13 +6,16 @@ package quota
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -20,6 +23,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -38,14 +42,21 @@ var _ = Describe("Reconciler", func() {
 	)
 
 	BeforeEach(func() {
-		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Quota{}).Build()
 
 		quotaName = "test-quota"
-		reconciler = &Reconciler{Client: fakeClient, Recorder: &record.FakeRecorder{}}
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Config:   controllermanagerconfigv1alpha1.QuotaControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: time.Minute}},
+			Recorder: &record.FakeRecorder{},
+		}
 		quota = &gardencorev1beta1.Quota{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: quotaName,
 			},
+			Spec: gardencorev1beta1.QuotaSpec{
+				Scope: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret"},
+			},
 		}
 
 		secretBinding = &gardencorev1beta1.SecretBinding{
@@ -83,12 +94,149 @@ var _ = Describe("Reconciler", func() {
 		It("should ensure the finalizer", func() {
 			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
 			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
-			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(quota.GetFinalizers()).Should(ConsistOf(finalizerName))
 		})
 	})
 
+	Context("usage", func() {
+		var cloudProfile *gardencorev1beta1.CloudProfile
+
+		BeforeEach(func() {
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					MachineTypes: []gardencorev1beta1.MachineType{{
+						Name:      "m1",
+						CPU:       resource.MustParse("2"),
+						Memory:    resource.MustParse("4Gi"),
+						CostUnits: ptr.To(resource.MustParse("0.5")),
+					}},
+					VolumeTypes: []gardencorev1beta1.VolumeType{{Name: "standard", Class: gardencorev1beta1.VolumeClassStandard}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+			quota.Spec.Metrics = corev1.ResourceList{
+				"cpu":       resource.MustParse("100"),
+				"shoots":    resource.MustParse("10"),
+				"nodes":     resource.MustParse("50"),
+				"costunits": resource.MustParse("20"),
+			}
+			Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+			Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+			Expect(fakeClient.Create(ctx, credentialsBinding)).To(Succeed())
+		})
+
+		newShootInNamespace := func(name, namespace string, maximum int32) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName: ptr.To(cloudProfile.Name),
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{{
+							Name:    "worker",
+							Machine: gardencorev1beta1.Machine{Type: "m1"},
+							Maximum: maximum,
+							Volume:  &gardencorev1beta1.Volume{Type: ptr.To("standard"), VolumeSize: "10Gi"},
+						}},
+					},
+				},
+			}
+		}
+
+		newShoot := func(name string, maximum int32) *gardencorev1beta1.Shoot {
+			return newShootInNamespace(name, "test-namespace", maximum)
+		}
+
+		It("should report the usage of the shoots referencing the quota", func() {
+			shoot1 := newShoot("shoot1", 3)
+			shoot1.Spec.SecretBindingName = ptr.To(secretBinding.Name)
+			Expect(fakeClient.Create(ctx, shoot1)).To(Succeed())
+
+			shoot2 := newShoot("shoot2", 2)
+			shoot2.Spec.CredentialsBindingName = ptr.To(credentialsBinding.Name)
+			Expect(fakeClient.Create(ctx, shoot2)).To(Succeed())
+
+			shoot3 := newShoot("shoot3", 10)
+			shoot3.Spec.SecretBindingName = ptr.To("other-binding")
+			Expect(fakeClient.Create(ctx, shoot3)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+			Expect(quota.Status.Used).To(HaveLen(4))
+			Expect(quota.Status.Used.Cpu().String()).To(Equal("10"))
+			Expect(quota.Status.Used.Name("shoots", resource.DecimalSI).String()).To(Equal("2"))
+			Expect(quota.Status.Used.Name("nodes", resource.DecimalSI).String()).To(Equal("5"))
+			Expect(quota.Status.Used.Name("costunits", resource.DecimalSI).String()).To(Equal("2500m"))
+		})
+
+		It("should report the highest usage of a single project for project-scoped quotas", func() {
+			patch := client.MergeFrom(quota.DeepCopy())
+			quota.Spec.Scope = corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project"}
+			Expect(fakeClient.Patch(ctx, quota, patch)).To(Succeed())
+
+			otherSecretBinding := secretBinding.DeepCopy()
+			otherSecretBinding.ResourceVersion = ""
+			otherSecretBinding.Namespace = "other-namespace"
+			Expect(fakeClient.Create(ctx, otherSecretBinding)).To(Succeed())
+
+			shoot1 := newShoot("shoot1", 3)
+			shoot1.Spec.SecretBindingName = ptr.To(secretBinding.Name)
+			Expect(fakeClient.Create(ctx, shoot1)).To(Succeed())
+
+			shoot2 := newShoot("shoot2", 2)
+			shoot2.Spec.CredentialsBindingName = ptr.To(credentialsBinding.Name)
+			Expect(fakeClient.Create(ctx, shoot2)).To(Succeed())
+
+			shoot3 := newShootInNamespace("shoot3", otherSecretBinding.Namespace, 6)
+			shoot3.Spec.SecretBindingName = ptr.To(otherSecretBinding.Name)
+			Expect(fakeClient.Create(ctx, shoot3)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+			Expect(quota.Status.Used).To(HaveLen(4))
+			Expect(quota.Status.Used.Cpu().String()).To(Equal("12"))
+			Expect(quota.Status.Used.Name("shoots", resource.DecimalSI).String()).To(Equal("2"))
+			Expect(quota.Status.Used.Name("nodes", resource.DecimalSI).String()).To(Equal("6"))
+			Expect(quota.Status.Used.Name("costunits", resource.DecimalSI).String()).To(Equal("3"))
+		})
+
+		It("should report zero usage if no shoot references the quota", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+			Expect(quota.Status.Used).To(HaveLen(4))
+			for metric, quantity := range quota.Status.Used {
+				Expect(quantity.IsZero()).To(BeTrue(), "metric %s", metric)
+			}
+		})
+
+		It("should ignore shoots whose resources cannot be computed", func() {
+			shoot1 := newShoot("shoot1", 3)
+			shoot1.Spec.SecretBindingName = ptr.To(secretBinding.Name)
+			Expect(fakeClient.Create(ctx, shoot1)).To(Succeed())
+
+			shoot2 := newShoot("shoot2", 2)
+			shoot2.Spec.SecretBindingName = ptr.To(secretBinding.Name)
+			shoot2.Spec.Provider.Workers[0].Machine.Type = "unknown"
+			Expect(fakeClient.Create(ctx, shoot2)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+			Expect(quota.Status.Used.Name("nodes", resource.DecimalSI).String()).To(Equal("3"))
+		})
+	})
+
 	Context("when deletion timestamp set", func() {
 		BeforeEach(func() {
 			quota.Finalizers = []string{finalizerName}
@@ -17,6 +17,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/quota"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/retry"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/staterevision"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/statuslabel"
 )
 
@@ -62,6 +63,12 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding retry reconciler: %w", err)
 	}
 
+	if err := (&staterevision.Reconciler{
+		Config: *cfg.Controllers.ShootStateRevision,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding state revision reconciler: %w", err)
+	}
+
 	if err := (&statuslabel.Reconciler{
 		Config: *cfg.Controllers.ShootStatusLabel,
 	}).AddToManager(mgr); err != nil {
@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -45,12 +46,24 @@ type parsedHibernationSchedule struct {
 	location  time.Location
 	schedule  cron.Schedule
 	operation operation
+	// exceptions contains the dates (format YYYY-MM-DD) in the schedule's location on which the schedule is not
+	// activated.
+	exceptions sets.Set[string]
 }
 
 // next returns the time in UTC from the schedule, that is immediately after the input time 't'.
 // The input 't' is converted in the schedule's location before any calculations are done.
 func (s *parsedHibernationSchedule) next(t time.Time) time.Time {
-	return s.schedule.Next(t.In(&s.location)).UTC()
+	n := s.schedule.Next(t.In(&s.location))
+	for !n.IsZero() && s.isException(n) {
+		n = s.schedule.Next(n)
+	}
+	return n.UTC()
+}
+
+// isException returns whether the given activation time falls on one of the exception dates of the schedule.
+func (s *parsedHibernationSchedule) isException(t time.Time) bool {
+	return s.exceptions.Has(t.In(&s.location).Format(time.DateOnly))
 }
 
 // previous returns the time in UTC from the schedule that is immediately before 'to' and after 'from'.
@@ -60,7 +73,10 @@ func (s *parsedHibernationSchedule) previous(from, to time.Time) *time.Time {
 	// To get the time that is immediately before `to`, iterate over every activation time in the cron schedule
 	// that is after "from" until the one that is immediately after `to` is reached.
 	var previousActivationTime *time.Time
-	for t := s.schedule.Next(from.In(&s.location)); !t.UTC().After(to.UTC()); t = s.schedule.Next(t) {
+	for t := s.schedule.Next(from.In(&s.location)); !t.IsZero() && !t.UTC().After(to.UTC()); t = s.schedule.Next(t) {
+		if s.isException(t) {
+			continue
+		}
 		inUTC := t.UTC()
 		previousActivationTime = &inUTC
 	}
@@ -121,10 +137,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// hibernated or wakeup the at a later time.
 	mostRecentSchedule := getScheduleWithMostRecentTime(parsedSchedules, r.Config.TriggerDeadlineDuration, shoot, now)
 	if mostRecentSchedule != nil {
-		if err := r.hibernateOrWakeUpShootBasedOnSchedule(ctx, shoot, mostRecentSchedule, now); err != nil {
+		maintenanceFreeze, window, err := gardenerutils.ActiveMaintenanceFreeze(ctx, r.Client, shoot, now)
+		if err != nil {
 			return reconcile.Result{}, err
 		}
-		log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
+
+		if maintenanceFreeze != nil {
+			if err := r.skipScheduleBecauseOfMaintenanceFreeze(ctx, shoot, mostRecentSchedule, gardenerutils.MaintenanceFreezeMessage(maintenanceFreeze, window), now); err != nil {
+				return reconcile.Result{}, err
+			}
+			log.Info("Skipped hibernation schedule because of active maintenance freeze", "maintenanceFreeze", maintenanceFreeze.Name, "end", window.End)
+		} else {
+			if err := r.hibernateOrWakeUpShootBasedOnSchedule(ctx, shoot, mostRecentSchedule, now); err != nil {
+				return reconcile.Result{}, err
+			}
+			log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
+		}
 	}
 
 	requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
@@ -151,8 +179,24 @@ func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context,
 	return r.Client.Status().Patch(ctx, shoot, patch)
 }
 
+// skipScheduleBecauseOfMaintenanceFreeze records that the given schedule was skipped because of an active maintenance
+// freeze. The trigger time is updated anyway, so that the schedule is not executed belatedly once the freeze has ended.
+func (r *Reconciler) skipScheduleBecauseOfMaintenanceFreeze(ctx context.Context, shoot *gardencorev1beta1.Shoot, schedule *parsedHibernationSchedule, message string, now time.Time) error {
+	switch schedule.operation {
+	case hibernate:
+		r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventMaintenanceFrozen, "Skipped hibernating cluster due to schedule because %s", message)
+	case wakeUp:
+		r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventMaintenanceFrozen, "Skipped waking up cluster due to schedule because %s", message)
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: now}
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
+
 // parseHibernationSchedules parses the given HibernationSchedules and returns an array of ParsedHibernationSchedules
-// If the Location of a HibernationSchedule is `nil`, it is defaulted to UTC.
+// If the Location of a HibernationSchedule is `nil`, it is defaulted to UTC. Exceptions of a HibernationSchedule only
+// apply to its start, i.e., the Shoot is not hibernated on these dates but still woken up.
 func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule) ([]parsedHibernationSchedule, error) {
 	var parsedHibernationSchedules []parsedHibernationSchedule
 
@@ -173,7 +217,7 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 				return nil, err
 			}
 			parsedHibernationSchedules = append(parsedHibernationSchedules,
-				parsedHibernationSchedule{location: *location, schedule: parsed, operation: hibernate},
+				parsedHibernationSchedule{location: *location, schedule: parsed, operation: hibernate, exceptions: sets.New(schedule.Exceptions...)},
 			)
 		}
 
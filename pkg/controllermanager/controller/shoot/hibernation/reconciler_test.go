@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/robfig/cron"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/component-base/version"
 	testclock "k8s.io/utils/clock/testing"
@@ -101,6 +102,18 @@ var _ = Describe("Shoot Hibernation", func() {
 				}
 				Expect(parsedSchedule.next(now)).To(Equal(expected))
 			})
+
+			It("should skip scheduling times on exception dates", func() {
+				now := mustParseRFC3339Time(weekDayAt2)
+				expected := mustParseRFC3339Time(weekDayAt0).Add(2 * 24 * time.Hour)
+
+				parsedSchedule := parsedHibernationSchedule{
+					location:   mustLoadLocation(locationEUBerlin),
+					schedule:   mustParseStandard(everyDayAt2),
+					exceptions: sets.New("2022-04-13"),
+				}
+				Expect(parsedSchedule.next(now)).To(Equal(expected))
+			})
 		})
 
 		Describe("#previous", func() {
@@ -129,6 +142,21 @@ var _ = Describe("Shoot Hibernation", func() {
 				prev := parsedSchedule.previous(from, now)
 				Expect(prev).To(BeNil())
 			})
+
+			It("should skip scheduling times on exception dates", func() {
+				now := mustParseRFC3339Time(weekDayAt2)
+				from := now.Add(-2 * 24 * time.Hour)
+
+				expected := mustParseRFC3339Time(weekDayAt0).Add(-24 * time.Hour)
+				parsedSchedule := parsedHibernationSchedule{
+					location:   mustLoadLocation(locationEUBerlin),
+					schedule:   mustParseStandard(everyDayAt2),
+					exceptions: sets.New("2022-04-12"),
+				}
+				prev := parsedSchedule.previous(from, now)
+				Expect(prev).NotTo(BeNil())
+				Expect(*prev).To(Equal(expected))
+			})
 		})
 	})
 
@@ -266,6 +294,21 @@ var _ = Describe("Shoot Hibernation", func() {
 					expectedHibernationEnabled:  true,
 					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
 				}),
+				Entry("when shoot has never hibernated and reconciliation is executed just after hibernation start schedule on an exception date", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -1*24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start:      &everyDayAt7,
+							End:        &everyDayAt2,
+							Exceptions: []string{"2022-04-12"},
+						}}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					triggerHibernationOrWakeup:  true,
+					expectedHibernationEnabled:  false,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
+				}),
 				Entry("when shoot has never been hibernated and reconciliation is executed exactly at hibernation start schedule", testEntry{
 					timeNow: timeWithOffset(weekDayAt7, 0),
 					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
@@ -417,6 +460,45 @@ var _ = Describe("Shoot Hibernation", func() {
 					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
 				}),
 			)
+
+			It("should skip the schedule while a maintenance freeze is active", func() {
+				timeNow := timeWithOffset(weekDayAt7, 1*time.Second)()
+				fakeClock = testclock.NewFakeClock(timeNow)
+
+				Expect(c.Create(ctx, &gardencorev1beta1.MaintenanceFreeze{
+					ObjectMeta: metav1.ObjectMeta{Name: "freeze"},
+					Spec: gardencorev1beta1.MaintenanceFreezeSpec{
+						Windows: []gardencorev1beta1.MaintenanceFreezeWindow{{
+							Begin: metav1.NewTime(timeNow.Add(-time.Hour)),
+							End:   metav1.NewTime(timeNow.Add(time.Hour)),
+						}},
+					},
+				})).To(Succeed())
+
+				shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -1*24*time.Hour)()}
+				shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+					Start: &everyDayAt7,
+					End:   &everyDayAt2,
+				}}
+				Expect(c.Create(ctx, shoot)).To(Succeed())
+
+				recorder := record.NewFakeRecorder(1)
+				reconciler := &Reconciler{
+					Client:   c,
+					Recorder: recorder,
+					Clock:    fakeClock,
+				}
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: requeueAfterBasedOnSchedule(everyDayAt2, "UTC")(timeNow)}))
+
+				reconciledShoot := &gardencorev1beta1.Shoot{}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), reconciledShoot)).To(Succeed())
+				Expect(reconciledShoot.Spec.Hibernation.Enabled).To(BeNil())
+				Expect(reconciledShoot.Status.LastHibernationTriggerTime.Time.UTC()).To(Equal(timeNow))
+				Expect(recorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ShootEventMaintenanceFrozen)))
+			})
 		})
 	})
 })
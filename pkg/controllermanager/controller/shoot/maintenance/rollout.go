@@ -0,0 +1,335 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// versionRollout decides whether automatic version updates may be rolled out to a Shoot according to the
+// VersionRolloutStrategy of its CloudProfile. A nil versionRollout allows all updates.
+type versionRollout struct {
+	strategy *gardencorev1beta1.VersionRolloutStrategy
+	wave     int
+	// peers are all other Shoots whose CloudProfile is the same as the one of the Shoot.
+	peers []gardencorev1beta1.Shoot
+	now   time.Time
+
+	// deferred contains messages for all updates which were not allowed.
+	deferred []string
+}
+
+// rolloutTarget is a version which is rolled out to Shoots.
+type rolloutTarget struct {
+	// description is a human-readable description of the version, e.g. `Kubernetes version "1.31.1"`.
+	description string
+	// eligible returns whether the given Shoot takes part in the rollout of the version.
+	eligible func(*gardencorev1beta1.Shoot) bool
+	// running returns whether the given Shoot is already running the version.
+	running func(*gardencorev1beta1.Shoot) bool
+}
+
+// newVersionRollout returns a versionRollout for the given Shoot. It returns nil if the CloudProfile does not configure
+// a VersionRolloutStrategy.
+func newVersionRollout(ctx context.Context, reader client.Reader, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, now time.Time) (*versionRollout, error) {
+	if cloudProfile.Spec.VersionRolloutStrategy == nil {
+		return nil, nil
+	}
+
+	resolver := &cloudProfileResolver{reader: reader, parents: map[client.ObjectKey]string{}}
+
+	cloudProfileName, err := resolver.cloudProfileName(ctx, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := reader.List(ctx, shootList); err != nil {
+		return nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	rollout := &versionRollout{
+		strategy: cloudProfile.Spec.VersionRolloutStrategy,
+		wave:     rolloutWave(cloudProfile.Spec.VersionRolloutStrategy, shoot),
+		now:      now,
+	}
+
+	for _, peer := range shootList.Items {
+		if peer.UID == shoot.UID || peer.DeletionTimestamp != nil || peer.Status.IsHibernated {
+			continue
+		}
+
+		peerCloudProfileName, err := resolver.cloudProfileName(ctx, &peer)
+		if err != nil {
+			return nil, err
+		}
+
+		if peerCloudProfileName == cloudProfileName {
+			rollout.peers = append(rollout.peers, peer)
+		}
+	}
+
+	return rollout, nil
+}
+
+// allows returns whether the given version may be rolled out to the Shoot. If not, the reason is logged and remembered
+// in the list of deferred updates.
+func (v *versionRollout) allows(log logr.Logger, target rolloutTarget) bool {
+	if v == nil {
+		return true
+	}
+
+	var (
+		soakDuration           = ptr.Deref(v.strategy.SoakDuration, metav1.Duration{Duration: 24 * time.Hour}).Duration
+		maxUnhealthyPercentage = int(ptr.Deref(v.strategy.MaxUnhealthyPercentage, 20))
+
+		adopters, unhealthyAdopters int
+		members                     = make([]int, v.wave)
+		soaked                      = make([]int, v.wave)
+		soaking                     = make([]int, v.wave)
+	)
+
+	for i := range v.peers {
+		peer := &v.peers[i]
+		if !target.eligible(peer) {
+			continue
+		}
+
+		running := target.running(peer)
+		healthySince, healthy := shootHealthySince(peer)
+
+		if running {
+			adopters++
+			if !healthy {
+				unhealthyAdopters++
+			}
+		}
+
+		wave := rolloutWave(v.strategy, peer)
+		if wave >= v.wave {
+			continue
+		}
+
+		members[wave]++
+		if running && healthy {
+			if v.now.Sub(healthySince) >= soakDuration {
+				soaked[wave]++
+			} else {
+				soaking[wave]++
+			}
+		}
+	}
+
+	var reason string
+	if adopters > 0 && unhealthyAdopters*100 > maxUnhealthyPercentage*adopters {
+		reason = fmt.Sprintf("rollout is halted because %d/%d Shoots running this version are unhealthy", unhealthyAdopters, adopters)
+	} else {
+		for wave := range v.wave {
+			if members[wave] > 0 && (soaked[wave] == 0 || soaking[wave] > 0) {
+				reason = fmt.Sprintf("waiting for the Shoots of rollout wave %q to be healthy on this version for %s", v.strategy.Waves[wave].Name, soakDuration)
+				break
+			}
+		}
+	}
+
+	if reason == "" {
+		return true
+	}
+
+	log.Info("Automatic update deferred because of version rollout strategy", "target", target.description, "reason", reason)
+	v.deferred = append(v.deferred, fmt.Sprintf("Automatic update to %s deferred: %s", target.description, reason))
+	return false
+}
+
+func kubernetesVersionRolloutTarget(version string) rolloutTarget {
+	running := func(shoot *gardencorev1beta1.Shoot) bool {
+		return shoot.Spec.Kubernetes.Version == version
+	}
+
+	return rolloutTarget{
+		description: fmt.Sprintf("Kubernetes version %q", version),
+		eligible: func(shoot *gardencorev1beta1.Shoot) bool {
+			if running(shoot) {
+				return true
+			}
+			// Automatic updates of the Kubernetes version only update to the latest patch version of the current minor
+			// version.
+			return shoot.Spec.Maintenance != nil && shoot.Spec.Maintenance.AutoUpdate != nil && shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion &&
+				autoUpdateReaches(shoot.Spec.Kubernetes.Version, version, gardencorev1beta1.UpdateStrategyPatch)
+		},
+		running: running,
+	}
+}
+
+func machineImageRolloutTarget(name, version string, updateStrategy gardencorev1beta1.MachineImageUpdateStrategy) rolloutTarget {
+	usesImage := func(shoot *gardencorev1beta1.Shoot, matchVersion func(string) bool) bool {
+		return slices.ContainsFunc(shoot.Spec.Provider.Workers, func(worker gardencorev1beta1.Worker) bool {
+			return worker.Machine.Image != nil && worker.Machine.Image.Name == name && matchVersion(ptr.Deref(worker.Machine.Image.Version, ""))
+		})
+	}
+
+	running := func(shoot *gardencorev1beta1.Shoot) bool {
+		return usesImage(shoot, func(v string) bool { return v == version })
+	}
+
+	return rolloutTarget{
+		description: fmt.Sprintf("machine image %q version %q", name, version),
+		eligible: func(shoot *gardencorev1beta1.Shoot) bool {
+			if running(shoot) {
+				return true
+			}
+			if shoot.Spec.Maintenance != nil && shoot.Spec.Maintenance.AutoUpdate != nil && !ptr.Deref(shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion, true) {
+				return false
+			}
+			return usesImage(shoot, func(v string) bool { return autoUpdateReaches(v, version, updateStrategy) })
+		},
+		running: running,
+	}
+}
+
+// autoUpdateReaches returns whether an automatic update of the current version with the given update strategy can
+// result in the target version.
+func autoUpdateReaches(current, target string, updateStrategy gardencorev1beta1.MachineImageUpdateStrategy) bool {
+	currentVersion, err := semver.NewVersion(current)
+	if err != nil {
+		return false
+	}
+	targetVersion, err := semver.NewVersion(target)
+	if err != nil {
+		return false
+	}
+
+	if !currentVersion.LessThan(targetVersion) {
+		return false
+	}
+
+	switch updateStrategy {
+	case gardencorev1beta1.UpdateStrategyPatch:
+		return currentVersion.Major() == targetVersion.Major() && currentVersion.Minor() == targetVersion.Minor()
+	case gardencorev1beta1.UpdateStrategyMinor:
+		return currentVersion.Major() == targetVersion.Major()
+	default:
+		return true
+	}
+}
+
+// rolloutWave returns the index of the first wave the Shoot matches. Shoots which do not match any wave belong to the
+// implicit last wave.
+func rolloutWave(strategy *gardencorev1beta1.VersionRolloutStrategy, shoot *gardencorev1beta1.Shoot) int {
+	for i, wave := range strategy.Waves {
+		if rolloutWaveMatches(wave, shoot) {
+			return i
+		}
+	}
+	return len(strategy.Waves)
+}
+
+func rolloutWaveMatches(wave gardencorev1beta1.VersionRolloutWave, shoot *gardencorev1beta1.Shoot) bool {
+	if wave.ShootSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(wave.ShootSelector)
+		if err != nil || !selector.Matches(labels.Set(shoot.Labels)) {
+			return false
+		}
+	}
+
+	if len(wave.Purposes) > 0 && !slices.Contains(wave.Purposes, ptr.Deref(shoot.Spec.Purpose, gardencorev1beta1.ShootPurposeEvaluation)) {
+		return false
+	}
+
+	if wave.Percentage != nil && rolloutBucket(shoot) >= *wave.Percentage {
+		return false
+	}
+
+	return true
+}
+
+// rolloutBucket deterministically assigns the Shoot to one of 100 buckets based on its UID.
+func rolloutBucket(shoot *gardencorev1beta1.Shoot) int32 {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(shoot.UID))
+	return int32(hash.Sum32() % 100) // #nosec G115 -- The modulo ensures that the value fits into int32.
+}
+
+// shootHealthySince returns whether the control plane and the nodes of the Shoot are healthy and since when. The time
+// of the last maintenance is taken into account since it usually introduces the rolled out versions.
+func shootHealthySince(shoot *gardencorev1beta1.Shoot) (time.Time, bool) {
+	if shoot.Status.LastOperation != nil && shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateFailed {
+		return time.Time{}, false
+	}
+
+	conditionTypes := []gardencorev1beta1.ConditionType{gardencorev1beta1.ShootControlPlaneHealthy}
+	if !v1beta1helper.IsWorkerless(shoot) {
+		conditionTypes = append(conditionTypes, gardencorev1beta1.ShootEveryNodeReady)
+	}
+
+	var since time.Time
+	for _, conditionType := range conditionTypes {
+		condition := v1beta1helper.GetCondition(shoot.Status.Conditions, conditionType)
+		if condition == nil || condition.Status != gardencorev1beta1.ConditionTrue {
+			return time.Time{}, false
+		}
+
+		if condition.LastTransitionTime.After(since) {
+			since = condition.LastTransitionTime.Time
+		}
+	}
+
+	if shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.TriggeredTime.After(since) {
+		since = shoot.Status.LastMaintenance.TriggeredTime.Time
+	}
+
+	return since, true
+}
+
+// cloudProfileResolver determines the name of the CloudProfile of Shoots. For Shoots referencing a
+// NamespacedCloudProfile, the name of its parent CloudProfile is returned.
+type cloudProfileResolver struct {
+	reader  client.Reader
+	parents map[client.ObjectKey]string
+}
+
+func (c *cloudProfileResolver) cloudProfileName(ctx context.Context, shoot *gardencorev1beta1.Shoot) (string, error) {
+	cloudProfileReference := gardenerutils.BuildCloudProfileReference(shoot)
+	if cloudProfileReference == nil {
+		return "", nil
+	}
+
+	if cloudProfileReference.Kind != v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile {
+		return cloudProfileReference.Name, nil
+	}
+
+	key := client.ObjectKey{Namespace: shoot.Namespace, Name: cloudProfileReference.Name}
+	if parent, ok := c.parents[key]; ok {
+		return parent, nil
+	}
+
+	namespacedCloudProfile := &gardencorev1beta1.NamespacedCloudProfile{}
+	if err := c.reader.Get(ctx, key, namespacedCloudProfile); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed getting NamespacedCloudProfile %s: %w", key, err)
+	}
+
+	c.parents[key] = namespacedCloudProfile.Spec.Parent.Name
+	return namespacedCloudProfile.Spec.Parent.Name, nil
+}
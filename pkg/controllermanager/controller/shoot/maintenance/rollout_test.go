@@ -0,0 +1,303 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Version rollout", func() {
+	var (
+		log      logr.Logger
+		now      time.Time
+		strategy *gardencorev1beta1.VersionRolloutStrategy
+
+		newShoot func(name string, purpose gardencorev1beta1.ShootPurpose, version string) *gardencorev1beta1.Shoot
+		healthy  func(shoot *gardencorev1beta1.Shoot, since time.Time) *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		log = logr.Discard()
+		now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		strategy = &gardencorev1beta1.VersionRolloutStrategy{
+			Waves: []gardencorev1beta1.VersionRolloutWave{
+				{Name: "canary", Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeEvaluation}},
+				{Name: "development", Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeDevelopment}},
+			},
+			SoakDuration:           &metav1.Duration{Duration: time.Hour},
+			MaxUnhealthyPercentage: ptr.To[int32](50),
+		}
+
+		newShoot = func(name string, purpose gardencorev1beta1.ShootPurpose, version string) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo", UID: types.UID(name)},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName: ptr.To("profile"),
+					Purpose:          &purpose,
+					Kubernetes:       gardencorev1beta1.Kubernetes{Version: version},
+					Maintenance: &gardencorev1beta1.Maintenance{
+						AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{KubernetesVersion: true},
+					},
+				},
+			}
+		}
+
+		healthy = func(shoot *gardencorev1beta1.Shoot, since time.Time) *gardencorev1beta1.Shoot {
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Name: "worker"}}
+			shoot.Status.Conditions = []gardencorev1beta1.Condition{
+				{Type: gardencorev1beta1.ShootControlPlaneHealthy, Status: gardencorev1beta1.ConditionTrue, LastTransitionTime: metav1.NewTime(since)},
+				{Type: gardencorev1beta1.ShootEveryNodeReady, Status: gardencorev1beta1.ConditionTrue, LastTransitionTime: metav1.NewTime(since)},
+			}
+			return shoot
+		}
+	})
+
+	Describe("#allows", func() {
+		It("should allow all updates if there is no version rollout", func() {
+			var rollout *versionRollout
+			Expect(rollout.allows(log, kubernetesVersionRolloutTarget("1.31.2"))).To(BeTrue())
+		})
+
+		It("should allow updates for the first wave", func() {
+			rollout := &versionRollout{strategy: strategy, wave: 0, now: now}
+
+			Expect(rollout.allows(log, kubernetesVersionRolloutTarget("1.31.2"))).To(BeTrue())
+			Expect(rollout.deferred).To(BeEmpty())
+		})
+
+		It("should defer updates until the Shoots of the previous waves have soaked", func() {
+			rollout := &versionRollout{
+				strategy: strategy,
+				wave:     2,
+				now:      now,
+				peers: []gardencorev1beta1.Shoot{
+					*healthy(newShoot("canary", gardencorev1beta1.ShootPurposeEvaluation, "1.31.2"), now.Add(-2*time.Hour)),
+					*healthy(newShoot("development", gardencorev1beta1.ShootPurposeDevelopment, "1.31.2"), now.Add(-30*time.Minute)),
+				},
+			}
+
+			Expect(rollout.allows(log, kubernetesVersionRolloutTarget("1.31.2"))).To(BeFalse())
+			Expect(rollout.deferred).To(ConsistOf(`Automatic update to Kubernetes version "1.31.2" deferred: waiting for the Shoots of rollout wave "development" to be healthy on this version for 1h0m0s`))
+		})
+
+		It("should defer updates if no Shoot of a previous wave runs the version yet", func() {
+			rollout := &versionRollout{
+				strategy: strategy,
+				wave:     1,
+				now:      now,
+				peers: []gardencorev1beta1.Shoot{
+					*healthy(newShoot("canary", gardencorev1beta1.ShootPurposeEvaluation, "1.31.1"), now.Add(-2*time.Hour)),
+				},
+			}
+
+			Expect(rollout.allows(log, kubernetesVersionRolloutTarget("1.31.2"))).To(BeFalse())
+		})
+
+		It("should allow updates once the Shoots of all previous waves have soaked", func() {
+			rollout := &versionRollout{
+				strategy: strategy,
+				wave:     2,
+				now:      now,
+				peers: []gardencorev1beta1.Shoot{
+					*healthy(newShoot("canary", gardencorev1beta1.ShootPurposeEvaluation, "1.31.2"), now.Add(-2*time.Hour)),
+					*healthy(newShoot("development", gardencorev1beta1.ShootPurposeDevelopment, "1.31.2"), now.Add(-time.Hour)),
+					*newShoot("production", gardencorev1beta1.ShootPurposeProduction, "1.31.1"),
+				},
+			}
+
+			Expect(rollout.allows(log, kubernetesVersionRolloutTarget("1.31.2"))).To(BeTrue())
+		})
+
+		It("should ignore Shoots which do not take part in the rollout", func() {
+			canary := newShoot("canary", gardencorev1beta1.ShootPurposeEvaluation, "1.31.1")
+			canary.Spec.Maintenance.AutoUpdate.KubernetesVersion = false
+
+			rollout := &versionRollout{
+				strategy: strategy,
+				wave:     1,
+				now:      now,
+				peers:    []gardencorev1beta1.Shoot{*canary},
+			}
+
+			Expect(rollout.allows(log, kubernetesVersionRolloutTarget("1.31.2"))).To(BeTrue())
+		})
+
+		It("should ignore Shoots whose automatic update would not pick the version", func() {
+			rollout := &versionRollout{
+				strategy: strategy,
+				wave:     1,
+				now:      now,
+				peers: []gardencorev1beta1.Shoot{
+					*healthy(newShoot("canary-1", gardencorev1beta1.ShootPurposeEvaluation, "1.30.5"), now.Add(-2*time.Hour)),
+					*healthy(newShoot("canary-2", gardencorev1beta1.ShootPurposeEvaluation, "1.32.0"), now.Add(-2*time.Hour)),
+				},
+			}
+
+			Expect(rollout.allows(log, kubernetesVersionRolloutTarget("1.31.2"))).To(BeTrue())
+
+			By("Add Shoot on the same minor version")
+			rollout.peers = append(rollout.peers, *healthy(newShoot("canary-3", gardencorev1beta1.ShootPurposeEvaluation, "1.31.1"), now.Add(-2*time.Hour)))
+			Expect(rollout.allows(log, kubernetesVersionRolloutTarget("1.31.2"))).To(BeFalse())
+		})
+
+		It("should halt the rollout if too many Shoots running the version are unhealthy", func() {
+			rollout := &versionRollout{
+				strategy: strategy,
+				wave:     0,
+				now:      now,
+				peers: []gardencorev1beta1.Shoot{
+					*healthy(newShoot("canary-1", gardencorev1beta1.ShootPurposeEvaluation, "1.31.2"), now.Add(-2*time.Hour)),
+					*newShoot("canary-2", gardencorev1beta1.ShootPurposeEvaluation, "1.31.2"),
+					*newShoot("canary-3", gardencorev1beta1.ShootPurposeEvaluation, "1.31.2"),
+				},
+			}
+
+			Expect(rollout.allows(log, kubernetesVersionRolloutTarget("1.31.2"))).To(BeFalse())
+			Expect(rollout.deferred).To(ConsistOf(`Automatic update to Kubernetes version "1.31.2" deferred: rollout is halted because 2/3 Shoots running this version are unhealthy`))
+		})
+
+		It("should consider machine image versions", func() {
+			canary := healthy(newShoot("canary", gardencorev1beta1.ShootPurposeEvaluation, "1.31.1"), now.Add(-30*time.Minute))
+			canary.Spec.Provider.Workers[0].Machine.Image = &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.2.3")}
+
+			rollout := &versionRollout{
+				strategy: strategy,
+				wave:     1,
+				now:      now,
+				peers:    []gardencorev1beta1.Shoot{*canary},
+			}
+
+			Expect(rollout.allows(log, machineImageRolloutTarget("gardenlinux", "1.2.3", gardencorev1beta1.UpdateStrategyPatch))).To(BeFalse())
+			Expect(rollout.allows(log, machineImageRolloutTarget("ubuntu", "1.2.3", gardencorev1beta1.UpdateStrategyPatch))).To(BeTrue())
+
+			rollout.now = now.Add(time.Hour)
+			Expect(rollout.allows(log, machineImageRolloutTarget("gardenlinux", "1.2.3", gardencorev1beta1.UpdateStrategyPatch))).To(BeTrue())
+		})
+
+		It("should ignore Shoots which cannot reach the machine image version with the update strategy", func() {
+			canary := healthy(newShoot("canary", gardencorev1beta1.ShootPurposeEvaluation, "1.31.1"), now.Add(-2*time.Hour))
+			canary.Spec.Provider.Workers[0].Machine.Image = &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.1.0")}
+
+			rollout := &versionRollout{
+				strategy: strategy,
+				wave:     1,
+				now:      now,
+				peers:    []gardencorev1beta1.Shoot{*canary},
+			}
+
+			Expect(rollout.allows(log, machineImageRolloutTarget("gardenlinux", "1.2.3", gardencorev1beta1.UpdateStrategyPatch))).To(BeTrue())
+			Expect(rollout.allows(log, machineImageRolloutTarget("gardenlinux", "2.0.0", gardencorev1beta1.UpdateStrategyMinor))).To(BeTrue())
+			Expect(rollout.allows(log, machineImageRolloutTarget("gardenlinux", "1.2.3", gardencorev1beta1.UpdateStrategyMinor))).To(BeFalse())
+			Expect(rollout.allows(log, machineImageRolloutTarget("gardenlinux", "2.0.0", gardencorev1beta1.UpdateStrategyMajor))).To(BeFalse())
+
+			By("Disable automatic updates of the machine image version")
+			rollout.peers[0].Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(false)
+			Expect(rollout.allows(log, machineImageRolloutTarget("gardenlinux", "1.2.3", gardencorev1beta1.UpdateStrategyMinor))).To(BeTrue())
+		})
+	})
+
+	Describe("#rolloutWave", func() {
+		It("should return the first matching wave", func() {
+			strategy.Waves = append([]gardencorev1beta1.VersionRolloutWave{{
+				Name:          "selected",
+				ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+			}}, strategy.Waves...)
+
+			shoot := newShoot("foo", gardencorev1beta1.ShootPurposeEvaluation, "1.31.1")
+			Expect(rolloutWave(strategy, shoot)).To(Equal(1))
+
+			shoot.Labels = map[string]string{"canary": "true"}
+			Expect(rolloutWave(strategy, shoot)).To(Equal(0))
+		})
+
+		It("should return the implicit last wave if no wave matches", func() {
+			Expect(rolloutWave(strategy, newShoot("foo", gardencorev1beta1.ShootPurposeProduction, "1.31.1"))).To(Equal(2))
+		})
+
+		It("should select Shoots by percentage", func() {
+			strategy.Waves = []gardencorev1beta1.VersionRolloutWave{{Name: "half", Percentage: ptr.To[int32](50)}}
+
+			var inWave int
+			for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p"} {
+				shoot := newShoot(name, gardencorev1beta1.ShootPurposeProduction, "1.31.1")
+				wave := rolloutWave(strategy, shoot)
+				Expect(rolloutWave(strategy, shoot)).To(Equal(wave), "assignment must be deterministic")
+				if wave == 0 {
+					inWave++
+				}
+			}
+
+			Expect(inWave).To(And(BeNumerically(">", 0), BeNumerically("<", 16)))
+		})
+	})
+
+	Describe("#newVersionRollout", func() {
+		var (
+			ctx          context.Context
+			c            client.Client
+			cloudProfile *gardencorev1beta1.CloudProfile
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			c = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec:       gardencorev1beta1.CloudProfileSpec{VersionRolloutStrategy: strategy},
+			}
+		})
+
+		It("should return nil if the CloudProfile has no version rollout strategy", func() {
+			cloudProfile.Spec.VersionRolloutStrategy = nil
+
+			Expect(newVersionRollout(ctx, c, newShoot("foo", gardencorev1beta1.ShootPurposeProduction, "1.31.1"), cloudProfile, now)).To(BeNil())
+		})
+
+		It("should collect the Shoots using the same CloudProfile", func() {
+			shoot := newShoot("shoot", gardencorev1beta1.ShootPurposeDevelopment, "1.31.1")
+
+			sameProfile := newShoot("same-profile", gardencorev1beta1.ShootPurposeEvaluation, "1.31.1")
+			otherProfile := newShoot("other-profile", gardencorev1beta1.ShootPurposeEvaluation, "1.31.1")
+			otherProfile.Spec.CloudProfileName = ptr.To("other")
+			namespacedProfile := newShoot("namespaced-profile", gardencorev1beta1.ShootPurposeEvaluation, "1.31.1")
+			namespacedProfile.Spec.CloudProfileName = nil
+			namespacedProfile.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{Kind: v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile, Name: "custom"}
+			hibernated := newShoot("hibernated", gardencorev1beta1.ShootPurposeEvaluation, "1.31.1")
+			hibernated.Status.IsHibernated = true
+
+			Expect(c.Create(ctx, &gardencorev1beta1.NamespacedCloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "garden-foo"},
+				Spec:       gardencorev1beta1.NamespacedCloudProfileSpec{Parent: gardencorev1beta1.CloudProfileReference{Kind: v1beta1constants.CloudProfileReferenceKindCloudProfile, Name: "profile"}},
+			})).To(Succeed())
+			for _, obj := range []*gardencorev1beta1.Shoot{shoot, sameProfile, otherProfile, namespacedProfile, hibernated} {
+				Expect(c.Create(ctx, obj)).To(Succeed())
+			}
+
+			rollout, err := newVersionRollout(ctx, c, shoot, cloudProfile, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollout.wave).To(Equal(1))
+
+			var peers []string
+			for _, peer := range rollout.peers {
+				peers = append(peers, peer.Name)
+			}
+			Expect(peers).To(ConsistOf("same-profile", "namespaced-profile"))
+		})
+	})
+})
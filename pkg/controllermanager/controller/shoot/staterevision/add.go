@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package staterevision
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "shoot-state-revision"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+	}
+
+	// ShootStates are only watched with their metadata since their specs can be large. They are read directly from the
+	// API server when needed.
+	shootState := &metav1.PartialObjectMetadata{}
+	shootState.SetGroupVersionKind(gardencorev1beta1.SchemeGroupVersion.WithKind("ShootState"))
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.Shoot{}, builder.WithPredicates(r.ShootPredicate())).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Watches(
+			shootState,
+			handler.EnqueueRequestsFromMapFunc(r.MapShootStateToShoot),
+			builder.WithPredicates(r.ShootStatePredicate()),
+		).
+		Complete(r)
+}
+
+// ShootPredicate reacts on Shoot events which are relevant for restoring a ShootState revision and on the deletion of
+// Shoots whose revisions have to be cleaned up.
+func (r *Reconciler) ShootPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			shoot, ok := e.Object.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			_, restore := v1beta1helper.ShootStateRevisionToRestore(shoot)
+			return restore
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			oldShoot, ok := e.ObjectOld.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			revision, restore := v1beta1helper.ShootStateRevisionToRestore(shoot)
+			if !restore {
+				return false
+			}
+
+			oldRevision, _ := v1beta1helper.ShootStateRevisionToRestore(oldShoot)
+			return revision != oldRevision || !apiequality.Semantic.DeepEqual(shoot.Status.LastOperation, oldShoot.Status.LastOperation)
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// ShootStatePredicate reacts on the creation of ShootStates and on updates which persist a new state.
+func (r *Reconciler) ShootStatePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectNew.GetAnnotations()[v1beta1constants.GardenerTimestamp] != e.ObjectOld.GetAnnotations()[v1beta1constants.GardenerTimestamp]
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// MapShootStateToShoot maps ShootStates to the Shoots they belong to. Revisions are not mapped since they are managed by
+// this controller.
+func (r *Reconciler) MapShootStateToShoot(_ context.Context, obj client.Object) []reconcile.Request {
+	if _, ok := obj.GetLabels()[v1beta1constants.LabelShootName]; ok {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(obj)}}
+}
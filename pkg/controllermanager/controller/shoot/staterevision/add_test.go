@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package staterevision_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/staterevision"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var (
+			p     predicate.Predicate
+			shoot *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"gardener.cloud/operation": "restore-shootstate-revision=foo--rev-1"},
+				},
+			}
+		})
+
+		It("should react on the creation of shoots with the restore operation", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should not react on the creation of shoots without the restore operation", func() {
+			Expect(p.Create(event.CreateEvent{Object: &gardencorev1beta1.Shoot{}})).To(BeFalse())
+		})
+
+		It("should react when the restore operation is added", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: &gardencorev1beta1.Shoot{}, ObjectNew: shoot})).To(BeTrue())
+		})
+
+		It("should react when the last operation changes while the restore operation is present", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeMigrate, State: gardencorev1beta1.LastOperationStateSucceeded}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+
+		It("should not react when nothing relevant changes", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Labels = map[string]string{"foo": "bar"}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should not react on updates of shoots without the restore operation", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: &gardencorev1beta1.Shoot{}})).To(BeFalse())
+		})
+
+		It("should react on the deletion of shoots", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: &gardencorev1beta1.Shoot{}})).To(BeTrue())
+		})
+	})
+
+	Describe("#ShootStatePredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootStatePredicate()
+		})
+
+		It("should react on the creation of shoot states", func() {
+			Expect(p.Create(event.CreateEvent{Object: &metav1.PartialObjectMetadata{}})).To(BeTrue())
+		})
+
+		It("should react when a new state was persisted", func() {
+			Expect(p.Update(event.UpdateEvent{
+				ObjectOld: &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"gardener.cloud/timestamp": "2025-01-01T00:00:00Z"}}},
+				ObjectNew: &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"gardener.cloud/timestamp": "2025-01-01T01:00:00Z"}}},
+			})).To(BeTrue())
+		})
+
+		It("should not react when no new state was persisted", func() {
+			Expect(p.Update(event.UpdateEvent{
+				ObjectOld: &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"gardener.cloud/timestamp": "2025-01-01T00:00:00Z"}}},
+				ObjectNew: &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"gardener.cloud/timestamp": "2025-01-01T00:00:00Z"}, Labels: map[string]string{"foo": "bar"}}},
+			})).To(BeFalse())
+		})
+
+		It("should not react on the deletion of shoot states", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: &metav1.PartialObjectMetadata{}})).To(BeFalse())
+		})
+	})
+
+	Describe("#MapShootStateToShoot", func() {
+		It("should map the shoot state to its shoot", func() {
+			Expect(reconciler.MapShootStateToShoot(context.Background(), &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"}})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "foo", Namespace: "garden-bar"}},
+			))
+		})
+
+		It("should not map revisions", func() {
+			Expect(reconciler.MapShootStateToShoot(context.Background(), &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo--rev-1",
+				Namespace: "garden-bar",
+				Labels:    map[string]string{"shoot.gardener.cloud/name": "foo"},
+			}})).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package staterevision
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+// Reconciler keeps revisions of the ShootStates of Shoots and restores the secrets and extension states of Shoots from
+// these revisions on request.
+type Reconciler struct {
+	Client client.Client
+	// APIReader is used to read ShootStates since they are not cached.
+	APIReader client.Reader
+	Config    controllermanagerconfigv1alpha1.ShootStateRevisionControllerConfiguration
+	Recorder  record.EventRecorder
+}
+
+// Reconcile creates a revision whenever a new state of a Shoot was persisted in its ShootState and deletes revisions
+// exceeding the configured maximum. If requested, it restores the ShootState of the Shoot from a revision.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, deleting ShootState revisions")
+			return reconcile.Result{}, r.deleteRevisions(ctx, log, request.Namespace, request.Name, 0)
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if err := r.reconcileRevisions(ctx, log, shoot); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, r.restoreRevision(ctx, log, shoot)
+}
+
+func (r *Reconciler) reconcileRevisions(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	maxRevisions := ptr.Deref(r.Config.MaxRevisions, 0)
+
+	shootState := &gardencorev1beta1.ShootState{}
+	if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(shoot), shootState); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading ShootState: %w", err)
+		}
+		return r.deleteRevisions(ctx, log, shoot.Namespace, shoot.Name, maxRevisions)
+	}
+
+	if maxRevisions > 0 && isPersistedState(shootState) {
+		revisions, err := shootstate.ListRevisions(ctx, r.APIReader, shoot.Namespace, shoot.Name)
+		if err != nil {
+			return err
+		}
+
+		if !hasRevision(revisions, shootState) {
+			revision, created, err := shootstate.CreateRevision(ctx, r.Client, shootState)
+			if err != nil {
+				return err
+			}
+			if created {
+				log.Info("Created ShootState revision", "revision", client.ObjectKeyFromObject(revision))
+			}
+		}
+	}
+
+	return r.deleteRevisions(ctx, log, shoot.Namespace, shoot.Name, maxRevisions)
+}
+
+// isPersistedState returns whether the given ShootState contains a state which was persisted by the gardenlet and which
+// is not about to be deleted.
+func isPersistedState(shootState *gardencorev1beta1.ShootState) bool {
+	_, ok := shootstate.Timestamp(shootState)
+	return ok &&
+		shootState.DeletionTimestamp == nil &&
+		shootState.Annotations[v1beta1constants.ConfirmationDeletion] != "true"
+}
+
+// hasRevision returns whether the newest of the given revisions already contains the state of the given ShootState.
+func hasRevision(revisions []gardencorev1beta1.ShootState, shootState *gardencorev1beta1.ShootState) bool {
+	if len(revisions) == 0 {
+		return false
+	}
+
+	timestamp, _ := shootstate.Timestamp(shootState)
+	newest, _ := shootstate.Timestamp(&revisions[0])
+	return !newest.Before(timestamp)
+}
+
+// deleteRevisions deletes all but the newest `keep` revisions of the ShootState of the given Shoot.
+func (r *Reconciler) deleteRevisions(ctx context.Context, log logr.Logger, namespace, shootName string, keep int) error {
+	revisions, err := shootstate.ListRevisions(ctx, r.APIReader, namespace, shootName)
+	if err != nil {
+		return err
+	}
+
+	for i := keep; i < len(revisions); i++ {
+		log.Info("Deleting ShootState revision", "revision", client.ObjectKeyFromObject(&revisions[i]))
+		if err := shootstate.DeleteRevision(ctx, r.Client, &revisions[i]); err != nil {
+			return fmt.Errorf("failed deleting ShootState revision %s: %w", client.ObjectKeyFromObject(&revisions[i]), err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) restoreRevision(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	revisionName, ok := v1beta1helper.ShootStateRevisionToRestore(shoot)
+	if !ok {
+		return nil
+	}
+	log = log.WithValues("revision", revisionName)
+
+	if !isWaitingForRestore(shoot.Status.LastOperation) {
+		log.V(1).Info("Waiting for the control plane to be restored before restoring ShootState revision")
+		return nil
+	}
+
+	revision := &gardencorev1beta1.ShootState{}
+	if err := r.APIReader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: revisionName}, revision); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading ShootState revision %q: %w", revisionName, err)
+		}
+	}
+
+	if !shootstate.IsRevision(revision) || revision.Labels[v1beta1constants.LabelShootName] != shoot.Name {
+		log.Info("Cannot restore ShootState from unknown revision")
+		r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventShootStateRestoreFailed, "Cannot restore secrets and extension states from unknown ShootState revision %q", revisionName)
+		return r.removeOperationAnnotation(ctx, shoot)
+	}
+
+	shootState := &gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{Name: shoot.Name, Namespace: shoot.Namespace}}
+	if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(shootState), shootState); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading ShootState: %w", err)
+		}
+
+		shootstate.RestoreFromRevision(shootState, revision)
+		if err := r.Client.Create(ctx, shootState); err != nil {
+			return fmt.Errorf("failed creating ShootState from revision %q: %w", revisionName, err)
+		}
+	} else {
+		patch := client.MergeFromWithOptions(shootState.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		shootstate.RestoreFromRevision(shootState, revision)
+		if err := r.Client.Patch(ctx, shootState, patch); err != nil {
+			return fmt.Errorf("failed restoring ShootState from revision %q: %w", revisionName, err)
+		}
+	}
+
+	log.Info("Restored secrets and extension states from ShootState revision")
+	r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventShootStateRestored, "Restored secrets and extension states from ShootState revision %q", revisionName)
+
+	return r.removeOperationAnnotation(ctx, shoot)
+}
+
+// isWaitingForRestore returns whether the control plane of the Shoot was migrated away from its former seed and is not
+// yet being restored on the new seed or whether its last restoration did not succeed. Only then the ShootState is not
+// overwritten by the gardenlet and can be changed before the gardenlet restores the control plane from it.
+func isWaitingForRestore(lastOperation *gardencorev1beta1.LastOperation) bool {
+	if lastOperation == nil || lastOperation.State == gardencorev1beta1.LastOperationStateProcessing {
+		return false
+	}
+
+	switch lastOperation.Type {
+	case gardencorev1beta1.LastOperationTypeMigrate:
+		return lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded || lastOperation.State == gardencorev1beta1.LastOperationStateAborted
+	case gardencorev1beta1.LastOperationTypeRestore:
+		return lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded
+	}
+
+	return false
+}
+
+func (r *Reconciler) removeOperationAnnotation(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed removing operation annotation: %w", err)
+	}
+	return nil
+}
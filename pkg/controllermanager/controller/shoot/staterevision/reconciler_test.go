@@ -0,0 +1,319 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package staterevision_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/staterevision"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		shoot      *gardencorev1beta1.Shoot
+		shootState *gardencorev1beta1.ShootState
+		request    reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		recorder = record.NewFakeRecorder(1)
+
+		reconciler = &Reconciler{
+			Client:    fakeClient,
+			APIReader: fakeClient,
+			Config: controllermanagerconfigv1alpha1.ShootStateRevisionControllerConfiguration{
+				MaxRevisions: ptr.To(2),
+			},
+			Recorder: recorder,
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+		}
+		shootState = &gardencorev1beta1.ShootState{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "foo",
+				Namespace:   "garden-bar",
+				Annotations: map[string]string{"gardener.cloud/timestamp": "2025-01-01T00:00:00Z"},
+			},
+			Spec: gardencorev1beta1.ShootStateSpec{
+				Gardener: []gardencorev1beta1.GardenerResourceData{
+					{Name: "ca", Type: "secret", Data: runtime.RawExtension{Raw: []byte(`{"new":"ca"}`)}},
+					{Name: "machine-state", Type: "machine-state", Data: runtime.RawExtension{Raw: []byte(`{"new":"machines"}`)}},
+				},
+				Extensions: []gardencorev1beta1.ExtensionResourceState{
+					{Kind: "Infrastructure", State: &runtime.RawExtension{Raw: []byte(`{"new":"infrastructure"}`)}},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	listRevisions := func() []string {
+		shootStateList := &gardencorev1beta1.ShootStateList{}
+		ExpectWithOffset(1, fakeClient.List(ctx, shootStateList, client.InNamespace(shoot.Namespace), client.MatchingLabels{"shoot.gardener.cloud/name": shoot.Name})).To(Succeed())
+
+		var names []string
+		for _, revision := range shootStateList.Items {
+			names = append(names, revision.Name)
+		}
+		return names
+	}
+
+	persistState := func(timestamp string) {
+		patch := client.MergeFrom(shootState.DeepCopy())
+		shootState.Annotations["gardener.cloud/timestamp"] = timestamp
+		ExpectWithOffset(1, fakeClient.Patch(ctx, shootState, patch)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	Describe("revisions", func() {
+		BeforeEach(func() {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeClient.Create(ctx, shootState)).To(Succeed())
+		})
+
+		It("should create a revision of the persisted state", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			revision := &gardencorev1beta1.ShootState{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: "foo--rev-1735689600"}, revision)).To(Succeed())
+			Expect(revision.Labels).To(HaveKeyWithValue("shoot.gardener.cloud/name", "foo"))
+			Expect(revision.Annotations).To(HaveKeyWithValue("gardener.cloud/timestamp", "2025-01-01T00:00:00Z"))
+			Expect(revision.Spec).To(Equal(shootState.Spec))
+		})
+
+		It("should not create another revision if the state did not change", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(listRevisions()).To(ConsistOf("foo--rev-1735689600"))
+		})
+
+		It("should only keep the configured number of revisions", func() {
+			persistState("2025-01-01T00:00:00Z")
+			persistState("2025-01-01T01:00:00Z")
+			persistState("2025-01-01T02:00:00Z")
+
+			Expect(listRevisions()).To(ConsistOf("foo--rev-1735693200", "foo--rev-1735696800"))
+		})
+
+		It("should not create revisions of states which are about to be deleted", func() {
+			shootState.Annotations["confirmation.gardener.cloud/deletion"] = "true"
+			Expect(fakeClient.Update(ctx, shootState)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(listRevisions()).To(BeEmpty())
+		})
+
+		It("should delete all revisions if no revisions shall be kept", func() {
+			persistState("2025-01-01T00:00:00Z")
+			reconciler.Config.MaxRevisions = ptr.To(0)
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(listRevisions()).To(BeEmpty())
+		})
+
+		It("should keep the revisions when the shoot state is deleted", func() {
+			persistState("2025-01-01T00:00:00Z")
+			Expect(fakeClient.Delete(ctx, shootState)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(listRevisions()).To(ConsistOf("foo--rev-1735689600"))
+		})
+
+		It("should delete all revisions when the shoot is deleted", func() {
+			persistState("2025-01-01T00:00:00Z")
+			Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(listRevisions()).To(BeEmpty())
+		})
+	})
+
+	Describe("restoration", func() {
+		var revision *gardencorev1beta1.ShootState
+
+		BeforeEach(func() {
+			reconciler.Config.MaxRevisions = ptr.To(5)
+
+			revision = &gardencorev1beta1.ShootState{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "foo--rev-1700000000",
+					Namespace:   "garden-bar",
+					Labels:      map[string]string{"shoot.gardener.cloud/name": "foo"},
+					Annotations: map[string]string{"gardener.cloud/timestamp": "2023-11-14T22:13:20Z"},
+				},
+				Spec: gardencorev1beta1.ShootStateSpec{
+					Gardener: []gardencorev1beta1.GardenerResourceData{
+						{Name: "ca", Type: "secret", Data: runtime.RawExtension{Raw: []byte(`{"old":"ca"}`)}},
+						{Name: "machine-state", Type: "machine-state", Data: runtime.RawExtension{Raw: []byte(`{"old":"machines"}`)}},
+					},
+					Extensions: []gardencorev1beta1.ExtensionResourceState{
+						{Kind: "Infrastructure", State: &runtime.RawExtension{Raw: []byte(`{"old":"infrastructure"}`)}},
+					},
+				},
+			}
+			Expect(fakeClient.Create(ctx, revision)).To(Succeed())
+			Expect(fakeClient.Create(ctx, shootState)).To(Succeed())
+
+			shoot.Annotations = map[string]string{"gardener.cloud/operation": "restore-shootstate-revision=foo--rev-1700000000"}
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:  gardencorev1beta1.LastOperationTypeMigrate,
+				State: gardencorev1beta1.LastOperationStateSucceeded,
+			}
+		})
+
+		expectRestored := func() {
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+			ExpectWithOffset(1, shootState.Annotations).To(HaveKeyWithValue("shootstate.gardener.cloud/restored-from-revision", "foo--rev-1700000000"))
+			ExpectWithOffset(1, shootState.Spec.Gardener).To(ConsistOf(
+				gardencorev1beta1.GardenerResourceData{Name: "machine-state", Type: "machine-state", Data: runtime.RawExtension{Raw: []byte(`{"new":"machines"}`)}},
+				gardencorev1beta1.GardenerResourceData{Name: "ca", Type: "secret", Data: runtime.RawExtension{Raw: []byte(`{"old":"ca"}`)}},
+			))
+			ExpectWithOffset(1, shootState.Spec.Extensions).To(Equal(revision.Spec.Extensions))
+
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			ExpectWithOffset(1, shoot.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+			ExpectWithOffset(1, recorder.Events).To(Receive(ContainSubstring("ShootStateRestored")))
+		}
+
+		It("should restore the secrets and extension states after the control plane was migrated", func() {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			expectRestored()
+		})
+
+		It("should restore the secrets and extension states if the restoration of the control plane failed", func() {
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:  gardencorev1beta1.LastOperationTypeRestore,
+				State: gardencorev1beta1.LastOperationStateError,
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			expectRestored()
+		})
+
+		It("should not create a new revision of the restored state", func() {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(listRevisions()).To(ConsistOf("foo--rev-1700000000", "foo--rev-1735689600"))
+		})
+
+		It("should create the shoot state if it does not exist", func() {
+			Expect(fakeClient.Delete(ctx, shootState)).To(Succeed())
+			shootState.ResourceVersion = ""
+			shootState.Spec = gardencorev1beta1.ShootStateSpec{}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+			Expect(shootState.Spec.Gardener).To(Equal(revision.Spec.Gardener[:1]))
+			Expect(shootState.Spec.Extensions).To(Equal(revision.Spec.Extensions))
+		})
+
+		It("should wait while the control plane is being migrated", func() {
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+			Expect(shootState.Annotations).NotTo(HaveKey("shootstate.gardener.cloud/restored-from-revision"))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKey("gardener.cloud/operation"))
+		})
+
+		It("should wait if the control plane is not migrated", func() {
+			shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKey("gardener.cloud/operation"))
+		})
+
+		It("should remove the operation annotation if the revision does not exist", func() {
+			shoot.Annotations["gardener.cloud/operation"] = "restore-shootstate-revision=foo--rev-1"
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+			Expect(recorder.Events).To(Receive(ContainSubstring("ShootStateRestoreFailed")))
+		})
+
+		It("should not restore from revisions of other shoots", func() {
+			shoot.Annotations["gardener.cloud/operation"] = "restore-shootstate-revision=foo"
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+			Expect(shootState.Annotations).NotTo(HaveKey("shootstate.gardener.cloud/restored-from-revision"))
+			Expect(recorder.Events).To(Receive(ContainSubstring("ShootStateRestoreFailed")))
+		})
+	})
+
+	It("should do nothing if neither the shoot nor revisions exist", func() {
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(BeNotFoundError())
+	})
+})
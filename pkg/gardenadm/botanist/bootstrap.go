@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// SecretNameBootstrapShootState is the name of the secret in the kube-system namespace of the autonomous shoot
+	// cluster which contains the ShootState handed over by 'gardenadm bootstrap'.
+	SecretNameBootstrapShootState = "gardenadm-bootstrap-shootstate"
+	// dataKeyShootStateSpec is the key in the bootstrap ShootState secret which holds the JSON-encoded ShootState spec.
+	dataKeyShootStateSpec = "spec"
+)
+
+// DeployMachineCRDs deploys the CustomResourceDefinitions of machine-controller-manager (e.g., MachineDeployment,
+// Machine) into the bootstrap cluster.
+func (b *AutonomousBotanist) DeployMachineCRDs(ctx context.Context) error {
+	return machinecontrollermanager.NewCRD(b.SeedClientSet.Client(), b.SeedClientSet.Applier()).Deploy(ctx)
+}
+
+// SyncClusterResource creates or updates the extensions Cluster resource for the shoot. Extensions read the shoot, the
+// cloud profile, and the seed from it.
+func (b *AutonomousBotanist) SyncClusterResource(ctx context.Context) error {
+	return extensions.SyncClusterResourceToSeed(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, b.Shoot.GetInfo(), b.Shoot.CloudProfile, b.Seed.GetInfo())
+}
+
+// ComputeShootState computes the ShootState of the shoot based on the resources in the bootstrap cluster, i.e., the
+// secrets which must be persisted (e.g., the certificate authorities) and the state of the extension resources and the
+// machines. It is handed over to 'gardenadm init' so that the autonomous shoot cluster uses the same secrets.
+func (b *AutonomousBotanist) ComputeShootState(ctx context.Context) (*gardencorev1beta1.ShootState, error) {
+	shoot := b.Shoot.GetInfo()
+
+	// The ShootState is written to the simulated garden cluster from which it is read again afterwards.
+	if err := shootstate.Deploy(ctx, clock.RealClock{}, b.GardenClient, b.SeedClientSet.Client(), shoot, true, nil); err != nil {
+		return nil, fmt.Errorf("failed computing ShootState: %w", err)
+	}
+
+	shootState := &gardencorev1beta1.ShootState{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shootState); err != nil {
+		return nil, fmt.Errorf("failed reading ShootState: %w", err)
+	}
+
+	shootState.SetGroupVersionKind(gardencorev1beta1.SchemeGroupVersion.WithKind("ShootState"))
+	shootState.ObjectMeta = metav1.ObjectMeta{Name: shootState.Name, Namespace: shootState.Namespace}
+	return shootState, nil
+}
+
+// RestoreSecretsFromShootState creates the secrets managed by the secrets manager which are contained in the handed
+// over ShootState (see ComputeShootState). This way, the secrets manager adopts them when initializing the secrets
+// management instead of generating new ones. It does nothing if there is no ShootState.
+func (b *AutonomousBotanist) RestoreSecretsFromShootState(ctx context.Context) error {
+	shootState := b.Shoot.GetShootState()
+	if shootState == nil {
+		return nil
+	}
+
+	var fns []flow.TaskFn
+
+	for _, entry := range shootState.Spec.Gardener {
+		if entry.Labels[secretsmanager.LabelKeyManagedBy] != secretsmanager.LabelValueSecretsManager ||
+			entry.Type != v1beta1constants.DataTypeSecret {
+			continue
+		}
+
+		fns = append(fns, func(ctx context.Context) error {
+			data := make(map[string][]byte)
+			if err := json.Unmarshal(entry.Data.Raw, &data); err != nil {
+				return fmt.Errorf("failed unmarshalling data of secret %s: %w", entry.Name, err)
+			}
+
+			secret := secretsmanager.Secret(metav1.ObjectMeta{Name: entry.Name, Namespace: b.Shoot.SeedNamespace, Labels: entry.Labels}, data)
+			return client.IgnoreAlreadyExists(b.SeedClientSet.Client().Create(ctx, secret))
+		})
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
+
+// PersistShootState stores the ShootState handed over by 'gardenadm bootstrap' (see ComputeShootState) in the
+// autonomous shoot cluster. Besides the secrets, it contains the state of the extension resources (e.g., Infrastructure
+// and Worker) and of the machines which only existed in the bootstrap cluster, hence it must be kept until it has been
+// handed over to the garden cluster by 'gardenadm connect' (see DeployShootState). It does nothing if there is no
+// ShootState.
+func (b *AutonomousBotanist) PersistShootState(ctx context.Context) error {
+	shootState := b.Shoot.GetShootState()
+	if shootState == nil {
+		return nil
+	}
+
+	spec, err := json.Marshal(shootState.Spec)
+	if err != nil {
+		return fmt.Errorf("failed marshalling ShootState spec: %w", err)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: SecretNameBootstrapShootState, Namespace: metav1.NamespaceSystem}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.SeedClientSet.Client(), secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{dataKeyShootStateSpec: spec}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed persisting ShootState in secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	return nil
+}
+
+// bootstrapShootStateSpec returns the ShootState spec persisted by PersistShootState or nil if the autonomous shoot
+// cluster was not bootstrapped by 'gardenadm bootstrap'.
+func (b *AutonomousBotanist) bootstrapShootStateSpec(ctx context.Context) (*gardencorev1beta1.ShootStateSpec, error) {
+	secret := &corev1.Secret{}
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: SecretNameBootstrapShootState, Namespace: metav1.NamespaceSystem}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	spec := &gardencorev1beta1.ShootStateSpec{}
+	if err := json.Unmarshal(secret.Data[dataKeyShootStateSpec], spec); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling ShootState spec from secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	return spec, nil
+}
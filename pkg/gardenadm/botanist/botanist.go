@@ -0,0 +1,341 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
+	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+const (
+	// internalDomain is the internal domain used for autonomous shoot clusters. It is never published to any DNS
+	// provider, it is only used for the certificates and in-cluster addresses of the control plane components.
+	internalDomain = "gardenadm.local"
+	// unmanagedDNSProvider is the type of DNS providers whose records are not managed by Gardener.
+	unmanagedDNSProvider = "unmanaged"
+)
+
+// AutonomousBotanist is a struct which has methods that perform operations for an autonomous shoot cluster.
+type AutonomousBotanist struct {
+	*botanistpkg.Botanist
+
+	// Resources are the resources read from the manifests directory.
+	Resources gardenadm.Resources
+	// FS is the file system of the node.
+	FS afero.Afero
+	// DBus is used for managing the systemd units of the node.
+	DBus dbus.DBus
+	// HostName is the host name of the node.
+	HostName string
+	// HelmRegistry is used for pulling the charts of the extensions.
+	HelmRegistry oci.Interface
+}
+
+// NewAutonomousBotanist creates a new AutonomousBotanist for the given resources. The garden cluster is simulated with
+// a fake client containing the resources. The control plane components are deployed to the 'kube-system' namespace of
+// the cluster which the given client set points to. If no client set is given (i.e., the cluster is not yet running),
+// a fake client set is used which allows to render the components without a running API server.
+func NewAutonomousBotanist(ctx context.Context, log logr.Logger, clientSet kubernetes.Interface, resources gardenadm.Resources) (*AutonomousBotanist, error) {
+	return newAutonomousBotanist(ctx, log, clientSet, resources, false)
+}
+
+// NewAutonomousBotanistForBootstrapCluster creates a new AutonomousBotanist for creating the infrastructure and the
+// control plane machines of the autonomous shoot cluster with the help of the bootstrap cluster which the given client
+// set points to (see 'gardenadm bootstrap'). In contrast to NewAutonomousBotanist, the bootstrap cluster is treated
+// like a seed, i.e., the resources are deployed to a dedicated shoot namespace and the shoot's credentials are read
+// from the given resources. Only the control plane worker pool is considered since the machines of the other worker
+// pools are managed by the autonomous shoot cluster itself later on.
+func NewAutonomousBotanistForBootstrapCluster(ctx context.Context, log logr.Logger, clientSet kubernetes.Interface, resources gardenadm.Resources) (*AutonomousBotanist, error) {
+	return newAutonomousBotanist(ctx, log, clientSet, resources, true)
+}
+
+func newAutonomousBotanist(ctx context.Context, log logr.Logger, clientSet kubernetes.Interface, resources gardenadm.Resources, bootstrapCluster bool) (*AutonomousBotanist, error) {
+	shoot := resources.Shoot.DeepCopy()
+	if shoot.Status.Gardener.Version == "" {
+		identity, err := gardenerutils.DetermineIdentity()
+		if err != nil {
+			return nil, fmt.Errorf("failed determining gardener identity: %w", err)
+		}
+		shoot.Status.Gardener = *identity
+	}
+	if err := defaultShoot(shoot, resources.CloudProfile); err != nil {
+		return nil, fmt.Errorf("failed defaulting shoot: %w", err)
+	}
+	if shoot.UID == "" {
+		shoot.UID = uuid.NewUUID()
+	}
+	if bootstrapCluster {
+		if len(shoot.Spec.Provider.Workers) == 0 {
+			return nil, fmt.Errorf("shoot must have at least one worker pool for running the control plane")
+		}
+		shoot.Spec.Provider.Workers = shoot.Spec.Provider.Workers[:1]
+		shoot.Status.TechnicalID = gardenerutils.ComputeTechnicalID(resources.Project.Name, shoot)
+	} else {
+		// The control plane of autonomous shoot clusters runs in the cluster itself, hence there is no dedicated seed
+		// namespace. Setting the technical ID makes all components use the 'kube-system' namespace instead.
+		shoot.Status.TechnicalID = metav1.NamespaceSystem
+	}
+	resources.Shoot = shoot
+
+	if clientSet == nil {
+		clientSet = NewFakeClientSet(shoot.Spec.Kubernetes.Version)
+	}
+
+	gardenClient, err := newFakeGardenClient(resources)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating fake garden client: %w", err)
+	}
+
+	seedObj, err := seedpkg.NewBuilder().WithSeedObject(seedForShoot(shoot)).Build(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed building seed object: %w", err)
+	}
+
+	shootBuilder := shootpkg.NewBuilder().
+		WithShootObject(shoot).
+		WithCloudProfileObject(resources.CloudProfile)
+	if bootstrapCluster {
+		// The provider extension running in the bootstrap cluster needs the credentials for creating the infrastructure.
+		shootBuilder = shootBuilder.WithShootCredentialsFrom(gardenClient)
+	} else {
+		shootBuilder = shootBuilder.WithoutShootCredentials()
+	}
+
+	shootObj, err := shootBuilder.
+		WithSeedObject(seedObj.GetInfo()).
+		WithProjectName(resources.Project.Name).
+		WithInternalDomain(&gardenerutils.Domain{Domain: internalDomain, Provider: unmanagedDNSProvider}).
+		WithServiceAccountIssuerHostname(nil).
+		Build(ctx, gardenClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed building shoot object: %w", err)
+	}
+
+	if resources.ShootState != nil {
+		shootObj.SetShootState(resources.ShootState)
+	}
+
+	// The networks of autonomous shoot clusters are known upfront since there is no infrastructure which could report
+	// them.
+	shootObj.Networks, err = shootpkg.ToNetworks(shoot, shootObj.IsWorkerless)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing shoot networks: %w", err)
+	}
+
+	config := &gardenletconfigv1alpha1.GardenletConfiguration{}
+	gardenletconfigv1alpha1.SetObjectDefaults_GardenletConfiguration(config)
+
+	o, err := operation.NewBuilder().
+		WithLogger(log).
+		WithConfig(config).
+		WithGardenerInfo(&shoot.Status.Gardener).
+		WithGardenClusterIdentity(shoot.Name).
+		WithSecrets(nil).
+		WithGarden(&garden.Garden{Project: resources.Project}).
+		WithSeed(seedObj).
+		WithShoot(shootObj).
+		Build(ctx, gardenClient, clientSet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed building operation: %w", err)
+	}
+
+	b, err := botanistpkg.New(ctx, o)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating botanist: %w", err)
+	}
+
+	hostName, err := nodeagent.GetHostName()
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching host name: %w", err)
+	}
+
+	helmRegistry, err := oci.NewHelmRegistry(gardenClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating helm registry: %w", err)
+	}
+
+	return &AutonomousBotanist{
+		Botanist:     b,
+		Resources:    resources,
+		FS:           afero.Afero{Fs: afero.NewOsFs()},
+		DBus:         dbus.New(log),
+		HostName:     hostName,
+		HelmRegistry: helmRegistry,
+	}, nil
+}
+
+// NewFakeClientSet returns a fake client set which can be used for rendering the control plane components before the
+// API server of the autonomous shoot cluster is running.
+func NewFakeClientSet(kubernetesVersion string) kubernetes.Interface {
+	return fakekubernetes.NewClientSetBuilder().
+		WithClient(fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()).
+		WithRESTConfig(&rest.Config{}).
+		WithVersion(kubernetesVersion).
+		Build()
+}
+
+func newFakeGardenClient(resources gardenadm.Resources) (client.Client, error) {
+	objects := []client.Object{resources.Project, resources.CloudProfile, resources.Shoot}
+
+	if resources.NamespacedCloudProfile != nil {
+		objects = append(objects, resources.NamespacedCloudProfile)
+	}
+	if resources.SecretBinding != nil {
+		objects = append(objects, resources.SecretBinding)
+	}
+	if resources.CredentialsBinding != nil {
+		objects = append(objects, resources.CredentialsBinding)
+	}
+	if resources.WorkloadIdentity != nil {
+		objects = append(objects, resources.WorkloadIdentity)
+	}
+	for _, obj := range resources.ControllerRegistrations {
+		objects = append(objects, obj)
+	}
+	for _, obj := range resources.ControllerDeployments {
+		objects = append(objects, obj)
+	}
+	for _, obj := range resources.Secrets {
+		objects = append(objects, obj)
+	}
+
+	c := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.GardenScheme).
+		WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+		WithIndex(&seedmanagementv1alpha1.ManagedSeed{}, seedmanagement.ManagedSeedShootName, indexer.ManagedSeedShootNameIndexerFunc).
+		Build()
+	for _, obj := range objects {
+		if err := c.Create(context.Background(), obj.DeepCopyObject().(client.Object)); err != nil {
+			return nil, fmt.Errorf("failed creating %T %s: %w", obj, client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	return c, nil
+}
+
+// defaultShoot applies the defaults which are usually set by the admission plugins of gardener-apiserver. They are not
+// running when bootstrapping an autonomous shoot cluster.
+func defaultShoot(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) error {
+	if shoot.Spec.Kubernetes.EnableStaticTokenKubeconfig == nil {
+		shoot.Spec.Kubernetes.EnableStaticTokenKubeconfig = ptr.To(false)
+	}
+
+	// There is no DNS infrastructure available when bootstrapping, hence the shoot must use unmanaged DNS.
+	if shoot.Spec.DNS == nil {
+		shoot.Spec.DNS = &gardencorev1beta1.DNS{}
+	}
+	if len(shoot.Spec.DNS.Providers) == 0 {
+		shoot.Spec.DNS.Providers = []gardencorev1beta1.DNSProvider{{Type: ptr.To(unmanagedDNSProvider)}}
+	}
+
+	if len(shoot.Spec.Provider.Workers) > 0 && shoot.Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod == nil {
+		shoot.Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod = &metav1.Duration{Duration: 40 * time.Second}
+	}
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		machineImage, err := defaultMachineImage(worker.Machine.Image, cloudProfile)
+		if err != nil {
+			return fmt.Errorf("failed defaulting machine image of worker pool %q: %w", worker.Name, err)
+		}
+		shoot.Spec.Provider.Workers[i].Machine.Image = machineImage
+	}
+
+	return nil
+}
+
+// defaultMachineImage defaults the machine image (and its version) to the first machine image of the CloudProfile and
+// its latest version.
+func defaultMachineImage(image *gardencorev1beta1.ShootMachineImage, cloudProfile *gardencorev1beta1.CloudProfile) (*gardencorev1beta1.ShootMachineImage, error) {
+	if image == nil {
+		defaultImage := v1beta1helper.GetDefaultMachineImageFromCloudProfile(*cloudProfile)
+		if defaultImage == nil {
+			return nil, fmt.Errorf("cloud profile does not contain any machine image")
+		}
+		image = &gardencorev1beta1.ShootMachineImage{Name: defaultImage.Name}
+	} else {
+		image = image.DeepCopy()
+	}
+
+	if image.Version != nil {
+		return image, nil
+	}
+
+	found, machineImage := v1beta1helper.DetermineMachineImageForName(cloudProfile, image.Name)
+	if !found {
+		return nil, fmt.Errorf("machine image %q is not supported by the cloud profile", image.Name)
+	}
+
+	found, latestVersion, err := v1beta1helper.GetLatestQualifyingVersion(v1beta1helper.ToExpirableVersions(machineImage.Versions))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("no qualifying version found for machine image %q", image.Name)
+	}
+
+	image.Version = &latestVersion.Version
+	return image, nil
+}
+
+// seedForShoot returns a Seed object which represents the autonomous shoot cluster itself since its control plane
+// runs in the cluster itself.
+func seedForShoot(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.Seed {
+	seed := &gardencorev1beta1.Seed{
+		ObjectMeta: metav1.ObjectMeta{Name: shoot.Name},
+		Spec: gardencorev1beta1.SeedSpec{
+			Provider: gardencorev1beta1.SeedProvider{
+				Type:   shoot.Spec.Provider.Type,
+				Region: shoot.Spec.Region,
+			},
+			Ingress: &gardencorev1beta1.Ingress{
+				Domain:     "ingress." + shoot.Name,
+				Controller: gardencorev1beta1.IngressController{Kind: "nginx"},
+			},
+		},
+		Status: gardencorev1beta1.SeedStatus{
+			KubernetesVersion: ptr.To(shoot.Spec.Kubernetes.Version),
+		},
+	}
+
+	if networking := shoot.Spec.Networking; networking != nil {
+		seed.Spec.Networks = gardencorev1beta1.SeedNetworks{
+			Nodes:      networking.Nodes,
+			Pods:       ptr.Deref(networking.Pods, ""),
+			Services:   ptr.Deref(networking.Services, ""),
+			IPFamilies: networking.IPFamilies,
+		}
+	}
+
+	return seed
+}
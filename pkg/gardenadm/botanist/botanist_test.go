@@ -0,0 +1,839 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"net"
+	"strings"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
+	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/charts"
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	mockkubernetes "github.com/gardener/gardener/pkg/client/kubernetes/mock"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	gardenletvalidation "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/validation"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("AutonomousBotanist", func() {
+	var (
+		ctx       = context.Background()
+		resources gardenadm.Resources
+	)
+
+	BeforeEach(func() {
+		resources = gardenadm.Resources{
+			CloudProfile: &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "local"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Type:    "local",
+					Regions: []gardencorev1beta1.Region{{Name: "local"}},
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.31.1"}},
+					},
+					MachineTypes: []gardencorev1beta1.MachineType{{Name: "local"}},
+					MachineImages: []gardencorev1beta1.MachineImage{{
+						Name: "local",
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}},
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0"}},
+						},
+					}},
+				},
+			},
+			Project: &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "garden"},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden")},
+			},
+			Shoot: &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "garden"},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfile: &gardencorev1beta1.CloudProfileReference{Name: "local"},
+					Region:       "local",
+					Networking: &gardencorev1beta1.Networking{
+						Type:     ptr.To("calico"),
+						Nodes:    ptr.To("10.10.0.0/16"),
+						Pods:     ptr.To("10.1.0.0/16"),
+						Services: ptr.To("10.2.0.0/16"),
+					},
+					Provider: gardencorev1beta1.Provider{
+						Type: "local",
+						Workers: []gardencorev1beta1.Worker{{
+							Name:    "control-plane",
+							Machine: gardencorev1beta1.Machine{Type: "local"},
+							CRI:     &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD},
+							Minimum: 1,
+							Maximum: 1,
+						}},
+					},
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+				},
+				Status: gardencorev1beta1.ShootStatus{
+					Gardener: gardencorev1beta1.Gardener{Version: "1.110.0"},
+				},
+			},
+		}
+
+		// Apply the defaults like gardenadm.ReadManifests does.
+		kubernetes.GardenScheme.Default(resources.CloudProfile)
+		kubernetes.GardenScheme.Default(resources.Shoot)
+	})
+
+	Describe("#NewAutonomousBotanist", func() {
+		It("should default the shoot", func() {
+			b, err := NewAutonomousBotanist(ctx, logr.Discard(), nil, resources)
+			Expect(err).NotTo(HaveOccurred())
+
+			shoot := b.Shoot.GetInfo()
+			Expect(shoot.UID).NotTo(BeEmpty())
+			Expect(shoot.Status.TechnicalID).To(Equal("kube-system"))
+			Expect(shoot.Spec.DNS.Providers).To(ConsistOf(gardencorev1beta1.DNSProvider{Type: ptr.To("unmanaged")}))
+			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(&gardencorev1beta1.ShootMachineImage{Name: "local", Version: ptr.To("1.1.0")}))
+			Expect(b.Shoot.SeedNamespace).To(Equal("kube-system"))
+			Expect(b.Shoot.ComputeOutOfClusterAPIServerAddress(true)).To(Equal("api.root.garden.internal.gardenadm.local"))
+
+			Expect(resources.Shoot.Spec.Provider.Workers[0].Machine.Image).To(BeNil(), "the given resources should not be mutated")
+		})
+
+		It("should fail if the machine image is not supported by the cloud profile", func() {
+			resources.Shoot.Spec.Provider.Workers[0].Machine.Image = &gardencorev1beta1.ShootMachineImage{Name: "foo"}
+
+			_, err := NewAutonomousBotanist(ctx, logr.Discard(), nil, resources)
+			Expect(err).To(MatchError(ContainSubstring(`machine image "foo" is not supported by the cloud profile`)))
+		})
+	})
+
+	Describe("#NewAutonomousBotanistForBootstrapCluster", func() {
+		It("should use a dedicated shoot namespace and only consider the control plane worker pool", func() {
+			resources.Shoot.Spec.Provider.Workers = append(resources.Shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker", Machine: gardencorev1beta1.Machine{Type: "local"}})
+
+			b, err := NewAutonomousBotanistForBootstrapCluster(ctx, logr.Discard(), NewFakeClientSet("1.31.1"), resources)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(b.Shoot.SeedNamespace).To(Equal("shoot--garden--root"))
+			Expect(b.Shoot.GetInfo().Spec.Provider.Workers).To(ConsistOf(HaveField("Name", "control-plane")))
+		})
+
+		It("should fail if the shoot has no worker pools", func() {
+			resources.Shoot.Spec.Provider.Workers = nil
+
+			_, err := NewAutonomousBotanistForBootstrapCluster(ctx, logr.Discard(), NewFakeClientSet("1.31.1"), resources)
+			Expect(err).To(MatchError(ContainSubstring("shoot must have at least one worker pool")))
+		})
+	})
+
+	Describe("bootstrap cluster", func() {
+		var (
+			b          *AutonomousBotanist
+			fakeClient client.Client
+		)
+
+		BeforeEach(func() {
+			var err error
+			b, err = NewAutonomousBotanistForBootstrapCluster(ctx, logr.Discard(), NewFakeClientSet("1.31.1"), resources)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClient = b.SeedClientSet.Client()
+		})
+
+		Describe("#ComputeShootState and #RestoreSecretsFromShootState", func() {
+			It("should hand over the secrets to the autonomous shoot cluster", func() {
+				Expect(b.InitializeSecretsManagement(ctx)).To(Succeed())
+				caSecret, found := b.SecretsManager.Get("ca", secretsmanager.Current)
+				Expect(found).To(BeTrue())
+
+				shootState, err := b.ComputeShootState(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shootState.Kind).To(Equal("ShootState"))
+				Expect(shootState.ResourceVersion).To(BeEmpty())
+				Expect(shootState.Spec.Gardener).To(ContainElement(HaveField("Name", caSecret.Name)))
+
+				resources.ShootState = shootState
+				bAutonomous, err := NewAutonomousBotanist(ctx, logr.Discard(), nil, resources)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(bAutonomous.RestoreSecretsFromShootState(ctx)).To(Succeed())
+				Expect(bAutonomous.InitializeSecretsManagement(ctx)).To(Succeed())
+
+				restoredCASecret, found := bAutonomous.SecretsManager.Get("ca", secretsmanager.Current)
+				Expect(found).To(BeTrue())
+				Expect(restoredCASecret.Name).To(Equal(caSecret.Name))
+				Expect(restoredCASecret.Namespace).To(Equal("kube-system"))
+				Expect(restoredCASecret.Data).To(Equal(caSecret.Data))
+			})
+
+			It("should do nothing if there is no ShootState", func() {
+				Expect(b.RestoreSecretsFromShootState(ctx)).To(Succeed())
+
+				secretList := &corev1.SecretList{}
+				Expect(fakeClient.List(ctx, secretList)).To(Succeed())
+				Expect(secretList.Items).To(BeEmpty())
+			})
+		})
+
+		Describe("#DeployMachineControllerManager", func() {
+			It("should deploy machine-controller-manager targeting the bootstrap cluster", func() {
+				Expect(b.DeployMachineControllerManager(ctx)).To(Succeed())
+
+				deployment := &appsv1.Deployment{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "machine-controller-manager", Namespace: "shoot--garden--root"}, deployment)).To(Succeed())
+				Expect(deployment.Labels).To(HaveKeyWithValue("provider.extensions.gardener.cloud/mutated-by-controlplane-webhook", "true"))
+				Expect(deployment.Spec.Template.Spec.Containers[0].Command).To(ContainElements(
+					"--namespace=shoot--garden--root",
+					"--target-kubeconfig=/var/run/secrets/gardener.cloud/shoot/generic-kubeconfig/kubeconfig",
+				))
+
+				secret := &corev1.Secret{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "machine-controller-manager-kubeconfig", Namespace: "shoot--garden--root"}, secret)).To(Succeed())
+				Expect(string(secret.Data["kubeconfig"])).To(And(
+					ContainSubstring("server: https://kubernetes.default.svc"),
+					ContainSubstring("tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token"),
+				))
+
+				clusterRoleBinding := &rbacv1.ClusterRoleBinding{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "gardenadm:bootstrap:machine-controller-manager:shoot--garden--root"}, clusterRoleBinding)).To(Succeed())
+				Expect(clusterRoleBinding.Subjects).To(ConsistOf(HaveField("Name", "machine-controller-manager")))
+			})
+		})
+
+		Describe("#WaitUntilControlPlaneMachinesCreated", func() {
+			It("should return the created machines", func() {
+				Expect(fakeClient.Create(ctx, &machinev1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{Name: "machine-0", Namespace: "shoot--garden--root"},
+					Spec:       machinev1alpha1.MachineSpec{ProviderID: "machine-0"},
+				})).To(Succeed())
+
+				machines, err := b.WaitUntilControlPlaneMachinesCreated(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(machines).To(ConsistOf(HaveField("Name", "machine-0")))
+			})
+		})
+
+		Describe("#StopBootstrapControllers", func() {
+			It("should scale down the extensions and machine-controller-manager", func() {
+				Expect(fakeClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "extension-provider-local", Labels: map[string]string{"gardener.cloud/role": "extension"}}})).To(Succeed())
+				extensionDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "provider-local", Namespace: "extension-provider-local"}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)}}
+				Expect(fakeClient.Create(ctx, extensionDeployment)).To(Succeed())
+				Expect(b.DeployMachineControllerManager(ctx)).To(Succeed())
+
+				Expect(b.StopBootstrapControllers(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(extensionDeployment), extensionDeployment)).To(Succeed())
+				Expect(extensionDeployment.Spec.Replicas).To(Equal(ptr.To[int32](0)))
+
+				mcmDeployment := &appsv1.Deployment{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "machine-controller-manager", Namespace: "shoot--garden--root"}, mcmDeployment)).To(Succeed())
+				Expect(mcmDeployment.Spec.Replicas).To(Equal(ptr.To[int32](0)))
+			})
+		})
+	})
+
+	Describe("bootstrapping", Ordered, func() {
+		var (
+			b  *AutonomousBotanist
+			fs afero.Afero
+
+			controlPlaneFiles, controlPlaneSecretFiles []extensionsv1alpha1.File
+		)
+
+		BeforeAll(func() {
+			resources.Shoot.Spec.Provider.Workers = append(resources.Shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{
+				Name:    "worker",
+				Machine: gardencorev1beta1.Machine{Type: "local"},
+				CRI:     &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD},
+				Minimum: 1,
+				Maximum: 2,
+			})
+			kubernetes.GardenScheme.Default(resources.Shoot)
+
+			var err error
+			b, err = NewAutonomousBotanist(ctx, logr.Discard(), nil, resources)
+			Expect(err).NotTo(HaveOccurred())
+
+			fs = afero.Afero{Fs: afero.NewMemMapFs()}
+			b.FS = fs
+			b.HostName = "machine-0"
+
+			Expect(b.InitializeSecretsManagement(ctx)).To(Succeed())
+		})
+
+		It("should render the control plane as static pods", func() {
+			var err error
+			controlPlaneFiles, controlPlaneSecretFiles, err = b.DeployControlPlane(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(controlPlaneSecretFiles).To(ContainElements(
+				HaveField("Path", "/var/lib/etcd-main/peer-ca/ca.key"),
+				HaveField("Path", "/var/lib/kube-controller-manager/ca-client/ca.key"),
+			))
+			for _, file := range controlPlaneFiles {
+				Expect(controlPlaneSecretFiles).NotTo(ContainElement(HaveField("Path", file.Path)))
+			}
+
+			var manifests []string
+			for _, file := range controlPlaneFiles {
+				if strings.HasPrefix(file.Path, staticpod.ManifestsDir+"/") {
+					manifests = append(manifests, strings.TrimPrefix(file.Path, staticpod.ManifestsDir+"/"))
+				}
+			}
+			Expect(manifests).To(ConsistOf(
+				"etcd-main.yaml",
+				"kube-apiserver.yaml",
+				"kube-controller-manager.yaml",
+				"kube-scheduler.yaml",
+				"gardener-resource-manager.yaml",
+			))
+		})
+
+		It("should compute the operating system config", func() {
+			oscSecret, err := b.ComputeOperatingSystemConfig(ctx, controlPlaneFiles)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(oscSecret.Namespace).To(Equal("kube-system"))
+			Expect(oscSecret.Labels).To(HaveKeyWithValue("worker.gardener.cloud/pool", "control-plane"))
+
+			osc := &extensionsv1alpha1.OperatingSystemConfig{}
+			Expect(runtime.DecodeInto(kubernetes.SeedCodec.UniversalDecoder(), oscSecret.Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig], osc)).To(Succeed())
+			Expect(osc.Spec.Files).To(ContainElements(controlPlaneFiles))
+			for _, file := range controlPlaneSecretFiles {
+				Expect(osc.Spec.Files).NotTo(ContainElement(HaveField("Path", file.Path)))
+			}
+			Expect(osc.Spec.Files).To(ContainElement(HaveField("Path", kubelet.PathKubeletConfig)))
+			Expect(osc.Spec.Units).To(ContainElements(
+				HaveField("Name", "kubelet.service"),
+				HaveField("Name", "gardener-node-agent.service"),
+			))
+		})
+
+		It("should compute the operating system configs of the other worker pools", func() {
+			oscSecrets, err := b.ComputeWorkerOperatingSystemConfigs(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(oscSecrets).To(HaveLen(1))
+			Expect(oscSecrets[0].Labels).To(HaveKeyWithValue("worker.gardener.cloud/pool", "worker"))
+
+			osc := &extensionsv1alpha1.OperatingSystemConfig{}
+			Expect(runtime.DecodeInto(kubernetes.SeedCodec.UniversalDecoder(), oscSecrets[0].Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig], osc)).To(Succeed())
+			Expect(osc.Spec.Files).To(ContainElement(HaveField("Path", nodeagentconfigv1alpha1.ConfigFilePath)))
+			Expect(osc.Spec.Files).NotTo(ContainElement(HaveField("Path", HavePrefix(staticpod.ManifestsDir))))
+		})
+
+		It("should write the etcd member configuration", func() {
+			Expect(b.WriteEtcdMemberConfig("10.0.0.2")).To(Succeed())
+
+			config, err := fs.ReadFile("/var/lib/etcd-main/config/etcd.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(config)).To(And(
+				ContainSubstring("name: machine-0"),
+				ContainSubstring("initial-cluster: machine-0=https://10.0.0.2:2380"),
+				ContainSubstring("initial-cluster-state: new"),
+				ContainSubstring("advertise-client-urls: https://10.0.0.2:2379"),
+			))
+
+			certificatePEM, err := fs.ReadFile("/var/lib/etcd-main/peer/tls.crt")
+			Expect(err).NotTo(HaveOccurred())
+			certificate, err := utils.DecodeCertificate(certificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificate.IPAddresses).To(ConsistOf(WithTransform(net.IP.String, Equal("10.0.0.2"))))
+			Expect(certificate.DNSNames).To(ConsistOf("machine-0"))
+		})
+
+		It("should load the etcd peer CA and the etcd client TLS configuration from the static pod files", func() {
+			Expect(WriteFiles(fs, controlPlaneSecretFiles)).To(Succeed())
+
+			for _, file := range controlPlaneFiles {
+				if file.Content.Inline == nil {
+					continue
+				}
+
+				content, err := utils.DecodeBase64(file.Content.Inline.Data)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fs.WriteFile(file.Path, content, 0600)).To(Succeed())
+			}
+
+			peerCA, err := LoadEtcdPeerCA(fs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(peerCA.Certificate.IsCA).To(BeTrue())
+
+			tlsConfig, err := EtcdClientTLSConfig(fs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tlsConfig.ServerName).To(Equal("etcd-main-client"))
+			Expect(tlsConfig.Certificates).To(HaveLen(1))
+
+			Expect(WriteEtcdMemberFiles(fs, peerCA, "machine-1", "10.0.0.3", []EtcdMember{
+				{Name: "machine-0", PeerURL: EtcdPeerURL("10.0.0.2")},
+				{Name: "machine-1", PeerURL: EtcdPeerURL("10.0.0.3")},
+			}, false)).To(Succeed())
+
+			config, err := fs.ReadFile("/var/lib/etcd-main/config/etcd.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(config)).To(And(
+				ContainSubstring("initial-cluster: machine-0=https://10.0.0.2:2380,machine-1=https://10.0.0.3:2380"),
+				ContainSubstring("initial-cluster-state: existing"),
+			))
+		})
+
+		It("should write the kubeconfigs", func() {
+			Expect(b.WriteKubeconfigs(ctx)).To(Succeed())
+
+			for _, path := range []string{PathKubeconfigAdmin, kubelet.PathKubeconfigReal} {
+				content, err := fs.ReadFile(path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(content)).To(ContainSubstring("server: https://api.root.garden.internal.gardenadm.local"))
+
+				info, err := fs.Stat(path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.Mode().Perm()).To(BeEquivalentTo(0600))
+			}
+		})
+
+		It("should ensure the hosts entry for the API server", func() {
+			Expect(fs.WriteFile(PathHostsFile, []byte("127.0.0.1 localhost\n10.0.0.1 api.root.garden.internal.gardenadm.local\n"), 0644)).To(Succeed())
+
+			Expect(b.EnsureAPIServerHostsEntry("127.0.0.1")).To(Succeed())
+			Expect(b.EnsureAPIServerHostsEntry("127.0.0.1")).To(Succeed())
+
+			content, err := fs.ReadFile(PathHostsFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("127.0.0.1 localhost\n127.0.0.1 api.root.garden.internal.gardenadm.local\n"))
+		})
+	})
+
+	Describe("cluster setup", func() {
+		var (
+			b          *AutonomousBotanist
+			fakeClient client.Client
+		)
+
+		BeforeEach(func() {
+			var err error
+			b, err = NewAutonomousBotanist(ctx, logr.Discard(), nil, resources)
+			Expect(err).NotTo(HaveOccurred())
+
+			b.FS = afero.Afero{Fs: afero.NewMemMapFs()}
+			b.HostName = "machine-0"
+			fakeClient = b.SeedClientSet.Client()
+		})
+
+		Describe("#MigrateSecrets", func() {
+			It("should copy the secrets managed by the secrets manager and keep existing ones", func() {
+				bootstrap, err := NewAutonomousBotanist(ctx, logr.Discard(), nil, resources)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bootstrap.InitializeSecretsManagement(ctx)).To(Succeed())
+
+				existingSecret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "kube-system", Labels: map[string]string{secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager}},
+					Data:       map[string][]byte{"foo": []byte("bar")},
+				}
+				Expect(fakeClient.Create(ctx, existingSecret)).To(Succeed())
+
+				Expect(b.MigrateSecrets(ctx, bootstrap.SeedClientSet.Client())).To(Succeed())
+
+				sourceList, targetList := &corev1.SecretList{}, &corev1.SecretList{}
+				Expect(bootstrap.SeedClientSet.Client().List(ctx, sourceList, client.InNamespace("kube-system"), client.MatchingLabels{secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager})).To(Succeed())
+				Expect(fakeClient.List(ctx, targetList, client.InNamespace("kube-system"), client.MatchingLabels{secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager})).To(Succeed())
+
+				Expect(sourceList.Items).To(ContainElement(HaveField("Name", existingSecret.Name)))
+				for _, secret := range sourceList.Items {
+					if secret.Name == existingSecret.Name {
+						secret.Data = existingSecret.Data
+					}
+
+					Expect(targetList.Items).To(ContainElement(And(
+						HaveField("Name", secret.Name),
+						HaveField("Data", secret.Data),
+					)))
+				}
+			})
+		})
+
+		Describe("#DeployNodeAgentResources", func() {
+			It("should deploy the operating system config secrets and the worker pools ConfigMap", func() {
+				oscSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-agent-control-plane", Namespace: "kube-system", Labels: map[string]string{"worker.gardener.cloud/pool": "control-plane"}}}
+				workerOSCSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-agent-worker", Namespace: "kube-system", Labels: map[string]string{"worker.gardener.cloud/pool": "worker"}}}
+
+				Expect(b.DeployNodeAgentResources(ctx, oscSecret, []*corev1.Secret{workerOSCSecret})).To(Succeed())
+
+				managedResource := &resourcesv1alpha1.ManagedResource{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: ManagedResourceNameGardenerNodeAgent, Namespace: "kube-system"}, managedResource)).To(Succeed())
+				Expect(managedResource.Spec.SecretRefs).To(HaveLen(2))
+				Expect(managedResource).To(matchers.NewManagedResourceContainsObjectsMatcher(fakeClient)(
+					oscSecret,
+					workerOSCSecret,
+					&corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: ConfigMapNameWorkerPools, Namespace: "kube-system"},
+						Data: map[string]string{
+							"controlPlaneWorkerPool": "control-plane",
+							"control-plane":          "gardener-node-agent-control-plane",
+							"worker":                 "gardener-node-agent-worker",
+						},
+					},
+				))
+
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "shoot-access-gardener-node-agent", Namespace: "kube-system"}, &corev1.Secret{})).To(Succeed())
+			})
+		})
+
+		Describe("#UploadControlPlaneSecretFiles", func() {
+			It("should upload the encrypted files and grant bootstrappers access to them", func() {
+				certificateKey, err := GenerateCertificateKey()
+				Expect(err).NotTo(HaveOccurred())
+
+				files := []extensionsv1alpha1.File{{
+					Path:    "/var/lib/etcd-main/peer-ca/ca.key",
+					Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64([]byte("private-key"))}},
+				}}
+
+				Expect(b.UploadControlPlaneSecretFiles(ctx, files, certificateKey)).To(Succeed())
+
+				secret := &corev1.Secret{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: SecretNameControlPlaneSecretFiles, Namespace: "kube-system"}, secret)).To(Succeed())
+				Expect(DecryptFiles(secret.Data[DataKeyControlPlaneSecretFiles], certificateKey)).To(Equal(files))
+
+				role := &rbacv1.Role{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "gardenadm:bootstrap-control-plane-secret-files", Namespace: "kube-system"}, role)).To(Succeed())
+				Expect(role.Rules).To(ConsistOf(rbacv1.PolicyRule{
+					APIGroups:     []string{""},
+					Resources:     []string{"secrets"},
+					ResourceNames: []string{SecretNameControlPlaneSecretFiles},
+					Verbs:         []string{"get"},
+				}))
+
+				roleBinding := &rbacv1.RoleBinding{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "gardenadm:bootstrap-control-plane-secret-files", Namespace: "kube-system"}, roleBinding)).To(Succeed())
+				Expect(roleBinding.Subjects).To(ConsistOf(HaveField("Name", "system:bootstrappers")))
+			})
+		})
+
+		Describe("garden registration", func() {
+			var gardenClient client.Client
+
+			BeforeEach(func() {
+				gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+			})
+
+			Describe("#RegisterShoot", func() {
+				It("should create the shoot and mark it as self-hosted", func() {
+					Expect(b.RegisterShoot(ctx, gardenClient)).To(Succeed())
+
+					shoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKey{Name: "root", Namespace: "garden"}, shoot)).To(Succeed())
+					Expect(shoot.Labels).To(HaveKeyWithValue("shoot.gardener.cloud/self-hosted", "true"))
+					Expect(shoot.Spec.Provider.Workers).To(ConsistOf(HaveField("Name", "control-plane")))
+					Expect(shoot.Status.TechnicalID).To(Equal("kube-system"))
+					Expect(shoot.Status.Gardener.Version).NotTo(BeEmpty())
+					Expect(shoot.Status.LastOperation).To(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(gardencorev1beta1.LastOperationTypeCreate),
+						"State": Equal(gardencorev1beta1.LastOperationStateSucceeded),
+					})))
+
+					Expect(b.GardenClient).To(BeIdenticalTo(gardenClient))
+					Expect(b.Shoot.GetInfo().UID).To(Equal(shoot.UID))
+				})
+
+				It("should succeed if the self-hosted shoot already exists", func() {
+					shoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "garden", Labels: map[string]string{"shoot.gardener.cloud/self-hosted": "true"}}}
+					Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+					Expect(b.RegisterShoot(ctx, gardenClient)).To(Succeed())
+
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Status.TechnicalID).To(Equal("kube-system"))
+				})
+
+				It("should fail if the shoot already exists but is not self-hosted", func() {
+					Expect(gardenClient.Create(ctx, &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "garden"}})).To(Succeed())
+
+					Expect(b.RegisterShoot(ctx, gardenClient)).To(MatchError(ContainSubstring("already exists in the garden cluster but is not self-hosted")))
+				})
+			})
+
+			Describe("#DeployGardenlet", func() {
+				var (
+					ctrl         *gomock.Controller
+					chartApplier *mockkubernetes.MockChartApplier
+					values       map[string]any
+				)
+
+				BeforeEach(func() {
+					ctrl = gomock.NewController(GinkgoT())
+					chartApplier = mockkubernetes.NewMockChartApplier(ctrl)
+					b.SeedClientSet = fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).WithChartApplier(chartApplier).Build()
+
+					gardenClient = fakeclient.NewClientBuilder().
+						WithScheme(kubernetes.GardenScheme).
+						WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+						WithInterceptorFuncs(interceptor.Funcs{
+							SubResourceCreate: func(_ context.Context, _ client.Client, subResourceName string, obj client.Object, subResource client.Object, _ ...client.SubResourceCreateOption) error {
+								tokenRequest, ok := subResource.(*authenticationv1.TokenRequest)
+								if !ok || subResourceName != "token" {
+									return fmt.Errorf("unexpected sub resource %s", subResourceName)
+								}
+								Expect(tokenRequest.Spec.ExpirationSeconds).To(PointTo(Equal(int64(90 * 24 * 60 * 60))))
+								tokenRequest.Status.Token = "token-for-" + obj.GetName()
+								return nil
+							},
+						}).
+						Build()
+					Expect(b.RegisterShoot(ctx, gardenClient)).To(Succeed())
+
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.ChartGardenlet, charts.ChartPathGardenlet, "garden", "gardenlet", gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+							applyOptions := &kubernetes.ApplyOptions{}
+							for _, opt := range opts {
+								opt.MutateApplyOptions(applyOptions)
+							}
+							values = applyOptions.Values.(map[string]any)
+							return nil
+						})
+				})
+
+				It("should grant access to the shoot only and deploy the gardenlet", func() {
+					Expect(b.DeployGardenlet(ctx, &rest.Config{Host: "https://api.garden.local", TLSClientConfig: rest.TLSClientConfig{CAData: []byte("ca")}})).To(Succeed())
+
+					serviceAccount := &corev1.ServiceAccount{}
+					Expect(gardenClient.Get(ctx, client.ObjectKey{Name: "gardenlet-root", Namespace: "garden"}, serviceAccount)).To(Succeed())
+
+					role := &rbacv1.Role{}
+					Expect(gardenClient.Get(ctx, client.ObjectKey{Name: "gardenlet-root", Namespace: "garden"}, role)).To(Succeed())
+					Expect(role.Rules).To(ConsistOf(
+						rbacv1.PolicyRule{APIGroups: []string{"core.gardener.cloud"}, Resources: []string{"shoots"}, ResourceNames: []string{"root"}, Verbs: []string{"get", "list", "watch"}},
+						rbacv1.PolicyRule{APIGroups: []string{"core.gardener.cloud"}, Resources: []string{"shootstates"}, ResourceNames: []string{"root"}, Verbs: []string{"get", "list", "watch", "patch", "update"}},
+						rbacv1.PolicyRule{APIGroups: []string{"core.gardener.cloud"}, Resources: []string{"shootstates"}, Verbs: []string{"create"}},
+					))
+
+					roleBinding := &rbacv1.RoleBinding{}
+					Expect(gardenClient.Get(ctx, client.ObjectKey{Name: "gardenlet-root", Namespace: "garden"}, roleBinding)).To(Succeed())
+					Expect(roleBinding.RoleRef.Name).To(Equal("gardenlet-root"))
+					Expect(roleBinding.Subjects).To(ConsistOf(rbacv1.Subject{Kind: "ServiceAccount", Name: "gardenlet-root", Namespace: "garden"}))
+
+					Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "garden"}, &corev1.Namespace{})).To(Succeed())
+
+					kubeconfig, err := utils.GetFromValuesMap(values, "config", "gardenClientConnection", "kubeconfig")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(kubeconfig).To(And(
+						ContainSubstring("server: https://api.garden.local"),
+						ContainSubstring("token: token-for-gardenlet-root"),
+					))
+
+					renderedChart, err := chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.31.1"}).RenderEmbeddedFS(charts.ChartGardenlet, charts.ChartPathGardenlet, "gardenlet", "garden", values)
+					Expect(err).NotTo(HaveOccurred())
+
+					configMap := &corev1.ConfigMap{}
+					for _, files := range renderedChart.Files() {
+						for _, content := range files {
+							if strings.Contains(content, "kind: GardenletConfiguration") {
+								Expect(yaml.Unmarshal([]byte(content), configMap)).To(Succeed())
+							}
+						}
+					}
+
+					config := configMap.Data["config.yaml"]
+					Expect(config).To(And(
+						ContainSubstring("selfHostedShoot:"),
+						ContainSubstring("kubeconfig: /etc/gardenlet/kubeconfig-garden/kubeconfig"),
+						Not(ContainSubstring("seedConfig:")),
+					))
+
+					gardenletConfig := &gardenletconfigv1alpha1.GardenletConfiguration{}
+					Expect(yaml.Unmarshal([]byte(config), gardenletConfig)).To(Succeed())
+					Expect(gardenletConfig.SelfHostedShoot).To(Equal(&gardenletconfigv1alpha1.SelfHostedShoot{Name: "root", Namespace: "garden"}))
+					Expect(gardenletvalidation.ValidateGardenletConfiguration(gardenletConfig, nil, false)).To(BeEmpty())
+				})
+			})
+
+			Describe("#DeployShootState", func() {
+				It("should deploy the ShootState with the secrets to persist", func() {
+					Expect(fakeClient.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "kube-system", Labels: map[string]string{secretsmanager.LabelKeyPersist: "true"}},
+						Data:       map[string][]byte{"foo": []byte("bar")},
+					})).To(Succeed())
+
+					Expect(b.RegisterShoot(ctx, gardenClient)).To(Succeed())
+					Expect(b.DeployShootState(ctx)).To(Succeed())
+
+					shootState := &gardencorev1beta1.ShootState{}
+					Expect(gardenClient.Get(ctx, client.ObjectKey{Name: "root", Namespace: "garden"}, shootState)).To(Succeed())
+					Expect(shootState.Spec.Gardener).To(ConsistOf(And(
+						HaveField("Name", "ca"),
+						HaveField("Type", "secret"),
+					)))
+				})
+
+				It("should also deploy the state handed over by 'gardenadm bootstrap'", func() {
+					Expect(fakeClient.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "kube-system", Labels: map[string]string{secretsmanager.LabelKeyPersist: "true"}},
+						Data:       map[string][]byte{"foo": []byte("bar")},
+					})).To(Succeed())
+
+					b.Shoot.SetShootState(&gardencorev1beta1.ShootState{Spec: gardencorev1beta1.ShootStateSpec{
+						Gardener:   []gardencorev1beta1.GardenerResourceData{{Name: "ca", Type: "secret", Data: runtime.RawExtension{Raw: []byte(`{"foo":"b2xk"}`)}}},
+						Extensions: []gardencorev1beta1.ExtensionResourceState{{Kind: "Worker", Name: ptr.To("root"), State: &runtime.RawExtension{Raw: []byte(`{"machines":"state"}`)}}},
+					}})
+					Expect(b.PersistShootState(ctx)).To(Succeed())
+					Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "gardenadm-bootstrap-shootstate", Namespace: "kube-system"}, &corev1.Secret{})).To(Succeed())
+
+					Expect(b.RegisterShoot(ctx, gardenClient)).To(Succeed())
+					Expect(b.DeployShootState(ctx)).To(Succeed())
+
+					shootState := &gardencorev1beta1.ShootState{}
+					Expect(gardenClient.Get(ctx, client.ObjectKey{Name: "root", Namespace: "garden"}, shootState)).To(Succeed())
+					Expect(shootState.Spec.Gardener).To(ConsistOf(And(
+						HaveField("Name", "ca"),
+						HaveField("Data.Raw", Not(ContainSubstring("b2xk"))),
+					)))
+					Expect(shootState.Spec.Extensions).To(ConsistOf(And(
+						HaveField("Kind", "Worker"),
+						HaveField("State.Raw", MatchJSON(`{"machines":"state"}`)),
+					)))
+				})
+			})
+		})
+
+		Describe("#ReconcileClusterInfo", func() {
+			It("should create the cluster-info ConfigMap and grant access to it", func() {
+				Expect(b.InitializeSecretsManagement(ctx)).To(Succeed())
+				Expect(b.ReconcileClusterInfo(ctx)).To(Succeed())
+
+				configMap := &corev1.ConfigMap{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "cluster-info", Namespace: "kube-public"}, configMap)).To(Succeed())
+				Expect(configMap.Data["kubeconfig"]).To(And(
+					ContainSubstring("server: https://api.root.garden.internal.gardenadm.local"),
+					ContainSubstring("certificate-authority-data:"),
+					Not(ContainSubstring("token:")),
+				))
+
+				roleBinding := &rbacv1.RoleBinding{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "gardenadm:bootstrap-signer-clusterinfo", Namespace: "kube-public"}, roleBinding)).To(Succeed())
+				Expect(roleBinding.Subjects).To(ConsistOf(
+					HaveField("Name", "system:anonymous"),
+					HaveField("Name", "system:bootstrappers"),
+				))
+			})
+		})
+
+		Describe("#WriteNodeAgentBootstrapToken", func() {
+			It("should write the bootstrap token and the machine name", func() {
+				Expect(b.WriteNodeAgentBootstrapToken(ctx)).To(Succeed())
+
+				token, err := b.FS.ReadFile(nodeagentconfigv1alpha1.BootstrapTokenFilePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(token)).To(MatchRegexp(`^[a-z0-9]{6}\.[a-z0-9]{16}$`))
+
+				machineName, err := b.FS.ReadFile(nodeagentconfigv1alpha1.MachineNameFilePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(machineName)).To(Equal("machine-0"))
+
+				secret := &corev1.Secret{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "bootstrap-token-" + string(token)[:6], Namespace: "kube-system"}, secret)).To(Succeed())
+			})
+		})
+
+		Describe("#DeployExtensions", func() {
+			It("should fail if a required extension is not registered", func() {
+				Expect(b.DeployExtensions(ctx)).To(MatchError(ContainSubstring("did not find ControllerRegistrations for the required extensions")))
+			})
+
+			It("should fail if the ControllerDeployment is missing", func() {
+				resources.ControllerRegistrations = []*gardencorev1beta1.ControllerRegistration{providerLocalRegistration()}
+
+				var err error
+				b, err = NewAutonomousBotanist(ctx, logr.Discard(), nil, resources)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(b.DeployExtensions(ctx)).To(MatchError("failed deploying extension provider-local: did not find ControllerDeployment provider-local"))
+			})
+
+			It("should fail if the ControllerDeployment is not of type helm", func() {
+				resources.ControllerRegistrations = []*gardencorev1beta1.ControllerRegistration{providerLocalRegistration()}
+				resources.ControllerDeployments = []*gardencorev1.ControllerDeployment{{ObjectMeta: metav1.ObjectMeta{Name: "provider-local"}}}
+
+				var err error
+				b, err = NewAutonomousBotanist(ctx, logr.Discard(), nil, resources)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(b.DeployExtensions(ctx)).To(MatchError("failed deploying extension provider-local: ControllerDeployment provider-local is not of type helm"))
+			})
+		})
+	})
+})
+
+var _ = Describe("#EnsureHostsEntry", func() {
+	It("should add the hosts entry and replace existing ones", func() {
+		fs := afero.Afero{Fs: afero.NewMemMapFs()}
+		Expect(fs.WriteFile(PathHostsFile, []byte("127.0.0.1 localhost\n# 10.0.0.1 api.example.com\n10.0.0.1 api.example.com\n"), 0644)).To(Succeed())
+
+		Expect(EnsureHostsEntry(fs, "10.0.0.2", "api.example.com")).To(Succeed())
+
+		content, err := fs.ReadFile(PathHostsFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("127.0.0.1 localhost\n# 10.0.0.1 api.example.com\n10.0.0.2 api.example.com\n"))
+	})
+
+	It("should create the hosts file if it does not exist", func() {
+		fs := afero.Afero{Fs: afero.NewMemMapFs()}
+
+		Expect(EnsureHostsEntry(fs, "10.0.0.2", "api.example.com")).To(Succeed())
+
+		content, err := fs.ReadFile(PathHostsFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("10.0.0.2 api.example.com\n"))
+	})
+})
+
+func providerLocalRegistration() *gardencorev1beta1.ControllerRegistration {
+	var resources []gardencorev1beta1.ControllerResource
+	for _, kind := range []string{extensionsv1alpha1.ControlPlaneResource, extensionsv1alpha1.InfrastructureResource, extensionsv1alpha1.OperatingSystemConfigResource, extensionsv1alpha1.WorkerResource} {
+		resources = append(resources, gardencorev1beta1.ControllerResource{Kind: kind, Type: "local"})
+	}
+	// The networking extension is not relevant for the tests, hence it is part of the same registration for simplicity.
+	resources = append(resources, gardencorev1beta1.ControllerResource{Kind: extensionsv1alpha1.NetworkResource, Type: "calico"})
+
+	return &gardencorev1beta1.ControllerRegistration{
+		ObjectMeta: metav1.ObjectMeta{Name: "provider-local"},
+		Spec: gardencorev1beta1.ControllerRegistrationSpec{
+			Resources: resources,
+			Deployment: &gardencorev1beta1.ControllerRegistrationDeployment{
+				DeploymentRefs: []gardencorev1beta1.DeploymentRef{{Name: "provider-local"}},
+			},
+		},
+	}
+}
@@ -0,0 +1,294 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	nodeagentpkg "github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// ManagedResourceNameGardenerNodeAgent is the name of the ManagedResource containing the resources for
+	// gardener-node-agent.
+	ManagedResourceNameGardenerNodeAgent = "shoot-gardener-node-agent"
+
+	// ConfigMapNameWorkerPools is the name of the ConfigMap in the kube-system namespace which maps the names of the
+	// worker pools to the names of their OperatingSystemConfig secrets.
+	ConfigMapNameWorkerPools = "gardenadm-worker-pools"
+	// DataKeyControlPlaneWorkerPool is the key in the worker pools ConfigMap which holds the name of the control plane
+	// worker pool. It cannot clash with the name of a worker pool since those must not contain uppercase characters.
+	DataKeyControlPlaneWorkerPool = "controlPlaneWorkerPool"
+
+	// roleNameClusterInfo is the name of the Role and RoleBinding which allow reading the cluster-info ConfigMap.
+	roleNameClusterInfo = "gardenadm:bootstrap-signer-clusterinfo"
+	// roleNameWorkerPools is the name of the Role and RoleBinding which allow reading the worker pools ConfigMap.
+	roleNameWorkerPools = "gardenadm:bootstrap-worker-pools"
+	// bootstrapTokenValidityNodeAgent is the validity of the bootstrap token used by gardener-node-agent of the first
+	// control plane node. It only needs to be valid until gardener-node-agent fetched its access token.
+	bootstrapTokenValidityNodeAgent = 6 * time.Hour
+)
+
+// MigrateSecrets copies the secrets managed by the secrets manager from the given source client (i.e., the fake client
+// used for bootstrapping the control plane) to the cluster. This way, the secrets manager picks up the existing CAs and
+// certificates instead of generating new ones which would not match those used by the static pods.
+func (b *AutonomousBotanist) MigrateSecrets(ctx context.Context, source client.Reader) error {
+	secretList := &corev1.SecretList{}
+	if err := source.List(ctx, secretList, client.InNamespace(b.Shoot.SeedNamespace), client.MatchingLabels{secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager}); err != nil {
+		return fmt.Errorf("failed listing secrets: %w", err)
+	}
+
+	for _, item := range secretList.Items {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        item.Name,
+				Namespace:   item.Namespace,
+				Labels:      item.Labels,
+				Annotations: item.Annotations,
+			},
+			Type:      item.Type,
+			Data:      item.Data,
+			Immutable: item.Immutable,
+		}
+
+		if err := b.SeedClientSet.Client().Create(ctx, secret); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed creating secret %s: %w", client.ObjectKeyFromObject(secret), err)
+		}
+	}
+
+	return nil
+}
+
+// DeployNodeAgentResources deploys the given OperatingSystemConfig secrets (see ComputeOperatingSystemConfig and
+// ComputeWorkerOperatingSystemConfigs) together with the RBAC resources for gardener-node-agent via a ManagedResource.
+// It also deploys the ConfigMap which maps the worker pools to their OperatingSystemConfig secrets, so that
+// `gardenadm join` can find the secret for the pool of the joining node. Finally, it creates the shoot access secret
+// whose token is used by gardener-node-agent after it has been bootstrapped.
+func (b *AutonomousBotanist) DeployNodeAgentResources(ctx context.Context, controlPlaneOSCSecret *corev1.Secret, workerOSCSecrets []*corev1.Secret) error {
+	var (
+		oscSecrets  = append([]*corev1.Secret{controlPlaneOSCSecret}, workerOSCSecrets...)
+		secretNames = make([]string, 0, len(oscSecrets))
+		workerPools = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapNameWorkerPools, Namespace: metav1.NamespaceSystem},
+			Data:       map[string]string{DataKeyControlPlaneWorkerPool: controlPlaneOSCSecret.Labels[v1beta1constants.LabelWorkerPool]},
+		}
+		objects = []client.Object{workerPools}
+	)
+
+	for _, secret := range oscSecrets {
+		secretNames = append(secretNames, secret.Name)
+		workerPools.Data[secret.Labels[v1beta1constants.LabelWorkerPool]] = secret.Name
+		objects = append(objects, secret)
+	}
+
+	objects = append(objects, workerPoolsRBACResources()...)
+
+	data, err := managedresources.
+		NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer).
+		AddAllAndSerialize(objects...)
+	if err != nil {
+		return fmt.Errorf("failed serializing resources for gardener-node-agent: %w", err)
+	}
+
+	rbacData, err := nodeagent.RBACResourcesData(secretNames)
+	if err != nil {
+		return fmt.Errorf("failed computing RBAC resources for gardener-node-agent: %w", err)
+	}
+
+	// Both data maps contain the compressed objects under the same key, hence they are stored in separate secrets
+	// (similar to the ManagedResource for gardener-node-agent deployed by gardenlet).
+	managedResource := managedresources.NewForShoot(b.SeedClientSet.Client(), b.Shoot.SeedNamespace, ManagedResourceNameGardenerNodeAgent, managedresources.LabelValueGardener, false)
+	for _, managedResourceSecret := range []struct {
+		name string
+		data map[string][]byte
+	}{
+		{ManagedResourceNameGardenerNodeAgent, data},
+		{ManagedResourceNameGardenerNodeAgent + "-rbac", rbacData},
+	} {
+		secretName, secret := managedresources.NewSecret(b.SeedClientSet.Client(), b.Shoot.SeedNamespace, managedResourceSecret.name, managedResourceSecret.data, true)
+		if err := secret.Reconcile(ctx); err != nil {
+			return fmt.Errorf("failed reconciling ManagedResource secret %s: %w", secretName, err)
+		}
+		managedResource.WithSecretRef(secretName)
+	}
+
+	if err := managedResource.Reconcile(ctx); err != nil {
+		return fmt.Errorf("failed reconciling ManagedResource for gardener-node-agent: %w", err)
+	}
+
+	return gardenerutils.NewShootAccessSecret(nodeagentconfigv1alpha1.AccessSecretName, b.Shoot.SeedNamespace).
+		WithTargetSecret(nodeagentconfigv1alpha1.AccessSecretName, metav1.NamespaceSystem).
+		Reconcile(ctx, b.SeedClientSet.Client())
+}
+
+// workerPoolsRBACResources returns the Role and RoleBinding which allow reading the worker pools ConfigMap with
+// bootstrap tokens.
+func workerPoolsRBACResources() []client.Object {
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: roleNameWorkerPools, Namespace: metav1.NamespaceSystem},
+		Rules: []rbacv1.PolicyRule{{
+			APIGroups:     []string{""},
+			Resources:     []string{"configmaps"},
+			ResourceNames: []string{ConfigMapNameWorkerPools},
+			Verbs:         []string{"get"},
+		}},
+	}
+
+	return []client.Object{
+		role,
+		&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: roleNameWorkerPools, Namespace: metav1.NamespaceSystem},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "Role",
+				Name:     role.Name,
+			},
+			Subjects: []rbacv1.Subject{{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: bootstraptokenapi.BootstrapDefaultGroup}},
+		},
+	}
+}
+
+// ReconcileClusterInfo creates the 'cluster-info' ConfigMap in the 'kube-public' namespace. It contains a kubeconfig
+// with the address and the CA bundle of the API server which is used by `gardenadm join` for discovering the cluster.
+// The ConfigMap can be read anonymously and with bootstrap tokens. Its authenticity is verified with the hash of the CA
+// certificate, hence it must not contain any confidential data.
+func (b *AutonomousBotanist) ReconcileClusterInfo(ctx context.Context) error {
+	caBundleSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameCACluster)
+	if !found {
+		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCACluster)
+	}
+
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig(
+		b.Shoot.GetInfo().Name,
+		clientcmdv1.Cluster{
+			Server:                   b.Shoot.ComputeOutOfClusterAPIServerAddress(true),
+			CertificateAuthorityData: caBundleSecret.Data[secretsutils.DataKeyCertificateBundle],
+		},
+		clientcmdv1.AuthInfo{},
+	))
+	if err != nil {
+		return fmt.Errorf("failed encoding cluster-info kubeconfig: %w", err)
+	}
+
+	var (
+		c = b.SeedClientSet.Client()
+
+		configMap   = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: bootstraptokenapi.ConfigMapClusterInfo, Namespace: metav1.NamespacePublic}}
+		role        = &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: roleNameClusterInfo, Namespace: metav1.NamespacePublic}}
+		roleBinding = &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: roleNameClusterInfo, Namespace: metav1.NamespacePublic}}
+	)
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, configMap, func() error {
+		configMap.Data = map[string]string{bootstraptokenapi.KubeConfigKey: string(kubeconfig)}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reconciling ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, role, func() error {
+		role.Rules = []rbacv1.PolicyRule{{
+			APIGroups:     []string{""},
+			Resources:     []string{"configmaps"},
+			ResourceNames: []string{bootstraptokenapi.ConfigMapClusterInfo},
+			Verbs:         []string{"get"},
+		}}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reconciling Role %s: %w", client.ObjectKeyFromObject(role), err)
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, roleBinding, func() error {
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     role.Name,
+		}
+		roleBinding.Subjects = []rbacv1.Subject{
+			{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: user.Anonymous},
+			{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: bootstraptokenapi.BootstrapDefaultGroup},
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reconciling RoleBinding %s: %w", client.ObjectKeyFromObject(roleBinding), err)
+	}
+
+	return nil
+}
+
+// WriteNodeAgentBootstrapToken creates a bootstrap token for gardener-node-agent and writes it to the node together with
+// the machine name. gardener-node-agent uses it for fetching its access token.
+func (b *AutonomousBotanist) WriteNodeAgentBootstrapToken(ctx context.Context) error {
+	tokenID, err := utils.GenerateRandomStringFromCharset(6, "0123456789abcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		return fmt.Errorf("failed generating bootstrap token ID: %w", err)
+	}
+
+	secret, err := bootstraptoken.ComputeBootstrapToken(ctx, b.SeedClientSet.Client(), tokenID, "Used by gardener-node-agent of the first control plane node", bootstrapTokenValidityNodeAgent)
+	if err != nil {
+		return fmt.Errorf("failed creating bootstrap token: %w", err)
+	}
+
+	for path, content := range map[string]string{
+		nodeagentconfigv1alpha1.BootstrapTokenFilePath: bootstraptoken.FromSecretData(secret.Data),
+		nodeagentconfigv1alpha1.MachineNameFilePath:    b.HostName,
+	} {
+		if err := b.FS.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return fmt.Errorf("failed creating directory for %s: %w", path, err)
+		}
+
+		if err := b.FS.WriteFile(path, []byte(content), 0600); err != nil {
+			return fmt.Errorf("failed writing %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// WaitUntilNodeRegistered waits until kubelet registered the Node object of this machine and returns it.
+func (b *AutonomousBotanist) WaitUntilNodeRegistered(ctx context.Context) (*corev1.Node, error) {
+	var node *corev1.Node
+
+	if err := retryutils.UntilTimeout(ctx, 5*time.Second, 5*time.Minute, func(ctx context.Context) (bool, error) {
+		var err error
+		if node, err = nodeagentpkg.FetchNodeByHostName(ctx, b.SeedClientSet.Client(), b.HostName); err != nil {
+			return retryutils.SevereError(err)
+		}
+		if node == nil {
+			return retryutils.MinorError(fmt.Errorf("node with host name %q is not yet registered", b.HostName))
+		}
+		return retryutils.Ok()
+	}); err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
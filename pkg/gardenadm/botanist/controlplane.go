@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/gardener/resourcemanager"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// SecretNameKubeconfigKubeControllerManager is the name of the secret containing the kubeconfig used by
+	// kube-controller-manager.
+	SecretNameKubeconfigKubeControllerManager = "kubeconfig-kube-controller-manager" // #nosec G101 -- No credential.
+	// SecretNameKubeconfigKubeScheduler is the name of the secret containing the kubeconfig used by kube-scheduler.
+	SecretNameKubeconfigKubeScheduler = "kubeconfig-kube-scheduler" // #nosec G101 -- No credential.
+	// SecretNameKubeconfigGardenerResourceManager is the name of the secret containing the kubeconfig used by
+	// gardener-resource-manager.
+	SecretNameKubeconfigGardenerResourceManager = "kubeconfig-gardener-resource-manager" // #nosec G101 -- No credential.
+	// SecretNameKubeconfigKubelet is the name of the secret containing the kubeconfig used by the kubelet of the first
+	// control plane node.
+	SecretNameKubeconfigKubelet = "kubeconfig-kubelet" // #nosec G101 -- No credential.
+	// SecretNameKubeconfigAdmin is the name of the secret containing the kubeconfig for the cluster administrator.
+	SecretNameKubeconfigAdmin = "kubeconfig-admin" // #nosec G101 -- No credential.
+
+	// volumeNameKubeconfig is the name of the volume which contains the generic kubeconfig of the control plane
+	// components, see gardenerutils.InjectGenericKubeconfig.
+	volumeNameKubeconfig = "kubeconfig"
+)
+
+// DeployControlPlane renders the control plane components (etcd, kube-apiserver, kube-controller-manager,
+// kube-scheduler, and gardener-resource-manager) with the existing component deployers and returns the files needed to
+// run them as static pods on the node. The second list contains the files with the content of secrets (e.g., the
+// private keys of the certificate authorities). They must not be added to the OperatingSystemConfig since it is
+// readable with bootstrap tokens, see WriteFiles and UploadControlPlaneSecretFiles. It must be called with a botanist
+// based on a fake client set (see NewAutonomousBotanist) since the API server is not running yet.
+func (b *AutonomousBotanist) DeployControlPlane(ctx context.Context) ([]extensionsv1alpha1.File, []extensionsv1alpha1.File, error) {
+	// The etcd component generates the server and client certificates which are used by the bootstrap etcd and by
+	// kube-apiserver.
+	if err := b.DeployEtcd(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed deploying etcd: %w", err)
+	}
+
+	if err := b.DeployKubeAPIServer(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed deploying kube-apiserver: %w", err)
+	}
+
+	if err := b.DeployKubeControllerManager(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed deploying kube-controller-manager: %w", err)
+	}
+
+	if err := b.Shoot.Components.ControlPlane.KubeScheduler.Deploy(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed deploying kube-scheduler: %w", err)
+	}
+
+	if err := b.useClientCertificateKubeconfigs(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	if err := b.deployGardenerResourceManager(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed deploying gardener-resource-manager: %w", err)
+	}
+
+	files, secretFiles, err := b.etcdStaticPodFiles(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, deployment := range []struct {
+		name      string
+		mutateFns []func(*corev1.Pod)
+	}{
+		{name: v1beta1constants.DeploymentNameKubeAPIServer, mutateFns: []func(*corev1.Pod){mutateKubeAPIServerPod}},
+		{name: v1beta1constants.DeploymentNameKubeControllerManager},
+		{name: v1beta1constants.DeploymentNameKubeScheduler},
+		{name: v1beta1constants.DeploymentNameGardenerResourceManager, mutateFns: []func(*corev1.Pod){mutateGardenerResourceManagerPod}},
+	} {
+		obj := &appsv1.Deployment{}
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: deployment.name, Namespace: b.Shoot.SeedNamespace}, obj); err != nil {
+			return nil, nil, fmt.Errorf("failed reading deployment %s: %w", deployment.name, err)
+		}
+
+		deploymentFiles, deploymentSecretFiles, err := staticpod.Translate(ctx, b.SeedClientSet.Client(), obj, deployment.mutateFns...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed translating deployment %s to static pod: %w", deployment.name, err)
+		}
+		files = append(files, deploymentFiles...)
+		secretFiles = append(secretFiles, deploymentSecretFiles...)
+	}
+
+	return files, secretFiles, nil
+}
+
+// ReconcileKubeconfigSecret generates a secret containing a kubeconfig with a client certificate for the given user
+// which is signed by the client CA. The kubeconfig points to the internal domain of the API server.
+func (b *AutonomousBotanist) ReconcileKubeconfigSecret(ctx context.Context, name, userName string, groups ...string) (*corev1.Secret, error) {
+	caBundleSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameCACluster)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCACluster)
+	}
+
+	return b.SecretsManager.Generate(ctx, &secretsutils.ControlPlaneSecretConfig{
+		Name: name,
+		CertificateSecretConfig: &secretsutils.CertificateSecretConfig{
+			CommonName:                  userName,
+			Organization:                groups,
+			CertType:                    secretsutils.ClientCert,
+			SkipPublishingCACertificate: true,
+		},
+		KubeConfigRequests: []secretsutils.KubeConfigRequest{{
+			ClusterName:   b.Shoot.GetInfo().Name,
+			APIServerHost: b.Shoot.ComputeOutOfClusterAPIServerAddress(true),
+			CAData:        caBundleSecret.Data[secretsutils.DataKeyCertificateBundle],
+		}},
+	}, secretsmanager.SignedByCA(v1beta1constants.SecretNameCAClient), secretsmanager.Rotate(secretsmanager.InPlace))
+}
+
+func (b *AutonomousBotanist) deployGardenerResourceManager(ctx context.Context) error {
+	kubeconfigSecret, err := b.ReconcileKubeconfigSecret(ctx, SecretNameKubeconfigGardenerResourceManager, "gardener.cloud:system:gardener-resource-manager", user.SystemPrivilegedGroup)
+	if err != nil {
+		return err
+	}
+
+	// The bootstrap kubeconfig is mounted to the generic kubeconfig path and is used for accessing the target cluster.
+	// Since the static pod does not have a service account, the source cluster is accessed with the same kubeconfig,
+	// see mutateGardenerResourceManagerPod.
+	b.Shoot.Components.ControlPlane.ResourceManager.SetSecrets(resourcemanager.Secrets{
+		BootstrapKubeconfig: &component.Secret{Name: kubeconfigSecret.Name},
+	})
+
+	return b.Shoot.Components.ControlPlane.ResourceManager.Deploy(ctx)
+}
+
+// useClientCertificateKubeconfigs replaces the generic token kubeconfigs of kube-controller-manager and kube-scheduler
+// with kubeconfigs containing client certificates. Static pods cannot use service account tokens, hence they must
+// authenticate with client certificates.
+func (b *AutonomousBotanist) useClientCertificateKubeconfigs(ctx context.Context) error {
+	for _, c := range []struct {
+		deploymentName, secretName, userName string
+	}{
+		{v1beta1constants.DeploymentNameKubeControllerManager, SecretNameKubeconfigKubeControllerManager, user.KubeControllerManager},
+		{v1beta1constants.DeploymentNameKubeScheduler, SecretNameKubeconfigKubeScheduler, user.KubeScheduler},
+	} {
+		kubeconfigSecret, err := b.ReconcileKubeconfigSecret(ctx, c.secretName, c.userName)
+		if err != nil {
+			return err
+		}
+
+		deployment := &appsv1.Deployment{}
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: c.deploymentName, Namespace: b.Shoot.SeedNamespace}, deployment); err != nil {
+			return fmt.Errorf("failed reading deployment %s: %w", c.deploymentName, err)
+		}
+
+		patch := client.MergeFrom(deployment.DeepCopy())
+		kubernetesutils.AddVolume(&deployment.Spec.Template.Spec, corev1.Volume{
+			Name: volumeNameKubeconfig,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: kubeconfigSecret.Name},
+			},
+		}, true)
+		if err := b.SeedClientSet.Client().Patch(ctx, deployment, patch); err != nil {
+			return fmt.Errorf("failed patching deployment %s: %w", c.deploymentName, err)
+		}
+	}
+
+	return nil
+}
+
+// mutateKubeAPIServerPod adapts the kube-apiserver pod to the bootstrap etcd running on the same node. There is only one
+// etcd for both main and events data, and there is no VPN connection to the nodes which could require an egress
+// selector.
+func mutateKubeAPIServerPod(pod *corev1.Pod) {
+	pod.Spec.HostAliases = append(pod.Spec.HostAliases, corev1.HostAlias{
+		IP:        "127.0.0.1",
+		Hostnames: []string{etcdServiceName},
+	})
+
+	for i, container := range pod.Spec.Containers {
+		if container.Name != v1beta1constants.DeploymentNameKubeAPIServer {
+			continue
+		}
+
+		pod.Spec.Containers[i].Args = slices.DeleteFunc(container.Args, func(arg string) bool {
+			return strings.HasPrefix(arg, "--etcd-servers-overrides=") || strings.HasPrefix(arg, "--egress-selector-config-file=")
+		})
+	}
+}
+
+// mutateGardenerResourceManagerPod makes gardener-resource-manager use the mounted kubeconfig for the source cluster as
+// well, since there is no service account token available for static pods.
+func mutateGardenerResourceManagerPod(pod *corev1.Pod) {
+	for i := range pod.Spec.Containers {
+		kubernetesutils.AddEnvVar(&pod.Spec.Containers[i], corev1.EnvVar{Name: "KUBECONFIG", Value: gardenerutils.PathGenericKubeconfig}, true)
+	}
+}
@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	iofs "io/fs"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	oscutils "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/utils"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// SecretNameControlPlaneSecretFiles is the name of the secret in the kube-system namespace which contains the
+	// encrypted secret files of the control plane static pods (see DeployControlPlane). Joining control plane nodes
+	// decrypt them with the certificate key printed by `gardenadm init`.
+	SecretNameControlPlaneSecretFiles = "gardenadm-control-plane-secret-files" // #nosec G101 -- No credential.
+	// DataKeyControlPlaneSecretFiles is the key in the data of the control plane secret files secret which contains the
+	// encrypted files.
+	DataKeyControlPlaneSecretFiles = "files"
+
+	// roleNameControlPlaneSecretFiles is the name of the Role and RoleBinding which allow reading the control plane
+	// secret files secret.
+	roleNameControlPlaneSecretFiles = "gardenadm:bootstrap-control-plane-secret-files"
+	// certificateKeySize is the size of the AES-256 key which is used for encrypting the control plane secret files.
+	certificateKeySize = 32
+)
+
+// GenerateCertificateKey generates a random key for encrypting the control plane secret files. The key is
+// hex-encoded, so that it can be passed on the command line.
+func GenerateCertificateKey() (string, error) {
+	key := make([]byte, certificateKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("failed generating certificate key: %w", err)
+	}
+
+	return hex.EncodeToString(key), nil
+}
+
+// EncryptFiles encrypts the given files with AES-GCM using the given hex-encoded certificate key.
+func EncryptFiles(files []extensionsv1alpha1.File, certificateKey string) ([]byte, error) {
+	aead, err := newAEAD(certificateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := json.Marshal(files)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling files: %w", err)
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed generating nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// DecryptFiles decrypts the given files which were encrypted with EncryptFiles.
+func DecryptFiles(ciphertext []byte, certificateKey string) ([]extensionsv1alpha1.File, error) {
+	aead, err := newAEAD(certificateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+
+	plaintext, err := aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed decrypting files (is the certificate key correct?): %w", err)
+	}
+
+	var files []extensionsv1alpha1.File
+	if err := json.Unmarshal(plaintext, &files); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling files: %w", err)
+	}
+
+	return files, nil
+}
+
+func newAEAD(certificateKey string) (cipher.AEAD, error) {
+	key, err := hex.DecodeString(certificateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding certificate key: %w", err)
+	}
+
+	if len(key) != certificateKeySize {
+		return nil, fmt.Errorf("certificate key must be %d bytes long, but is %d bytes long", certificateKeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// WriteFiles writes the given files with inline content to the node. It is used for the secret files of the control
+// plane static pods which are not part of the OperatingSystemConfig, hence they are not managed by
+// gardener-node-agent.
+func WriteFiles(fs afero.Afero, files []extensionsv1alpha1.File) error {
+	for _, file := range files {
+		if file.Content.Inline == nil {
+			return fmt.Errorf("file %s has no inline content", file.Path)
+		}
+
+		content, err := oscutils.NewFileContentInlineCodec().Decode(file.Content.Inline)
+		if err != nil {
+			return fmt.Errorf("failed decoding content of file %s: %w", file.Path, err)
+		}
+
+		if err := fs.MkdirAll(filepath.Dir(file.Path), 0700); err != nil {
+			return fmt.Errorf("failed creating directory for %s: %w", file.Path, err)
+		}
+
+		if err := fs.WriteFile(file.Path, content, iofs.FileMode(ptr.Deref(file.Permissions, 0600))); err != nil {
+			return fmt.Errorf("failed writing %s: %w", file.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// UploadControlPlaneSecretFiles stores the given secret files of the control plane static pods (see
+// DeployControlPlane) encrypted with the given certificate key in the cluster. Joining control plane nodes fetch them
+// with their bootstrap token, but can only decrypt them with the certificate key. This way, the private keys of the
+// certificate authorities are never exposed to holders of a bootstrap token only (similar to `kubeadm init
+// --upload-certs`).
+func (b *AutonomousBotanist) UploadControlPlaneSecretFiles(ctx context.Context, files []extensionsv1alpha1.File, certificateKey string) error {
+	ciphertext, err := EncryptFiles(files, certificateKey)
+	if err != nil {
+		return fmt.Errorf("failed encrypting control plane secret files: %w", err)
+	}
+
+	var (
+		c = b.SeedClientSet.Client()
+
+		secret      = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: SecretNameControlPlaneSecretFiles, Namespace: metav1.NamespaceSystem}}
+		role        = &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: roleNameControlPlaneSecretFiles, Namespace: metav1.NamespaceSystem}}
+		roleBinding = &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: roleNameControlPlaneSecretFiles, Namespace: metav1.NamespaceSystem}}
+	)
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{DataKeyControlPlaneSecretFiles: ciphertext}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reconciling secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, role, func() error {
+		role.Rules = []rbacv1.PolicyRule{{
+			APIGroups:     []string{""},
+			Resources:     []string{"secrets"},
+			ResourceNames: []string{SecretNameControlPlaneSecretFiles},
+			Verbs:         []string{"get"},
+		}}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reconciling Role %s: %w", client.ObjectKeyFromObject(role), err)
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, roleBinding, func() error {
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     role.Name,
+		}
+		roleBinding.Subjects = []rbacv1.Subject{{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: bootstraptokenapi.BootstrapDefaultGroup}}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reconciling RoleBinding %s: %w", client.ObjectKeyFromObject(roleBinding), err)
+	}
+
+	return nil
+}
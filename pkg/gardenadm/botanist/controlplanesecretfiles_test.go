@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"k8s.io/utils/ptr"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+var _ = Describe("ControlPlaneSecretFiles", func() {
+	var (
+		certificateKey string
+		files          []extensionsv1alpha1.File
+	)
+
+	BeforeEach(func() {
+		var err error
+		certificateKey, err = GenerateCertificateKey()
+		Expect(err).NotTo(HaveOccurred())
+
+		files = []extensionsv1alpha1.File{{
+			Path:        "/var/lib/etcd-main/peer-ca/ca.key",
+			Permissions: ptr.To[uint32](0600),
+			Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64([]byte("private-key"))}},
+		}}
+	})
+
+	Describe("#GenerateCertificateKey", func() {
+		It("should generate a random hex-encoded 32 bytes key", func() {
+			Expect(certificateKey).To(MatchRegexp("^[0-9a-f]{64}$"))
+
+			otherCertificateKey, err := GenerateCertificateKey()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otherCertificateKey).NotTo(Equal(certificateKey))
+		})
+	})
+
+	Describe("#EncryptFiles, #DecryptFiles", func() {
+		It("should encrypt and decrypt the files", func() {
+			ciphertext, err := EncryptFiles(files, certificateKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(ciphertext)).NotTo(ContainSubstring(files[0].Content.Inline.Data))
+
+			Expect(DecryptFiles(ciphertext, certificateKey)).To(Equal(files))
+		})
+
+		It("should fail decrypting with a different key", func() {
+			ciphertext, err := EncryptFiles(files, certificateKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherCertificateKey, err := GenerateCertificateKey()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = DecryptFiles(ciphertext, otherCertificateKey)
+			Expect(err).To(MatchError(ContainSubstring("is the certificate key correct?")))
+		})
+
+		It("should fail for an invalid key", func() {
+			_, err := EncryptFiles(files, "foo")
+			Expect(err).To(MatchError(ContainSubstring("failed decoding certificate key")))
+
+			_, err = EncryptFiles(files, "abcd")
+			Expect(err).To(MatchError(ContainSubstring("certificate key must be 32 bytes long")))
+		})
+
+		It("should fail for a too short ciphertext", func() {
+			_, err := DecryptFiles([]byte("foo"), certificateKey)
+			Expect(err).To(MatchError(ContainSubstring("ciphertext is too short")))
+		})
+	})
+
+	Describe("#WriteFiles", func() {
+		It("should write the files with their permissions", func() {
+			fs := afero.Afero{Fs: afero.NewMemMapFs()}
+			Expect(WriteFiles(fs, files)).To(Succeed())
+
+			content, err := fs.ReadFile(files[0].Path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("private-key"))
+
+			info, err := fs.Stat(files[0].Path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(BeEquivalentTo(0600))
+		})
+
+		It("should fail for files without inline content", func() {
+			Expect(WriteFiles(afero.Afero{Fs: afero.NewMemMapFs()}, []extensionsv1alpha1.File{{Path: "/foo"}})).To(MatchError(ContainSubstring("has no inline content")))
+		})
+	})
+})
@@ -0,0 +1,353 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/imagevector"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// etcdServiceName is the name of the etcd-main client service which kube-apiserver uses for connecting to etcd. It is
+// resolved to the bootstrap etcd running on the same node.
+var etcdServiceName = etcdconstants.ServiceName(v1beta1constants.ETCDRoleMain)
+
+const (
+	etcdPortClient  = 2379
+	etcdPortPeer    = 2380
+	etcdPortMetrics = 2381
+
+	etcdVolumeNameCA     = "ca"
+	etcdVolumeNameServer = "server"
+	etcdVolumeNamePeerCA = "peer-ca"
+	etcdVolumeNamePeer   = "peer"
+	etcdVolumeNameConfig = "config"
+	etcdVolumeNameData   = "data"
+
+	etcdVolumeMountPathCA     = "/var/etcd/ssl/ca"
+	etcdVolumeMountPathServer = "/var/etcd/ssl/server"
+	etcdVolumeMountPathPeerCA = "/var/etcd/ssl/peer-ca"
+	etcdVolumeMountPathPeer   = "/var/etcd/ssl/peer"
+	etcdVolumeMountPathConfig = "/var/etcd/config"
+	etcdVolumeMountPathData   = "/var/etcd/data"
+
+	etcdConfigFileName = "etcd.yaml"
+)
+
+// EtcdMember is a member of the bootstrap etcd cluster running on the control plane nodes.
+type EtcdMember struct {
+	// Name is the name of the member, i.e., the host name of the control plane node.
+	Name string
+	// PeerURL is the URL on which the member is reachable for its peers.
+	PeerURL string
+}
+
+// EtcdPeerURL returns the URL on which the etcd member running on the node with the given IP address is reachable for
+// its peers.
+func EtcdPeerURL(ip string) string {
+	return "https://" + net.JoinHostPort(ip, strconv.Itoa(etcdPortPeer))
+}
+
+// EtcdClientURL returns the URL on which the etcd member running on the node with the given IP address serves clients.
+func EtcdClientURL(ip string) string {
+	return "https://" + net.JoinHostPort(ip, strconv.Itoa(etcdPortClient))
+}
+
+// etcdStaticPodFiles returns the files for running etcd as static pod on the control plane nodes. etcd-druid cannot
+// manage etcd before the cluster is running, hence a plain etcd is used for bootstrapping the control plane. It reuses
+// the server certificate generated by the etcd component (see DeployEtcd), so that kube-apiserver can connect to it in
+// the same way as to an etcd managed by etcd-druid.
+// The files are the same on all control plane nodes, hence they must not contain anything specific to a single node.
+// The node-specific configuration and the peer certificate are written separately, see WriteEtcdMemberFiles. Like for
+// staticpod.Translate, the files of the Secret volumes are returned separately.
+func (b *AutonomousBotanist) etcdStaticPodFiles(ctx context.Context) ([]extensionsv1alpha1.File, []extensionsv1alpha1.File, error) {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEtcd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameCAETCD)
+	if !found {
+		return nil, nil, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAETCD)
+	}
+
+	serverSecret, found := b.SecretsManager.Get("etcd-server-" + v1beta1constants.ETCDRoleMain)
+	if !found {
+		return nil, nil, fmt.Errorf("secret %q not found", "etcd-server-"+v1beta1constants.ETCDRoleMain)
+	}
+
+	// The peer CA (including its private key) is handed over to joining control plane nodes (see
+	// UploadControlPlaneSecretFiles), so that they can issue the peer certificate for their etcd member, see
+	// WriteEtcdMemberFiles.
+	peerCASecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameCAETCDPeer, secretsmanager.Current)
+	if !found {
+		return nil, nil, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAETCDPeer)
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1beta1constants.ETCDMain,
+			Namespace: b.Shoot.SeedNamespace,
+			Labels: map[string]string{
+				v1beta1constants.LabelApp:  "etcd",
+				v1beta1constants.LabelRole: v1beta1constants.ETCDRoleMain,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  "etcd",
+				Image: image.String(),
+				Command: []string{
+					"etcd",
+					"--config-file=" + filepath.Join(etcdVolumeMountPathConfig, etcdConfigFileName),
+				},
+				LivenessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						HTTPGet: &corev1.HTTPGetAction{
+							Host: "127.0.0.1",
+							Path: "/health",
+							Port: intstr.FromInt32(etcdPortMetrics),
+						},
+					},
+					InitialDelaySeconds: 15,
+					TimeoutSeconds:      15,
+					FailureThreshold:    8,
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: etcdVolumeNameCA, MountPath: etcdVolumeMountPathCA, ReadOnly: true},
+					{Name: etcdVolumeNameServer, MountPath: etcdVolumeMountPathServer, ReadOnly: true},
+					{Name: etcdVolumeNamePeerCA, MountPath: etcdVolumeMountPathPeerCA, ReadOnly: true},
+					{Name: etcdVolumeNamePeer, MountPath: etcdVolumeMountPathPeer, ReadOnly: true},
+					{Name: etcdVolumeNameConfig, MountPath: etcdVolumeMountPathConfig, ReadOnly: true},
+					{Name: etcdVolumeNameData, MountPath: etcdVolumeMountPathData},
+				},
+			}},
+			Volumes: []corev1.Volume{
+				{
+					Name:         etcdVolumeNameCA,
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: caSecret.Name}},
+				},
+				{
+					Name:         etcdVolumeNameServer,
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: serverSecret.Name}},
+				},
+				{
+					Name:         etcdVolumeNamePeerCA,
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: peerCASecret.Name}},
+				},
+				etcdHostPathVolume(etcdVolumeNamePeer),
+				etcdHostPathVolume(etcdVolumeNameConfig),
+				etcdHostPathVolume(etcdVolumeNameData),
+			},
+		},
+	}
+
+	return staticpod.Translate(ctx, b.SeedClientSet.Client(), pod)
+}
+
+func etcdHostPathVolume(name string) corev1.Volume {
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
+			Path: etcdHostPath(name),
+			Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+		}},
+	}
+}
+
+// etcdHostPath returns the path on the node for the given volume of the etcd static pod, see staticpod.Translate.
+func etcdHostPath(volumeName string) string {
+	return filepath.Join(staticpod.VolumesDir, v1beta1constants.ETCDMain, volumeName)
+}
+
+// WriteEtcdMemberConfig writes the configuration and the peer certificate of the etcd member running on this node for
+// bootstrapping a new etcd cluster. It must be called before applying the OperatingSystemConfig (see
+// ApplyOperatingSystemConfig) on the first control plane node.
+func (b *AutonomousBotanist) WriteEtcdMemberConfig(nodeIP string) error {
+	peerCASecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameCAETCDPeer, secretsmanager.Current)
+	if !found {
+		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAETCDPeer)
+	}
+
+	peerCA, err := secretsutils.LoadCertificate(peerCASecret.Name, peerCASecret.Data[secretsutils.DataKeyPrivateKeyCA], peerCASecret.Data[secretsutils.DataKeyCertificateCA])
+	if err != nil {
+		return fmt.Errorf("failed loading etcd peer CA: %w", err)
+	}
+
+	return WriteEtcdMemberFiles(b.FS, peerCA, b.HostName, nodeIP, []EtcdMember{{Name: b.HostName, PeerURL: EtcdPeerURL(nodeIP)}}, true)
+}
+
+// etcdConfig is the subset of the etcd configuration file used by the bootstrap etcd.
+type etcdConfig struct {
+	Name                     string                `json:"name"`
+	DataDir                  string                `json:"data-dir"`
+	ListenClientURLs         string                `json:"listen-client-urls"`
+	AdvertiseClientURLs      string                `json:"advertise-client-urls"`
+	ListenPeerURLs           string                `json:"listen-peer-urls"`
+	InitialAdvertisePeerURLs string                `json:"initial-advertise-peer-urls"`
+	InitialCluster           string                `json:"initial-cluster"`
+	InitialClusterState      string                `json:"initial-cluster-state"`
+	ListenMetricsURLs        string                `json:"listen-metrics-urls"`
+	SnapshotCount            int                   `json:"snapshot-count"`
+	ClientTransportSecurity  etcdTransportSecurity `json:"client-transport-security"`
+	PeerTransportSecurity    etcdTransportSecurity `json:"peer-transport-security"`
+}
+
+type etcdTransportSecurity struct {
+	CertFile       string `json:"cert-file"`
+	KeyFile        string `json:"key-file"`
+	ClientCertAuth bool   `json:"client-cert-auth"`
+	TrustedCAFile  string `json:"trusted-ca-file"`
+}
+
+// WriteEtcdMemberFiles writes the configuration file and the peer certificate (signed by the given peer CA) of the etcd
+// member with the given name running on this node. The member is part of the given initial cluster, which must include
+// the member itself. If newCluster is false, the member joins an existing etcd cluster.
+func WriteEtcdMemberFiles(fs afero.Afero, peerCA *secretsutils.Certificate, name, nodeIP string, initialCluster []EtcdMember, newCluster bool) error {
+	ip := net.ParseIP(nodeIP)
+	if ip == nil {
+		return fmt.Errorf("invalid IP address %q", nodeIP)
+	}
+
+	peerCertificate, err := (&secretsutils.CertificateSecretConfig{
+		Name:        "etcd-peer",
+		CommonName:  "etcd-peer",
+		DNSNames:    []string{name},
+		IPAddresses: []net.IP{ip},
+		CertType:    secretsutils.ServerClientCert,
+		SigningCA:   peerCA,
+	}).GenerateCertificate()
+	if err != nil {
+		return fmt.Errorf("failed generating etcd peer certificate: %w", err)
+	}
+
+	members := make([]string, 0, len(initialCluster))
+	for _, member := range initialCluster {
+		members = append(members, member.Name+"="+member.PeerURL)
+	}
+
+	initialClusterState := "existing"
+	if newCluster {
+		initialClusterState = "new"
+	}
+
+	config, err := yaml.Marshal(&etcdConfig{
+		Name:                     name,
+		DataDir:                  etcdVolumeMountPathData,
+		ListenClientURLs:         fmt.Sprintf("https://0.0.0.0:%d", etcdPortClient),
+		AdvertiseClientURLs:      EtcdClientURL(nodeIP),
+		ListenPeerURLs:           fmt.Sprintf("https://0.0.0.0:%d", etcdPortPeer),
+		InitialAdvertisePeerURLs: EtcdPeerURL(nodeIP),
+		InitialCluster:           strings.Join(members, ","),
+		InitialClusterState:      initialClusterState,
+		ListenMetricsURLs:        fmt.Sprintf("http://127.0.0.1:%d", etcdPortMetrics),
+		SnapshotCount:            10000,
+		ClientTransportSecurity: etcdTransportSecurity{
+			CertFile:       filepath.Join(etcdVolumeMountPathServer, secretsutils.DataKeyCertificate),
+			KeyFile:        filepath.Join(etcdVolumeMountPathServer, secretsutils.DataKeyPrivateKey),
+			ClientCertAuth: true,
+			TrustedCAFile:  filepath.Join(etcdVolumeMountPathCA, secretsutils.DataKeyCertificateBundle),
+		},
+		PeerTransportSecurity: etcdTransportSecurity{
+			CertFile:       filepath.Join(etcdVolumeMountPathPeer, secretsutils.DataKeyCertificate),
+			KeyFile:        filepath.Join(etcdVolumeMountPathPeer, secretsutils.DataKeyPrivateKey),
+			ClientCertAuth: true,
+			TrustedCAFile:  filepath.Join(etcdVolumeMountPathPeerCA, secretsutils.DataKeyCertificateCA),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed marshalling etcd configuration: %w", err)
+	}
+
+	for path, content := range map[string][]byte{
+		filepath.Join(etcdHostPath(etcdVolumeNamePeer), secretsutils.DataKeyCertificate): peerCertificate.CertificatePEM,
+		filepath.Join(etcdHostPath(etcdVolumeNamePeer), secretsutils.DataKeyPrivateKey):  peerCertificate.PrivateKeyPEM,
+		filepath.Join(etcdHostPath(etcdVolumeNameConfig), etcdConfigFileName):            config,
+	} {
+		if err := fs.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return fmt.Errorf("failed creating directory for %s: %w", path, err)
+		}
+
+		if err := fs.WriteFile(path, content, 0600); err != nil {
+			return fmt.Errorf("failed writing %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// LoadEtcdPeerCA reads the etcd peer CA from the files of the etcd static pod on this node.
+func LoadEtcdPeerCA(fs afero.Afero) (*secretsutils.Certificate, error) {
+	certificate, err := fs.ReadFile(filepath.Join(etcdHostPath(etcdVolumeNamePeerCA), secretsutils.DataKeyCertificateCA))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading etcd peer CA certificate: %w", err)
+	}
+
+	privateKey, err := fs.ReadFile(filepath.Join(etcdHostPath(etcdVolumeNamePeerCA), secretsutils.DataKeyPrivateKeyCA))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading etcd peer CA private key: %w", err)
+	}
+
+	return secretsutils.LoadCertificate(v1beta1constants.SecretNameCAETCDPeer, privateKey, certificate)
+}
+
+// EtcdClientTLSConfig returns a TLS configuration for connecting to the etcd members running on the control plane nodes
+// based on the files of the etcd static pod on this node. The server certificate of etcd is also valid for client
+// authentication.
+func EtcdClientTLSConfig(fs afero.Afero) (*tls.Config, error) {
+	certificate, err := fs.ReadFile(filepath.Join(etcdHostPath(etcdVolumeNameServer), secretsutils.DataKeyCertificate))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading etcd client certificate: %w", err)
+	}
+
+	privateKey, err := fs.ReadFile(filepath.Join(etcdHostPath(etcdVolumeNameServer), secretsutils.DataKeyPrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading etcd client private key: %w", err)
+	}
+
+	caBundle, err := fs.ReadFile(filepath.Join(etcdHostPath(etcdVolumeNameCA), secretsutils.DataKeyCertificateBundle))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading etcd CA bundle: %w", err)
+	}
+
+	keyPair, err := tls.X509KeyPair(certificate, privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading etcd client certificate: %w", err)
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caBundle) {
+		return nil, fmt.Errorf("failed parsing etcd CA bundle")
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		RootCAs:      rootCAs,
+		// The server certificate of etcd does not contain the IP addresses of the nodes, but the name of the client
+		// service which kube-apiserver uses.
+		ServerName: etcdServiceName,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
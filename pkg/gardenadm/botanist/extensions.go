@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/component-base/featuregate"
+	"k8s.io/utils/ptr"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	extensioncrds "github.com/gardener/gardener/pkg/component/extensions/crds"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+// DeployExtensionCRDs deploys the CustomResourceDefinitions of the extension resources (e.g., Cluster, Worker,
+// OperatingSystemConfig). In autonomous shoot clusters, the extension resources of the shoot live in the cluster
+// itself, hence both the general and the shoot-specific CRDs are required.
+func (b *AutonomousBotanist) DeployExtensionCRDs(ctx context.Context) error {
+	return extensioncrds.NewCRD(b.SeedClientSet.Applier(), true, true).Deploy(ctx)
+}
+
+// DeployExtensions deploys the extensions required by the shoot. For each required extension, the chart of the
+// referenced ControllerDeployment is rendered (similar to the ControllerInstallation controller of gardenlet) and
+// deployed via a ManagedResource. The extensions run in the host network and tolerate all taints since there is no
+// pod network yet and the node is not ready before the networking extension has been deployed.
+func (b *AutonomousBotanist) DeployExtensions(ctx context.Context) error {
+	return b.forEachRequiredExtension(func(registration *gardencorev1beta1.ControllerRegistration) error {
+		namespace, secretData, err := b.renderExtension(ctx, registration)
+		if err != nil {
+			return err
+		}
+
+		if err := gardenerutils.MutateObjectsInSecretData(
+			secretData,
+			namespace,
+			[]string{appsv1.GroupName, batchv1.GroupName},
+			func(obj runtime.Object) error {
+				return kubernetesutils.VisitPodSpec(obj, func(podSpec *corev1.PodSpec) {
+					podSpec.HostNetwork = true
+					podSpec.Tolerations = append(podSpec.Tolerations, corev1.Toleration{Operator: corev1.TolerationOpExists})
+				})
+			},
+		); err != nil {
+			return fmt.Errorf("failed mutating rendered objects: %w", err)
+		}
+
+		return managedresources.CreateForShoot(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, "extension-"+registration.Name, managedresources.LabelValueGardener, false, secretData)
+	})
+}
+
+// ApplyExtensions deploys the extensions required by the shoot into the bootstrap cluster (see 'gardenadm
+// bootstrap'). In contrast to DeployExtensions, the rendered charts are applied directly since there is no
+// gardener-resource-manager running in the bootstrap cluster.
+func (b *AutonomousBotanist) ApplyExtensions(ctx context.Context) error {
+	return b.forEachRequiredExtension(func(registration *gardencorev1beta1.ControllerRegistration) error {
+		_, secretData, err := b.renderExtension(ctx, registration)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(secretData)) {
+			if err := b.SeedClientSet.Applier().ApplyManifest(ctx, kubernetes.NewManifestReader(secretData[key]), kubernetes.DefaultMergeFuncs); err != nil {
+				return fmt.Errorf("failed applying rendered manifest %s: %w", key, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (b *AutonomousBotanist) forEachRequiredExtension(fn func(*gardencorev1beta1.ControllerRegistration) error) error {
+	registrations, err := b.requiredControllerRegistrations()
+	if err != nil {
+		return err
+	}
+
+	for _, registration := range registrations {
+		if err := fn(registration); err != nil {
+			return fmt.Errorf("failed deploying extension %s: %w", registration.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (b *AutonomousBotanist) requiredControllerRegistrations() ([]*gardencorev1beta1.ControllerRegistration, error) {
+	controllerRegistrationList := &gardencorev1beta1.ControllerRegistrationList{}
+	for _, registration := range b.Resources.ControllerRegistrations {
+		controllerRegistrationList.Items = append(controllerRegistrationList.Items, *registration)
+	}
+
+	requiredExtensions := gardenerutils.ComputeRequiredExtensionsForShoot(b.Shoot.GetInfo(), b.Seed.GetInfo(), controllerRegistrationList, nil, nil)
+
+	var (
+		registrations []*gardencorev1beta1.ControllerRegistration
+		foundIDs      = sets.New[string]()
+	)
+
+	for _, registration := range b.Resources.ControllerRegistrations {
+		required := registration.Spec.Deployment != nil && ptr.Deref(registration.Spec.Deployment.Policy, gardencorev1beta1.ControllerDeploymentPolicyOnDemand) != gardencorev1beta1.ControllerDeploymentPolicyOnDemand
+
+		for _, resource := range registration.Spec.Resources {
+			if id := gardenerutils.ExtensionsID(resource.Kind, resource.Type); requiredExtensions.Has(id) {
+				foundIDs.Insert(id)
+				required = true
+			}
+		}
+
+		if required {
+			registrations = append(registrations, registration)
+		}
+	}
+
+	if missingIDs := requiredExtensions.Difference(foundIDs); missingIDs.Len() > 0 {
+		return nil, fmt.Errorf("did not find ControllerRegistrations for the required extensions %v", sets.List(missingIDs))
+	}
+
+	return registrations, nil
+}
+
+// renderExtension renders the chart of the given extension and returns the namespace of the extension as well as the
+// rendered manifests. The namespace is created if it does not exist yet.
+func (b *AutonomousBotanist) renderExtension(ctx context.Context, registration *gardencorev1beta1.ControllerRegistration) (string, map[string][]byte, error) {
+	helmDeployment, err := b.helmDeploymentForRegistration(registration)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var helmValues map[string]any
+	if helmDeployment.Values != nil {
+		if err := json.Unmarshal(helmDeployment.Values.Raw, &helmValues); err != nil {
+			return "", nil, fmt.Errorf("chart values cannot be unmarshalled: %w", err)
+		}
+	}
+
+	archive := helmDeployment.RawChart
+	if len(archive) == 0 {
+		if archive, err = b.HelmRegistry.Pull(ctx, helmDeployment.OCIRepository); err != nil {
+			return "", nil, fmt.Errorf("failed pulling chart: %w", err)
+		}
+	}
+
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "extension-" + registration.Name}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.SeedClientSet.Client(), namespace, func() error {
+		metav1.SetMetaDataLabel(&namespace.ObjectMeta, v1beta1constants.GardenRole, v1beta1constants.GardenRoleExtension)
+		metav1.SetMetaDataLabel(&namespace.ObjectMeta, v1beta1constants.LabelControllerRegistrationName, registration.Name)
+		return nil
+	}); err != nil {
+		return "", nil, fmt.Errorf("failed reconciling namespace %s: %w", namespace.Name, err)
+	}
+
+	release, err := b.SeedClientSet.ChartRenderer().RenderArchive(archive, registration.Name, namespace.Name, utils.MergeMaps(helmValues, b.extensionValues()))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed rendering chart: %w", err)
+	}
+
+	return namespace.Name, release.AsSecretData(), nil
+}
+
+func (b *AutonomousBotanist) helmDeploymentForRegistration(registration *gardencorev1beta1.ControllerRegistration) (*gardencorev1.HelmControllerDeployment, error) {
+	if registration.Spec.Deployment == nil || len(registration.Spec.Deployment.DeploymentRefs) == 0 {
+		return nil, fmt.Errorf("ControllerRegistration does not reference a ControllerDeployment")
+	}
+
+	name := registration.Spec.Deployment.DeploymentRefs[0].Name
+	for _, controllerDeployment := range b.Resources.ControllerDeployments {
+		if controllerDeployment.Name != name {
+			continue
+		}
+
+		if controllerDeployment.Helm == nil {
+			return nil, fmt.Errorf("ControllerDeployment %s is not of type helm", name)
+		}
+		return controllerDeployment.Helm, nil
+	}
+
+	return nil, fmt.Errorf("did not find ControllerDeployment %s", name)
+}
+
+// extensionValues returns the standard values which are mixed into the chart values of the extensions. They are a
+// subset of the values provided by gardenlet since there is neither a garden cluster nor a dedicated seed.
+func (b *AutonomousBotanist) extensionValues() map[string]any {
+	featureToEnabled := make(map[featuregate.Feature]bool)
+	for feature := range features.DefaultFeatureGate.GetAll() {
+		featureToEnabled[feature] = features.DefaultFeatureGate.Enabled(feature)
+	}
+
+	seed := b.Seed.GetInfo()
+
+	return map[string]any{
+		"gardener": map[string]any{
+			"version":                b.GardenerInfo.Version,
+			"autonomousShootCluster": true,
+			"seed": map[string]any{
+				"name":     seed.Name,
+				"provider": seed.Spec.Provider.Type,
+				"region":   seed.Spec.Provider.Region,
+				"networks": seed.Spec.Networks,
+				"spec":     seed.Spec,
+			},
+			"gardenlet": map[string]any{
+				"featureGates": featureToEnabled,
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+// RegisterShoot creates the Shoot resource of the autonomous shoot cluster in the garden cluster the given client
+// points to and marks it as self-hosted, so that it is never scheduled to a seed. If the Shoot already exists (e.g.,
+// because 'gardenadm connect' is retried), it must be self-hosted. Afterwards, the botanist uses the given client and
+// the registered Shoot instead of the simulated garden cluster, i.e., all further operations on the garden cluster
+// (like syncing the shoot's credentials) act on the real one.
+func (b *AutonomousBotanist) RegisterShoot(ctx context.Context, gardenClient client.Client) error {
+	desiredShoot := b.Resources.Shoot
+
+	shoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: desiredShoot.Name, Namespace: desiredShoot.Namespace}}
+	if err := gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+
+		shoot.Labels = desiredShoot.Labels
+		shoot.Annotations = desiredShoot.Annotations
+		shoot.Spec = desiredShoot.Spec
+		metav1.SetMetaDataLabel(&shoot.ObjectMeta, v1beta1constants.LabelShootSelfHosted, "true")
+
+		if err := gardenClient.Create(ctx, shoot); err != nil {
+			return fmt.Errorf("failed creating shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+	} else if !v1beta1helper.IsShootSelfHosted(shoot) {
+		return fmt.Errorf("shoot %s already exists in the garden cluster but is not self-hosted", client.ObjectKeyFromObject(shoot))
+	}
+
+	// The control plane of autonomous shoot clusters runs in the 'kube-system' namespace of the cluster itself, see
+	// NewAutonomousBotanist.
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.TechnicalID = b.Shoot.SeedNamespace
+	shoot.Status.Gardener = *b.GardenerInfo
+	if shoot.Status.LastOperation == nil {
+		// The cluster was already created by 'gardenadm init'. The gardenlet deployed by DeployGardenlet only starts
+		// backing up the ShootState of shoots which were created successfully.
+		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+			Type:           gardencorev1beta1.LastOperationTypeCreate,
+			State:          gardencorev1beta1.LastOperationStateSucceeded,
+			Progress:       100,
+			Description:    "Autonomous shoot cluster has been registered via 'gardenadm connect'.",
+			LastUpdateTime: metav1.Now(),
+		}
+	}
+	if err := gardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed patching status of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	b.GardenClient = gardenClient
+	b.Shoot.SetInfo(shoot)
+	return nil
+}
+
+// DeployShootState computes the ShootState of the autonomous shoot cluster (i.e., the secrets which must be persisted
+// and the state of the extension resources) and deploys it into the garden cluster. This allows Gardener to take over
+// the lifecycle operations of the cluster (e.g., credentials rotation) without losing any state. If the cluster was
+// bootstrapped by 'gardenadm bootstrap', the state handed over from the bootstrap cluster (e.g., of the Infrastructure
+// and the machines) is deployed as well since it cannot be computed from the cluster. RegisterShoot must have been
+// called before.
+func (b *AutonomousBotanist) DeployShootState(ctx context.Context) error {
+	bootstrapSpec, err := b.bootstrapShootStateSpec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if bootstrapSpec == nil {
+		return shootstate.Deploy(ctx, clock.RealClock{}, b.GardenClient, b.SeedClientSet.Client(), b.Shoot.GetInfo(), true, nil)
+	}
+
+	shoot := b.Shoot.GetInfo()
+	shootState := &gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{Name: shoot.Name, Namespace: shoot.Namespace}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.GardenClient, shootState, func() error {
+		shootState.Spec = *bootstrapSpec
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed deploying handed over ShootState %s: %w", client.ObjectKeyFromObject(shootState), err)
+	}
+
+	// The state computed from the cluster is merged into the handed over state, i.e., it takes precedence.
+	return shootstate.Deploy(ctx, clock.RealClock{}, b.GardenClient, b.SeedClientSet.Client(), shoot, false, nil)
+}
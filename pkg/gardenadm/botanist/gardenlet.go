@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/charts"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controller/gardenletdeployer"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	gardenletbootstraputil "github.com/gardener/gardener/pkg/gardenlet/bootstrap/util"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// GardenletTokenExpiration is the requested validity of the token which the gardenlet of the autonomous shoot cluster
+// uses for accessing the garden cluster. Running 'gardenadm connect' again issues a new token.
+const GardenletTokenExpiration = 90 * 24 * time.Hour
+
+// GardenletResourceName returns the name of the ServiceAccount, Role, and RoleBinding in the project namespace of the
+// garden cluster which are used by the gardenlet of the given self-hosted shoot.
+func GardenletResourceName(shootName string) string {
+	return "gardenlet-" + shootName
+}
+
+// DeployGardenlet deploys a gardenlet into the autonomous shoot cluster which is only responsible for the registered
+// Shoot (see RegisterShoot). In the garden cluster, it is represented by a ServiceAccount in the project namespace
+// whose permissions are restricted to the Shoot and its ShootState. The gardenlet takes over the periodic backup of the
+// ShootState, so that Gardener can take over the lifecycle operations of the cluster. The given REST config of the
+// garden cluster is used for computing the gardenlet's kubeconfig.
+func (b *AutonomousBotanist) DeployGardenlet(ctx context.Context, gardenRESTConfig *rest.Config) error {
+	token, err := b.reconcileGardenletServiceAccount(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The CA bundle of the garden cluster might be referenced by a file on the local machine, hence it must be loaded for
+	// being usable by the gardenlet.
+	gardenletRESTConfig := rest.CopyConfig(gardenRESTConfig)
+	if err := rest.LoadTLSFiles(gardenletRESTConfig); err != nil {
+		return fmt.Errorf("failed loading TLS files of garden kubeconfig: %w", err)
+	}
+	gardenletRESTConfig.CAFile = ""
+
+	kubeconfig, err := gardenletbootstraputil.CreateGardenletKubeconfigWithToken(gardenletRESTConfig, token)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenlet kubeconfig: %w", err)
+	}
+
+	values, err := b.gardenletChartValues(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed computing gardenlet chart values: %w", err)
+	}
+
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.GardenNamespace}}
+	if err := b.SeedClientSet.Client().Create(ctx, namespace); client.IgnoreAlreadyExists(err) != nil {
+		return fmt.Errorf("failed creating namespace %s: %w", namespace.Name, err)
+	}
+
+	return b.SeedClientSet.ChartApplier().ApplyFromEmbeddedFS(ctx, charts.ChartGardenlet, charts.ChartPathGardenlet, v1beta1constants.GardenNamespace, "gardenlet", kubernetes.Values(values))
+}
+
+func (b *AutonomousBotanist) reconcileGardenletServiceAccount(ctx context.Context) (string, error) {
+	var (
+		shoot = b.Shoot.GetInfo()
+		name  = GardenletResourceName(shoot.Name)
+
+		serviceAccount = &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: shoot.Namespace}}
+		role           = &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: shoot.Namespace}}
+		roleBinding    = &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: shoot.Namespace}}
+	)
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.GardenClient, serviceAccount, func() error {
+		serviceAccount.AutomountServiceAccountToken = ptr.To(false)
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("failed reconciling ServiceAccount %s: %w", client.ObjectKeyFromObject(serviceAccount), err)
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.GardenClient, role, func() error {
+		role.Rules = []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{gardencorev1beta1.GroupName},
+				Resources:     []string{"shoots"},
+				ResourceNames: []string{shoot.Name},
+				Verbs:         []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups:     []string{gardencorev1beta1.GroupName},
+				Resources:     []string{"shootstates"},
+				ResourceNames: []string{shoot.Name},
+				Verbs:         []string{"get", "list", "watch", "patch", "update"},
+			},
+			{
+				// The 'create' verb cannot be restricted by resource names.
+				APIGroups: []string{gardencorev1beta1.GroupName},
+				Resources: []string{"shootstates"},
+				Verbs:     []string{"create"},
+			},
+		}
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("failed reconciling Role %s: %w", client.ObjectKeyFromObject(role), err)
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.GardenClient, roleBinding, func() error {
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     role.Name,
+		}
+		roleBinding.Subjects = []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: serviceAccount.Name, Namespace: serviceAccount.Namespace}}
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("failed reconciling RoleBinding %s: %w", client.ObjectKeyFromObject(roleBinding), err)
+	}
+
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: ptr.To(int64(GardenletTokenExpiration / time.Second)),
+		},
+	}
+	if err := b.GardenClient.SubResource("token").Create(ctx, serviceAccount, tokenRequest); err != nil {
+		return "", fmt.Errorf("failed requesting token for ServiceAccount %s: %w", client.ObjectKeyFromObject(serviceAccount), err)
+	}
+
+	return tokenRequest.Status.Token, nil
+}
+
+func (b *AutonomousBotanist) gardenletChartValues(kubeconfig []byte) (map[string]any, error) {
+	valuesHelper := gardenletdeployer.NewValuesHelper(nil)
+
+	deployment, err := valuesHelper.MergeGardenletDeployment(&seedmanagementv1alpha1.GardenletDeployment{
+		ReplicaCount:         ptr.To[int32](1),
+		RevisionHistoryLimit: ptr.To[int32](2),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	shoot := b.Shoot.GetInfo()
+	config := &gardenletconfigv1alpha1.GardenletConfiguration{
+		SelfHostedShoot: &gardenletconfigv1alpha1.SelfHostedShoot{
+			Name:      shoot.Name,
+			Namespace: shoot.Namespace,
+		},
+	}
+	gardenletconfigv1alpha1.SetObjectDefaults_GardenletConfiguration(config)
+
+	values, err := valuesHelper.GetGardenletChartValues(deployment, config, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// The kubeconfig is passed inline since the values helper would interpret it as a file path.
+	return utils.SetToValuesMap(values, string(kubeconfig), "config", "gardenClientConnection", "kubeconfig")
+}
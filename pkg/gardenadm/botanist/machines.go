@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/imagevector"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const (
+	machineControllerManagerName = v1beta1constants.DeploymentNameMachineControllerManager
+
+	// machinesTimeout is the maximum duration to wait for the control plane machines to be created.
+	machinesTimeout = 20 * time.Minute
+)
+
+// DeployMachineControllerManager deploys machine-controller-manager into the bootstrap cluster. In contrast to regular
+// shoot clusters, the target cluster of machine-controller-manager is the bootstrap cluster itself because the
+// autonomous shoot cluster does not exist yet. Hence, the machines never join it, and machine-controller-manager is
+// stopped once the machines have been created (see StopBootstrapControllers).
+func (b *AutonomousBotanist) DeployMachineControllerManager(ctx context.Context) error {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameMachineControllerManager, imagevectorutils.RuntimeVersion(b.SeedVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	if err != nil {
+		return err
+	}
+
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig(
+		b.Shoot.SeedNamespace,
+		clientcmdv1.Cluster{
+			Server:               "https://kubernetes.default.svc",
+			CertificateAuthority: "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt",
+		},
+		clientcmdv1.AuthInfo{TokenFile: "/var/run/secrets/kubernetes.io/serviceaccount/token"},
+	))
+	if err != nil {
+		return err
+	}
+
+	var (
+		c      = b.SeedClientSet.Client()
+		labels = map[string]string{
+			v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
+			v1beta1constants.LabelRole: machineControllerManagerName,
+		}
+
+		serviceAccount     = &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: machineControllerManagerName, Namespace: b.Shoot.SeedNamespace}}
+		clusterRole        = &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "gardenadm:bootstrap:" + machineControllerManagerName}}
+		clusterRoleBinding = &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "gardenadm:bootstrap:" + machineControllerManagerName + ":" + b.Shoot.SeedNamespace}}
+		secret             = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: machineControllerManagerName + "-kubeconfig", Namespace: b.Shoot.SeedNamespace}}
+		deployment         = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: machineControllerManagerName, Namespace: b.Shoot.SeedNamespace}}
+	)
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, serviceAccount, func() error {
+		serviceAccount.AutomountServiceAccountToken = ptr.To(true)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, clusterRole, func() error {
+		clusterRole.Rules = []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{machinev1alpha1.GroupName},
+				Resources: []string{"*"},
+				Verbs:     []string{"*"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"nodes", "nodes/status", "pods", "persistentvolumes", "persistentvolumeclaims", "secrets", "configmaps", "events", "endpoints"},
+				Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "patch", "update", "watch"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods/eviction"},
+				Verbs:     []string{"create"},
+			},
+			{
+				APIGroups: []string{"storage.k8s.io"},
+				Resources: []string{"volumeattachments"},
+				Verbs:     []string{"delete", "get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"coordination.k8s.io"},
+				Resources: []string{"leases"},
+				Verbs:     []string{"create", "get", "list", "update", "watch"},
+			},
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, clusterRoleBinding, func() error {
+		clusterRoleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     clusterRole.Name,
+		}
+		clusterRoleBinding.Subjects = []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccount.Name,
+			Namespace: serviceAccount.Namespace,
+		}}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{"kubeconfig": kubeconfig}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, deployment, func() error {
+		// The control plane webhook of the provider extension injects the provider-specific sidecar container.
+		metav1.SetMetaDataLabel(&deployment.ObjectMeta, v1beta1constants.LabelExtensionProviderMutatedByControlplaneWebhook, "true")
+		deployment.Spec = appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:            machineControllerManagerName,
+						Image:           image.String(),
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Command: []string{
+							"./machine-controller-manager",
+							"--control-kubeconfig=inClusterConfig",
+							"--machine-safety-overshooting-period=1m",
+							"--namespace=" + b.Shoot.SeedNamespace,
+							"--safety-up=2",
+							"--safety-down=1",
+							"--target-kubeconfig=" + gardenerutils.PathGenericKubeconfig,
+							"--v=3",
+						},
+						VolumeMounts: []corev1.VolumeMount{{
+							Name:      "kubeconfig",
+							MountPath: gardenerutils.VolumeMountPathGenericKubeconfig,
+							ReadOnly:  true,
+						}},
+					}},
+					ServiceAccountName:            serviceAccount.Name,
+					TerminationGracePeriodSeconds: ptr.To[int64](5),
+					Volumes: []corev1.Volume{{
+						Name: "kubeconfig",
+						VolumeSource: corev1.VolumeSource{
+							Secret: &corev1.SecretVolumeSource{SecretName: secret.Name},
+						},
+					}},
+				},
+			},
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WaitUntilControlPlaneMachinesCreated waits until the machines of the control plane worker pool have been created by
+// the infrastructure provider, i.e., until at least the minimum number of machines of the pool have a provider ID. It
+// returns the created machines.
+func (b *AutonomousBotanist) WaitUntilControlPlaneMachinesCreated(ctx context.Context) ([]machinev1alpha1.Machine, error) {
+	var (
+		minimum  = b.Shoot.GetInfo().Spec.Provider.Workers[0].Minimum
+		machines []machinev1alpha1.Machine
+	)
+
+	if err := retry.UntilTimeout(ctx, 5*time.Second, machinesTimeout, func(ctx context.Context) (bool, error) {
+		machineList := &machinev1alpha1.MachineList{}
+		if err := b.SeedClientSet.Client().List(ctx, machineList, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+			return retry.SevereError(err)
+		}
+
+		machines = nil
+		for _, machine := range machineList.Items {
+			if machine.Spec.ProviderID != "" {
+				machines = append(machines, machine)
+			}
+		}
+
+		if int32(len(machines)) < max(minimum, 1) { // #nosec G115 -- the number of machines is small.
+			return retry.MinorError(fmt.Errorf("only %d of %d control plane machines have been created", len(machines), max(minimum, 1)))
+		}
+		return retry.Ok()
+	}); err != nil {
+		return nil, err
+	}
+
+	return machines, nil
+}
+
+// StopBootstrapControllers scales down the extension controllers and machine-controller-manager in the bootstrap
+// cluster. The extension controllers are stopped first, so that the worker controller does not scale up
+// machine-controller-manager again. Afterwards, the resources in the bootstrap cluster are no longer reconciled, i.e.,
+// the created machines are left untouched.
+func (b *AutonomousBotanist) StopBootstrapControllers(ctx context.Context) error {
+	c := b.SeedClientSet.Client()
+
+	namespaceList := &corev1.NamespaceList{}
+	if err := c.List(ctx, namespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleExtension}); err != nil {
+		return fmt.Errorf("failed listing extension namespaces: %w", err)
+	}
+
+	for _, namespace := range namespaceList.Items {
+		deploymentList := &appsv1.DeploymentList{}
+		if err := c.List(ctx, deploymentList, client.InNamespace(namespace.Name)); err != nil {
+			return fmt.Errorf("failed listing deployments in namespace %s: %w", namespace.Name, err)
+		}
+
+		for _, deployment := range deploymentList.Items {
+			if err := kubernetesutils.ScaleDeployment(ctx, c, client.ObjectKeyFromObject(&deployment), 0); err != nil {
+				return fmt.Errorf("failed scaling down deployment %s: %w", client.ObjectKeyFromObject(&deployment), err)
+			}
+		}
+	}
+
+	return client.IgnoreNotFound(kubernetesutils.ScaleDeployment(ctx, c, client.ObjectKey{Name: machineControllerManagerName, Namespace: b.Shoot.SeedNamespace}, 0))
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+const (
+	// PathKubeconfigAdmin is the path to the kubeconfig for the cluster administrator on the control plane node.
+	PathKubeconfigAdmin = "/etc/kubernetes/admin.conf"
+	// PathHostsFile is the path to the hosts file of the node.
+	PathHostsFile = "/etc/hosts"
+
+	// userNameAdmin is the name of the user in the kubeconfig for the cluster administrator.
+	userNameAdmin = "gardenadm:cluster-admin"
+)
+
+// WriteKubeconfigs writes the kubeconfigs for kubelet and for the cluster administrator to the node. Both contain
+// client certificates since there is no API server yet which could be used for bootstrapping them.
+func (b *AutonomousBotanist) WriteKubeconfigs(ctx context.Context) error {
+	for _, kubeconfig := range []struct {
+		secretName, path, userName string
+		groups                     []string
+	}{
+		{SecretNameKubeconfigKubelet, kubelet.PathKubeconfigReal, "system:node:" + b.HostName, []string{user.NodesGroup}},
+		{SecretNameKubeconfigAdmin, PathKubeconfigAdmin, userNameAdmin, []string{user.SystemPrivilegedGroup}},
+	} {
+		secret, err := b.ReconcileKubeconfigSecret(ctx, kubeconfig.secretName, kubeconfig.userName, kubeconfig.groups...)
+		if err != nil {
+			return fmt.Errorf("failed generating kubeconfig secret %s: %w", kubeconfig.secretName, err)
+		}
+
+		if err := b.FS.MkdirAll(filepath.Dir(kubeconfig.path), 0700); err != nil {
+			return fmt.Errorf("failed creating directory for kubeconfig %s: %w", kubeconfig.path, err)
+		}
+
+		if err := b.FS.WriteFile(kubeconfig.path, secret.Data[secretsutils.DataKeyKubeconfig], 0600); err != nil {
+			return fmt.Errorf("failed writing kubeconfig %s: %w", kubeconfig.path, err)
+		}
+	}
+
+	return nil
+}
+
+// EnsureAPIServerHostsEntry ensures that the hosts file of the node resolves the domain of the API server to the given
+// IP address. The internal domain of autonomous shoot clusters is not published to any DNS provider, hence kubelet and
+// the other components on the node would not be able to reach the API server otherwise.
+func (b *AutonomousBotanist) EnsureAPIServerHostsEntry(ip string) error {
+	return EnsureHostsEntry(b.FS, ip, b.Shoot.ComputeOutOfClusterAPIServerAddress(true))
+}
+
+// EnsureHostsEntry ensures that the hosts file of the node resolves the given host name to the given IP address. Existing
+// entries for the host name are replaced.
+func EnsureHostsEntry(fs afero.Afero, ip, hostName string) error {
+	var lines []string
+
+	content, err := fs.ReadFile(PathHostsFile)
+	if err != nil && !errors.Is(err, iofs.ErrNotExist) {
+		return fmt.Errorf("failed reading hosts file: %w", err)
+	}
+
+	if len(content) > 0 {
+		lines = strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	}
+
+	lines = slices.DeleteFunc(lines, func(line string) bool {
+		fields := strings.Fields(line)
+		return len(fields) > 1 && !strings.HasPrefix(fields[0], "#") && slices.Contains(fields[1:], hostName)
+	})
+	lines = append(lines, ip+" "+hostName)
+
+	if err := fs.WriteFile(PathHostsFile, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed writing hosts file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
+	oscutils "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/utils"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	"github.com/gardener/gardener/pkg/nodeagent/registry"
+)
+
+var nodeAgentConfigDecoder runtime.Decoder
+
+func init() {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(nodeagentconfigv1alpha1.AddToScheme(scheme))
+	nodeAgentConfigDecoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+}
+
+// ComputeOperatingSystemConfig computes the OperatingSystemConfig for the control plane worker pool (i.e., the first
+// worker pool of the shoot) with the existing component and returns the secret which gardener-node-agent reads. The
+// given control plane files (see DeployControlPlane) are added to the OperatingSystemConfig and kubelet is configured to
+// run the static pods. It must be called with a botanist based on a fake client set since there is no operating system
+// extension reconciling the OperatingSystemConfig.
+func (b *AutonomousBotanist) ComputeOperatingSystemConfig(ctx context.Context, controlPlaneFiles []extensionsv1alpha1.File) (*corev1.Secret, error) {
+	worker, err := b.controlPlaneWorkerPool()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.DeployOperatingSystemConfig(ctx); err != nil {
+		return nil, fmt.Errorf("failed deploying operating system config: %w", err)
+	}
+
+	return b.operatingSystemConfigSecret(ctx, worker, func(osc *extensionsv1alpha1.OperatingSystemConfig) error {
+		for i, file := range osc.Spec.Files {
+			if file.Path == kubelet.PathKubeletConfig {
+				if err := enableStaticPods(&osc.Spec.Files[i]); err != nil {
+					return err
+				}
+			}
+		}
+
+		osc.Spec.Files = append(osc.Spec.Files, controlPlaneFiles...)
+		return nil
+	})
+}
+
+// ComputeWorkerOperatingSystemConfigs returns the secrets which gardener-node-agent reads for all worker pools except
+// the control plane worker pool. It must be called after ComputeOperatingSystemConfig.
+func (b *AutonomousBotanist) ComputeWorkerOperatingSystemConfigs(ctx context.Context) ([]*corev1.Secret, error) {
+	controlPlaneWorker, err := b.controlPlaneWorkerPool()
+	if err != nil {
+		return nil, err
+	}
+
+	var secrets []*corev1.Secret
+	for _, worker := range b.Shoot.GetInfo().Spec.Provider.Workers {
+		if worker.Name == controlPlaneWorker {
+			continue
+		}
+
+		secret, err := b.operatingSystemConfigSecret(ctx, worker.Name, nil)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, secret)
+	}
+
+	return secrets, nil
+}
+
+func (b *AutonomousBotanist) operatingSystemConfigSecret(ctx context.Context, worker string, mutate func(*extensionsv1alpha1.OperatingSystemConfig) error) (*corev1.Secret, error) {
+	oscList := &extensionsv1alpha1.OperatingSystemConfigList{}
+	if err := b.SeedClientSet.Client().List(ctx, oscList, client.InNamespace(b.Shoot.SeedNamespace), client.MatchingLabels{v1beta1constants.LabelWorkerPool: worker}); err != nil {
+		return nil, fmt.Errorf("failed listing operating system configs: %w", err)
+	}
+
+	var osc *extensionsv1alpha1.OperatingSystemConfig
+	for _, item := range oscList.Items {
+		if item.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+			osc = item.DeepCopy()
+			break
+		}
+	}
+	if osc == nil {
+		return nil, fmt.Errorf("did not find operating system config with purpose %q for worker pool %q", extensionsv1alpha1.OperatingSystemConfigPurposeReconcile, worker)
+	}
+
+	var (
+		secretName string
+		err        error
+	)
+	for _, file := range osc.Spec.Files {
+		if file.Path == nodeagentconfigv1alpha1.ConfigFilePath {
+			if secretName, err = nodeAgentSecretName(file); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if secretName == "" {
+		return nil, fmt.Errorf("did not find gardener-node-agent configuration in operating system config for worker pool %q", worker)
+	}
+
+	if mutate != nil {
+		if err := mutate(osc); err != nil {
+			return nil, err
+		}
+	}
+
+	return nodeagent.OperatingSystemConfigSecret(ctx, b.SeedClientSet.Client(), osc, secretName, worker)
+}
+
+// ApplyOperatingSystemConfig applies the given OperatingSystemConfig secret (see ComputeOperatingSystemConfig) to the
+// node by running the reconciliation logic of gardener-node-agent once. This writes all files (including the static pod
+// manifests) and starts all units (including kubelet and gardener-node-agent itself).
+func (b *AutonomousBotanist) ApplyOperatingSystemConfig(ctx context.Context, oscSecret *corev1.Secret) error {
+	kubernetesVersion := b.Shoot.KubernetesVersion
+
+	reconciler := &operatingsystemconfig.Reconciler{
+		Client: fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(oscSecret.DeepCopy()).Build(),
+		Config: nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig{
+			SecretName:        oscSecret.Name,
+			KubernetesVersion: kubernetesVersion,
+		},
+		Recorder:      &record.FakeRecorder{},
+		DBus:          b.DBus,
+		FS:            b.FS,
+		Extractor:     registry.NewExtractor(),
+		CancelContext: func() {},
+		HostName:      b.HostName,
+	}
+
+	if _, err := reconciler.Reconcile(logf.IntoContext(ctx, b.Logger), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(oscSecret)}); err != nil {
+		return fmt.Errorf("failed applying operating system config: %w", err)
+	}
+
+	return nil
+}
+
+// controlPlaneWorkerPool returns the name of the worker pool whose nodes run the control plane. For now, this is always
+// the first worker pool.
+func (b *AutonomousBotanist) controlPlaneWorkerPool() (string, error) {
+	workers := b.Shoot.GetInfo().Spec.Provider.Workers
+	if len(workers) == 0 {
+		return "", fmt.Errorf("shoot must have at least one worker pool for running the control plane")
+	}
+
+	return workers[0].Name, nil
+}
+
+func enableStaticPods(file *extensionsv1alpha1.File) error {
+	if file.Content.Inline == nil {
+		return fmt.Errorf("kubelet configuration file %s has no inline content", file.Path)
+	}
+
+	codec := kubelet.NewConfigCodec(oscutils.NewFileContentInlineCodec())
+
+	config, err := codec.Decode(file.Content.Inline)
+	if err != nil {
+		return fmt.Errorf("failed decoding kubelet configuration: %w", err)
+	}
+
+	config.StaticPodPath = staticpod.ManifestsDir
+
+	if file.Content.Inline, err = codec.Encode(config, file.Content.Inline.Encoding); err != nil {
+		return fmt.Errorf("failed encoding kubelet configuration: %w", err)
+	}
+
+	return nil
+}
+
+func nodeAgentSecretName(file extensionsv1alpha1.File) (string, error) {
+	if file.Content.Inline == nil {
+		return "", fmt.Errorf("gardener-node-agent configuration file %s has no inline content", file.Path)
+	}
+
+	data, err := oscutils.NewFileContentInlineCodec().Decode(file.Content.Inline)
+	if err != nil {
+		return "", fmt.Errorf("failed decoding gardener-node-agent configuration file content: %w", err)
+	}
+
+	config := &nodeagentconfigv1alpha1.NodeAgentConfiguration{}
+	if err := runtime.DecodeInto(nodeAgentConfigDecoder, data, config); err != nil {
+		return "", fmt.Errorf("failed decoding gardener-node-agent configuration: %w", err)
+	}
+
+	if config.Controllers.OperatingSystemConfig.SecretName == "" {
+		return "", fmt.Errorf("gardener-node-agent configuration does not contain the operating system config secret name")
+	}
+
+	return config.Controllers.OperatingSystemConfig.SecretName, nil
+}
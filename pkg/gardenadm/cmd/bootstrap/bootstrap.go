@@ -6,12 +6,31 @@ package bootstrap
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"time"
 
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/provider-local/local"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
 )
 
+// machineTimeout is the maximum duration to wait for the first control plane machine to be reachable.
+const machineTimeout = 10 * time.Minute
+
 // NewCommand creates a new cobra.Command.
 func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	opts := &Options{}
@@ -19,10 +38,17 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "bootstrap",
 		Short: "Bootstrap the infrastructure for an Autonomous Shoot Cluster",
-		Long:  "Bootstrap the infrastructure for an Autonomous Shoot Cluster (networks, machines, etc.)",
+		Long: "Bootstrap the infrastructure for an Autonomous Shoot Cluster (networks, machines, etc.). " +
+			"The provider extension is deployed into a temporary bootstrap cluster (either an existing cluster or a KinD cluster created on the fly), which then reconciles the Infrastructure, OperatingSystemConfig, and Worker resources of the shoot. " +
+			"Afterwards, gardenadm is installed on the first control plane machine and 'gardenadm init' is executed on it. " +
+			"The state of the bootstrap cluster (e.g., the certificate authorities and the state of the infrastructure and machines) is handed over to the machine and persisted in the autonomous shoot cluster before the bootstrap cluster is deleted. " +
+			"For provider-local, the machines are pods in the bootstrap cluster, hence an existing cluster must be given via --kubeconfig.",
+
+		Example: `# Bootstrap the infrastructure in a temporary KinD cluster
+gardenadm bootstrap --config-dir ./manifests --gardenadm-image <image>
 
-		Example: `# Bootstrap the infrastructure
-gardenadm bootstrap --kubeconfig ~/.kube/config`,
+# Bootstrap the infrastructure using an existing cluster as bootstrap cluster
+gardenadm bootstrap --config-dir ./manifests --gardenadm-image <image> --kubeconfig ~/.kube/config`,
 
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := opts.Complete(); err != nil {
@@ -42,7 +68,194 @@ gardenadm bootstrap --kubeconfig ~/.kube/config`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprintln(ioStreams.Out, "not implemented as well")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) (err error) {
+	log := logger.MustNewZapLogger(logger.InfoLevel, logger.FormatText, logzap.WriteTo(ioStreams.ErrOut))
+
+	resources, err := gardenadm.ReadManifests(os.DirFS(opts.ConfigDir))
+	if err != nil {
+		return fmt.Errorf("failed reading manifests from %s: %w", opts.ConfigDir, err)
+	}
+
+	kubeconfigPath := opts.Kubeconfig
+	if kubeconfigPath == "" {
+		// For provider-local, the machines are pods in the bootstrap cluster, i.e., they would be deleted together with
+		// the temporary KinD cluster.
+		if resources.Shoot.Spec.Provider.Type == local.Type {
+			return fmt.Errorf("the machines of provider-local run in the bootstrap cluster, hence an existing cluster must be given via --kubeconfig")
+		}
+
+		log.Info("Creating KinD cluster as bootstrap cluster", "name", kindClusterName)
+		kind, kindErr := createKindCluster(ctx, ioStreams.ErrOut)
+		if kindErr != nil {
+			return kindErr
+		}
+
+		// Only the bootstrap cluster created by gardenadm itself is deleted. On failure, it is kept for debugging. On
+		// success, 'gardenadm init' has persisted the handed over state in the autonomous shoot cluster, i.e., the
+		// bootstrap cluster is no longer needed.
+		defer func() {
+			if err != nil {
+				log.Info("Keeping KinD cluster for debugging, delete it with 'kind delete cluster --name "+kindClusterName+"'", "kubeconfig", kind.kubeconfigPath)
+				return
+			}
+
+			log.Info("Deleting KinD cluster", "name", kindClusterName)
+			err = kind.delete(ctx)
+		}()
+
+		kubeconfigPath = kind.kubeconfigPath
+	}
+
+	clientSet, err := cmd.NewClientSetFromFile(kubeconfigPath, kubernetes.SeedScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	b, err := botanist.NewAutonomousBotanistForBootstrapCluster(ctx, log, clientSet, resources)
+	if err != nil {
+		return fmt.Errorf("failed creating autonomous botanist: %w", err)
+	}
+
+	machines, err := createMachines(ctx, log, b)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Computing state for handover")
+	shootState, err := b.ComputeShootState(ctx)
+	if err != nil {
+		return err
+	}
+
+	shootStateData, err := yaml.Marshal(shootState)
+	if err != nil {
+		return fmt.Errorf("failed marshalling ShootState: %w", err)
+	}
+
+	machine := machines[0]
+
+	log.Info("Connecting to first control plane machine", "machine", machine.Name)
+	conn, err := waitForMachineConnection(ctx, b, machine)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	log.Info("Copying manifests to machine", "directory", manifestsDir)
+	if err := copyManifests(ctx, conn, opts.ConfigDir, map[string][]byte{"shootstate.yaml": shootStateData}, ioStreams.ErrOut); err != nil {
+		return err
+	}
+
+	log.Info("Installing gardenadm on machine", "image", opts.GardenadmImage)
+	if err := installGardenadm(ctx, conn, opts.GardenadmImage, ioStreams.ErrOut, ioStreams.ErrOut); err != nil {
+		return err
+	}
+
+	log.Info("Running 'gardenadm init' on machine")
+	if err := conn.Run(ctx, nil, ioStreams.Out, ioStreams.ErrOut, gardenadmPath+" init --config-dir "+shellQuote(manifestsDir)); err != nil {
+		return fmt.Errorf("failed running 'gardenadm init' on machine %s: %w", machine.Name, err)
+	}
+
+	fmt.Fprintf(ioStreams.Out, `Your autonomous shoot cluster has been bootstrapped on machine %s!
+
+Log in to the machine and follow the instructions above for joining further machines to the cluster.
+`, machine.Name)
+
 	return nil
 }
+
+func createMachines(ctx context.Context, log logr.Logger, b *botanist.AutonomousBotanist) ([]machinev1alpha1.Machine, error) {
+	log.Info("Deploying CustomResourceDefinitions")
+	if err := b.DeployExtensionCRDs(ctx); err != nil {
+		return nil, fmt.Errorf("failed deploying extension CRDs: %w", err)
+	}
+
+	if err := b.DeployMachineCRDs(ctx); err != nil {
+		return nil, fmt.Errorf("failed deploying machine CRDs: %w", err)
+	}
+
+	log.Info("Deploying shoot namespace", "namespace", b.Shoot.SeedNamespace)
+	if err := b.DeploySeedNamespace(ctx); err != nil {
+		return nil, fmt.Errorf("failed deploying shoot namespace: %w", err)
+	}
+
+	log.Info("Initializing secrets management")
+	if err := b.InitializeSecretsManagement(ctx); err != nil {
+		return nil, fmt.Errorf("failed initializing secrets management: %w", err)
+	}
+
+	if err := b.DeployCloudProviderSecret(ctx); err != nil {
+		return nil, fmt.Errorf("failed deploying cloud provider secret: %w", err)
+	}
+
+	if err := b.SyncClusterResource(ctx); err != nil {
+		return nil, fmt.Errorf("failed syncing Cluster resource: %w", err)
+	}
+
+	log.Info("Deploying extensions")
+	if err := b.ApplyExtensions(ctx); err != nil {
+		return nil, err
+	}
+
+	log.Info("Reconciling infrastructure")
+	if err := b.DeployInfrastructure(ctx); err != nil {
+		return nil, fmt.Errorf("failed deploying Infrastructure: %w", err)
+	}
+
+	if err := b.WaitForInfrastructure(ctx); err != nil {
+		return nil, fmt.Errorf("failed waiting for Infrastructure: %w", err)
+	}
+
+	log.Info("Reconciling operating system config")
+	if err := b.DeployOperatingSystemConfig(ctx); err != nil {
+		return nil, fmt.Errorf("failed deploying OperatingSystemConfig: %w", err)
+	}
+
+	if err := b.Shoot.Components.Extensions.OperatingSystemConfig.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("failed waiting for OperatingSystemConfig: %w", err)
+	}
+
+	log.Info("Reconciling worker")
+	if err := b.DeployMachineControllerManager(ctx); err != nil {
+		return nil, fmt.Errorf("failed deploying machine-controller-manager: %w", err)
+	}
+
+	if err := b.DeployWorker(ctx); err != nil {
+		return nil, fmt.Errorf("failed deploying Worker: %w", err)
+	}
+
+	log.Info("Waiting for control plane machines to be created")
+	machines, err := b.WaitUntilControlPlaneMachinesCreated(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed waiting for control plane machines: %w", err)
+	}
+
+	// The machines never join the bootstrap cluster, hence the Worker would never become ready. The controllers are
+	// stopped so that they do not replace the machines.
+	log.Info("Stopping controllers in bootstrap cluster")
+	if err := b.StopBootstrapControllers(ctx); err != nil {
+		return nil, err
+	}
+
+	return machines, nil
+}
+
+func waitForMachineConnection(ctx context.Context, b *botanist.AutonomousBotanist, machine machinev1alpha1.Machine) (machineConnection, error) {
+	var conn machineConnection
+
+	if err := retryutils.UntilTimeout(ctx, 5*time.Second, machineTimeout, func(ctx context.Context) (bool, error) {
+		var err error
+		if conn, err = connectToMachine(b, machine); err != nil {
+			return retryutils.MinorError(err)
+		}
+
+		if err := conn.Run(ctx, nil, io.Discard, io.Discard, "true"); err != nil {
+			return retryutils.MinorError(fmt.Errorf("machine %s is not yet reachable: %w", machine.Name, errors.Join(err, conn.Close())))
+		}
+		return retryutils.Ok()
+	}); err != nil {
+		return nil, err
+	}
+
+	return conn, nil
+}
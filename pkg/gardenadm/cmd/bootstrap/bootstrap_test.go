@@ -5,8 +5,7 @@
 package bootstrap_test
 
 import (
-	"bytes"
-	"io"
+	"context"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -19,24 +18,33 @@ import (
 var _ = Describe("Bootstrap", func() {
 	var (
 		ioStreams genericiooptions.IOStreams
-		out       *bytes.Buffer
 		cmd       *cobra.Command
 	)
 
 	BeforeEach(func() {
-		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
+		ioStreams, _, _, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(context.Background())
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(cmd.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+		It("should fail if no config directory is given", func() {
+			Expect(cmd.Flags().Set("gardenadm-image", "gardenadm:latest")).To(Succeed())
 
-			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("must provide a path to a config directory")))
+		})
+
+		It("should fail if no gardenadm image is given", func() {
+			Expect(cmd.Flags().Set("config-dir", GinkgoT().TempDir())).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("must provide a gardenadm image")))
+		})
+
+		It("should fail if the config directory does not contain a shoot", func() {
+			Expect(cmd.Flags().Set("config-dir", GinkgoT().TempDir())).To(Succeed())
+			Expect(cmd.Flags().Set("gardenadm-image", "gardenadm:latest")).To(Succeed())
 
-			output, err := io.ReadAll(out)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented as well\n"))
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("failed reading manifests")))
 		})
 	})
 })
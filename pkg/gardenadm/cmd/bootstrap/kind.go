@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// kindClusterName is the name of the temporary KinD cluster which is used as bootstrap cluster.
+const kindClusterName = "gardenadm-bootstrap"
+
+// kindCluster is a temporary KinD cluster created by 'gardenadm bootstrap'. In contrast to an in-process control plane
+// (e.g., envtest), it runs a kubelet, i.e., the pods of the extensions and of machine-controller-manager can actually
+// run in it.
+type kindCluster struct {
+	out            io.Writer
+	tempDir        string
+	kubeconfigPath string
+}
+
+func createKindCluster(ctx context.Context, out io.Writer) (*kindCluster, error) {
+	tempDir, err := os.MkdirTemp("", "gardenadm-bootstrap-")
+	if err != nil {
+		return nil, fmt.Errorf("failed creating temporary directory: %w", err)
+	}
+
+	cluster := &kindCluster{
+		out:            out,
+		tempDir:        tempDir,
+		kubeconfigPath: filepath.Join(tempDir, "kubeconfig"),
+	}
+
+	if err := cluster.run(ctx, "create", "cluster", "--name", kindClusterName, "--kubeconfig", cluster.kubeconfigPath, "--wait", "5m"); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed creating KinD cluster (is the kind CLI installed?): %w", err), os.RemoveAll(tempDir))
+	}
+
+	return cluster, nil
+}
+
+func (k *kindCluster) delete(ctx context.Context) error {
+	if err := k.run(ctx, "delete", "cluster", "--name", kindClusterName, "--kubeconfig", k.kubeconfigPath); err != nil {
+		return fmt.Errorf("failed deleting KinD cluster: %w", err)
+	}
+
+	return os.RemoveAll(k.tempDir)
+}
+
+func (k *kindCluster) run(ctx context.Context, args ...string) error {
+	cmd := exec.CommandContext(ctx, "kind", args...)
+	cmd.Stdout = k.out
+	cmd.Stderr = k.out
+	return cmd.Run()
+}
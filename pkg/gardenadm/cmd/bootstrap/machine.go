@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"golang.org/x/crypto/ssh"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/provider-local/local"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+const (
+	// manifestsDir is the directory on the machine to which the configuration resources are copied.
+	manifestsDir = "/var/lib/gardenadm/manifests"
+	// gardenadmPath is the path on the machine to which the gardenadm binary is installed.
+	gardenadmPath = "/opt/bin/gardenadm"
+	// sshUser is the user which is created on all machines by the operating system config, see the gardeneruser
+	// component.
+	sshUser = "gardener"
+)
+
+// machineConnection executes commands on a machine.
+type machineConnection interface {
+	// Run executes the given shell command on the machine.
+	Run(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, command string) error
+	// Close closes the connection.
+	Close() error
+}
+
+// connectToMachine connects to the given machine. For provider-local, machines are pods in the bootstrap cluster, hence
+// commands are executed via the API server. For all other providers, an SSH connection is established with the SSH key
+// pair of the shoot. The host keys of freshly created machines are not known, hence they cannot be verified.
+func connectToMachine(b *botanist.AutonomousBotanist, machine machinev1alpha1.Machine) (machineConnection, error) {
+	if b.Shoot.GetInfo().Spec.Provider.Type == local.Type {
+		return &podExecConnection{b: b, namespace: machine.Namespace, podName: machine.Spec.ProviderID}, nil
+	}
+
+	sshKeyPair, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHKeyPair)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameSSHKeyPair)
+	}
+
+	signer, err := ssh.ParsePrivateKey(sshKeyPair.Data[secretsutils.DataKeyRSAPrivateKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing SSH private key: %w", err)
+	}
+
+	host := machine.Labels[machinev1alpha1.NodeLabelKey]
+	if host == "" {
+		return nil, fmt.Errorf("machine %s has no node name yet", machine.Name)
+	}
+
+	sshClient, err := ssh.Dial("tcp", net.JoinHostPort(host, "22"), &ssh.ClientConfig{
+		User:            sshUser,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(), // #nosec G106 -- The host key of a freshly created machine is not known.
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed connecting to machine %s via SSH: %w", machine.Name, err)
+	}
+
+	return &sshConnection{client: sshClient}, nil
+}
+
+type podExecConnection struct {
+	b         *botanist.AutonomousBotanist
+	namespace string
+	podName   string
+}
+
+func (p *podExecConnection) Run(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, command string) error {
+	return p.b.SeedClientSet.PodExecutor().ExecuteWithStreams(ctx, p.namespace, p.podName, "node", stdin, stdout, stderr, "sh", "-c", command)
+}
+
+func (p *podExecConnection) Close() error {
+	return nil
+}
+
+type sshConnection struct {
+	client *ssh.Client
+}
+
+func (s *sshConnection) Run(_ context.Context, stdin io.Reader, stdout, stderr io.Writer, command string) error {
+	session, err := s.client.NewSession()
+	if err != nil {
+		return fmt.Errorf("failed creating SSH session: %w", err)
+	}
+	defer session.Close()
+
+	session.Stdin = stdin
+	session.Stdout = stdout
+	session.Stderr = stderr
+
+	// The gardener user is not root, hence the command is executed with sudo.
+	return session.Run("sudo sh -c " + shellQuote(command))
+}
+
+func (s *sshConnection) Close() error {
+	return s.client.Close()
+}
+
+// copyManifests copies all configuration resources from the given directory and the given additional files to the
+// manifests directory on the machine.
+func copyManifests(ctx context.Context, conn machineConnection, configDir string, additionalFiles map[string][]byte, stderr io.Writer) error {
+	files := make(map[string][]byte, len(additionalFiles))
+
+	if err := fs.WalkDir(os.DirFS(configDir), ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || (path.Ext(filePath) != ".yaml" && path.Ext(filePath) != ".yml") {
+			return nil
+		}
+
+		data, err := os.ReadFile(filepath.Join(configDir, filePath))
+		if err != nil {
+			return err
+		}
+
+		files[filePath] = data
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reading manifests from %s: %w", configDir, err)
+	}
+
+	for fileName, data := range additionalFiles {
+		files[fileName] = data
+	}
+
+	for filePath, data := range files {
+		destination := path.Join(manifestsDir, filePath)
+
+		if err := conn.Run(ctx, bytes.NewReader(data), io.Discard, stderr, fmt.Sprintf("mkdir -p %s && cat > %s", shellQuote(path.Dir(destination)), shellQuote(destination))); err != nil {
+			return fmt.Errorf("failed copying %s to machine: %w", filePath, err)
+		}
+	}
+
+	return nil
+}
+
+// installGardenadm pulls the given image on the machine and copies the contained gardenadm binary to gardenadmPath.
+func installGardenadm(ctx context.Context, conn machineConnection, image string, stdout, stderr io.Writer) error {
+	script := fmt.Sprintf(`set -o errexit
+set -o nounset
+
+image=%s
+tmp_dir="$(mktemp -d)"
+unmount() {
+  ctr images unmount "$tmp_dir" && rm -rf "$tmp_dir"
+}
+trap unmount EXIT
+
+ctr images pull  "$image" --hosts-dir "/etc/containerd/certs.d"
+ctr images mount "$image" "$tmp_dir"
+
+mkdir -p %s
+cp -f "$tmp_dir/ko-app/gardenadm" %s
+chmod +x %s
+`, shellQuote(image), shellQuote(path.Dir(gardenadmPath)), shellQuote(gardenadmPath), shellQuote(gardenadmPath))
+
+	if err := conn.Run(ctx, nil, stdout, stderr, script); err != nil {
+		return fmt.Errorf("failed installing gardenadm: %w", err)
+	}
+
+	return nil
+}
+
+// shellQuote quotes the given string for use in a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
@@ -13,8 +13,16 @@ import (
 
 // Options contains options for this command.
 type Options struct {
-	// Kubeconfig is the path to the kubeconfig file pointing to the KinD cluster.
+	// ConfigDir is the path to the directory containing the Gardener configuration resources (CloudProfile, Shoot,
+	// ControllerRegistrations, ControllerDeployments, etc.). The same resources are handed over to 'gardenadm init' on
+	// the first control plane machine.
+	ConfigDir string
+	// Kubeconfig is the path to the kubeconfig file pointing to an existing cluster which is used as bootstrap cluster.
+	// If it is empty, a temporary KinD cluster is created and deleted again afterwards.
 	Kubeconfig string
+	// GardenadmImage is the container image containing the gardenadm binary which is installed on the first control
+	// plane machine.
+	GardenadmImage string
 }
 
 // Complete completes the options.
@@ -28,13 +36,19 @@ func (o *Options) Complete() error {
 
 // Validate validates the options.
 func (o *Options) Validate() error {
-	if len(o.Kubeconfig) == 0 {
-		return fmt.Errorf("must provide a path to a KinD cluster kubeconfig")
+	if len(o.ConfigDir) == 0 {
+		return fmt.Errorf("must provide a path to a config directory")
+	}
+
+	if len(o.GardenadmImage) == 0 {
+		return fmt.Errorf("must provide a gardenadm image")
 	}
 
 	return nil
 }
 
 func (o *Options) addFlags(fs *pflag.FlagSet) {
-	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the KinD cluster")
+	fs.StringVarP(&o.ConfigDir, "config-dir", "d", "", "Path to the directory containing the Gardener configuration files, i.e., files containing resources like CloudProfile, Shoot, etc. Only files with .yaml or .yml extensions are considered.")
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to an existing cluster which is used as bootstrap cluster (defaults to the KUBECONFIG environment variable). If empty, a temporary KinD cluster is created and deleted afterwards.")
+	fs.StringVar(&o.GardenadmImage, "gardenadm-image", "", "Container image containing the gardenadm binary which is installed on the first control plane machine (the binary is expected at /ko-app/gardenadm)")
 }
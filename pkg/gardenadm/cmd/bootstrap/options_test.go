@@ -21,20 +21,52 @@ var _ = Describe("Options", func() {
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
+		It("should default the kubeconfig to the KUBECONFIG environment variable", func() {
+			GinkgoT().Setenv("KUBECONFIG", "/some/kubeconfig")
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.Kubeconfig).To(Equal("/some/kubeconfig"))
+		})
+
+		It("should leave the kubeconfig empty if the KUBECONFIG environment variable is not set", func() {
+			GinkgoT().Setenv("KUBECONFIG", "")
+
 			Expect(options.Complete()).To(Succeed())
+			Expect(options.Kubeconfig).To(BeEmpty())
+		})
+
+		It("should not overwrite the given kubeconfig", func() {
+			GinkgoT().Setenv("KUBECONFIG", "/some/kubeconfig")
+			options.Kubeconfig = "/other/kubeconfig"
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.Kubeconfig).To(Equal("/other/kubeconfig"))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should pass for valid options", func() {
-			options.Kubeconfig = "some-path-to-kubeconfig"
+		BeforeEach(func() {
+			options.ConfigDir = "manifests"
+			options.GardenadmImage = "registry.local/gardenadm:latest"
+		})
 
+		It("should succeed if all options are given", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
 
-		It("should fail because kubeconfig path is not set", func() {
-			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a KinD cluster kubeconfig")))
+		It("should succeed without kubeconfig", func() {
+			options.Kubeconfig = ""
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail if no config directory is given", func() {
+			options.ConfigDir = ""
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a config directory")))
+		})
+
+		It("should fail if no gardenadm image is given", func() {
+			options.GardenadmImage = ""
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a gardenadm image")))
 		})
 	})
 })
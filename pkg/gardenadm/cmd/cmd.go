@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
+)
+
+// NewClientSetFromFile creates a new uncached client set for the cluster the given kubeconfig file points to. The
+// provided scheme is used for the client. It is exposed as variable so that it can be replaced in unit tests.
+var NewClientSetFromFile = func(kubeconfigPath string, scheme *runtime.Scheme) (kubernetes.Interface, error) {
+	return kubernetes.NewClientFromFile("", kubeconfigPath,
+		kubernetes.WithClientOptions(client.Options{Scheme: scheme}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+}
+
+// TimeNow returns the current time. It is exposed as variable so that it can be replaced in unit tests.
+var TimeNow = time.Now
+
+// JoinCommand returns the 'gardenadm join' command for the given bootstrap token. The given CA data is used for
+// computing the hash of the CA certificate which is used by the joining node for verifying the API server. In case the
+// CA data contains multiple certificates, the first one is used.
+func JoinCommand(token string, caData []byte, address string) (string, error) {
+	if len(caData) == 0 {
+		return "", fmt.Errorf("the CA certificate is required for computing the join command")
+	}
+
+	caCert, err := utils.DecodeCertificate(caData)
+	if err != nil {
+		return "", fmt.Errorf("failed decoding CA certificate: %w", err)
+	}
+
+	return fmt.Sprintf("gardenadm join --bootstrap-token %s --ca-certificate-hash %s %s", token, bootstraptoken.CACertificateHash(caCert), address), nil
+}
@@ -7,9 +7,18 @@ package connect
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/logger"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -18,11 +27,15 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "connect",
-		Short: "Deploy a gardenlet for further cluster management",
-		Long:  "Deploy a gardenlet for further cluster management",
+		Short: "Register the autonomous shoot cluster with an existing garden cluster",
+		Long: "Register the autonomous shoot cluster with an existing garden cluster. " +
+			"The Shoot resource is created in the project namespace of the garden cluster and marked as self-hosted, so that it is never scheduled to a seed. " +
+			"Afterwards, the credentials of the cluster (e.g., the CA bundle) and its ShootState are migrated to the garden cluster, so that Gardener can take over the lifecycle operations of the cluster. " +
+			"Finally, a gardenlet is deployed into the cluster which is only permitted to access this Shoot in the garden cluster and keeps its ShootState up-to-date. " +
+			"The gardenlet's token for the garden cluster expires after 90 days, run this command again for renewing it.",
 
-		Example: `# Deploy a gardenlet
-gardenadm connect`,
+		Example: `# Register the autonomous shoot cluster with the garden cluster
+gardenadm connect --config-dir ./manifests --garden-kubeconfig ~/.kube/garden.yaml`,
 
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := opts.Complete(); err != nil {
@@ -42,7 +55,56 @@ gardenadm connect`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprintln(ioStreams.Out, "not implemented")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	log := logger.MustNewZapLogger(logger.InfoLevel, logger.FormatText, logzap.WriteTo(ioStreams.ErrOut))
+
+	resources, err := gardenadm.ReadManifests(os.DirFS(opts.ConfigDir))
+	if err != nil {
+		return fmt.Errorf("failed reading manifests from %s: %w", opts.ConfigDir, err)
+	}
+
+	clientSet, err := cmd.NewClientSetFromFile(opts.Kubeconfig, kubernetes.SeedScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	gardenClientSet, err := cmd.NewClientSetFromFile(opts.GardenKubeconfig, kubernetes.GardenScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating garden client: %w", err)
+	}
+
+	b, err := botanist.NewAutonomousBotanist(ctx, log, clientSet, resources)
+	if err != nil {
+		return fmt.Errorf("failed creating autonomous botanist: %w", err)
+	}
+
+	log.Info("Registering shoot in garden cluster")
+	if err := b.RegisterShoot(ctx, gardenClientSet.Client()); err != nil {
+		return err
+	}
+
+	// The secrets manager adopts the existing secrets in the cluster, i.e., no secrets are regenerated. However, the
+	// shoot's credentials (e.g., the CA bundle) are synced to the project namespace in the garden cluster.
+	log.Info("Migrating credentials to garden cluster")
+	if err := b.InitializeSecretsManagement(ctx); err != nil {
+		return fmt.Errorf("failed initializing secrets management: %w", err)
+	}
+
+	log.Info("Migrating ShootState to garden cluster")
+	if err := b.DeployShootState(ctx); err != nil {
+		return fmt.Errorf("failed deploying ShootState: %w", err)
+	}
+
+	log.Info("Deploying gardenlet")
+	if err := b.DeployGardenlet(ctx, gardenClientSet.RESTConfig()); err != nil {
+		return fmt.Errorf("failed deploying gardenlet: %w", err)
+	}
+
+	fmt.Fprintf(ioStreams.Out, `Your autonomous shoot cluster has been registered as shoot %s in the garden cluster!
+
+The gardenlet deployed to the cluster authenticates to the garden cluster with a token which expires after %d days.
+Run 'gardenadm connect' again for renewing it.
+`, client.ObjectKeyFromObject(b.Shoot.GetInfo()), int(botanist.GardenletTokenExpiration.Hours()/24))
+
 	return nil
 }
@@ -5,16 +5,57 @@
 package connect
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
 )
 
 // Options contains options for this command.
-type Options struct{}
+type Options struct {
+	// ConfigDir is the path to the directory containing the Gardener configuration resources (CloudProfile, Shoot,
+	// ControllerRegistrations, ControllerDeployments, etc.) which were used for 'gardenadm init'.
+	ConfigDir string
+	// GardenKubeconfig is the path to the kubeconfig file pointing to the garden cluster.
+	GardenKubeconfig string
+	// Kubeconfig is the path to the kubeconfig file pointing to the autonomous shoot cluster.
+	Kubeconfig string
+}
 
 // Complete completes the options.
-func (o *Options) Complete() error { return nil }
+func (o *Options) Complete() error {
+	if o.Kubeconfig == "" {
+		o.Kubeconfig = os.Getenv("KUBECONFIG")
+	}
+
+	if o.Kubeconfig == "" {
+		o.Kubeconfig = botanist.PathKubeconfigAdmin
+	}
+
+	return nil
+}
 
 // Validate validates the options.
-func (o *Options) Validate() error { return nil }
+func (o *Options) Validate() error {
+	if len(o.ConfigDir) == 0 {
+		return fmt.Errorf("must provide a path to a config directory")
+	}
+
+	if len(o.GardenKubeconfig) == 0 {
+		return fmt.Errorf("must provide a path to a garden cluster kubeconfig")
+	}
+
+	if len(o.Kubeconfig) == 0 {
+		return fmt.Errorf("must provide a path to a kubeconfig")
+	}
+
+	return nil
+}
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ConfigDir, "config-dir", "d", "", "Path to the directory containing the Gardener configuration files which were used for 'gardenadm init', i.e., files containing resources like CloudProfile, Shoot, etc. Only files with .yaml or .yml extensions are considered.")
+	fs.StringVarP(&o.GardenKubeconfig, "garden-kubeconfig", "g", "", "Path to the kubeconfig file pointing to the garden cluster")
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the autonomous shoot cluster (defaults to the KUBECONFIG environment variable or "+botanist.PathKubeconfigAdmin+")")
+}
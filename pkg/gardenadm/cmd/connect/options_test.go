@@ -21,14 +21,53 @@ var _ = Describe("Options", func() {
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
+		It("should default the kubeconfig to the KUBECONFIG environment variable", func() {
+			GinkgoT().Setenv("KUBECONFIG", "/some/kubeconfig")
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.Kubeconfig).To(Equal("/some/kubeconfig"))
+		})
+
+		It("should default the kubeconfig to the admin kubeconfig", func() {
+			GinkgoT().Setenv("KUBECONFIG", "")
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.Kubeconfig).To(Equal("/etc/kubernetes/admin.conf"))
+		})
+
+		It("should not overwrite the given kubeconfig", func() {
+			GinkgoT().Setenv("KUBECONFIG", "/some/kubeconfig")
+			options.Kubeconfig = "/other/kubeconfig"
+
 			Expect(options.Complete()).To(Succeed())
+			Expect(options.Kubeconfig).To(Equal("/other/kubeconfig"))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should return nil", func() {
+		BeforeEach(func() {
+			options.ConfigDir = "manifests"
+			options.GardenKubeconfig = "garden.yaml"
+			options.Kubeconfig = "kubeconfig.yaml"
+		})
+
+		It("should succeed if all options are given", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
+
+		It("should fail if no config directory is given", func() {
+			options.ConfigDir = ""
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a config directory")))
+		})
+
+		It("should fail if no garden kubeconfig is given", func() {
+			options.GardenKubeconfig = ""
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a garden cluster kubeconfig")))
+		})
+
+		It("should fail if no kubeconfig is given", func() {
+			options.Kubeconfig = ""
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a kubeconfig")))
+		})
 	})
 })
@@ -7,9 +7,26 @@ package discover
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,10 +36,14 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "discover",
 		Short: "Conveniently download Gardener configuration resources from an existing garden cluster",
-		Long:  "Conveniently download Gardener configuration resources from an existing garden cluster (CloudProfile, ControllerRegistrations, ControllerDeployments, etc.)",
+		Long: "Conveniently download Gardener configuration resources from an existing garden cluster (CloudProfile, ControllerRegistrations, ControllerDeployments, etc.). " +
+			"Based on the given Shoot manifest, all resources required for bootstrapping it are written to the output directory which can be consumed by 'gardenadm init'.",
+
+		Example: `# Download the configuration for the given shoot into the directory of the shoot manifest
+gardenadm discover --kubeconfig ~/.kube/config --manifest ./manifests/shoot.yaml
 
-		Example: `# Download the configuration
-gardenadm discover --kubeconfig ~/.kube/config`,
+# Download the configuration into another directory, leaving out the data of the credentials secret
+gardenadm discover --kubeconfig ~/.kube/config --manifest ./shoot.yaml --output-dir ./manifests --omit-secret-data`,
 
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := opts.Complete(); err != nil {
@@ -42,7 +63,245 @@ gardenadm discover --kubeconfig ~/.kube/config`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprintln(ioStreams.Out, "not implemented")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	shoot, err := readShoot(opts.ShootManifest)
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := cmd.NewClientSetFromFile(opts.Kubeconfig, kubernetes.GardenScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating garden client: %w", err)
+	}
+
+	var objects []client.Object
+
+	if filepath.Clean(opts.OutputDir) != filepath.Clean(filepath.Dir(opts.ShootManifest)) {
+		objects = append(objects, shoot)
+	}
+
+	cloudProfiles, err := discoverCloudProfile(ctx, clientSet.Client(), shoot)
+	if err != nil {
+		return err
+	}
+	objects = append(objects, cloudProfiles...)
+
+	extensions, err := discoverExtensions(ctx, clientSet.Client(), shoot)
+	if err != nil {
+		return err
+	}
+	objects = append(objects, extensions...)
+
+	credentials, err := discoverCredentials(ctx, clientSet.Client(), shoot, opts.OmitSecretData)
+	if err != nil {
+		return err
+	}
+	objects = append(objects, credentials...)
+
+	if err := os.MkdirAll(opts.OutputDir, 0700); err != nil {
+		return fmt.Errorf("failed creating output directory %s: %w", opts.OutputDir, err)
+	}
+
+	for _, obj := range objects {
+		path, err := writeManifest(opts.OutputDir, obj)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(ioStreams.Out, "Wrote %s\n", path)
+	}
+
 	return nil
 }
+
+func readShoot(path string) (*gardencorev1beta1.Shoot, error) {
+	data, err := os.ReadFile(path) // #nosec: G304 -- The path is provided by the user on purpose.
+	if err != nil {
+		return nil, fmt.Errorf("failed reading shoot manifest %s: %w", path, err)
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if _, _, err := kubernetes.GardenCodec.UniversalDeserializer().Decode(data, nil, shoot); err != nil {
+		return nil, fmt.Errorf("failed decoding shoot manifest %s: %w", path, err)
+	}
+
+	if len(shoot.Namespace) == 0 {
+		return nil, fmt.Errorf("shoot manifest %s must specify the namespace of the shoot", path)
+	}
+
+	return shoot, nil
+}
+
+func discoverCloudProfile(ctx context.Context, c client.Client, shoot *gardencorev1beta1.Shoot) ([]client.Object, error) {
+	cloudProfileReference := gardenerutils.BuildCloudProfileReference(shoot)
+	if cloudProfileReference == nil {
+		return nil, fmt.Errorf("shoot does not reference a cloud profile")
+	}
+
+	cloudProfileName := cloudProfileReference.Name
+	var objects []client.Object
+
+	if cloudProfileReference.Kind == v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile {
+		namespacedCloudProfile := &gardencorev1beta1.NamespacedCloudProfile{}
+		if err := c.Get(ctx, client.ObjectKey{Name: cloudProfileReference.Name, Namespace: shoot.Namespace}, namespacedCloudProfile); err != nil {
+			return nil, fmt.Errorf("failed reading NamespacedCloudProfile %s: %w", cloudProfileReference.Name, err)
+		}
+		objects = append(objects, namespacedCloudProfile)
+
+		cloudProfileName = namespacedCloudProfile.Spec.Parent.Name
+	}
+
+	cloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := c.Get(ctx, client.ObjectKey{Name: cloudProfileName}, cloudProfile); err != nil {
+		return nil, fmt.Errorf("failed reading CloudProfile %s: %w", cloudProfileName, err)
+	}
+
+	return append([]client.Object{cloudProfile}, objects...), nil
+}
+
+func discoverExtensions(ctx context.Context, c client.Client, shoot *gardencorev1beta1.Shoot) ([]client.Object, error) {
+	controllerRegistrationList := &gardencorev1beta1.ControllerRegistrationList{}
+	if err := c.List(ctx, controllerRegistrationList); err != nil {
+		return nil, fmt.Errorf("failed listing ControllerRegistrations: %w", err)
+	}
+
+	// The control plane of an autonomous shoot runs in the shoot cluster itself, i.e., the shoot acts as its own seed.
+	seed := &gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{Type: shoot.Spec.Provider.Type}}}
+	requiredExtensions := gardenerutils.ComputeRequiredExtensionsForShoot(shoot, seed, controllerRegistrationList, nil, nil)
+
+	var (
+		objects                   []client.Object
+		controllerDeploymentNames = sets.New[string]()
+	)
+
+	for _, controllerRegistration := range controllerRegistrationList.Items {
+		if !isControllerRegistrationRequired(controllerRegistration, requiredExtensions) {
+			continue
+		}
+
+		objects = append(objects, controllerRegistration.DeepCopy())
+
+		if controllerRegistration.Spec.Deployment != nil {
+			for _, deploymentRef := range controllerRegistration.Spec.Deployment.DeploymentRefs {
+				controllerDeploymentNames.Insert(deploymentRef.Name)
+			}
+		}
+	}
+
+	for _, name := range sets.List(controllerDeploymentNames) {
+		controllerDeployment := &gardencorev1.ControllerDeployment{}
+		if err := c.Get(ctx, client.ObjectKey{Name: name}, controllerDeployment); err != nil {
+			return nil, fmt.Errorf("failed reading ControllerDeployment %s: %w", name, err)
+		}
+		objects = append(objects, controllerDeployment)
+	}
+
+	return objects, nil
+}
+
+func isControllerRegistrationRequired(controllerRegistration gardencorev1beta1.ControllerRegistration, requiredExtensions sets.Set[string]) bool {
+	if deployment := controllerRegistration.Spec.Deployment; deployment != nil && deployment.Policy != nil &&
+		*deployment.Policy != gardencorev1beta1.ControllerDeploymentPolicyOnDemand {
+		return true
+	}
+
+	for _, resource := range controllerRegistration.Spec.Resources {
+		if requiredExtensions.Has(gardenerutils.ExtensionsID(resource.Kind, resource.Type)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func discoverCredentials(ctx context.Context, c client.Client, shoot *gardencorev1beta1.Shoot, omitSecretData bool) ([]client.Object, error) {
+	var (
+		objects        []client.Object
+		credentialsRef corev1.ObjectReference
+	)
+
+	switch {
+	case shoot.Spec.CredentialsBindingName != nil:
+		credentialsBinding := &securityv1alpha1.CredentialsBinding{}
+		if err := c.Get(ctx, client.ObjectKey{Name: *shoot.Spec.CredentialsBindingName, Namespace: shoot.Namespace}, credentialsBinding); err != nil {
+			return nil, fmt.Errorf("failed reading CredentialsBinding %s: %w", *shoot.Spec.CredentialsBindingName, err)
+		}
+		objects = append(objects, credentialsBinding)
+		credentialsRef = credentialsBinding.CredentialsRef
+
+	case shoot.Spec.SecretBindingName != nil:
+		secretBinding := &gardencorev1beta1.SecretBinding{}
+		if err := c.Get(ctx, client.ObjectKey{Name: *shoot.Spec.SecretBindingName, Namespace: shoot.Namespace}, secretBinding); err != nil {
+			return nil, fmt.Errorf("failed reading SecretBinding %s: %w", *shoot.Spec.SecretBindingName, err)
+		}
+		objects = append(objects, secretBinding)
+		credentialsRef = corev1.ObjectReference{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Secret",
+			Name:       secretBinding.SecretRef.Name,
+			Namespace:  secretBinding.SecretRef.Namespace,
+		}
+
+	default:
+		// Workerless shoots do not need credentials.
+		return nil, nil
+	}
+
+	if len(credentialsRef.Namespace) == 0 {
+		credentialsRef.Namespace = shoot.Namespace
+	}
+	key := client.ObjectKey{Name: credentialsRef.Name, Namespace: credentialsRef.Namespace}
+
+	switch {
+	case credentialsRef.APIVersion == corev1.SchemeGroupVersion.String() && credentialsRef.Kind == "Secret":
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, key, secret); err != nil {
+			return nil, fmt.Errorf("failed reading credentials secret %s: %w", key, err)
+		}
+		if omitSecretData {
+			for k := range secret.Data {
+				secret.Data[k] = nil
+			}
+		}
+		objects = append(objects, secret)
+
+	case credentialsRef.APIVersion == securityv1alpha1.SchemeGroupVersion.String() && credentialsRef.Kind == "WorkloadIdentity":
+		workloadIdentity := &securityv1alpha1.WorkloadIdentity{}
+		if err := c.Get(ctx, key, workloadIdentity); err != nil {
+			return nil, fmt.Errorf("failed reading credentials workload identity %s: %w", key, err)
+		}
+		objects = append(objects, workloadIdentity)
+
+	default:
+		return nil, fmt.Errorf("unsupported credentials reference %s %s", credentialsRef.APIVersion, credentialsRef.Kind)
+	}
+
+	return objects, nil
+}
+
+func writeManifest(dir string, obj client.Object) (string, error) {
+	gvk, err := apiutil.GVKForObject(obj, kubernetes.GardenScheme)
+	if err != nil {
+		return "", fmt.Errorf("failed determining GVK for object %s: %w", client.ObjectKeyFromObject(obj), err)
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	// Drop server-populated metadata which must not be contained in manifests that are applied again later.
+	obj.SetResourceVersion("")
+	obj.SetUID("")
+	obj.SetGeneration(0)
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetManagedFields(nil)
+	obj.SetOwnerReferences(nil)
+
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("failed marshalling %s %s: %w", gvk.Kind, client.ObjectKeyFromObject(obj), err)
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.yaml", strings.ToLower(gvk.Kind), obj.GetName()))
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", fmt.Errorf("failed writing manifest file %s: %w", path, err)
+	}
+
+	return path, nil
+}
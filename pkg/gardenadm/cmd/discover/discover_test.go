@@ -5,38 +5,205 @@
 package discover_test
 
 import (
-	"bytes"
-	"io"
+	"context"
+	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
 
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/discover"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("Discover", func() {
 	var (
-		ioStreams genericiooptions.IOStreams
-		out       *bytes.Buffer
-		cmd       *cobra.Command
+		ctx          context.Context
+		ioStreams    genericiooptions.IOStreams
+		cmd          *cobra.Command
+		fakeClient   client.Client
+		manifestDir  string
+		outputDir    string
+		shootPath    string
+		shoot        *gardencorev1beta1.Shoot
+		cloudProfile *gardencorev1beta1.CloudProfile
 	)
 
 	BeforeEach(func() {
-		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
+		ctx = context.Background()
+		ioStreams, _, _, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		DeferCleanup(test.WithVar(&gardenadmcmd.NewClientSetFromFile, func(string, *runtime.Scheme) (kubernetes.Interface, error) {
+			return fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(), nil
+		}))
+
+		manifestDir = GinkgoT().TempDir()
+		outputDir = GinkgoT().TempDir()
+
+		shoot = &gardencorev1beta1.Shoot{
+			TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+			ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "garden"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfile:           &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "local"},
+				CredentialsBindingName: ptr.To("local"),
+				Networking:             &gardencorev1beta1.Networking{Type: ptr.To("calico")},
+				Provider: gardencorev1beta1.Provider{
+					Type: "local",
+					Workers: []gardencorev1beta1.Worker{{
+						Name:    "control-plane",
+						Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "local"}},
+					}},
+				},
+			},
+		}
+
+		shootYAML, err := yaml.Marshal(shoot)
+		Expect(err).NotTo(HaveOccurred())
+		shootPath = filepath.Join(manifestDir, "shoot.yaml")
+		Expect(os.WriteFile(shootPath, shootYAML, 0600)).To(Succeed())
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "local"}, Spec: gardencorev1beta1.CloudProfileSpec{Type: "local"}}
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+		for _, obj := range []client.Object{
+			&gardencorev1beta1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-local"},
+				Spec: gardencorev1beta1.ControllerRegistrationSpec{
+					Resources: []gardencorev1beta1.ControllerResource{
+						{Kind: extensionsv1alpha1.InfrastructureResource, Type: "local"},
+						{Kind: extensionsv1alpha1.OperatingSystemConfigResource, Type: "local"},
+					},
+					Deployment: &gardencorev1beta1.ControllerRegistrationDeployment{DeploymentRefs: []gardencorev1beta1.DeploymentRef{{Name: "provider-local"}}},
+				},
+			},
+			&gardencorev1beta1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "networking-calico"},
+				Spec: gardencorev1beta1.ControllerRegistrationSpec{
+					Resources: []gardencorev1beta1.ControllerResource{{Kind: extensionsv1alpha1.NetworkResource, Type: "calico"}},
+				},
+			},
+			&gardencorev1beta1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-other"},
+				Spec: gardencorev1beta1.ControllerRegistrationSpec{
+					Resources:  []gardencorev1beta1.ControllerResource{{Kind: extensionsv1alpha1.InfrastructureResource, Type: "other"}},
+					Deployment: &gardencorev1beta1.ControllerRegistrationDeployment{DeploymentRefs: []gardencorev1beta1.DeploymentRef{{Name: "provider-other"}}},
+				},
+			},
+			&gardencorev1.ControllerDeployment{ObjectMeta: metav1.ObjectMeta{Name: "provider-local"}},
+			&gardencorev1.ControllerDeployment{ObjectMeta: metav1.ObjectMeta{Name: "provider-other"}},
+			&securityv1alpha1.CredentialsBinding{
+				ObjectMeta:     metav1.ObjectMeta{Name: "local", Namespace: "garden"},
+				CredentialsRef: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Name: "local-credentials", Namespace: "garden"},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "local-credentials", Namespace: "garden"},
+				Data:       map[string][]byte{"token": []byte("secret")},
+			},
+		} {
+			Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+		}
+
+		Expect(cmd.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+		Expect(cmd.Flags().Set("manifest", shootPath)).To(Succeed())
 	})
 
+	readDir := func(dir string) []string {
+		entries, err := os.ReadDir(dir)
+		Expect(err).NotTo(HaveOccurred())
+
+		var names []string
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		return names
+	}
+
+	readSecret := func(path string) *corev1.Secret {
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		secret := &corev1.Secret{}
+		Expect(yaml.Unmarshal(data, secret)).To(Succeed())
+		return secret
+	}
+
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(cmd.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+		It("should write the required resources into the directory of the shoot manifest", func() {
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+			Expect(readDir(manifestDir)).To(ConsistOf(
+				"shoot.yaml",
+				"cloudprofile-local.yaml",
+				"controllerregistration-provider-local.yaml",
+				"controllerregistration-networking-calico.yaml",
+				"controllerdeployment-provider-local.yaml",
+				"credentialsbinding-local.yaml",
+				"secret-local-credentials.yaml",
+			))
+
+			secret := readSecret(filepath.Join(manifestDir, "secret-local-credentials.yaml"))
+			Expect(secret.Data).To(HaveKeyWithValue("token", []byte("secret")))
+			Expect(secret.ResourceVersion).To(BeEmpty())
+		})
+
+		It("should write the shoot into a different output directory and omit the secret data", func() {
+			Expect(cmd.Flags().Set("output-dir", outputDir)).To(Succeed())
+			Expect(cmd.Flags().Set("omit-secret-data", "true")).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+			Expect(readDir(outputDir)).To(ContainElements("shoot-root.yaml", "secret-local-credentials.yaml"))
+			Expect(readSecret(filepath.Join(outputDir, "secret-local-credentials.yaml")).Data).To(HaveKeyWithValue("token", BeEmpty()))
+		})
+
+		It("should write the NamespacedCloudProfile and its parent", func() {
+			shoot.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "custom"}
+			shootYAML, err := yaml.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.WriteFile(shootPath, shootYAML, 0600)).To(Succeed())
+
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.NamespacedCloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "garden"},
+				Spec:       gardencorev1beta1.NamespacedCloudProfileSpec{Parent: gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "local"}},
+			})).To(Succeed())
 
 			Expect(cmd.RunE(cmd, nil)).To(Succeed())
 
-			output, err := io.ReadAll(out)
+			Expect(readDir(manifestDir)).To(ContainElements("cloudprofile-local.yaml", "namespacedcloudprofile-custom.yaml"))
+		})
+
+		It("should fail if the cloud profile does not exist", func() {
+			Expect(fakeClient.Delete(ctx, cloudProfile)).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("failed reading CloudProfile local")))
+		})
+
+		It("should fail if the shoot manifest does not specify a namespace", func() {
+			shoot.Namespace = ""
+			shootYAML, err := yaml.Marshal(shoot)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented\n"))
+			Expect(os.WriteFile(shootPath, shootYAML, 0600)).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("must specify the namespace")))
 		})
 	})
 })
@@ -6,6 +6,7 @@ package discover
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/spf13/pflag"
 )
@@ -14,10 +15,23 @@ import (
 type Options struct {
 	// Kubeconfig is the path to the kubeconfig file pointing to the garden cluster.
 	Kubeconfig string
+	// ShootManifest is the path to the Shoot manifest file for which the configuration shall be downloaded.
+	ShootManifest string
+	// OutputDir is the path to the directory into which the downloaded manifests shall be written. Defaults to the
+	// directory containing the Shoot manifest.
+	OutputDir string
+	// OmitSecretData specifies whether the data of the referenced credentials secrets shall be left out.
+	OmitSecretData bool
 }
 
 // Complete completes the options.
-func (o *Options) Complete() error { return nil }
+func (o *Options) Complete() error {
+	if len(o.OutputDir) == 0 && len(o.ShootManifest) > 0 {
+		o.OutputDir = filepath.Dir(o.ShootManifest)
+	}
+
+	return nil
+}
 
 // Validate validates the options.
 func (o *Options) Validate() error {
@@ -25,9 +39,20 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("must provide a path to a garden cluster kubeconfig")
 	}
 
+	if len(o.ShootManifest) == 0 {
+		return fmt.Errorf("must provide a path to a shoot manifest file")
+	}
+
+	if len(o.OutputDir) == 0 {
+		return fmt.Errorf("must provide a path to an output directory")
+	}
+
 	return nil
 }
 
 func (o *Options) addFlags(fs *pflag.FlagSet) {
 	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the garden cluster")
+	fs.StringVarP(&o.ShootManifest, "manifest", "m", "", "Path to the shoot manifest file")
+	fs.StringVarP(&o.OutputDir, "output-dir", "o", "", "Path to the directory into which the downloaded manifests shall be written (defaults to the directory of the shoot manifest)")
+	fs.BoolVar(&o.OmitSecretData, "omit-secret-data", false, "Do not include the data of the referenced credentials secrets in the downloaded manifests")
 }
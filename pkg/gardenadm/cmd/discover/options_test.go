@@ -21,20 +21,49 @@ var _ = Describe("Options", func() {
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
+		It("should default the output directory to the directory of the shoot manifest", func() {
+			options.ShootManifest = "some/dir/shoot.yaml"
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.OutputDir).To(Equal("some/dir"))
+		})
+
+		It("should not overwrite the output directory", func() {
+			options.ShootManifest = "some/dir/shoot.yaml"
+			options.OutputDir = "other/dir"
+
 			Expect(options.Complete()).To(Succeed())
+			Expect(options.OutputDir).To(Equal("other/dir"))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should pass for valid options", func() {
+		BeforeEach(func() {
 			options.Kubeconfig = "some-path-to-kubeconfig"
+			options.ShootManifest = "some-path-to-shoot-manifest"
+			options.OutputDir = "some-output-dir"
+		})
 
+		It("should pass for valid options", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
 
 		It("should fail because kubeconfig path is not set", func() {
+			options.Kubeconfig = ""
+
 			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a garden cluster kubeconfig")))
 		})
+
+		It("should fail because shoot manifest path is not set", func() {
+			options.ShootManifest = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a shoot manifest file")))
+		})
+
+		It("should fail because output directory is not set", func() {
+			options.OutputDir = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to an output directory")))
+		})
 	})
 })
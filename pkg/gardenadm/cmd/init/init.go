@@ -7,9 +7,35 @@ package init
 import (
 	"context"
 	"fmt"
+	"net"
+	"os"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+const (
+	// joinTokenValidity is the validity of the bootstrap token contained in the printed join command.
+	joinTokenValidity = 24 * time.Hour
+	// apiServerTimeout is the maximum duration to wait for the API server to become available.
+	apiServerTimeout = 10 * time.Minute
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,10 +45,13 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "init",
 		Short: "Bootstrap the first control plane node",
-		Long:  "Bootstrap the first control plane node",
+		Long: "Bootstrap the first control plane node of an autonomous shoot cluster. " +
+			"Based on the Gardener configuration resources in the given directory (see 'gardenadm discover'), the control plane components (etcd, kube-apiserver, kube-controller-manager, kube-scheduler, and gardener-resource-manager) are started as static pods, " +
+			"gardener-node-agent is set up on the node, and the required extensions are deployed. " +
+			"Finally, the command for joining further nodes is printed.",
 
 		Example: `# Bootstrap the first control plane node
-gardenadm init`,
+gardenadm init --config-dir ./manifests`,
 
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := opts.Complete(); err != nil {
@@ -42,7 +71,229 @@ gardenadm init`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprintln(ioStreams.Out, "not implemented")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	log := logger.MustNewZapLogger(logger.InfoLevel, logger.FormatText, logzap.WriteTo(ioStreams.ErrOut))
+
+	resources, err := gardenadm.ReadManifests(os.DirFS(opts.ConfigDir))
+	if err != nil {
+		return fmt.Errorf("failed reading manifests from %s: %w", opts.ConfigDir, err)
+	}
+
+	// The API server is not running yet, hence the control plane components are rendered with a fake client first and
+	// started as static pods.
+	b, err := botanist.NewAutonomousBotanist(ctx, log, nil, resources)
+	if err != nil {
+		return fmt.Errorf("failed creating autonomous botanist: %w", err)
+	}
+
+	// The joining nodes cannot resolve the internal domain of the API server yet, hence the address of this node is
+	// used for discovering the cluster. It is also the address on which the etcd member of this node is reachable for
+	// the etcd members of further control plane nodes.
+	hostIP, err := utilnet.ChooseHostInterface()
+	if err != nil {
+		return fmt.Errorf("failed determining host IP address: %w", err)
+	}
+
+	oscSecret, workerOSCSecrets, controlPlaneSecretFiles, err := bootstrapControlPlane(ctx, log, b, hostIP.String())
+	if err != nil {
+		return err
+	}
+
+	log.Info("Waiting for API server to become available")
+	clientSet, err := waitForAPIServer(ctx, botanist.PathKubeconfigAdmin)
+	if err != nil {
+		return err
+	}
+
+	// From now on, the API server is running, hence a botanist based on the real client is used.
+	bCluster, err := botanist.NewAutonomousBotanist(ctx, log, clientSet, b.Resources)
+	if err != nil {
+		return fmt.Errorf("failed creating autonomous botanist: %w", err)
+	}
+
+	if err := setUpCluster(ctx, log, b, bCluster, oscSecret, workerOSCSecrets); err != nil {
+		return err
+	}
+
+	// The secret files of the control plane (e.g., the private keys of the certificate authorities) are handed over to
+	// further control plane nodes encrypted with a key which is only printed here, i.e., a bootstrap token alone does not
+	// grant access to them.
+	certificateKey, err := botanist.GenerateCertificateKey()
+	if err != nil {
+		return err
+	}
+
+	log.Info("Uploading encrypted control plane secret files")
+	if err := bCluster.UploadControlPlaneSecretFiles(ctx, controlPlaneSecretFiles, certificateKey); err != nil {
+		return err
+	}
+
+	joinCommand, err := createJoinCommand(ctx, bCluster, hostIP.String())
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ioStreams.Out, `Your autonomous shoot cluster control plane has initialized successfully!
+
+To start using your cluster, run:
+
+  export KUBECONFIG=%s
+
+You can now join any number of worker nodes by running the following on each node as root:
+
+  %[2]s
+
+You can join further control plane nodes by running the following on each node as root:
+
+  %[2]s --control-plane --certificate-key %[3]s
+
+Please note that the certificate key gives access to confidential data of the cluster (e.g., the private keys of the
+certificate authorities). Keep it secret.
+`, botanist.PathKubeconfigAdmin, joinCommand, certificateKey)
+
+	return nil
+}
+
+func bootstrapControlPlane(ctx context.Context, log logr.Logger, b *botanist.AutonomousBotanist, hostIP string) (*corev1.Secret, []*corev1.Secret, []extensionsv1alpha1.File, error) {
+	// If the machines were created by 'gardenadm bootstrap', the secrets generated in the bootstrap cluster (e.g., the
+	// certificate authorities and the SSH key pair) are handed over and must be reused.
+	if err := b.RestoreSecretsFromShootState(ctx); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed restoring secrets from ShootState: %w", err)
+	}
+
+	log.Info("Initializing secrets management")
+	if err := b.InitializeSecretsManagement(ctx); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed initializing secrets management: %w", err)
+	}
+
+	log.Info("Rendering control plane components")
+	controlPlaneFiles, controlPlaneSecretFiles, err := b.DeployControlPlane(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	log.Info("Computing operating system configs")
+	oscSecret, err := b.ComputeOperatingSystemConfig(ctx, controlPlaneFiles)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	workerOSCSecrets, err := b.ComputeWorkerOperatingSystemConfigs(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	log.Info("Writing kubeconfigs")
+	if err := b.WriteKubeconfigs(ctx); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := b.EnsureAPIServerHostsEntry("127.0.0.1"); err != nil {
+		return nil, nil, nil, err
+	}
+
+	log.Info("Writing etcd member configuration")
+	if err := b.WriteEtcdMemberConfig(hostIP); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// The secret files are not part of the operating system config since it is readable with bootstrap tokens, hence
+	// they are written separately before the static pods are started.
+	log.Info("Writing control plane secret files")
+	if err := botanist.WriteFiles(b.FS, controlPlaneSecretFiles); err != nil {
+		return nil, nil, nil, err
+	}
+
+	log.Info("Applying operating system config")
+	if err := b.ApplyOperatingSystemConfig(ctx, oscSecret); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return oscSecret, workerOSCSecrets, controlPlaneSecretFiles, nil
+}
+
+func waitForAPIServer(ctx context.Context, kubeconfigPath string) (kubernetes.Interface, error) {
+	var clientSet kubernetes.Interface
+
+	if err := retryutils.UntilTimeout(ctx, 5*time.Second, apiServerTimeout, func(_ context.Context) (bool, error) {
+		var err error
+		if clientSet, err = cmd.NewClientSetFromFile(kubeconfigPath, kubernetes.SeedScheme); err != nil {
+			return retryutils.MinorError(fmt.Errorf("API server is not yet available: %w", err))
+		}
+		return retryutils.Ok()
+	}); err != nil {
+		return nil, err
+	}
+
+	return clientSet, nil
+}
+
+func setUpCluster(ctx context.Context, log logr.Logger, bBootstrap, b *botanist.AutonomousBotanist, oscSecret *corev1.Secret, workerOSCSecrets []*corev1.Secret) error {
+	log.Info("Migrating secrets into cluster")
+	if err := b.MigrateSecrets(ctx, bBootstrap.SeedClientSet.Client()); err != nil {
+		return err
+	}
+
+	// The state handed over by 'gardenadm bootstrap' must be kept in the cluster since the bootstrap cluster is deleted
+	// once 'gardenadm init' has succeeded.
+	log.Info("Persisting handed over ShootState")
+	if err := b.PersistShootState(ctx); err != nil {
+		return err
+	}
+
+	log.Info("Deploying extension CRDs")
+	if err := b.DeployExtensionCRDs(ctx); err != nil {
+		return fmt.Errorf("failed deploying extension CRDs: %w", err)
+	}
+
+	log.Info("Initializing secrets management")
+	if err := b.InitializeSecretsManagement(ctx); err != nil {
+		return fmt.Errorf("failed initializing secrets management: %w", err)
+	}
+
+	log.Info("Deploying resources for gardener-node-agent")
+	if err := b.DeployNodeAgentResources(ctx, oscSecret, workerOSCSecrets); err != nil {
+		return err
+	}
+
+	log.Info("Deploying extensions")
+	if err := b.DeployExtensions(ctx); err != nil {
+		return err
+	}
+
+	log.Info("Publishing cluster information")
+	if err := b.ReconcileClusterInfo(ctx); err != nil {
+		return err
+	}
+
+	log.Info("Bootstrapping gardener-node-agent")
+	if err := b.WriteNodeAgentBootstrapToken(ctx); err != nil {
+		return err
+	}
+
+	log.Info("Waiting for node to be registered")
+	if _, err := b.WaitUntilNodeRegistered(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+func createJoinCommand(ctx context.Context, b *botanist.AutonomousBotanist, hostIP string) (string, error) {
+	caBundleSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameCACluster)
+	if !found {
+		return "", fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCACluster)
+	}
+
+	tokenID, err := utils.GenerateRandomStringFromCharset(6, "0123456789abcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		return "", fmt.Errorf("failed generating bootstrap token ID: %w", err)
+	}
+
+	secret, err := bootstraptoken.ComputeBootstrapToken(ctx, b.SeedClientSet.Client(), tokenID, "Used for joining nodes via 'gardenadm join'", joinTokenValidity)
+	if err != nil {
+		return "", fmt.Errorf("failed creating bootstrap token: %w", err)
+	}
+
+	return cmd.JoinCommand(bootstraptoken.FromSecretData(secret.Data), caBundleSecret.Data[secretsutils.DataKeyCertificateBundle], "https://"+net.JoinHostPort(hostIP, "443"))
+}
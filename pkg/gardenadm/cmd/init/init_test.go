@@ -5,8 +5,9 @@
 package init_test
 
 import (
-	"bytes"
-	"io"
+	"context"
+	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -19,22 +20,30 @@ import (
 var _ = Describe("Init", func() {
 	var (
 		ioStreams genericiooptions.IOStreams
-		out       *bytes.Buffer
 		cmd       *cobra.Command
 	)
 
 	BeforeEach(func() {
-		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
+		ioStreams, _, _, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(context.Background())
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+		It("should fail if no config directory is given", func() {
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("must provide a path to a config directory")))
+		})
+
+		It("should fail if the config directory does not contain a shoot", func() {
+			configDir := GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(configDir, "cloudprofile.yaml"), []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: CloudProfile
+metadata:
+  name: local
+`), 0600)).To(Succeed())
 
-			output, err := io.ReadAll(out)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented\n"))
+			Expect(cmd.Flags().Set("config-dir", configDir)).To(Succeed())
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("must provide a *gardencorev1beta1.Shoot resource")))
 		})
 	})
 })
@@ -5,16 +5,30 @@
 package init
 
 import (
+	"fmt"
+
 	"github.com/spf13/pflag"
 )
 
 // Options contains options for this command.
-type Options struct{}
+type Options struct {
+	// ConfigDir is the path to the directory containing the Gardener configuration resources (CloudProfile, Shoot,
+	// ControllerRegistrations, ControllerDeployments, etc.).
+	ConfigDir string
+}
 
 // Complete completes the options.
 func (o *Options) Complete() error { return nil }
 
 // Validate validates the options.
-func (o *Options) Validate() error { return nil }
+func (o *Options) Validate() error {
+	if len(o.ConfigDir) == 0 {
+		return fmt.Errorf("must provide a path to a config directory")
+	}
+
+	return nil
+}
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ConfigDir, "config-dir", "d", "", "Path to a directory containing the Gardener configuration files for the init command, i.e., files containing resources like CloudProfile, Shoot, etc. Only files with .yaml or .yml extensions are considered.")
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package join
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// etcdTimeout is the maximum duration to wait for the etcd member of this node to catch up with the cluster.
+const etcdTimeout = 5 * time.Minute
+
+// fetchAndWriteControlPlaneSecretFiles fetches the encrypted secret files of the control plane static pods (see
+// botanist.UploadControlPlaneSecretFiles), decrypts them with the given certificate key, and writes them to the node.
+func fetchAndWriteControlPlaneSecretFiles(ctx context.Context, fs afero.Afero, clientSet kubernetesclientset.Interface, certificateKey string) error {
+	secret, err := clientSet.CoreV1().Secrets(metav1.NamespaceSystem).Get(ctx, botanist.SecretNameControlPlaneSecretFiles, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed fetching control plane secret files secret %s/%s: %w", metav1.NamespaceSystem, botanist.SecretNameControlPlaneSecretFiles, err)
+	}
+
+	files, err := botanist.DecryptFiles(secret.Data[botanist.DataKeyControlPlaneSecretFiles], certificateKey)
+	if err != nil {
+		return err
+	}
+
+	return botanist.WriteFiles(fs, files)
+}
+
+// joinEtcdCluster adds the etcd member of this node to the etcd cluster running on the existing control plane nodes
+// (see addEtcdMember and promoteEtcdMember). The files of the etcd static pod (including the peer CA used for issuing
+// the peer certificate of the new member) have already been written by fetchAndWriteControlPlaneSecretFiles and
+// gardener-node-agent.
+func joinEtcdCluster(ctx context.Context, log logr.Logger, fs afero.Afero, controlPlaneIP, hostName, hostIP string) error {
+	tlsConfig, err := botanist.EtcdClientTLSConfig(fs)
+	if err != nil {
+		return err
+	}
+
+	peerCA, err := botanist.LoadEtcdPeerCA(fs)
+	if err != nil {
+		return err
+	}
+
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{botanist.EtcdClientURL(controlPlaneIP)},
+		TLS:         tlsConfig,
+		DialTimeout: 30 * time.Second,
+		Context:     ctx,
+	})
+	if err != nil {
+		return fmt.Errorf("failed creating etcd client: %w", err)
+	}
+	defer etcdClient.Close()
+
+	memberID, err := addEtcdMember(ctx, log, fs, etcdClient, peerCA, hostName, hostIP)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Waiting for etcd member to catch up and promoting it to a voting member")
+	return promoteEtcdMember(ctx, etcdClient, memberID)
+}
+
+// addEtcdMember adds the etcd member of this node as learner, so that it does not count towards the quorum before it
+// has caught up with the leader. Then, it writes the configuration and the peer certificate of the member. It returns
+// the ID of the member.
+func addEtcdMember(ctx context.Context, log logr.Logger, fs afero.Afero, etcdCluster clientv3.Cluster, peerCA *secretsutils.Certificate, hostName, hostIP string) (uint64, error) {
+	memberList, err := etcdCluster.MemberList(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed listing etcd members: %w", err)
+	}
+
+	var (
+		peerURL  = botanist.EtcdPeerURL(hostIP)
+		members  = memberList.Members
+		memberID uint64
+	)
+
+	// The member might have already been added by a previous (failed) attempt to join this node.
+	if i := slices.IndexFunc(members, func(member *etcdserverpb.Member) bool { return slices.Contains(member.PeerURLs, peerURL) }); i >= 0 {
+		memberID = members[i].ID
+	} else {
+		log.Info("Adding etcd member as learner", "peerURL", peerURL)
+		response, err := etcdCluster.MemberAddAsLearner(ctx, []string{peerURL})
+		if err != nil {
+			return 0, fmt.Errorf("failed adding etcd member: %w", err)
+		}
+		memberID, members = response.Member.ID, response.Members
+	}
+
+	var initialCluster []botanist.EtcdMember
+	for _, member := range members {
+		name := member.Name
+		if member.ID == memberID {
+			name = hostName
+		}
+
+		// Members which have been added but have not been started yet do not have a name. They must not be part of the
+		// initial cluster.
+		if name == "" || len(member.PeerURLs) == 0 {
+			continue
+		}
+
+		initialCluster = append(initialCluster, botanist.EtcdMember{Name: name, PeerURL: member.PeerURLs[0]})
+	}
+
+	if err := botanist.WriteEtcdMemberFiles(fs, peerCA, hostName, hostIP, initialCluster, false); err != nil {
+		return 0, err
+	}
+
+	return memberID, nil
+}
+
+// promoteEtcdMember promotes the learner with the given ID to a voting member once it is in sync with the leader.
+func promoteEtcdMember(ctx context.Context, etcdCluster clientv3.Cluster, memberID uint64) error {
+	return retryutils.UntilTimeout(ctx, 5*time.Second, etcdTimeout, func(ctx context.Context) (bool, error) {
+		memberList, err := etcdCluster.MemberList(ctx)
+		if err != nil {
+			return retryutils.MinorError(fmt.Errorf("failed listing etcd members: %w", err))
+		}
+
+		if i := slices.IndexFunc(memberList.Members, func(member *etcdserverpb.Member) bool { return member.ID == memberID }); i >= 0 && !memberList.Members[i].IsLearner {
+			return retryutils.Ok()
+		}
+
+		if _, err := etcdCluster.MemberPromote(ctx, memberID); err != nil {
+			return retryutils.MinorError(fmt.Errorf("etcd member is not yet ready to be promoted: %w", err))
+		}
+		return retryutils.Ok()
+	})
+}
+
+// waitForLocalAPIServer waits until the API server running on this node is ready.
+func waitForLocalAPIServer(ctx context.Context, cluster *cluster) error {
+	restConfig := rest.CopyConfig(cluster.restConfig)
+	restConfig.Host = "https://127.0.0.1:443"
+
+	clientSet, err := kubernetesclientset.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	return retryutils.UntilTimeout(ctx, 5*time.Second, nodeTimeout, func(ctx context.Context) (bool, error) {
+		if _, err := clientSet.Discovery().RESTClient().Get().AbsPath("/readyz").DoRaw(ctx); err != nil {
+			return retryutils.MinorError(fmt.Errorf("API server on this node is not yet ready: %w", err))
+		}
+		return retryutils.Ok()
+	})
+}
@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package join_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("ControlPlane", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+		fs  afero.Afero
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+	})
+
+	Describe("#FetchAndWriteControlPlaneSecretFiles", func() {
+		var (
+			certificateKey string
+			files          []extensionsv1alpha1.File
+			clientSet      *kubernetesfake.Clientset
+		)
+
+		BeforeEach(func() {
+			var err error
+			certificateKey, err = botanist.GenerateCertificateKey()
+			Expect(err).NotTo(HaveOccurred())
+
+			files = []extensionsv1alpha1.File{{
+				Path:    "/var/lib/etcd-main/peer-ca/ca.key",
+				Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64([]byte("private-key"))}},
+			}}
+
+			ciphertext, err := botanist.EncryptFiles(files, certificateKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			clientSet = kubernetesfake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardenadm-control-plane-secret-files", Namespace: "kube-system"},
+				Data:       map[string][]byte{"files": ciphertext},
+			})
+		})
+
+		It("should decrypt and write the control plane secret files", func() {
+			Expect(FetchAndWriteControlPlaneSecretFiles(ctx, fs, clientSet, certificateKey)).To(Succeed())
+
+			content, err := fs.ReadFile("/var/lib/etcd-main/peer-ca/ca.key")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("private-key"))
+		})
+
+		It("should fail for a wrong certificate key", func() {
+			otherCertificateKey, err := botanist.GenerateCertificateKey()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(FetchAndWriteControlPlaneSecretFiles(ctx, fs, clientSet, otherCertificateKey)).To(MatchError(ContainSubstring("is the certificate key correct?")))
+			Expect(fs.Exists("/var/lib/etcd-main/peer-ca/ca.key")).To(BeFalse())
+		})
+
+		It("should fail if the secret does not exist", func() {
+			clientSet = kubernetesfake.NewSimpleClientset()
+
+			Expect(FetchAndWriteControlPlaneSecretFiles(ctx, fs, clientSet, certificateKey)).To(MatchError(ContainSubstring("failed fetching control plane secret files secret kube-system/gardenadm-control-plane-secret-files")))
+		})
+	})
+
+	Describe("etcd", func() {
+		var (
+			peerCA      *secretsutils.Certificate
+			etcdCluster *fakeEtcdCluster
+
+			existingMember = &etcdserverpb.Member{ID: 1, Name: "machine-0", PeerURLs: []string{"https://10.0.0.2:2380"}}
+		)
+
+		BeforeEach(func() {
+			var err error
+			peerCA, err = (&secretsutils.CertificateSecretConfig{Name: "ca-etcd-peer", CommonName: "ca-etcd-peer", CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			etcdCluster = &fakeEtcdCluster{members: []*etcdserverpb.Member{existingMember}, nextID: 2}
+		})
+
+		Describe("#AddEtcdMember", func() {
+			It("should add the member as learner and write its configuration", func() {
+				memberID, err := AddEtcdMember(ctx, log, fs, etcdCluster, peerCA, "machine-1", "10.0.0.3")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(memberID).To(Equal(uint64(2)))
+
+				Expect(etcdCluster.members).To(ContainElement(And(
+					HaveField("ID", uint64(2)),
+					HaveField("PeerURLs", ConsistOf("https://10.0.0.3:2380")),
+					HaveField("IsLearner", true),
+				)))
+
+				config, err := fs.ReadFile("/var/lib/etcd-main/config/etcd.yaml")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(config)).To(And(
+					ContainSubstring("name: machine-1"),
+					ContainSubstring("initial-cluster: machine-0=https://10.0.0.2:2380,machine-1=https://10.0.0.3:2380"),
+					ContainSubstring("initial-cluster-state: existing"),
+				))
+
+				certificatePEM, err := fs.ReadFile("/var/lib/etcd-main/peer/tls.crt")
+				Expect(err).NotTo(HaveOccurred())
+				certificate, err := utils.DecodeCertificate(certificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(certificate.Issuer.CommonName).To(Equal("ca-etcd-peer"))
+				Expect(certificate.DNSNames).To(ConsistOf("machine-1"))
+			})
+
+			It("should reuse the member added by a previous attempt", func() {
+				etcdCluster.members = append(etcdCluster.members, &etcdserverpb.Member{ID: 5, PeerURLs: []string{"https://10.0.0.3:2380"}, IsLearner: true})
+
+				memberID, err := AddEtcdMember(ctx, log, fs, etcdCluster, peerCA, "machine-1", "10.0.0.3")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(memberID).To(Equal(uint64(5)))
+				Expect(etcdCluster.members).To(HaveLen(2))
+
+				config, err := fs.ReadFile("/var/lib/etcd-main/config/etcd.yaml")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(config)).To(ContainSubstring("initial-cluster: machine-0=https://10.0.0.2:2380,machine-1=https://10.0.0.3:2380"))
+			})
+
+			It("should not add other members which have not been started yet to the initial cluster", func() {
+				etcdCluster.members = append(etcdCluster.members, &etcdserverpb.Member{ID: 7, PeerURLs: []string{"https://10.0.0.4:2380"}, IsLearner: true})
+
+				_, err := AddEtcdMember(ctx, log, fs, etcdCluster, peerCA, "machine-1", "10.0.0.3")
+				Expect(err).NotTo(HaveOccurred())
+
+				config, err := fs.ReadFile("/var/lib/etcd-main/config/etcd.yaml")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(config)).To(And(
+					ContainSubstring("initial-cluster: machine-0=https://10.0.0.2:2380,machine-1=https://10.0.0.3:2380"),
+					Not(ContainSubstring("10.0.0.4")),
+				))
+			})
+
+			It("should fail if the member cannot be added", func() {
+				etcdCluster.addErr = fmt.Errorf("too many learners")
+
+				_, err := AddEtcdMember(ctx, log, fs, etcdCluster, peerCA, "machine-1", "10.0.0.3")
+				Expect(err).To(MatchError(ContainSubstring("failed adding etcd member: too many learners")))
+				Expect(fs.Exists("/var/lib/etcd-main/config/etcd.yaml")).To(BeFalse())
+			})
+		})
+
+		Describe("#PromoteEtcdMember", func() {
+			It("should promote the learner", func() {
+				etcdCluster.members = append(etcdCluster.members, &etcdserverpb.Member{ID: 2, Name: "machine-1", PeerURLs: []string{"https://10.0.0.3:2380"}, IsLearner: true})
+
+				Expect(PromoteEtcdMember(ctx, etcdCluster, 2)).To(Succeed())
+				Expect(etcdCluster.promoted).To(ConsistOf(uint64(2)))
+				Expect(etcdCluster.members[1].IsLearner).To(BeFalse())
+			})
+
+			It("should not promote a member which is already a voting member", func() {
+				etcdCluster.members = append(etcdCluster.members, &etcdserverpb.Member{ID: 2, Name: "machine-1", PeerURLs: []string{"https://10.0.0.3:2380"}})
+
+				Expect(PromoteEtcdMember(ctx, etcdCluster, 2)).To(Succeed())
+				Expect(etcdCluster.promoted).To(BeEmpty())
+			})
+		})
+	})
+})
+
+type fakeEtcdCluster struct {
+	clientv3.Cluster
+
+	members  []*etcdserverpb.Member
+	nextID   uint64
+	addErr   error
+	promoted []uint64
+}
+
+func (f *fakeEtcdCluster) MemberList(_ context.Context) (*clientv3.MemberListResponse, error) {
+	return &clientv3.MemberListResponse{Members: f.members}, nil
+}
+
+func (f *fakeEtcdCluster) MemberAddAsLearner(_ context.Context, peerAddrs []string) (*clientv3.MemberAddResponse, error) {
+	if f.addErr != nil {
+		return nil, f.addErr
+	}
+
+	member := &etcdserverpb.Member{ID: f.nextID, PeerURLs: peerAddrs, IsLearner: true}
+	f.members = append(f.members, member)
+	f.nextID++
+
+	return &clientv3.MemberAddResponse{Member: member, Members: f.members}, nil
+}
+
+func (f *fakeEtcdCluster) MemberPromote(_ context.Context, id uint64) (*clientv3.MemberPromoteResponse, error) {
+	i := slices.IndexFunc(f.members, func(member *etcdserverpb.Member) bool { return member.ID == id })
+	if i < 0 {
+		return nil, fmt.Errorf("member %d not found", id)
+	}
+
+	f.members[i].IsLearner = false
+	f.promoted = append(f.promoted, id)
+
+	return &clientv3.MemberPromoteResponse{Members: f.members}, nil
+}
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package join
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kubernetesclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	certutil "k8s.io/client-go/util/cert"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// discoveryTimeout is the maximum duration to wait for the cluster information to become available.
+const discoveryTimeout = 5 * time.Minute
+
+// cluster contains the discovered information about the cluster.
+type cluster struct {
+	// restConfig authenticates with the bootstrap token against the API server of the control plane node which was
+	// used for discovering the cluster.
+	restConfig *rest.Config
+	// apiServerHost is the (internal) domain of the API server.
+	apiServerHost string
+	// controlPlaneIP is the IP address of the control plane node which was used for discovering the cluster.
+	controlPlaneIP string
+}
+
+// discoverCluster fetches the cluster-info ConfigMap (see botanist.ReconcileClusterInfo) from the given control plane
+// node. The ConfigMap is fetched without verifying the serving certificate since the CA is not known yet. Instead, the
+// contained CA bundle is verified against the given CA certificate hashes. This is sufficient for establishing trust:
+// all further requests verify the serving certificate of the API server against this CA bundle, hence they fail if
+// the ConfigMap was forged. Unlike kubeadm, there is no check of the signature of the ConfigMap.
+func discoverCluster(ctx context.Context, opts *Options) (*cluster, error) {
+	address, err := url.Parse(opts.ControlPlaneAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing control plane address: %w", err)
+	}
+
+	controlPlaneIP, err := resolveIP(ctx, address.Hostname())
+	if err != nil {
+		return nil, err
+	}
+
+	insecureClientSet, err := kubernetesclientset.NewForConfig(&rest.Config{
+		Host:            opts.ControlPlaneAddress,
+		TLSClientConfig: rest.TLSClientConfig{Insecure: true},
+		Timeout:         30 * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client: %w", err)
+	}
+
+	var kubeconfig []byte
+	if err := retryutils.UntilTimeout(ctx, 5*time.Second, discoveryTimeout, func(ctx context.Context) (bool, error) {
+		configMap, err := insecureClientSet.CoreV1().ConfigMaps(metav1.NamespacePublic).Get(ctx, bootstraptokenapi.ConfigMapClusterInfo, metav1.GetOptions{})
+		if err != nil {
+			return retryutils.MinorError(fmt.Errorf("failed fetching ConfigMap %s/%s: %w", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo, err))
+		}
+
+		if kubeconfig = []byte(configMap.Data[bootstraptokenapi.KubeConfigKey]); len(kubeconfig) == 0 {
+			return retryutils.SevereError(fmt.Errorf("ConfigMap %s/%s does not contain a kubeconfig", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo))
+		}
+		return retryutils.Ok()
+	}); err != nil {
+		return nil, err
+	}
+
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading cluster-info kubeconfig: %w", err)
+	}
+	if len(config.Clusters) != 1 {
+		return nil, fmt.Errorf("cluster-info kubeconfig must contain exactly one cluster, but found %d", len(config.Clusters))
+	}
+
+	var (
+		server string
+		caData []byte
+	)
+	for _, c := range config.Clusters {
+		server, caData = c.Server, c.CertificateAuthorityData
+	}
+
+	if err := verifyCABundle(caData, opts.CACertificateHashes); err != nil {
+		return nil, err
+	}
+
+	if !strings.Contains(server, "://") {
+		server = "https://" + server
+	}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing API server address %q from cluster-info: %w", server, err)
+	}
+
+	return &cluster{
+		restConfig: &rest.Config{
+			Host:        opts.ControlPlaneAddress,
+			BearerToken: opts.BootstrapToken,
+			TLSClientConfig: rest.TLSClientConfig{
+				CAData: caData,
+				// The serving certificate of the API server is not valid for the IP addresses of the nodes, but for the
+				// domain of the API server.
+				ServerName: serverURL.Hostname(),
+			},
+			Timeout: 30 * time.Second,
+		},
+		apiServerHost:  serverURL.Hostname(),
+		controlPlaneIP: controlPlaneIP,
+	}, nil
+}
+
+// verifyCABundle verifies that at least one certificate of the given CA bundle matches one of the given hashes.
+func verifyCABundle(caData []byte, hashes []string) error {
+	certificates, err := certutil.ParseCertsPEM(caData)
+	if err != nil {
+		return fmt.Errorf("failed parsing CA bundle from cluster-info: %w", err)
+	}
+
+	var errs []error
+	for _, certificate := range certificates {
+		err := bootstraptoken.VerifyCACertificateHash(certificate, hashes...)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+
+	return fmt.Errorf("failed verifying CA bundle from cluster-info: %w", errors.Join(errs...))
+}
+
+func resolveIP(ctx context.Context, host string) (string, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String(), nil
+	}
+
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return "", fmt.Errorf("failed resolving control plane address %q: %w", host, err)
+	}
+	if len(addresses) == 0 {
+		return "", fmt.Errorf("control plane address %q did not resolve to any IP address", host)
+	}
+
+	return addresses[0].IP.String(), nil
+}
+
+// determineWorkerPool returns the name of the worker pool which the node joins and the name of its
+// OperatingSystemConfig secret based on the worker pools ConfigMap (see botanist.DeployNodeAgentResources).
+func determineWorkerPool(ctx context.Context, clientSet kubernetesclientset.Interface, opts *Options) (string, string, error) {
+	configMap, err := clientSet.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, botanist.ConfigMapNameWorkerPools, metav1.GetOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("failed fetching ConfigMap %s/%s: %w", metav1.NamespaceSystem, botanist.ConfigMapNameWorkerPools, err)
+	}
+
+	var (
+		controlPlaneWorkerPool = configMap.Data[botanist.DataKeyControlPlaneWorkerPool]
+		workerPool             = opts.WorkerPoolName
+	)
+
+	switch {
+	case opts.ControlPlane && workerPool == "":
+		workerPool = controlPlaneWorkerPool
+	case opts.ControlPlane && workerPool != controlPlaneWorkerPool:
+		return "", "", fmt.Errorf("control plane nodes must join the control plane worker pool %q", controlPlaneWorkerPool)
+	case !opts.ControlPlane && workerPool != "" && workerPool == controlPlaneWorkerPool:
+		return "", "", fmt.Errorf("worker pool %q is the control plane worker pool, use --control-plane for joining it", workerPool)
+	case !opts.ControlPlane && workerPool == "":
+		workerPools := sets.KeySet(configMap.Data).Delete(botanist.DataKeyControlPlaneWorkerPool, controlPlaneWorkerPool)
+		if workerPools.Len() != 1 {
+			return "", "", fmt.Errorf("must provide a worker pool name since the cluster does not have exactly one worker pool besides the control plane worker pool, available worker pools: %v", sets.List(workerPools))
+		}
+		workerPool = workerPools.UnsortedList()[0]
+	}
+
+	secretName, ok := configMap.Data[workerPool]
+	if !ok || workerPool == botanist.DataKeyControlPlaneWorkerPool {
+		return "", "", fmt.Errorf("worker pool %q does not exist, available worker pools: %v", workerPool, sets.List(sets.KeySet(configMap.Data).Delete(botanist.DataKeyControlPlaneWorkerPool)))
+	}
+
+	return workerPool, secretName, nil
+}
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package join_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("Discovery", func() {
+	var (
+		ctx = context.Background()
+
+		caPEM, otherCAPEM   []byte
+		caHash, otherCAHash string
+	)
+
+	generateCA := func() ([]byte, string) {
+		ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+		caCert, err := utils.DecodeCertificate(ca.CertificatePEM)
+		Expect(err).NotTo(HaveOccurred())
+		return ca.CertificatePEM, bootstraptoken.CACertificateHash(caCert)
+	}
+
+	BeforeEach(func() {
+		caPEM, caHash = generateCA()
+		otherCAPEM, otherCAHash = generateCA()
+	})
+
+	Describe("#VerifyCABundle", func() {
+		It("should succeed if a certificate of the bundle matches one of the hashes", func() {
+			Expect(VerifyCABundle(append(otherCAPEM, caPEM...), []string{"sha256:0000", caHash})).To(Succeed())
+		})
+
+		It("should fail if no certificate of the bundle matches the hashes", func() {
+			Expect(VerifyCABundle(otherCAPEM, []string{caHash})).To(MatchError(And(
+				ContainSubstring("failed verifying CA bundle from cluster-info"),
+				ContainSubstring("does not match any of the expected hashes"),
+			)))
+		})
+
+		It("should fail if the bundle cannot be parsed", func() {
+			Expect(VerifyCABundle([]byte("foo"), []string{caHash})).To(MatchError(ContainSubstring("failed parsing CA bundle from cluster-info")))
+		})
+	})
+
+	Describe("#DiscoverCluster", func() {
+		var (
+			server     *httptest.Server
+			kubeconfig []byte
+			opts       *Options
+		)
+
+		BeforeEach(func() {
+			var err error
+			kubeconfig, err = clientcmd.Write(clientcmdapi.Config{Clusters: map[string]*clientcmdapi.Cluster{"root": {
+				Server:                   "https://api.root.internal.gardenadm.local",
+				CertificateAuthorityData: caPEM,
+			}}})
+			Expect(err).NotTo(HaveOccurred())
+
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/namespaces/kube-public/configmaps/cluster-info" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				// The cluster-info ConfigMap must be fetched anonymously.
+				Expect(r.Header.Get("Authorization")).To(BeEmpty())
+
+				w.Header().Set("Content-Type", "application/json")
+				Expect(json.NewEncoder(w).Encode(&corev1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster-info", Namespace: "kube-public"},
+					Data:       map[string]string{"kubeconfig": string(kubeconfig)},
+				})).To(Succeed())
+			}))
+			DeferCleanup(server.Close)
+
+			opts = &Options{
+				ControlPlaneAddress: server.URL,
+				BootstrapToken:      "abcdef.0123456789abcdef",
+				CACertificateHashes: []string{caHash},
+			}
+		})
+
+		It("should discover the cluster and trust its CA", func() {
+			cluster, err := DiscoverCluster(ctx, opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cluster).To(Equal(&Cluster{
+				Host:           server.URL,
+				BearerToken:    "abcdef.0123456789abcdef",
+				CAData:         caPEM,
+				ServerName:     "api.root.internal.gardenadm.local",
+				APIServerHost:  "api.root.internal.gardenadm.local",
+				ControlPlaneIP: "127.0.0.1",
+			}))
+		})
+
+		It("should fail if the CA does not match the hashes", func() {
+			opts.CACertificateHashes = []string{otherCAHash}
+
+			_, err := DiscoverCluster(ctx, opts)
+			Expect(err).To(MatchError(ContainSubstring("failed verifying CA bundle from cluster-info")))
+		})
+
+		It("should fail if the cluster-info ConfigMap does not contain a kubeconfig", func() {
+			kubeconfig = nil
+
+			_, err := DiscoverCluster(ctx, opts)
+			Expect(err).To(MatchError(ContainSubstring("does not contain a kubeconfig")))
+		})
+
+		It("should fail if the cluster-info kubeconfig does not contain exactly one cluster", func() {
+			var err error
+			kubeconfig, err = clientcmd.Write(clientcmdapi.Config{Clusters: map[string]*clientcmdapi.Cluster{
+				"foo": {Server: "https://foo", CertificateAuthorityData: caPEM},
+				"bar": {Server: "https://bar", CertificateAuthorityData: caPEM},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = DiscoverCluster(ctx, opts)
+			Expect(err).To(MatchError(ContainSubstring("must contain exactly one cluster, but found 2")))
+		})
+	})
+
+	Describe("#DetermineWorkerPool", func() {
+		var (
+			clientSet *kubernetesfake.Clientset
+			opts      *Options
+		)
+
+		BeforeEach(func() {
+			clientSet = kubernetesfake.NewSimpleClientset(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardenadm-worker-pools", Namespace: "kube-system"},
+				Data: map[string]string{
+					"controlPlaneWorkerPool": "control-plane",
+					"control-plane":          "gardener-node-agent-control-plane",
+					"worker":                 "gardener-node-agent-worker",
+				},
+			})
+			opts = &Options{}
+		})
+
+		It("should default to the only other worker pool", func() {
+			workerPool, secretName, err := DetermineWorkerPool(ctx, clientSet, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workerPool).To(Equal("worker"))
+			Expect(secretName).To(Equal("gardener-node-agent-worker"))
+		})
+
+		It("should use the given worker pool", func() {
+			opts.WorkerPoolName = "worker"
+
+			workerPool, secretName, err := DetermineWorkerPool(ctx, clientSet, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workerPool).To(Equal("worker"))
+			Expect(secretName).To(Equal("gardener-node-agent-worker"))
+		})
+
+		It("should default to the control plane worker pool for control plane nodes", func() {
+			opts.ControlPlane = true
+
+			workerPool, secretName, err := DetermineWorkerPool(ctx, clientSet, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workerPool).To(Equal("control-plane"))
+			Expect(secretName).To(Equal("gardener-node-agent-control-plane"))
+		})
+
+		It("should fail if a control plane node should join another worker pool", func() {
+			opts.ControlPlane = true
+			opts.WorkerPoolName = "worker"
+
+			_, _, err := DetermineWorkerPool(ctx, clientSet, opts)
+			Expect(err).To(MatchError(ContainSubstring(`control plane nodes must join the control plane worker pool "control-plane"`)))
+		})
+
+		It("should fail if a worker node should join the control plane worker pool", func() {
+			opts.WorkerPoolName = "control-plane"
+
+			_, _, err := DetermineWorkerPool(ctx, clientSet, opts)
+			Expect(err).To(MatchError(ContainSubstring("use --control-plane for joining it")))
+		})
+
+		It("should fail if the worker pool cannot be determined", func() {
+			configMap, err := clientSet.CoreV1().ConfigMaps("kube-system").Get(ctx, "gardenadm-worker-pools", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			configMap.Data["other"] = "gardener-node-agent-other"
+			_, err = clientSet.CoreV1().ConfigMaps("kube-system").Update(ctx, configMap, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, err = DetermineWorkerPool(ctx, clientSet, opts)
+			Expect(err).To(MatchError(ContainSubstring("must provide a worker pool name")))
+		})
+
+		It("should fail if the worker pool does not exist", func() {
+			opts.WorkerPoolName = "foo"
+
+			_, _, err := DetermineWorkerPool(ctx, clientSet, opts)
+			Expect(err).To(MatchError(ContainSubstring(`worker pool "foo" does not exist`)))
+		})
+
+		It("should fail if the worker pools ConfigMap does not exist", func() {
+			clientSet = kubernetesfake.NewSimpleClientset()
+
+			_, _, err := DetermineWorkerPool(ctx, clientSet, opts)
+			Expect(err).To(MatchError(ContainSubstring("failed fetching ConfigMap kube-system/gardenadm-worker-pools")))
+		})
+	})
+})
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package join
+
+import (
+	"context"
+)
+
+// Functions exported for testing.
+
+var (
+	VerifyCABundle                       = verifyCABundle
+	DetermineWorkerPool                  = determineWorkerPool
+	FetchAndWriteControlPlaneSecretFiles = fetchAndWriteControlPlaneSecretFiles
+	AddEtcdMember                        = addEtcdMember
+	PromoteEtcdMember                    = promoteEtcdMember
+)
+
+// Cluster is the discovered information about the cluster.
+type Cluster struct {
+	Host           string
+	BearerToken    string
+	CAData         []byte
+	ServerName     string
+	APIServerHost  string
+	ControlPlaneIP string
+}
+
+// DiscoverCluster calls discoverCluster and returns the discovered information.
+func DiscoverCluster(ctx context.Context, opts *Options) (*Cluster, error) {
+	c, err := discoverCluster(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cluster{
+		Host:           c.restConfig.Host,
+		BearerToken:    c.restConfig.BearerToken,
+		CAData:         c.restConfig.CAData,
+		ServerName:     c.restConfig.ServerName,
+		APIServerHost:  c.apiServerHost,
+		ControlPlaneIP: c.controlPlaneIP,
+	}, nil
+}
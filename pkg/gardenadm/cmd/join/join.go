@@ -7,25 +7,57 @@ package join
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	kubernetesclientset "k8s.io/client-go/kubernetes"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
 )
 
+// nodeTimeout is the maximum duration to wait for the node to be registered and ready.
+const nodeTimeout = 10 * time.Minute
+
 // NewCommand creates a new cobra.Command.
 func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	opts := &Options{}
 
 	cmd := &cobra.Command{
-		Use:   "join",
+		Use:   "join <control-plane-address>",
 		Short: "Bootstrap further control plane nodes or worker nodes and join them to the cluster",
-		Long:  "Bootstrap further control plane nodes or worker nodes and join them to the cluster",
+		Long: "Bootstrap further control plane nodes or worker nodes and join them to the cluster. " +
+			"The cluster is discovered via the API server at the given address of an existing control plane node. " +
+			"Its authenticity is verified with the given CA certificate hash, and the bootstrap token is used for fetching the operating system config of the node's worker pool. " +
+			"Then, gardener-node-agent is installed on the node which sets up kubelet and all other components. " +
+			"With --control-plane, the node additionally runs an etcd member and an API server, making the control plane highly available. " +
+			"The secrets of the control plane are fetched in encrypted form and decrypted with the certificate key printed by 'gardenadm init'.",
 
 		Example: `# Bootstrap a worker node and join it to the cluster
-gardenadm join`,
+gardenadm join --bootstrap-token <token> --ca-certificate-hash <hash> https://<control-plane-address>:443
+
+# Bootstrap a worker node of a specific worker pool and join it to the cluster
+gardenadm join --bootstrap-token <token> --ca-certificate-hash <hash> --worker-pool-name <pool> https://<control-plane-address>:443
+
+# Bootstrap a further control plane node and join it to the cluster
+gardenadm join --bootstrap-token <token> --ca-certificate-hash <hash> --control-plane --certificate-key <key> https://<control-plane-address>:443`,
+
+		Args: cobra.MaximumNArgs(1),
 
-		RunE: func(cmd *cobra.Command, _ []string) error {
-			if err := opts.Complete(); err != nil {
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Complete(args); err != nil {
 				return err
 			}
 
@@ -42,7 +74,122 @@ gardenadm join`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprintln(ioStreams.Out, "not implemented either")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	log := logger.MustNewZapLogger(logger.InfoLevel, logger.FormatText, logzap.WriteTo(ioStreams.ErrOut))
+
+	var (
+		fs = afero.Afero{Fs: afero.NewOsFs()}
+		db = dbus.New(log)
+	)
+
+	hostName, err := nodeagent.GetHostName()
+	if err != nil {
+		return fmt.Errorf("failed fetching host name: %w", err)
+	}
+
+	hostIP, err := utilnet.ChooseHostInterface()
+	if err != nil {
+		return fmt.Errorf("failed determining host IP address: %w", err)
+	}
+
+	log.Info("Discovering cluster", "address", opts.ControlPlaneAddress)
+	cluster, err := discoverCluster(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := kubernetesclientset.NewForConfig(cluster.restConfig)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	workerPool, oscSecretName, err := determineWorkerPool(ctx, clientSet, opts)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Fetching operating system config", "workerPool", workerPool)
+	osc, err := fetchOperatingSystemConfig(ctx, clientSet, oscSecretName)
+	if err != nil {
+		return err
+	}
+
+	// The internal domain of the API server is not published to any DNS provider, hence it is resolved to the control
+	// plane node which was used for discovering the cluster.
+	if err := botanist.EnsureHostsEntry(fs, cluster.controlPlaneIP, cluster.apiServerHost); err != nil {
+		return err
+	}
+
+	if opts.ControlPlane {
+		// The secret files of the control plane static pods are not part of the operating system config since it is
+		// readable with the bootstrap token only. They must be written before gardener-node-agent starts the static pods.
+		log.Info("Fetching and writing control plane secret files")
+		if err := fetchAndWriteControlPlaneSecretFiles(ctx, fs, clientSet, opts.CertificateKey); err != nil {
+			return err
+		}
+	}
+
+	log.Info("Installing gardener-node-agent")
+	if err := installNodeAgent(ctx, log, fs, db, osc, opts.BootstrapToken, hostName); err != nil {
+		return err
+	}
+
+	log.Info("Waiting for node to be registered and ready")
+	node, err := waitForNodeReady(ctx, hostName)
+	if err != nil {
+		return err
+	}
+
+	if opts.ControlPlane {
+		log.Info("Joining etcd cluster")
+		if err := joinEtcdCluster(ctx, log, fs, cluster.controlPlaneIP, hostName, hostIP.String()); err != nil {
+			return err
+		}
+
+		log.Info("Waiting for API server on this node to become ready")
+		if err := waitForLocalAPIServer(ctx, cluster); err != nil {
+			return err
+		}
+
+		// From now on, the components on this node use the API server running on the same node.
+		if err := botanist.EnsureHostsEntry(fs, "127.0.0.1", cluster.apiServerHost); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(ioStreams.Out, `Your node %s has successfully joined the cluster as part of worker pool %q!
+
+Run 'kubectl get nodes' on a control plane node to see it.
+`, node.Name, workerPool)
+
 	return nil
 }
+
+func waitForNodeReady(ctx context.Context, hostName string) (*corev1.Node, error) {
+	var node *corev1.Node
+
+	if err := retryutils.UntilTimeout(ctx, 5*time.Second, nodeTimeout, func(ctx context.Context) (bool, error) {
+		// kubelet writes its kubeconfig after it has received its client certificate. It is also authorized to read the
+		// Node objects.
+		clientSet, err := cmd.NewClientSetFromFile(kubelet.PathKubeconfigReal, kubernetes.ShootScheme)
+		if err != nil {
+			return retryutils.MinorError(fmt.Errorf("kubelet has not yet been bootstrapped: %w", err))
+		}
+
+		if node, err = nodeagent.FetchNodeByHostName(ctx, clientSet.Client(), hostName); err != nil {
+			return retryutils.MinorError(err)
+		}
+		if node == nil {
+			return retryutils.MinorError(fmt.Errorf("node with host name %q is not yet registered", hostName))
+		}
+
+		if err := health.CheckNode(node); err != nil {
+			return retryutils.MinorError(fmt.Errorf("node %s is not yet ready: %w", node.Name, err))
+		}
+		return retryutils.Ok()
+	}); err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
@@ -5,8 +5,7 @@
 package join_test
 
 import (
-	"bytes"
-	"io"
+	"context"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -19,22 +18,28 @@ import (
 var _ = Describe("Join", func() {
 	var (
 		ioStreams genericiooptions.IOStreams
-		out       *bytes.Buffer
 		cmd       *cobra.Command
 	)
 
 	BeforeEach(func() {
-		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
+		ioStreams, _, _, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(context.Background())
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+		It("should fail if no control plane address is given", func() {
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("must provide the address of a control plane node")))
+		})
+
+		It("should fail if no bootstrap token is given", func() {
+			Expect(cmd.RunE(cmd, []string{"https://10.0.0.1:443"})).To(MatchError(ContainSubstring("must provide a bootstrap token")))
+		})
+
+		It("should fail if no CA certificate hash is given", func() {
+			Expect(cmd.Flags().Set("bootstrap-token", "abcdef.0123456789abcdef")).To(Succeed())
 
-			output, err := io.ReadAll(out)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented either\n"))
+			Expect(cmd.RunE(cmd, []string{"https://10.0.0.1:443"})).To(MatchError(ContainSubstring("must provide at least one CA certificate hash")))
 		})
 	})
 })
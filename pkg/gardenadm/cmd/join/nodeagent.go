@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package join
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	kubernetesclientset "k8s.io/client-go/kubernetes"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
+	oscutils "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/utils"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/bootstrap"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/registry"
+)
+
+var oscDecoder runtime.Decoder
+
+func init() {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(extensionsv1alpha1.AddToScheme(scheme))
+	oscDecoder = serializer.NewCodecFactory(scheme).UniversalDeserializer()
+}
+
+func fetchOperatingSystemConfig(ctx context.Context, clientSet kubernetesclientset.Interface, secretName string) (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	secret, err := clientSet.CoreV1().Secrets(metav1.NamespaceSystem).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching operating system config secret %s/%s: %w", metav1.NamespaceSystem, secretName, err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(oscDecoder, secret.Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig], osc); err != nil {
+		return nil, fmt.Errorf("failed decoding operating system config from secret %s/%s: %w", metav1.NamespaceSystem, secretName, err)
+	}
+
+	return osc, nil
+}
+
+// installNodeAgent writes the configuration, the bootstrap token, and the binary of gardener-node-agent (taken from the
+// given OperatingSystemConfig) to the node and bootstraps it. This is what the gardener-node-init unit does on machines
+// provisioned by machine-controller-manager. gardener-node-agent then applies the OperatingSystemConfig of the worker
+// pool, i.e., it sets up kubelet and all other components.
+func installNodeAgent(ctx context.Context, log logr.Logger, fs afero.Afero, db dbus.DBus, osc *extensionsv1alpha1.OperatingSystemConfig, bootstrapToken, hostName string) error {
+	configFile := fileWithPath(osc.Spec.Files, nodeagentconfigv1alpha1.ConfigFilePath)
+	if configFile == nil || configFile.Content.Inline == nil {
+		return fmt.Errorf("operating system config does not contain the gardener-node-agent configuration file %s", nodeagentconfigv1alpha1.ConfigFilePath)
+	}
+
+	binaryFile := fileWithPath(osc.Spec.Files, nodeagent.PathBinary)
+	if binaryFile == nil || binaryFile.Content.ImageRef == nil {
+		return fmt.Errorf("operating system config does not contain the gardener-node-agent binary %s", nodeagent.PathBinary)
+	}
+
+	config, err := oscutils.NewFileContentInlineCodec().Decode(configFile.Content.Inline)
+	if err != nil {
+		return fmt.Errorf("failed decoding gardener-node-agent configuration: %w", err)
+	}
+
+	for path, content := range map[string][]byte{
+		nodeagentconfigv1alpha1.ConfigFilePath:         config,
+		nodeagentconfigv1alpha1.BootstrapTokenFilePath: []byte(bootstrapToken),
+		nodeagentconfigv1alpha1.MachineNameFilePath:    []byte(hostName),
+	} {
+		if err := fs.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return fmt.Errorf("failed creating directory for %s: %w", path, err)
+		}
+
+		if err := fs.WriteFile(path, content, 0600); err != nil {
+			return fmt.Errorf("failed writing %s: %w", path, err)
+		}
+	}
+
+	log.Info("Extracting gardener-node-agent binary", "image", binaryFile.Content.ImageRef.Image)
+	if err := registry.NewExtractor().CopyFromImage(ctx, binaryFile.Content.ImageRef.Image, binaryFile.Content.ImageRef.FilePathInImage, nodeagent.PathBinary, 0755); err != nil {
+		return fmt.Errorf("failed extracting gardener-node-agent binary: %w", err)
+	}
+
+	return bootstrap.Bootstrap(ctx, log, fs, &withoutNodeInit{DBus: db}, nil)
+}
+
+func fileWithPath(files []extensionsv1alpha1.File, path string) *extensionsv1alpha1.File {
+	if i := slices.IndexFunc(files, func(file extensionsv1alpha1.File) bool { return file.Path == path }); i >= 0 {
+		return &files[i]
+	}
+	return nil
+}
+
+// withoutNodeInit skips disabling the gardener-node-init unit during the bootstrap of gardener-node-agent. Nodes joined
+// with `gardenadm join` are not provisioned with this unit, hence systemd would fail disabling it.
+type withoutNodeInit struct {
+	dbus.DBus
+}
+
+func (d *withoutNodeInit) Disable(ctx context.Context, unitNames ...string) error {
+	unitNames = slices.DeleteFunc(slices.Clone(unitNames), func(unitName string) bool {
+		return unitName == nodeagentconfigv1alpha1.InitUnitName
+	})
+	if len(unitNames) == 0 {
+		return nil
+	}
+
+	return d.DBus.Disable(ctx, unitNames...)
+}
@@ -5,16 +5,100 @@
 package join
 
 import (
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
 	"github.com/spf13/pflag"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
 )
 
 // Options contains options for this command.
-type Options struct{}
+type Options struct {
+	// ControlPlaneAddress is the address of the API server of an existing control plane node which is used for
+	// discovering the cluster.
+	ControlPlaneAddress string
+	// BootstrapToken is the bootstrap token used for discovering the cluster and for bootstrapping gardener-node-agent.
+	BootstrapToken string
+	// CACertificateHashes are the hashes of the CA certificates one of which must match the CA certificate of the
+	// cluster.
+	CACertificateHashes []string
+	// WorkerPoolName is the name of the worker pool which the node should join. If empty, it is determined
+	// automatically.
+	WorkerPoolName string
+	// ControlPlane specifies whether the node should join as a further control plane node.
+	ControlPlane bool
+	// CertificateKey is the key printed by `gardenadm init` which is used for decrypting the secret files of the control
+	// plane (see botanist.UploadControlPlaneSecretFiles). It is required if ControlPlane is true.
+	CertificateKey string
+}
 
 // Complete completes the options.
-func (o *Options) Complete() error { return nil }
+func (o *Options) Complete(args []string) error {
+	if len(args) > 0 {
+		o.ControlPlaneAddress = strings.TrimSpace(args[0])
+	}
+
+	if o.ControlPlaneAddress != "" && !strings.Contains(o.ControlPlaneAddress, "://") {
+		o.ControlPlaneAddress = "https://" + o.ControlPlaneAddress
+	}
+
+	return nil
+}
 
 // Validate validates the options.
-func (o *Options) Validate() error { return nil }
+func (o *Options) Validate() error {
+	if len(o.ControlPlaneAddress) == 0 {
+		return fmt.Errorf("must provide the address of a control plane node")
+	}
+
+	if u, err := url.Parse(o.ControlPlaneAddress); err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return fmt.Errorf("the control plane address %q must be a valid https URL", o.ControlPlaneAddress)
+	}
+
+	if len(o.BootstrapToken) == 0 {
+		return fmt.Errorf("must provide a bootstrap token")
+	}
+
+	if !bootstraptokenutil.IsValidBootstrapToken(o.BootstrapToken) {
+		return fmt.Errorf("the bootstrap token does not match the expected format %q", bootstraptokenapi.BootstrapTokenPattern)
+	}
+
+	if len(o.CACertificateHashes) == 0 {
+		return fmt.Errorf("must provide at least one CA certificate hash")
+	}
+
+	for _, hash := range o.CACertificateHashes {
+		if !strings.HasPrefix(hash, bootstraptoken.CACertificateHashPrefix) {
+			return fmt.Errorf("the CA certificate hash %q must start with %q", hash, bootstraptoken.CACertificateHashPrefix)
+		}
+	}
+
+	if !o.ControlPlane && len(o.CertificateKey) > 0 {
+		return fmt.Errorf("the certificate key can only be provided with --control-plane")
+	}
+
+	if o.ControlPlane {
+		if len(o.CertificateKey) == 0 {
+			return fmt.Errorf("must provide the certificate key printed by 'gardenadm init' for joining a control plane node")
+		}
+
+		if key, err := hex.DecodeString(o.CertificateKey); err != nil || len(key) != 32 {
+			return fmt.Errorf("the certificate key must be a hex-encoded 32 bytes key")
+		}
+	}
+
+	return nil
+}
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.BootstrapToken, "bootstrap-token", "", "Bootstrap token for discovering the cluster and bootstrapping the node (see 'gardenadm token create').")
+	fs.StringSliceVar(&o.CACertificateHashes, "ca-certificate-hash", nil, "Hash of the cluster CA certificate of the form \"sha256:<hex>\" which is used for verifying the discovered cluster information. Can be specified multiple times, e.g. during CA rotation.")
+	fs.StringVarP(&o.WorkerPoolName, "worker-pool-name", "w", "", "Name of the worker pool which the node should join. Defaults to the control plane worker pool if --control-plane is set, or to the only other worker pool otherwise.")
+	fs.BoolVar(&o.ControlPlane, "control-plane", false, "Join the node as a further control plane node which runs an additional etcd member and API server.")
+	fs.StringVar(&o.CertificateKey, "certificate-key", "", "Key printed by 'gardenadm init' for decrypting the secrets of the control plane. Required with --control-plane.")
+}
@@ -14,6 +14,11 @@ import (
 var _ = Describe("Options", func() {
 	var (
 		options *Options
+
+		token = "abcdef.0123456789abcdef"
+		hash  = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+		certificateKey = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
 	)
 
 	BeforeEach(func() {
@@ -21,14 +26,93 @@ var _ = Describe("Options", func() {
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
-			Expect(options.Complete()).To(Succeed())
+		It("should use the first argument as control plane address", func() {
+			Expect(options.Complete([]string{"https://10.0.0.1:443"})).To(Succeed())
+			Expect(options.ControlPlaneAddress).To(Equal("https://10.0.0.1:443"))
+		})
+
+		It("should default the scheme of the control plane address", func() {
+			Expect(options.Complete([]string{"10.0.0.1:443"})).To(Succeed())
+			Expect(options.ControlPlaneAddress).To(Equal("https://10.0.0.1:443"))
+		})
+
+		It("should not set the control plane address if no argument is given", func() {
+			Expect(options.Complete(nil)).To(Succeed())
+			Expect(options.ControlPlaneAddress).To(BeEmpty())
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should return nil", func() {
+		BeforeEach(func() {
+			options.ControlPlaneAddress = "https://10.0.0.1:443"
+			options.BootstrapToken = token
+			options.CACertificateHashes = []string{hash}
+		})
+
+		It("should pass for valid options", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
+
+		It("should fail because control plane address is not set", func() {
+			options.ControlPlaneAddress = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the address of a control plane node")))
+		})
+
+		It("should fail because control plane address is not an https URL", func() {
+			options.ControlPlaneAddress = "http://10.0.0.1:443"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must be a valid https URL")))
+		})
+
+		It("should fail because bootstrap token is not set", func() {
+			options.BootstrapToken = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a bootstrap token")))
+		})
+
+		It("should fail because bootstrap token has an invalid format", func() {
+			options.BootstrapToken = "foo"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("does not match the expected format")))
+		})
+
+		It("should fail because CA certificate hash is not set", func() {
+			options.CACertificateHashes = nil
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide at least one CA certificate hash")))
+		})
+
+		It("should fail because CA certificate hash has an unsupported format", func() {
+			options.CACertificateHashes = []string{"md5:foo"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`must start with "sha256:"`)))
+		})
+
+		It("should pass for a control plane node with a certificate key", func() {
+			options.ControlPlane = true
+			options.CertificateKey = certificateKey
+
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail because the certificate key is not set for a control plane node", func() {
+			options.ControlPlane = true
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the certificate key printed by 'gardenadm init'")))
+		})
+
+		It("should fail because the certificate key has an invalid format", func() {
+			options.ControlPlane = true
+			options.CertificateKey = "abcdef"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must be a hex-encoded 32 bytes key")))
+		})
+
+		It("should fail because the certificate key is set for a worker node", func() {
+			options.CertificateKey = certificateKey
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("can only be provided with --control-plane")))
+		})
 	})
 })
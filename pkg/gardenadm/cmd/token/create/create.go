@@ -7,9 +7,22 @@ package create
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/rest"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+	"k8s.io/cluster-bootstrap/util/tokens"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,15 +32,18 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create [token]",
 		Short: "Create a bootstrap token on the server",
-		Long: "The [token] is the actual token to write." +
-			"This should be a securely generated random token of the form \"[a-z0-9]{6}.[a-z0-9]{16}\"." +
+		Long: "The [token] is the actual token to write. " +
+			"This should be a securely generated random token of the form \"[a-z0-9]{6}.[a-z0-9]{16}\". " +
 			"If no [token] is given, gardenadm will generate a random token instead.",
 
 		Example: `# Create a bootstrap token with id "foo123" on the server
 gardenadm token create foo123.bar4567890baz123
 
 # Create a bootstrap token generated randomly
-gardenadm token create`,
+gardenadm token create
+
+# Create a bootstrap token which never expires and print the command for joining a node with it
+gardenadm token create --ttl 0 --print-join-command`,
 
 		Args: cobra.MaximumNArgs(1),
 
@@ -49,7 +65,79 @@ gardenadm token create`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprintln(ioStreams.Out, "not implemented")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	clientSet, err := cmd.NewClientSetFromFile(opts.Kubeconfig, kubernetes.ShootScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	tokenID, tokenSecret, err := tokens.ParseToken(opts.Token)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bootstraptokenutil.BootstrapTokenSecretName(tokenID),
+			Namespace: metav1.NamespaceSystem,
+		},
+		Type: bootstraptokenapi.SecretTypeBootstrapToken,
+		Data: map[string][]byte{
+			bootstraptokenapi.BootstrapTokenIDKey:     []byte(tokenID),
+			bootstraptokenapi.BootstrapTokenSecretKey: []byte(tokenSecret),
+		},
+	}
+
+	if opts.Description != "" {
+		secret.Data[bootstraptokenapi.BootstrapTokenDescriptionKey] = []byte(opts.Description)
+	}
+	if opts.TTL > 0 {
+		secret.Data[bootstraptokenapi.BootstrapTokenExpirationKey] = []byte(cmd.TimeNow().Add(opts.TTL).UTC().Format(time.RFC3339))
+	}
+	for _, usage := range opts.Usages {
+		secret.Data[bootstraptokenapi.BootstrapTokenUsagePrefix+usage] = []byte("true")
+	}
+	if len(opts.Groups) > 0 {
+		secret.Data[bootstraptokenapi.BootstrapTokenExtraGroupsKey] = []byte(strings.Join(opts.Groups, ","))
+	}
+
+	if err := clientSet.Client().Create(ctx, secret); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("a bootstrap token with ID %q already exists", tokenID)
+		}
+		return fmt.Errorf("failed creating bootstrap token secret: %w", err)
+	}
+
+	if !opts.PrintJoinCommand {
+		fmt.Fprintln(ioStreams.Out, opts.Token)
+		return nil
+	}
+
+	joinCommand, err := joinCommand(clientSet.RESTConfig(), opts.Token)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(ioStreams.Out, joinCommand)
 	return nil
 }
+
+func joinCommand(restConfig *rest.Config, token string) (string, error) {
+	if restConfig == nil {
+		return "", fmt.Errorf("cannot compute join command without REST config")
+	}
+
+	caData := restConfig.CAData
+	if len(caData) == 0 && restConfig.CAFile != "" {
+		var err error
+		if caData, err = os.ReadFile(restConfig.CAFile); err != nil {
+			return "", fmt.Errorf("failed reading CA file %s: %w", restConfig.CAFile, err)
+		}
+	}
+
+	if len(caData) == 0 {
+		return "", fmt.Errorf("the kubeconfig does not contain a CA certificate which is required for computing the join command")
+	}
+
+	return cmd.JoinCommand(token, caData, restConfig.Host)
+}
@@ -6,35 +6,130 @@ package create_test
 
 import (
 	"bytes"
+	"context"
 	"io"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/rest"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/token/create"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("Create", func() {
 	var (
-		ioStreams genericiooptions.IOStreams
-		out       *bytes.Buffer
-		cmd       *cobra.Command
+		ctx        context.Context
+		ioStreams  genericiooptions.IOStreams
+		out        *bytes.Buffer
+		cmd        *cobra.Command
+		fakeClient client.Client
+		restConfig *rest.Config
+		now        time.Time
+
+		token = "abcdef.0123456789abcdef"
 	)
 
 	BeforeEach(func() {
+		ctx = context.Background()
 		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		restConfig = &rest.Config{Host: "https://api.example.com"}
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		DeferCleanup(test.WithVars(
+			&gardenadmcmd.NewClientSetFromFile, func(string, *runtime.Scheme) (kubernetes.Interface, error) {
+				return fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).WithRESTConfig(restConfig).Build(), nil
+			},
+			&gardenadmcmd.TimeNow, func() time.Time { return now },
+		))
+
+		Expect(cmd.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
 	})
 
+	getSecret := func() *corev1.Secret {
+		secret := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "bootstrap-token-abcdef", Namespace: "kube-system"}, secret)).To(Succeed())
+		return secret
+	}
+
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(cmd.RunE(cmd, []string{"some-token"})).To(Succeed())
+		It("should create the bootstrap token and print it", func() {
+			Expect(cmd.RunE(cmd, []string{token})).To(Succeed())
+
+			output, err := io.ReadAll(out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(output)).To(Equal(token + "\n"))
+
+			secret := getSecret()
+			Expect(secret.Type).To(Equal(bootstraptokenapi.SecretTypeBootstrapToken))
+			Expect(secret.Data).To(Equal(map[string][]byte{
+				"token-id":                       []byte("abcdef"),
+				"token-secret":                   []byte("0123456789abcdef"),
+				"description":                    []byte("Used for joining nodes via `gardenadm join`"),
+				"expiration":                     []byte("2024-01-02T00:00:00Z"),
+				"usage-bootstrap-signing":        []byte("true"),
+				"usage-bootstrap-authentication": []byte("true"),
+			}))
+		})
+
+		It("should create the bootstrap token with the given settings", func() {
+			Expect(cmd.Flags().Set("ttl", "0")).To(Succeed())
+			Expect(cmd.Flags().Set("description", "foo")).To(Succeed())
+			Expect(cmd.Flags().Set("usages", "authentication")).To(Succeed())
+			Expect(cmd.Flags().Set("groups", "system:bootstrappers:foo,system:bootstrappers:bar")).To(Succeed())
+
+			Expect(cmd.RunE(cmd, []string{token})).To(Succeed())
+
+			Expect(getSecret().Data).To(Equal(map[string][]byte{
+				"token-id":                       []byte("abcdef"),
+				"token-secret":                   []byte("0123456789abcdef"),
+				"description":                    []byte("foo"),
+				"usage-bootstrap-authentication": []byte("true"),
+				"auth-extra-groups":              []byte("system:bootstrappers:foo,system:bootstrappers:bar"),
+			}))
+		})
+
+		It("should fail if the token already exists", func() {
+			Expect(cmd.RunE(cmd, []string{token})).To(Succeed())
+			Expect(cmd.RunE(cmd, []string{token})).To(MatchError(ContainSubstring(`a bootstrap token with ID "abcdef" already exists`)))
+		})
+
+		It("should print the join command", func() {
+			ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			caCert, err := utils.DecodeCertificate(ca.CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+			restConfig.CAData = ca.CertificatePEM
+
+			Expect(cmd.Flags().Set("print-join-command", "true")).To(Succeed())
+			Expect(cmd.RunE(cmd, []string{token})).To(Succeed())
 
 			output, err := io.ReadAll(out)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented\n"))
+			Expect(string(output)).To(Equal("gardenadm join --bootstrap-token " + token + " --ca-certificate-hash " + bootstraptoken.CACertificateHash(caCert) + " https://api.example.com\n"))
+		})
+
+		It("should fail printing the join command if the kubeconfig has no CA", func() {
+			Expect(cmd.Flags().Set("print-join-command", "true")).To(Succeed())
+			Expect(cmd.RunE(cmd, []string{token})).To(MatchError(ContainSubstring("does not contain a CA certificate")))
 		})
 	})
 })
@@ -6,26 +6,49 @@ package create
 
 import (
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
 )
 
 // Options contains options for this command.
 type Options struct {
+	// Kubeconfig is the path to the kubeconfig file pointing to the autonomous shoot cluster.
+	Kubeconfig string
 	// Token is the token to create.
 	Token string
+	// Description is the human-readable description of the token.
+	Description string
+	// TTL is the duration after which the token is automatically deleted. A zero value means the token never expires.
+	TTL time.Duration
+	// Usages are the ways in which the token can be used.
+	Usages []string
+	// Groups are the extra groups the token authenticates as when used for authentication.
+	Groups []string
+	// PrintJoinCommand specifies whether the full 'gardenadm join' command should be printed instead of only the token.
+	PrintJoinCommand bool
 }
 
 // Complete completes the options.
 func (o *Options) Complete(args []string) error {
+	if o.Kubeconfig == "" {
+		o.Kubeconfig = os.Getenv("KUBECONFIG")
+	}
+
 	if len(args) > 0 {
 		o.Token = strings.TrimSpace(args[0])
 	}
 
 	if o.Token == "" {
-		// TODO: Generate a random token instead.
-		o.Token = "foo123.bar4567890baz123"
+		token, err := bootstraptokenutil.GenerateBootstrapToken()
+		if err != nil {
+			return fmt.Errorf("failed generating random bootstrap token: %w", err)
+		}
+		o.Token = token
 	}
 
 	return nil
@@ -33,13 +56,40 @@ func (o *Options) Complete(args []string) error {
 
 // Validate validates the options.
 func (o *Options) Validate() error {
+	if len(o.Kubeconfig) == 0 {
+		return fmt.Errorf("must provide a path to a kubeconfig")
+	}
+
 	if o.Token == "" {
 		return fmt.Errorf("must provide a token to create")
 	}
 
-	// TODO: Validate that the token has the correct/expected format
+	if !bootstraptokenutil.IsValidBootstrapToken(o.Token) {
+		return fmt.Errorf("the token %q does not match the expected format %q", o.Token, bootstraptokenapi.BootstrapTokenPattern)
+	}
+
+	if o.TTL < 0 {
+		return fmt.Errorf("the TTL must not be negative")
+	}
+
+	if err := bootstraptokenutil.ValidateUsages(o.Usages); err != nil {
+		return err
+	}
+
+	for _, group := range o.Groups {
+		if err := bootstraptokenutil.ValidateBootstrapGroupName(group); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the autonomous shoot cluster (defaults to the KUBECONFIG environment variable)")
+	fs.StringVar(&o.Description, "description", "Used for joining nodes via `gardenadm join`", "A human-readable description of how this token is used")
+	fs.DurationVar(&o.TTL, "ttl", 24*time.Hour, "The duration before the token is automatically deleted (e.g. 1s, 2m, 3h). If set to '0', the token will never expire")
+	fs.StringSliceVar(&o.Usages, "usages", bootstraptokenapi.KnownTokenUsages, "Describes the ways in which this token can be used")
+	fs.StringSliceVar(&o.Groups, "groups", nil, "Extra groups that this token will authenticate as when used for authentication. Must match \""+bootstraptokenapi.BootstrapGroupPattern+"\"")
+	fs.BoolVar(&o.PrintJoinCommand, "print-join-command", false, "Instead of printing only the token, print the full 'gardenadm join' command that can be used to join a node with this token")
+}
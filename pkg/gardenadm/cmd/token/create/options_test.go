@@ -5,6 +5,8 @@
 package create_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -15,7 +17,7 @@ var _ = Describe("Options", func() {
 	var (
 		options *Options
 
-		token = "some.token"
+		token = "abcdef.0123456789abcdef"
 	)
 
 	BeforeEach(func() {
@@ -30,19 +32,64 @@ var _ = Describe("Options", func() {
 
 		It("should generate a random token", func() {
 			Expect(options.Complete(nil)).To(Succeed())
-			Expect(options.Token).To(Equal("foo123.bar4567890baz123"))
+			Expect(options.Token).To(MatchRegexp(`^[a-z0-9]{6}\.[a-z0-9]{16}$`))
+		})
+
+		It("should default the kubeconfig from the environment", func() {
+			GinkgoT().Setenv("KUBECONFIG", "some-path-to-kubeconfig")
+
+			Expect(options.Complete(nil)).To(Succeed())
+			Expect(options.Kubeconfig).To(Equal("some-path-to-kubeconfig"))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should pass for valid options", func() {
-			options.Token = "foo"
+		BeforeEach(func() {
+			options.Kubeconfig = "some-path-to-kubeconfig"
+			options.Token = token
+			options.TTL = time.Hour
+			options.Usages = []string{"signing", "authentication"}
+			options.Groups = []string{"system:bootstrappers:foo"}
+		})
 
+		It("should pass for valid options", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
 
+		It("should fail because kubeconfig is not set", func() {
+			options.Kubeconfig = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a kubeconfig")))
+		})
+
 		It("should fail because token is not set", func() {
+			options.Token = ""
+
 			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a token to create")))
 		})
+
+		It("should fail because token has an invalid format", func() {
+			options.Token = "foo"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("does not match the expected format")))
+		})
+
+		It("should fail because TTL is negative", func() {
+			options.TTL = -time.Hour
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must not be negative")))
+		})
+
+		It("should fail because of an unknown usage", func() {
+			options.Usages = []string{"foo"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("invalid bootstrap token usage string")))
+		})
+
+		It("should fail because of an invalid group", func() {
+			options.Groups = []string{"system:masters"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("bootstrap group")))
+		})
 	})
 })
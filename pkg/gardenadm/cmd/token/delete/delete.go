@@ -9,7 +9,14 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,8 +26,9 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete [token-id]",
 		Short: "Delete a bootstrap token on the server",
-		Long: "This command will delete a bootstrap token for you." +
-			"The [token-id] is the ID of the token of the form \"[a-z0-9]{6}\" to delete",
+		Long: "This command will delete a bootstrap token for you. " +
+			"The [token-id] is the ID of the token of the form \"[a-z0-9]{6}\" to delete. " +
+			"Alternatively, the full token of the form \"[a-z0-9]{6}.[a-z0-9]{16}\" can be passed.",
 
 		Example: `# Delete a bootstrap token with id "foo123" on the server
 gardenadm token delete foo123`,
@@ -45,7 +53,20 @@ gardenadm token delete foo123`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprintln(ioStreams.Out, "not implemented")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	clientSet, err := cmd.NewClientSetFromFile(opts.Kubeconfig, kubernetes.ShootScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: bootstraptokenutil.BootstrapTokenSecretName(opts.TokenID), Namespace: metav1.NamespaceSystem}}
+	if err := clientSet.Client().Delete(ctx, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("bootstrap token with ID %q does not exist", opts.TokenID)
+		}
+		return fmt.Errorf("failed deleting bootstrap token secret: %w", err)
+	}
+
+	fmt.Fprintf(ioStreams.Out, "bootstrap token %q deleted\n", opts.TokenID)
 	return nil
 }
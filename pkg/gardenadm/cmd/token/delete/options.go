@@ -6,33 +6,58 @@ package delete
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/pflag"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+	"k8s.io/cluster-bootstrap/util/tokens"
 )
 
 // Options contains options for this command.
 type Options struct {
+	// Kubeconfig is the path to the kubeconfig file pointing to the autonomous shoot cluster.
+	Kubeconfig string
 	// TokenID is the ID of the token to delete.
 	TokenID string
 }
 
 // Complete completes the options.
 func (o *Options) Complete(args []string) error {
+	if o.Kubeconfig == "" {
+		o.Kubeconfig = os.Getenv("KUBECONFIG")
+	}
+
 	if len(args) > 0 {
 		o.TokenID = strings.TrimSpace(args[0])
 	}
 
+	// Allow passing the full token as well for convenience.
+	if tokenID, _, err := tokens.ParseToken(o.TokenID); err == nil {
+		o.TokenID = tokenID
+	}
+
 	return nil
 }
 
 // Validate validates the options.
 func (o *Options) Validate() error {
+	if len(o.Kubeconfig) == 0 {
+		return fmt.Errorf("must provide a path to a kubeconfig")
+	}
+
 	if o.TokenID == "" {
 		return fmt.Errorf("must provide a token ID to delete")
 	}
 
+	if !bootstraptokenutil.IsValidBootstrapTokenID(o.TokenID) {
+		return fmt.Errorf("the token ID %q does not match the expected format %q", o.TokenID, bootstraptokenapi.BootstrapTokenIDPattern)
+	}
+
 	return nil
 }
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the autonomous shoot cluster (defaults to the KUBECONFIG environment variable)")
+}
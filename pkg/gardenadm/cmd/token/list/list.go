@@ -6,10 +6,27 @@ package list
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+	bootstrapsecretutil "k8s.io/cluster-bootstrap/util/secrets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -22,7 +39,10 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 		Long:  "List all bootstrap tokens on the server",
 
 		Example: `# List all bootstrap tokens on the server
-gardenadm token list`,
+gardenadm token list
+
+# List all bootstrap tokens on the server in YAML format
+gardenadm token list --output yaml`,
 
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := opts.Complete(); err != nil {
@@ -42,7 +62,122 @@ gardenadm token list`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprintln(ioStreams.Out, "not implemented")
+// bootstrapToken is the printable representation of a bootstrap token secret.
+type bootstrapToken struct {
+	Token       string       `json:"token"`
+	Description string       `json:"description,omitempty"`
+	Expires     *metav1.Time `json:"expires,omitempty"`
+	Usages      []string     `json:"usages,omitempty"`
+	Groups      []string     `json:"groups,omitempty"`
+}
+
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	clientSet, err := cmd.NewClientSetFromFile(opts.Kubeconfig, kubernetes.ShootScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := clientSet.Client().List(ctx, secretList, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fmt.Errorf("failed listing bootstrap token secrets: %w", err)
+	}
+
+	tokens := make([]bootstrapToken, 0, len(secretList.Items))
+	for _, secret := range secretList.Items {
+		if secret.Type != bootstraptokenapi.SecretTypeBootstrapToken {
+			continue
+		}
+
+		token, err := bootstrapTokenFromSecret(&secret)
+		if err != nil {
+			fmt.Fprintf(ioStreams.ErrOut, "Skipping invalid bootstrap token secret %s: %v\n", secret.Name, err)
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Token < tokens[j].Token })
+
+	switch opts.OutputFormat {
+	case OutputFormatJSON:
+		data, err := json.MarshalIndent(tokens, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(ioStreams.Out, string(data))
+	case OutputFormatYAML:
+		data, err := yaml.Marshal(tokens)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(ioStreams.Out, string(data))
+	default:
+		return printTable(ioStreams.Out, tokens)
+	}
+
 	return nil
 }
+
+func bootstrapTokenFromSecret(secret *corev1.Secret) (bootstrapToken, error) {
+	tokenID := bootstrapsecretutil.GetData(secret, bootstraptokenapi.BootstrapTokenIDKey)
+	tokenSecret := bootstrapsecretutil.GetData(secret, bootstraptokenapi.BootstrapTokenSecretKey)
+	token := bootstraptokenutil.TokenFromIDAndSecret(tokenID, tokenSecret)
+
+	if !bootstraptokenutil.IsValidBootstrapToken(token) {
+		return bootstrapToken{}, fmt.Errorf("token does not match the expected format")
+	}
+
+	result := bootstrapToken{
+		Token:       token,
+		Description: bootstrapsecretutil.GetData(secret, bootstraptokenapi.BootstrapTokenDescriptionKey),
+	}
+
+	if expiration := bootstrapsecretutil.GetData(secret, bootstraptokenapi.BootstrapTokenExpirationKey); expiration != "" {
+		expires, err := time.Parse(time.RFC3339, expiration)
+		if err != nil {
+			return bootstrapToken{}, fmt.Errorf("failed parsing expiration %q: %w", expiration, err)
+		}
+		result.Expires = &metav1.Time{Time: expires}
+	}
+
+	for key, value := range secret.Data {
+		if strings.HasPrefix(key, bootstraptokenapi.BootstrapTokenUsagePrefix) && string(value) == "true" {
+			result.Usages = append(result.Usages, strings.TrimPrefix(key, bootstraptokenapi.BootstrapTokenUsagePrefix))
+		}
+	}
+	sort.Strings(result.Usages)
+
+	groups, err := bootstrapsecretutil.GetGroups(secret)
+	if err != nil {
+		return bootstrapToken{}, err
+	}
+	// The default group is always added implicitly, hence only the extra groups are of interest here.
+	for _, group := range groups {
+		if group != bootstraptokenapi.BootstrapDefaultGroup {
+			result.Groups = append(result.Groups, group)
+		}
+	}
+
+	return result, nil
+}
+
+func printTable(out io.Writer, tokens []bootstrapToken) error {
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "TOKEN\tTTL\tEXPIRES\tUSAGES\tDESCRIPTION\tEXTRA GROUPS")
+
+	now := cmd.TimeNow()
+	for _, token := range tokens {
+		ttl, expires := "<forever>", "<never>"
+		if token.Expires != nil {
+			expires = token.Expires.UTC().Format(time.RFC3339)
+			ttl = "<expired>"
+			if remaining := token.Expires.Sub(now); remaining > 0 {
+				ttl = duration.ShortHumanDuration(remaining)
+			}
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", token.Token, ttl, expires, strings.Join(token.Usages, ","), token.Description, strings.Join(token.Groups, ","))
+	}
+
+	return w.Flush()
+}
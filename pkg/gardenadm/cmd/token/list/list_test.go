@@ -6,35 +6,134 @@ package list_test
 
 import (
 	"bytes"
+	"context"
 	"io"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/token/list"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("List", func() {
 	var (
-		ioStreams genericiooptions.IOStreams
-		out       *bytes.Buffer
-		cmd       *cobra.Command
+		ctx        context.Context
+		ioStreams  genericiooptions.IOStreams
+		out        *bytes.Buffer
+		errOut     *bytes.Buffer
+		cmd        *cobra.Command
+		fakeClient client.Client
 	)
 
 	BeforeEach(func() {
-		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
+		ctx = context.Background()
+		ioStreams, _, out, errOut = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		DeferCleanup(test.WithVars(
+			&gardenadmcmd.NewClientSetFromFile, func(string, *runtime.Scheme) (kubernetes.Interface, error) {
+				return fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(), nil
+			},
+			&gardenadmcmd.TimeNow, func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) },
+		))
+
+		for _, secret := range []*corev1.Secret{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "bootstrap-token-abcdef", Namespace: "kube-system"},
+				Type:       bootstraptokenapi.SecretTypeBootstrapToken,
+				Data: map[string][]byte{
+					"token-id":                       []byte("abcdef"),
+					"token-secret":                   []byte("0123456789abcdef"),
+					"description":                    []byte("foo"),
+					"expiration":                     []byte("2024-01-01T02:00:00Z"),
+					"usage-bootstrap-signing":        []byte("true"),
+					"usage-bootstrap-authentication": []byte("true"),
+					"auth-extra-groups":              []byte("system:bootstrappers:foo"),
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "bootstrap-token-ghijkl", Namespace: "kube-system"},
+				Type:       bootstraptokenapi.SecretTypeBootstrapToken,
+				Data: map[string][]byte{
+					"token-id":                       []byte("ghijkl"),
+					"token-secret":                   []byte("0123456789abcdef"),
+					"usage-bootstrap-authentication": []byte("true"),
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "bootstrap-token-invalid", Namespace: "kube-system"},
+				Type:       bootstraptokenapi.SecretTypeBootstrapToken,
+				Data:       map[string][]byte{"token-id": []byte("invalid")},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "kube-system"},
+				Data:       map[string][]byte{"foo": []byte("bar")},
+			},
+		} {
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+		}
+
+		Expect(cmd.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
+		It("should print the bootstrap tokens as table", func() {
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+			output, err := io.ReadAll(out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(output)).To(Equal(`TOKEN                     TTL         EXPIRES                USAGES                   DESCRIPTION   EXTRA GROUPS
+abcdef.0123456789abcdef   2h          2024-01-01T02:00:00Z   authentication,signing   foo           system:bootstrappers:foo
+ghijkl.0123456789abcdef   <forever>   <never>                authentication                         
+`))
+			Expect(errOut.String()).To(ContainSubstring("Skipping invalid bootstrap token secret bootstrap-token-invalid"))
+		})
+
+		It("should print the bootstrap tokens as YAML", func() {
+			Expect(cmd.Flags().Set("output", "yaml")).To(Succeed())
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+			output, err := io.ReadAll(out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(output)).To(Equal(`- description: foo
+  expires: "2024-01-01T02:00:00Z"
+  groups:
+  - system:bootstrappers:foo
+  token: abcdef.0123456789abcdef
+  usages:
+  - authentication
+  - signing
+- token: ghijkl.0123456789abcdef
+  usages:
+  - authentication
+`))
+		})
+
+		It("should print the bootstrap tokens as JSON", func() {
+			Expect(cmd.Flags().Set("output", "json")).To(Succeed())
 			Expect(cmd.RunE(cmd, nil)).To(Succeed())
 
 			output, err := io.ReadAll(out)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented\n"))
+			Expect(string(output)).To(MatchJSON(`[
+  {"token": "abcdef.0123456789abcdef", "description": "foo", "expires": "2024-01-01T02:00:00Z", "usages": ["authentication", "signing"], "groups": ["system:bootstrappers:foo"]},
+  {"token": "ghijkl.0123456789abcdef", "usages": ["authentication"]}
+]`))
 		})
 	})
 })
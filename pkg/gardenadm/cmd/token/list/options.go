@@ -5,16 +5,59 @@
 package list
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// OutputFormatTable prints the tokens as table.
+	OutputFormatTable = "table"
+	// OutputFormatJSON prints the tokens as JSON.
+	OutputFormatJSON = "json"
+	// OutputFormatYAML prints the tokens as YAML.
+	OutputFormatYAML = "yaml"
 )
 
+var supportedOutputFormats = sets.New(OutputFormatTable, OutputFormatJSON, OutputFormatYAML)
+
 // Options contains options for this command.
-type Options struct{}
+type Options struct {
+	// Kubeconfig is the path to the kubeconfig file pointing to the autonomous shoot cluster.
+	Kubeconfig string
+	// OutputFormat is the format in which the tokens are printed.
+	OutputFormat string
+}
 
 // Complete completes the options.
-func (o *Options) Complete() error { return nil }
+func (o *Options) Complete() error {
+	if o.Kubeconfig == "" {
+		o.Kubeconfig = os.Getenv("KUBECONFIG")
+	}
+
+	if o.OutputFormat == "" {
+		o.OutputFormat = OutputFormatTable
+	}
+
+	return nil
+}
 
 // Validate validates the options.
-func (o *Options) Validate() error { return nil }
+func (o *Options) Validate() error {
+	if len(o.Kubeconfig) == 0 {
+		return fmt.Errorf("must provide a path to a kubeconfig")
+	}
+
+	if !supportedOutputFormats.Has(o.OutputFormat) {
+		return fmt.Errorf("unsupported output format %q, supported formats are %v", o.OutputFormat, sets.List(supportedOutputFormats))
+	}
+
+	return nil
+}
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the autonomous shoot cluster (defaults to the KUBECONFIG environment variable)")
+	fs.StringVarP(&o.OutputFormat, "output", "o", OutputFormatTable, "Output format, one of: table, json, yaml")
+}
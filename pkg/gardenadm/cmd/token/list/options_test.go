@@ -21,14 +21,35 @@ var _ = Describe("Options", func() {
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
+		It("should default the kubeconfig and the output format", func() {
+			GinkgoT().Setenv("KUBECONFIG", "some-path-to-kubeconfig")
+
 			Expect(options.Complete()).To(Succeed())
+			Expect(options.Kubeconfig).To(Equal("some-path-to-kubeconfig"))
+			Expect(options.OutputFormat).To(Equal("table"))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should return nil", func() {
+		BeforeEach(func() {
+			options.Kubeconfig = "some-path-to-kubeconfig"
+			options.OutputFormat = "json"
+		})
+
+		It("should pass for valid options", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
+
+		It("should fail because kubeconfig is not set", func() {
+			options.Kubeconfig = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a kubeconfig")))
+		})
+
+		It("should fail because output format is not supported", func() {
+			options.OutputFormat = "xml"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unsupported output format "xml"`)))
+		})
 	})
 })
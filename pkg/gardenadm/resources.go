@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardenadm
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Resources contains the resources read from the manifests directory which are required for bootstrapping an
+// autonomous shoot cluster.
+type Resources struct {
+	// CloudProfile is the CloudProfile referenced by the Shoot.
+	CloudProfile *gardencorev1beta1.CloudProfile
+	// NamespacedCloudProfile is the NamespacedCloudProfile referenced by the Shoot (if any).
+	NamespacedCloudProfile *gardencorev1beta1.NamespacedCloudProfile
+	// Project is the Project the Shoot belongs to. If no Project manifest is provided, it is derived from the namespace
+	// of the Shoot.
+	Project *gardencorev1beta1.Project
+	// Shoot is the Shoot describing the autonomous shoot cluster.
+	Shoot *gardencorev1beta1.Shoot
+	// ControllerRegistrations are the ControllerRegistrations of the extensions.
+	ControllerRegistrations []*gardencorev1beta1.ControllerRegistration
+	// ControllerDeployments are the ControllerDeployments of the extensions.
+	ControllerDeployments []*gardencorev1.ControllerDeployment
+	// SecretBinding is the SecretBinding referenced by the Shoot (if any).
+	SecretBinding *gardencorev1beta1.SecretBinding
+	// CredentialsBinding is the CredentialsBinding referenced by the Shoot (if any).
+	CredentialsBinding *securityv1alpha1.CredentialsBinding
+	// WorkloadIdentity is the WorkloadIdentity referenced by the CredentialsBinding (if any).
+	WorkloadIdentity *securityv1alpha1.WorkloadIdentity
+	// Secrets are the Secrets contained in the manifests directory.
+	Secrets []*corev1.Secret
+	// ShootState is the ShootState of the Shoot (if any). It is handed over by 'gardenadm bootstrap' and contains the
+	// state of the resources which were created in the bootstrap cluster (e.g., the certificate authorities).
+	ShootState *gardencorev1beta1.ShootState
+}
+
+// ReadManifests reads all YAML manifests from the given file system and returns the contained resources. Each file
+// might contain multiple documents separated by '---'. It returns an error if the manifests contain unsupported
+// resources, if singleton resources are contained multiple times, or if the CloudProfile or Shoot is missing.
+func ReadManifests(fsys fs.FS) (Resources, error) {
+	var resources Resources
+
+	if err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || (filepath.Ext(path) != ".yaml" && filepath.Ext(path) != ".yml") {
+			return nil
+		}
+
+		file, err := fsys.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed opening file %s: %w", path, err)
+		}
+		defer file.Close()
+
+		decoder := utilyaml.NewYAMLOrJSONDecoder(file, 1024)
+		for {
+			var raw runtime.RawExtension
+			if err := decoder.Decode(&raw); err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return fmt.Errorf("failed decoding file %s: %w", path, err)
+			}
+
+			if len(strings.TrimSpace(string(raw.Raw))) == 0 || string(raw.Raw) == "null" {
+				continue
+			}
+
+			obj, err := runtime.Decode(kubernetes.GardenCodec.UniversalDeserializer(), raw.Raw)
+			if err != nil {
+				return fmt.Errorf("failed decoding resource in file %s: %w", path, err)
+			}
+			// Apply the defaults like the API server would do when creating the resources in the garden cluster.
+			kubernetes.GardenScheme.Default(obj)
+
+			if err := resources.add(obj); err != nil {
+				return fmt.Errorf("failed adding resource from file %s: %w", path, err)
+			}
+		}
+	}); err != nil {
+		return Resources{}, err
+	}
+
+	if resources.CloudProfile == nil {
+		return Resources{}, fmt.Errorf("must provide a *gardencorev1beta1.CloudProfile resource, but did not find any")
+	}
+	if resources.Shoot == nil {
+		return Resources{}, fmt.Errorf("must provide a *gardencorev1beta1.Shoot resource, but did not find any")
+	}
+
+	if resources.Project == nil {
+		resources.Project = projectForNamespace(resources.Shoot.Namespace)
+	}
+
+	return resources, nil
+}
+
+func (r *Resources) add(obj runtime.Object) error {
+	switch o := obj.(type) {
+	case *gardencorev1beta1.CloudProfile:
+		if r.CloudProfile != nil {
+			return errMultipleResources(o)
+		}
+		r.CloudProfile = o
+	case *gardencorev1beta1.NamespacedCloudProfile:
+		if r.NamespacedCloudProfile != nil {
+			return errMultipleResources(o)
+		}
+		r.NamespacedCloudProfile = o
+	case *gardencorev1beta1.Project:
+		if r.Project != nil {
+			return errMultipleResources(o)
+		}
+		r.Project = o
+	case *gardencorev1beta1.Shoot:
+		if r.Shoot != nil {
+			return errMultipleResources(o)
+		}
+		r.Shoot = o
+	case *gardencorev1beta1.SecretBinding:
+		if r.SecretBinding != nil {
+			return errMultipleResources(o)
+		}
+		r.SecretBinding = o
+	case *securityv1alpha1.CredentialsBinding:
+		if r.CredentialsBinding != nil {
+			return errMultipleResources(o)
+		}
+		r.CredentialsBinding = o
+	case *securityv1alpha1.WorkloadIdentity:
+		if r.WorkloadIdentity != nil {
+			return errMultipleResources(o)
+		}
+		r.WorkloadIdentity = o
+	case *gardencorev1beta1.ControllerRegistration:
+		r.ControllerRegistrations = append(r.ControllerRegistrations, o)
+	case *gardencorev1.ControllerDeployment:
+		r.ControllerDeployments = append(r.ControllerDeployments, o)
+	case *corev1.Secret:
+		r.Secrets = append(r.Secrets, o)
+	case *gardencorev1beta1.ShootState:
+		if r.ShootState != nil {
+			return errMultipleResources(o)
+		}
+		r.ShootState = o
+	default:
+		return fmt.Errorf("unsupported resource type %T", obj)
+	}
+
+	return nil
+}
+
+func errMultipleResources(obj runtime.Object) error {
+	return fmt.Errorf("found more than one %T resource, but only one is allowed", obj)
+}
+
+func projectForNamespace(namespace string) *gardencorev1beta1.Project {
+	name := strings.TrimPrefix(namespace, gardenerutils.ProjectNamespacePrefix)
+	if namespace == v1beta1constants.GardenNamespace {
+		name = v1beta1constants.GardenNamespace
+	}
+
+	return &gardencorev1beta1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       gardencorev1beta1.ProjectSpec{Namespace: &namespace},
+	}
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardenadm_test
+
+import (
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/gardenadm"
+)
+
+var _ = Describe("Resources", func() {
+	Describe("#ReadManifests", func() {
+		var fsys fstest.MapFS
+
+		BeforeEach(func() {
+			fsys = fstest.MapFS{
+				"cloudprofile.yaml": &fstest.MapFile{Data: []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: CloudProfile
+metadata:
+  name: local
+spec:
+  type: local
+`)},
+				"shoot.yaml": &fstest.MapFile{Data: []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: root
+  namespace: garden-foo
+spec:
+  cloudProfile:
+    name: local
+`)},
+				"extensions/provider-local.yaml": &fstest.MapFile{Data: []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: ControllerRegistration
+metadata:
+  name: provider-local
+---
+apiVersion: core.gardener.cloud/v1
+kind: ControllerDeployment
+metadata:
+  name: provider-local
+helm:
+  rawChart: Zm9v
+`)},
+				"README.md": &fstest.MapFile{Data: []byte("not a manifest")},
+			}
+		})
+
+		It("should read all resources and derive the project", func() {
+			resources, err := ReadManifests(fsys)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resources.CloudProfile.Name).To(Equal("local"))
+			Expect(resources.Shoot.Name).To(Equal("root"))
+			Expect(resources.ControllerRegistrations).To(HaveLen(1))
+			Expect(resources.ControllerRegistrations[0].Name).To(Equal("provider-local"))
+			Expect(resources.ControllerDeployments).To(HaveLen(1))
+			Expect(resources.ControllerDeployments[0].Helm.RawChart).To(Equal([]byte("foo")))
+
+			Expect(resources.Project.Name).To(Equal("foo"))
+			Expect(resources.Project.Spec.Namespace).To(Equal(ptr.To("garden-foo")))
+		})
+
+		It("should use the provided project", func() {
+			fsys["project.yaml"] = &fstest.MapFile{Data: []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: Project
+metadata:
+  name: bar
+spec:
+  namespace: garden-foo
+`)}
+
+			resources, err := ReadManifests(fsys)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources.Project.Name).To(Equal("bar"))
+		})
+
+		It("should read the handed over shoot state", func() {
+			fsys["shootstate.yaml"] = &fstest.MapFile{Data: []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: ShootState
+metadata:
+  name: root
+  namespace: garden-foo
+spec:
+  gardener:
+  - name: ca
+    type: secret
+`)}
+
+			resources, err := ReadManifests(fsys)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources.ShootState.Spec.Gardener).To(ConsistOf(HaveField("Name", "ca")))
+		})
+
+		It("should fail if the shoot is missing", func() {
+			delete(fsys, "shoot.yaml")
+
+			_, err := ReadManifests(fsys)
+			Expect(err).To(MatchError(ContainSubstring("must provide a *gardencorev1beta1.Shoot resource")))
+		})
+
+		It("should fail if the cloud profile is missing", func() {
+			delete(fsys, "cloudprofile.yaml")
+
+			_, err := ReadManifests(fsys)
+			Expect(err).To(MatchError(ContainSubstring("must provide a *gardencorev1beta1.CloudProfile resource")))
+		})
+
+		It("should fail if a singleton resource is contained multiple times", func() {
+			fsys["other-shoot.yaml"] = fsys["shoot.yaml"]
+
+			_, err := ReadManifests(fsys)
+			Expect(err).To(MatchError(ContainSubstring("found more than one *v1beta1.Shoot resource")))
+		})
+
+		It("should fail for unsupported resources", func() {
+			fsys["configmap.yaml"] = &fstest.MapFile{Data: []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+`)}
+
+			_, err := ReadManifests(fsys)
+			Expect(err).To(MatchError(ContainSubstring("unsupported resource type *v1.ConfigMap")))
+		})
+	})
+})
@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package staticpod
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// ManifestsDir is the directory on the node from which kubelet reads the static pod manifests.
+	ManifestsDir = "/etc/kubernetes/manifests"
+	// VolumesDir is the directory on the node below which the content of the translated volumes is stored.
+	VolumesDir = "/var/lib"
+	// PriorityClassName is the priority class used for all static pods.
+	PriorityClassName = "system-node-critical"
+)
+
+var encoder = kubernetes.SeedCodec.EncoderForVersion(kubernetes.SeedSerializer, corev1.SchemeGroupVersion)
+
+// Translate translates the given object (Deployment, StatefulSet, or Pod) into the files which are needed to run it as
+// a static pod. Static pods must not reference any API objects, hence all Secret, ConfigMap, and projected volumes are
+// translated into files on the node which are mounted via hostPath volumes. The first returned list contains the static
+// pod manifest as well as the files for the ConfigMap volumes. The second list contains the files for the Secret volumes.
+// They are returned separately since they contain confidential data (e.g., private keys of certificate authorities)
+// which must not be distributed via an OperatingSystemConfig. The optional mutate functions can be used to adapt the pod
+// before it is encoded.
+func Translate(ctx context.Context, c client.Reader, obj client.Object, mutateFns ...func(*corev1.Pod)) ([]extensionsv1alpha1.File, []extensionsv1alpha1.File, error) {
+	var (
+		objectMeta metav1.ObjectMeta
+		podSpec    corev1.PodSpec
+	)
+
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		objectMeta, podSpec = o.Spec.Template.ObjectMeta, o.Spec.Template.Spec
+	case *appsv1.StatefulSet:
+		objectMeta, podSpec = o.Spec.Template.ObjectMeta, o.Spec.Template.Spec
+	case *corev1.Pod:
+		objectMeta, podSpec = o.ObjectMeta, o.Spec
+	default:
+		return nil, nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        obj.GetName(),
+			Namespace:   obj.GetNamespace(),
+			Labels:      objectMeta.Labels,
+			Annotations: objectMeta.Annotations,
+		},
+		Spec: *podSpec.DeepCopy(),
+	}
+
+	files, secretFiles, err := translateVolumes(ctx, c, pod)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := validateEnvironment(pod); err != nil {
+		return nil, nil, err
+	}
+
+	// Static pods are not scheduled and must not reference any service accounts.
+	pod.Spec.ServiceAccountName = ""
+	pod.Spec.DeprecatedServiceAccount = ""
+	pod.Spec.AutomountServiceAccountToken = nil
+	pod.Spec.Affinity = nil
+	pod.Spec.TopologySpreadConstraints = nil
+	pod.Spec.HostNetwork = true
+	pod.Spec.PriorityClassName = PriorityClassName
+	pod.Spec.Priority = nil
+
+	// The files of the translated volumes are owned by root, hence the containers must run as root (like for kubeadm).
+	if pod.Spec.SecurityContext != nil {
+		pod.Spec.SecurityContext.RunAsUser = nil
+		pod.Spec.SecurityContext.RunAsGroup = nil
+		pod.Spec.SecurityContext.RunAsNonRoot = nil
+		pod.Spec.SecurityContext.FSGroup = nil
+	}
+	for i := range pod.Spec.InitContainers {
+		clearRunAsUser(pod.Spec.InitContainers[i].SecurityContext)
+	}
+	for i := range pod.Spec.Containers {
+		clearRunAsUser(pod.Spec.Containers[i].SecurityContext)
+	}
+
+	for _, fn := range mutateFns {
+		fn(pod)
+	}
+
+	pod.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+	podRaw, err := runtime.Encode(encoder, pod)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed encoding static pod manifest for %s: %w", client.ObjectKeyFromObject(pod), err)
+	}
+
+	return append([]extensionsv1alpha1.File{{
+		Path:        filepath.Join(ManifestsDir, pod.Name+".yaml"),
+		Permissions: ptr.To[uint32](0600),
+		Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64(podRaw)}},
+	}}, files...), secretFiles, nil
+}
+
+func translateVolumes(ctx context.Context, c client.Reader, pod *corev1.Pod) ([]extensionsv1alpha1.File, []extensionsv1alpha1.File, error) {
+	var files, secretFiles []extensionsv1alpha1.File
+
+	// Static pods cannot use service account tokens, hence volumes projecting such tokens are removed. The components
+	// must be configured to use a kubeconfig instead.
+	removeServiceAccountTokenVolumes(pod)
+
+	for i, volume := range pod.Spec.Volumes {
+		var (
+			dir               = filepath.Join(VolumesDir, pod.Name, volume.Name)
+			volumeFiles       []extensionsv1alpha1.File
+			volumeSecretFiles []extensionsv1alpha1.File
+			err               error
+		)
+
+		switch {
+		case volume.Secret != nil:
+			volumeSecretFiles, err = filesForSecret(ctx, c, pod.Namespace, dir, volume.Secret.SecretName, volume.Secret.Items, volume.Secret.DefaultMode, ptr.Deref(volume.Secret.Optional, false))
+		case volume.ConfigMap != nil:
+			volumeFiles, err = filesForConfigMap(ctx, c, pod.Namespace, dir, volume.ConfigMap.Name, volume.ConfigMap.Items, volume.ConfigMap.DefaultMode, ptr.Deref(volume.ConfigMap.Optional, false))
+		case volume.Projected != nil:
+			for _, source := range volume.Projected.Sources {
+				var sourceFiles []extensionsv1alpha1.File
+
+				switch {
+				case source.Secret != nil:
+					sourceFiles, err = filesForSecret(ctx, c, pod.Namespace, dir, source.Secret.Name, source.Secret.Items, volume.Projected.DefaultMode, ptr.Deref(source.Secret.Optional, false))
+					volumeSecretFiles = append(volumeSecretFiles, sourceFiles...)
+				case source.ConfigMap != nil:
+					sourceFiles, err = filesForConfigMap(ctx, c, pod.Namespace, dir, source.ConfigMap.Name, source.ConfigMap.Items, volume.Projected.DefaultMode, ptr.Deref(source.ConfigMap.Optional, false))
+					volumeFiles = append(volumeFiles, sourceFiles...)
+				default:
+					err = fmt.Errorf("unsupported projected volume source in volume %q of pod %s, only secrets and config maps are supported", volume.Name, client.ObjectKeyFromObject(pod))
+				}
+
+				if err != nil {
+					break
+				}
+			}
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		files = append(files, volumeFiles...)
+		secretFiles = append(secretFiles, volumeSecretFiles...)
+		pod.Spec.Volumes[i].VolumeSource = corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{Path: dir, Type: ptr.To(corev1.HostPathDirectoryOrCreate)},
+		}
+	}
+
+	return files, secretFiles, nil
+}
+
+func removeServiceAccountTokenVolumes(pod *corev1.Pod) {
+	volumeNames := sets.New[string]()
+	pod.Spec.Volumes = slices.DeleteFunc(pod.Spec.Volumes, func(volume corev1.Volume) bool {
+		if volume.Projected == nil || !slices.ContainsFunc(volume.Projected.Sources, func(source corev1.VolumeProjection) bool {
+			return source.ServiceAccountToken != nil
+		}) {
+			return false
+		}
+
+		volumeNames.Insert(volume.Name)
+		return true
+	})
+
+	removeVolumeMounts := func(containers []corev1.Container) {
+		for i := range containers {
+			containers[i].VolumeMounts = slices.DeleteFunc(containers[i].VolumeMounts, func(volumeMount corev1.VolumeMount) bool {
+				return volumeNames.Has(volumeMount.Name)
+			})
+		}
+	}
+	removeVolumeMounts(pod.Spec.InitContainers)
+	removeVolumeMounts(pod.Spec.Containers)
+}
+
+func filesForSecret(ctx context.Context, c client.Reader, namespace, dir, name string, items []corev1.KeyToPath, defaultMode *int32, optional bool) ([]extensionsv1alpha1.File, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, secret); err != nil {
+		if client.IgnoreNotFound(err) == nil && optional {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading secret %s/%s: %w", namespace, name, err)
+	}
+
+	return filesForData(dir, secret.Data, items, defaultMode)
+}
+
+func filesForConfigMap(ctx context.Context, c client.Reader, namespace, dir, name string, items []corev1.KeyToPath, defaultMode *int32, optional bool) ([]extensionsv1alpha1.File, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, configMap); err != nil {
+		if client.IgnoreNotFound(err) == nil && optional {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading config map %s/%s: %w", namespace, name, err)
+	}
+
+	data := make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+	for key, value := range configMap.Data {
+		data[key] = []byte(value)
+	}
+	for key, value := range configMap.BinaryData {
+		data[key] = value
+	}
+
+	return filesForData(dir, data, items, defaultMode)
+}
+
+func filesForData(dir string, data map[string][]byte, items []corev1.KeyToPath, defaultMode *int32) ([]extensionsv1alpha1.File, error) {
+	// Use the same default mode like kubelet does when mounting secrets, config maps, or projected volumes.
+	mode := uint32(ptr.Deref(defaultMode, corev1.SecretVolumeSourceDefaultMode)) // #nosec G115 -- File modes are always positive and small.
+
+	if len(items) == 0 {
+		for _, key := range slices.Sorted(maps.Keys(data)) {
+			items = append(items, corev1.KeyToPath{Key: key, Path: key})
+		}
+	}
+
+	files := make([]extensionsv1alpha1.File, 0, len(items))
+	for _, item := range items {
+		value, ok := data[item.Key]
+		if !ok {
+			return nil, fmt.Errorf("key %q referenced in volume items does not exist", item.Key)
+		}
+
+		itemMode := mode
+		if item.Mode != nil {
+			itemMode = uint32(*item.Mode) // #nosec G115 -- File modes are always positive and small.
+		}
+
+		files = append(files, extensionsv1alpha1.File{
+			Path:        filepath.Join(dir, item.Path),
+			Permissions: ptr.To(itemMode),
+			Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64(value)}},
+		})
+	}
+
+	return files, nil
+}
+
+func clearRunAsUser(securityContext *corev1.SecurityContext) {
+	if securityContext == nil {
+		return
+	}
+
+	securityContext.RunAsUser = nil
+	securityContext.RunAsGroup = nil
+	securityContext.RunAsNonRoot = nil
+}
+
+func validateEnvironment(pod *corev1.Pod) error {
+	for _, container := range append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...) {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil || envFrom.ConfigMapRef != nil {
+				return fmt.Errorf("container %q of pod %s references secrets or config maps in its environment which is not supported for static pods", container.Name, client.ObjectKeyFromObject(pod))
+			}
+		}
+
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && (env.ValueFrom.SecretKeyRef != nil || env.ValueFrom.ConfigMapKeyRef != nil) {
+				return fmt.Errorf("environment variable %q of container %q of pod %s references a secret or config map which is not supported for static pods", env.Name, container.Name, client.ObjectKeyFromObject(pod))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package staticpod_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+var _ = Describe("StaticPod", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+
+		deployment *appsv1.Deployment
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "kube-system"},
+			Data:       map[string][]byte{"b": []byte("secret-b"), "a": []byte("secret-a")},
+		})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "configmap", Namespace: "kube-system"},
+			Data:       map[string]string{"config": "foo"},
+		})).To(Succeed())
+
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: "kube-system"},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "kube-apiserver"}},
+					Spec: corev1.PodSpec{
+						ServiceAccountName: "kube-apiserver",
+						Affinity:           &corev1.Affinity{},
+						SecurityContext:    &corev1.PodSecurityContext{RunAsUser: ptr.To[int64](65532), RunAsNonRoot: ptr.To(true)},
+						Containers: []corev1.Container{{
+							Name:            "kube-apiserver",
+							SecurityContext: &corev1.SecurityContext{RunAsUser: ptr.To[int64](65532)},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "secret", MountPath: "/srv/secret"},
+								{Name: "projected", MountPath: "/srv/projected"},
+								{Name: "token", MountPath: "/var/run/secrets/kubernetes.io/serviceaccount"},
+							},
+						}},
+						Volumes: []corev1.Volume{
+							{Name: "secret", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "secret", DefaultMode: ptr.To[int32](0600)}}},
+							{Name: "projected", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{
+								{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "configmap"}, Items: []corev1.KeyToPath{{Key: "config", Path: "config.yaml"}}}},
+								{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Optional: ptr.To(true)}},
+							}}}},
+							{Name: "token", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{
+								{ServiceAccountToken: &corev1.ServiceAccountTokenProjection{Path: "token"}},
+							}}}},
+							{Name: "empty", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#Translate", func() {
+		It("should translate the deployment into a static pod and files for its volumes", func() {
+			files, secretFiles, err := Translate(ctx, fakeClient, deployment, func(pod *corev1.Pod) {
+				pod.Labels["foo"] = "bar"
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(files).To(HaveLen(2))
+			Expect(files[0].Path).To(Equal("/etc/kubernetes/manifests/kube-apiserver.yaml"))
+			Expect(files[0].Permissions).To(Equal(ptr.To[uint32](0600)))
+			Expect(files[1:]).To(Equal([]extensionsv1alpha1.File{
+				{
+					Path:        "/var/lib/kube-apiserver/projected/config.yaml",
+					Permissions: ptr.To[uint32](0644),
+					Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64([]byte("foo"))}},
+				},
+			}))
+			Expect(secretFiles).To(Equal([]extensionsv1alpha1.File{
+				{
+					Path:        "/var/lib/kube-apiserver/secret/a",
+					Permissions: ptr.To[uint32](0600),
+					Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64([]byte("secret-a"))}},
+				},
+				{
+					Path:        "/var/lib/kube-apiserver/secret/b",
+					Permissions: ptr.To[uint32](0600),
+					Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64([]byte("secret-b"))}},
+				},
+			}))
+
+			pod := decodePod(files[0])
+			Expect(pod.Name).To(Equal("kube-apiserver"))
+			Expect(pod.Namespace).To(Equal("kube-system"))
+			Expect(pod.Labels).To(Equal(map[string]string{"app": "kube-apiserver", "foo": "bar"}))
+			Expect(pod.Spec.HostNetwork).To(BeTrue())
+			Expect(pod.Spec.PriorityClassName).To(Equal(PriorityClassName))
+			Expect(pod.Spec.ServiceAccountName).To(BeEmpty())
+			Expect(pod.Spec.Affinity).To(BeNil())
+			Expect(pod.Spec.SecurityContext.RunAsUser).To(BeNil())
+			Expect(pod.Spec.SecurityContext.RunAsNonRoot).To(BeNil())
+			Expect(pod.Spec.Containers[0].SecurityContext.RunAsUser).To(BeNil())
+			Expect(pod.Spec.Containers[0].VolumeMounts).To(Equal([]corev1.VolumeMount{
+				{Name: "secret", MountPath: "/srv/secret"},
+				{Name: "projected", MountPath: "/srv/projected"},
+			}))
+			Expect(pod.Spec.Volumes).To(Equal([]corev1.Volume{
+				{Name: "secret", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/lib/kube-apiserver/secret", Type: ptr.To(corev1.HostPathDirectoryOrCreate)}}},
+				{Name: "projected", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/lib/kube-apiserver/projected", Type: ptr.To(corev1.HostPathDirectoryOrCreate)}}},
+				{Name: "empty", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			}))
+		})
+
+		It("should fail for unsupported object types", func() {
+			_, _, err := Translate(ctx, fakeClient, &corev1.Service{})
+			Expect(err).To(MatchError(ContainSubstring("unsupported object type *v1.Service")))
+		})
+
+		It("should fail if a referenced secret does not exist", func() {
+			deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName = "missing"
+
+			_, _, err := Translate(ctx, fakeClient, deployment)
+			Expect(err).To(MatchError(ContainSubstring("failed reading secret kube-system/missing")))
+		})
+
+		It("should fail if a referenced key does not exist", func() {
+			deployment.Spec.Template.Spec.Volumes[0].Secret.Items = []corev1.KeyToPath{{Key: "c", Path: "c"}}
+
+			_, _, err := Translate(ctx, fakeClient, deployment)
+			Expect(err).To(MatchError(ContainSubstring(`key "c" referenced in volume items does not exist`)))
+		})
+
+		It("should fail if the environment references a secret", func() {
+			deployment.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{
+				Name:      "FOO",
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}, Key: "a"}},
+			}}
+
+			_, _, err := Translate(ctx, fakeClient, deployment)
+			Expect(err).To(MatchError(ContainSubstring(`environment variable "FOO" of container "kube-apiserver"`)))
+		})
+	})
+})
+
+func decodePod(file extensionsv1alpha1.File) *corev1.Pod {
+	GinkgoHelper()
+
+	data, err := utils.DecodeBase64(file.Content.Inline.Data)
+	Expect(err).NotTo(HaveOccurred())
+
+	pod := &corev1.Pod{}
+	Expect(runtime.DecodeInto(kubernetes.SeedCodec.UniversalDecoder(), data, pod)).To(Succeed())
+	return pod
+}
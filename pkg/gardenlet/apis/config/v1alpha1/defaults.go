@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
@@ -62,6 +63,10 @@ func SetDefaults_GardenletConfiguration(obj *GardenletConfiguration) {
 		obj.ETCDConfig = &ETCDConfig{}
 	}
 
+	if obj.HelmChartCache == nil {
+		obj.HelmChartCache = &HelmChartCacheConfiguration{}
+	}
+
 	SetDefaults_ExposureClassHandler(obj.ExposureClassHandlers)
 }
 
@@ -80,6 +85,15 @@ func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 	if obj.Metrics.Port == 0 {
 		obj.Metrics.Port = 2729
 	}
+
+	if obj.WakeUpProxy != nil {
+		if obj.WakeUpProxy.RetryAfter == nil {
+			obj.WakeUpProxy.RetryAfter = &metav1.Duration{Duration: time.Minute}
+		}
+		if obj.WakeUpProxy.MinWakeUpInterval == nil {
+			obj.WakeUpProxy.MinWakeUpInterval = &metav1.Duration{Duration: 15 * time.Minute}
+		}
+	}
 }
 
 // SetDefaults_ExposureClassHandler sets defaults for the configuration for an exposure class handler.
@@ -536,3 +550,13 @@ func SetDefaults_BackupCompactionController(obj *BackupCompactionController) {
 		obj.MetricsScrapeWaitDuration = &metav1.Duration{Duration: 60 * time.Second}
 	}
 }
+
+// SetDefaults_HelmChartCacheConfiguration sets defaults for the cache of Helm charts.
+func SetDefaults_HelmChartCacheConfiguration(obj *HelmChartCacheConfiguration) {
+	if obj.MaxSize == nil {
+		obj.MaxSize = ptr.To(resource.MustParse("128Mi"))
+	}
+	if obj.Directory != nil && obj.DirectoryMaxSize == nil {
+		obj.DirectoryMaxSize = ptr.To(resource.MustParse("1Gi"))
+	}
+}
@@ -622,6 +622,20 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Server.Metrics.BindAddress).To(Equal("127.0.0.1"))
 			Expect(obj.Server.Metrics.Port).To(Equal(1011))
 		})
+
+		It("should not default the wake-up proxy configuration if it is not set", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Server.WakeUpProxy).To(BeNil())
+		})
+
+		It("should default the durations of the wake-up proxy", func() {
+			obj.Server.WakeUpProxy = &WakeUpProxyServer{}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Server.WakeUpProxy.RetryAfter).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(obj.Server.WakeUpProxy.MinWakeUpInterval).To(PointTo(Equal(metav1.Duration{Duration: 15 * time.Minute})))
+		})
 	})
 
 	Describe("Logging defaulting", func() {
@@ -862,6 +876,33 @@ var _ = Describe("Defaults", func() {
 			Expect(*obj.Monitoring.Shoot.Enabled).To(BeFalse())
 		})
 	})
+
+	Describe("HelmChartCacheConfiguration defaulting", func() {
+		It("should default the Helm chart cache configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.HelmChartCache.MaxSize).To(PointTo(Equal(resource.MustParse("128Mi"))))
+			Expect(obj.HelmChartCache.Directory).To(BeNil())
+			Expect(obj.HelmChartCache.DirectoryMaxSize).To(BeNil())
+			Expect(obj.HelmChartCache.TagTTL).To(BeNil())
+		})
+
+		It("should not overwrite already set values for the Helm chart cache configuration", func() {
+			obj.HelmChartCache = &HelmChartCacheConfiguration{MaxSize: ptr.To(resource.MustParse("1Gi"))}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.HelmChartCache.MaxSize).To(PointTo(Equal(resource.MustParse("1Gi"))))
+		})
+
+		It("should default the maximum directory size if a directory is set", func() {
+			obj.HelmChartCache = &HelmChartCacheConfiguration{Directory: ptr.To("/var/cache/gardener/charts")}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.HelmChartCache.DirectoryMaxSize).To(PointTo(Equal(resource.MustParse("1Gi"))))
+		})
+	})
 })
 
 var _ = Describe("Constants", func() {
@@ -84,6 +84,22 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// HelmChartCache contains optional settings for the cache of Helm charts pulled from OCI registries.
+	// +optional
+	HelmChartCache *HelmChartCacheConfiguration `json:"helmChartCache,omitempty"`
+	// SelfHostedShoot contains the configuration for a gardenlet running in a self-hosted shoot cluster which was
+	// connected to the garden cluster via `gardenadm connect`. If set, gardenlet does not register a seed and is only
+	// responsible for the given Shoot. Must not be set together with SeedConfig.
+	// +optional
+	SelfHostedShoot *SelfHostedShoot `json:"selfHostedShoot,omitempty"`
+}
+
+// SelfHostedShoot contains the configuration for a gardenlet running in a self-hosted shoot cluster.
+type SelfHostedShoot struct {
+	// Name is the name of the self-hosted Shoot in the garden cluster.
+	Name string `json:"name"`
+	// Namespace is the namespace of the self-hosted Shoot in the garden cluster.
+	Namespace string `json:"namespace"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -313,6 +329,22 @@ type ShootControllerConfiguration struct {
 	// Default: 120s
 	// +optional
 	DNSEntryTTLSeconds *int64 `json:"dnsEntryTTLSeconds,omitempty"`
+	// SecretsStorageBackend configures the storage backend which seals the data of the CA secrets of shoots before they
+	// are stored in the seed. If not set, the data is stored in plain text.
+	// +optional
+	SecretsStorageBackend *SecretsStorageBackend `json:"secretsStorageBackend,omitempty"`
+}
+
+// SecretsStorageBackend configures the storage backend of the secrets manager.
+type SecretsStorageBackend struct {
+	// KMS configures a KMS plugin implementing the KMSv2 gRPC protocol which envelope-encrypts the secret data.
+	KMS KMSSecretsStorageBackend `json:"kms"`
+}
+
+// KMSSecretsStorageBackend configures the KMS plugin of the secrets manager storage backend.
+type KMSSecretsStorageBackend struct {
+	// Endpoint is the gRPC endpoint of the KMS plugin, e.g., 'unix:///var/run/kms-plugin/socket.sock'.
+	Endpoint string `json:"endpoint"`
 }
 
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
@@ -525,6 +557,27 @@ type ServerConfiguration struct {
 	// Metrics is the configuration for serving the metrics endpoint.
 	// +optional
 	Metrics *Server `json:"metrics,omitempty"`
+	// WakeUpProxy is the configuration for the proxy which wakes up hibernated shoots with enabled wake-up on demand
+	// when their API server is requested. The proxy is only started if this field is set.
+	// +optional
+	WakeUpProxy *WakeUpProxyServer `json:"wakeUpProxy,omitempty"`
+}
+
+// WakeUpProxyServer contains the configuration for the proxy which wakes up hibernated shoots on demand.
+type WakeUpProxyServer struct {
+	// BindAddress is the IP address on which to listen. The proxy always serves on port 2730 since the network
+	// policies for the istio ingress gateways only allow this port.
+	// +optional
+	BindAddress string `json:"bindAddress,omitempty"`
+	// RetryAfter is the duration after which callers are asked to retry their requests while the shoot is woken up.
+	// Defaults to 1m.
+	// +optional
+	RetryAfter *metav1.Duration `json:"retryAfter,omitempty"`
+	// MinWakeUpInterval is the minimum duration between two wake-ups of the same shoot by the proxy. Requests arriving
+	// within this duration after the last wake-up do not wake up the shoot again.
+	// Defaults to 15m.
+	// +optional
+	MinWakeUpInterval *metav1.Duration `json:"minWakeUpInterval,omitempty"`
 }
 
 // Server contains information for HTTP(S) server configuration.
@@ -745,3 +798,25 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// HelmChartCacheConfiguration contains settings for the cache of Helm charts pulled from OCI registries.
+type HelmChartCacheConfiguration struct {
+	// MaxSize is the maximum total size of the charts kept in memory. Once it is exceeded, the least recently used charts
+	// are evicted.
+	// Defaults to 128Mi.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+	// Directory is the path of an optional on-disk store for the charts. If set, all pulled charts are additionally stored
+	// in this directory, so that charts evicted from memory or pulled before a restart don't need to be pulled again.
+	// +optional
+	Directory *string `json:"directory,omitempty"`
+	// DirectoryMaxSize is the maximum total size of the charts kept in the directory. Once it is exceeded, the least
+	// recently used charts are removed from the directory.
+	// Defaults to 1Gi if a directory is set.
+	// +optional
+	DirectoryMaxSize *resource.Quantity `json:"directoryMaxSize,omitempty"`
+	// TagTTL is the duration for which the digest resolved for a tag is cached. Within this duration, the registry is not
+	// contacted for resolving the tag again. If not set, tags are resolved whenever a chart is pulled.
+	// +optional
+	TagTTL *metav1.Duration `json:"tagTTL,omitempty"`
+}
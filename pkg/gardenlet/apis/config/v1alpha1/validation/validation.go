@@ -7,6 +7,7 @@ package validation
 import (
 	"fmt"
 	"net"
+	"path/filepath"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -76,7 +77,13 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 	}
 
 	seedConfigPath := fldPath.Child("seedConfig")
-	if !inTemplate && cfg.SeedConfig == nil {
+	if cfg.SelfHostedShoot != nil {
+		if inTemplate {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("selfHostedShoot"), "self-hosted shoot config must not be set for gardenlets responsible for a seed"))
+		} else {
+			allErrs = append(allErrs, validateSelfHostedShoot(cfg, fldPath.Child("selfHostedShoot"))...)
+		}
+	} else if !inTemplate && cfg.SeedConfig == nil {
 		allErrs = append(allErrs, field.Invalid(seedConfigPath, cfg, "seed config must be set"))
 	}
 
@@ -133,6 +140,9 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	allErrs = append(allErrs, validateHelmChartCacheConfiguration(cfg.HelmChartCache, fldPath.Child("helmChartCache"))...)
+	allErrs = append(allErrs, validateWakeUpProxyServer(cfg.Server.WakeUpProxy, fldPath.Child("server", "wakeUpProxy"))...)
+
 	return allErrs
 }
 
@@ -240,6 +250,10 @@ func validateShootControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootCont
 		}
 	}
 
+	if cfg.SecretsStorageBackend != nil && len(cfg.SecretsStorageBackend.KMS.Endpoint) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretsStorageBackend", "kms", "endpoint"), "must provide the endpoint of the KMS plugin"))
+	}
+
 	return allErrs
 }
 
@@ -336,3 +350,71 @@ func validateBastionControllerConfiguration(cfg *gardenletconfigv1alpha1.Bastion
 
 	return allErrs
 }
+
+func validateHelmChartCacheConfiguration(conf *gardenletconfigv1alpha1.HelmChartCacheConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if conf.MaxSize != nil && conf.MaxSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSize"), conf.MaxSize.String(), "must be positive"))
+	}
+	if conf.DirectoryMaxSize != nil && conf.DirectoryMaxSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("directoryMaxSize"), conf.DirectoryMaxSize.String(), "must be positive"))
+	}
+	if conf.Directory != nil && !filepath.IsAbs(*conf.Directory) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("directory"), *conf.Directory, "must be an absolute path"))
+	}
+	if conf.TagTTL != nil && conf.TagTTL.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("tagTTL"), conf.TagTTL.Duration.String(), "must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateWakeUpProxyServer(conf *gardenletconfigv1alpha1.WakeUpProxyServer, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if conf.RetryAfter != nil && conf.RetryAfter.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retryAfter"), conf.RetryAfter.Duration.String(), "must be positive"))
+	}
+	if conf.MinWakeUpInterval != nil && conf.MinWakeUpInterval.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minWakeUpInterval"), conf.MinWakeUpInterval.Duration.String(), "must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateSelfHostedShoot(cfg *gardenletconfigv1alpha1.GardenletConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(cfg.SelfHostedShoot.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must provide the name of the self-hosted shoot"))
+	}
+	if len(cfg.SelfHostedShoot.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "must provide the namespace of the self-hosted shoot"))
+	}
+
+	if cfg.SeedConfig != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("seedConfig"), "seed config must not be set for gardenlets responsible for a self-hosted shoot"))
+	}
+
+	// The kubeconfig bootstrapping and rotation via CertificateSigningRequests is bound to seeds, hence the kubeconfig for
+	// the garden cluster must be provided directly (see `gardenadm connect`).
+	if cfg.GardenClientConnection != nil {
+		if cfg.GardenClientConnection.BootstrapKubeconfig != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("gardenClientConnection", "bootstrapKubeconfig"), "kubeconfig bootstrapping is not supported for gardenlets responsible for a self-hosted shoot"))
+		}
+		if cfg.GardenClientConnection.KubeconfigSecret != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("gardenClientConnection", "kubeconfigSecret"), "kubeconfig secrets are not supported for gardenlets responsible for a self-hosted shoot"))
+		}
+	}
+
+	return allErrs
+}
@@ -345,6 +345,23 @@ var _ = Describe("GardenletConfiguration", func() {
 					"Field": Equal("controllers.shoot.dnsEntryTTLSeconds"),
 				}))))
 			})
+
+			It("should allow a valid secrets storage backend", func() {
+				cfg.Controllers.Shoot.SecretsStorageBackend = &gardenletconfigv1alpha1.SecretsStorageBackend{KMS: gardenletconfigv1alpha1.KMSSecretsStorageBackend{Endpoint: "unix:///var/run/kms-plugin/socket.sock"}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should require the endpoint of the KMS plugin", func() {
+				cfg.Controllers.Shoot.SecretsStorageBackend = &gardenletconfigv1alpha1.SecretsStorageBackend{}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil, false)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shoot.secretsStorageBackend.kms.endpoint"),
+				}))))
+			})
 		})
 
 		Context("shootCare controller", func() {
@@ -512,6 +529,69 @@ var _ = Describe("GardenletConfiguration", func() {
 			})
 		})
 
+		Context("self-hosted shoot", func() {
+			var seedConfig *gardenletconfigv1alpha1.SeedConfig
+
+			BeforeEach(func() {
+				seedConfig = cfg.SeedConfig
+				cfg.SeedConfig = nil
+				cfg.SelfHostedShoot = &gardenletconfigv1alpha1.SelfHostedShoot{Name: "foo", Namespace: "garden-bar"}
+			})
+
+			It("should allow a configuration without seedConfig", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should require name and namespace", func() {
+				cfg.SelfHostedShoot = &gardenletconfigv1alpha1.SelfHostedShoot{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("selfHostedShoot.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("selfHostedShoot.namespace"),
+					})),
+				))
+			})
+
+			It("should forbid the self-hosted shoot config in templates", func() {
+				cfg.SeedConfig = seedConfig
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, true)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("selfHostedShoot"),
+					})),
+				))
+			})
+
+			It("should forbid setting seedConfig and kubeconfig bootstrapping", func() {
+				cfg.SeedConfig = seedConfig
+				cfg.GardenClientConnection = &gardenletconfigv1alpha1.GardenClientConnection{
+					BootstrapKubeconfig: &corev1.SecretReference{Name: "gardenlet-kubeconfig-bootstrap", Namespace: "garden"},
+					KubeconfigSecret:    &corev1.SecretReference{Name: "gardenlet-kubeconfig", Namespace: "garden"},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("seedConfig"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("gardenClientConnection.bootstrapKubeconfig"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("gardenClientConnection.kubeconfigSecret"),
+					})),
+				))
+			})
+		})
+
 		Context("seed template", func() {
 			It("should forbid invalid fields in seed template", func() {
 				cfg.SeedConfig.Spec.Networks.Nodes = ptr.To("")
@@ -719,6 +799,84 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("helmChartCache", func() {
+			It("should pass with valid options", func() {
+				cfg.HelmChartCache = &gardenletconfigv1alpha1.HelmChartCacheConfiguration{
+					MaxSize:          ptr.To(resource.MustParse("256Mi")),
+					Directory:        ptr.To("/var/cache/charts"),
+					DirectoryMaxSize: ptr.To(resource.MustParse("1Gi")),
+					TagTTL:           &metav1.Duration{Duration: 5 * time.Minute},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with invalid options", func() {
+				cfg.HelmChartCache = &gardenletconfigv1alpha1.HelmChartCacheConfiguration{
+					MaxSize:          ptr.To(resource.MustParse("0")),
+					Directory:        ptr.To("charts"),
+					DirectoryMaxSize: ptr.To(resource.MustParse("-1Gi")),
+					TagTTL:           &metav1.Duration{Duration: -time.Minute},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("helmChartCache.maxSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("helmChartCache.directory"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("helmChartCache.directoryMaxSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("helmChartCache.tagTTL"),
+					})),
+				))
+			})
+		})
+
+		Context("wakeUpProxy", func() {
+			It("should pass with valid options", func() {
+				cfg.Server.WakeUpProxy = &gardenletconfigv1alpha1.WakeUpProxyServer{
+					RetryAfter:        &metav1.Duration{Duration: 30 * time.Second},
+					MinWakeUpInterval: &metav1.Duration{Duration: time.Hour},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with a non-positive retry duration", func() {
+				cfg.Server.WakeUpProxy = &gardenletconfigv1alpha1.WakeUpProxyServer{
+					RetryAfter: &metav1.Duration{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("server.wakeUpProxy.retryAfter"),
+					})),
+				))
+			})
+
+			It("should fail with a negative minimum wake-up interval", func() {
+				cfg.Server.WakeUpProxy = &gardenletconfigv1alpha1.WakeUpProxyServer{
+					MinWakeUpInterval: &metav1.Duration{Duration: -time.Minute},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("server.wakeUpProxy.minWakeUpInterval"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
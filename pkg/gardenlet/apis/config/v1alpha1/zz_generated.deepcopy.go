@@ -501,6 +501,16 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HelmChartCache != nil {
+		in, out := &in.HelmChartCache, &out.HelmChartCache
+		*out = new(HelmChartCacheConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SelfHostedShoot != nil {
+		in, out := &in.SelfHostedShoot, &out.SelfHostedShoot
+		*out = new(SelfHostedShoot)
+		**out = **in
+	}
 	return
 }
 
@@ -644,6 +654,58 @@ func (in *GardenletObjectControllerConfiguration) DeepCopy() *GardenletObjectCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartCacheConfiguration) DeepCopyInto(out *HelmChartCacheConfiguration) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Directory != nil {
+		in, out := &in.Directory, &out.Directory
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryMaxSize != nil {
+		in, out := &in.DirectoryMaxSize, &out.DirectoryMaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TagTTL != nil {
+		in, out := &in.TagTTL, &out.TagTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartCacheConfiguration.
+func (in *HelmChartCacheConfiguration) DeepCopy() *HelmChartCacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartCacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSSecretsStorageBackend) DeepCopyInto(out *KMSSecretsStorageBackend) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSSecretsStorageBackend.
+func (in *KMSSecretsStorageBackend) DeepCopy() *KMSSecretsStorageBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSSecretsStorageBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeconfigValidity) DeepCopyInto(out *KubeconfigValidity) {
 	*out = *in
@@ -960,6 +1022,23 @@ func (in *SNIIngress) DeepCopy() *SNIIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsStorageBackend) DeepCopyInto(out *SecretsStorageBackend) {
+	*out = *in
+	out.KMS = in.KMS
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsStorageBackend.
+func (in *SecretsStorageBackend) DeepCopy() *SecretsStorageBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsStorageBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedCareControllerConfiguration) DeepCopyInto(out *SeedCareControllerConfiguration) {
 	*out = *in
@@ -1051,6 +1130,22 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelfHostedShoot) DeepCopyInto(out *SelfHostedShoot) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelfHostedShoot.
+func (in *SelfHostedShoot) DeepCopy() *SelfHostedShoot {
+	if in == nil {
+		return nil
+	}
+	out := new(SelfHostedShoot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -1080,6 +1175,11 @@ func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
 		*out = new(Server)
 		**out = **in
 	}
+	if in.WakeUpProxy != nil {
+		in, out := &in.WakeUpProxy, &out.WakeUpProxy
+		*out = new(WakeUpProxyServer)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1194,6 +1294,11 @@ func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfigu
 		*out = new(int64)
 		**out = **in
 	}
+	if in.SecretsStorageBackend != nil {
+		in, out := &in.SecretsStorageBackend, &out.SecretsStorageBackend
+		*out = new(SecretsStorageBackend)
+		**out = **in
+	}
 	return
 }
 
@@ -1417,3 +1522,29 @@ func (in *Vali) DeepCopy() *Vali {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WakeUpProxyServer) DeepCopyInto(out *WakeUpProxyServer) {
+	*out = *in
+	if in.RetryAfter != nil {
+		in, out := &in.RetryAfter, &out.RetryAfter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinWakeUpInterval != nil {
+		in, out := &in.MinWakeUpInterval, &out.MinWakeUpInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WakeUpProxyServer.
+func (in *WakeUpProxyServer) DeepCopy() *WakeUpProxyServer {
+	if in == nil {
+		return nil
+	}
+	out := new(WakeUpProxyServer)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -133,4 +133,7 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 			SetDefaults_ShootMonitoringConfig(in.Monitoring.Shoot)
 		}
 	}
+	if in.HelmChartCache != nil {
+		SetDefaults_HelmChartCacheConfiguration(in.HelmChartCache)
+	}
 }
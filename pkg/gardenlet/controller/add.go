@@ -30,6 +30,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/networkpolicy"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/tokenrequestor/workloadidentity"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/vpaevictionrequirements"
 	"github.com/gardener/gardener/pkg/healthz"
@@ -140,3 +141,23 @@ func AddToManager(
 
 	return nil
 }
+
+// AddToManagerForSelfHostedShoot adds the gardenlet controllers which are relevant for a gardenlet responsible for a
+// self-hosted shoot (see `gardenadm connect`) to the given manager. The seed cluster is the self-hosted shoot cluster
+// itself in this case.
+func AddToManagerForSelfHostedShoot(
+	mgr manager.Manager,
+	gardenCluster cluster.Cluster,
+	seedCluster cluster.Cluster,
+	cfg *gardenletconfigv1alpha1.GardenletConfiguration,
+) error {
+	// Self-hosted shoots are not scheduled to any seed, hence the seed name is empty.
+	if err := (&state.Reconciler{
+		Config:    *cfg.Controllers.ShootState,
+		MergeSpec: true,
+	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+		return fmt.Errorf("failed adding state reconciler: %w", err)
+	}
+
+	return nil
+}
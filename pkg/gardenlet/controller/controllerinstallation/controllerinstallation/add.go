@@ -40,7 +40,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, gard
 		r.Clock = clock.RealClock{}
 	}
 	if r.HelmRegistry == nil {
-		helmRegistry, err := oci.NewHelmRegistry()
+		helmRegistry, err := oci.NewHelmRegistry(mgr.GetAPIReader())
 		if err != nil {
 			return err
 		}
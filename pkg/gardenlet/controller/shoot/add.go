@@ -7,6 +7,7 @@ package shoot
 import (
 	"context"
 	"fmt"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/ptr"
@@ -23,8 +24,13 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/pkg/utils/secrets/manager/kms"
 )
 
+// kmsCallTimeout is the timeout for calls to the KMS plugin of the secrets storage backend.
+const kmsCallTimeout = 3 * time.Second
+
 // AddToManager adds all Shoot controllers to the given manager.
 func AddToManager(
 	ctx context.Context,
@@ -47,6 +53,14 @@ func AddToManager(
 	}
 	shootStateControllerEnabled := responsibleForUnmanagedSeed && ptr.Deref(cfg.Controllers.ShootState.ConcurrentSyncs, 0) > 0
 
+	var secretsStorageBackend secretsmanager.StorageBackend
+	if storageBackend := cfg.Controllers.Shoot.SecretsStorageBackend; storageBackend != nil {
+		var err error
+		if secretsStorageBackend, err = kms.NewGRPCBackend(ctx, storageBackend.KMS.Endpoint, kmsCallTimeout); err != nil {
+			return fmt.Errorf("failed creating secrets storage backend: %w", err)
+		}
+	}
+
 	if err := (&shoot.Reconciler{
 		SeedClientSet:               seedClientSet,
 		ShootClientMap:              shootClientMap,
@@ -54,6 +68,7 @@ func AddToManager(
 		Identity:                    identity,
 		GardenClusterIdentity:       gardenClusterIdentity,
 		ShootStateControllerEnabled: shootStateControllerEnabled,
+		SecretsStorageBackend:       secretsStorageBackend,
 	}).AddToManager(mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
@@ -75,8 +90,9 @@ func AddToManager(
 		mgr.GetLogger().Info("Adding shoot state reconciler since gardenlet is responsible for an unmanaged seed")
 
 		if err := (&state.Reconciler{
-			Config:   *cfg.Controllers.ShootState,
-			SeedName: cfg.SeedConfig.Name,
+			Config:                *cfg.Controllers.ShootState,
+			SeedName:              cfg.SeedConfig.Name,
+			SecretsStorageBackend: secretsStorageBackend,
 		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
 			return fmt.Errorf("failed adding state reconciler: %w", err)
 		}
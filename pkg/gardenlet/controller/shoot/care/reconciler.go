@@ -11,6 +11,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -41,6 +42,8 @@ var (
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
 	NewWebhookRemediator = defaultNewWebhookRemediator
+	// NewWorkerPoolObserver is used to create a new worker pool observation instance.
+	NewWorkerPoolObserver = defaultNewWorkerPoolObserver
 )
 
 // Reconciler reconciles Shoot resources and executes care operations, e.g. health checks or garbage collection.
@@ -128,6 +131,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
 		initializeShootClients                = shootClientInitializer(careCtx, o)
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
+		updatedWorkerPools                    []gardencorev1beta1.WorkerPoolStatus
 	)
 
 	if err := flow.Parallel(
@@ -178,20 +182,26 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			}
 			return nil
 		},
+		// Observe the number of nodes per worker pool
+		func(ctx context.Context) error {
+			updatedWorkerPools = NewWorkerPoolObserver(log, shoot, initializeShootClients).Observe(ctx)
+			return nil
+		},
 	)(careCtx); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	// Update Shoot status (conditions, constraints) if necessary
+	// Update Shoot status (conditions, constraints, worker pools) if necessary
 	if v1beta1helper.ConditionsNeedUpdate(shootConditions.ConvertToSlice(), updatedConditions) ||
-		v1beta1helper.ConditionsNeedUpdate(shootConstraints.ConvertToSlice(), updatedConstraints) {
-		log.V(1).Info("Updating status conditions and constraints")
+		v1beta1helper.ConditionsNeedUpdate(shootConstraints.ConvertToSlice(), updatedConstraints) ||
+		!apiequality.Semantic.DeepEqual(shoot.Status.WorkerPools, updatedWorkerPools) {
+		log.V(1).Info("Updating status conditions, constraints and worker pools")
 		// Rebuild shoot conditions and constraints to ensure that only the conditions and constraints with the
 		// correct types will be updated, and any other conditions will remain intact
 		conditions := v1beta1helper.BuildConditions(shoot.Status.Conditions, updatedConditions, shootConditions.ConditionTypes())
 		constraints := v1beta1helper.BuildConditions(shoot.Status.Constraints, updatedConstraints, shootConstraints.ConstraintTypes())
 
-		if err := r.patchStatus(ctx, shoot, conditions, constraints); err != nil {
+		if err := r.patchStatus(ctx, shoot, conditions, constraints, updatedWorkerPools); err != nil {
 			log.Error(err, "Error when trying to update the shoot status")
 			return reconcile.Result{}, err
 		}
@@ -208,10 +218,11 @@ func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1b
 	return out
 }
 
-func (r *Reconciler) patchStatus(ctx context.Context, shoot *gardencorev1beta1.Shoot, conditions, constraints []gardencorev1beta1.Condition) error {
+func (r *Reconciler) patchStatus(ctx context.Context, shoot *gardencorev1beta1.Shoot, conditions, constraints []gardencorev1beta1.Condition, workerPools []gardencorev1beta1.WorkerPoolStatus) error {
 	patch := client.StrategicMergeFrom(shoot.DeepCopy())
 	shoot.Status.Conditions = conditions
 	shoot.Status.Constraints = constraints
+	shoot.Status.WorkerPools = workerPools
 	return r.GardenClient.Status().Patch(ctx, shoot, patch)
 }
 
@@ -230,7 +241,7 @@ func (r *Reconciler) patchStatusToUnknown(ctx context.Context, shoot *gardencore
 		return nil
 	}
 
-	return r.patchStatus(ctx, shoot, updatedConditions, updatedConstraints)
+	return r.patchStatus(ctx, shoot, updatedConditions, updatedConstraints, shoot.Status.WorkerPools)
 }
 
 func shootClientInitializer(ctx context.Context, o *operation.Operation) func() (kubernetes.Interface, bool, error) {
@@ -210,6 +210,9 @@ var _ = Describe("Shoot Care Control", func() {
 				DeferCleanup(test.WithVars(
 					&NewOperation, operationFunc,
 					&NewGarbageCollector, nopGarbageCollectorFunc(),
+					&NewWorkerPoolObserver, workerPoolObserverFunc(func(shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.WorkerPoolStatus {
+						return shoot.Status.WorkerPools
+					}),
 				))
 				reconciler = &Reconciler{
 					GardenClient:   gardenClient,
@@ -242,6 +245,20 @@ var _ = Describe("Shoot Care Control", func() {
 					Expect(updatedShoot.Status.Constraints).To(BeEmpty())
 				})
 
+				It("should update the observed worker pools", func() {
+					DeferCleanup(test.WithVar(
+						&NewWorkerPoolObserver, workerPoolObserverFunc(func(_ *gardencorev1beta1.Shoot) []gardencorev1beta1.WorkerPoolStatus {
+							return []gardencorev1beta1.WorkerPoolStatus{{Name: "worker", Nodes: 3}}
+						}),
+					))
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.WorkerPools).To(ConsistOf(gardencorev1beta1.WorkerPoolStatus{Name: "worker", Nodes: 3}))
+				})
+
 				It("should remove conditions / constraints", func() {
 					apiServerCondition := gardencorev1beta1.Condition{
 						Type:   gardencorev1beta1.ShootAPIServerAvailable,
@@ -558,6 +575,18 @@ func opFunc(op *operation.Operation, err error) NewOperationFunc {
 	}
 }
 
+type resultingWorkerPoolsFunc func() []gardencorev1beta1.WorkerPoolStatus
+
+func (w resultingWorkerPoolsFunc) Observe(_ context.Context) []gardencorev1beta1.WorkerPoolStatus {
+	return w()
+}
+
+func workerPoolObserverFunc(fn func(*gardencorev1beta1.Shoot) []gardencorev1beta1.WorkerPoolStatus) NewWorkerPoolObserverFunc {
+	return func(_ logr.Logger, shoot *gardencorev1beta1.Shoot, _ ShootClientInit) WorkerPoolObserver {
+		return resultingWorkerPoolsFunc(func() []gardencorev1beta1.WorkerPoolStatus { return fn(shoot) })
+	}
+}
+
 type nopGarbageCollector struct{}
 
 func (n *nopGarbageCollector) Collect(_ context.Context) {}
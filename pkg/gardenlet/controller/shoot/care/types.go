@@ -123,6 +123,20 @@ var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1
 	return NewWebhookRemediation(log, shoot, init)
 }
 
+// WorkerPoolObserver is an interface used to observe the worker pools of a shoot cluster.
+type WorkerPoolObserver interface {
+	Observe(ctx context.Context) []gardencorev1beta1.WorkerPoolStatus
+}
+
+// NewWorkerPoolObserverFunc is a function used to create a new instance to observe the worker pools of a shoot cluster.
+type NewWorkerPoolObserverFunc func(log logr.Logger, shoot *gardencorev1beta1.Shoot, init ShootClientInit) WorkerPoolObserver
+
+// defaultNewWorkerPoolObserver is the default function to create a new instance to observe the worker pools of a
+// shoot cluster.
+var defaultNewWorkerPoolObserver NewWorkerPoolObserverFunc = func(log logr.Logger, shoot *gardencorev1beta1.Shoot, init ShootClientInit) WorkerPoolObserver {
+	return NewWorkerPoolObservation(log, shoot, init)
+}
+
 // NewOperationFunc is a function used to create a new `operation.Operation` instance.
 type NewOperationFunc func(
 	ctx context.Context,
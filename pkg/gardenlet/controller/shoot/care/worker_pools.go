@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+)
+
+// WorkerPoolObservation contains required information for observing the worker pools of a shoot cluster.
+type WorkerPoolObservation struct {
+	log                    logr.Logger
+	initializeShootClients ShootClientInit
+	shoot                  *gardencorev1beta1.Shoot
+}
+
+// NewWorkerPoolObservation creates a new instance for observing the worker pools of a shoot cluster.
+func NewWorkerPoolObservation(log logr.Logger, shoot *gardencorev1beta1.Shoot, shootClientInit ShootClientInit) *WorkerPoolObservation {
+	return &WorkerPoolObservation{
+		log:                    log,
+		initializeShootClients: shootClientInit,
+		shoot:                  shoot,
+	}
+}
+
+// Observe returns the number of nodes per worker pool of the shoot cluster. The last observed worker pools in the
+// status of the shoot are returned if the nodes cannot be listed, e.g., because the API server is not running.
+func (w *WorkerPoolObservation) Observe(ctx context.Context) []gardencorev1beta1.WorkerPoolStatus {
+	if len(w.shoot.Spec.Provider.Workers) == 0 {
+		return nil
+	}
+
+	if w.shoot.Status.IsHibernated {
+		return w.workerPools(nil)
+	}
+
+	shootClient, apiServerRunning, err := w.initializeShootClients()
+	if err != nil || !apiServerRunning {
+		return w.shoot.Status.WorkerPools
+	}
+
+	workerPoolToNodes, err := botanist.WorkerPoolToNodesMap(ctx, shootClient.Client())
+	if err != nil {
+		w.log.Error(fmt.Errorf("failed listing nodes: %w", err), "Failed observing worker pools")
+		return w.shoot.Status.WorkerPools
+	}
+
+	return w.workerPools(func(name string) int32 {
+		return int32(len(workerPoolToNodes[name])) // #nosec G115 -- The number of nodes of a worker pool fits into int32.
+	})
+}
+
+func (w *WorkerPoolObservation) workerPools(nodes func(string) int32) []gardencorev1beta1.WorkerPoolStatus {
+	workerPools := make([]gardencorev1beta1.WorkerPoolStatus, 0, len(w.shoot.Spec.Provider.Workers))
+	for _, worker := range w.shoot.Spec.Provider.Workers {
+		workerPool := gardencorev1beta1.WorkerPoolStatus{Name: worker.Name}
+		if nodes != nil {
+			workerPool.Nodes = nodes(worker.Name)
+		}
+		workerPools = append(workerPools, workerPool)
+	}
+	return workerPools
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("WorkerPoolObservation", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient       client.Client
+		apiServerRunning bool
+		shootClientInit  func() (kubernetes.Interface, bool, error)
+
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		apiServerRunning = true
+		shootClientInit = func() (kubernetes.Interface, bool, error) {
+			return kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build(), apiServerRunning, nil
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{Name: "pool-1"}, {Name: "pool-2"}},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				WorkerPools: []gardencorev1beta1.WorkerPoolStatus{{Name: "pool-1", Nodes: 5}},
+			},
+		}
+
+		for i, pool := range []string{"pool-1", "pool-1", "pool-2", "removed"} {
+			Expect(fakeClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("node-%d", i),
+				Labels: map[string]string{"worker.gardener.cloud/pool": pool},
+			}})).To(Succeed())
+		}
+	})
+
+	Describe("#Observe", func() {
+		It("should return the number of nodes per worker pool", func() {
+			Expect(NewWorkerPoolObservation(logr.Discard(), shoot, shootClientInit).Observe(ctx)).To(Equal([]gardencorev1beta1.WorkerPoolStatus{
+				{Name: "pool-1", Nodes: 2},
+				{Name: "pool-2", Nodes: 1},
+			}))
+		})
+
+		It("should return no nodes for hibernated shoots", func() {
+			shoot.Status.IsHibernated = true
+
+			Expect(NewWorkerPoolObservation(logr.Discard(), shoot, shootClientInit).Observe(ctx)).To(Equal([]gardencorev1beta1.WorkerPoolStatus{
+				{Name: "pool-1"},
+				{Name: "pool-2"},
+			}))
+		})
+
+		It("should keep the last observed worker pools if the API server is not running", func() {
+			apiServerRunning = false
+
+			Expect(NewWorkerPoolObservation(logr.Discard(), shoot, shootClientInit).Observe(ctx)).To(Equal(shoot.Status.WorkerPools))
+		})
+
+		It("should return nothing for workerless shoots", func() {
+			shoot.Spec.Provider.Workers = nil
+
+			Expect(NewWorkerPoolObservation(logr.Discard(), shoot, shootClientInit).Observe(ctx)).To(BeNil())
+		})
+	})
+})
@@ -51,6 +51,7 @@ import (
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 const taskID = "initializeOperation"
@@ -66,6 +67,9 @@ type Reconciler struct {
 	GardenClusterIdentity       string
 	Clock                       clock.Clock
 	ShootStateControllerEnabled bool
+	// SecretsStorageBackend is the storage backend which seals the data of the CA secrets of shoots. If nil, the data
+	// is stored in plain text.
+	SecretsStorageBackend secretsmanager.StorageBackend
 }
 
 // Reconcile implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
@@ -104,6 +108,13 @@ func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot
 	)
 	log = log.WithValues("operation", strings.ToLower(string(operationType)))
 
+	if revision, ok := v1beta1helper.ShootStateRevisionToRestore(shoot); ok && isRestoring {
+		// gardener-controller-manager restores the ShootState from the revision and removes the operation annotation
+		// afterwards. The control plane must not be restored before that.
+		log.Info("Waiting for ShootState to be restored from revision before restoring the control plane", "revision", revision)
+		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
 	if !controllerutil.ContainsFinalizer(shoot, gardencorev1beta1.GardenerName) {
 		log.Info("Adding finalizer")
 		if err := controllerutils.AddFinalizers(ctx, r.GardenClient, shoot, gardencorev1beta1.GardenerName); err != nil {
@@ -395,6 +406,7 @@ func (r *Reconciler) initializeOperation(
 		WithGarden(gardenObj).
 		WithSeed(seedObj).
 		WithShoot(shootObj).
+		WithSecretsStorageBackend(r.SecretsStorageBackend).
 		Build(ctx, r.GardenClient, r.SeedClientSet, r.ShootClientMap)
 	if err != nil {
 		return nil, err
@@ -22,6 +22,7 @@ import (
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // Reconciler performs periodic backups of Shoot states.
@@ -31,6 +32,13 @@ type Reconciler struct {
 	Config       gardenletconfigv1alpha1.ShootStateControllerConfiguration
 	Clock        clock.Clock
 	SeedName     string
+	// MergeSpec specifies whether the computed state is merged into the existing ShootState instead of replacing it.
+	// This is required for self-hosted shoots whose ShootState contains state handed over by 'gardenadm bootstrap' which
+	// cannot be computed from the cluster.
+	MergeSpec bool
+	// SecretsStorageBackend is the storage backend which seals the data of the CA secrets of shoots. If nil, the data
+	// is stored in plain text.
+	SecretsStorageBackend secretsmanager.StorageBackend
 }
 
 var (
@@ -90,7 +98,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if nextBackupDue := lastBackup.Add(r.Config.SyncPeriod.Duration); nextBackupDue.Before(r.Clock.Now().UTC()) {
 		log.Info("Performing periodic ShootState backup", "lastBackup", lastBackup.Round(time.Minute), "nextBackupDue", nextBackupDue.Round(time.Minute))
-		if err := shootstate.Deploy(ctx, r.Clock, r.GardenClient, r.SeedClient, shoot, true); err != nil {
+		if err := shootstate.Deploy(ctx, r.Clock, r.GardenClient, r.SeedClient, shoot, !r.MergeSpec, r.SecretsStorageBackend); err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed performing periodic ShootState backup: %w", err)
 		}
 		lastBackup = r.Clock.Now()
@@ -43,6 +43,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		secretsmanager.Config{
 			CASecretAutoRotation: false,
 			SecretNamesToTimes:   b.lastSecretRotationStartTimes(),
+			StorageBackend:       o.SecretsStorageBackend,
 		},
 	)
 	if err != nil {
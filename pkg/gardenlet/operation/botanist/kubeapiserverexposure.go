@@ -6,12 +6,14 @@ package botanist
 
 import (
 	"context"
+	"fmt"
 	"net"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component"
 	kubeapiserverexposure "github.com/gardener/gardener/pkg/component/kubernetes/apiserverexposure"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -104,11 +106,24 @@ func (b *Botanist) setAPIServerServiceClusterIPs(clusterIPs []string) {
 					Namespace: b.IstioNamespace(),
 					Labels:    b.IstioLabels(),
 				},
+				WakeUpProxy: b.kubeAPIServerSNIWakeUpProxy(),
 			}
 		},
 	)
 }
 
+// kubeAPIServerSNIWakeUpProxy returns the values for routing the API server requests to the wake-up proxy of gardenlet
+// if the shoot is hibernated and shall be woken up on demand.
+func (b *Botanist) kubeAPIServerSNIWakeUpProxy() *kubeapiserverexposure.WakeUpProxy {
+	if b.Config == nil || b.Config.Server.WakeUpProxy == nil || !b.Shoot.HibernationEnabled || !v1beta1helper.HibernationWakeUpOnDemandEnabled(b.Shoot.GetInfo()) {
+		return nil
+	}
+
+	return &kubeapiserverexposure.WakeUpProxy{
+		Host: fmt.Sprintf("%s.%s.svc.%s", v1beta1constants.DeploymentNameGardenlet, v1beta1constants.GardenNamespace, gardencorev1beta1.DefaultDomain),
+	}
+}
+
 func mapToReservedKubeApiServerRange(ip net.IP) string {
 	// prevent leakage of real cluster ip to shoot. we use the reserved range 240.0.0.0/8 as prefix instead.
 	// e.g. cluster ip in seed:  192.168.102.23 => ip in shoot:  240.168.102.23
@@ -22,6 +22,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	kubeapiserverexposure "github.com/gardener/gardener/pkg/component/kubernetes/apiserverexposure"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	gardenletfeatures "github.com/gardener/gardener/pkg/gardenlet/features"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
@@ -155,4 +156,42 @@ var _ = Describe("KubeAPIServerExposure", func() {
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(destinationRule), destinationRule)).To(BeNotFoundError())
 		})
 	})
+
+	Describe("#kubeAPIServerSNIWakeUpProxy", func() {
+		BeforeEach(func() {
+			botanist.Config = &gardenletconfigv1alpha1.GardenletConfiguration{
+				Server: gardenletconfigv1alpha1.ServerConfiguration{
+					WakeUpProxy: &gardenletconfigv1alpha1.WakeUpProxyServer{},
+				},
+			}
+			botanist.Shoot.HibernationEnabled = true
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Hibernation: &gardencorev1beta1.Hibernation{
+						Enabled:        ptr.To(true),
+						WakeUpOnDemand: ptr.To(true),
+					},
+				},
+			})
+		})
+
+		It("should route the requests to the wake-up proxy if the hibernated shoot shall be woken up on demand", func() {
+			Expect(botanist.kubeAPIServerSNIWakeUpProxy()).To(Equal(&kubeapiserverexposure.WakeUpProxy{Host: "gardenlet.garden.svc.cluster.local"}))
+		})
+
+		It("should not route the requests to the wake-up proxy if the shoot is not hibernated", func() {
+			botanist.Shoot.HibernationEnabled = false
+			Expect(botanist.kubeAPIServerSNIWakeUpProxy()).To(BeNil())
+		})
+
+		It("should not route the requests to the wake-up proxy if wake-up on demand is disabled", func() {
+			botanist.Shoot.GetInfo().Spec.Hibernation.WakeUpOnDemand = nil
+			Expect(botanist.kubeAPIServerSNIWakeUpProxy()).To(BeNil())
+		})
+
+		It("should not route the requests to the wake-up proxy if it is not enabled in gardenlet", func() {
+			botanist.Config.Server.WakeUpProxy = nil
+			Expect(botanist.kubeAPIServerSNIWakeUpProxy()).To(BeNil())
+		})
+	})
 })
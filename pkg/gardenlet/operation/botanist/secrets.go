@@ -176,6 +176,12 @@ func (b *Botanist) caCertGenerateOptionsFor(configName string) []secretsmanager.
 		options = append(options, secretsmanager.IgnoreOldSecrets())
 	}
 
+	// kube-controller-manager mounts the client and kubelet CA secrets for signing certificates, hence only the data of
+	// the other CA secrets (which are exclusively read via the secrets manager) can be sealed by its storage backend.
+	if configName != v1beta1constants.SecretNameCAClient && configName != v1beta1constants.SecretNameCAKubelet {
+		options = append(options, secretsmanager.Seal())
+	}
+
 	if configName == v1beta1constants.SecretNameCAClient {
 		return options
 	}
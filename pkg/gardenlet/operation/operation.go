@@ -32,6 +32,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -168,6 +169,13 @@ func (b *Builder) WithShootFromCluster(seedClientSet kubernetes.Interface, s *ga
 	return b
 }
 
+// WithSecretsStorageBackend sets the storage backend which is used by the secrets manager of the Operation. It is
+// optional.
+func (b *Builder) WithSecretsStorageBackend(storageBackend secretsmanager.StorageBackend) *Builder {
+	b.secretsStorageBackend = storageBackend
+	return b
+}
+
 // Build initializes a new Operation object.
 func (b *Builder) Build(
 	ctx context.Context,
@@ -179,9 +187,10 @@ func (b *Builder) Build(
 	error,
 ) {
 	operation := &Operation{
-		GardenClient:   gardenClient,
-		SeedClientSet:  seedClientSet,
-		ShootClientMap: shootClientMap,
+		GardenClient:          gardenClient,
+		SeedClientSet:         seedClientSet,
+		ShootClientMap:        shootClientMap,
+		SecretsStorageBackend: b.secretsStorageBackend,
 	}
 
 	config, err := b.configFunc()
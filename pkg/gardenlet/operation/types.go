@@ -34,6 +34,7 @@ type Builder struct {
 	secretsFunc               func() (map[string]*corev1.Secret, error)
 	seedFunc                  func(context.Context) (*seed.Seed, error)
 	shootFunc                 func(context.Context, client.Reader, *garden.Garden, *seed.Seed, *corev1.Secret) (*shoot.Shoot, error)
+	secretsStorageBackend     secretsmanager.StorageBackend
 }
 
 // Operation contains all data required to perform an operation on a Shoot cluster.
@@ -41,6 +42,8 @@ type Operation struct {
 	secrets        map[string]*corev1.Secret
 	secretsMutex   sync.RWMutex
 	SecretsManager secretsmanager.Interface
+	// SecretsStorageBackend is the storage backend used by the SecretsManager (if any).
+	SecretsStorageBackend secretsmanager.StorageBackend
 
 	Config                *gardenletconfigv1alpha1.GardenletConfiguration
 	Logger                logr.Logger
@@ -0,0 +1,385 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wakeupproxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	istionetworkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/extensions"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// HeaderWakeUp is the header with which callers not authenticating with a client certificate can explicitly request
+// the wake-up of a hibernated shoot by setting it to 'true'. This is only allowed for shoots which opted in via the
+// 'shoot.gardener.cloud/wake-up-on-demand-unauthenticated' annotation.
+const HeaderWakeUp = "X-Gardener-Wake-Up"
+
+// Server is a proxy which wakes up hibernated shoots with enabled wake-up on demand when their API server is
+// requested. The istio ingress gateway routes the requests to this server while the shoot is hibernated. It terminates
+// the TLS connection with the serving certificate of the respective kube-apiserver and answers all requests with
+// '503 Service Unavailable' and a 'Retry-After' header. Shoots are only woken up for requests authenticated with a
+// client certificate issued by the client CA of the shoot or, if the shoot opted in, for requests explicitly asking for
+// it via HeaderWakeUp.
+type Server struct {
+	Log logr.Logger
+	// SeedReader is used to read the SNI VirtualServices, the serving certificates, the client CAs and the Clusters in
+	// the seed. It should be backed by a cache, see NewSeedCluster, since it is used for every TLS handshake and request.
+	SeedReader   client.Reader
+	GardenClient client.Client
+	Recorder     record.EventRecorder
+	Clock        clock.PassiveClock
+	// BindAddress is the IP address on which to listen.
+	BindAddress string
+	// RetryAfter is the duration after which callers are asked to retry their requests.
+	RetryAfter time.Duration
+	// MinWakeUpInterval is the minimum duration between two wake-ups of the same shoot. The time of the last wake-up is
+	// stored in the 'shoot.gardener.cloud/last-wake-up-on-demand' annotation of the shoot.
+	MinWakeUpInterval time.Duration
+}
+
+// NewSeedCluster returns a cluster for the seed whose cache only contains the objects read by the Server, i.e., the
+// SNI VirtualServices of the kube-apiservers and the secrets containing their serving certificates and client CAs. The
+// cluster must be added to the manager and its client can be used as the Server's SeedReader.
+func NewSeedCluster(restConfig *rest.Config, log logr.Logger) (cluster.Cluster, error) {
+	secretsSelector, err := labels.Parse(fmt.Sprintf("%s=%s,%s in (%s,%s)",
+		secretsmanager.LabelKeyManagedBy, secretsmanager.LabelValueSecretsManager,
+		secretsmanager.LabelKeyName, v1beta1constants.DeploymentNameKubeAPIServer, v1beta1constants.SecretNameCAClient,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster.New(restConfig, func(opts *cluster.Options) {
+		opts.Scheme = kubernetes.SeedScheme
+		opts.Logger = log
+
+		opts.Cache.ByObject = map[client.Object]cache.ByObject{
+			&istionetworkingv1beta1.VirtualService{}: {
+				Field: fields.SelectorFromSet(fields.Set{metav1.ObjectNameField: v1beta1constants.DeploymentNameKubeAPIServer}),
+			},
+			&corev1.Secret{}: {
+				Label: secretsSelector,
+			},
+		}
+	})
+}
+
+// Start starts the server and blocks until the context is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              net.JoinHostPort(s.BindAddress, strconv.Itoa(v1beta1constants.GardenletWakeUpProxyPort)),
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+		TLSConfig: &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			GetConfigForClient: s.getConfigForClient,
+		},
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			s.Log.Error(err, "Failed shutting down wake-up proxy")
+		}
+	}()
+
+	s.Log.Info("Starting wake-up proxy", "address", server.Addr)
+	if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed running wake-up proxy: %w", err)
+	}
+	return nil
+}
+
+// NeedLeaderElection returns false since all gardenlet replicas are endpoints of the gardenlet service and must be able
+// to serve requests.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// getConfigForClient returns the TLS config for the kube-apiserver of the requested shoot. It contains the serving
+// certificate of the kube-apiserver and verifies client certificates if given by the caller.
+func (s *Server) getConfigForClient(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+	ctx := hello.Context()
+
+	namespace, err := s.namespaceForHost(ctx, hello.ServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := s.servingCertificate(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCAs, err := s.clientCAs(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*certificate},
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		ClientCAs:    clientCAs,
+	}, nil
+}
+
+// ServeHTTP wakes up the shoot whose API server was requested and asks the caller to retry the request later.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	host := r.Host
+	if r.TLS != nil && r.TLS.ServerName != "" {
+		host = r.TLS.ServerName
+	} else if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	log := s.Log.WithValues("host", host)
+
+	namespace, err := s.namespaceForHost(ctx, host)
+	if err != nil {
+		log.Info("Cannot serve request for unknown host", "reason", err.Error())
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, err.Error(), 0)
+		return
+	}
+
+	// The caller cannot be authenticated with other credentials than client certificates because the kube-apiserver is
+	// not running. Hence, requests without a client certificate issued by the client CA of the shoot must explicitly ask
+	// for the wake-up so that arbitrary requests, e.g., of scanners, don't wake up the shoot.
+	authenticated, err := s.authenticated(ctx, r, namespace)
+	if err != nil {
+		log.Error(err, "Failed verifying client certificate")
+		writeStatus(w, http.StatusServiceUnavailable, metav1.StatusReasonServiceUnavailable, "shoot is hibernated and the client certificate could not be verified", s.RetryAfter)
+		return
+	}
+
+	if !authenticated && r.Header.Get(HeaderWakeUp) != "true" {
+		writeStatus(w, http.StatusServiceUnavailable, metav1.StatusReasonServiceUnavailable, fmt.Sprintf("shoot is hibernated, authenticate with a client certificate or set the %s header to 'true' for waking it up", HeaderWakeUp), 0)
+		return
+	}
+
+	message, retryAfter, err := s.wakeUp(ctx, log.WithValues("namespace", namespace), namespace, authenticated)
+	if err != nil {
+		log.Error(err, "Failed waking up shoot")
+		writeStatus(w, http.StatusServiceUnavailable, metav1.StatusReasonServiceUnavailable, "shoot is hibernated and could not be woken up", s.RetryAfter)
+		return
+	}
+
+	writeStatus(w, http.StatusServiceUnavailable, metav1.StatusReasonServiceUnavailable, message, retryAfter)
+}
+
+// authenticated returns whether the caller presented a client certificate issued by a client CA of the kube-apiserver
+// in the given namespace.
+func (s *Server) authenticated(ctx context.Context, r *http.Request, namespace string) (bool, error) {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return false, nil
+	}
+
+	clientCAs, err := s.clientCAs(ctx, namespace)
+	if err != nil {
+		return false, err
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range r.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	_, err = r.TLS.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         clientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err == nil, nil
+}
+
+// wakeUp disables the hibernation of the shoot in the given namespace if it is hibernated and has wake-up on demand
+// enabled. Unauthenticated callers can only wake up shoots which opted in for it. It returns a message for the caller
+// and the duration after which the caller shall retry the request.
+func (s *Server) wakeUp(ctx context.Context, log logr.Logger, namespace string, authenticated bool) (string, time.Duration, error) {
+	clusterShoot, err := extensions.GetShoot(ctx, s.SeedReader, namespace)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed reading shoot from cluster resource: %w", err)
+	}
+	if clusterShoot == nil {
+		return "", 0, fmt.Errorf("cluster resource %q does not contain a shoot", namespace)
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := s.GardenClient.Get(ctx, client.ObjectKeyFromObject(clusterShoot), shoot); err != nil {
+		return "", 0, fmt.Errorf("failed reading shoot: %w", err)
+	}
+
+	if !v1beta1helper.HibernationWakeUpOnDemandEnabled(shoot) {
+		return "shoot is hibernated and wake-up on demand is disabled", 0, nil
+	}
+
+	if !authenticated && !kubernetesutils.HasMetaDataAnnotation(shoot, v1beta1constants.AnnotationShootWakeUpOnDemandUnauthenticated, "true") {
+		return "shoot is hibernated, authenticate with a client certificate for waking it up", 0, nil
+	}
+
+	if !v1beta1helper.HibernationIsEnabled(shoot) {
+		return "shoot is being woken up, please retry later", s.RetryAfter, nil
+	}
+
+	if !shoot.Status.IsHibernated {
+		return "shoot is being hibernated, please retry later", s.RetryAfter, nil
+	}
+
+	if wait := s.durationUntilNextWakeUp(shoot); wait > 0 {
+		return "shoot was woken up on demand recently, please retry later", wait, nil
+	}
+
+	// The time of the wake-up is stored in the shoot so that the wake-ups are rate-limited across all gardenlet replicas
+	// and restarts. The optimistic lock ensures that concurrent requests wake up the shoot only once.
+	patch := client.MergeFromWithOptions(shoot.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootLastWakeUpOnDemand, s.Clock.Now().UTC().Format(time.RFC3339))
+	shoot.Spec.Hibernation.Enabled = ptr.To(false)
+	if err := s.GardenClient.Patch(ctx, shoot, patch); err != nil {
+		return "", 0, fmt.Errorf("failed disabling hibernation: %w", err)
+	}
+
+	log.Info("Woke up hibernated shoot on demand", "shoot", client.ObjectKeyFromObject(shoot))
+	s.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationDisabled, "Waking up cluster because its API server was requested")
+
+	return "shoot is being woken up, please retry later", s.RetryAfter, nil
+}
+
+// durationUntilNextWakeUp returns the duration until the given shoot may be woken up on demand again.
+func (s *Server) durationUntilNextWakeUp(shoot *gardencorev1beta1.Shoot) time.Duration {
+	lastWakeUp, err := time.Parse(time.RFC3339, shoot.Annotations[v1beta1constants.AnnotationShootLastWakeUpOnDemand])
+	if err != nil {
+		return 0
+	}
+	return lastWakeUp.Add(s.MinWakeUpInterval).Sub(s.Clock.Now())
+}
+
+// namespaceForHost returns the namespace of the shoot whose SNI VirtualService serves the given host.
+func (s *Server) namespaceForHost(ctx context.Context, host string) (string, error) {
+	if host == "" {
+		return "", errors.New("no server name indicated")
+	}
+
+	virtualServiceList := &istionetworkingv1beta1.VirtualServiceList{}
+	if err := s.SeedReader.List(ctx, virtualServiceList); err != nil {
+		return "", fmt.Errorf("failed listing virtual services: %w", err)
+	}
+
+	for _, virtualService := range virtualServiceList.Items {
+		if virtualService.Name == v1beta1constants.DeploymentNameKubeAPIServer && slices.Contains(virtualService.Spec.Hosts, host) {
+			return virtualService.Namespace, nil
+		}
+	}
+
+	return "", fmt.Errorf("no shoot found for host %q", host)
+}
+
+// servingCertificate returns the most recent serving certificate of the kube-apiserver in the given namespace.
+func (s *Server) servingCertificate(ctx context.Context, namespace string) (*tls.Certificate, error) {
+	secretList := &corev1.SecretList{}
+	if err := s.SeedReader.List(ctx, secretList, client.InNamespace(namespace), client.MatchingLabels{
+		secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager,
+		secretsmanager.LabelKeyName:      v1beta1constants.DeploymentNameKubeAPIServer,
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing serving certificates: %w", err)
+	}
+
+	var (
+		newest         *corev1.Secret
+		newestIssuedAt int64
+	)
+	for i := range secretList.Items {
+		issuedAt, err := strconv.ParseInt(secretList.Items[i].Labels[secretsmanager.LabelKeyIssuedAtTime], 10, 64)
+		if err != nil {
+			continue
+		}
+		if newest == nil || issuedAt > newestIssuedAt {
+			newest, newestIssuedAt = &secretList.Items[i], issuedAt
+		}
+	}
+
+	if newest == nil {
+		return nil, fmt.Errorf("no serving certificate found in namespace %q", namespace)
+	}
+
+	certificate, err := tls.X509KeyPair(newest.Data[secretsutils.DataKeyCertificate], newest.Data[secretsutils.DataKeyPrivateKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing serving certificate %s: %w", client.ObjectKeyFromObject(newest), err)
+	}
+
+	return &certificate, nil
+}
+
+// clientCAs returns the pool of the client CAs of the kube-apiserver in the given namespace. It contains all current
+// client CAs so that client certificates issued during a CA rotation are accepted as well.
+func (s *Server) clientCAs(ctx context.Context, namespace string) (*x509.CertPool, error) {
+	secretList := &corev1.SecretList{}
+	if err := s.SeedReader.List(ctx, secretList, client.InNamespace(namespace), client.MatchingLabels{
+		secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager,
+		secretsmanager.LabelKeyName:      v1beta1constants.SecretNameCAClient,
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing client CAs: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	for _, secret := range secretList.Items {
+		pool.AppendCertsFromPEM(secret.Data[secretsutils.DataKeyCertificateCA])
+	}
+	return pool, nil
+}
+
+func writeStatus(w http.ResponseWriter, code int32, reason metav1.StatusReason, message string, retryAfter time.Duration) {
+	status := &metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Message:  message,
+		Reason:   reason,
+		Code:     code,
+	}
+
+	if retryAfter > 0 {
+		seconds := int32(retryAfter.Seconds()) // #nosec G115 -- The retry duration is configured in a reasonable range.
+		status.Details = &metav1.StatusDetails{RetryAfterSeconds: seconds}
+		w.Header().Set("Retry-After", strconv.Itoa(int(seconds)))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(int(code))
+	_ = json.NewEncoder(w).Encode(status)
+}
@@ -0,0 +1,344 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wakeupproxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	istionetworkingv1beta1 "istio.io/api/networking/v1beta1"
+	istionetworkingclientv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		ctx = context.Background()
+
+		seedClient   client.Client
+		gardenClient client.Client
+		recorder     *record.FakeRecorder
+		fakeClock    *testclock.FakeClock
+		server       *Server
+
+		host      = "api.foo.bar.example.com"
+		namespace = "shoot--bar--foo"
+		shoot     *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		recorder = record.NewFakeRecorder(1)
+		fakeClock = testclock.NewFakeClock(time.Now().Truncate(time.Second))
+
+		server = &Server{
+			Log:               logr.Discard(),
+			SeedReader:        seedClient,
+			GardenClient:      gardenClient,
+			Recorder:          recorder,
+			Clock:             fakeClock,
+			RetryAfter:        time.Minute,
+			MinWakeUpInterval: 15 * time.Minute,
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			TypeMeta: metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "garden-bar",
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{
+					Enabled:        ptr.To(true),
+					WakeUpOnDemand: ptr.To(true),
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{IsHibernated: true},
+		}
+
+		rawShoot, err := json.Marshal(shoot)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			Spec:       extensionsv1alpha1.ClusterSpec{Shoot: runtime.RawExtension{Raw: rawShoot}},
+		})).To(Succeed())
+
+		Expect(seedClient.Create(ctx, &istionetworkingclientv1beta1.VirtualService{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: namespace},
+			Spec:       istionetworkingv1beta1.VirtualService{Hosts: []string{host, "api.internal.example.com"}},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &istionetworkingclientv1beta1.VirtualService{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "shoot--bar--other"},
+			Spec:       istionetworkingv1beta1.VirtualService{Hosts: []string{"api.other.example.com"}},
+		})).To(Succeed())
+	})
+
+	createCertificateSecret := func(name, secretName, issuedAt string) *secretsutils.Certificate {
+		certificate, err := (&secretsutils.CertificateSecretConfig{
+			Name:       name,
+			CommonName: host,
+			CertType:   secretsutils.CACert,
+		}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(seedClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      secretName,
+				Namespace: namespace,
+				Labels: map[string]string{
+					"managed-by":     "secrets-manager",
+					"name":           name,
+					"issued-at-time": issuedAt,
+				},
+			},
+			Data: map[string][]byte{
+				"tls.crt": certificate.CertificatePEM,
+				"tls.key": certificate.PrivateKeyPEM,
+				"ca.crt":  certificate.CertificatePEM,
+			},
+		})).To(Succeed())
+
+		return certificate
+	}
+
+	Describe("#ServeHTTP", func() {
+		serveRequest := func(host string, mutate func(*http.Request)) *httptest.ResponseRecorder {
+			request := httptest.NewRequest(http.MethodGet, "https://"+host+"/api", nil)
+			request.TLS = &tls.ConnectionState{ServerName: host}
+			mutate(request)
+
+			response := httptest.NewRecorder()
+			server.ServeHTTP(response, request)
+			return response
+		}
+
+		serve := func(host string) *httptest.ResponseRecorder {
+			return serveRequest(host, func(request *http.Request) {
+				request.Header.Set("X-Gardener-Wake-Up", "true")
+			})
+		}
+
+		clientCertificate := func(signingCA *secretsutils.Certificate) *x509.Certificate {
+			certificate, err := (&secretsutils.CertificateSecretConfig{
+				Name:       "client",
+				CommonName: "foo",
+				CertType:   secretsutils.ClientCert,
+				SigningCA:  signingCA,
+			}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			x509Certificate, err := utils.DecodeCertificate(certificate.CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+			return x509Certificate
+		}
+
+		serveWithClientCertificate := func(host string, certificate *x509.Certificate) *httptest.ResponseRecorder {
+			return serveRequest(host, func(request *http.Request) {
+				request.TLS.PeerCertificates = []*x509.Certificate{certificate}
+			})
+		}
+
+		expectStatus := func(response *httptest.ResponseRecorder, code int32, retryAfterSeconds int32) {
+			Expect(response.Code).To(Equal(int(code)))
+
+			status := &metav1.Status{}
+			Expect(json.Unmarshal(response.Body.Bytes(), status)).To(Succeed())
+			Expect(status.Code).To(Equal(code))
+
+			if retryAfterSeconds > 0 {
+				Expect(response.Header().Get("Retry-After")).To(Equal(strconv.Itoa(int(retryAfterSeconds))))
+				Expect(status.Details).To(Equal(&metav1.StatusDetails{RetryAfterSeconds: retryAfterSeconds}))
+			} else {
+				Expect(response.Header().Get("Retry-After")).To(BeEmpty())
+				Expect(status.Details).To(BeNil())
+			}
+		}
+
+		It("should wake up the hibernated shoot for requests authenticated with a client certificate and ask the caller to retry", func() {
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+			clientCA := createCertificateSecret("ca-client", "ca-client-1", "1000")
+
+			expectStatus(serveWithClientCertificate(host, clientCertificate(clientCA)), http.StatusServiceUnavailable, 60)
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/last-wake-up-on-demand", fakeClock.Now().UTC().Format(time.RFC3339)))
+			Expect(recorder.Events).To(Receive(ContainSubstring("WokenUp")))
+		})
+
+		It("should not wake up the shoot for requests with a client certificate issued by another CA", func() {
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+			createCertificateSecret("ca-client", "ca-client-1", "1000")
+			otherCA, err := (&secretsutils.CertificateSecretConfig{Name: "other", CommonName: "other", CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			expectStatus(serveWithClientCertificate(host, clientCertificate(otherCA)), http.StatusServiceUnavailable, 0)
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should wake up the hibernated shoot for unauthenticated requests asking for it if the shoot opted in", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/wake-up-on-demand-unauthenticated", "true")
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+			expectStatus(serve(host), http.StatusServiceUnavailable, 60)
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+			Expect(recorder.Events).To(Receive(ContainSubstring("WokenUp")))
+		})
+
+		It("should not wake up the shoot for unauthenticated requests asking for it if the shoot did not opt in", func() {
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+			response := serve(host)
+			expectStatus(response, http.StatusServiceUnavailable, 0)
+			Expect(response.Body.String()).To(ContainSubstring("authenticate with a client certificate for waking it up"))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should not wake up the shoot for unauthenticated requests not asking for it", func() {
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+			response := serveRequest(host, func(*http.Request) {})
+			expectStatus(response, http.StatusServiceUnavailable, 0)
+			Expect(response.Body.String()).To(ContainSubstring("set the X-Gardener-Wake-Up header to 'true'"))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should not wake up the shoot again within the minimum wake-up interval", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/wake-up-on-demand-unauthenticated", "true")
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+			expectStatus(serve(host), http.StatusServiceUnavailable, 60)
+			Expect(recorder.Events).To(Receive())
+
+			hibernate := func() {
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				shoot.Spec.Hibernation.Enabled = ptr.To(true)
+				Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+			}
+			hibernate()
+
+			By("Use a new server to ensure the last wake-up is not remembered in memory")
+			server = &Server{
+				Log:               logr.Discard(),
+				SeedReader:        seedClient,
+				GardenClient:      gardenClient,
+				Recorder:          recorder,
+				Clock:             fakeClock,
+				RetryAfter:        time.Minute,
+				MinWakeUpInterval: 15 * time.Minute,
+			}
+
+			fakeClock.Step(5 * time.Minute)
+			expectStatus(serve(host), http.StatusServiceUnavailable, 600)
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+
+			fakeClock.Step(10 * time.Minute)
+			expectStatus(serve(host), http.StatusServiceUnavailable, 60)
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+		})
+
+		It("should not wake up the shoot if it is still being hibernated", func() {
+			shoot.Status.IsHibernated = false
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/wake-up-on-demand-unauthenticated", "true")
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+			expectStatus(serve(host), http.StatusServiceUnavailable, 60)
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should not wake up the shoot if wake-up on demand is disabled", func() {
+			shoot.Spec.Hibernation.WakeUpOnDemand = nil
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+			expectStatus(serve(host), http.StatusServiceUnavailable, 0)
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+		})
+
+		It("should answer with not found for unknown hosts", func() {
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+			expectStatus(serve("api.unknown.example.com"), http.StatusNotFound, 0)
+			expectStatus(serve("api.other.example.com"), http.StatusNotFound, 0)
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+		})
+	})
+
+	Describe("#getConfigForClient", func() {
+		It("should return the newest serving certificate and all client CAs of the requested kube-apiserver", func() {
+			createCertificateSecret("kube-apiserver", "kube-apiserver-old", "1000")
+			newest := createCertificateSecret("kube-apiserver", "kube-apiserver-new", "2000")
+			clientCA1 := createCertificateSecret("ca-client", "ca-client-1", "1000")
+			clientCA2 := createCertificateSecret("ca-client", "ca-client-2", "2000")
+
+			config, err := server.getConfigForClient(&tls.ClientHelloInfo{ServerName: "api.internal.example.com"})
+			Expect(err).NotTo(HaveOccurred())
+
+			expected, err := tls.X509KeyPair(newest.CertificatePEM, newest.PrivateKeyPEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Certificates).To(HaveLen(1))
+			Expect(config.Certificates[0].Certificate).To(Equal(expected.Certificate))
+
+			expectedClientCAs := x509.NewCertPool()
+			expectedClientCAs.AppendCertsFromPEM(clientCA1.CertificatePEM)
+			expectedClientCAs.AppendCertsFromPEM(clientCA2.CertificatePEM)
+			Expect(config.ClientCAs.Equal(expectedClientCAs)).To(BeTrue())
+			Expect(config.ClientAuth).To(Equal(tls.VerifyClientCertIfGiven))
+		})
+
+		It("should fail if there is no serving certificate", func() {
+			_, err := server.getConfigForClient(&tls.ClientHelloInfo{ServerName: host})
+			Expect(err).To(MatchError(ContainSubstring("no serving certificate found")))
+		})
+
+		It("should fail for unknown hosts", func() {
+			_, err := server.getConfigForClient(&tls.ClientHelloInfo{ServerName: "api.unknown.example.com"})
+			Expect(err).To(MatchError(ContainSubstring("no shoot found")))
+		})
+	})
+})
@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -33,6 +34,30 @@ func SetDefaults_OperatingSystemConfigControllerConfig(obj *OperatingSystemConfi
 	if obj.SyncPeriod == nil {
 		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
 	}
+
+	if obj.DriftDetection == nil {
+		obj.DriftDetection = &DriftDetectionConfig{}
+	}
+	if obj.DriftDetection.Enabled == nil {
+		obj.DriftDetection.Enabled = ptr.To(false)
+	}
+	if obj.DriftDetection.Remediate == nil {
+		obj.DriftDetection.Remediate = ptr.To(false)
+	}
+
+	if obj.Rollback == nil {
+		obj.Rollback = &RollbackConfig{}
+	}
+	if obj.Rollback.Enabled == nil {
+		obj.Rollback.Enabled = ptr.To(true)
+	}
+	if obj.Rollback.HealthCheckTimeout == nil {
+		obj.Rollback.HealthCheckTimeout = &metav1.Duration{Duration: 2 * time.Minute}
+	}
+
+	if obj.InPlaceUpdates != nil && obj.InPlaceUpdates.DrainTimeout == nil {
+		obj.InPlaceUpdates.DrainTimeout = &metav1.Duration{Duration: 2 * time.Hour}
+	}
 }
 
 // SetDefaults_TokenControllerConfig sets defaults for the TokenControllerConfig object.
@@ -42,6 +67,50 @@ func SetDefaults_TokenControllerConfig(obj *TokenControllerConfig) {
 	}
 }
 
+// SetDefaults_DataVolumePressureCheckConfig sets defaults for the DataVolumePressureCheckConfig object.
+func SetDefaults_DataVolumePressureCheckConfig(obj *DataVolumePressureCheckConfig) {
+	if len(obj.Paths) == 0 {
+		obj.Paths = []string{"/var/lib/kubelet", "/var/lib/containerd"}
+	}
+	if obj.UsageThresholdPercent == nil {
+		obj.UsageThresholdPercent = ptr.To[int32](90)
+	}
+	if obj.InodesThresholdPercent == nil {
+		obj.InodesThresholdPercent = ptr.To[int32](90)
+	}
+}
+
+// SetDefaults_ClockSkewCheckConfig sets defaults for the ClockSkewCheckConfig object.
+func SetDefaults_ClockSkewCheckConfig(obj *ClockSkewCheckConfig) {
+	if obj.MaxSkew == nil {
+		obj.MaxSkew = &metav1.Duration{Duration: 2 * time.Second}
+	}
+}
+
+// SetDefaults_KernelProblemsCheckConfig sets defaults for the KernelProblemsCheckConfig object.
+func SetDefaults_KernelProblemsCheckConfig(obj *KernelProblemsCheckConfig) {
+	if obj.Window == nil {
+		obj.Window = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
+// SetDefaults_CertificateExpiryCheckConfig sets defaults for the CertificateExpiryCheckConfig object.
+func SetDefaults_CertificateExpiryCheckConfig(obj *CertificateExpiryCheckConfig) {
+	if obj.Path == "" {
+		obj.Path = "/var/lib/kubelet/pki/kubelet-client-current.pem"
+	}
+	if obj.Threshold == nil {
+		obj.Threshold = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+}
+
+// SetDefaults_RemediationConfig sets defaults for the RemediationConfig object.
+func SetDefaults_RemediationConfig(obj *RemediationConfig) {
+	if obj.MinInterval == nil {
+		obj.MinInterval = &metav1.Duration{Duration: time.Hour}
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	componentbaseconfigv1alpha1.RecommendedDefaultClientConnectionConfiguration(obj)
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
@@ -54,16 +55,59 @@ var _ = Describe("Defaults", func() {
 					SetDefaults_OperatingSystemConfigControllerConfig(obj)
 
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+					Expect(obj.DriftDetection).To(Equal(&DriftDetectionConfig{
+						Enabled:   ptr.To(false),
+						Remediate: ptr.To(false),
+					}))
+					Expect(obj.Rollback).To(Equal(&RollbackConfig{
+						Enabled:            ptr.To(true),
+						HealthCheckTimeout: &metav1.Duration{Duration: 2 * time.Minute},
+					}))
 				})
 
 				It("should not overwrite existing values", func() {
 					obj := &OperatingSystemConfigControllerConfig{
 						SyncPeriod: &metav1.Duration{Duration: time.Second},
+						DriftDetection: &DriftDetectionConfig{
+							Enabled:   ptr.To(true),
+							Remediate: ptr.To(true),
+						},
+						Rollback: &RollbackConfig{
+							Enabled:            ptr.To(false),
+							HealthCheckTimeout: &metav1.Duration{Duration: time.Minute},
+						},
 					}
 
 					SetDefaults_OperatingSystemConfigControllerConfig(obj)
 
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+					Expect(obj.DriftDetection).To(Equal(&DriftDetectionConfig{
+						Enabled:   ptr.To(true),
+						Remediate: ptr.To(true),
+					}))
+					Expect(obj.Rollback).To(Equal(&RollbackConfig{
+						Enabled:            ptr.To(false),
+						HealthCheckTimeout: &metav1.Duration{Duration: time.Minute},
+					}))
+				})
+
+				It("should default the in-place updates configuration if set", func() {
+					obj := &OperatingSystemConfigControllerConfig{InPlaceUpdates: &InPlaceUpdatesConfig{MaxUnavailable: 2}}
+
+					SetDefaults_OperatingSystemConfigControllerConfig(obj)
+
+					Expect(obj.InPlaceUpdates).To(Equal(&InPlaceUpdatesConfig{
+						MaxUnavailable: 2,
+						DrainTimeout:   &metav1.Duration{Duration: 2 * time.Hour},
+					}))
+				})
+
+				It("should not default the in-place updates configuration if not set", func() {
+					obj := &OperatingSystemConfigControllerConfig{}
+
+					SetDefaults_OperatingSystemConfigControllerConfig(obj)
+
+					Expect(obj.InPlaceUpdates).To(BeNil())
 				})
 			})
 
@@ -88,6 +132,68 @@ var _ = Describe("Defaults", func() {
 			})
 		})
 
+		Describe("Health check controller", func() {
+			It("should not enable any node problem checks by default", func() {
+				SetObjectDefaults_NodeAgentConfiguration(obj)
+
+				Expect(obj.Controllers.HealthCheck).To(BeNil())
+			})
+
+			It("should default the enabled node problem checks", func() {
+				obj.Controllers.HealthCheck = &HealthCheckControllerConfig{
+					DataVolumePressure:             &DataVolumePressureCheckConfig{},
+					FailedSystemdUnits:             &FailedSystemdUnitsCheckConfig{Remediation: &RemediationConfig{Action: RemediationActionRestartUnits}},
+					ClockSkew:                      &ClockSkewCheckConfig{NTPServer: "pool.ntp.org"},
+					KernelProblems:                 &KernelProblemsCheckConfig{},
+					KubeletClientCertificateExpiry: &CertificateExpiryCheckConfig{},
+				}
+
+				SetObjectDefaults_NodeAgentConfiguration(obj)
+
+				Expect(obj.Controllers.HealthCheck).To(Equal(&HealthCheckControllerConfig{
+					DataVolumePressure: &DataVolumePressureCheckConfig{
+						Paths:                  []string{"/var/lib/kubelet", "/var/lib/containerd"},
+						UsageThresholdPercent:  ptr.To[int32](90),
+						InodesThresholdPercent: ptr.To[int32](90),
+					},
+					FailedSystemdUnits: &FailedSystemdUnitsCheckConfig{
+						Remediation: &RemediationConfig{Action: RemediationActionRestartUnits, MinInterval: &metav1.Duration{Duration: time.Hour}},
+					},
+					ClockSkew: &ClockSkewCheckConfig{
+						NTPServer: "pool.ntp.org",
+						MaxSkew:   &metav1.Duration{Duration: 2 * time.Second},
+					},
+					KernelProblems: &KernelProblemsCheckConfig{
+						Window: &metav1.Duration{Duration: 10 * time.Minute},
+					},
+					KubeletClientCertificateExpiry: &CertificateExpiryCheckConfig{
+						Path:      "/var/lib/kubelet/pki/kubelet-client-current.pem",
+						Threshold: &metav1.Duration{Duration: 24 * time.Hour},
+					},
+				}))
+			})
+
+			It("should not overwrite existing values", func() {
+				obj.Controllers.HealthCheck = &HealthCheckControllerConfig{
+					DataVolumePressure: &DataVolumePressureCheckConfig{
+						Paths:                  []string{"/var/lib"},
+						UsageThresholdPercent:  ptr.To[int32](80),
+						InodesThresholdPercent: ptr.To[int32](70),
+					},
+					KubeletClientCertificateExpiry: &CertificateExpiryCheckConfig{
+						Path:        "/foo.pem",
+						Threshold:   &metav1.Duration{Duration: time.Hour},
+						Remediation: &RemediationConfig{Action: RemediationActionReboot, MinInterval: &metav1.Duration{Duration: time.Minute}},
+					},
+				}
+				expected := obj.Controllers.HealthCheck.DeepCopy()
+
+				SetObjectDefaults_NodeAgentConfiguration(obj)
+
+				Expect(obj.Controllers.HealthCheck).To(Equal(expected))
+			})
+		})
+
 		Describe("Server configuration", func() {
 			It("should default the object", func() {
 				obj := &ServerConfiguration{}
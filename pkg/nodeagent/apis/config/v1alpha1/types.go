@@ -32,6 +32,9 @@ const (
 	KubeconfigFilePath = CredentialsDir + "/kubeconfig"
 	// MachineNameFilePath is the file path on the worker node that contains the machine name.
 	MachineNameFilePath = BaseDir + "/machine-name"
+	// DebugSocketPath is the path of the Unix socket on the worker node on which the gardener-node-agent serves its
+	// local debugging API.
+	DebugSocketPath = "/run/gardener-node-agent/debug.sock"
 
 	// UnitName is the name of the gardener-node-agent systemd service.
 	UnitName = "gardener-node-agent.service"
@@ -47,6 +50,41 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+	// NodeConditionTypeOperatingSystemConfigDrift is a constant for the type of the Node condition describing whether
+	// the files and units on the node deviate from the last applied operating system configuration.
+	NodeConditionTypeOperatingSystemConfigDrift = "OperatingSystemConfigDrift"
+	// NodeConditionTypeOperatingSystemConfigRolledBack is a constant for the type of the Node condition describing
+	// whether the last operating system configuration was rolled back because it could not be applied or the node was
+	// unhealthy after applying it.
+	NodeConditionTypeOperatingSystemConfigRolledBack = "OperatingSystemConfigRolledBack"
+	// NodeConditionTypeInPlaceUpdate is a constant for the type of the Node condition describing the progress of an
+	// in-place update of the Kubernetes version or the operating system version of the node.
+	NodeConditionTypeInPlaceUpdate = "InPlaceUpdate"
+	// AnnotationKeyInPlaceUpdateStarted is a constant for an annotation key on a Node describing the time when
+	// gardener-node-agent started to drain the node for performing an in-place update.
+	AnnotationKeyInPlaceUpdateStarted = "node-agent.gardener.cloud/in-place-update-started"
+	// AnnotationKeyCordonedForInPlaceUpdate is a constant for an annotation key on a Node describing that it was
+	// cordoned by gardener-node-agent for performing an in-place update.
+	AnnotationKeyCordonedForInPlaceUpdate = "node-agent.gardener.cloud/cordoned-for-in-place-update"
+	// InPlaceUpdateLeaseNamePrefix is the prefix of the names of the Leases used for coordinating the in-place updates
+	// of the nodes of a worker pool. The name of the worker pool and the index of the update slot are appended.
+	InPlaceUpdateLeaseNamePrefix = "gardener-node-agent-in-place-update-"
+
+	// NodeConditionTypeDataVolumePressure is a constant for the type of the Node condition describing whether the disk
+	// or inode usage of the kubelet or containerd data volume exceeds the configured threshold.
+	NodeConditionTypeDataVolumePressure = "DataVolumePressure"
+	// NodeConditionTypeFailedSystemdUnits is a constant for the type of the Node condition describing whether systemd
+	// units on the node are in the failed state.
+	NodeConditionTypeFailedSystemdUnits = "FailedSystemdUnits"
+	// NodeConditionTypeClockSkew is a constant for the type of the Node condition describing whether the clock of the
+	// node deviates from the time reported by the configured NTP server.
+	NodeConditionTypeClockSkew = "ClockSkew"
+	// NodeConditionTypeKernelProblem is a constant for the type of the Node condition describing whether the kernel
+	// recently reported out-of-memory kills or hung tasks.
+	NodeConditionTypeKernelProblem = "KernelProblem"
+	// NodeConditionTypeKubeletClientCertificateExpiring is a constant for the type of the Node condition describing
+	// whether the client certificate of the kubelet is about to expire.
+	NodeConditionTypeKubeletClientCertificateExpiring = "KubeletClientCertificateExpiring"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -102,6 +140,9 @@ type ControllerConfiguration struct {
 	OperatingSystemConfig OperatingSystemConfigControllerConfig `json:"operatingSystemConfig"`
 	// Token is the configuration for the access token controller.
 	Token TokenControllerConfig `json:"token"`
+	// HealthCheck is the configuration for the health check controller.
+	// +optional
+	HealthCheck *HealthCheckControllerConfig `json:"healthCheck,omitempty"`
 }
 
 // OperatingSystemConfigControllerConfig defines the configuration of the operating system config controller.
@@ -115,6 +156,168 @@ type OperatingSystemConfigControllerConfig struct {
 	// KubernetesVersion contains the Kubernetes version of the kubelet, used for annotating the corresponding node
 	// resource with a kubernetes version annotation.
 	KubernetesVersion *semver.Version `json:"kubernetesVersion"`
+	// DriftDetection is the configuration for detecting and remediating manual changes of the files and units managed
+	// by the operating system config.
+	// +optional
+	DriftDetection *DriftDetectionConfig `json:"driftDetection,omitempty"`
+	// Rollback is the configuration for verifying the health of the node after applying a changed operating system
+	// config and for rolling back to the previously applied one if the verification fails.
+	// +optional
+	Rollback *RollbackConfig `json:"rollback,omitempty"`
+	// InPlaceUpdates is the configuration for updating the Kubernetes version and the operating system version of the
+	// node in-place. It is only set for worker pools with the in-place update strategy.
+	// +optional
+	InPlaceUpdates *InPlaceUpdatesConfig `json:"inPlaceUpdates,omitempty"`
+}
+
+// DriftDetectionConfig defines the configuration for detecting and remediating drift of the files and units on the node
+// from the last applied operating system config.
+type DriftDetectionConfig struct {
+	// Enabled specifies whether the files and units on the node are periodically compared with the last applied
+	// operating system config. Drift is reported as a condition and as an event on the Node. Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Remediate specifies whether drifted files and units are restored and the units depending on them are restarted.
+	// Defaults to false.
+	// +optional
+	Remediate *bool `json:"remediate,omitempty"`
+}
+
+// RollbackConfig defines the configuration for rolling back a changed operating system config if the node is unhealthy
+// after applying it.
+type RollbackConfig struct {
+	// Enabled specifies whether the health of the node is verified after applying a changed operating system config and
+	// whether the previously applied one is restored if the verification fails. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// HealthCheckTimeout is the duration to wait for the changed units to become active and for kubelet and containerd
+	// to become healthy after applying a changed operating system config. Defaults to 2m.
+	// +optional
+	HealthCheckTimeout *metav1.Duration `json:"healthCheckTimeout,omitempty"`
+}
+
+// InPlaceUpdatesConfig defines the configuration for updating the node in-place.
+type InPlaceUpdatesConfig struct {
+	// MaxUnavailable is the maximum number of nodes of the worker pool which are updated in-place at the same time.
+	MaxUnavailable int32 `json:"maxUnavailable"`
+	// DrainTimeout is the duration to wait for the pods to be evicted from the node before the update is performed
+	// nevertheless. Defaults to 2h.
+	// +optional
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+}
+
+// HealthCheckControllerConfig defines the configuration of the health check controller. Besides the health checks of
+// kubelet and containerd which are always performed, additional checks for detecting problems of the node can be
+// enabled. Each of them reports its result via a dedicated Node condition.
+type HealthCheckControllerConfig struct {
+	// DataVolumePressure is the configuration for checking the disk and inode usage of the kubelet and containerd data
+	// volumes. The check is disabled if not set.
+	// +optional
+	DataVolumePressure *DataVolumePressureCheckConfig `json:"dataVolumePressure,omitempty"`
+	// FailedSystemdUnits is the configuration for checking for systemd units in the failed state. The check is disabled
+	// if not set.
+	// +optional
+	FailedSystemdUnits *FailedSystemdUnitsCheckConfig `json:"failedSystemdUnits,omitempty"`
+	// ClockSkew is the configuration for checking the clock of the node against an NTP server. The check is disabled if
+	// not set.
+	// +optional
+	ClockSkew *ClockSkewCheckConfig `json:"clockSkew,omitempty"`
+	// KernelProblems is the configuration for checking the kernel messages in the journal for out-of-memory kills and
+	// hung tasks. The check is disabled if not set.
+	// +optional
+	KernelProblems *KernelProblemsCheckConfig `json:"kernelProblems,omitempty"`
+	// KubeletClientCertificateExpiry is the configuration for checking the expiry of the client certificate of the
+	// kubelet. The check is disabled if not set.
+	// +optional
+	KubeletClientCertificateExpiry *CertificateExpiryCheckConfig `json:"kubeletClientCertificateExpiry,omitempty"`
+}
+
+// DataVolumePressureCheckConfig defines the configuration of the data volume pressure check.
+type DataVolumePressureCheckConfig struct {
+	// Paths are the paths whose file systems are checked. Paths which do not exist are ignored. Defaults to the data
+	// directories of kubelet and containerd.
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+	// UsageThresholdPercent is the disk usage in percent above which a problem is reported. Defaults to 90.
+	// +optional
+	UsageThresholdPercent *int32 `json:"usageThresholdPercent,omitempty"`
+	// InodesThresholdPercent is the inode usage in percent above which a problem is reported. Defaults to 90.
+	// +optional
+	InodesThresholdPercent *int32 `json:"inodesThresholdPercent,omitempty"`
+	// Remediation is the configuration for remediating the problem.
+	// +optional
+	Remediation *RemediationConfig `json:"remediation,omitempty"`
+}
+
+// FailedSystemdUnitsCheckConfig defines the configuration of the failed systemd units check.
+type FailedSystemdUnitsCheckConfig struct {
+	// IgnoredUnits are the names of the units which are not considered by the check.
+	// +optional
+	IgnoredUnits []string `json:"ignoredUnits,omitempty"`
+	// Remediation is the configuration for remediating the problem. If the `RestartUnits` action is configured without
+	// units, the failed units are restarted.
+	// +optional
+	Remediation *RemediationConfig `json:"remediation,omitempty"`
+}
+
+// ClockSkewCheckConfig defines the configuration of the clock skew check.
+type ClockSkewCheckConfig struct {
+	// NTPServer is the address of the NTP server the clock of the node is compared with. The port defaults to 123.
+	NTPServer string `json:"ntpServer"`
+	// MaxSkew is the maximum tolerated deviation of the clock of the node. Defaults to 2s.
+	// +optional
+	MaxSkew *metav1.Duration `json:"maxSkew,omitempty"`
+	// Remediation is the configuration for remediating the problem.
+	// +optional
+	Remediation *RemediationConfig `json:"remediation,omitempty"`
+}
+
+// KernelProblemsCheckConfig defines the configuration of the kernel problems check.
+type KernelProblemsCheckConfig struct {
+	// Window is the duration for which kernel messages are considered, i.e., a problem is reported as long as the
+	// kernel reported an out-of-memory kill or a hung task within this duration. Defaults to 10m.
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+	// Remediation is the configuration for remediating the problem.
+	// +optional
+	Remediation *RemediationConfig `json:"remediation,omitempty"`
+}
+
+// CertificateExpiryCheckConfig defines the configuration of a certificate expiry check.
+type CertificateExpiryCheckConfig struct {
+	// Path is the path of the PEM encoded certificate. Defaults to the current client certificate of the kubelet.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Threshold is the remaining validity of the certificate below which a problem is reported. Defaults to 24h.
+	// +optional
+	Threshold *metav1.Duration `json:"threshold,omitempty"`
+	// Remediation is the configuration for remediating the problem.
+	// +optional
+	Remediation *RemediationConfig `json:"remediation,omitempty"`
+}
+
+// RemediationAction is the action performed for remediating a problem of the node.
+type RemediationAction string
+
+const (
+	// RemediationActionRestartUnits restarts systemd units.
+	RemediationActionRestartUnits RemediationAction = "RestartUnits"
+	// RemediationActionReboot reboots the node.
+	RemediationActionReboot RemediationAction = "Reboot"
+)
+
+// RemediationConfig defines how a problem of the node is remediated. A problem is only remediated if it persists for
+// at least a minute.
+type RemediationConfig struct {
+	// Action is the action performed for remediating the problem. Possible values are `RestartUnits` and `Reboot`.
+	Action RemediationAction `json:"action"`
+	// Units are the names of the systemd units which are restarted by the `RestartUnits` action.
+	// +optional
+	Units []string `json:"units,omitempty"`
+	// MinInterval is the minimum duration between two remediations of the problem. It also applies across restarts of
+	// gardener-node-agent and reboots of the node. Defaults to 1h.
+	// +optional
+	MinInterval *metav1.Duration `json:"minInterval,omitempty"`
 }
 
 // TokenControllerConfig defines the configuration of the access token controller.
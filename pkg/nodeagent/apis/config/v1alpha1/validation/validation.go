@@ -48,6 +48,7 @@ func validateControllerConfiguration(conf nodeagentconfigv1alpha1.ControllerConf
 
 	allErrs = append(allErrs, validateOperatingSystemConfigControllerConfiguration(conf.OperatingSystemConfig, fldPath.Child("operatingSystemConfig"))...)
 	allErrs = append(allErrs, validateTokenControllerConfiguration(conf.Token, fldPath.Child("token"))...)
+	allErrs = append(allErrs, validateHealthCheckControllerConfiguration(conf.HealthCheck, fldPath.Child("healthCheck"))...)
 
 	return allErrs
 }
@@ -61,6 +62,19 @@ func validateOperatingSystemConfigControllerConfiguration(conf nodeagentconfigv1
 
 	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
 
+	if conf.Rollback != nil && conf.Rollback.HealthCheckTimeout != nil && conf.Rollback.HealthCheckTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rollback", "healthCheckTimeout"), conf.Rollback.HealthCheckTimeout, "must be positive"))
+	}
+
+	if conf.InPlaceUpdates != nil {
+		if conf.InPlaceUpdates.MaxUnavailable < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("inPlaceUpdates", "maxUnavailable"), conf.InPlaceUpdates.MaxUnavailable, "must be at least 1"))
+		}
+		if conf.InPlaceUpdates.DrainTimeout != nil && conf.InPlaceUpdates.DrainTimeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("inPlaceUpdates", "drainTimeout"), conf.InPlaceUpdates.DrainTimeout, "must be positive"))
+		}
+	}
+
 	if conf.KubernetesVersion == nil {
 		allErrs = append(allErrs, field.Required(fldPath.Child("kubernetesVersion"), "must provide a supported kubernetes version"))
 	} else if err := kubernetesversion.CheckIfSupported(conf.KubernetesVersion.String()); err != nil {
@@ -98,6 +112,93 @@ func validateTokenControllerConfiguration(conf nodeagentconfigv1alpha1.TokenCont
 	return allErrs
 }
 
+func validateHealthCheckControllerConfiguration(conf *nodeagentconfigv1alpha1.HealthCheckControllerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if cfg := conf.DataVolumePressure; cfg != nil {
+		idxPath := fldPath.Child("dataVolumePressure")
+		allErrs = append(allErrs, validatePercentage(cfg.UsageThresholdPercent, idxPath.Child("usageThresholdPercent"))...)
+		allErrs = append(allErrs, validatePercentage(cfg.InodesThresholdPercent, idxPath.Child("inodesThresholdPercent"))...)
+		allErrs = append(allErrs, validateRemediation(cfg.Remediation, true, idxPath.Child("remediation"))...)
+	}
+
+	if cfg := conf.FailedSystemdUnits; cfg != nil {
+		allErrs = append(allErrs, validateRemediation(cfg.Remediation, false, fldPath.Child("failedSystemdUnits", "remediation"))...)
+	}
+
+	if cfg := conf.ClockSkew; cfg != nil {
+		idxPath := fldPath.Child("clockSkew")
+		if cfg.NTPServer == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("ntpServer"), "must provide the NTP server"))
+		}
+		allErrs = append(allErrs, validatePositiveDuration(cfg.MaxSkew, idxPath.Child("maxSkew"))...)
+		allErrs = append(allErrs, validateRemediation(cfg.Remediation, true, idxPath.Child("remediation"))...)
+	}
+
+	if cfg := conf.KernelProblems; cfg != nil {
+		idxPath := fldPath.Child("kernelProblems")
+		allErrs = append(allErrs, validatePositiveDuration(cfg.Window, idxPath.Child("window"))...)
+		allErrs = append(allErrs, validateRemediation(cfg.Remediation, true, idxPath.Child("remediation"))...)
+	}
+
+	if cfg := conf.KubeletClientCertificateExpiry; cfg != nil {
+		idxPath := fldPath.Child("kubeletClientCertificateExpiry")
+		allErrs = append(allErrs, validatePositiveDuration(cfg.Threshold, idxPath.Child("threshold"))...)
+		allErrs = append(allErrs, validateRemediation(cfg.Remediation, true, idxPath.Child("remediation"))...)
+	}
+
+	return allErrs
+}
+
+var availableRemediationActions = sets.New(
+	string(nodeagentconfigv1alpha1.RemediationActionRestartUnits),
+	string(nodeagentconfigv1alpha1.RemediationActionReboot),
+)
+
+func validateRemediation(conf *nodeagentconfigv1alpha1.RemediationConfig, unitsRequired bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if !availableRemediationActions.Has(string(conf.Action)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("action"), conf.Action, sets.List(availableRemediationActions)))
+	}
+
+	if conf.Action == nodeagentconfigv1alpha1.RemediationActionRestartUnits && unitsRequired && len(conf.Units) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("units"), "must provide the units to restart"))
+	}
+
+	allErrs = append(allErrs, validatePositiveDuration(conf.MinInterval, fldPath.Child("minInterval"))...)
+
+	return allErrs
+}
+
+func validatePercentage(val *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if val != nil && (*val < 1 || *val > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *val, "must be between 1 and 100"))
+	}
+
+	return allErrs
+}
+
+func validatePositiveDuration(val *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if val != nil && val.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, val, "must be positive"))
+	}
+
+	return allErrs
+}
+
 func validateSyncPeriod(val *metav1.Duration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -14,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1/validation"
@@ -107,6 +108,129 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 				})),
 			))
 		})
+
+		It("should fail because rollback health check timeout is not positive", func() {
+			config.Controllers.OperatingSystemConfig.Rollback = &RollbackConfig{HealthCheckTimeout: &metav1.Duration{}}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.rollback.healthCheckTimeout"),
+				})),
+			))
+		})
+
+		It("should succeed for a valid in-place updates configuration", func() {
+			config.Controllers.OperatingSystemConfig.InPlaceUpdates = &InPlaceUpdatesConfig{
+				MaxUnavailable: 1,
+				DrainTimeout:   &metav1.Duration{Duration: time.Hour},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because in-place updates configuration is invalid", func() {
+			config.Controllers.OperatingSystemConfig.InPlaceUpdates = &InPlaceUpdatesConfig{
+				DrainTimeout: &metav1.Duration{},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.inPlaceUpdates.maxUnavailable"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.inPlaceUpdates.drainTimeout"),
+				})),
+			))
+		})
+	})
+
+	Context("Health Check Controller", func() {
+		It("should succeed for a valid health check configuration", func() {
+			config.Controllers.HealthCheck = &HealthCheckControllerConfig{
+				DataVolumePressure: &DataVolumePressureCheckConfig{
+					UsageThresholdPercent: ptr.To[int32](85),
+					Remediation:           &RemediationConfig{Action: RemediationActionRestartUnits, Units: []string{"containerd.service"}},
+				},
+				FailedSystemdUnits: &FailedSystemdUnitsCheckConfig{
+					Remediation: &RemediationConfig{Action: RemediationActionRestartUnits},
+				},
+				ClockSkew: &ClockSkewCheckConfig{
+					NTPServer: "pool.ntp.org",
+					MaxSkew:   &metav1.Duration{Duration: time.Second},
+				},
+				KernelProblems: &KernelProblemsCheckConfig{
+					Remediation: &RemediationConfig{Action: RemediationActionReboot, MinInterval: &metav1.Duration{Duration: 6 * time.Hour}},
+				},
+				KubeletClientCertificateExpiry: &CertificateExpiryCheckConfig{},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because the health check configuration is invalid", func() {
+			config.Controllers.HealthCheck = &HealthCheckControllerConfig{
+				DataVolumePressure: &DataVolumePressureCheckConfig{
+					UsageThresholdPercent:  ptr.To[int32](0),
+					InodesThresholdPercent: ptr.To[int32](101),
+					Remediation:            &RemediationConfig{Action: RemediationActionRestartUnits},
+				},
+				FailedSystemdUnits: &FailedSystemdUnitsCheckConfig{
+					Remediation: &RemediationConfig{Action: "Foo"},
+				},
+				ClockSkew: &ClockSkewCheckConfig{
+					MaxSkew: &metav1.Duration{},
+				},
+				KernelProblems: &KernelProblemsCheckConfig{
+					Window:      &metav1.Duration{},
+					Remediation: &RemediationConfig{Action: RemediationActionReboot, MinInterval: &metav1.Duration{}},
+				},
+				KubeletClientCertificateExpiry: &CertificateExpiryCheckConfig{
+					Threshold: &metav1.Duration{Duration: -time.Hour},
+				},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.dataVolumePressure.usageThresholdPercent"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.dataVolumePressure.inodesThresholdPercent"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.dataVolumePressure.remediation.units"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.healthCheck.failedSystemdUnits.remediation.action"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.clockSkew.ntpServer"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.clockSkew.maxSkew"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.kernelProblems.window"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.kernelProblems.remediation.minInterval"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.kubeletClientCertificateExpiry.threshold"),
+				})),
+			))
+		})
 	})
 
 	Context("Token Controller", func() {
@@ -27,4 +27,35 @@ func SetObjectDefaults_NodeAgentConfiguration(in *NodeAgentConfiguration) {
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_OperatingSystemConfigControllerConfig(&in.Controllers.OperatingSystemConfig)
 	SetDefaults_TokenControllerConfig(&in.Controllers.Token)
+	if in.Controllers.HealthCheck != nil {
+		if in.Controllers.HealthCheck.DataVolumePressure != nil {
+			SetDefaults_DataVolumePressureCheckConfig(in.Controllers.HealthCheck.DataVolumePressure)
+			if in.Controllers.HealthCheck.DataVolumePressure.Remediation != nil {
+				SetDefaults_RemediationConfig(in.Controllers.HealthCheck.DataVolumePressure.Remediation)
+			}
+		}
+		if in.Controllers.HealthCheck.FailedSystemdUnits != nil {
+			if in.Controllers.HealthCheck.FailedSystemdUnits.Remediation != nil {
+				SetDefaults_RemediationConfig(in.Controllers.HealthCheck.FailedSystemdUnits.Remediation)
+			}
+		}
+		if in.Controllers.HealthCheck.ClockSkew != nil {
+			SetDefaults_ClockSkewCheckConfig(in.Controllers.HealthCheck.ClockSkew)
+			if in.Controllers.HealthCheck.ClockSkew.Remediation != nil {
+				SetDefaults_RemediationConfig(in.Controllers.HealthCheck.ClockSkew.Remediation)
+			}
+		}
+		if in.Controllers.HealthCheck.KernelProblems != nil {
+			SetDefaults_KernelProblemsCheckConfig(in.Controllers.HealthCheck.KernelProblems)
+			if in.Controllers.HealthCheck.KernelProblems.Remediation != nil {
+				SetDefaults_RemediationConfig(in.Controllers.HealthCheck.KernelProblems.Remediation)
+			}
+		}
+		if in.Controllers.HealthCheck.KubeletClientCertificateExpiry != nil {
+			SetDefaults_CertificateExpiryCheckConfig(in.Controllers.HealthCheck.KubeletClientCertificateExpiry)
+			if in.Controllers.HealthCheck.KubeletClientCertificateExpiry.Remediation != nil {
+				SetDefaults_RemediationConfig(in.Controllers.HealthCheck.KubeletClientCertificateExpiry.Remediation)
+			}
+		}
+	}
 }
@@ -22,10 +22,14 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/controller/node"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/token"
+	"github.com/gardener/gardener/pkg/nodeagent/debug"
 )
 
-// AddToManager adds all controllers to the given manager.
-func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Manager, cfg *nodeagentconfigv1alpha1.NodeAgentConfiguration, hostName, machineName, nodeName string) error {
+// AddToManager adds all controllers to the given manager. The errors of their reconcilers are recorded for the debugging
+// API server, and the operating system config controller is registered at it as well.
+func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Manager, cfg *nodeagentconfigv1alpha1.NodeAgentConfiguration, hostName, machineName, nodeName string, debugServer *debug.Server) error {
+	reconcileErrors := debugServer.ReconcileErrors
+
 	nodePredicate, err := predicate.LabelSelectorPredicate(metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelHostname: hostName}})
 	if err != nil {
 		return fmt.Errorf("failed computing label selector predicate for node: %w", err)
@@ -33,28 +37,35 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 
 	if features.DefaultFeatureGate.Enabled(features.NodeAgentAuthorizer) {
 		if err := (&certificate.Reconciler{
-			Cancel:      cancel,
-			MachineName: machineName,
+			Cancel:         cancel,
+			MachineName:    machineName,
+			WrapReconciler: reconcileErrors.WrapReconciler(certificate.ControllerName),
 		}).AddToManager(mgr); err != nil {
 			return fmt.Errorf("failed adding certificate controller: %w", err)
 		}
 	}
 
-	if err := (&node.Reconciler{}).AddToManager(mgr, nodePredicate); err != nil {
+	if err := (&node.Reconciler{
+		WrapReconciler: reconcileErrors.WrapReconciler(node.ControllerName),
+	}).AddToManager(mgr, nodePredicate); err != nil {
 		return fmt.Errorf("failed adding node controller: %w", err)
 	}
 
-	if err := (&operatingsystemconfig.Reconciler{
-		Config:        cfg.Controllers.OperatingSystemConfig,
-		HostName:      hostName,
-		NodeName:      nodeName,
-		CancelContext: cancel,
-	}).AddToManager(ctx, mgr); err != nil {
+	operatingSystemConfigReconciler := &operatingsystemconfig.Reconciler{
+		Config:         cfg.Controllers.OperatingSystemConfig,
+		HostName:       hostName,
+		NodeName:       nodeName,
+		CancelContext:  cancel,
+		WrapReconciler: reconcileErrors.WrapReconciler(operatingsystemconfig.ControllerName),
+	}
+	if err := operatingSystemConfigReconciler.AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed adding operating system config controller: %w", err)
 	}
+	debugServer.SetOperatingSystemConfigReconciler(operatingSystemConfigReconciler)
 
 	if err := (&token.Reconciler{
-		Config: cfg.Controllers.Token,
+		Config:         cfg.Controllers.Token,
+		WrapReconciler: reconcileErrors.WrapReconciler(token.ControllerName),
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding token controller: %w", err)
 	}
@@ -62,18 +73,24 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 	// Enable lease controller only if gardener-node-agent was able to determine the node name.
 	// Otherwise, gardener-node-agent would try to list leases of the entire kube-system namespace which is not allowed by node-agent-authorizer.
 	if !features.DefaultFeatureGate.Enabled(features.NodeAgentAuthorizer) || nodeName != "" {
-		if err := (&lease.Reconciler{}).AddToManager(mgr, nodePredicate); err != nil {
+		if err := (&lease.Reconciler{
+			WrapReconciler: reconcileErrors.WrapReconciler(lease.ControllerName),
+		}).AddToManager(mgr, nodePredicate); err != nil {
 			return fmt.Errorf("failed adding lease controller: %w", err)
 		}
 	}
 
-	if err := (&healthcheck.Reconciler{}).AddToManager(mgr, nodePredicate); err != nil {
+	if err := (&healthcheck.Reconciler{
+		Config:         cfg.Controllers.HealthCheck,
+		WrapReconciler: reconcileErrors.WrapReconciler(healthcheck.ControllerName),
+	}).AddToManager(mgr, nodePredicate); err != nil {
 		return fmt.Errorf("failed adding health-check controller: %w", err)
 	}
 
 	if err := (&hostnamecheck.Reconciler{
-		HostName:      hostName,
-		CancelContext: cancel,
+		HostName:       hostName,
+		CancelContext:  cancel,
+		WrapReconciler: reconcileErrors.WrapReconciler(hostnamecheck.ControllerName),
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding hostname-check controller: %w", err)
 	}
@@ -25,11 +25,12 @@ import (
 // When the certificate is renewed it saves the resulting kubeconfig on the disk, cancels its context to initiate a
 // restart of gardener-node-agent.
 type Reconciler struct {
-	Cancel      context.CancelFunc
-	Clock       clock.Clock
-	FS          afero.Afero
-	Config      *rest.Config
-	MachineName string
+	Cancel         context.CancelFunc
+	Clock          clock.Clock
+	FS             afero.Afero
+	Config         *rest.Config
+	MachineName    string
+	WrapReconciler func(reconcile.Reconciler) reconcile.Reconciler
 
 	renewalDeadline *time.Time
 }
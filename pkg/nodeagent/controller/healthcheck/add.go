@@ -12,12 +12,16 @@ import (
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/defaults"
 	"github.com/containerd/containerd/namespaces"
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 )
@@ -43,25 +47,37 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, nodePredicate predicate.P
 		r.DBus = dbus.New(mgr.GetLogger().WithValues("controller", ControllerName))
 	}
 
+	if r.FS.Fs == nil {
+		r.FS = afero.Afero{Fs: afero.NewOsFs()}
+	}
+
 	if len(r.HealthCheckers) == 0 {
-		if err := r.setDefaultHealthChecks(); err != nil {
+		healthCheckers, err := NewDefaultHealthCheckers(r.Client, r.DBus, r.Recorder)
+		if err != nil {
 			return err
 		}
+		r.HealthCheckers = append(healthCheckers, NewNodeProblemCheckers(r.Client, clock.RealClock{}, r.DBus, r.Recorder, r.FS, r.Config)...)
 	}
 
 	if r.HealthCheckIntervalSeconds == 0 {
 		r.HealthCheckIntervalSeconds = defaultIntervalSeconds
 	}
 
+	var reconciler reconcile.Reconciler = r
+	if r.WrapReconciler != nil {
+		reconciler = r.WrapReconciler(r)
+	}
+
 	return builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
 		For(&corev1.Node{}, builder.WithPredicates(nodePredicate)).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
-		Complete(r)
+		Complete(reconciler)
 }
 
-func (r *Reconciler) setDefaultHealthChecks() error {
+// NewDefaultHealthCheckers returns the health checkers for containerd and kubelet.
+func NewDefaultHealthCheckers(c client.Client, dbus dbus.DBus, recorder record.EventRecorder) ([]HealthChecker, error) {
 	clock := clock.RealClock{}
 
 	address := os.Getenv("CONTAINERD_ADDRESS")
@@ -76,12 +92,11 @@ func (r *Reconciler) setDefaultHealthChecks() error {
 
 	client, err := containerd.New(address, containerd.WithDefaultNamespace(namespace))
 	if err != nil {
-		return fmt.Errorf("error creating containerd client: %w", err)
+		return nil, fmt.Errorf("error creating containerd client: %w", err)
 	}
 
-	containerdHealthChecker := NewContainerdHealthChecker(r.Client, client, clock, r.DBus, r.Recorder)
-
-	kubeletHealthChecker := NewKubeletHealthChecker(r.Client, clock, r.DBus, r.Recorder, net.InterfaceAddrs)
-	r.HealthCheckers = []HealthChecker{containerdHealthChecker, kubeletHealthChecker}
-	return nil
+	return []HealthChecker{
+		NewContainerdHealthChecker(c, client, clock, dbus, recorder),
+		NewKubeletHealthChecker(c, clock, dbus, recorder, net.InterfaceAddrs),
+	}, nil
 }
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+type certificateExpiryDetector struct {
+	config nodeagentconfigv1alpha1.CertificateExpiryCheckConfig
+	clock  clock.Clock
+	fs     afero.Afero
+}
+
+// NewCertificateExpiryDetector creates a detector which reports a problem if the remaining validity of the configured
+// certificate falls below the configured threshold.
+func NewCertificateExpiryDetector(config nodeagentconfigv1alpha1.CertificateExpiryCheckConfig, clock clock.Clock, fs afero.Afero) NodeProblemDetector {
+	return &certificateExpiryDetector{config: config, clock: clock, fs: fs}
+}
+
+// Name returns the name of this detector.
+func (*certificateExpiryDetector) Name() string {
+	return "kubelet-client-certificate-expiry"
+}
+
+// ConditionType returns the type of the Node condition the result of this detector is reported with.
+func (*certificateExpiryDetector) ConditionType() corev1.NodeConditionType {
+	return nodeagentconfigv1alpha1.NodeConditionTypeKubeletClientCertificateExpiring
+}
+
+// Detect returns a problem if the certificate expires within the threshold.
+func (c *certificateExpiryDetector) Detect(_ context.Context) (*NodeProblem, error) {
+	content, err := c.fs.ReadFile(c.config.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading certificate file %s: %w", c.config.Path, err)
+	}
+
+	// The file might contain the certificate and the private key (e.g., the kubelet client certificate).
+	var certificate *x509.Certificate
+	for block, rest := pem.Decode(content); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if certificate, err = x509.ParseCertificate(block.Bytes); err != nil {
+			return nil, fmt.Errorf("failed parsing certificate in %s: %w", c.config.Path, err)
+		}
+		break
+	}
+	if certificate == nil {
+		return nil, fmt.Errorf("no certificate found in %s", c.config.Path)
+	}
+
+	threshold := 24 * time.Hour
+	if c.config.Threshold != nil {
+		threshold = c.config.Threshold.Duration
+	}
+
+	remainingValidity := certificate.NotAfter.Sub(c.clock.Now())
+	switch {
+	case remainingValidity <= 0:
+		return &NodeProblem{
+			Reason:  "CertificateExpired",
+			Message: fmt.Sprintf("The certificate %s expired at %s.", c.config.Path, certificate.NotAfter.UTC().Format(time.RFC3339)),
+		}, nil
+	case remainingValidity < threshold:
+		return &NodeProblem{
+			Reason:  "CertificateExpiring",
+			Message: fmt.Sprintf("The certificate %s expires at %s (threshold %s).", c.config.Path, certificate.NotAfter.UTC().Format(time.RFC3339), threshold),
+		}, nil
+	}
+
+	return nil, nil
+}
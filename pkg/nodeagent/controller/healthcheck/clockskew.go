@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const (
+	// ntpPacketSize is the size of NTP packets without extension fields.
+	ntpPacketSize = 48
+	// ntpEpochOffset is the number of seconds between the NTP epoch (1900) and the Unix epoch (1970).
+	ntpEpochOffset = 2208988800
+	// ntpQueryTimeout is the maximum duration of a query of the NTP server.
+	ntpQueryTimeout = 5 * time.Second
+)
+
+// QueryNTPOffset queries the given NTP server using the SNTP protocol (RFC 4330) and returns the offset of the local
+// clock, i.e., a positive offset means that the local clock is behind the time of the NTP server.
+func QueryNTPOffset(ctx context.Context, server string) (time.Duration, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "123")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ntpQueryTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", server)
+	if err != nil {
+		return 0, fmt.Errorf("failed connecting to NTP server %s: %w", server, err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return 0, fmt.Errorf("failed setting deadline for NTP query: %w", err)
+		}
+	}
+
+	request := make([]byte, ntpPacketSize)
+	// Leap indicator 0, version 4, mode 3 (client).
+	request[0] = 0<<6 | 4<<3 | 3
+
+	originateTime := time.Now()
+	if _, err := conn.Write(request); err != nil {
+		return 0, fmt.Errorf("failed sending NTP request to %s: %w", server, err)
+	}
+
+	response := make([]byte, ntpPacketSize)
+	n, err := conn.Read(response)
+	if err != nil {
+		return 0, fmt.Errorf("failed reading NTP response from %s: %w", server, err)
+	}
+	destinationTime := time.Now()
+
+	return ntpOffset(response[:n], originateTime, destinationTime)
+}
+
+func ntpOffset(response []byte, originateTime, destinationTime time.Time) (time.Duration, error) {
+	if len(response) < ntpPacketSize {
+		return 0, fmt.Errorf("NTP response is too short (%d bytes)", len(response))
+	}
+	if mode := response[0] & 0x7; mode != 4 {
+		return 0, fmt.Errorf("unexpected mode %d in NTP response", mode)
+	}
+	if stratum := response[1]; stratum == 0 {
+		return 0, fmt.Errorf("NTP server sent kiss-o'-death packet with code %q", string(response[12:16]))
+	}
+
+	var (
+		receiveTime  = ntpTime(response[32:40])
+		transmitTime = ntpTime(response[40:48])
+	)
+
+	return (receiveTime.Sub(originateTime) + transmitTime.Sub(destinationTime)) / 2, nil
+}
+
+func ntpTime(b []byte) time.Time {
+	var (
+		seconds  = int64(binary.BigEndian.Uint32(b[0:4])) - ntpEpochOffset
+		fraction = uint64(binary.BigEndian.Uint32(b[4:8]))
+	)
+	// #nosec G115 -- The result of the shift is smaller than 1e9.
+	return time.Unix(seconds, int64((fraction*uint64(time.Second))>>32))
+}
+
+type clockSkewDetector struct {
+	config   nodeagentconfigv1alpha1.ClockSkewCheckConfig
+	queryNTP func(context.Context, string) (time.Duration, error)
+}
+
+// NewClockSkewDetector creates a detector which reports a problem if the clock of the node deviates from the time of
+// the configured NTP server by more than the configured maximum skew.
+func NewClockSkewDetector(config nodeagentconfigv1alpha1.ClockSkewCheckConfig, queryNTP func(context.Context, string) (time.Duration, error)) NodeProblemDetector {
+	return &clockSkewDetector{config: config, queryNTP: queryNTP}
+}
+
+// Name returns the name of this detector.
+func (*clockSkewDetector) Name() string {
+	return "clock-skew"
+}
+
+// ConditionType returns the type of the Node condition the result of this detector is reported with.
+func (*clockSkewDetector) ConditionType() corev1.NodeConditionType {
+	return nodeagentconfigv1alpha1.NodeConditionTypeClockSkew
+}
+
+// Detect returns a problem if the clock of the node deviates from the time of the NTP server by more than the maximum
+// skew.
+func (c *clockSkewDetector) Detect(ctx context.Context) (*NodeProblem, error) {
+	offset, err := c.queryNTP(ctx, c.config.NTPServer)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSkew := 2 * time.Second
+	if c.config.MaxSkew != nil {
+		maxSkew = c.config.MaxSkew.Duration
+	}
+
+	if offset.Abs() <= maxSkew {
+		return nil, nil
+	}
+
+	return &NodeProblem{
+		Reason: "ClockSkewExceeded",
+		// The offset is rounded so that the message does not change on every check.
+		Message: fmt.Sprintf("The clock of the node deviates by %s from the time of NTP server %s (maximum skew %s).", offset.Round(time.Second), c.config.NTPServer, maxSkew),
+	}, nil
+}
@@ -51,6 +51,14 @@ func (*containerdHealthChecker) Name() string {
 	return "containerd"
 }
 
+// Probe returns an error if the version of containerd cannot be retrieved.
+func (c *containerdHealthChecker) Probe(ctx context.Context) error {
+	if _, err := c.containerdClient.Version(ctx); err != nil {
+		return fmt.Errorf("unable to get containerd version: %w", err)
+	}
+	return nil
+}
+
 // Check performs the actual health check for containerd.
 func (c *containerdHealthChecker) Check(ctx context.Context, node *corev1.Node) error {
 	log := logf.FromContext(ctx).WithName(c.Name())
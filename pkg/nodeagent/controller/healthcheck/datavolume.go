@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// FileSystemStats contains the block and inode statistics of a file system.
+type FileSystemStats struct {
+	// TotalBlocks is the total number of blocks of the file system.
+	TotalBlocks uint64
+	// FreeBlocks is the number of free blocks of the file system.
+	FreeBlocks uint64
+	// AvailableBlocks is the number of blocks available to unprivileged users.
+	AvailableBlocks uint64
+	// TotalInodes is the total number of inodes of the file system.
+	TotalInodes uint64
+	// FreeInodes is the number of free inodes of the file system.
+	FreeInodes uint64
+}
+
+// StatFS returns the statistics of the file system containing the given path.
+func StatFS(path string) (FileSystemStats, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return FileSystemStats{}, &os.PathError{Op: "statfs", Path: path, Err: err}
+	}
+
+	return FileSystemStats{
+		TotalBlocks:     stat.Blocks,
+		FreeBlocks:      stat.Bfree,
+		AvailableBlocks: stat.Bavail,
+		TotalInodes:     stat.Files,
+		FreeInodes:      stat.Ffree,
+	}, nil
+}
+
+type dataVolumePressureDetector struct {
+	config nodeagentconfigv1alpha1.DataVolumePressureCheckConfig
+	statFS func(string) (FileSystemStats, error)
+}
+
+// NewDataVolumePressureDetector creates a detector which reports a problem if the disk or inode usage of the file
+// systems of the configured paths exceeds the configured thresholds.
+func NewDataVolumePressureDetector(config nodeagentconfigv1alpha1.DataVolumePressureCheckConfig, statFS func(string) (FileSystemStats, error)) NodeProblemDetector {
+	return &dataVolumePressureDetector{config: config, statFS: statFS}
+}
+
+// Name returns the name of this detector.
+func (*dataVolumePressureDetector) Name() string {
+	return "data-volume-pressure"
+}
+
+// ConditionType returns the type of the Node condition the result of this detector is reported with.
+func (*dataVolumePressureDetector) ConditionType() corev1.NodeConditionType {
+	return nodeagentconfigv1alpha1.NodeConditionTypeDataVolumePressure
+}
+
+// Detect returns a problem if the disk or inode usage of any of the configured paths exceeds the thresholds.
+func (d *dataVolumePressureDetector) Detect(_ context.Context) (*NodeProblem, error) {
+	var (
+		usageThreshold  = ptr.Deref(d.config.UsageThresholdPercent, 90)
+		inodesThreshold = ptr.Deref(d.config.InodesThresholdPercent, 90)
+		messages        []string
+	)
+
+	for _, path := range d.config.Paths {
+		stats, err := d.statFS(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("failed getting file system statistics of %s: %w", path, err)
+		}
+
+		// The usage is computed in the same way as by `df`, i.e., blocks reserved for privileged users are not
+		// considered to be available.
+		if used := stats.TotalBlocks - stats.FreeBlocks; used+stats.AvailableBlocks > 0 {
+			if usage := percentage(used, used+stats.AvailableBlocks); usage > uint64(usageThreshold) {
+				messages = append(messages, fmt.Sprintf("disk usage of %s is %d%% (threshold %d%%)", path, usage, usageThreshold))
+			}
+		}
+
+		if stats.TotalInodes > 0 {
+			if usage := percentage(stats.TotalInodes-stats.FreeInodes, stats.TotalInodes); usage > uint64(inodesThreshold) {
+				messages = append(messages, fmt.Sprintf("inode usage of %s is %d%% (threshold %d%%)", path, usage, inodesThreshold))
+			}
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	return &NodeProblem{
+		Reason:  "UsageThresholdExceeded",
+		Message: "The " + strings.Join(messages, ", ") + ".",
+	}, nil
+}
+
+func percentage(part, total uint64) uint64 {
+	return (part*100 + total - 1) / total
+}
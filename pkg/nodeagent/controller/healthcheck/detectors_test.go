@@ -0,0 +1,321 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+var _ = Describe("Node problem detectors", func() {
+	var (
+		ctx       = context.Background()
+		fakeClock *testclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	})
+
+	Describe("DataVolumePressure", func() {
+		var (
+			config nodeagentconfigv1alpha1.DataVolumePressureCheckConfig
+			stats  map[string]FileSystemStats
+			statFS = func(path string) (FileSystemStats, error) {
+				s, ok := stats[path]
+				if !ok {
+					return FileSystemStats{}, &fs.PathError{Op: "statfs", Path: path, Err: fs.ErrNotExist}
+				}
+				return s, nil
+			}
+		)
+
+		BeforeEach(func() {
+			config = nodeagentconfigv1alpha1.DataVolumePressureCheckConfig{
+				Paths:                  []string{"/var/lib/kubelet", "/var/lib/containerd"},
+				UsageThresholdPercent:  ptr.To[int32](90),
+				InodesThresholdPercent: ptr.To[int32](80),
+			}
+			stats = map[string]FileSystemStats{
+				"/var/lib/kubelet": {TotalBlocks: 1000, FreeBlocks: 500, AvailableBlocks: 450, TotalInodes: 100, FreeInodes: 50},
+			}
+		})
+
+		It("should not report a problem if the usage is below the thresholds", func() {
+			Expect(NewDataVolumePressureDetector(config, statFS).Detect(ctx)).To(BeNil())
+		})
+
+		It("should report a problem if the disk or inode usage exceeds the threshold", func() {
+			stats["/var/lib/containerd"] = FileSystemStats{TotalBlocks: 1000, FreeBlocks: 100, AvailableBlocks: 50, TotalInodes: 100, FreeInodes: 10}
+
+			Expect(NewDataVolumePressureDetector(config, statFS).Detect(ctx)).To(Equal(&NodeProblem{
+				Reason:  "UsageThresholdExceeded",
+				Message: "The disk usage of /var/lib/containerd is 95% (threshold 90%), inode usage of /var/lib/containerd is 90% (threshold 80%).",
+			}))
+		})
+
+		It("should return an error if the file system statistics cannot be retrieved", func() {
+			detector := NewDataVolumePressureDetector(config, func(string) (FileSystemStats, error) { return FileSystemStats{}, errors.New("fake") })
+
+			_, err := detector.Detect(ctx)
+			Expect(err).To(MatchError(ContainSubstring("fake")))
+		})
+	})
+
+	Describe("FailedSystemdUnits", func() {
+		var fakeDBus *fakedbus.DBus
+
+		BeforeEach(func() {
+			fakeDBus = fakedbus.New()
+		})
+
+		It("should not report a problem if no unit failed", func() {
+			Expect(NewFailedSystemdUnitsDetector(nodeagentconfigv1alpha1.FailedSystemdUnitsCheckConfig{}, fakeDBus).Detect(ctx)).To(BeNil())
+		})
+
+		It("should report the failed units which are not ignored", func() {
+			fakeDBus.FailedUnitNames = []string{"foo.service", "bar.service", "ignored.service"}
+			config := nodeagentconfigv1alpha1.FailedSystemdUnitsCheckConfig{IgnoredUnits: []string{"ignored.service"}}
+
+			Expect(NewFailedSystemdUnitsDetector(config, fakeDBus).Detect(ctx)).To(Equal(&NodeProblem{
+				Reason:  "UnitsFailed",
+				Message: "The following systemd units are in the failed state: bar.service, foo.service.",
+				Units:   []string{"bar.service", "foo.service"},
+			}))
+		})
+
+		It("should not report a problem if only ignored units failed", func() {
+			fakeDBus.FailedUnitNames = []string{"ignored.service"}
+			config := nodeagentconfigv1alpha1.FailedSystemdUnitsCheckConfig{IgnoredUnits: []string{"ignored.service"}}
+
+			Expect(NewFailedSystemdUnitsDetector(config, fakeDBus).Detect(ctx)).To(BeNil())
+		})
+	})
+
+	Describe("ClockSkew", func() {
+		var config nodeagentconfigv1alpha1.ClockSkewCheckConfig
+
+		BeforeEach(func() {
+			config = nodeagentconfigv1alpha1.ClockSkewCheckConfig{
+				NTPServer: "ntp.example.com",
+				MaxSkew:   &metav1.Duration{Duration: time.Second},
+			}
+		})
+
+		It("should not report a problem if the offset is within the maximum skew", func() {
+			queryNTP := func(_ context.Context, server string) (time.Duration, error) {
+				Expect(server).To(Equal("ntp.example.com"))
+				return -500 * time.Millisecond, nil
+			}
+
+			Expect(NewClockSkewDetector(config, queryNTP).Detect(ctx)).To(BeNil())
+		})
+
+		It("should report a problem if the offset exceeds the maximum skew", func() {
+			queryNTP := func(context.Context, string) (time.Duration, error) { return -3200 * time.Millisecond, nil }
+
+			Expect(NewClockSkewDetector(config, queryNTP).Detect(ctx)).To(Equal(&NodeProblem{
+				Reason:  "ClockSkewExceeded",
+				Message: "The clock of the node deviates by -3s from the time of NTP server ntp.example.com (maximum skew 1s).",
+			}))
+		})
+
+		Describe("#QueryNTPOffset", func() {
+			var conn net.PacketConn
+
+			BeforeEach(func() {
+				var err error
+				conn, err = net.ListenPacket("udp", "127.0.0.1:0")
+				Expect(err).NotTo(HaveOccurred())
+				DeferCleanup(func() { Expect(conn.Close()).To(Succeed()) })
+			})
+
+			serve := func(offset time.Duration, stratum byte) {
+				go func() {
+					defer GinkgoRecover()
+
+					request := make([]byte, 48)
+					_, addr, err := conn.ReadFrom(request)
+					if err != nil {
+						return
+					}
+
+					now := time.Now().Add(offset)
+					response := make([]byte, 48)
+					response[0] = 4<<3 | 4
+					response[1] = stratum
+					copy(response[12:16], "RATE")
+					for _, i := range []int{32, 40} {
+						binary.BigEndian.PutUint32(response[i:], uint32(now.Unix()+2208988800))                                // #nosec G115 -- Test code.
+						binary.BigEndian.PutUint32(response[i+4:], uint32((uint64(now.Nanosecond())<<32)/uint64(time.Second))) // #nosec G115 -- Test code.
+					}
+					_, _ = conn.WriteTo(response, addr)
+				}()
+			}
+
+			It("should return the offset of the local clock", func() {
+				serve(time.Hour, 2)
+
+				offset, err := QueryNTPOffset(ctx, conn.LocalAddr().String())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(offset).To(BeNumerically("~", time.Hour, time.Second))
+			})
+
+			It("should return an error for kiss-o'-death packets", func() {
+				serve(0, 0)
+
+				_, err := QueryNTPOffset(ctx, conn.LocalAddr().String())
+				Expect(err).To(MatchError(ContainSubstring(`kiss-o'-death packet with code "RATE"`)))
+			})
+		})
+	})
+
+	Describe("KernelProblems", func() {
+		var (
+			config   nodeagentconfigv1alpha1.KernelProblemsCheckConfig
+			messages []string
+			since    time.Time
+
+			readKernelMessages = func(_ context.Context, s time.Time) ([]string, error) {
+				since = s
+				return messages, nil
+			}
+		)
+
+		BeforeEach(func() {
+			config = nodeagentconfigv1alpha1.KernelProblemsCheckConfig{Window: &metav1.Duration{Duration: 5 * time.Minute}}
+			messages = []string{
+				"eth0: Link is Up",
+				"Memory cgroup out of memory: Killed process 1234 (foo) total-vm:1000kB",
+			}
+		})
+
+		It("should not report a problem if there are no relevant kernel messages", func() {
+			Expect(NewKernelProblemsDetector(config, fakeClock, readKernelMessages).Detect(ctx)).To(BeNil())
+			Expect(since).To(Equal(fakeClock.Now().Add(-5 * time.Minute)))
+		})
+
+		It("should report out-of-memory kills", func() {
+			messages = append(messages, "Out of memory: Killed process 1234 (foo) total-vm:1000kB")
+
+			Expect(NewKernelProblemsDetector(config, fakeClock, readKernelMessages).Detect(ctx)).To(Equal(&NodeProblem{
+				Reason:  "OOMKilling",
+				Message: "The kernel reported 1 out-of-memory kill(s) within the last 5m0s.",
+			}))
+		})
+
+		It("should report hung tasks", func() {
+			messages = append(messages,
+				"INFO: task jbd2/sda1-8:123 blocked for more than 120 seconds.",
+				"INFO: task foo:456 blocked for more than 120 seconds.",
+			)
+
+			Expect(NewKernelProblemsDetector(config, fakeClock, readKernelMessages).Detect(ctx)).To(Equal(&NodeProblem{
+				Reason:  "TaskHung",
+				Message: "The kernel reported 2 hung task(s) within the last 5m0s.",
+			}))
+		})
+
+		It("should report hung tasks and out-of-memory kills", func() {
+			messages = append(messages,
+				"INFO: task foo:456 blocked for more than 120 seconds.",
+				"Out of memory: Killed process 1234 (foo) total-vm:1000kB",
+			)
+
+			Expect(NewKernelProblemsDetector(config, fakeClock, readKernelMessages).Detect(ctx)).To(Equal(&NodeProblem{
+				Reason:  "TaskHungAndOOMKilling",
+				Message: "The kernel reported 1 hung task(s) and 1 out-of-memory kill(s) within the last 5m0s.",
+			}))
+		})
+	})
+
+	Describe("CertificateExpiry", func() {
+		var (
+			fakeFS afero.Afero
+			config nodeagentconfigv1alpha1.CertificateExpiryCheckConfig
+
+			writeCertificate = func(notAfter time.Time) {
+				GinkgoHelper()
+
+				key, err := rsa.GenerateKey(rand.Reader, 2048)
+				Expect(err).NotTo(HaveOccurred())
+
+				template := &x509.Certificate{
+					SerialNumber: big.NewInt(1),
+					Subject:      pkix.Name{CommonName: "system:node:foo"},
+					NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+					NotAfter:     notAfter,
+				}
+				certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+				Expect(err).NotTo(HaveOccurred())
+
+				content := append(
+					pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+					pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})...,
+				)
+				Expect(fakeFS.WriteFile(config.Path, content, 0600)).To(Succeed())
+			}
+		)
+
+		BeforeEach(func() {
+			fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+			config = nodeagentconfigv1alpha1.CertificateExpiryCheckConfig{
+				Path:      "/var/lib/kubelet/pki/kubelet-client-current.pem",
+				Threshold: &metav1.Duration{Duration: 24 * time.Hour},
+			}
+		})
+
+		It("should not report a problem if the certificate is valid long enough", func() {
+			writeCertificate(fakeClock.Now().Add(48 * time.Hour))
+
+			Expect(NewCertificateExpiryDetector(config, fakeClock, fakeFS).Detect(ctx)).To(BeNil())
+		})
+
+		It("should report a problem if the certificate expires soon", func() {
+			writeCertificate(fakeClock.Now().Add(time.Hour))
+
+			Expect(NewCertificateExpiryDetector(config, fakeClock, fakeFS).Detect(ctx)).To(Equal(&NodeProblem{
+				Reason:  "CertificateExpiring",
+				Message: fmt.Sprintf("The certificate %s expires at 2024-01-01T01:00:00Z (threshold 24h0m0s).", config.Path),
+			}))
+		})
+
+		It("should report a problem if the certificate is expired", func() {
+			writeCertificate(fakeClock.Now().Add(-time.Hour))
+
+			Expect(NewCertificateExpiryDetector(config, fakeClock, fakeFS).Detect(ctx)).To(Equal(&NodeProblem{
+				Reason:  "CertificateExpired",
+				Message: fmt.Sprintf("The certificate %s expired at 2023-12-31T23:00:00Z.", config.Path),
+			}))
+		})
+
+		It("should return an error if the certificate file does not exist", func() {
+			_, err := NewCertificateExpiryDetector(config, fakeClock, fakeFS).Detect(ctx)
+			Expect(err).To(MatchError(ContainSubstring("failed reading certificate file")))
+		})
+	})
+})
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+type failedSystemdUnitsDetector struct {
+	config nodeagentconfigv1alpha1.FailedSystemdUnitsCheckConfig
+	dbus   dbus.DBus
+}
+
+// NewFailedSystemdUnitsDetector creates a detector which reports a problem if systemd units are in the failed state.
+func NewFailedSystemdUnitsDetector(config nodeagentconfigv1alpha1.FailedSystemdUnitsCheckConfig, dbus dbus.DBus) NodeProblemDetector {
+	return &failedSystemdUnitsDetector{config: config, dbus: dbus}
+}
+
+// Name returns the name of this detector.
+func (*failedSystemdUnitsDetector) Name() string {
+	return "failed-systemd-units"
+}
+
+// ConditionType returns the type of the Node condition the result of this detector is reported with.
+func (*failedSystemdUnitsDetector) ConditionType() corev1.NodeConditionType {
+	return nodeagentconfigv1alpha1.NodeConditionTypeFailedSystemdUnits
+}
+
+// Detect returns a problem if any systemd unit which is not ignored is in the failed state.
+func (f *failedSystemdUnitsDetector) Detect(ctx context.Context) (*NodeProblem, error) {
+	unitNames, err := f.dbus.FailedUnits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing failed units: %w", err)
+	}
+
+	unitNames = slices.DeleteFunc(unitNames, func(unitName string) bool {
+		return slices.Contains(f.config.IgnoredUnits, unitName)
+	})
+	if len(unitNames) == 0 {
+		return nil, nil
+	}
+	slices.Sort(unitNames)
+
+	return &NodeProblem{
+		Reason:  "UnitsFailed",
+		Message: fmt.Sprintf("The following systemd units are in the failed state: %s.", strings.Join(unitNames, ", ")),
+		Units:   unitNames,
+	}, nil
+}
@@ -21,3 +21,12 @@ type HealthChecker interface {
 	// Check executes the health check.
 	Check(ctx context.Context, node *corev1.Node) error
 }
+
+// HealthProber can be implemented by health checkers to probe the health of a node component once without trying to
+// fix it.
+type HealthProber interface {
+	// Name returns the name of the healthchecker.
+	Name() string
+	// Probe returns an error if the component is unhealthy.
+	Probe(ctx context.Context) error
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+var (
+	// kernelOOMPattern matches the message of the kernel when the system ran out of memory. Out-of-memory kills of
+	// containers due to their memory limits ("Memory cgroup out of memory") are not considered as a problem of the node.
+	kernelOOMPattern = regexp.MustCompile(`^Out of memory: `)
+	// kernelHungTaskPattern matches the message of the kernel when a task is blocked for too long.
+	kernelHungTaskPattern = regexp.MustCompile(`^INFO: task \S+ blocked for more than \d+ seconds\.`)
+)
+
+// ReadKernelMessages returns the kernel messages from the journal since the given time.
+func ReadKernelMessages(ctx context.Context, since time.Time) ([]string, error) {
+	output, err := exec.CommandContext(ctx, "journalctl", "--dmesg", "--no-pager", "--output=cat", fmt.Sprintf("--since=@%d", since.Unix())).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed reading kernel messages from journal: %w", err)
+	}
+	return strings.Split(strings.TrimSpace(string(output)), "\n"), nil
+}
+
+type kernelProblemsDetector struct {
+	config             nodeagentconfigv1alpha1.KernelProblemsCheckConfig
+	clock              clock.Clock
+	readKernelMessages func(context.Context, time.Time) ([]string, error)
+}
+
+// NewKernelProblemsDetector creates a detector which reports a problem if the kernel reported out-of-memory kills or
+// hung tasks within the configured window.
+func NewKernelProblemsDetector(config nodeagentconfigv1alpha1.KernelProblemsCheckConfig, clock clock.Clock, readKernelMessages func(context.Context, time.Time) ([]string, error)) NodeProblemDetector {
+	return &kernelProblemsDetector{config: config, clock: clock, readKernelMessages: readKernelMessages}
+}
+
+// Name returns the name of this detector.
+func (*kernelProblemsDetector) Name() string {
+	return "kernel-problems"
+}
+
+// ConditionType returns the type of the Node condition the result of this detector is reported with.
+func (*kernelProblemsDetector) ConditionType() corev1.NodeConditionType {
+	return nodeagentconfigv1alpha1.NodeConditionTypeKernelProblem
+}
+
+// Detect returns a problem if the kernel messages within the window contain out-of-memory kills or hung tasks.
+func (k *kernelProblemsDetector) Detect(ctx context.Context) (*NodeProblem, error) {
+	window := 10 * time.Minute
+	if k.config.Window != nil {
+		window = k.config.Window.Duration
+	}
+
+	messages, err := k.readKernelMessages(ctx, k.clock.Now().Add(-window))
+	if err != nil {
+		return nil, err
+	}
+
+	var oomKills, hungTasks int
+	for _, message := range messages {
+		switch {
+		case kernelOOMPattern.MatchString(message):
+			oomKills++
+		case kernelHungTaskPattern.MatchString(message):
+			hungTasks++
+		}
+	}
+
+	switch {
+	case hungTasks > 0 && oomKills > 0:
+		return &NodeProblem{
+			Reason:  "TaskHungAndOOMKilling",
+			Message: fmt.Sprintf("The kernel reported %d hung task(s) and %d out-of-memory kill(s) within the last %s.", hungTasks, oomKills, window),
+		}, nil
+	case hungTasks > 0:
+		return &NodeProblem{
+			Reason:  "TaskHung",
+			Message: fmt.Sprintf("The kernel reported %d hung task(s) within the last %s.", hungTasks, window),
+		}, nil
+	case oomKills > 0:
+		return &NodeProblem{
+			Reason:  "OOMKilling",
+			Message: fmt.Sprintf("The kernel reported %d out-of-memory kill(s) within the last %s.", oomKills, window),
+		}, nil
+	}
+
+	return nil, nil
+}
@@ -133,6 +133,25 @@ func (k *KubeletHealthChecker) Check(ctx context.Context, node *corev1.Node) err
 	return err
 }
 
+// Probe returns an error if the health endpoint of the kubelet does not respond successfully.
+func (k *KubeletHealthChecker) Probe(ctx context.Context) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, k.kubeletHealthEndpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed creating request to kubelet health endpoint: %w", err)
+	}
+
+	response, err := k.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("HTTP request to kubelet health endpoint failed: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("kubelet health endpoint responded with status code %d", response.StatusCode)
+	}
+	return nil
+}
+
 // ensureNodeInternalIP restores the internalIP of the node if this was initially set but lost in the process.
 // This happens if Kubelet runs into a timeout when contacting the cloud provider API during start-up, see https://github.com/gardener/gardener/commit/1311de43a1745cbc8cf65d57c72e9ed0a2c5e586#diff-738db1352694482843441061260a6f02.
 func (k *KubeletHealthChecker) ensureNodeInternalIP(ctx context.Context, node *corev1.Node) error {
@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+const (
+	// remediationDir is the directory on the worker node that contains the times of the last remediations of the node
+	// problems. They are persisted on the host for rate limiting remediations across restarts and reboots.
+	remediationDir = nodeagentconfigv1alpha1.BaseDir + "/health-check"
+
+	conditionReasonNoProblem   = "NoProblem"
+	conditionReasonCheckFailed = "CheckFailed"
+)
+
+// NodeProblem describes a problem of the node detected by a NodeProblemDetector.
+type NodeProblem struct {
+	// Reason is a brief CamelCase reason for the problem.
+	Reason string
+	// Message is a human-readable description of the problem.
+	Message string
+	// Units are the names of the systemd units affected by the problem. They are restarted by the `RestartUnits`
+	// remediation action if no units are configured.
+	Units []string
+}
+
+// NodeProblemDetector can be implemented to detect a problem of the node which is reported via a dedicated Node
+// condition.
+type NodeProblemDetector interface {
+	// Name returns the name of the detector.
+	Name() string
+	// ConditionType returns the type of the Node condition the result of the detector is reported with.
+	ConditionType() corev1.NodeConditionType
+	// Detect returns the problem of the node, or nil if there is none.
+	Detect(ctx context.Context) (*NodeProblem, error)
+}
+
+type nodeProblemChecker struct {
+	client      client.Client
+	clock       clock.Clock
+	dbus        dbus.DBus
+	recorder    record.EventRecorder
+	fs          afero.Afero
+	detector    NodeProblemDetector
+	remediation *nodeagentconfigv1alpha1.RemediationConfig
+
+	firstDetection *time.Time
+}
+
+// NewNodeProblemChecker creates a health checker which reports the result of the given detector via a Node condition
+// and remediates the detected problem according to the given remediation configuration (optional).
+func NewNodeProblemChecker(
+	client client.Client,
+	clock clock.Clock,
+	dbus dbus.DBus,
+	recorder record.EventRecorder,
+	fs afero.Afero,
+	detector NodeProblemDetector,
+	remediation *nodeagentconfigv1alpha1.RemediationConfig,
+) HealthChecker {
+	return &nodeProblemChecker{
+		client:      client,
+		clock:       clock,
+		dbus:        dbus,
+		recorder:    recorder,
+		fs:          fs,
+		detector:    detector,
+		remediation: remediation,
+	}
+}
+
+// NewNodeProblemCheckers returns the health checkers for the node problem checks enabled in the given configuration.
+func NewNodeProblemCheckers(c client.Client, clock clock.Clock, dbus dbus.DBus, recorder record.EventRecorder, fs afero.Afero, config *nodeagentconfigv1alpha1.HealthCheckControllerConfig) []HealthChecker {
+	if config == nil {
+		return nil
+	}
+
+	var healthCheckers []HealthChecker
+
+	if cfg := config.DataVolumePressure; cfg != nil {
+		healthCheckers = append(healthCheckers, NewNodeProblemChecker(c, clock, dbus, recorder, fs, NewDataVolumePressureDetector(*cfg, StatFS), cfg.Remediation))
+	}
+	if cfg := config.FailedSystemdUnits; cfg != nil {
+		healthCheckers = append(healthCheckers, NewNodeProblemChecker(c, clock, dbus, recorder, fs, NewFailedSystemdUnitsDetector(*cfg, dbus), cfg.Remediation))
+	}
+	if cfg := config.ClockSkew; cfg != nil {
+		healthCheckers = append(healthCheckers, NewNodeProblemChecker(c, clock, dbus, recorder, fs, NewClockSkewDetector(*cfg, QueryNTPOffset), cfg.Remediation))
+	}
+	if cfg := config.KernelProblems; cfg != nil {
+		healthCheckers = append(healthCheckers, NewNodeProblemChecker(c, clock, dbus, recorder, fs, NewKernelProblemsDetector(*cfg, clock, ReadKernelMessages), cfg.Remediation))
+	}
+	if cfg := config.KubeletClientCertificateExpiry; cfg != nil {
+		healthCheckers = append(healthCheckers, NewNodeProblemChecker(c, clock, dbus, recorder, fs, NewCertificateExpiryDetector(*cfg, clock, fs), cfg.Remediation))
+	}
+
+	return healthCheckers
+}
+
+// Name returns the name of this health check.
+func (n *nodeProblemChecker) Name() string {
+	return n.detector.Name()
+}
+
+// Check detects the problem of the node, reports it via the Node condition and remediates it if configured.
+func (n *nodeProblemChecker) Check(ctx context.Context, node *corev1.Node) error {
+	log := logf.FromContext(ctx).WithName(n.Name())
+
+	problem, err := n.detector.Detect(ctx)
+	if err != nil {
+		log.Error(err, "Failed detecting node problem")
+		return n.patchCondition(ctx, node, corev1.ConditionUnknown, conditionReasonCheckFailed, err.Error())
+	}
+
+	if problem == nil {
+		if n.firstDetection != nil {
+			log.Info("Node problem is resolved")
+			n.recorder.Eventf(node, corev1.EventTypeNormal, n.Name(), "Node problem %s is resolved", n.detector.ConditionType())
+			n.firstDetection = nil
+		}
+		return n.patchCondition(ctx, node, corev1.ConditionFalse, conditionReasonNoProblem, fmt.Sprintf("Check %s did not detect a problem.", n.Name()))
+	}
+
+	if n.firstDetection == nil {
+		now := n.clock.Now()
+		n.firstDetection = &now
+
+		log.Info("Detected node problem", "reason", problem.Reason, "message", problem.Message)
+		n.recorder.Eventf(node, corev1.EventTypeWarning, n.Name(), "Detected node problem %s: %s", n.detector.ConditionType(), problem.Message)
+	}
+
+	if err := n.patchCondition(ctx, node, corev1.ConditionTrue, problem.Reason, problem.Message); err != nil {
+		return err
+	}
+
+	if n.remediation == nil || n.clock.Since(*n.firstDetection) < maxFailureDuration {
+		return nil
+	}
+
+	return n.remediate(ctx, node, problem)
+}
+
+func (n *nodeProblemChecker) remediate(ctx context.Context, node *corev1.Node, problem *NodeProblem) error {
+	log := logf.FromContext(ctx).WithName(n.Name())
+
+	lastRemediation, err := n.lastRemediation()
+	if err != nil {
+		return err
+	}
+	if minInterval := n.remediation.MinInterval; lastRemediation != nil && minInterval != nil && n.clock.Since(*lastRemediation) < minInterval.Duration {
+		log.V(1).Info("Skipping remediation of node problem because the last remediation was too recent", "lastRemediation", lastRemediation, "minInterval", minInterval.Duration)
+		return nil
+	}
+
+	// The time of the remediation is persisted before it is performed so that a reboot does not lead to a reboot loop.
+	if err := n.recordRemediation(); err != nil {
+		return err
+	}
+
+	switch n.remediation.Action {
+	case nodeagentconfigv1alpha1.RemediationActionRestartUnits:
+		units := n.remediation.Units
+		if len(units) == 0 {
+			units = problem.Units
+		}
+
+		log.Info("Remediating node problem by restarting units", "units", units)
+		n.recorder.Eventf(node, corev1.EventTypeWarning, n.Name(), "Node problem %s persists for more than %s, restarting units %s", n.detector.ConditionType(), maxFailureDuration, strings.Join(units, ", "))
+
+		var errs []error
+		for _, unit := range units {
+			if err := n.dbus.Restart(ctx, n.recorder, node, unit); err != nil {
+				errs = append(errs, fmt.Errorf("failed restarting unit %s: %w", unit, err))
+			}
+		}
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+
+	case nodeagentconfigv1alpha1.RemediationActionReboot:
+		log.Info("Remediating node problem by rebooting the node")
+		n.recorder.Eventf(node, corev1.EventTypeWarning, n.Name(), "Node problem %s persists for more than %s, rebooting the node now", n.detector.ConditionType(), maxFailureDuration)
+
+		if err := n.dbus.Reboot(); err != nil {
+			return fmt.Errorf("rebooting the node failed: %w", err)
+		}
+	}
+
+	n.firstDetection = nil
+	return nil
+}
+
+func (n *nodeProblemChecker) remediationFilePath() string {
+	return filepath.Join(remediationDir, n.Name()+"-last-remediation")
+}
+
+func (n *nodeProblemChecker) lastRemediation() (*time.Time, error) {
+	content, err := n.fs.ReadFile(n.remediationFilePath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading time of last remediation: %w", err)
+	}
+
+	lastRemediation, err := time.Parse(time.RFC3339, strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing time of last remediation: %w", err)
+	}
+	return &lastRemediation, nil
+}
+
+func (n *nodeProblemChecker) recordRemediation() error {
+	if err := n.fs.MkdirAll(remediationDir, os.ModeDir); err != nil {
+		return fmt.Errorf("unable to create directory %q: %w", remediationDir, err)
+	}
+
+	if err := n.fs.WriteFile(n.remediationFilePath(), []byte(n.clock.Now().UTC().Format(time.RFC3339)), 0600); err != nil {
+		return fmt.Errorf("failed persisting time of remediation: %w", err)
+	}
+	return nil
+}
+
+// patchCondition updates the Node condition of the detector if its status, reason or message changed. Heartbeats are
+// not updated to avoid load on the API server.
+func (n *nodeProblemChecker) patchCondition(ctx context.Context, node *corev1.Node, status corev1.ConditionStatus, reason, message string) error {
+	conditionType := n.detector.ConditionType()
+
+	i := slices.IndexFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool { return c.Type == conditionType })
+	if i != -1 {
+		condition := node.Status.Conditions[i]
+		if condition.Status == status && condition.Reason == reason && condition.Message == message {
+			return nil
+		}
+	}
+
+	var (
+		patch     = client.StrategicMergeFrom(node.DeepCopy())
+		now       = metav1.NewTime(n.clock.Now())
+		condition = corev1.NodeCondition{
+			Type:               conditionType,
+			Status:             status,
+			LastHeartbeatTime:  now,
+			LastTransitionTime: now,
+			Reason:             reason,
+			Message:            message,
+		}
+	)
+
+	if i == -1 {
+		node.Status.Conditions = append(node.Status.Conditions, condition)
+	} else {
+		if node.Status.Conditions[i].Status == status {
+			condition.LastTransitionTime = node.Status.Conditions[i].LastTransitionTime
+		}
+		node.Status.Conditions[i] = condition
+	}
+
+	if err := n.client.Status().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed patching %s condition of node: %w", conditionType, err)
+	}
+	return nil
+}
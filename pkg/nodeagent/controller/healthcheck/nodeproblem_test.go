@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+type fakeDetector struct {
+	problem *NodeProblem
+	err     error
+}
+
+func (*fakeDetector) Name() string { return "fake" }
+
+func (*fakeDetector) ConditionType() corev1.NodeConditionType { return "FakeProblem" }
+
+func (f *fakeDetector) Detect(_ context.Context) (*NodeProblem, error) { return f.problem, f.err }
+
+var _ = Describe("NodeProblemChecker", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		fakeDBus   *fakedbus.DBus
+		fakeFS     afero.Afero
+		recorder   *record.FakeRecorder
+
+		node        *corev1.Node
+		detector    *fakeDetector
+		remediation *nodeagentconfigv1alpha1.RemediationConfig
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithStatusSubresource(&corev1.Node{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		fakeDBus = fakedbus.New()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		recorder = record.NewFakeRecorder(100)
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+		detector = &fakeDetector{}
+		remediation = nil
+	})
+
+	check := func() error {
+		GinkgoHelper()
+
+		n := &corev1.Node{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), n)).To(Succeed())
+		return NewNodeProblemChecker(fakeClient, fakeClock, fakeDBus, recorder, fakeFS, detector, remediation).Check(ctx, n)
+	}
+
+	condition := func() *corev1.NodeCondition {
+		GinkgoHelper()
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		for _, c := range node.Status.Conditions {
+			if c.Type == "FakeProblem" {
+				return &c
+			}
+		}
+		return nil
+	}
+
+	It("should report that there is no problem", func() {
+		Expect(check()).To(Succeed())
+
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(corev1.ConditionFalse),
+			"Reason": Equal("NoProblem"),
+		})))
+		Expect(fakeDBus.Actions).To(BeEmpty())
+	})
+
+	It("should report that the check failed", func() {
+		detector.err = errors.New("fake")
+
+		Expect(check()).To(Succeed())
+
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(corev1.ConditionUnknown),
+			"Reason":  Equal("CheckFailed"),
+			"Message": Equal("fake"),
+		})))
+	})
+
+	It("should report the problem and not update the condition if nothing changed", func() {
+		detector.problem = &NodeProblem{Reason: "Foo", Message: "foo"}
+
+		Expect(check()).To(Succeed())
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(corev1.ConditionTrue),
+			"Reason":  Equal("Foo"),
+			"Message": Equal("foo"),
+		})))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Detected node problem FakeProblem: foo")))
+
+		resourceVersion := node.ResourceVersion
+		fakeClock.Step(time.Minute)
+		Expect(check()).To(Succeed())
+		Expect(condition().LastHeartbeatTime.Time).To(BeTemporally("==", fakeClock.Now().Add(-time.Minute)))
+		Expect(node.ResourceVersion).To(Equal(resourceVersion))
+	})
+
+	It("should not remediate the problem if no remediation is configured", func() {
+		detector.problem = &NodeProblem{Reason: "Foo", Message: "foo", Units: []string{"foo.service"}}
+		checker := NewNodeProblemChecker(fakeClient, fakeClock, fakeDBus, recorder, fakeFS, detector, nil)
+
+		Expect(checker.Check(ctx, node)).To(Succeed())
+		fakeClock.Step(2 * time.Minute)
+		Expect(checker.Check(ctx, node)).To(Succeed())
+
+		Expect(fakeDBus.Actions).To(BeEmpty())
+	})
+
+	Context("remediation", func() {
+		var checker HealthChecker
+
+		BeforeEach(func() {
+			detector.problem = &NodeProblem{Reason: "Foo", Message: "foo", Units: []string{"foo.service"}}
+			remediation = &nodeagentconfigv1alpha1.RemediationConfig{
+				Action:      nodeagentconfigv1alpha1.RemediationActionRestartUnits,
+				MinInterval: &metav1.Duration{Duration: time.Hour},
+			}
+		})
+
+		JustBeforeEach(func() {
+			checker = NewNodeProblemChecker(fakeClient, fakeClock, fakeDBus, recorder, fakeFS, detector, remediation)
+		})
+
+		It("should restart the affected units if the problem persists", func() {
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(fakeDBus.Actions).To(BeEmpty())
+
+			fakeClock.Step(time.Minute)
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"foo.service"}}))
+
+			content, err := fakeFS.ReadFile("/var/lib/gardener-node-agent/health-check/fake-last-remediation")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("2024-01-01T00:01:00Z"))
+		})
+
+		It("should restart the configured units", func() {
+			remediation.Units = []string{"bar.service", "baz.service"}
+			checker = NewNodeProblemChecker(fakeClient, fakeClock, fakeDBus, recorder, fakeFS, detector, remediation)
+
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			fakeClock.Step(time.Minute)
+			Expect(checker.Check(ctx, node)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(ConsistOf(
+				fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"bar.service"}},
+				fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"baz.service"}},
+			))
+		})
+
+		It("should reboot the node", func() {
+			remediation.Action = nodeagentconfigv1alpha1.RemediationActionReboot
+			checker = NewNodeProblemChecker(fakeClient, fakeClock, fakeDBus, recorder, fakeFS, detector, remediation)
+
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			fakeClock.Step(time.Minute)
+			Expect(checker.Check(ctx, node)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionReboot, UnitNames: []string{"reboot"}}))
+		})
+
+		It("should rate limit the remediations even across restarts", func() {
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			fakeClock.Step(time.Minute)
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(fakeDBus.Actions).To(HaveLen(1))
+
+			By("Simulate restart of gardener-node-agent")
+			checker = NewNodeProblemChecker(fakeClient, fakeClock, fakeDBus, recorder, fakeFS, detector, remediation)
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			fakeClock.Step(30 * time.Minute)
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(fakeDBus.Actions).To(HaveLen(1))
+
+			fakeClock.Step(30 * time.Minute)
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(fakeDBus.Actions).To(HaveLen(2))
+		})
+
+		It("should not remediate the problem if it is resolved in the meantime", func() {
+			Expect(checker.Check(ctx, node)).To(Succeed())
+
+			detector.problem = nil
+			fakeClock.Step(time.Minute)
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(recorder.Events).To(Receive(ContainSubstring("Detected node problem FakeProblem")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Node problem FakeProblem is resolved")))
+
+			detector.problem = &NodeProblem{Reason: "Foo", Message: "foo"}
+			fakeClock.Step(time.Second)
+			Expect(checker.Check(ctx, node)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+	})
+
+	Describe("#NewNodeProblemCheckers", func() {
+		It("should not return any checker if no configuration is provided", func() {
+			Expect(NewNodeProblemCheckers(fakeClient, fakeClock, fakeDBus, recorder, fakeFS, nil)).To(BeEmpty())
+		})
+
+		It("should return the checkers for all enabled checks", func() {
+			checkers := NewNodeProblemCheckers(fakeClient, fakeClock, fakeDBus, recorder, fakeFS, &nodeagentconfigv1alpha1.HealthCheckControllerConfig{
+				DataVolumePressure: &nodeagentconfigv1alpha1.DataVolumePressureCheckConfig{},
+				KernelProblems:     &nodeagentconfigv1alpha1.KernelProblemsCheckConfig{},
+			})
+
+			var names []string
+			for _, checker := range checkers {
+				names = append(names, checker.Name())
+			}
+			Expect(names).To(ConsistOf("data-volume-pressure", "kernel-problems"))
+		})
+	})
+})
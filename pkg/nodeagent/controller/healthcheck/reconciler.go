@@ -8,23 +8,29 @@ import (
 	"context"
 	"time"
 
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-// Reconciler checks for containerd and kubelet health and restarts them if required.
+// Reconciler checks for containerd and kubelet health and restarts them if required. Additionally, it performs the
+// configured node problem checks.
 type Reconciler struct {
 	Client                     client.Client
+	Config                     *nodeagentconfigv1alpha1.HealthCheckControllerConfig
 	Recorder                   record.EventRecorder
 	DBus                       dbus.DBus
+	FS                         afero.Afero
 	HealthCheckers             []HealthChecker
 	HealthCheckIntervalSeconds int32
+	WrapReconciler             func(reconcile.Reconciler) reconcile.Reconciler
 }
 
 // Reconcile executes all defined health checks.
@@ -8,6 +8,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/gardener/pkg/controllerutils"
 )
@@ -17,10 +18,15 @@ const ControllerName = "hostname-check"
 
 // AddToManager adds Reconciler to the given manager.
 func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	var reconciler reconcile.Reconciler = r
+	if r.WrapReconciler != nil {
+		reconciler = r.WrapReconciler(r)
+	}
+
 	return builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
 		WatchesRawSource(controllerutils.EnqueueOnce).
-		Complete(r)
+		Complete(reconciler)
 }
@@ -20,8 +20,9 @@ import (
 // the hostname of the node has changed. Calling the cancel func leads to terminating (and eventually restarting) the
 // gardener-node-agent such that it can fetch the hostname again during start-up.
 type Reconciler struct {
-	CancelContext context.CancelFunc
-	HostName      string
+	CancelContext  context.CancelFunc
+	HostName       string
+	WrapReconciler func(reconcile.Reconciler) reconcile.Reconciler
 }
 
 // Reconcile checks periodically whether the hostname changed. If yes, it calls the cancel func.
@@ -26,6 +26,7 @@ type Reconciler struct {
 	LeaseDurationSeconds int32
 	Namespace            string
 	Clock                clock.Clock
+	WrapReconciler       func(reconcile.Reconciler) reconcile.Reconciler
 }
 
 // Reconcile renews the heartbeat lease resource.
@@ -26,9 +26,10 @@ const annotationRestartSystemdServices = "worker.gardener.cloud/restart-systemd-
 
 // Reconciler checks for node annotation changes and restarts the specified systemd services.
 type Reconciler struct {
-	Client   client.Client
-	Recorder record.EventRecorder
-	DBus     dbus.DBus
+	Client         client.Client
+	Recorder       record.EventRecorder
+	DBus           dbus.DBus
+	WrapReconciler func(reconcile.Reconciler) reconcile.Reconciler
 }
 
 // Reconcile checks for node annotation changes and restarts the specified systemd services.
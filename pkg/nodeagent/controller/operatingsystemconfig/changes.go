@@ -128,6 +128,18 @@ func computeOperatingSystemConfigChanges(log logr.Logger, fs afero.Afero, newOSC
 		return nil, fmt.Errorf("unable to decode the old OSC read from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
 	}
 
+	return computeOperatingSystemConfigChangesBetween(fs, oldOSC, newOSC, newOSCChecksum)
+}
+
+// computeOperatingSystemConfigChangesBetween computes and persists the changes required to transition the node from the
+// old to the new operating system config.
+func computeOperatingSystemConfigChangesBetween(fs afero.Afero, oldOSC, newOSC *extensionsv1alpha1.OperatingSystemConfig, newOSCChecksum string) (*operatingSystemConfigChanges, error) {
+	changes := &operatingSystemConfigChanges{
+		fs:                            fs,
+		OperatingSystemConfigChecksum: newOSCChecksum,
+	}
+
+	newOSCFiles := collectAllFiles(newOSC)
 	oldOSCFiles := collectAllFiles(oldOSC)
 	// File changes have to be computed in one step for all files,
 	// because moving a file from osc.unit.files to osc.files or vice versa should not result in a change and a delete event.
@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// DryRunResult describes what the next reconciliation of the operating system config would change on the node.
+type DryRunResult struct {
+	// AppliedChecksum is the checksum of the operating system config which was last applied successfully. It is empty
+	// if the node is not registered yet.
+	AppliedChecksum string `json:"appliedChecksum,omitempty"`
+	// DesiredChecksum is the checksum of the operating system config in the secret.
+	DesiredChecksum string `json:"desiredChecksum"`
+	// RolledBack is true if the desired operating system config was rolled back because it could not be applied or the
+	// node was unhealthy after applying it. It is not applied again until it changes.
+	RolledBack bool `json:"rolledBack,omitempty"`
+	// InPlaceUpdateRequired is true if the Kubernetes version or the operating system version change, i.e., the node is
+	// drained and the operating system is updated before the changes are applied.
+	InPlaceUpdateRequired bool `json:"inPlaceUpdateRequired,omitempty"`
+	// Changes are the changes which are applied to the node for the desired operating system config. If it is already
+	// applied, they contain the remaining work of a previous reconciliation which was interrupted.
+	Changes Changes `json:"changes"`
+	// DriftedPaths are the paths of files and units which deviate from the desired operating system config if it is
+	// already applied.
+	DriftedPaths []string `json:"driftedPaths,omitempty"`
+}
+
+// Changes describes the files and units which are changed on the node.
+type Changes struct {
+	// ChangedFiles are the paths of the files which are written.
+	ChangedFiles []string `json:"changedFiles,omitempty"`
+	// DeletedFiles are the paths of the files which are removed.
+	DeletedFiles []string `json:"deletedFiles,omitempty"`
+	// ChangedUnits are the units which are written and enabled or disabled.
+	ChangedUnits []UnitChange `json:"changedUnits,omitempty"`
+	// DeletedUnits are the names of the units which are stopped and removed.
+	DeletedUnits []string `json:"deletedUnits,omitempty"`
+	// UnitCommands are the commands which are executed for the units after the systemd daemon was reloaded.
+	UnitCommands []UnitCommand `json:"unitCommands,omitempty"`
+	// ContainerdConfigFileChanged is true if the containerd configuration file changes, i.e., containerd is restarted.
+	ContainerdConfigFileChanged bool `json:"containerdConfigFileChanged,omitempty"`
+	// DesiredContainerdRegistries are the upstreams of the registries which are configured for containerd.
+	DesiredContainerdRegistries []string `json:"desiredContainerdRegistries,omitempty"`
+	// DeletedContainerdRegistries are the upstreams of the registries whose configuration is removed from containerd.
+	DeletedContainerdRegistries []string `json:"deletedContainerdRegistries,omitempty"`
+	// MustRestartNodeAgent is true if gardener-node-agent restarts itself after applying the changes.
+	MustRestartNodeAgent bool `json:"mustRestartNodeAgent,omitempty"`
+}
+
+// UnitChange describes a unit which is written to the node.
+type UnitChange struct {
+	// Name is the name of the unit.
+	Name string `json:"name"`
+	// ChangedDropIns are the names of the drop-ins of the unit which are written.
+	ChangedDropIns []string `json:"changedDropIns,omitempty"`
+	// DeletedDropIns are the names of the drop-ins of the unit which are removed.
+	DeletedDropIns []string `json:"deletedDropIns,omitempty"`
+}
+
+// UnitCommand describes a command which is executed for a unit.
+type UnitCommand struct {
+	// Name is the name of the unit.
+	Name string `json:"name"`
+	// Command is the command which is executed, i.e., `restart` or `stop`.
+	Command extensionsv1alpha1.UnitCommand `json:"command"`
+}
+
+// UnitState describes the state of a unit of the applied operating system config.
+type UnitState struct {
+	// Name is the name of the unit.
+	Name string `json:"name"`
+	// ActiveState is the active state of the unit, e.g. "active", "activating" or "failed".
+	ActiveState string `json:"activeState"`
+}
+
+// DryRun computes the changes which the next reconciliation would apply to the node for the operating system config in
+// the secret. Nothing is changed on the node or in the cluster.
+func (r *Reconciler) DryRun(ctx context.Context) (*DryRunResult, error) {
+	// The computation logs its progress as if the changes were applied, which would be misleading for a dry run.
+	log := logr.Discard()
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: r.Config.SecretName, Namespace: metav1.NamespaceSystem}, secret); err != nil {
+		return nil, fmt.Errorf("failed reading secret with operating system config: %w", err)
+	}
+
+	osc, oscChecksum, err := extractOSCFromSecret(secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed extracting OSC from secret: %w", err)
+	}
+
+	result := &DryRunResult{DesiredChecksum: oscChecksum}
+
+	// The node is looked up by the host name since the node name is only set by the reconciler itself.
+	node, err := nodeagent.FetchNodeByHostName(ctx, r.Client, r.HostName)
+	if err != nil {
+		return nil, err
+	}
+	if node != nil {
+		result.AppliedChecksum = node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]
+	}
+
+	if result.RolledBack, err = r.wasRolledBack(oscChecksum); err != nil {
+		return nil, err
+	}
+
+	// The reconciliation adds the drop-in for the containerd unit to the operating system config before computing the
+	// changes.
+	if extensionsv1alpha1helper.HasContainerdConfiguration(osc.Spec.CRIConfig) {
+		addContainerdEnvironmentDropIn(osc)
+	}
+
+	// Computing the changes persists them on the disk, hence all writes go to an in-memory layer on top of the file
+	// system which is discarded afterwards.
+	fs := afero.Afero{Fs: afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(r.FS.Fs), afero.NewMemMapFs())}
+	if err := fs.MkdirAll(nodeagentconfigv1alpha1.BaseDir, defaultDirPermissions); err != nil {
+		return nil, fmt.Errorf("failed creating directory %s in memory: %w", nodeagentconfigv1alpha1.BaseDir, err)
+	}
+
+	oscChanges, err := computeOperatingSystemConfigChanges(log, fs, osc, oscChecksum)
+	if err != nil {
+		return nil, fmt.Errorf("failed calculating the OSC changes: %w", err)
+	}
+	result.Changes = oscChanges.summarize()
+
+	if r.inPlaceUpdatesEnabled() && node != nil && result.AppliedChecksum != oscChecksum {
+		lastAppliedOSC, err := readLastAppliedOperatingSystemConfig(r.FS)
+		if err != nil {
+			return nil, err
+		}
+		result.InPlaceUpdateRequired = inPlaceUpdateRequired(osc, lastAppliedOSC)
+	}
+
+	if result.AppliedChecksum == oscChecksum {
+		d, err := (&Reconciler{FS: fs}).detectDrift(log, osc)
+		if err != nil {
+			return nil, fmt.Errorf("failed detecting drift: %w", err)
+		}
+		result.DriftedPaths = d.paths
+	}
+
+	return result, nil
+}
+
+func (o *operatingSystemConfigChanges) summarize() Changes {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	var changes Changes
+
+	for _, file := range o.Files.Changed {
+		changes.ChangedFiles = append(changes.ChangedFiles, file.Path)
+	}
+	for _, file := range o.Files.Deleted {
+		changes.DeletedFiles = append(changes.DeletedFiles, file.Path)
+	}
+
+	for _, unit := range o.Units.Changed {
+		unitChange := UnitChange{Name: unit.Name}
+		for _, dropIn := range unit.DropInsChanges.Changed {
+			unitChange.ChangedDropIns = append(unitChange.ChangedDropIns, dropIn.Name)
+		}
+		for _, dropIn := range unit.DropInsChanges.Deleted {
+			unitChange.DeletedDropIns = append(unitChange.DeletedDropIns, dropIn.Name)
+		}
+		changes.ChangedUnits = append(changes.ChangedUnits, unitChange)
+	}
+	for _, unit := range o.Units.Deleted {
+		changes.DeletedUnits = append(changes.DeletedUnits, unit.Name)
+	}
+
+	changes.MustRestartNodeAgent = o.MustRestartNodeAgent
+	for _, command := range o.Units.Commands {
+		changes.UnitCommands = append(changes.UnitCommands, UnitCommand{Name: command.Name, Command: command.Command})
+		if command.Name == nodeagentconfigv1alpha1.UnitName {
+			changes.MustRestartNodeAgent = true
+		}
+	}
+
+	changes.ContainerdConfigFileChanged = o.Containerd.ConfigFileChanged
+	for _, registry := range o.Containerd.Registries.Desired {
+		changes.DesiredContainerdRegistries = append(changes.DesiredContainerdRegistries, registry.Upstream)
+	}
+	for _, registry := range o.Containerd.Registries.Deleted {
+		changes.DeletedContainerdRegistries = append(changes.DeletedContainerdRegistries, registry.Upstream)
+	}
+
+	return changes
+}
+
+// UnitStates returns the active states of the units of the last applied operating system config.
+func (r *Reconciler) UnitStates(ctx context.Context) ([]UnitState, error) {
+	lastAppliedOSC, err := readLastAppliedOperatingSystemConfig(r.FS)
+	if err != nil {
+		return nil, err
+	}
+	if lastAppliedOSC == nil {
+		return nil, nil
+	}
+
+	var states []UnitState
+	for _, unit := range mergeUnits(lastAppliedOSC.Spec.Units, lastAppliedOSC.Status.ExtensionUnits) {
+		activeState, err := r.DBus.ActiveState(ctx, unit.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed getting active state of unit %q: %w", unit.Name, err)
+		}
+		states = append(states, UnitState{Name: unit.Name, ActiveState: activeState})
+	}
+
+	return states, nil
+}
+
+// TriggerReconciliation enqueues the secret with the operating system config for an immediate reconciliation, i.e.,
+// without the jitter delay which is applied for changes of the secret.
+func (r *Reconciler) TriggerReconciliation() error {
+	if r.reconciliationTrigger == nil {
+		return fmt.Errorf("controller is not added to the manager")
+	}
+
+	select {
+	case r.reconciliationTrigger <- event.GenericEvent{Object: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: r.Config.SecretName, Namespace: metav1.NamespaceSystem}}}:
+	default:
+		// A reconciliation was already triggered but not yet picked up by the controller.
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+var _ = Describe("Debug", func() {
+	const (
+		hostName = "host"
+		nodeName = "node"
+
+		containerdConfigFile = "/etc/containerd/config.toml"
+		fooFilePath          = "/etc/foo"
+		changesFilePath      = "/var/lib/gardener-node-agent/last-computed-osc-changes.yaml"
+	)
+
+	var (
+		ctx = logf.IntoContext(context.Background(), logr.Discard())
+
+		fakeClient client.Client
+		fakeDBus   *fakedbus.DBus
+		fakeFS     afero.Afero
+		reconciler *Reconciler
+
+		node   *corev1.Node
+		secret *corev1.Secret
+		osc    *extensionsv1alpha1.OperatingSystemConfig
+	)
+
+	updateSecret := func(checksum string) {
+		GinkgoHelper()
+
+		ser := json.NewSerializerWithOptions(json.DefaultMetaFactory, kubernetes.SeedScheme, kubernetes.SeedScheme, json.SerializerOptions{Yaml: true})
+		oscRaw, err := runtime.Encode(ser, osc)
+		Expect(err).NotTo(HaveOccurred())
+
+		metav1.SetMetaDataAnnotation(&secret.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig, checksum)
+		secret.Data = map[string][]byte{nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig: oscRaw}
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+	}
+
+	applyOperatingSystemConfig := func() {
+		GinkgoHelper()
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(secret)})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithStatusSubresource(&corev1.Node{}).Build()
+		fakeDBus = fakedbus.New()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig{
+				SyncPeriod:        &metav1.Duration{Duration: time.Minute},
+				SecretName:        "osc-secret",
+				KubernetesVersion: semver.MustParse("1.31.1"),
+			},
+			Recorder: record.NewFakeRecorder(10),
+			DBus:     fakeDBus,
+			FS:       fakeFS,
+			HostName: hostName,
+			NodeName: nodeName,
+		}
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				CRIConfig: &extensionsv1alpha1.CRIConfig{
+					Name:       extensionsv1alpha1.CRINameContainerD,
+					Containerd: &extensionsv1alpha1.ContainerdConfig{SandboxImage: "pause"},
+				},
+				Files: []extensionsv1alpha1.File{{
+					Path:        fooFilePath,
+					Permissions: ptr.To[uint32](0644),
+					Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "foo"}},
+				}},
+				Units: []extensionsv1alpha1.Unit{
+					{
+						Name:      "foo.service",
+						Content:   ptr.To("[Unit]\nDescription=foo"),
+						FilePaths: []string{fooFilePath},
+					},
+					{
+						Name:    "bar.service",
+						Content: ptr.To("[Unit]\nDescription=bar"),
+						DropIns: []extensionsv1alpha1.DropIn{{Name: "10-bar.conf", Content: "[Service]\nRestart=always"}},
+					},
+				},
+			},
+		}
+
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "osc-secret", Namespace: "kube-system"}}
+		Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+		updateSecret("checksum-1")
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName, Labels: map[string]string{corev1.LabelHostname: hostName}}}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+		Expect(fakeFS.WriteFile(containerdConfigFile, []byte("version = 2\n"), 0644)).To(Succeed())
+	})
+
+	Describe("#DryRun", func() {
+		It("should compute the changes without changing the node", func() {
+			result, err := reconciler.DryRun(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.AppliedChecksum).To(BeEmpty())
+			Expect(result.DesiredChecksum).To(Equal("checksum-1"))
+			Expect(result.Changes).To(Equal(Changes{
+				ChangedFiles: []string{fooFilePath},
+				ChangedUnits: []UnitChange{
+					{Name: "foo.service"},
+					{Name: "bar.service", ChangedDropIns: []string{"10-bar.conf"}},
+					{Name: "containerd.service", ChangedDropIns: []string{"30-env_config.conf"}},
+				},
+				UnitCommands: []UnitCommand{
+					{Name: "foo.service", Command: "restart"},
+					{Name: "bar.service", Command: "restart"},
+					{Name: "containerd.service", Command: "restart"},
+				},
+				ContainerdConfigFileChanged: true,
+			}))
+			Expect(result.DriftedPaths).To(BeEmpty())
+
+			Expect(fakeFS.Exists(changesFilePath)).To(BeFalse())
+			Expect(fakeFS.Exists(fooFilePath)).To(BeFalse())
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		It("should report that the operating system config is applied", func() {
+			applyOperatingSystemConfig()
+
+			result, err := reconciler.DryRun(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.AppliedChecksum).To(Equal("checksum-1"))
+			Expect(result.DesiredChecksum).To(Equal("checksum-1"))
+			Expect(result.Changes).To(BeZero())
+			Expect(result.DriftedPaths).To(BeEmpty())
+		})
+
+		It("should report drifted files if the operating system config is applied", func() {
+			applyOperatingSystemConfig()
+			Expect(fakeFS.WriteFile(fooFilePath, []byte("changed"), 0644)).To(Succeed())
+
+			result, err := reconciler.DryRun(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.DriftedPaths).To(ConsistOf(fooFilePath))
+		})
+
+		It("should compute the changes for a changed operating system config", func() {
+			applyOperatingSystemConfig()
+			changes, err := fakeFS.ReadFile(changesFilePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			osc.Spec.Units = osc.Spec.Units[:1]
+			updateSecret("checksum-2")
+
+			result, err := reconciler.DryRun(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.AppliedChecksum).To(Equal("checksum-1"))
+			Expect(result.DesiredChecksum).To(Equal("checksum-2"))
+			Expect(result.Changes).To(Equal(Changes{DeletedUnits: []string{"bar.service"}}))
+
+			Expect(fakeFS.ReadFile(changesFilePath)).To(Equal(changes))
+		})
+	})
+
+	Describe("#UnitStates", func() {
+		It("should return nothing if no operating system config was applied yet", func() {
+			Expect(reconciler.UnitStates(ctx)).To(BeEmpty())
+		})
+
+		It("should return the states of the units of the applied operating system config", func() {
+			applyOperatingSystemConfig()
+			fakeDBus.ActiveStates = map[string]string{"bar.service": "failed"}
+
+			Expect(reconciler.UnitStates(ctx)).To(ConsistOf(
+				UnitState{Name: "foo.service", ActiveState: "active"},
+				UnitState{Name: "bar.service", ActiveState: "failed"},
+				UnitState{Name: "containerd.service", ActiveState: "active"},
+			))
+		})
+	})
+
+	Describe("#TriggerReconciliation", func() {
+		It("should fail if the controller was not added to a manager", func() {
+			Expect(reconciler.TriggerReconciliation()).To(MatchError("controller is not added to the manager"))
+		})
+	})
+})
@@ -0,0 +1,409 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	lastAppliedChecksumsFilePath        = nodeagentconfigv1alpha1.BaseDir + "/last-applied-checksums.yaml"
+	lastAppliedContainerdConfigFilePath = nodeagentconfigv1alpha1.BaseDir + "/last-applied-containerd-config.toml"
+
+	conditionReasonNoDrift         = "NoDrift"
+	conditionReasonDriftDetected   = "DriftDetected"
+	conditionReasonDriftRemediated = "DriftRemediated"
+
+	eventReasonDriftDetected   = "OSCDriftDetected"
+	eventReasonDriftRemediated = "OSCDriftRemediated"
+)
+
+// filesRemovedAfterBootstrap contains the paths of files which are part of the operating system config but removed by
+// gardener-node-agent once the node has joined the cluster. They are not subject to drift detection.
+var filesRemovedAfterBootstrap = sets.New(kubelet.PathKubeconfigBootstrap, nodeagentconfigv1alpha1.BootstrapTokenFilePath)
+
+// appliedChecksums contains the checksums of the files on the node whose desired content is not part of the operating
+// system config, i.e., files extracted from images and the containerd configuration file.
+type appliedChecksums map[string]string
+
+// drift describes the files and units on the node which deviate from the last applied operating system config.
+type drift struct {
+	files            []extensionsv1alpha1.File
+	units            []changedUnit
+	containerdConfig bool
+	paths            []string
+}
+
+func (d *drift) empty() bool {
+	return len(d.paths) == 0
+}
+
+// unitCommands returns the commands for the drifted units and for the units referring to drifted files via their
+// `FilePaths`.
+func (d *drift) unitCommands(osc *extensionsv1alpha1.OperatingSystemConfig) []unitCommand {
+	driftedFiles := sets.New[string]()
+	for _, file := range d.files {
+		driftedFiles.Insert(file.Path)
+	}
+
+	var commands []unitCommand
+	for _, unit := range mergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits) {
+		if slices.ContainsFunc(d.units, func(u changedUnit) bool { return u.Name == unit.Name }) ||
+			slices.ContainsFunc(unit.FilePaths, driftedFiles.Has) {
+			commands = append(commands, unitCommand{
+				Name:    unit.Name,
+				Command: getCommandToExecute(unit),
+			})
+		}
+	}
+
+	return commands
+}
+
+func (r *Reconciler) driftDetectionEnabled() bool {
+	return r.Config.DriftDetection != nil && ptr.Deref(r.Config.DriftDetection.Enabled, false)
+}
+
+func (r *Reconciler) driftRemediationEnabled() bool {
+	return r.Config.DriftDetection != nil && ptr.Deref(r.Config.DriftDetection.Remediate, false)
+}
+
+// reconcileDrift compares the files and units on the node with the given (already applied) operating system config. The
+// result is reported as condition and event on the node. If enabled, drifted files and units are restored and the
+// units depending on them are restarted.
+func (r *Reconciler) reconcileDrift(ctx context.Context, log logr.Logger, node *corev1.Node, osc *extensionsv1alpha1.OperatingSystemConfig, changes *operatingSystemConfigChanges) error {
+	d, err := r.detectDrift(log, osc)
+	if err != nil {
+		return fmt.Errorf("failed detecting drift: %w", err)
+	}
+
+	if d.empty() {
+		log.V(1).Info("Files and units on this node match the applied operating system config")
+		return r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrift, corev1.ConditionFalse, conditionReasonNoDrift, "Files and units on the node match the applied operating system config.")
+	}
+
+	message := fmt.Sprintf("Files and units on the node deviate from the applied operating system config: %s", strings.Join(d.paths, ", "))
+	log.Info("Detected drift from the applied operating system config", "paths", d.paths)
+
+	if !r.driftRemediationEnabled() {
+		r.Recorder.Event(node, corev1.EventTypeWarning, eventReasonDriftDetected, message)
+		return r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrift, corev1.ConditionTrue, conditionReasonDriftDetected, message)
+	}
+
+	log.Info("Remediating drift from the applied operating system config")
+	if err := r.remediateDrift(ctx, log, node, osc, changes, d); err != nil {
+		message = fmt.Sprintf("%s. Remediation failed: %v", message, err)
+		r.Recorder.Event(node, corev1.EventTypeWarning, eventReasonDriftDetected, message)
+		return errors.Join(err, r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrift, corev1.ConditionTrue, conditionReasonDriftDetected, message))
+	}
+
+	message = fmt.Sprintf("Restored files and units which deviated from the applied operating system config: %s", strings.Join(d.paths, ", "))
+	r.Recorder.Event(node, corev1.EventTypeNormal, eventReasonDriftRemediated, message)
+	if err := r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrift, corev1.ConditionFalse, conditionReasonDriftRemediated, message); err != nil {
+		return err
+	}
+
+	if changes.MustRestartNodeAgent {
+		log.Info("Must restart myself (gardener-node-agent unit), canceling the context to initiate graceful shutdown")
+		if err := changes.setMustRestartNodeAgent(false); err != nil {
+			return err
+		}
+		r.CancelContext()
+	}
+
+	return nil
+}
+
+func (r *Reconciler) detectDrift(log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) (*drift, error) {
+	checksums, err := r.loadAppliedChecksums()
+	if err != nil {
+		if !errors.Is(err, afero.ErrFileNotFound) {
+			return nil, err
+		}
+
+		log.Info("No checksums of applied files found, recording the current state", "path", lastAppliedChecksumsFilePath)
+		if err := r.recordAppliedChecksums(osc); err != nil {
+			return nil, err
+		}
+		if checksums, err = r.loadAppliedChecksums(); err != nil {
+			return nil, err
+		}
+	}
+
+	d := &drift{}
+
+	for _, file := range collectAllFiles(osc) {
+		if filesRemovedAfterBootstrap.Has(file.Path) {
+			continue
+		}
+
+		var expectedChecksum string
+		switch {
+		case file.Content.Inline != nil:
+			data, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode data of file %q: %w", file.Path, err)
+			}
+			expectedChecksum = utils.ComputeSHA256Hex(data)
+		case file.Content.ImageRef != nil:
+			checksum, ok := checksums[file.Path]
+			if !ok {
+				continue
+			}
+			expectedChecksum = checksum
+		default:
+			continue
+		}
+
+		drifted, err := r.fileDrifted(file.Path, expectedChecksum, ptr.To(getFilePermissions(file)))
+		if err != nil {
+			return nil, err
+		}
+		if drifted {
+			d.files = append(d.files, file)
+			d.paths = append(d.paths, file.Path)
+		}
+	}
+
+	for _, unit := range mergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits) {
+		var (
+			unitFilePath   = path.Join(etcSystemdSystem, unit.Name)
+			unitDrifted    bool
+			driftedDropIns []extensionsv1alpha1.DropIn
+		)
+
+		if unit.Content != nil {
+			drifted, err := r.fileDrifted(unitFilePath, utils.ComputeSHA256Hex([]byte(*unit.Content)), ptr.To(defaultFilePermissions))
+			if err != nil {
+				return nil, err
+			}
+			if drifted {
+				unitDrifted = true
+				d.paths = append(d.paths, unitFilePath)
+			}
+		}
+
+		for _, dropIn := range unit.DropIns {
+			dropInFilePath := path.Join(unitFilePath+".d", dropIn.Name)
+
+			drifted, err := r.fileDrifted(dropInFilePath, utils.ComputeSHA256Hex([]byte(dropIn.Content)), ptr.To(defaultFilePermissions))
+			if err != nil {
+				return nil, err
+			}
+			if drifted {
+				driftedDropIns = append(driftedDropIns, dropIn)
+				d.paths = append(d.paths, dropInFilePath)
+			}
+		}
+
+		if unitDrifted || len(driftedDropIns) > 0 {
+			d.units = append(d.units, changedUnit{
+				Unit:           unit,
+				DropInsChanges: dropIns{Changed: driftedDropIns},
+			})
+		}
+	}
+
+	if checksum, ok := checksums[configFile]; ok && extensionsv1alpha1helper.HasContainerdConfiguration(osc.Spec.CRIConfig) {
+		drifted, err := r.fileDrifted(configFile, checksum, nil)
+		if err != nil {
+			return nil, err
+		}
+		if drifted {
+			d.containerdConfig = true
+			d.paths = append(d.paths, configFile)
+		}
+	}
+
+	return d, nil
+}
+
+// fileDrifted returns true if the file at the given path does not exist, or if the checksum of its content or its
+// permissions (if given) differ from the expected ones.
+func (r *Reconciler) fileDrifted(filePath, expectedChecksum string, expectedPermissions *os.FileMode) (bool, error) {
+	info, err := r.FS.Stat(filePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return true, nil
+		}
+		return false, fmt.Errorf("unable to stat file %q: %w", filePath, err)
+	}
+
+	if expectedPermissions != nil && info.Mode().Perm() != *expectedPermissions {
+		return true, nil
+	}
+
+	data, err := r.FS.ReadFile(filePath)
+	if err != nil {
+		return false, fmt.Errorf("unable to read file %q: %w", filePath, err)
+	}
+
+	return utils.ComputeSHA256Hex(data) != expectedChecksum, nil
+}
+
+func (r *Reconciler) remediateDrift(ctx context.Context, log logr.Logger, node *corev1.Node, osc *extensionsv1alpha1.OperatingSystemConfig, changes *operatingSystemConfigChanges, d *drift) error {
+	changes.lock.Lock()
+	changes.Files.Changed = d.files
+	changes.Units.Changed = d.units
+	changes.Units.Commands = d.unitCommands(osc)
+	changes.Containerd.ConfigFileChanged = d.containerdConfig
+	err := changes.persist()
+	changes.lock.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed persisting changes for remediating drift: %w", err)
+	}
+
+	if d.containerdConfig {
+		data, err := r.FS.ReadFile(lastAppliedContainerdConfigFilePath)
+		if err != nil {
+			return fmt.Errorf("unable to read last applied containerd config.toml from %q: %w", lastAppliedContainerdConfigFilePath, err)
+		}
+		if err := r.FS.WriteFile(configFile, data, 0644); err != nil {
+			return fmt.Errorf("unable to restore containerd config.toml: %w", err)
+		}
+		if err := r.FS.Chmod(configFile, 0644); err != nil {
+			return fmt.Errorf("unable to ensure permissions for containerd config.toml: %w", err)
+		}
+		log.Info("Successfully restored containerd config.toml", "path", configFile)
+	}
+
+	if err := r.applyChangedInlineFiles(log, changes); err != nil {
+		return fmt.Errorf("failed restoring inline files: %w", err)
+	}
+
+	if err := r.applyChangedImageRefFiles(ctx, log, changes); err != nil {
+		return fmt.Errorf("failed restoring imageRef files: %w", err)
+	}
+
+	if err := r.applyChangedUnits(ctx, log, changes); err != nil {
+		return fmt.Errorf("failed restoring units: %w", err)
+	}
+
+	if err := r.DBus.DaemonReload(ctx); err != nil {
+		return fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+
+	log.Info("Executing unit commands for units affected by drift", "unitCommands", len(changes.Units.Commands))
+	if err := r.executeUnitCommands(ctx, log, node, changes); err != nil {
+		return fmt.Errorf("failed executing unit commands: %w", err)
+	}
+
+	return r.recordAppliedChecksums(osc)
+}
+
+func (r *Reconciler) patchNodeCondition(ctx context.Context, node *corev1.Node, conditionType corev1.NodeConditionType, status corev1.ConditionStatus, reason, message string) error {
+	i := slices.IndexFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool { return c.Type == conditionType })
+	if i != -1 {
+		condition := node.Status.Conditions[i]
+		if condition.Status == status && condition.Reason == reason && condition.Message == message {
+			return nil
+		}
+	}
+
+	var (
+		patch     = client.StrategicMergeFrom(node.DeepCopy())
+		now       = metav1.Now()
+		condition = corev1.NodeCondition{
+			Type:               conditionType,
+			Status:             status,
+			LastHeartbeatTime:  now,
+			LastTransitionTime: now,
+			Reason:             reason,
+			Message:            message,
+		}
+	)
+
+	if i == -1 {
+		node.Status.Conditions = append(node.Status.Conditions, condition)
+	} else {
+		if node.Status.Conditions[i].Status == status {
+			condition.LastTransitionTime = node.Status.Conditions[i].LastTransitionTime
+		}
+		node.Status.Conditions[i] = condition
+	}
+
+	if err := r.Client.Status().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed patching %s condition of node: %w", condition.Type, err)
+	}
+	return nil
+}
+
+// recordAppliedChecksums persists the checksums of the files on the node whose desired content is not part of the
+// operating system config. A copy of the containerd configuration file is kept so that it can be restored in case of
+// drift.
+func (r *Reconciler) recordAppliedChecksums(osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	checksums := appliedChecksums{}
+
+	for _, file := range collectAllFiles(osc) {
+		if file.Content.ImageRef == nil {
+			continue
+		}
+
+		data, err := r.FS.ReadFile(file.Path)
+		if err != nil {
+			if errors.Is(err, afero.ErrFileNotFound) {
+				continue
+			}
+			return fmt.Errorf("unable to read file %q: %w", file.Path, err)
+		}
+		checksums[file.Path] = utils.ComputeSHA256Hex(data)
+	}
+
+	if extensionsv1alpha1helper.HasContainerdConfiguration(osc.Spec.CRIConfig) {
+		data, err := r.FS.ReadFile(configFile)
+		if err != nil {
+			return fmt.Errorf("unable to read containerd config.toml: %w", err)
+		}
+		checksums[configFile] = utils.ComputeSHA256Hex(data)
+
+		if err := r.FS.WriteFile(lastAppliedContainerdConfigFilePath, data, 0600); err != nil {
+			return fmt.Errorf("unable to write copy of containerd config.toml to %q: %w", lastAppliedContainerdConfigFilePath, err)
+		}
+	}
+
+	out, err := yaml.Marshal(checksums)
+	if err != nil {
+		return fmt.Errorf("failed marshalling the checksums into YAML: %w", err)
+	}
+
+	if err := r.FS.WriteFile(lastAppliedChecksumsFilePath, out, 0600); err != nil {
+		return fmt.Errorf("unable to write checksums of applied files to %q: %w", lastAppliedChecksumsFilePath, err)
+	}
+	return nil
+}
+
+func (r *Reconciler) loadAppliedChecksums() (appliedChecksums, error) {
+	data, err := r.FS.ReadFile(lastAppliedChecksumsFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read checksums of applied files from %q: %w", lastAppliedChecksumsFilePath, err)
+	}
+
+	checksums := appliedChecksums{}
+	if err := yaml.Unmarshal(data, &checksums); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling the checksums of applied files: %w", err)
+	}
+	return checksums, nil
+}
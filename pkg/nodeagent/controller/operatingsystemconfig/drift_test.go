@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+var _ = Describe("Drift", func() {
+	const (
+		nodeName    = "node"
+		oscChecksum = "checksum"
+
+		containerdConfigFile = "/etc/containerd/config.toml"
+		fooFilePath          = "/etc/foo"
+		barDropInFilePath    = "/etc/systemd/system/bar.service.d/10-bar.conf"
+	)
+
+	var (
+		ctx = logf.IntoContext(context.Background(), logr.Discard())
+
+		fakeClient client.Client
+		fakeDBus   *fakedbus.DBus
+		fakeFS     afero.Afero
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		node   *corev1.Node
+		secret *corev1.Secret
+		osc    *extensionsv1alpha1.OperatingSystemConfig
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithStatusSubresource(&corev1.Node{}).Build()
+		fakeDBus = fakedbus.New()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig{
+				SyncPeriod:        &metav1.Duration{Duration: time.Minute},
+				SecretName:        "osc-secret",
+				KubernetesVersion: semver.MustParse("1.31.1"),
+				DriftDetection: &nodeagentconfigv1alpha1.DriftDetectionConfig{
+					Enabled:   ptr.To(true),
+					Remediate: ptr.To(false),
+				},
+			},
+			Recorder: recorder,
+			DBus:     fakeDBus,
+			FS:       fakeFS,
+			NodeName: nodeName,
+		}
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				CRIConfig: &extensionsv1alpha1.CRIConfig{
+					Name:       extensionsv1alpha1.CRINameContainerD,
+					Containerd: &extensionsv1alpha1.ContainerdConfig{SandboxImage: "pause"},
+				},
+				Files: []extensionsv1alpha1.File{{
+					Path:        fooFilePath,
+					Permissions: ptr.To[uint32](0644),
+					Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "foo"}},
+				}},
+				Units: []extensionsv1alpha1.Unit{
+					{
+						Name:      "foo.service",
+						Content:   ptr.To("[Unit]\nDescription=foo"),
+						FilePaths: []string{fooFilePath},
+					},
+					{
+						Name:    "bar.service",
+						Content: ptr.To("[Unit]\nDescription=bar"),
+						DropIns: []extensionsv1alpha1.DropIn{{Name: "10-bar.conf", Content: "[Service]\nRestart=always"}},
+					},
+				},
+			},
+		}
+
+		ser := json.NewSerializerWithOptions(json.DefaultMetaFactory, kubernetes.SeedScheme, kubernetes.SeedScheme, json.SerializerOptions{Yaml: true})
+		oscRaw, err := runtime.Encode(ser, osc)
+		Expect(err).NotTo(HaveOccurred())
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "osc-secret",
+				Namespace:   "kube-system",
+				Annotations: map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig: oscChecksum},
+			},
+			Data: map[string][]byte{nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig: oscRaw},
+		}
+		Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+		Expect(fakeFS.WriteFile(containerdConfigFile, []byte("version = 2\n"), 0644)).To(Succeed())
+
+		By("Apply operating system config")
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(secret)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Annotations).To(HaveKeyWithValue(nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, oscChecksum))
+		Eventually(recorder.Events).Should(Receive(ContainSubstring("OSCApplied")))
+
+		fakeDBus.Actions = nil
+	})
+
+	reconcileSecret := func() {
+		GinkgoHelper()
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(secret)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+	}
+
+	driftCondition := func() corev1.NodeCondition {
+		GinkgoHelper()
+
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrift {
+				return condition
+			}
+		}
+
+		Fail("drift condition not found")
+		return corev1.NodeCondition{}
+	}
+
+	It("should report that there is no drift", func() {
+		reconcileSecret()
+
+		Expect(driftCondition().Status).To(Equal(corev1.ConditionFalse))
+		Expect(driftCondition().Reason).To(Equal("NoDrift"))
+		Expect(fakeDBus.Actions).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should not patch the condition if it did not change", func() {
+		reconcileSecret()
+		resourceVersion := node.ResourceVersion
+
+		reconcileSecret()
+		Expect(node.ResourceVersion).To(Equal(resourceVersion))
+		Expect(driftCondition().Reason).To(Equal("NoDrift"))
+	})
+
+	It("should not check for drift if it is disabled", func() {
+		reconciler.Config.DriftDetection.Enabled = ptr.To(false)
+		Expect(fakeFS.WriteFile(fooFilePath, []byte("changed"), 0644)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(secret)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Status.Conditions).To(BeEmpty())
+	})
+
+	It("should report drifted files and units without remediating them", func() {
+		Expect(fakeFS.WriteFile(fooFilePath, []byte("changed"), 0644)).To(Succeed())
+		Expect(fakeFS.Chmod(barDropInFilePath, 0777)).To(Succeed())
+		Expect(fakeFS.WriteFile(containerdConfigFile, []byte("version = 2\n\n[debug]\n  level = \"debug\"\n"), 0644)).To(Succeed())
+
+		reconcileSecret()
+
+		Expect(driftCondition().Status).To(Equal(corev1.ConditionTrue))
+		Expect(driftCondition().Reason).To(Equal("DriftDetected"))
+		Expect(driftCondition().Message).To(And(
+			ContainSubstring(fooFilePath),
+			ContainSubstring(barDropInFilePath),
+			ContainSubstring(containerdConfigFile),
+		))
+		Eventually(recorder.Events).Should(Receive(ContainSubstring("Warning OSCDriftDetected")))
+
+		content, err := fakeFS.ReadFile(fooFilePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("changed"))
+		Expect(fakeDBus.Actions).To(BeEmpty())
+	})
+
+	It("should restore drifted files and units and restart the dependent units", func() {
+		reconciler.Config.DriftDetection.Remediate = ptr.To(true)
+
+		Expect(fakeFS.WriteFile(fooFilePath, []byte("changed"), 0644)).To(Succeed())
+		Expect(fakeFS.Remove(barDropInFilePath)).To(Succeed())
+		Expect(fakeFS.WriteFile(containerdConfigFile, []byte("version = 2\n\n[debug]\n  level = \"debug\"\n"), 0644)).To(Succeed())
+
+		reconcileSecret()
+
+		Expect(driftCondition().Status).To(Equal(corev1.ConditionFalse))
+		Expect(driftCondition().Reason).To(Equal("DriftRemediated"))
+		Eventually(recorder.Events).Should(Receive(ContainSubstring("Normal OSCDriftRemediated")))
+
+		content, err := fakeFS.ReadFile(fooFilePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("foo"))
+
+		content, err = fakeFS.ReadFile(barDropInFilePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("[Service]\nRestart=always"))
+
+		content, err = fakeFS.ReadFile(containerdConfigFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).NotTo(ContainSubstring("debug"))
+
+		Expect(fakeDBus.Actions).To(ConsistOf(
+			fakedbus.SystemdAction{Action: fakedbus.ActionEnable, UnitNames: []string{"bar.service"}},
+			fakedbus.SystemdAction{Action: fakedbus.ActionDaemonReload},
+			fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"foo.service"}},
+			fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"bar.service"}},
+			fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"containerd.service"}},
+		))
+
+		By("Reconcile again to verify that the drift is gone")
+		fakeDBus.Actions = nil
+		reconcileSecret()
+
+		Expect(driftCondition().Status).To(Equal(corev1.ConditionFalse))
+		Expect(driftCondition().Reason).To(Equal("NoDrift"))
+		Expect(fakeDBus.Actions).To(BeEmpty())
+	})
+})
@@ -0,0 +1,375 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const (
+	lastUpdatedOperatingSystemVersionFilePath = nodeagentconfigv1alpha1.BaseDir + "/last-updated-os-version"
+
+	conditionReasonWaitingForUpdateSlot = "WaitingForUpdateSlot"
+	conditionReasonDraining             = "Draining"
+	conditionReasonUpdating             = "Updating"
+	conditionReasonUpdateSucceeded      = "UpdateSucceeded"
+	conditionReasonUpdateFailed         = "UpdateFailed"
+
+	eventReasonInPlaceUpdateDrainTimeout = "InPlaceUpdateDrainTimeout"
+	eventReasonInPlaceUpdateFailed       = "InPlaceUpdateFailed"
+	eventReasonInPlaceUpdateSucceeded    = "InPlaceUpdateSucceeded"
+
+	// inPlaceUpdateRequeueInterval is the interval in which the controller checks whether an update slot became free
+	// or whether the node has been drained.
+	inPlaceUpdateRequeueInterval = 10 * time.Second
+	// osUpdateTimeout is the maximum duration of the operating system update command.
+	osUpdateTimeout = 30 * time.Minute
+	// inPlaceUpdateLeaseGracePeriod is added to the drain timeout for computing the duration of the update slot lease.
+	// The lease is renewed while the node waits for being drained, hence the grace period must cover the operating
+	// system update and the application of the operating system config.
+	inPlaceUpdateLeaseGracePeriod = osUpdateTimeout + 15*time.Minute
+)
+
+func (r *Reconciler) inPlaceUpdatesEnabled() bool {
+	return r.Config.InPlaceUpdates != nil
+}
+
+// inPlaceUpdateRequired returns true if the Kubernetes version or the operating system version of the given operating
+// system config differ from the last applied one.
+func inPlaceUpdateRequired(osc, lastAppliedOSC *extensionsv1alpha1.OperatingSystemConfig) bool {
+	if osc.Spec.InPlaceUpdates == nil || lastAppliedOSC == nil || lastAppliedOSC.Spec.InPlaceUpdates == nil {
+		return false
+	}
+	return *osc.Spec.InPlaceUpdates != *lastAppliedOSC.Spec.InPlaceUpdates
+}
+
+// prepareInPlaceUpdate acquires an update slot of the worker pool, cordons and drains the node, and updates the
+// operating system if necessary. It returns a non-zero duration if the preparation is not yet completed and the
+// reconciliation must be requeued.
+func (r *Reconciler) prepareInPlaceUpdate(ctx context.Context, log logr.Logger, node *corev1.Node, osc, lastAppliedOSC *extensionsv1alpha1.OperatingSystemConfig) (time.Duration, error) {
+	log = log.WithValues("kubeletVersion", osc.Spec.InPlaceUpdates.KubeletVersion, "operatingSystemVersion", osc.Spec.InPlaceUpdates.OperatingSystemVersion)
+
+	acquired, err := r.acquireInPlaceUpdateSlot(ctx, node)
+	if err != nil {
+		return 0, fmt.Errorf("failed acquiring in-place update slot: %w", err)
+	}
+	if !acquired {
+		log.Info("All in-place update slots of the worker pool are taken, waiting", "maxUnavailable", r.Config.InPlaceUpdates.MaxUnavailable)
+		return inPlaceUpdateRequeueInterval, r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeInPlaceUpdate, corev1.ConditionTrue, conditionReasonWaitingForUpdateSlot,
+			fmt.Sprintf("Waiting for one of the %d in-place update slots of the worker pool.", r.Config.InPlaceUpdates.MaxUnavailable))
+	}
+
+	started, err := r.cordonNode(ctx, log, node)
+	if err != nil {
+		return 0, fmt.Errorf("failed cordoning node: %w", err)
+	}
+
+	drained, remainingPods, err := r.drainNode(ctx, log, node)
+	if err != nil {
+		return 0, fmt.Errorf("failed draining node: %w", err)
+	}
+	if !drained {
+		if drainTimeout := r.Config.InPlaceUpdates.DrainTimeout.Duration; time.Since(started) < drainTimeout {
+			log.Info("Waiting for pods to be evicted from node", "remainingPods", len(remainingPods))
+			return inPlaceUpdateRequeueInterval, r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeInPlaceUpdate, corev1.ConditionTrue, conditionReasonDraining,
+				fmt.Sprintf("Waiting for %d pod(s) to be evicted from the node: %s", len(remainingPods), strings.Join(remainingPods, ", ")))
+		}
+
+		log.Info("Drain timeout exceeded, updating node nevertheless", "remainingPods", len(remainingPods))
+		r.Recorder.Eventf(node, corev1.EventTypeWarning, eventReasonInPlaceUpdateDrainTimeout, "Drain timeout exceeded, updating node in-place nevertheless, remaining pods: %s", strings.Join(remainingPods, ", "))
+	}
+
+	if err := r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeInPlaceUpdate, corev1.ConditionTrue, conditionReasonUpdating,
+		fmt.Sprintf("Updating node in-place to Kubernetes version %s and operating system version %s.", osc.Spec.InPlaceUpdates.KubeletVersion, osc.Spec.InPlaceUpdates.OperatingSystemVersion)); err != nil {
+		return 0, err
+	}
+
+	if osc.Spec.InPlaceUpdates.OperatingSystemVersion != lastAppliedOSC.Spec.InPlaceUpdates.OperatingSystemVersion {
+		if err := r.updateOperatingSystem(ctx, log, node, osc); err != nil {
+			return 0, err
+		}
+	}
+
+	return 0, nil
+}
+
+func inPlaceUpdateLeaseName(workerPoolName string, slot int) string {
+	return fmt.Sprintf("%s%s-%d", nodeagentconfigv1alpha1.InPlaceUpdateLeaseNamePrefix, workerPoolName, slot)
+}
+
+// acquireInPlaceUpdateSlot acquires (or renews) one of the 'maxUnavailable' leases of the worker pool of the node. A
+// lease is free if it has no holder or if it was not renewed in time.
+func (r *Reconciler) acquireInPlaceUpdateSlot(ctx context.Context, node *corev1.Node) (bool, error) {
+	workerPoolName := node.Labels[v1beta1constants.LabelWorkerPool]
+	if workerPoolName == "" {
+		return false, fmt.Errorf("node does not have the %s label", v1beta1constants.LabelWorkerPool)
+	}
+
+	var (
+		now           = metav1.NowMicro()
+		leaseDuration = r.Config.InPlaceUpdates.DrainTimeout.Duration + inPlaceUpdateLeaseGracePeriod
+		free          []*coordinationv1.Lease
+	)
+
+	for slot := range int(r.Config.InPlaceUpdates.MaxUnavailable) {
+		lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: inPlaceUpdateLeaseName(workerPoolName, slot), Namespace: metav1.NamespaceSystem}}
+		if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(lease), lease); err != nil && !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed reading lease %s: %w", client.ObjectKeyFromObject(lease), err)
+		}
+
+		holder := ptr.Deref(lease.Spec.HolderIdentity, "")
+		if holder == node.Name {
+			// This node already holds this slot, hence it only renews it.
+			free = []*coordinationv1.Lease{lease}
+			break
+		}
+		if holder == "" || leaseExpired(lease, now.Time) {
+			free = append(free, lease)
+		}
+	}
+
+	for _, lease := range free {
+		if ptr.Deref(lease.Spec.HolderIdentity, "") != node.Name {
+			lease.Spec.AcquireTime = &now
+		}
+		lease.Spec.HolderIdentity = &node.Name
+		lease.Spec.LeaseDurationSeconds = ptr.To(int32(leaseDuration.Seconds()))
+		lease.Spec.RenewTime = &now
+
+		var err error
+		if lease.ResourceVersion == "" {
+			err = r.Client.Create(ctx, lease)
+		} else {
+			err = r.Client.Update(ctx, lease)
+		}
+
+		if err != nil {
+			if apierrors.IsAlreadyExists(err) || apierrors.IsConflict(err) {
+				// Another node took this slot in the meantime, try the next one.
+				continue
+			}
+			return false, fmt.Errorf("failed acquiring lease %s: %w", client.ObjectKeyFromObject(lease), err)
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func leaseExpired(lease *coordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	return lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second).Before(now)
+}
+
+// releaseInPlaceUpdateSlots releases all update slots of the worker pool of the node held by this node.
+func (r *Reconciler) releaseInPlaceUpdateSlots(ctx context.Context, node *corev1.Node) error {
+	workerPoolName := node.Labels[v1beta1constants.LabelWorkerPool]
+	if workerPoolName == "" {
+		return nil
+	}
+
+	for slot := range int(r.Config.InPlaceUpdates.MaxUnavailable) {
+		lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: inPlaceUpdateLeaseName(workerPoolName, slot), Namespace: metav1.NamespaceSystem}}
+		if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(lease), lease); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed reading lease %s: %w", client.ObjectKeyFromObject(lease), err)
+		}
+
+		if ptr.Deref(lease.Spec.HolderIdentity, "") != node.Name {
+			continue
+		}
+
+		lease.Spec.HolderIdentity = nil
+		lease.Spec.AcquireTime = nil
+		lease.Spec.RenewTime = nil
+		if err := r.Client.Update(ctx, lease); err != nil {
+			return fmt.Errorf("failed releasing lease %s: %w", client.ObjectKeyFromObject(lease), err)
+		}
+	}
+
+	return nil
+}
+
+// cordonNode marks the node as unschedulable and records the start time of the in-place update. It returns the time
+// when the in-place update was started.
+func (r *Reconciler) cordonNode(ctx context.Context, log logr.Logger, node *corev1.Node) (time.Time, error) {
+	if value, ok := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyInPlaceUpdateStarted]; ok {
+		started, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed parsing value of annotation %s: %w", nodeagentconfigv1alpha1.AnnotationKeyInPlaceUpdateStarted, err)
+		}
+		return started, nil
+	}
+
+	started := time.Now().UTC()
+
+	patch := client.MergeFrom(node.DeepCopy())
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyInPlaceUpdateStarted, started.Format(time.RFC3339))
+	// Nodes which were cordoned by somebody else must stay unschedulable after the in-place update.
+	if !node.Spec.Unschedulable {
+		log.Info("Cordoning node for in-place update")
+		node.Spec.Unschedulable = true
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyCordonedForInPlaceUpdate, "true")
+	}
+
+	return started, r.Client.Patch(ctx, node, patch)
+}
+
+// drainNode evicts all pods from the node which are not managed by a DaemonSet and which are no static pods. Evictions
+// which are rejected because of PodDisruptionBudgets are retried in the next reconciliation. It returns true if no such
+// pods are left on the node.
+func (r *Reconciler) drainNode(ctx context.Context, log logr.Logger, node *corev1.Node) (bool, []string, error) {
+	podList := &corev1.PodList{}
+	if err := r.APIReader.List(ctx, podList, client.MatchingFields{"spec.nodeName": node.Name}); err != nil {
+		return false, nil, fmt.Errorf("failed listing pods on node: %w", err)
+	}
+
+	var remainingPods []string
+	for _, pod := range podList.Items {
+		if !mustBeEvicted(pod) {
+			continue
+		}
+
+		remainingPods = append(remainingPods, client.ObjectKeyFromObject(&pod).String())
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		if err := r.Client.SubResource("eviction").Create(ctx, &pod, &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}}); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			if apierrors.IsTooManyRequests(err) {
+				log.V(1).Info("Eviction of pod was rejected, retrying later", "pod", client.ObjectKeyFromObject(&pod), "reason", err.Error())
+				continue
+			}
+			return false, nil, fmt.Errorf("failed evicting pod %s: %w", client.ObjectKeyFromObject(&pod), err)
+		}
+		log.Info("Evicted pod", "pod", client.ObjectKeyFromObject(&pod))
+	}
+
+	return len(remainingPods) == 0, remainingPods, nil
+}
+
+func mustBeEvicted(pod corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	if controller := metav1.GetControllerOf(&pod); controller != nil && controller.Kind == "DaemonSet" {
+		return false
+	}
+	return true
+}
+
+// updateOperatingSystem executes the command provided by the operating system extension for updating the operating
+// system to the desired version. The command is only executed once per version.
+func (r *Reconciler) updateOperatingSystem(ctx context.Context, log logr.Logger, node *corev1.Node, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	version := osc.Spec.InPlaceUpdates.OperatingSystemVersion
+
+	lastUpdatedVersion, err := r.FS.ReadFile(lastUpdatedOperatingSystemVersionFilePath)
+	if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("unable to read last updated operating system version from file path %s: %w", lastUpdatedOperatingSystemVersionFilePath, err)
+	}
+	if strings.TrimSpace(string(lastUpdatedVersion)) == version {
+		log.Info("Operating system was already updated")
+		return nil
+	}
+
+	if osc.Status.InPlaceUpdates == nil || osc.Status.InPlaceUpdates.OSUpdate == nil {
+		return r.failInPlaceUpdate(ctx, node, fmt.Errorf("operating system config does not provide a command for updating the operating system to version %s", version))
+	}
+
+	command := osc.Status.InPlaceUpdates.OSUpdate
+	log.Info("Updating operating system", "command", command.Command, "args", command.Args)
+
+	updateCtx, cancel := context.WithTimeout(ctx, osUpdateTimeout)
+	defer cancel()
+
+	if output, err := Exec(updateCtx, command.Command, command.Args...); err != nil {
+		return r.failInPlaceUpdate(ctx, node, fmt.Errorf("failed updating operating system to version %s: %w, output: %s", version, err, string(output)))
+	}
+
+	if err := r.FS.WriteFile(lastUpdatedOperatingSystemVersionFilePath, []byte(version), 0600); err != nil {
+		return fmt.Errorf("unable to write last updated operating system version to file path %q: %w", lastUpdatedOperatingSystemVersionFilePath, err)
+	}
+
+	log.Info("Successfully updated operating system")
+	return nil
+}
+
+// failInPlaceUpdate reports the given error on the node and returns it. The node stays cordoned and keeps its update
+// slot, and the update is retried with the next reconciliation.
+func (r *Reconciler) failInPlaceUpdate(ctx context.Context, node *corev1.Node, cause error) error {
+	r.Recorder.Event(node, corev1.EventTypeWarning, eventReasonInPlaceUpdateFailed, cause.Error())
+	if err := r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeInPlaceUpdate, corev1.ConditionFalse, conditionReasonUpdateFailed, cause.Error()); err != nil {
+		return errors.Join(cause, err)
+	}
+	return cause
+}
+
+// finishInPlaceUpdate uncordons the node if it was cordoned for the in-place update, releases the update slot, and
+// reports the result of the update on the node. It is a no-op if no in-place update is in progress.
+func (r *Reconciler) finishInPlaceUpdate(ctx context.Context, log logr.Logger, node *corev1.Node, osc *extensionsv1alpha1.OperatingSystemConfig, cause error) error {
+	if node == nil || !r.inPlaceUpdatesEnabled() {
+		return nil
+	}
+	if _, ok := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyInPlaceUpdateStarted]; !ok {
+		return nil
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	if _, ok := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyCordonedForInPlaceUpdate]; ok {
+		log.Info("Uncordoning node after in-place update")
+		node.Spec.Unschedulable = false
+	}
+	delete(node.Annotations, nodeagentconfigv1alpha1.AnnotationKeyInPlaceUpdateStarted)
+	delete(node.Annotations, nodeagentconfigv1alpha1.AnnotationKeyCordonedForInPlaceUpdate)
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed uncordoning node: %w", err)
+	}
+
+	if err := r.releaseInPlaceUpdateSlots(ctx, node); err != nil {
+		return err
+	}
+
+	if cause != nil {
+		return r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeInPlaceUpdate, corev1.ConditionFalse, conditionReasonUpdateFailed,
+			fmt.Sprintf("In-place update failed: %v", cause))
+	}
+
+	message := "Node has been updated in-place."
+	if osc.Spec.InPlaceUpdates != nil {
+		message = fmt.Sprintf("Node has been updated in-place to Kubernetes version %s and operating system version %s.", osc.Spec.InPlaceUpdates.KubeletVersion, osc.Spec.InPlaceUpdates.OperatingSystemVersion)
+	}
+
+	log.Info("Successfully finished in-place update")
+	r.Recorder.Event(node, corev1.EventTypeNormal, eventReasonInPlaceUpdateSucceeded, message)
+	return r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeInPlaceUpdate, corev1.ConditionFalse, conditionReasonUpdateSucceeded, message)
+}
@@ -0,0 +1,287 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("In-place updates", func() {
+	const (
+		nodeName       = "node"
+		workerPoolName = "pool"
+		leaseName      = "gardener-node-agent-in-place-update-pool-0"
+	)
+
+	var (
+		ctx = logf.IntoContext(context.Background(), logr.Discard())
+
+		fakeClient client.Client
+		fakeFS     afero.Afero
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		node   *corev1.Node
+		secret *corev1.Secret
+		osc    *extensionsv1alpha1.OperatingSystemConfig
+
+		executedCommands [][]string
+	)
+
+	updateSecret := func(osc *extensionsv1alpha1.OperatingSystemConfig, checksum string) {
+		GinkgoHelper()
+
+		ser := json.NewSerializerWithOptions(json.DefaultMetaFactory, kubernetes.SeedScheme, kubernetes.SeedScheme, json.SerializerOptions{Yaml: true})
+		oscRaw, err := runtime.Encode(ser, osc)
+		Expect(err).NotTo(HaveOccurred())
+
+		metav1.SetMetaDataAnnotation(&secret.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig, checksum)
+		secret.Data = map[string][]byte{nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig: oscRaw}
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+	}
+
+	reconcileSecret := func() (reconcile.Result, error) {
+		GinkgoHelper()
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(secret)})
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		return result, err
+	}
+
+	inPlaceUpdateCondition := func() *corev1.NodeCondition {
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == nodeagentconfigv1alpha1.NodeConditionTypeInPlaceUpdate {
+				return &condition
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.ShootScheme).
+			WithStatusSubresource(&corev1.Node{}).
+			WithIndex(&corev1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			Build()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		recorder = record.NewFakeRecorder(10)
+
+		executedCommands = nil
+		DeferCleanup(test.WithVar(&Exec, func(_ context.Context, command string, args ...string) ([]byte, error) {
+			executedCommands = append(executedCommands, append([]string{command}, args...))
+			return nil, nil
+		}))
+
+		reconciler = &Reconciler{
+			Client:    fakeClient,
+			APIReader: fakeClient,
+			Config: nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig{
+				SyncPeriod:        &metav1.Duration{Duration: time.Minute},
+				SecretName:        "osc-secret",
+				KubernetesVersion: semver.MustParse("1.31.1"),
+				DriftDetection:    &nodeagentconfigv1alpha1.DriftDetectionConfig{Enabled: ptr.To(false)},
+				InPlaceUpdates: &nodeagentconfigv1alpha1.InPlaceUpdatesConfig{
+					MaxUnavailable: 1,
+					DrainTimeout:   &metav1.Duration{Duration: time.Hour},
+				},
+			},
+			Recorder: recorder,
+			DBus:     fakedbus.New(),
+			FS:       fakeFS,
+			NodeName: nodeName,
+		}
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Files: []extensionsv1alpha1.File{{
+					Path:    "/opt/bin/kubelet",
+					Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "v1.31.1"}},
+				}},
+				InPlaceUpdates: &extensionsv1alpha1.InPlaceUpdates{
+					OperatingSystemVersion: "1.0",
+					KubeletVersion:         "1.31.1",
+				},
+			},
+			Status: extensionsv1alpha1.OperatingSystemConfigStatus{
+				InPlaceUpdates: &extensionsv1alpha1.InPlaceUpdatesStatus{
+					OSUpdate: &extensionsv1alpha1.OSUpdate{Command: "/usr/bin/update-os", Args: []string{"--version"}},
+				},
+			},
+		}
+
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "osc-secret", Namespace: "kube-system"}}
+		Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+		updateSecret(osc, "checksum")
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName, Labels: map[string]string{"worker.gardener.cloud/pool": workerPoolName}}}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+		By("Apply initial operating system config")
+		Expect(reconcileSecret()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(inPlaceUpdateCondition()).To(BeNil())
+		Expect(executedCommands).To(BeEmpty())
+
+		By("Update Kubernetes and operating system version")
+		osc.Spec.Files[0].Content.Inline.Data = "v1.31.2"
+		osc.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{
+			OperatingSystemVersion: "1.1",
+			KubeletVersion:         "1.31.2",
+		}
+		osc.Status.InPlaceUpdates.OSUpdate.Args = []string{"--version", "1.1"}
+		updateSecret(osc, "new-checksum")
+	})
+
+	It("should wait until an update slot is free", func() {
+		lease := &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: leaseName, Namespace: "kube-system"},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To("other-node"),
+				LeaseDurationSeconds: ptr.To[int32](3600),
+				RenewTime:            &metav1.MicroTime{Time: time.Now()},
+			},
+		}
+		Expect(fakeClient.Create(ctx, lease)).To(Succeed())
+
+		Expect(reconcileSecret()).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+		Expect(node.Spec.Unschedulable).To(BeFalse())
+		Expect(node.Annotations).To(HaveKeyWithValue(nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, "checksum"))
+		Expect(inPlaceUpdateCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(corev1.ConditionTrue),
+			"Reason": Equal("WaitingForUpdateSlot"),
+		})))
+
+		By("Take over the slot after the lease of the other node expired")
+		lease.Spec.RenewTime = &metav1.MicroTime{Time: time.Now().Add(-2 * time.Hour)}
+		Expect(fakeClient.Update(ctx, lease)).To(Succeed())
+
+		Expect(reconcileSecret()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(node.Annotations).To(HaveKeyWithValue(nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, "new-checksum"))
+	})
+
+	It("should cordon and drain the node, update it, and uncordon it", func() {
+		pods := []*corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}, Spec: corev1.PodSpec{NodeName: nodeName}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "other-node", Namespace: "default"}, Spec: corev1.PodSpec{NodeName: "other"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "completed", Namespace: "default"}, Spec: corev1.PodSpec{NodeName: nodeName}, Status: corev1.PodStatus{Phase: corev1.PodSucceeded}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "static", Namespace: "kube-system", Annotations: map[string]string{"kubernetes.io/config.mirror": "foo"}}, Spec: corev1.PodSpec{NodeName: nodeName}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "daemon", Namespace: "kube-system", OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "daemon", UID: "1", Controller: ptr.To(true)}}}, Spec: corev1.PodSpec{NodeName: nodeName}},
+		}
+		for _, pod := range pods {
+			Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+		}
+
+		By("Cordon node and evict pods")
+		Expect(reconcileSecret()).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+		Expect(node.Spec.Unschedulable).To(BeTrue())
+		Expect(node.Annotations).To(HaveKey(nodeagentconfigv1alpha1.AnnotationKeyInPlaceUpdateStarted))
+		Expect(node.Annotations).To(HaveKeyWithValue(nodeagentconfigv1alpha1.AnnotationKeyCordonedForInPlaceUpdate, "true"))
+		Expect(inPlaceUpdateCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(corev1.ConditionTrue),
+			"Reason":  Equal("Draining"),
+			"Message": ContainSubstring("default/app"),
+		})))
+
+		lease := &coordinationv1.Lease{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: leaseName, Namespace: "kube-system"}, lease)).To(Succeed())
+		Expect(lease.Spec.HolderIdentity).To(PointTo(Equal(nodeName)))
+
+		podList := &corev1.PodList{}
+		Expect(fakeClient.List(ctx, podList)).To(Succeed())
+		Expect(podList.Items).To(HaveLen(4))
+
+		By("Update node after it was drained")
+		Expect(reconcileSecret()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(executedCommands).To(Equal([][]string{{"/usr/bin/update-os", "--version", "1.1"}}))
+
+		content, err := fakeFS.ReadFile("/opt/bin/kubelet")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("v1.31.2"))
+
+		Expect(node.Spec.Unschedulable).To(BeFalse())
+		Expect(node.Annotations).NotTo(HaveKey(nodeagentconfigv1alpha1.AnnotationKeyInPlaceUpdateStarted))
+		Expect(node.Annotations).NotTo(HaveKey(nodeagentconfigv1alpha1.AnnotationKeyCordonedForInPlaceUpdate))
+		Expect(node.Annotations).To(HaveKeyWithValue(nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, "new-checksum"))
+		Expect(inPlaceUpdateCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(corev1.ConditionFalse),
+			"Reason":  Equal("UpdateSucceeded"),
+			"Message": And(ContainSubstring("1.31.2"), ContainSubstring("1.1")),
+		})))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(lease), lease)).To(Succeed())
+		Expect(lease.Spec.HolderIdentity).To(BeNil())
+	})
+
+	It("should not update the operating system if only the Kubernetes version changed", func() {
+		osc.Spec.InPlaceUpdates.OperatingSystemVersion = "1.0"
+		updateSecret(osc, "new-checksum")
+
+		Expect(reconcileSecret()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(executedCommands).To(BeEmpty())
+		Expect(inPlaceUpdateCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{"Reason": Equal("UpdateSucceeded")})))
+	})
+
+	It("should keep nodes cordoned which were cordoned by somebody else", func() {
+		node.Spec.Unschedulable = true
+		Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+		Expect(reconcileSecret()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(node.Spec.Unschedulable).To(BeTrue())
+		Expect(inPlaceUpdateCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{"Reason": Equal("UpdateSucceeded")})))
+	})
+
+	It("should update the node if the drain timeout is exceeded", func() {
+		reconciler.Config.InPlaceUpdates.DrainTimeout = &metav1.Duration{Duration: time.Nanosecond}
+
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "terminating", Namespace: "default", Finalizers: []string{"foo"}}, Spec: corev1.PodSpec{NodeName: nodeName}}
+		Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, pod)).To(Succeed())
+
+		Expect(reconcileSecret()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Eventually(recorder.Events).Should(Receive(ContainSubstring("InPlaceUpdateDrainTimeout")))
+		Expect(node.Annotations).To(HaveKeyWithValue(nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, "new-checksum"))
+	})
+
+	It("should fail if no command for updating the operating system is provided", func() {
+		osc.Status.InPlaceUpdates = nil
+		updateSecret(osc, "new-checksum")
+
+		_, err := reconcileSecret()
+		Expect(err).To(MatchError(ContainSubstring("does not provide a command for updating the operating system")))
+		Expect(node.Spec.Unschedulable).To(BeTrue())
+		Expect(node.Annotations).To(HaveKeyWithValue(nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, "checksum"))
+		Expect(inPlaceUpdateCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(corev1.ConditionFalse),
+			"Reason": Equal("UpdateFailed"),
+		})))
+	})
+})
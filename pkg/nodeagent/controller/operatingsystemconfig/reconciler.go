@@ -29,6 +29,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -39,6 +40,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/nodeagent"
 	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 	filespkg "github.com/gardener/gardener/pkg/nodeagent/files"
 	"github.com/gardener/gardener/pkg/nodeagent/registry"
@@ -63,7 +65,10 @@ func init() {
 // Reconciler decodes the OperatingSystemConfig resources from secrets and applies the systemd units and files to the
 // node.
 type Reconciler struct {
-	Client        client.Client
+	Client client.Client
+	// APIReader is used for reading objects which are not cached by the manager, i.e., the update slot leases and the
+	// pods on the node during in-place updates.
+	APIReader     client.Reader
 	Config        nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig
 	Recorder      record.EventRecorder
 	DBus          dbus.DBus
@@ -72,6 +77,12 @@ type Reconciler struct {
 	CancelContext context.CancelFunc
 	HostName      string
 	NodeName      string
+	// HealthCheckers are used to verify the health of the node after applying a changed operating system config.
+	HealthCheckers []healthcheck.HealthChecker
+	// WrapReconciler wraps the reconciler when it is added to the manager, e.g., for recording its errors. Optional.
+	WrapReconciler func(reconcile.Reconciler) reconcile.Reconciler
+
+	reconciliationTrigger chan event.GenericEvent
 }
 
 // Reconcile decodes the OperatingSystemConfig resources from secrets and applies the systemd units and files to the
@@ -79,7 +90,15 @@ type Reconciler struct {
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(ctx)
 
-	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	reconciliationTimeout := controllerutils.DefaultReconciliationTimeout
+	if r.rollbackEnabled() {
+		reconciliationTimeout += r.Config.Rollback.HealthCheckTimeout.Duration
+	}
+	if r.inPlaceUpdatesEnabled() {
+		reconciliationTimeout += osUpdateTimeout
+	}
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, reconciliationTimeout)
 	defer cancel()
 
 	secret := &corev1.Secret{}
@@ -107,6 +126,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed extracting OSC from secret: %w", err)
 	}
 
+	if rolledBack, err := r.wasRolledBack(oscChecksum); err != nil {
+		return reconcile.Result{}, err
+	} else if rolledBack {
+		if err := r.resumeRollback(ctx, log, node, oscChecksum); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed resuming rollback of operating system config: %w", err)
+		}
+
+		log.Info("Operating system config was rolled back, waiting for a new one", "checksum", oscChecksum)
+		return reconcile.Result{}, r.finishInPlaceUpdate(ctx, log, node, osc, errors.New("operating system config was rolled back"))
+	}
+
 	log.Info("Applying containerd configuration")
 	if err := r.ReconcileContainerdConfig(ctx, log, osc); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed reconciling containerd configuration: %w", err)
@@ -118,61 +148,73 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if node != nil && node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == oscChecksum {
-		log.Info("Configuration on this node is up to date, nothing to be done")
-		return reconcile.Result{}, nil
-	}
+		if !r.driftDetectionEnabled() {
+			log.Info("Configuration on this node is up to date, nothing to be done")
+			return reconcile.Result{}, nil
+		}
 
-	log.Info("Applying new or changed inline files")
-	if err := r.applyChangedInlineFiles(log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed inline files: %w", err)
+		log.Info("Configuration on this node is up to date, checking for drift")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.reconcileDrift(ctx, log, node, osc, oscChanges)
 	}
 
-	log.Info("Applying containerd registries")
-	waitForRegistries, err := r.ReconcileContainerdRegistries(ctx, log, oscChanges)
-	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed reconciling containerd registries: %w", err)
-	}
+	if r.inPlaceUpdatesEnabled() && node != nil {
+		lastAppliedOSC, err := readLastAppliedOperatingSystemConfig(r.FS)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
 
-	log.Info("Applying new or changed imageRef files")
-	if err := r.applyChangedImageRefFiles(ctx, log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed imageRef files: %w", err)
+		if inPlaceUpdateRequired(osc, lastAppliedOSC) {
+			log.Info("Kubernetes version or operating system version changed, preparing in-place update")
+			if requeueAfter, err := r.prepareInPlaceUpdate(ctx, log, node, osc, lastAppliedOSC); err != nil || requeueAfter > 0 {
+				return reconcile.Result{RequeueAfter: requeueAfter}, err
+			}
+		}
 	}
 
-	log.Info("Applying new or changed units", "changedUnits", len(oscChanges.Units.Changed))
-	if err := r.applyChangedUnits(ctx, log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed units: %w", err)
-	}
+	var (
+		lastAppliedOSC *extensionsv1alpha1.OperatingSystemConfig
+		healthProbers  []healthcheck.HealthProber
+		unitsToVerify  []extensionsv1alpha1.Unit
+	)
 
-	log.Info("Removing no longer needed units", "deletedUnits", len(oscChanges.Units.Deleted))
-	if err := r.removeDeletedUnits(ctx, log, node, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed removing deleted units: %w", err)
-	}
+	if r.rollbackEnabled() && node != nil {
+		// The last applied OSC on the disk is only replaced after the new OSC was applied successfully, hence it serves as
+		// snapshot of the previously applied files and units.
+		if lastAppliedOSC, err = readLastAppliedOperatingSystemConfig(r.FS); err != nil {
+			return reconcile.Result{}, err
+		}
 
-	log.Info("Reloading systemd daemon")
-	if err := r.DBus.DaemonReload(ctx); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed reloading systemd daemon: %w", err)
+		if lastAppliedOSC != nil {
+			healthProbers = r.healthyProbers(ctx)
+			unitsToVerify = computeUnitsToVerify(osc, oscChanges)
+		}
 	}
 
-	log.Info("Executing unit commands (start/stop)", "unitCommands", len(oscChanges.Units.Commands))
-	if err := r.executeUnitCommands(ctx, log, node, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed executing unit commands: %w", err)
-	}
+	if err := r.applyOperatingSystemConfigChanges(ctx, log, node, oscChanges); err != nil {
+		if lastAppliedOSC == nil {
+			return reconcile.Result{}, err
+		}
 
-	// After the node is prepared, we can wait for the registries to be configured.
-	// The ones with readiness probes should also succeed here since their cache/mirror pods
-	// can now start as workload in the cluster.
-	log.Info("Waiting for containerd registries to be configured")
-	if err := waitForRegistries(); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed configuring containerd registries: %w", err)
+		// Failing to apply the changes, e.g., because a changed unit cannot be restarted, leaves the node in an
+		// inconsistent state, hence the previously applied operating system config is restored as well.
+		log.Error(err, "Failed applying operating system config, rolling back to the previously applied one")
+		return reconcile.Result{}, r.rollbackAndFinishInPlaceUpdate(ctx, log, node, osc, lastAppliedOSC, oscChecksum, healthProbers, fmt.Errorf("it could not be applied: %w", err))
 	}
 
-	log.Info("Removing no longer needed files")
-	if err := r.removeDeletedFiles(log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed removing deleted files: %w", err)
+	if lastAppliedOSC != nil {
+		if err := r.verifyHealth(ctx, log, unitsToVerify, healthProbers); err != nil {
+			log.Error(err, "Node is unhealthy after applying operating system config, rolling back to the previously applied one")
+			return reconcile.Result{}, r.rollbackAndFinishInPlaceUpdate(ctx, log, node, osc, lastAppliedOSC, oscChecksum, healthProbers, fmt.Errorf("the node was unhealthy after applying it: %w", err))
+		}
 	}
 
 	log.Info("Successfully applied operating system config")
 
+	log.Info("Persisting checksums of applied files to the disk", "path", lastAppliedChecksumsFilePath)
+	if err := r.recordAppliedChecksums(osc); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	log.Info("Persisting current operating system config as 'last-applied' file to the disk", "path", lastAppliedOperatingSystemConfigFilePath)
 	oscRaw, err := runtime.Encode(codec, osc)
 	if err != nil {
@@ -205,6 +247,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed removing bootstrap token file %q: %w", nodeagentconfigv1alpha1.BootstrapTokenFilePath, err)
 	}
 
+	if err := r.resetRolledBackCondition(ctx, node, oscChecksum); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.finishInPlaceUpdate(ctx, log, node, osc, nil); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed finishing in-place update: %w", err)
+	}
+
 	r.Recorder.Event(node, corev1.EventTypeNormal, "OSCApplied", "Operating system config has been applied successfully")
 	patch := client.MergeFrom(node.DeepCopy())
 	metav1.SetMetaDataLabel(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, r.Config.KubernetesVersion.String())
@@ -549,3 +599,56 @@ func (r *Reconciler) executeUnitCommands(ctx context.Context, log logr.Logger, n
 
 	return flow.Parallel(fns...)(ctx)
 }
+
+func (r *Reconciler) applyOperatingSystemConfigChanges(ctx context.Context, log logr.Logger, node *corev1.Node, oscChanges *operatingSystemConfigChanges) error {
+	log.Info("Applying new or changed inline files")
+	if err := r.applyChangedInlineFiles(log, oscChanges); err != nil {
+		return fmt.Errorf("failed applying changed inline files: %w", err)
+	}
+
+	log.Info("Applying containerd registries")
+	waitForRegistries, err := r.ReconcileContainerdRegistries(ctx, log, oscChanges)
+	if err != nil {
+		return fmt.Errorf("failed reconciling containerd registries: %w", err)
+	}
+
+	log.Info("Applying new or changed imageRef files")
+	if err := r.applyChangedImageRefFiles(ctx, log, oscChanges); err != nil {
+		return fmt.Errorf("failed applying changed imageRef files: %w", err)
+	}
+
+	log.Info("Applying new or changed units", "changedUnits", len(oscChanges.Units.Changed))
+	if err := r.applyChangedUnits(ctx, log, oscChanges); err != nil {
+		return fmt.Errorf("failed applying changed units: %w", err)
+	}
+
+	log.Info("Removing no longer needed units", "deletedUnits", len(oscChanges.Units.Deleted))
+	if err := r.removeDeletedUnits(ctx, log, node, oscChanges); err != nil {
+		return fmt.Errorf("failed removing deleted units: %w", err)
+	}
+
+	log.Info("Reloading systemd daemon")
+	if err := r.DBus.DaemonReload(ctx); err != nil {
+		return fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+
+	log.Info("Executing unit commands (start/stop)", "unitCommands", len(oscChanges.Units.Commands))
+	if err := r.executeUnitCommands(ctx, log, node, oscChanges); err != nil {
+		return fmt.Errorf("failed executing unit commands: %w", err)
+	}
+
+	// After the node is prepared, we can wait for the registries to be configured.
+	// The ones with readiness probes should also succeed here since their cache/mirror pods
+	// can now start as workload in the cluster.
+	log.Info("Waiting for containerd registries to be configured")
+	if err := waitForRegistries(); err != nil {
+		return fmt.Errorf("failed configuring containerd registries: %w", err)
+	}
+
+	log.Info("Removing no longer needed files")
+	if err := r.removeDeletedFiles(log, oscChanges); err != nil {
+		return fmt.Errorf("failed removing deleted files: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	systemdunit "github.com/coreos/go-systemd/v22/unit"
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const (
+	lastRolledBackOperatingSystemConfigChecksumFilePath = nodeagentconfigv1alpha1.BaseDir + "/last-rolled-back-osc-checksum"
+
+	conditionReasonHealthCheckFailed = "HealthCheckFailed"
+	conditionReasonApplied           = "Applied"
+
+	eventReasonRolledBack = "OSCRolledBack"
+
+	rollbackChangesChecksumPrefix = "rollback-"
+)
+
+// healthCheckInterval is the interval in which the health of the node is checked after applying a changed operating
+// system config.
+const healthCheckInterval = 5 * time.Second
+
+func (r *Reconciler) rollbackEnabled() bool {
+	return r.Config.Rollback != nil && ptr.Deref(r.Config.Rollback.Enabled, false)
+}
+
+func readLastAppliedOperatingSystemConfig(fs afero.Afero) (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	oscRaw, err := fs.ReadFile(lastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading last applied OSC from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(decoder, oscRaw, osc); err != nil {
+		return nil, fmt.Errorf("unable to decode the last applied OSC read from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+	return osc, nil
+}
+
+// wasRolledBack returns true if the operating system config with the given checksum was rolled back before.
+func (r *Reconciler) wasRolledBack(oscChecksum string) (bool, error) {
+	data, err := r.FS.ReadFile(lastRolledBackOperatingSystemConfigChecksumFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to read checksum of last rolled back OSC from file path %s: %w", lastRolledBackOperatingSystemConfigChecksumFilePath, err)
+	}
+	return strings.TrimSpace(string(data)) == oscChecksum, nil
+}
+
+// healthyProbers returns the health probers which currently consider their components healthy. Components which are
+// already unhealthy before a changed operating system config is applied must not cause a rollback.
+func (r *Reconciler) healthyProbers(ctx context.Context) []healthcheck.HealthProber {
+	var probers []healthcheck.HealthProber
+	for _, healthChecker := range r.HealthCheckers {
+		prober, ok := healthChecker.(healthcheck.HealthProber)
+		if !ok {
+			continue
+		}
+		if err := prober.Probe(ctx); err == nil {
+			probers = append(probers, prober)
+		}
+	}
+	return probers
+}
+
+// computeUnitsToVerify returns the units which are restarted when the given changes are applied. The gardener-node-agent
+// unit is excluded since it is only restarted after the changes have been applied.
+func computeUnitsToVerify(osc *extensionsv1alpha1.OperatingSystemConfig, changes *operatingSystemConfigChanges) []extensionsv1alpha1.Unit {
+	var (
+		allUnits = mergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits)
+		units    []extensionsv1alpha1.Unit
+	)
+
+	for _, command := range changes.Units.Commands {
+		if command.Command != extensionsv1alpha1.CommandRestart || command.Name == nodeagentconfigv1alpha1.UnitName {
+			continue
+		}
+		if i := slices.IndexFunc(allUnits, func(unit extensionsv1alpha1.Unit) bool { return unit.Name == command.Name }); i != -1 {
+			units = append(units, allUnits[i])
+		}
+	}
+
+	if changes.Containerd.ConfigFileChanged && !slices.ContainsFunc(units, func(unit extensionsv1alpha1.Unit) bool {
+		return unit.Name == v1beta1constants.OperatingSystemConfigUnitNameContainerDService
+	}) {
+		units = append(units, extensionsv1alpha1.Unit{Name: v1beta1constants.OperatingSystemConfigUnitNameContainerDService})
+	}
+
+	return units
+}
+
+// isOneShot returns true if the given unit is a service of type 'oneshot'. Such units become 'inactive' after they
+// finished successfully.
+func isOneShot(unit extensionsv1alpha1.Unit) bool {
+	var contents []string
+	if unit.Content != nil {
+		contents = append(contents, *unit.Content)
+	}
+	for _, dropIn := range unit.DropIns {
+		contents = append(contents, dropIn.Content)
+	}
+
+	var oneShot bool
+	for _, content := range contents {
+		options, err := systemdunit.DeserializeOptions(strings.NewReader(content))
+		if err != nil {
+			continue
+		}
+		for _, option := range options {
+			if option.Section == "Service" && option.Name == "Type" {
+				oneShot = option.Value == "oneshot"
+			}
+		}
+	}
+	return oneShot
+}
+
+// verifyHealth waits until the given units are active and the given health probers consider their components healthy.
+func (r *Reconciler) verifyHealth(ctx context.Context, log logr.Logger, units []extensionsv1alpha1.Unit, probers []healthcheck.HealthProber) error {
+	timeout := r.Config.Rollback.HealthCheckTimeout.Duration
+	log.Info("Verifying health of the node after applying operating system config", "units", len(units), "healthProbers", len(probers), "timeout", timeout)
+
+	return retry.UntilTimeout(ctx, healthCheckInterval, timeout, func(ctx context.Context) (bool, error) {
+		for _, unit := range units {
+			activeState, err := r.DBus.ActiveState(ctx, unit.Name)
+			if err != nil {
+				return retry.MinorError(err)
+			}
+
+			if activeState != "active" && (activeState != "inactive" || !isOneShot(unit)) {
+				return retry.MinorError(fmt.Errorf("unit %q is %s", unit.Name, activeState))
+			}
+		}
+
+		for _, prober := range probers {
+			if err := prober.Probe(ctx); err != nil {
+				return retry.MinorError(fmt.Errorf("%s is unhealthy: %w", prober.Name(), err))
+			}
+		}
+
+		return retry.Ok()
+	})
+}
+
+// rollback restores the files and units of the last applied operating system config and records the checksum of the
+// failed one so that it is not applied again. The changes required for the rollback are persisted before the node is
+// touched, hence an interrupted rollback is resumed by the next reconciliation instead of applying the failed operating
+// system config again.
+func (r *Reconciler) rollback(ctx context.Context, log logr.Logger, node *corev1.Node, failedOSC, lastAppliedOSC *extensionsv1alpha1.OperatingSystemConfig, failedOSCChecksum string, probers []healthcheck.HealthProber, cause error) error {
+	changes, err := computeOperatingSystemConfigChangesBetween(r.FS, failedOSC.DeepCopy(), lastAppliedOSC.DeepCopy(), rollbackChangesChecksum(failedOSCChecksum))
+	if err != nil {
+		return fmt.Errorf("failed calculating the OSC changes for the rollback: %w", err)
+	}
+
+	if err := r.FS.WriteFile(lastRolledBackOperatingSystemConfigChecksumFilePath, []byte(failedOSCChecksum), 0600); err != nil {
+		return fmt.Errorf("unable to write checksum of rolled back OSC to file path %q: %w", lastRolledBackOperatingSystemConfigChecksumFilePath, err)
+	}
+
+	return r.completeRollback(ctx, log, node, lastAppliedOSC, failedOSCChecksum, changes, probers, cause)
+}
+
+// rollbackAndFinishInPlaceUpdate rolls back the given failed operating system config and finishes a pending in-place
+// update as failed.
+func (r *Reconciler) rollbackAndFinishInPlaceUpdate(ctx context.Context, log logr.Logger, node *corev1.Node, failedOSC, lastAppliedOSC *extensionsv1alpha1.OperatingSystemConfig, failedOSCChecksum string, probers []healthcheck.HealthProber, cause error) error {
+	if err := r.rollback(ctx, log, node, failedOSC, lastAppliedOSC, failedOSCChecksum, probers, cause); err != nil {
+		return fmt.Errorf("failed rolling back operating system config: %w", err)
+	}
+	return r.finishInPlaceUpdate(ctx, log, node, failedOSC, cause)
+}
+
+// rollbackChangesChecksum returns the checksum under which the changes for rolling back the operating system config
+// with the given checksum are persisted. It differs from the checksum of any operating system config, hence the
+// persisted changes are never mistaken for the changes of a regular reconciliation.
+func rollbackChangesChecksum(failedOSCChecksum string) string {
+	return rollbackChangesChecksumPrefix + failedOSCChecksum
+}
+
+// loadRollbackChanges returns the persisted changes of an interrupted rollback of the operating system config with the
+// given checksum. It returns nil if there is no such rollback.
+func loadRollbackChanges(fs afero.Afero, failedOSCChecksum string) (*operatingSystemConfigChanges, error) {
+	changes, err := loadOSCChanges(fs)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to load osc changes file: %w", err)
+	}
+
+	if changes.OperatingSystemConfigChecksum != rollbackChangesChecksum(failedOSCChecksum) {
+		return nil, nil
+	}
+	return changes, nil
+}
+
+// resumeRollback completes the rollback of the operating system config with the given checksum if it was interrupted,
+// e.g., because gardener-node-agent was restarted.
+func (r *Reconciler) resumeRollback(ctx context.Context, log logr.Logger, node *corev1.Node, failedOSCChecksum string) error {
+	changes, err := loadRollbackChanges(r.FS, failedOSCChecksum)
+	if err != nil || changes == nil || node == nil {
+		return err
+	}
+
+	lastAppliedOSC, err := readLastAppliedOperatingSystemConfig(r.FS)
+	if err != nil {
+		return err
+	}
+	if lastAppliedOSC == nil {
+		return fmt.Errorf("last applied operating system config not found in file path %s", lastAppliedOperatingSystemConfigFilePath)
+	}
+
+	log.Info("Resuming interrupted rollback of operating system config", "checksum", failedOSCChecksum)
+	return r.completeRollback(ctx, log, node, lastAppliedOSC, failedOSCChecksum, changes, r.healthyProbers(ctx), errors.New("the node was unhealthy after applying it or it could not be applied, the interrupted rollback has been resumed"))
+}
+
+// completeRollback applies the (remaining) changes of a rollback and verifies the health of the node afterwards. The
+// persisted changes are removed once the rollback is completed.
+func (r *Reconciler) completeRollback(ctx context.Context, log logr.Logger, node *corev1.Node, lastAppliedOSC *extensionsv1alpha1.OperatingSystemConfig, failedOSCChecksum string, changes *operatingSystemConfigChanges, probers []healthcheck.HealthProber, cause error) error {
+	unitsToVerify := computeUnitsToVerify(lastAppliedOSC, changes)
+
+	log.Info("Applying containerd configuration of the previously applied operating system config")
+	if err := r.ReconcileContainerdConfig(ctx, log, lastAppliedOSC.DeepCopy()); err != nil {
+		return fmt.Errorf("failed reconciling containerd configuration: %w", err)
+	}
+
+	if err := r.applyOperatingSystemConfigChanges(ctx, log, node, changes); err != nil {
+		return err
+	}
+
+	if err := r.recordAppliedChecksums(lastAppliedOSC); err != nil {
+		return err
+	}
+
+	healthErr := r.verifyHealth(ctx, log, unitsToVerify, probers)
+
+	mustRestartNodeAgent := changes.MustRestartNodeAgent
+	if err := r.FS.Remove(lastComputedOperatingSystemConfigChangesFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed removing file %q: %w", lastComputedOperatingSystemConfigChangesFilePath, err)
+	}
+
+	message := fmt.Sprintf("Operating system config with checksum %s has been rolled back because %v", failedOSCChecksum, cause)
+	if healthErr != nil {
+		log.Error(healthErr, "Node is still unhealthy after rolling back operating system config", "checksum", failedOSCChecksum)
+		message += fmt.Sprintf(". The node is still unhealthy after the rollback: %v", healthErr)
+	} else {
+		log.Info("Successfully rolled back operating system config", "checksum", failedOSCChecksum)
+	}
+
+	r.Recorder.Event(node, corev1.EventTypeWarning, eventReasonRolledBack, message)
+	if err := r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigRolledBack, corev1.ConditionTrue, conditionReasonHealthCheckFailed, message); err != nil {
+		return err
+	}
+
+	if mustRestartNodeAgent {
+		log.Info("Must restart myself (gardener-node-agent unit), canceling the context to initiate graceful shutdown")
+		r.CancelContext()
+	}
+
+	if healthErr != nil {
+		return fmt.Errorf("node is still unhealthy after rolling back operating system config: %w", healthErr)
+	}
+	return nil
+}
+
+// resetRolledBackCondition marks a previous rollback as obsolete after the operating system config with the given
+// checksum has been applied successfully.
+func (r *Reconciler) resetRolledBackCondition(ctx context.Context, node *corev1.Node, oscChecksum string) error {
+	if err := r.FS.Remove(lastRolledBackOperatingSystemConfigChecksumFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed removing file %q: %w", lastRolledBackOperatingSystemConfigChecksumFilePath, err)
+	}
+
+	if !slices.ContainsFunc(node.Status.Conditions, func(condition corev1.NodeCondition) bool {
+		return condition.Type == nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigRolledBack && condition.Status == corev1.ConditionTrue
+	}) {
+		return nil
+	}
+
+	return r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigRolledBack, corev1.ConditionFalse, conditionReasonApplied,
+		fmt.Sprintf("Operating system config with checksum %s has been applied successfully.", oscChecksum))
+}
@@ -36,6 +36,11 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		r.secretNameToPath[config.SecretName] = config.Path
 	}
 
+	var reconciler reconcile.Reconciler = r
+	if r.WrapReconciler != nil {
+		reconciler = r.WrapReconciler(r)
+	}
+
 	return builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
@@ -48,5 +53,5 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 			}),
 		).
 		WithOptions(controller.Options{MaxConcurrentReconciles: len(r.Config.SyncConfigs)}).
-		Complete(r)
+		Complete(reconciler)
 }
@@ -24,9 +24,10 @@ import (
 
 // Reconciler fetches the shoot access token for gardener-node-agent and writes it to disk.
 type Reconciler struct {
-	APIReader client.Reader
-	Config    nodeagentconfigv1alpha1.TokenControllerConfig
-	FS        afero.Afero
+	APIReader      client.Reader
+	Config         nodeagentconfigv1alpha1.TokenControllerConfig
+	FS             afero.Afero
+	WrapReconciler func(reconcile.Reconciler) reconcile.Reconciler
 
 	secretNameToPath map[string]string
 }
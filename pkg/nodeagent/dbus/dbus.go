@@ -34,6 +34,10 @@ type DBus interface {
 	Restart(ctx context.Context, recorder record.EventRecorder, node runtime.Object, unitName string) error
 	// Reboot this machines, is the same as executing "systemctl reboot".
 	Reboot() error
+	// ActiveState returns the active state of the given unit, e.g. "active", "activating" or "failed".
+	ActiveState(ctx context.Context, unitName string) (string, error)
+	// FailedUnits returns the names of all units in the "failed" state, same as executing "systemctl list-units --state=failed".
+	FailedUnits(ctx context.Context) ([]string, error)
 }
 
 type db struct {
@@ -121,6 +125,44 @@ func (_ *db) DaemonReload(ctx context.Context) error {
 	return nil
 }
 
+func (_ *db) ActiveState(ctx context.Context, unitName string) (string, error) {
+	dbc, err := dbus.NewWithContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to connect to dbus: %w", err)
+	}
+	defer dbc.Close()
+
+	property, err := dbc.GetUnitPropertyContext(ctx, unitName, "ActiveState")
+	if err != nil {
+		return "", fmt.Errorf("unable to get active state of unit %s: %w", unitName, err)
+	}
+
+	activeState, ok := property.Value.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected type %T of active state of unit %s", property.Value.Value(), unitName)
+	}
+	return activeState, nil
+}
+
+func (_ *db) FailedUnits(ctx context.Context) ([]string, error) {
+	dbc, err := dbus.NewWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to dbus: %w", err)
+	}
+	defer dbc.Close()
+
+	units, err := dbc.ListUnitsFilteredContext(ctx, []string{"failed"})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list failed units: %w", err)
+	}
+
+	unitNames := make([]string, 0, len(units))
+	for _, unit := range units {
+		unitNames = append(unitNames, unit.Name)
+	}
+	return unitNames, nil
+}
+
 func (d *db) runCommand(
 	ctx context.Context,
 	recorder record.EventRecorder,
@@ -44,8 +44,10 @@ type SystemdAction struct {
 
 // DBus is a fake implementation for the dbus.DBus interface.
 type DBus struct {
-	Actions  []SystemdAction
-	failures map[string]error
+	Actions         []SystemdAction
+	ActiveStates    map[string]string
+	FailedUnitNames []string
+	failures        map[string]error
 
 	mutex sync.Mutex
 }
@@ -166,6 +168,26 @@ func (d *DBus) Stop(_ context.Context, _ record.EventRecorder, _ runtime.Object,
 	return nil
 }
 
+// ActiveState implements dbus.DBus. It returns the state stored in ActiveStates for the given unit, or "active" if
+// there is none.
+func (d *DBus) ActiveState(_ context.Context, unitName string) (string, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if activeState, ok := d.ActiveStates[unitName]; ok {
+		return activeState, nil
+	}
+	return "active", nil
+}
+
+// FailedUnits implements dbus.DBus. It returns the unit names stored in FailedUnitNames.
+func (d *DBus) FailedUnits(_ context.Context) ([]string, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return append([]string{}, d.FailedUnitNames...), nil
+}
+
 func failureKey(action SystemdAction) string {
 	return strings.Join(action.UnitNames, "-") + strconv.Itoa(int(action.Action))
 }
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+)
+
+// baseURL is the URL used for all requests. The host is irrelevant since the connection is established to the socket.
+const baseURL = "http://gardener-node-agent"
+
+// Client is a client for the local debugging API of gardener-node-agent.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient returns a new client for the debugging API served on the given Unix socket.
+func NewClient(socketPath string) *Client {
+	return &Client{
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var dialer net.Dialer
+					return dialer.DialContext(ctx, "unix", socketPath)
+				},
+			},
+		},
+	}
+}
+
+// Status returns the status of gardener-node-agent and the node.
+func (c *Client) Status(ctx context.Context) (*Status, error) {
+	status := &Status{}
+	if err := c.do(ctx, http.MethodGet, PathStatus, http.StatusOK, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// DryRun returns the changes which the next reconciliation of the operating system config would apply to the node.
+func (c *Client) DryRun(ctx context.Context) (*operatingsystemconfig.DryRunResult, error) {
+	result := &operatingsystemconfig.DryRunResult{}
+	if err := c.do(ctx, http.MethodGet, PathOperatingSystemConfigDryRun, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TriggerReconciliation triggers an immediate reconciliation of the operating system config.
+func (c *Client) TriggerReconciliation(ctx context.Context) error {
+	return c.do(ctx, http.MethodPost, PathOperatingSystemConfigReconcile, http.StatusAccepted, nil)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, expectedStatusCode int, into any) error {
+	request, err := http.NewRequestWithContext(ctx, method, baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed creating request: %w", err)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed sending request to gardener-node-agent (is it running and are you root?): %w", err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("failed reading response: %w", err)
+	}
+
+	if response.StatusCode != expectedStatusCode {
+		errResponse := errorResponse{}
+		if err := json.Unmarshal(body, &errResponse); err != nil || errResponse.Message == "" {
+			errResponse.Message = string(body)
+		}
+		return fmt.Errorf("request failed with status code %d: %s", response.StatusCode, errResponse.Message)
+	}
+
+	if into == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, into); err != nil {
+		return fmt.Errorf("failed decoding response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debug
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ReconcileErrors records the errors returned by the reconcilers of the controllers of gardener-node-agent.
+type ReconcileErrors struct {
+	clock clock.Clock
+
+	lock        sync.RWMutex
+	controllers map[string]*ControllerStatus
+	objects     map[string]map[string]ReconcileError
+}
+
+// NewReconcileErrors returns a new ReconcileErrors.
+func NewReconcileErrors(clock clock.Clock) *ReconcileErrors {
+	return &ReconcileErrors{
+		clock:       clock,
+		controllers: map[string]*ControllerStatus{},
+		objects:     map[string]map[string]ReconcileError{},
+	}
+}
+
+// WrapReconciler returns a function which wraps the reconciler of the given controller. The wrapping reconciler records
+// the errors returned by the reconciler and clears the recorded error of an object once it was reconciled successfully.
+func (r *ReconcileErrors) WrapReconciler(controller string) func(reconcile.Reconciler) reconcile.Reconciler {
+	return func(reconciler reconcile.Reconciler) reconcile.Reconciler {
+		return &errorRecordingReconciler{
+			Reconciler:      reconciler,
+			reconcileErrors: r,
+			controller:      controller,
+		}
+	}
+}
+
+// Controllers returns the status of all controllers whose reconcilers returned an error.
+func (r *ReconcileErrors) Controllers() map[string]ControllerStatus {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	out := make(map[string]ControllerStatus, len(r.controllers))
+	for name, status := range r.controllers {
+		out[name] = *status
+	}
+	return out
+}
+
+// LastError returns the last error returned by the reconciler of the given controller for the given object, or nil if
+// there was none.
+func (r *ReconcileErrors) LastError(controller, object string) *ReconcileError {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	if reconcileError, ok := r.objects[controller][object]; ok {
+		return &reconcileError
+	}
+	return nil
+}
+
+func (r *ReconcileErrors) record(controller, object string, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	reconcileError := ReconcileError{
+		Object:  object,
+		Message: err.Error(),
+		Time:    r.clock.Now().UTC(),
+	}
+
+	status, ok := r.controllers[controller]
+	if !ok {
+		status = &ControllerStatus{}
+		r.controllers[controller] = status
+	}
+	status.Errors++
+	status.LastError = &reconcileError
+
+	if _, ok := r.objects[controller]; !ok {
+		r.objects[controller] = map[string]ReconcileError{}
+	}
+	r.objects[controller][object] = reconcileError
+}
+
+func (r *ReconcileErrors) clear(controller, object string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.objects[controller][object]; !ok {
+		return
+	}
+	delete(r.objects[controller], object)
+
+	// The last error of the controller is replaced by the latest error of the objects which still fail to reconcile.
+	status := r.controllers[controller]
+	if status.LastError == nil || status.LastError.Object != object {
+		return
+	}
+	status.LastError = nil
+	for _, reconcileError := range r.objects[controller] {
+		if status.LastError == nil || reconcileError.Time.After(status.LastError.Time) {
+			status.LastError = &reconcileError
+		}
+	}
+}
+
+// errorRecordingReconciler records the errors returned by the wrapped reconciler.
+type errorRecordingReconciler struct {
+	reconcile.Reconciler
+
+	reconcileErrors *ReconcileErrors
+	controller      string
+}
+
+// Reconcile calls the wrapped reconciler and records its error, or clears the recorded error of the object if it was
+// reconciled successfully.
+func (e *errorRecordingReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	result, err := e.Reconciler.Reconcile(ctx, request)
+
+	object := request.Name
+	if request.Namespace != "" {
+		object = request.Namespace + "/" + request.Name
+	}
+
+	if err != nil {
+		e.reconcileErrors.record(e.controller, object, err)
+	} else {
+		e.reconcileErrors.clear(e.controller, object)
+	}
+
+	return result, err
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debug_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/debug"
+)
+
+var _ = Describe("ReconcileErrors", func() {
+	var (
+		ctx       = context.Background()
+		now       = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		fakeClock *testclock.FakeClock
+
+		reconcileErrors *ReconcileErrors
+		errs            map[string]error
+
+		tokenReconciler reconcile.Reconciler
+		oscReconciler   reconcile.Reconciler
+	)
+
+	newReconciler := func(controller string) reconcile.Reconciler {
+		return reconcileErrors.WrapReconciler(controller)(reconcile.Func(func(_ context.Context, request reconcile.Request) (reconcile.Result, error) {
+			return reconcile.Result{RequeueAfter: time.Minute}, errs[request.Name]
+		}))
+	}
+
+	reconcileObject := func(reconciler reconcile.Reconciler, namespace, name string) error {
+		GinkgoHelper()
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}})
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		return err
+	}
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(now)
+		reconcileErrors = NewReconcileErrors(fakeClock)
+		errs = map[string]error{}
+
+		tokenReconciler = newReconciler("token")
+		oscReconciler = newReconciler("operatingsystemconfig")
+	})
+
+	It("should record the errors of the reconcilers", func() {
+		errs["foo"] = errors.New("fake 1")
+		Expect(reconcileObject(tokenReconciler, "kube-system", "foo")).To(MatchError("fake 1"))
+		fakeClock.Step(time.Minute)
+		errs["bar"] = errors.New("fake 2")
+		Expect(reconcileObject(tokenReconciler, "kube-system", "bar")).To(MatchError("fake 2"))
+		errs["osc"] = errors.New("fake 3")
+		Expect(reconcileObject(oscReconciler, "", "osc")).To(MatchError("fake 3"))
+
+		Expect(reconcileErrors.Controllers()).To(Equal(map[string]ControllerStatus{
+			"token": {
+				Errors:    2,
+				LastError: &ReconcileError{Object: "kube-system/bar", Message: "fake 2", Time: now.Add(time.Minute)},
+			},
+			"operatingsystemconfig": {
+				Errors:    1,
+				LastError: &ReconcileError{Object: "osc", Message: "fake 3", Time: now.Add(time.Minute)},
+			},
+		}))
+		Expect(reconcileErrors.LastError("token", "kube-system/foo")).To(Equal(&ReconcileError{Object: "kube-system/foo", Message: "fake 1", Time: now}))
+		Expect(reconcileErrors.LastError("token", "kube-system/baz")).To(BeNil())
+		Expect(reconcileErrors.LastError("foo", "kube-system/foo")).To(BeNil())
+	})
+
+	It("should not record anything for successful reconciliations", func() {
+		Expect(reconcileObject(tokenReconciler, "kube-system", "foo")).To(Succeed())
+
+		Expect(reconcileErrors.Controllers()).To(BeEmpty())
+		Expect(reconcileErrors.LastError("token", "kube-system/foo")).To(BeNil())
+	})
+
+	It("should clear the error of an object once it was reconciled successfully", func() {
+		errs["foo"] = errors.New("fake 1")
+		Expect(reconcileObject(tokenReconciler, "kube-system", "foo")).To(MatchError("fake 1"))
+		fakeClock.Step(time.Minute)
+		errs["bar"] = errors.New("fake 2")
+		Expect(reconcileObject(tokenReconciler, "kube-system", "bar")).To(MatchError("fake 2"))
+
+		By("Reconcile object whose error is not the last one successfully")
+		delete(errs, "foo")
+		Expect(reconcileObject(tokenReconciler, "kube-system", "foo")).To(Succeed())
+
+		Expect(reconcileErrors.LastError("token", "kube-system/foo")).To(BeNil())
+		Expect(reconcileErrors.Controllers()).To(Equal(map[string]ControllerStatus{
+			"token": {
+				Errors:    2,
+				LastError: &ReconcileError{Object: "kube-system/bar", Message: "fake 2", Time: now.Add(time.Minute)},
+			},
+		}))
+
+		By("Reconcile object whose error is the last one successfully")
+		fakeClock.Step(time.Minute)
+		errs["foo"] = errors.New("fake 3")
+		Expect(reconcileObject(tokenReconciler, "kube-system", "foo")).To(MatchError("fake 3"))
+		fakeClock.Step(time.Minute)
+		errs["baz"] = errors.New("fake 4")
+		Expect(reconcileObject(tokenReconciler, "kube-system", "baz")).To(MatchError("fake 4"))
+		delete(errs, "baz")
+		Expect(reconcileObject(tokenReconciler, "kube-system", "baz")).To(Succeed())
+
+		Expect(reconcileErrors.LastError("token", "kube-system/baz")).To(BeNil())
+		Expect(reconcileErrors.Controllers()).To(Equal(map[string]ControllerStatus{
+			"token": {
+				Errors:    4,
+				LastError: &ReconcileError{Object: "kube-system/foo", Message: "fake 3", Time: now.Add(2 * time.Minute)},
+			},
+		}))
+
+		By("Reconcile all objects successfully")
+		clear(errs)
+		Expect(reconcileObject(tokenReconciler, "kube-system", "foo")).To(Succeed())
+		Expect(reconcileObject(tokenReconciler, "kube-system", "bar")).To(Succeed())
+
+		Expect(reconcileErrors.Controllers()).To(Equal(map[string]ControllerStatus{"token": {Errors: 4}}))
+	})
+})
@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/token"
+)
+
+// requestTimeout is the maximum duration for serving a request.
+const requestTimeout = time.Minute
+
+// OperatingSystemConfigReconciler is the part of the operating system config controller used by the Server.
+type OperatingSystemConfigReconciler interface {
+	// DryRun computes the changes which the next reconciliation would apply to the node.
+	DryRun(ctx context.Context) (*operatingsystemconfig.DryRunResult, error)
+	// UnitStates returns the active states of the units of the last applied operating system config.
+	UnitStates(ctx context.Context) ([]operatingsystemconfig.UnitState, error)
+	// TriggerReconciliation enqueues the operating system config for an immediate reconciliation.
+	TriggerReconciliation() error
+}
+
+// Server serves the local debugging API of gardener-node-agent. It listens on a Unix socket which is only accessible
+// for root, hence it is neither exposed to the network nor to the workload running on the node.
+type Server struct {
+	Log logr.Logger
+	// FS is used for reading the files of the synced access tokens.
+	FS afero.Afero
+	// SocketPath is the path of the Unix socket on which the server listens.
+	SocketPath string
+	// TokenConfig is the configuration of the token controller.
+	TokenConfig nodeagentconfigv1alpha1.TokenControllerConfig
+	// ReconcileErrors contains the errors returned by the reconcilers of the controllers.
+	ReconcileErrors *ReconcileErrors
+
+	lock                  sync.RWMutex
+	operatingSystemConfig OperatingSystemConfigReconciler
+}
+
+// SetOperatingSystemConfigReconciler sets the reconciler of the operating system config controller once it was added
+// to the manager. Until then, the endpoints for the operating system config are not available.
+func (s *Server) SetOperatingSystemConfigReconciler(reconciler OperatingSystemConfigReconciler) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.operatingSystemConfig = reconciler
+}
+
+func (s *Server) getOperatingSystemConfigReconciler() OperatingSystemConfigReconciler {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.operatingSystemConfig
+}
+
+// Start starts the server and blocks until the context is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	listener, err := s.listen()
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+PathStatus, s.handleStatus)
+	mux.HandleFunc("GET "+PathOperatingSystemConfigDryRun, s.handleOperatingSystemConfigDryRun)
+	mux.HandleFunc("POST "+PathOperatingSystemConfigReconcile, s.handleOperatingSystemConfigReconcile)
+
+	server := &http.Server{
+		Handler:           http.TimeoutHandler(mux, requestTimeout, "request timed out"),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			s.Log.Error(err, "Failed shutting down debugging API server")
+		}
+	}()
+
+	s.Log.Info("Starting debugging API server", "socketPath", s.SocketPath)
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed running debugging API server: %w", err)
+	}
+	return nil
+}
+
+// NeedLeaderElection returns false since the server must be available on every node.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// listen creates the Unix socket. Both the socket and its directory are only accessible for the owner, i.e., root.
+func (s *Server) listen() (net.Listener, error) {
+	dir := filepath.Dir(s.SocketPath)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed creating directory %s for debugging API socket: %w", dir, err)
+	}
+	if err := os.Chmod(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed restricting permissions of directory %s for debugging API socket: %w", dir, err)
+	}
+
+	// The socket file of a previous run is not removed if gardener-node-agent was not shut down gracefully.
+	if err := os.Remove(s.SocketPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed removing stale debugging API socket %s: %w", s.SocketPath, err)
+	}
+
+	listener, err := net.Listen("unix", s.SocketPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed listening on debugging API socket %s: %w", s.SocketPath, err)
+	}
+	if err := os.Chmod(s.SocketPath, 0600); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed restricting permissions of debugging API socket %s: %w", s.SocketPath, err), listener.Close())
+	}
+
+	return listener, nil
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	status := &Status{
+		Controllers: s.ReconcileErrors.Controllers(),
+		Tokens:      s.tokenSyncStatus(),
+	}
+
+	if reconciler := s.getOperatingSystemConfigReconciler(); reconciler == nil {
+		status.Errors = append(status.Errors, "operating system config controller is not running yet")
+	} else {
+		var err error
+		if status.OperatingSystemConfig, err = reconciler.DryRun(ctx); err != nil {
+			status.Errors = append(status.Errors, fmt.Sprintf("failed computing pending changes of operating system config: %v", err))
+		}
+		if status.Units, err = reconciler.UnitStates(ctx); err != nil {
+			status.Errors = append(status.Errors, fmt.Sprintf("failed reading states of units: %v", err))
+		}
+	}
+
+	writeResponse(w, http.StatusOK, status)
+}
+
+func (s *Server) tokenSyncStatus() []TokenSyncStatus {
+	var tokens []TokenSyncStatus
+
+	for _, config := range s.TokenConfig.SyncConfigs {
+		status := TokenSyncStatus{
+			SecretName: config.SecretName,
+			Path:       config.Path,
+			LastError:  s.ReconcileErrors.LastError(token.ControllerName, metav1.NamespaceSystem+"/"+config.SecretName),
+		}
+
+		if info, err := s.FS.Stat(config.Path); err == nil {
+			lastUpdateTime := info.ModTime().UTC()
+			status.LastUpdateTime = &lastUpdateTime
+		}
+
+		tokens = append(tokens, status)
+	}
+
+	return tokens
+}
+
+func (s *Server) handleOperatingSystemConfigDryRun(w http.ResponseWriter, r *http.Request) {
+	reconciler := s.getOperatingSystemConfigReconciler()
+	if reconciler == nil {
+		writeError(w, http.StatusServiceUnavailable, "operating system config controller is not running yet")
+		return
+	}
+
+	result, err := reconciler.DryRun(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed computing changes of operating system config: %v", err))
+		return
+	}
+
+	writeResponse(w, http.StatusOK, result)
+}
+
+func (s *Server) handleOperatingSystemConfigReconcile(w http.ResponseWriter, _ *http.Request) {
+	reconciler := s.getOperatingSystemConfigReconciler()
+	if reconciler == nil {
+		writeError(w, http.StatusServiceUnavailable, "operating system config controller is not running yet")
+		return
+	}
+
+	if err := reconciler.TriggerReconciliation(); err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed triggering reconciliation of operating system config: %v", err))
+		return
+	}
+
+	s.Log.Info("Triggered reconciliation of operating system config via debugging API")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// errorResponse is the body of responses for failed requests.
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeResponse(w, code, errorResponse{Message: message})
+}
+
+func writeResponse(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	// The response header was already written, hence an error can't be reported to the client anymore.
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debug_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	. "github.com/gardener/gardener/pkg/nodeagent/debug"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		now             = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		fakeFS          afero.Afero
+		reconcileErrors *ReconcileErrors
+		reconciler      *fakeOperatingSystemConfigReconciler
+
+		socketPath string
+		server     *Server
+		client     *Client
+		serverDone chan error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		reconcileErrors = NewReconcileErrors(testclock.NewFakeClock(now))
+		reconciler = &fakeOperatingSystemConfigReconciler{
+			result: &operatingsystemconfig.DryRunResult{
+				AppliedChecksum: "checksum-1",
+				DesiredChecksum: "checksum-2",
+				Changes:         operatingsystemconfig.Changes{ChangedFiles: []string{"/etc/foo"}},
+			},
+			units: []operatingsystemconfig.UnitState{{Name: "foo.service", ActiveState: "active"}},
+		}
+
+		// The path of Unix sockets is limited to about 100 characters, hence the temporary directory of the test can't be
+		// used since its path might be too long.
+		tempDir, err := os.MkdirTemp("", "gna-debug-")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() { Expect(os.RemoveAll(tempDir)).To(Succeed()) })
+		socketPath = filepath.Join(tempDir, "run", "debug.sock")
+
+		server = &Server{
+			Log:        logr.Discard(),
+			FS:         fakeFS,
+			SocketPath: socketPath,
+			TokenConfig: nodeagentconfigv1alpha1.TokenControllerConfig{
+				SyncConfigs: []nodeagentconfigv1alpha1.TokenSecretSyncConfig{
+					{SecretName: "foo", Path: "/var/lib/foo/token"},
+					{SecretName: "bar", Path: "/var/lib/bar/token"},
+				},
+			},
+			ReconcileErrors: reconcileErrors,
+		}
+		client = NewClient(socketPath)
+
+		serverDone = make(chan error, 1)
+		go func() {
+			defer GinkgoRecover()
+			serverDone <- server.Start(ctx)
+		}()
+		Eventually(func() error {
+			_, err := os.Stat(socketPath)
+			return err
+		}).Should(Succeed())
+
+		DeferCleanup(func() {
+			cancel()
+			Eventually(serverDone).Should(Receive(BeNil()))
+		})
+	})
+
+	It("should only allow the owner to access the socket", func() {
+		info, err := os.Stat(socketPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Type()).To(Equal(os.ModeSocket))
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		info, err = os.Stat(filepath.Dir(socketPath))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0700)))
+	})
+
+	It("should not need leader election", func() {
+		Expect(server.NeedLeaderElection()).To(BeFalse())
+	})
+
+	When("the operating system config controller is not running yet", func() {
+		It("should return the status without the operating system config", func() {
+			status, err := client.Status(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.OperatingSystemConfig).To(BeNil())
+			Expect(status.Units).To(BeEmpty())
+			Expect(status.Errors).To(ConsistOf("operating system config controller is not running yet"))
+		})
+
+		It("should fail computing the dry run", func() {
+			_, err := client.DryRun(ctx)
+			Expect(err).To(MatchError("request failed with status code 503: operating system config controller is not running yet"))
+		})
+
+		It("should fail triggering a reconciliation", func() {
+			Expect(client.TriggerReconciliation(ctx)).To(MatchError("request failed with status code 503: operating system config controller is not running yet"))
+		})
+	})
+
+	When("the operating system config controller is running", func() {
+		BeforeEach(func() {
+			server.SetOperatingSystemConfigReconciler(reconciler)
+		})
+
+		It("should return the status", func() {
+			lastUpdateTime := now.Add(-time.Hour)
+			Expect(fakeFS.WriteFile("/var/lib/foo/token", []byte("token"), 0600)).To(Succeed())
+			Expect(fakeFS.Chtimes("/var/lib/foo/token", lastUpdateTime, lastUpdateTime)).To(Succeed())
+
+			tokenReconciler := reconcileErrors.WrapReconciler("token")(reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{}, errors.New("fake")
+			}))
+			_, err := tokenReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "kube-system", Name: "bar"}})
+			Expect(err).To(MatchError("fake"))
+
+			status, err := client.Status(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			lastError := &ReconcileError{Object: "kube-system/bar", Message: "fake", Time: now}
+			Expect(status).To(Equal(&Status{
+				OperatingSystemConfig: reconciler.result,
+				Units:                 reconciler.units,
+				Controllers:           map[string]ControllerStatus{"token": {Errors: 1, LastError: lastError}},
+				Tokens: []TokenSyncStatus{
+					{SecretName: "foo", Path: "/var/lib/foo/token", LastUpdateTime: &lastUpdateTime},
+					{SecretName: "bar", Path: "/var/lib/bar/token", LastError: lastError},
+				},
+			}))
+		})
+
+		It("should report errors while collecting the status", func() {
+			reconciler.err = errors.New("fake")
+
+			status, err := client.Status(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.Errors).To(ConsistOf(
+				"failed computing pending changes of operating system config: fake",
+				"failed reading states of units: fake",
+			))
+		})
+
+		It("should return the dry run", func() {
+			Expect(client.DryRun(ctx)).To(Equal(reconciler.result))
+		})
+
+		It("should fail returning the dry run", func() {
+			reconciler.err = errors.New("fake")
+
+			_, err := client.DryRun(ctx)
+			Expect(err).To(MatchError("request failed with status code 500: failed computing changes of operating system config: fake"))
+		})
+
+		It("should trigger a reconciliation", func() {
+			Expect(client.TriggerReconciliation(ctx)).To(Succeed())
+			Expect(reconciler.triggered).To(Equal(1))
+		})
+
+		It("should fail triggering a reconciliation", func() {
+			reconciler.err = errors.New("fake")
+
+			Expect(client.TriggerReconciliation(ctx)).To(MatchError("request failed with status code 500: failed triggering reconciliation of operating system config: fake"))
+		})
+	})
+
+	It("should remove a stale socket when starting", func() {
+		cancel()
+		Eventually(serverDone).Should(Receive(BeNil()))
+
+		Expect(os.WriteFile(socketPath, nil, 0600)).To(Succeed())
+
+		ctx, cancel = context.WithCancel(context.Background())
+		go func() {
+			defer GinkgoRecover()
+			serverDone <- server.Start(ctx)
+		}()
+
+		Eventually(func() error {
+			_, err := client.Status(ctx)
+			return err
+		}).Should(Succeed())
+	})
+})
+
+type fakeOperatingSystemConfigReconciler struct {
+	result    *operatingsystemconfig.DryRunResult
+	units     []operatingsystemconfig.UnitState
+	err       error
+	triggered int
+}
+
+func (f *fakeOperatingSystemConfigReconciler) DryRun(_ context.Context) (*operatingsystemconfig.DryRunResult, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.result, nil
+}
+
+func (f *fakeOperatingSystemConfigReconciler) UnitStates(_ context.Context) ([]operatingsystemconfig.UnitState, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.units, nil
+}
+
+func (f *fakeOperatingSystemConfigReconciler) TriggerReconciliation() error {
+	if f.err != nil {
+		return f.err
+	}
+	f.triggered++
+	return nil
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debug
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+)
+
+const (
+	// PathStatus is the path of the endpoint which returns the Status.
+	PathStatus = "/status"
+	// PathOperatingSystemConfigDryRun is the path of the endpoint which returns the changes the next reconciliation of
+	// the operating system config would apply to the node.
+	PathOperatingSystemConfigDryRun = "/operatingsystemconfig/dry-run"
+	// PathOperatingSystemConfigReconcile is the path of the endpoint which triggers a reconciliation of the operating
+	// system config.
+	PathOperatingSystemConfigReconcile = "/operatingsystemconfig/reconcile"
+)
+
+// Status describes the state of gardener-node-agent and the node.
+type Status struct {
+	// OperatingSystemConfig contains the checksums of the applied and the desired operating system config and the
+	// changes which are pending for the desired one.
+	OperatingSystemConfig *operatingsystemconfig.DryRunResult `json:"operatingSystemConfig,omitempty"`
+	// Units are the states of the units of the applied operating system config.
+	Units []operatingsystemconfig.UnitState `json:"units,omitempty"`
+	// Controllers contains the status of the controllers whose reconcilers returned errors since gardener-node-agent
+	// was started.
+	Controllers map[string]ControllerStatus `json:"controllers,omitempty"`
+	// Tokens contains the status of the access tokens synced to the disk.
+	Tokens []TokenSyncStatus `json:"tokens,omitempty"`
+	// Errors contains the errors which occurred while collecting the status.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// ControllerStatus describes the errors returned by the reconciler of a controller.
+type ControllerStatus struct {
+	// Errors is the number of errors returned by the reconciler since gardener-node-agent was started.
+	Errors int `json:"errors"`
+	// LastError is the last error returned by the reconciler for an object which was not reconciled successfully since.
+	LastError *ReconcileError `json:"lastError,omitempty"`
+}
+
+// ReconcileError describes an error returned by a reconciler.
+type ReconcileError struct {
+	// Object is the key of the reconciled object.
+	Object string `json:"object"`
+	// Message is the message of the error.
+	Message string `json:"message"`
+	// Time is the time when the error was returned.
+	Time time.Time `json:"time"`
+}
+
+// TokenSyncStatus describes the status of an access token which is synced to the disk.
+type TokenSyncStatus struct {
+	// SecretName is the name of the secret containing the access token.
+	SecretName string `json:"secretName"`
+	// Path is the path of the file to which the access token is synced.
+	Path string `json:"path"`
+	// LastUpdateTime is the time when the file was last written. It is unset if the file does not exist.
+	LastUpdateTime *time.Time `json:"lastUpdateTime,omitempty"`
+	// LastError is the last error which occurred while syncing the access token. It is unset once the access token was
+	// synced successfully.
+	LastError *ReconcileError `json:"lastError,omitempty"`
+}
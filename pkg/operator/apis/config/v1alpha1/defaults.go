@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
@@ -23,6 +24,9 @@ func SetDefaults_OperatorConfiguration(obj *OperatorConfiguration) {
 	if obj.LogFormat == "" {
 		obj.LogFormat = logger.FormatJSON
 	}
+	if obj.HelmChartCache == nil {
+		obj.HelmChartCache = &HelmChartCacheConfiguration{}
+	}
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
@@ -126,3 +130,13 @@ func SetDefaults_ExtensionRequiredVirtualControllerConfiguration(obj *ExtensionR
 		obj.ConcurrentSyncs = ptr.To(5)
 	}
 }
+
+// SetDefaults_HelmChartCacheConfiguration sets defaults for the cache of Helm charts.
+func SetDefaults_HelmChartCacheConfiguration(obj *HelmChartCacheConfiguration) {
+	if obj.MaxSize == nil {
+		obj.MaxSize = ptr.To(resource.MustParse("128Mi"))
+	}
+	if obj.Directory != nil && obj.DirectoryMaxSize == nil {
+		obj.DirectoryMaxSize = ptr.To(resource.MustParse("1Gi"))
+	}
+}
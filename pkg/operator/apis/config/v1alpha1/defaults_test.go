@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
@@ -327,4 +328,31 @@ var _ = Describe("Defaults", func() {
 			})
 		})
 	})
+
+	Describe("HelmChartCacheConfiguration defaulting", func() {
+		It("should default the Helm chart cache configuration", func() {
+			SetObjectDefaults_OperatorConfiguration(obj)
+
+			Expect(obj.HelmChartCache.MaxSize).To(PointTo(Equal(resource.MustParse("128Mi"))))
+			Expect(obj.HelmChartCache.Directory).To(BeNil())
+			Expect(obj.HelmChartCache.DirectoryMaxSize).To(BeNil())
+			Expect(obj.HelmChartCache.TagTTL).To(BeNil())
+		})
+
+		It("should not overwrite already set values for the Helm chart cache configuration", func() {
+			obj.HelmChartCache = &HelmChartCacheConfiguration{MaxSize: ptr.To(resource.MustParse("1Gi"))}
+
+			SetObjectDefaults_OperatorConfiguration(obj)
+
+			Expect(obj.HelmChartCache.MaxSize).To(PointTo(Equal(resource.MustParse("1Gi"))))
+		})
+
+		It("should default the maximum directory size if a directory is set", func() {
+			obj.HelmChartCache = &HelmChartCacheConfiguration{Directory: ptr.To("/var/cache/gardener/charts")}
+
+			SetObjectDefaults_OperatorConfiguration(obj)
+
+			Expect(obj.HelmChartCache.DirectoryMaxSize).To(PointTo(Equal(resource.MustParse("1Gi"))))
+		})
+	})
 })
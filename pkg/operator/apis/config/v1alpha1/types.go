@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 
@@ -43,6 +44,9 @@ type OperatorConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeTolerationConfiguration `json:"nodeToleration,omitempty"`
+	// HelmChartCache contains optional settings for the cache of Helm charts pulled from OCI registries.
+	// +optional
+	HelmChartCache *HelmChartCacheConfiguration `json:"helmChartCache,omitempty"`
 }
 
 // ConditionThreshold defines the threshold of the given condition type.
@@ -176,6 +180,28 @@ type NodeTolerationConfiguration struct {
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
 
+// HelmChartCacheConfiguration contains settings for the cache of Helm charts pulled from OCI registries.
+type HelmChartCacheConfiguration struct {
+	// MaxSize is the maximum total size of the charts kept in memory. Once it is exceeded, the least recently used charts
+	// are evicted.
+	// Defaults to 128Mi.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+	// Directory is the path of an optional on-disk store for the charts. If set, all pulled charts are additionally stored
+	// in this directory, so that charts evicted from memory or pulled before a restart don't need to be pulled again.
+	// +optional
+	Directory *string `json:"directory,omitempty"`
+	// DirectoryMaxSize is the maximum total size of the charts kept in the directory. Once it is exceeded, the least
+	// recently used charts are removed from the directory.
+	// Defaults to 1Gi if a directory is set.
+	// +optional
+	DirectoryMaxSize *resource.Quantity `json:"directoryMaxSize,omitempty"`
+	// TagTTL is the duration for which the digest resolved for a tag is cached. Within this duration, the registry is not
+	// contacted for resolving the tag again. If not set, tags are resolved whenever a chart is pulled.
+	// +optional
+	TagTTL *metav1.Duration `json:"tagTTL,omitempty"`
+}
+
 const (
 	// DefaultLockObjectNamespace is the default lock namespace for leader election.
 	DefaultLockObjectNamespace = "garden"
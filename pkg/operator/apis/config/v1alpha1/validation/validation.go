@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"path/filepath"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -37,6 +38,7 @@ func ValidateOperatorConfiguration(conf *operatorconfigv1alpha1.OperatorConfigur
 
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	allErrs = append(allErrs, validateNodeTolerationConfiguration(conf.NodeToleration, field.NewPath("nodeToleration"))...)
+	allErrs = append(allErrs, validateHelmChartCacheConfiguration(conf.HelmChartCache, field.NewPath("helmChartCache"))...)
 
 	return allErrs
 }
@@ -122,3 +124,26 @@ func validateNodeTolerationConfiguration(conf *operatorconfigv1alpha1.NodeTolera
 
 	return allErrs
 }
+
+func validateHelmChartCacheConfiguration(conf *operatorconfigv1alpha1.HelmChartCacheConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if conf.MaxSize != nil && conf.MaxSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSize"), conf.MaxSize.String(), "must be positive"))
+	}
+	if conf.DirectoryMaxSize != nil && conf.DirectoryMaxSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("directoryMaxSize"), conf.DirectoryMaxSize.String(), "must be positive"))
+	}
+	if conf.Directory != nil && !filepath.IsAbs(*conf.Directory) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("directory"), *conf.Directory, "must be an absolute path"))
+	}
+	if conf.TagTTL != nil && conf.TagTTL.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("tagTTL"), conf.TagTTL.Duration.String(), "must not be negative"))
+	}
+
+	return allErrs
+}
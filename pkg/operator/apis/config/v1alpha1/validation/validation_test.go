@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -292,4 +293,45 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 			)
 		})
 	})
+
+	Context("helmChartCache", func() {
+		It("should pass with valid options", func() {
+			conf.HelmChartCache = &operatorconfigv1alpha1.HelmChartCacheConfiguration{
+				MaxSize:          ptr.To(resource.MustParse("256Mi")),
+				Directory:        ptr.To("/var/cache/charts"),
+				DirectoryMaxSize: ptr.To(resource.MustParse("1Gi")),
+				TagTTL:           &metav1.Duration{Duration: 5 * time.Minute},
+			}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail with invalid options", func() {
+			conf.HelmChartCache = &operatorconfigv1alpha1.HelmChartCacheConfiguration{
+				MaxSize:          ptr.To(resource.MustParse("0")),
+				Directory:        ptr.To("charts"),
+				DirectoryMaxSize: ptr.To(resource.MustParse("-1Gi")),
+				TagTTL:           &metav1.Duration{Duration: -time.Minute},
+			}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("helmChartCache.maxSize"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("helmChartCache.directory"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("helmChartCache.directoryMaxSize"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("helmChartCache.tagTTL"),
+				})),
+			))
+		})
+	})
 })
@@ -198,6 +198,42 @@ func (in *GardenletDeployerControllerConfig) DeepCopy() *GardenletDeployerContro
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartCacheConfiguration) DeepCopyInto(out *HelmChartCacheConfiguration) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Directory != nil {
+		in, out := &in.Directory, &out.Directory
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryMaxSize != nil {
+		in, out := &in.DirectoryMaxSize, &out.DirectoryMaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TagTTL != nil {
+		in, out := &in.TagTTL, &out.TagTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartCacheConfiguration.
+func (in *HelmChartCacheConfiguration) DeepCopy() *HelmChartCacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartCacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicyControllerConfiguration) DeepCopyInto(out *NetworkPolicyControllerConfiguration) {
 	*out = *in
@@ -278,6 +314,11 @@ func (in *OperatorConfiguration) DeepCopyInto(out *OperatorConfiguration) {
 		*out = new(NodeTolerationConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HelmChartCache != nil {
+		in, out := &in.HelmChartCache, &out.HelmChartCache
+		*out = new(HelmChartCacheConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
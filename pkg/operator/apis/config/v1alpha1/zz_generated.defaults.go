@@ -33,4 +33,7 @@ func SetObjectDefaults_OperatorConfiguration(in *OperatorConfiguration) {
 	SetDefaults_ExtensionControllerConfiguration(&in.Controllers.Extension)
 	SetDefaults_ExtensionRequiredRuntimeControllerConfiguration(&in.Controllers.ExtensionRequiredRuntime)
 	SetDefaults_ExtensionRequiredVirtualControllerConfiguration(&in.Controllers.ExtensionRequiredVirtual)
+	if in.HelmChartCache != nil {
+		SetDefaults_HelmChartCacheConfiguration(in.HelmChartCache)
+	}
 }
@@ -58,7 +58,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, virt
 	}
 	if r.HelmRegistry == nil {
 		var err error
-		r.HelmRegistry, err = oci.NewHelmRegistry()
+		r.HelmRegistry, err = oci.NewHelmRegistry(mgr.GetAPIReader())
 		if err != nil {
 			return fmt.Errorf("failed creating new Helm registry: %w", err)
 		}
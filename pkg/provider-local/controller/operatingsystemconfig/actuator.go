@@ -28,18 +28,18 @@ func NewActuator(mgr manager.Manager) operatingsystemconfig.Actuator {
 	}
 }
 
-func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, *extensionsv1alpha1.InPlaceUpdatesStatus, error) {
 	switch purpose := osc.Spec.Purpose; purpose {
 	case extensionsv1alpha1.OperatingSystemConfigPurposeProvision:
 		userData, err := a.handleProvisionOSC(ctx, osc)
-		return []byte(userData), nil, nil, err
+		return []byte(userData), nil, nil, nil, err
 
 	case extensionsv1alpha1.OperatingSystemConfigPurposeReconcile:
 		extensionUnits, extensionFiles, err := a.handleReconcileOSC(osc)
-		return nil, extensionUnits, extensionFiles, err
+		return nil, extensionUnits, extensionFiles, handleInPlaceUpdates(osc), err
 
 	default:
-		return nil, nil, nil, fmt.Errorf("unknown purpose: %s", purpose)
+		return nil, nil, nil, nil, fmt.Errorf("unknown purpose: %s", purpose)
 	}
 }
 
@@ -55,7 +55,7 @@ func (a *actuator) ForceDelete(ctx context.Context, log logr.Logger, osc *extens
 	return a.Delete(ctx, log, osc)
 }
 
-func (a *actuator) Restore(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+func (a *actuator) Restore(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, *extensionsv1alpha1.InPlaceUpdatesStatus, error) {
 	return a.Reconcile(ctx, log, osc)
 }
 
@@ -83,3 +83,17 @@ func (a *actuator) handleReconcileOSC(_ *extensionsv1alpha1.OperatingSystemConfi
 	// provider-local does not add any additional units or additional files
 	return nil, nil, nil
 }
+
+func handleInPlaceUpdates(osc *extensionsv1alpha1.OperatingSystemConfig) *extensionsv1alpha1.InPlaceUpdatesStatus {
+	if osc.Spec.InPlaceUpdates == nil {
+		return nil
+	}
+
+	// provider-local does not ship real operating system images, hence the update command only records the new version
+	return &extensionsv1alpha1.InPlaceUpdatesStatus{
+		OSUpdate: &extensionsv1alpha1.OSUpdate{
+			Command: "/bin/sh",
+			Args:    []string{"-c", fmt.Sprintf("echo %q > /etc/gardener-local-os-version", osc.Spec.InPlaceUpdates.OperatingSystemVersion)},
+		},
+	}
+}
@@ -143,6 +143,30 @@ type HealthControllerConfig struct {
 	// SyncPeriod is the duration how often the controller performs its reconciliation.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Rules is a list of generic health rules for resource kinds, e.g., custom resources which have no dedicated health
+	// check. For kinds with a dedicated health check, the rule is evaluated in addition.
+	// +optional
+	Rules []HealthRule `json:"rules,omitempty"`
+}
+
+// HealthRule configures how the health of objects of a certain kind is determined.
+type HealthRule struct {
+	// Group is the API group of the kind. It is empty for the core API group.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the objects.
+	Kind string `json:"kind"`
+	// Healthy is a CEL expression which must evaluate to true if the object is healthy. The object is available as
+	// 'object' variable. If not set, the object is healthy if its status is up-to-date (the observed generation matches
+	// the generation), its 'Stalled' condition is not 'True', and its 'Ready' (or, if not present, 'Available')
+	// condition is 'True'.
+	// +optional
+	Healthy *string `json:"healthy,omitempty"`
+	// Progressing is a CEL expression which must evaluate to true if the object is progressing. The object is available
+	// as 'object' variable. If not set, the object is progressing if its status is outdated (the observed generation does
+	// not match the generation) or its 'Reconciling' condition is 'True'.
+	// +optional
+	Progressing *string `json:"progressing,omitempty"`
 }
 
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
@@ -9,6 +9,7 @@ import (
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
@@ -100,6 +101,7 @@ func validateResourceManagerControllerConfiguration(conf resourcemanagerconfigv1
 
 	allErrs = append(allErrs, validateConcurrentSyncs(conf.Health.ConcurrentSyncs, fldPath.Child("health"))...)
 	allErrs = append(allErrs, validateSyncPeriod(conf.Health.SyncPeriod, fldPath.Child("health"))...)
+	allErrs = append(allErrs, validateHealthRules(conf.Health.Rules, fldPath.Child("health", "rules"))...)
 
 	allErrs = append(allErrs, validateManagedResourceControllerConfiguration(conf.ManagedResource, fldPath.Child("managedResources"))...)
 
@@ -114,6 +116,35 @@ func validateResourceManagerControllerConfiguration(conf resourcemanagerconfigv1
 	return allErrs
 }
 
+func validateHealthRules(rules []resourcemanagerconfigv1alpha1.HealthRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	groupKinds := sets.New[schema.GroupKind]()
+
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+
+		if len(rule.Kind) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("kind"), "must provide a kind"))
+		}
+
+		groupKind := schema.GroupKind{Group: rule.Group, Kind: rule.Kind}
+		if groupKinds.Has(groupKind) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, groupKind.String()))
+		}
+		groupKinds.Insert(groupKind)
+
+		if rule.Healthy != nil && len(*rule.Healthy) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("healthy"), *rule.Healthy, "expression must not be empty"))
+		}
+
+		if rule.Progressing != nil && len(*rule.Progressing) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("progressing"), *rule.Progressing, "expression must not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateManagedResourceControllerConfiguration(conf resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -303,6 +303,42 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+
+				It("should succeed for valid health rules", func() {
+					conf.Controllers.Health.Rules = []resourcemanagerconfigv1alpha1.HealthRule{
+						{Group: "example.com", Kind: "Foo"},
+						{Group: "example.com", Kind: "Bar", Healthy: ptr.To("object.status.ready"), Progressing: ptr.To("false")},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return errors for invalid health rules", func() {
+					conf.Controllers.Health.Rules = []resourcemanagerconfigv1alpha1.HealthRule{
+						{Group: "example.com"},
+						{Group: "example.com", Kind: "Foo", Healthy: ptr.To("")},
+						{Group: "example.com", Kind: "Foo", Progressing: ptr.To("")},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.health.rules[0].kind"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.health.rules[1].healthy"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("controllers.health.rules[2]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.health.rules[2].progressing"),
+						})),
+					))
+				})
 			})
 
 			Context("managed resources", func() {
@@ -48,6 +48,13 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.HealthChecker == nil {
+		healthChecker, err := utils.NewHealthChecker(r.TargetScheme, r.Config.Rules)
+		if err != nil {
+			return fmt.Errorf("failed creating health checker: %w", err)
+		}
+		r.HealthChecker = healthChecker
+	}
 
 	c, err := builder.
 		ControllerManagedBy(mgr).
@@ -100,7 +107,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 			targetCluster.GetCache(),
 			obj,
 			handler.EnqueueRequestsFromMapFunc(utils.MapToOriginManagedResource(c.GetLogger(), clusterID)),
-			utils.HealthStatusChanged(c.GetLogger()),
+			utils.HealthStatusChanged(c.GetLogger(), r.HealthChecker),
 		)); err != nil {
 			return fmt.Errorf("error starting watch for GVK %s: %w", gvk.String(), err)
 		}
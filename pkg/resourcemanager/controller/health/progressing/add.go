@@ -6,6 +6,7 @@ package progressing
 
 import (
 	"context"
+	"fmt"
 
 	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
 	"github.com/go-logr/logr"
@@ -49,6 +50,13 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.HealthChecker == nil {
+		healthChecker, err := utils.NewHealthChecker(targetCluster.GetScheme(), r.Config.Rules)
+		if err != nil {
+			return fmt.Errorf("failed creating health checker: %w", err)
+		}
+		r.HealthChecker = healthChecker
+	}
 
 	c, err := builder.
 		ControllerManagedBy(mgr).
@@ -15,6 +15,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +39,8 @@ type Reconciler struct {
 	Config       resourcemanagerconfigv1alpha1.HealthControllerConfig
 	Clock        clock.Clock
 	ClassFilter  *resourcemanagerpredicate.ClassFilter
+	// HealthChecker evaluates the configured health rules in addition to the dedicated progressing checks.
+	HealthChecker *utils.HealthChecker
 }
 
 // Reconcile performs the progressing checks.
@@ -96,8 +99,10 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	conditionResourcesProgressing := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesProgressing)
 
 	for _, ref := range mr.Status.Resources {
+		hasRule := r.HealthChecker.HasRule(ref.GroupVersionKind().GroupKind())
+
 		// Skip API groups that are irrelevant for progressing checks.
-		if !sets.New(appsv1.GroupName, monitoring.GroupName, certv1alpha1.GroupName).Has(ref.GroupVersionKind().Group) {
+		if !hasRule && !sets.New(appsv1.GroupName, monitoring.GroupName, certv1alpha1.GroupName).Has(ref.GroupVersionKind().Group) {
 			continue
 		}
 
@@ -119,7 +124,13 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		case "Issuer":
 			obj = &certv1alpha1.Issuer{}
 		default:
-			continue
+			if !hasRule {
+				continue
+			}
+
+			u := &unstructured.Unstructured{}
+			u.SetGroupVersionKind(ref.GroupVersionKind())
+			obj = u
 		}
 
 		var (
@@ -225,5 +236,9 @@ func (r *Reconciler) checkProgressing(ctx context.Context, obj client.Object) (b
 		progressing, reason = health.IsCertificateIssuerProgressing(o)
 	}
 
-	return progressing, reason, nil
+	if progressing {
+		return progressing, reason, nil
+	}
+
+	return r.HealthChecker.CheckProgressing(obj)
 }
@@ -31,7 +31,7 @@ var _ = Describe("HealthStatusChanged", func() {
 
 	BeforeEach(func() {
 		log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(GinkgoWriter))
-		p = HealthStatusChanged(log)
+		p = HealthStatusChanged(log, nil)
 	})
 
 	Context("metadata-only events", func() {
@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+)
+
+// HealthChecker checks the health of objects. In addition to the dedicated health checks for well-known kinds (see
+// CheckHealth), it evaluates the configured generic health rules.
+type HealthChecker struct {
+	scheme *runtime.Scheme
+	rules  map[schema.GroupKind]healthRule
+}
+
+type healthRule struct {
+	healthy     cel.Program
+	progressing cel.Program
+}
+
+// NewHealthChecker compiles the given health rules and returns a new HealthChecker. The given scheme is used to determine
+// the kind of typed objects.
+func NewHealthChecker(scheme *runtime.Scheme, rules []resourcemanagerconfigv1alpha1.HealthRule) (*HealthChecker, error) {
+	env, err := cel.NewEnv(cel.Variable("object", cel.DynType))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CEL environment: %w", err)
+	}
+
+	h := &HealthChecker{scheme: scheme, rules: make(map[schema.GroupKind]healthRule, len(rules))}
+
+	for _, rule := range rules {
+		var (
+			groupKind = schema.GroupKind{Group: rule.Group, Kind: rule.Kind}
+			compiled  healthRule
+		)
+
+		if rule.Healthy != nil {
+			if compiled.healthy, err = compileExpression(env, *rule.Healthy); err != nil {
+				return nil, fmt.Errorf("failed compiling healthy expression for %s: %w", groupKind, err)
+			}
+		}
+
+		if rule.Progressing != nil {
+			if compiled.progressing, err = compileExpression(env, *rule.Progressing); err != nil {
+				return nil, fmt.Errorf("failed compiling progressing expression for %s: %w", groupKind, err)
+			}
+		}
+
+		h.rules[groupKind] = compiled
+	}
+
+	return h, nil
+}
+
+func compileExpression(env *cel.Env, expression string) (cel.Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if outputType := ast.OutputType(); outputType != cel.BoolType && outputType != cel.DynType {
+		return nil, fmt.Errorf("expression must return a bool but returns %s", outputType)
+	}
+
+	return env.Program(ast)
+}
+
+// HasRule returns whether a health rule is configured for the given GroupKind.
+func (h *HealthChecker) HasRule(groupKind schema.GroupKind) bool {
+	if h == nil {
+		return false
+	}
+
+	_, ok := h.rules[groupKind]
+	return ok
+}
+
+// CheckHealth checks whether the given object is healthy.
+// It returns a bool indicating whether the object was actually checked and an error if any health check failed.
+func (h *HealthChecker) CheckHealth(obj client.Object) (bool, error) {
+	checked, err := CheckHealth(obj)
+	if err != nil || obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
+		return checked, err
+	}
+
+	rule, ok := h.ruleFor(obj)
+	if !ok {
+		return checked, nil
+	}
+
+	content, err := toUnstructured(obj)
+	if err != nil {
+		return false, err
+	}
+
+	if rule.healthy == nil {
+		return true, checkStatusConventionsHealthy(obj.GetGeneration(), content)
+	}
+
+	healthy, err := evaluate(rule.healthy, content)
+	if err != nil {
+		return false, fmt.Errorf("failed evaluating healthy expression: %w", err)
+	}
+
+	if !healthy {
+		return true, errors.New("healthy expression evaluated to false")
+	}
+
+	return true, nil
+}
+
+// CheckProgressing checks whether the given object is progressing according to the configured health rules. It returns
+// a bool indicating whether the object is progressing, a reason for it if so and an error if the check failed.
+func (h *HealthChecker) CheckProgressing(obj client.Object) (bool, string, error) {
+	if obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
+		return false, "", nil
+	}
+
+	rule, ok := h.ruleFor(obj)
+	if !ok {
+		return false, "", nil
+	}
+
+	content, err := toUnstructured(obj)
+	if err != nil {
+		return false, "", err
+	}
+
+	if rule.progressing == nil {
+		progressing, reason := isStatusConventionsProgressing(obj.GetGeneration(), content)
+		return progressing, reason, nil
+	}
+
+	progressing, err := evaluate(rule.progressing, content)
+	if err != nil {
+		return false, "", fmt.Errorf("failed evaluating progressing expression: %w", err)
+	}
+
+	if progressing {
+		return true, "progressing expression evaluated to true", nil
+	}
+
+	return false, "", nil
+}
+
+func (h *HealthChecker) ruleFor(obj client.Object) (healthRule, bool) {
+	if h == nil {
+		return healthRule{}, false
+	}
+
+	// Typed objects read via the client do not have their TypeMeta set.
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() && h.scheme != nil {
+		var err error
+		if gvk, err = apiutil.GVKForObject(obj, h.scheme); err != nil {
+			return healthRule{}, false
+		}
+	}
+
+	rule, ok := h.rules[gvk.GroupKind()]
+	return rule, ok
+}
+
+func toUnstructured(obj client.Object) (map[string]any, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u.UnstructuredContent(), nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed converting object to unstructured: %w", err)
+	}
+	return content, nil
+}
+
+func evaluate(program cel.Program, content map[string]any) (bool, error) {
+	result, _, err := program.Eval(map[string]any{"object": content})
+	if err != nil {
+		return false, err
+	}
+
+	value, ok := result.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned %T instead of bool", result.Value())
+	}
+	return value, nil
+}
+
+// checkStatusConventionsHealthy checks the health of an object based on the conventions for the status of Kubernetes
+// objects (also used by kstatus).
+func checkStatusConventionsHealthy(generation int64, content map[string]any) error {
+	if outdated, message := isObservedGenerationOutdated(generation, content); outdated {
+		return errors.New(message)
+	}
+
+	conditions := statusConditions(content)
+
+	if condition, ok := conditions["Stalled"]; ok && condition.status == "True" {
+		return fmt.Errorf("condition %q is True: %s", "Stalled", condition.message)
+	}
+
+	for _, conditionType := range []string{"Ready", "Available"} {
+		if condition, ok := conditions[conditionType]; ok {
+			if condition.status != "True" {
+				return fmt.Errorf("condition %q has status %q: %s", conditionType, condition.status, condition.message)
+			}
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// isStatusConventionsProgressing checks whether an object is progressing based on the conventions for the status of
+// Kubernetes objects (also used by kstatus).
+func isStatusConventionsProgressing(generation int64, content map[string]any) (bool, string) {
+	if outdated, message := isObservedGenerationOutdated(generation, content); outdated {
+		return true, message
+	}
+
+	if condition, ok := statusConditions(content)["Reconciling"]; ok && condition.status == "True" {
+		return true, fmt.Sprintf("condition %q is True: %s", "Reconciling", condition.message)
+	}
+
+	return false, ""
+}
+
+func isObservedGenerationOutdated(generation int64, content map[string]any) (bool, string) {
+	observedGeneration, found, err := unstructured.NestedInt64(content, "status", "observedGeneration")
+	if err != nil || !found {
+		return false, ""
+	}
+
+	if observedGeneration < generation {
+		return true, fmt.Sprintf("observed generation outdated (%d/%d)", observedGeneration, generation)
+	}
+	return false, ""
+}
+
+type condition struct {
+	status  string
+	message string
+}
+
+func statusConditions(content map[string]any) map[string]condition {
+	conditions := make(map[string]condition)
+
+	items, found, err := unstructured.NestedSlice(content, "status", "conditions")
+	if err != nil || !found {
+		return conditions
+	}
+
+	for _, item := range items {
+		c, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		conditionType, _, _ := unstructured.NestedString(c, "type")
+		status, _, _ := unstructured.NestedString(c, "status")
+		message, _, _ := unstructured.NestedString(c, "message")
+		conditions[conditionType] = condition{status: status, message: message}
+	}
+
+	return conditions
+}
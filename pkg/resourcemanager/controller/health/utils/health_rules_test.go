@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+)
+
+var _ = Describe("HealthChecker", func() {
+	var (
+		fooGVK = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Foo"}
+		barGVK = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Bar"}
+
+		healthChecker *HealthChecker
+	)
+
+	newObject := func(gvk schema.GroupVersionKind, generation int64, status map[string]any) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]any{}}
+		obj.SetGroupVersionKind(gvk)
+		obj.SetGeneration(generation)
+		if status != nil {
+			obj.Object["status"] = status
+		}
+		return obj
+	}
+
+	condition := func(conditionType, status string) map[string]any {
+		return map[string]any{"type": conditionType, "status": status, "message": "some message"}
+	}
+
+	BeforeEach(func() {
+		var err error
+		healthChecker, err = NewHealthChecker(kubernetesscheme.Scheme, []resourcemanagerconfigv1alpha1.HealthRule{
+			{Group: "example.com", Kind: "Foo"},
+			{Group: "example.com", Kind: "Bar", Healthy: ptr.To("object.status.phase == 'Running'"), Progressing: ptr.To("object.status.phase == 'Pending'")},
+			{Group: "", Kind: "ConfigMap", Healthy: ptr.To("object.data.healthy == 'true'")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#NewHealthChecker", func() {
+		It("should fail for an invalid expression", func() {
+			_, err := NewHealthChecker(kubernetesscheme.Scheme, []resourcemanagerconfigv1alpha1.HealthRule{{Kind: "Foo", Healthy: ptr.To("object.status.")}})
+			Expect(err).To(MatchError(ContainSubstring("failed compiling healthy expression for Foo")))
+		})
+
+		It("should fail for an expression not returning a bool", func() {
+			_, err := NewHealthChecker(kubernetesscheme.Scheme, []resourcemanagerconfigv1alpha1.HealthRule{{Kind: "Foo", Progressing: ptr.To("'foo'")}})
+			Expect(err).To(MatchError(ContainSubstring("expression must return a bool")))
+		})
+	})
+
+	Describe("#HasRule", func() {
+		It("should return whether a rule is configured", func() {
+			Expect(healthChecker.HasRule(fooGVK.GroupKind())).To(BeTrue())
+			Expect(healthChecker.HasRule(schema.GroupKind{Group: "example.com", Kind: "Baz"})).To(BeFalse())
+		})
+
+		It("should return false for a nil checker", func() {
+			Expect((*HealthChecker)(nil).HasRule(fooGVK.GroupKind())).To(BeFalse())
+		})
+	})
+
+	Describe("#CheckHealth", func() {
+		Context("status conventions", func() {
+			It("should consider an object without status healthy", func() {
+				checked, err := healthChecker.CheckHealth(newObject(fooGVK, 1, nil))
+				Expect(checked).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should consider an object with outdated observed generation unhealthy", func() {
+				checked, err := healthChecker.CheckHealth(newObject(fooGVK, 2, map[string]any{"observedGeneration": int64(1)}))
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError("observed generation outdated (1/2)"))
+			})
+
+			It("should consider a stalled object unhealthy", func() {
+				checked, err := healthChecker.CheckHealth(newObject(fooGVK, 1, map[string]any{"conditions": []any{condition("Stalled", "True"), condition("Ready", "True")}}))
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring(`condition "Stalled" is True`)))
+			})
+
+			It("should consider an object with Ready=False unhealthy", func() {
+				checked, err := healthChecker.CheckHealth(newObject(fooGVK, 1, map[string]any{"conditions": []any{condition("Ready", "False"), condition("Available", "True")}}))
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring(`condition "Ready" has status "False"`)))
+			})
+
+			It("should consider an object with Available=False unhealthy", func() {
+				checked, err := healthChecker.CheckHealth(newObject(fooGVK, 1, map[string]any{"conditions": []any{condition("Available", "Unknown")}}))
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring(`condition "Available" has status "Unknown"`)))
+			})
+
+			It("should consider an up-to-date and ready object healthy", func() {
+				checked, err := healthChecker.CheckHealth(newObject(fooGVK, 2, map[string]any{"observedGeneration": int64(2), "conditions": []any{condition("Ready", "True")}}))
+				Expect(checked).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("CEL expressions", func() {
+			It("should consider the object healthy if the expression evaluates to true", func() {
+				checked, err := healthChecker.CheckHealth(newObject(barGVK, 1, map[string]any{"phase": "Running"}))
+				Expect(checked).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should consider the object unhealthy if the expression evaluates to false", func() {
+				checked, err := healthChecker.CheckHealth(newObject(barGVK, 1, map[string]any{"phase": "Failed"}))
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError("healthy expression evaluated to false"))
+			})
+
+			It("should return an error if the expression cannot be evaluated", func() {
+				checked, err := healthChecker.CheckHealth(newObject(barGVK, 1, nil))
+				Expect(checked).To(BeFalse())
+				Expect(err).To(MatchError(ContainSubstring("failed evaluating healthy expression")))
+			})
+
+			It("should evaluate the expression for typed objects", func() {
+				configMap := &corev1.ConfigMap{Data: map[string]string{"healthy": "true"}}
+				checked, err := healthChecker.CheckHealth(configMap)
+				Expect(checked).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+
+				configMap.Data["healthy"] = "false"
+				checked, err = healthChecker.CheckHealth(configMap)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError("healthy expression evaluated to false"))
+			})
+		})
+
+		It("should not check objects without rule", func() {
+			checked, err := healthChecker.CheckHealth(newObject(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Baz"}, 1, nil))
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not check objects with skip-health-check annotation", func() {
+			obj := newObject(barGVK, 1, map[string]any{"phase": "Failed"})
+			obj.SetAnnotations(map[string]string{"resources.gardener.cloud/skip-health-check": "true"})
+
+			checked, err := healthChecker.CheckHealth(obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should still perform the dedicated health checks", func() {
+			deployment := &appsv1.Deployment{Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{
+				Type:   appsv1.DeploymentAvailable,
+				Status: corev1.ConditionFalse,
+			}}}}
+
+			checked, err := healthChecker.CheckHealth(deployment)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should only perform the dedicated health checks for a nil checker", func() {
+			checked, err := (*HealthChecker)(nil).CheckHealth(newObject(barGVK, 1, map[string]any{"phase": "Failed"}))
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("#CheckProgressing", func() {
+		It("should consider an object with outdated observed generation progressing", func() {
+			progressing, reason, err := healthChecker.CheckProgressing(newObject(fooGVK, 2, map[string]any{"observedGeneration": int64(1)}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeTrue())
+			Expect(reason).To(Equal("observed generation outdated (1/2)"))
+		})
+
+		It("should consider a reconciling object progressing", func() {
+			progressing, reason, err := healthChecker.CheckProgressing(newObject(fooGVK, 1, map[string]any{"conditions": []any{condition("Reconciling", "True")}}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeTrue())
+			Expect(reason).To(ContainSubstring(`condition "Reconciling" is True`))
+		})
+
+		It("should not consider an up-to-date object progressing", func() {
+			progressing, _, err := healthChecker.CheckProgressing(newObject(fooGVK, 1, map[string]any{"observedGeneration": int64(1), "conditions": []any{condition("Reconciling", "False")}}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeFalse())
+		})
+
+		It("should evaluate the CEL expression", func() {
+			progressing, reason, err := healthChecker.CheckProgressing(newObject(barGVK, 1, map[string]any{"phase": "Pending"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeTrue())
+			Expect(reason).To(Equal("progressing expression evaluated to true"))
+
+			progressing, _, err = healthChecker.CheckProgressing(newObject(barGVK, 1, map[string]any{"phase": "Running"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeFalse())
+		})
+
+		It("should not consider objects without rule progressing", func() {
+			progressing, _, err := healthChecker.CheckProgressing(&appsv1.Deployment{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeFalse())
+		})
+	})
+})
@@ -137,6 +137,8 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 
 		forceOverwriteLabels      bool
 		forceOverwriteAnnotations bool
+		serverSideApply           = ptr.Deref(mr.Spec.ApplyMode, resourcesv1alpha1.ApplyModeUpdate) == resourcesv1alpha1.ApplyModeServerSideApply
+		forceConflicts            = ptr.Deref(mr.Spec.ForceConflicts, false)
 
 		decodingErrors []*decodingError
 
@@ -244,6 +246,8 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 						obj:                       obj,
 						forceOverwriteLabels:      forceOverwriteLabels,
 						forceOverwriteAnnotations: forceOverwriteAnnotations,
+						serverSideApply:           serverSideApply,
+						forceConflicts:            forceConflicts,
 					}
 					objectReference = resourcesv1alpha1.ObjectReference{
 						ObjectReference: corev1.ObjectReference{
@@ -298,7 +302,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		reason := resourcesv1alpha1.ConditionApplyProgressing
 		msg := "The resources are currently being reconciled."
 		switch conditionResourcesApplied.Reason {
-		case resourcesv1alpha1.ConditionApplyFailed, resourcesv1alpha1.ConditionDeletionFailed, resourcesv1alpha1.ConditionDeletionPending:
+		case resourcesv1alpha1.ConditionApplyFailed, resourcesv1alpha1.ConditionApplyConflict, resourcesv1alpha1.ConditionDeletionFailed, resourcesv1alpha1.ConditionDeletionPending:
 			// keep condition reason and message if last reconciliation failed
 			reason = conditionResourcesApplied.Reason
 			msg = conditionResourcesApplied.Message
@@ -348,7 +352,15 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 
 	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
 	if err := r.applyNewResources(reconcileCtx, log, origin, newResourcesObjects, injectLabels, equivalences); err != nil {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
+		var (
+			reason      = resourcesv1alpha1.ConditionApplyFailed
+			conflictErr *applyConflictError
+		)
+		if errors.As(err, &conflictErr) {
+			reason = resourcesv1alpha1.ConditionApplyConflict
+		}
+
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, reason, err.Error())
 		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
 			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
 		}
@@ -487,28 +499,36 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 
 		resourceLogger.V(1).Info("Applying")
 
-		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
-			metadata, err := meta.Accessor(obj.obj)
-			if err != nil {
-				return fmt.Errorf("error getting metadata of object %q: %s", resource, err)
-			}
+		var operationResult controllerutil.OperationResult
+		if obj.serverSideApply && !ignore(obj.obj) {
+			operationResult, err = r.applyServerSide(ctx, origin, obj, labelsToInject, scaledHorizontally)
+		} else {
+			operationResult, err = controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
+				metadata, err := meta.Accessor(obj.obj)
+				if err != nil {
+					return fmt.Errorf("error getting metadata of object %q: %s", resource, err)
+				}
 
-			// if the ignore annotation is set to false, do nothing (ignore the resource)
-			if ignore(metadata) {
-				annotations := current.GetAnnotations()
-				delete(annotations, descriptionAnnotation)
-				current.SetAnnotations(annotations)
-				return nil
-			}
+				// if the ignore annotation is set to false, do nothing (ignore the resource)
+				if ignore(metadata) {
+					annotations := current.GetAnnotations()
+					delete(annotations, descriptionAnnotation)
+					current.SetAnnotations(annotations)
+					return nil
+				}
 
-			if err := injectLabels(obj.obj, labelsToInject); err != nil {
-				return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
-			}
+				if err := injectLabels(obj.obj, labelsToInject); err != nil {
+					return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
+				}
 
-			return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
-		})
+				return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
+			})
+		}
 		if err != nil {
 			if apierrors.IsConflict(err) {
+				if obj.serverSideApply {
+					return &applyConflictError{resource: resource, err: err}
+				}
 				return err
 			}
 
@@ -983,6 +1003,8 @@ type object struct {
 	oldInformation            resourcesv1alpha1.ObjectReference
 	forceOverwriteLabels      bool
 	forceOverwriteAnnotations bool
+	serverSideApply           bool
+	forceConflicts            bool
 }
 
 type decodingError struct {
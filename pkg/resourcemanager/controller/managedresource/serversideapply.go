@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// fieldManagerPrefix is the prefix of the field managers used for applying objects with server-side apply.
+	fieldManagerPrefix = "gardener-resource-manager:"
+	// fieldManagerMaxLength is the maximum length of field managers accepted by the API server.
+	fieldManagerMaxLength = 128
+)
+
+// fieldManagerForOrigin returns the field manager used for applying the objects of the ManagedResource with the given
+// origin with server-side apply. This way, each ManagedResource only owns the fields of the objects it applies.
+func fieldManagerForOrigin(origin string) string {
+	fieldManager := fieldManagerPrefix + origin
+	if len(fieldManager) <= fieldManagerMaxLength {
+		return fieldManager
+	}
+
+	// Shorten too long field managers but keep them unique by appending a hash of the origin.
+	hash := utils.ComputeSHA256Hex([]byte(origin))[:16]
+	return fieldManager[:fieldManagerMaxLength-len(hash)-1] + "-" + hash
+}
+
+// applyConflictError is returned if applying an object with server-side apply failed because of conflicts with other
+// field managers.
+type applyConflictError struct {
+	resource string
+	err      error
+}
+
+func (e *applyConflictError) Error() string {
+	return fmt.Sprintf("conflicts during server-side apply of object %q, set .spec.forceConflicts=true to take over the conflicting fields: %s", e.resource, e.err)
+}
+
+func (e *applyConflictError) Unwrap() error {
+	return e.err
+}
+
+// applyServerSide applies the given object with server-side apply. Fields which should be preserved (e.g. replicas of
+// horizontally scaled objects) are taken over from the current object, so that they neither conflict with nor are
+// overwritten for other field managers.
+func (r *Reconciler) applyServerSide(ctx context.Context, origin string, obj object, labelsToInject map[string]string, preserveReplicas bool) (controllerutil.OperationResult, error) {
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(obj.obj.GroupVersionKind())
+	if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(obj.obj), current); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return controllerutil.OperationResultNone, err
+		}
+		current = nil
+	}
+
+	desired, err := desiredObjectForServerSideApply(origin, obj.obj, current, labelsToInject, preserveReplicas)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	opts := []client.PatchOption{client.FieldOwner(fieldManagerForOrigin(origin))}
+	if obj.forceConflicts {
+		opts = append(opts, client.ForceOwnership)
+	}
+
+	result := controllerutil.OperationResultCreated
+	if current != nil {
+		result = controllerutil.OperationResultUpdated
+	}
+
+	if err := r.TargetClient.Patch(ctx, desired, client.Apply, opts...); err != nil {
+		return result, err
+	}
+
+	if current != nil && current.GetResourceVersion() == desired.GetResourceVersion() {
+		result = controllerutil.OperationResultNone
+	}
+	return result, nil
+}
+
+// desiredObjectForServerSideApply computes the object which is applied with server-side apply based on the object
+// contained in the ManagedResource and the current object in the target cluster (nil if it does not exist yet).
+func desiredObjectForServerSideApply(origin string, obj, current *unstructured.Unstructured, labelsToInject map[string]string, preserveReplicas bool) (*unstructured.Unstructured, error) {
+	desired := obj.DeepCopy()
+
+	if err := injectLabels(desired, labelsToInject); err != nil {
+		return nil, fmt.Errorf("error injecting labels: %w", err)
+	}
+
+	annotations := desired.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[descriptionAnnotation] = descriptionAnnotationText
+	annotations[resourcesv1alpha1.OriginAnnotation] = origin
+	desired.SetAnnotations(annotations)
+
+	// The applied configuration must neither contain server-managed metadata nor the status.
+	desired.SetResourceVersion("")
+	desired.SetManagedFields(nil)
+	delete(desired.Object, "status")
+
+	if current == nil {
+		return desired, nil
+	}
+
+	if preserveReplicas || obj.GetAnnotations()[resourcesv1alpha1.PreserveReplicas] == "true" {
+		replicas, found, err := unstructured.NestedFieldCopy(current.Object, "spec", "replicas")
+		if err != nil {
+			return nil, fmt.Errorf("error reading current replicas: %w", err)
+		}
+		if found {
+			if err := unstructured.SetNestedField(desired.Object, replicas, "spec", "replicas"); err != nil {
+				return nil, fmt.Errorf("error preserving replicas: %w", err)
+			}
+		}
+	}
+
+	if obj.GetAnnotations()[resourcesv1alpha1.PreserveResources] == "true" {
+		if err := preserveContainerResources(current, desired); err != nil {
+			return nil, fmt.Errorf("error preserving resources: %w", err)
+		}
+	}
+
+	return desired, nil
+}
+
+// podSpecPaths are the paths to the pod specs of the workload resources whose container resources can be preserved.
+var podSpecPaths = [][]string{
+	// Deployment, StatefulSet, DaemonSet, Job
+	{"spec", "template", "spec"},
+	// CronJob
+	{"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// preserveContainerResources takes over the CPU and memory requests and limits of the containers of the current object
+// into the desired object.
+func preserveContainerResources(current, desired *unstructured.Unstructured) error {
+	for _, podSpecPath := range podSpecPaths {
+		containersPath := append(append([]string{}, podSpecPath...), "containers")
+
+		desiredContainers, found, err := unstructured.NestedSlice(desired.Object, containersPath...)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		currentContainers, _, err := unstructured.NestedSlice(current.Object, containersPath...)
+		if err != nil {
+			return err
+		}
+
+		for _, c := range desiredContainers {
+			desiredContainer, ok := c.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			currentContainer := containerWithName(currentContainers, desiredContainer["name"])
+			if currentContainer == nil {
+				continue
+			}
+
+			for _, field := range []string{"requests", "limits"} {
+				for _, resourceName := range []string{"cpu", "memory"} {
+					value, found, err := unstructured.NestedFieldCopy(currentContainer, "resources", field, resourceName)
+					if err != nil {
+						return err
+					}
+					if !found {
+						continue
+					}
+
+					if err := unstructured.SetNestedField(desiredContainer, value, "resources", field, resourceName); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if err := unstructured.SetNestedSlice(desired.Object, desiredContainers, containersPath...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func containerWithName(containers []any, name any) map[string]any {
+	for _, c := range containers {
+		if container, ok := c.(map[string]any); ok && container["name"] == name {
+			return container
+		}
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("server-side apply", func() {
+	const origin = "test:a/b"
+
+	var obj, current *unstructured.Unstructured
+
+	BeforeEach(func() {
+		obj = &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]any{
+				"name":            "foo",
+				"namespace":       "bar",
+				"resourceVersion": "42",
+				"annotations":     map[string]any{"foo": "bar"},
+			},
+			"spec": map[string]any{
+				"replicas": int64(1),
+				"template": map[string]any{
+					"spec": map[string]any{
+						"containers": []any{
+							map[string]any{"name": "app", "resources": map[string]any{"requests": map[string]any{"cpu": "100m"}}},
+						},
+					},
+				},
+			},
+			"status": map[string]any{"replicas": int64(1)},
+		}}
+
+		current = obj.DeepCopy()
+		Expect(unstructured.SetNestedField(current.Object, int64(3), "spec", "replicas")).To(Succeed())
+		Expect(unstructured.SetNestedSlice(current.Object, []any{
+			map[string]any{"name": "app", "resources": map[string]any{
+				"requests": map[string]any{"cpu": "200m", "memory": "1Gi", "ephemeral-storage": "1Gi"},
+				"limits":   map[string]any{"memory": "2Gi"},
+			}},
+		}, "spec", "template", "spec", "containers")).To(Succeed())
+	})
+
+	Describe("#fieldManagerForOrigin", func() {
+		It("should return the field manager for the origin", func() {
+			Expect(fieldManagerForOrigin(origin)).To(Equal("gardener-resource-manager:test:a/b"))
+		})
+
+		It("should shorten too long field managers", func() {
+			longOrigin := "cluster:" + strings.Repeat("a", 63) + "/" + strings.Repeat("b", 63)
+
+			fieldManager := fieldManagerForOrigin(longOrigin)
+			Expect(fieldManager).To(HaveLen(128))
+			Expect(fieldManager).To(HavePrefix("gardener-resource-manager:cluster:aaa"))
+			Expect(fieldManager).NotTo(Equal(fieldManagerForOrigin(longOrigin + "c")))
+		})
+	})
+
+	Describe("#desiredObjectForServerSideApply", func() {
+		It("should inject labels and annotations and drop the status", func() {
+			desired, err := desiredObjectForServerSideApply(origin, obj, nil, map[string]string{"foo": "bar"}, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(desired.GetLabels()).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(desired.GetAnnotations()).To(Equal(map[string]string{
+				"foo":                             "bar",
+				"resources.gardener.cloud/origin": origin,
+				descriptionAnnotation:             descriptionAnnotationText,
+			}))
+			Expect(desired.GetResourceVersion()).To(BeEmpty())
+			Expect(desired.Object).NotTo(HaveKey("status"))
+
+			templateLabels, _, err := unstructured.NestedStringMap(desired.Object, "spec", "template", "metadata", "labels")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(templateLabels).To(Equal(map[string]string{"foo": "bar"}))
+
+			By("Ensure original object was not mutated")
+			Expect(obj.GetLabels()).To(BeEmpty())
+			Expect(obj.Object).To(HaveKey("status"))
+		})
+
+		It("should not preserve any fields if the object does not exist yet", func() {
+			desired, err := desiredObjectForServerSideApply(origin, obj, nil, nil, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(desired.Object["spec"]).To(Equal(obj.Object["spec"]))
+		})
+
+		It("should not preserve any fields if not requested", func() {
+			desired, err := desiredObjectForServerSideApply(origin, obj, current, nil, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(desired.Object["spec"]).To(Equal(obj.Object["spec"]))
+		})
+
+		It("should preserve the replicas of horizontally scaled objects", func() {
+			desired, err := desiredObjectForServerSideApply(origin, obj, current, nil, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(desired.Object["spec"]).To(HaveKeyWithValue("replicas", int64(3)))
+		})
+
+		It("should preserve the replicas if the object is annotated accordingly", func() {
+			obj.SetAnnotations(map[string]string{"resources.gardener.cloud/preserve-replicas": "true"})
+
+			desired, err := desiredObjectForServerSideApply(origin, obj, current, nil, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(desired.Object["spec"]).To(HaveKeyWithValue("replicas", int64(3)))
+		})
+
+		It("should preserve the CPU and memory resources if the object is annotated accordingly", func() {
+			obj.SetAnnotations(map[string]string{"resources.gardener.cloud/preserve-resources": "true"})
+
+			desired, err := desiredObjectForServerSideApply(origin, obj, current, nil, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(desired.Object["spec"]).To(HaveKeyWithValue("replicas", int64(1)))
+			containers, _, err := unstructured.NestedSlice(desired.Object, "spec", "template", "spec", "containers")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(containers).To(ConsistOf(map[string]any{"name": "app", "resources": map[string]any{
+				"requests": map[string]any{"cpu": "200m", "memory": "1Gi"},
+				"limits":   map[string]any{"memory": "2Gi"},
+			}}))
+		})
+
+		It("should preserve the CPU and memory resources of CronJobs", func() {
+			cronJob := &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "batch/v1",
+				"kind":       "CronJob",
+				"metadata":   map[string]any{"name": "foo", "annotations": map[string]any{"resources.gardener.cloud/preserve-resources": "true"}},
+				"spec": map[string]any{"jobTemplate": map[string]any{"spec": map[string]any{"template": map[string]any{"spec": map[string]any{
+					"containers": []any{map[string]any{"name": "app"}},
+				}}}}},
+			}}
+			currentCronJob := cronJob.DeepCopy()
+			Expect(unstructured.SetNestedSlice(currentCronJob.Object, []any{
+				map[string]any{"name": "app", "resources": map[string]any{"limits": map[string]any{"cpu": "1"}}},
+			}, "spec", "jobTemplate", "spec", "template", "spec", "containers")).To(Succeed())
+
+			desired, err := desiredObjectForServerSideApply(origin, cronJob, currentCronJob, nil, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			containers, _, err := unstructured.NestedSlice(desired.Object, "spec", "jobTemplate", "spec", "template", "spec", "containers")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(containers).To(ConsistOf(map[string]any{"name": "app", "resources": map[string]any{"limits": map[string]any{"cpu": "1"}}}))
+		})
+	})
+
+	Describe("#applyServerSide", func() {
+		var (
+			ctx = context.TODO()
+
+			reconciler   *Reconciler
+			patchOptions *client.PatchOptions
+			patchErr     error
+		)
+
+		BeforeEach(func() {
+			patchOptions = nil
+			patchErr = nil
+
+			obj.SetResourceVersion("")
+			obj.SetAnnotations(nil)
+		})
+
+		newReconciler := func(objects ...client.Object) *Reconciler {
+			return &Reconciler{
+				TargetClient: fake.NewClientBuilder().
+					WithScheme(kubernetesscheme.Scheme).
+					WithObjects(objects...).
+					WithInterceptorFuncs(interceptor.Funcs{
+						Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+							Expect(patch.Type()).To(Equal(types.ApplyPatchType))
+							patchOptions = (&client.PatchOptions{}).ApplyOptions(opts)
+
+							if patchErr == nil {
+								obj.SetResourceVersion("2")
+							}
+							return patchErr
+						},
+					}).
+					Build(),
+			}
+		}
+
+		It("should create the object with the field manager of the origin", func() {
+			reconciler = newReconciler()
+
+			result, err := reconciler.applyServerSide(ctx, origin, object{obj: obj}, nil, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(controllerutil.OperationResultCreated))
+
+			Expect(patchOptions.FieldManager).To(Equal("gardener-resource-manager:test:a/b"))
+			Expect(patchOptions.Force).To(BeNil())
+		})
+
+		It("should force the ownership if configured", func() {
+			reconciler = newReconciler()
+
+			_, err := reconciler.applyServerSide(ctx, origin, object{obj: obj, forceConflicts: true}, nil, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(patchOptions.Force).To(Equal(ptr.To(true)))
+		})
+
+		It("should report an update of an existing object", func() {
+			reconciler = newReconciler(current)
+
+			result, err := reconciler.applyServerSide(ctx, origin, object{obj: obj}, nil, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(controllerutil.OperationResultUpdated))
+		})
+
+		It("should return the error of the apply", func() {
+			reconciler = newReconciler(current)
+			patchErr = apierrors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, "foo", errors.New("conflict with \"kubectl\": .spec.replicas"))
+
+			result, err := reconciler.applyServerSide(ctx, origin, object{obj: obj}, nil, false)
+			Expect(err).To(BeIdenticalTo(patchErr))
+			Expect(result).To(Equal(controllerutil.OperationResultUpdated))
+		})
+	})
+
+	Describe("#applyConflictError", func() {
+		It("should wrap the conflict", func() {
+			conflict := apierrors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, "foo", errors.New("conflict"))
+			err := &applyConflictError{resource: "apps/v1/Deployment/bar/foo", err: conflict}
+
+			Expect(err).To(MatchError(ContainSubstring(`conflicts during server-side apply of object "apps/v1/Deployment/bar/foo"`)))
+			Expect(apierrors.IsConflict(err)).To(BeTrue())
+			Expect(errors.Is(err, conflict)).To(BeTrue())
+		})
+	})
+})
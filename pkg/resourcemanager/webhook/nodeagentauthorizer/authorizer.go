@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/go-logr/logr"
@@ -17,6 +18,7 @@ import (
 	eventsv1 "k8s.io/api/events/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	auth "k8s.io/apiserver/pkg/authorization/authorizer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,7 +36,10 @@ func NewAuthorizer(logger logr.Logger, sourceClient, targetClient client.Client,
 	}
 }
 
-const valitailTokenSecretName = "gardener-valitail"
+const (
+	valitailTokenSecretName      = "gardener-valitail"
+	inPlaceUpdateLeaseNamePrefix = "gardener-node-agent-in-place-update-"
+)
 
 var (
 	certificateSigningRequestResource = certificatesv1.Resource("certificatesigningrequests")
@@ -42,6 +47,7 @@ var (
 	eventResource                     = eventsv1.Resource("events")
 	leaseResource                     = coordinationv1.Resource("leases")
 	nodeResource                      = corev1.Resource("nodes")
+	podResource                       = corev1.Resource("pods")
 	secretsResource                   = corev1.Resource("secrets")
 )
 
@@ -81,6 +87,8 @@ func (a *authorizer) Authorize(ctx context.Context, attrs auth.Attributes) (auth
 			return a.authorizeLease(ctx, requestLog, machineName, attrs)
 		case nodeResource:
 			return a.authorizeNode(ctx, requestLog, machineName, attrs)
+		case podResource:
+			return a.authorizePod(ctx, requestLog, machineName, attrs)
 		case secretsResource:
 			return a.authorizeSecret(ctx, requestLog, machineName, attrs)
 		}
@@ -148,6 +156,14 @@ func (a *authorizer) authorizeLease(ctx context.Context, log logr.Logger, machin
 		return auth.DecisionDeny, fmt.Sprintf(`expecting "node" label on machine %q`, machineName), nil
 	}
 
+	// Leases for coordinating in-place updates are shared between all gardener-node-agents of a worker pool.
+	if workerPool := machine.Spec.NodeTemplateSpec.Labels[v1beta1constants.LabelWorkerPool]; workerPool != "" &&
+		strings.HasPrefix(attrs.GetName(), inPlaceUpdateLeaseNamePrefix+workerPool+"-") &&
+		attrs.GetNamespace() == metav1.NamespaceSystem &&
+		slices.Contains([]string{"get", "update"}, attrs.GetVerb()) {
+		return auth.DecisionAllow, "", nil
+	}
+
 	allowedLease := "gardener-node-agent-" + node
 	if (attrs.GetVerb() != "create" && attrs.GetName() != allowedLease) || attrs.GetNamespace() != metav1.NamespaceSystem {
 		log.Info("Denying authorization because gardener-node-agent is not allowed to access the lease", "nodeName", node, "machineName", machineName, "leaseName", attrs.GetName())
@@ -186,6 +202,62 @@ func (a *authorizer) authorizeNode(ctx context.Context, log logr.Logger, machine
 	return auth.DecisionAllow, "", nil
 }
 
+func (a *authorizer) authorizePod(ctx context.Context, log logr.Logger, machineName string, attrs auth.Attributes) (auth.Decision, string, error) {
+	if ok, reason := a.checkSubresource(log, attrs, "eviction"); !ok {
+		return auth.DecisionDeny, reason, nil
+	}
+
+	allowedVerbs := []string{"get", "list", "watch"}
+	if attrs.GetSubresource() != "" {
+		// Pods are evicted when the node is drained for an in-place update.
+		allowedVerbs = []string{"create"}
+	}
+	if allowed, reason := a.checkVerb(log, attrs, allowedVerbs...); !allowed {
+		return auth.DecisionDeny, reason, nil
+	}
+
+	machine := &machinev1alpha1.Machine{}
+	if err := a.sourceClient.Get(ctx, client.ObjectKey{Name: machineName, Namespace: a.machineNamespace}, machine); err != nil {
+		return auth.DecisionDeny, "", fmt.Errorf("error getting machine %q: %w", machineName, err)
+	}
+
+	node := machine.Labels[machinev1alpha1.NodeLabelKey]
+	if node == "" {
+		log.Info(`Denying request because the machine does not have a "node" label`, "machineName", machineName)
+		return auth.DecisionDeny, fmt.Sprintf("gardener-node-agent can only access pods running on the node of machine %q", machineName), nil
+	}
+
+	// Pods may only be listed or watched with a field selector restricting the result to pods bound to the node of
+	// the gardener-node-agent.
+	if attrs.GetVerb() == "list" || attrs.GetVerb() == "watch" {
+		requirements, err := attrs.GetFieldSelector()
+		if err != nil {
+			return auth.DecisionDeny, fmt.Sprintf("failed parsing field selector: %v", err), nil
+		}
+
+		for _, requirement := range requirements {
+			if requirement.Field == "spec.nodeName" && requirement.Operator == selection.Equals && requirement.Value == node {
+				return auth.DecisionAllow, "", nil
+			}
+		}
+
+		log.Info("Denying authorization because pods are not listed for the node of the machine", "nodeName", node, "machineName", machineName)
+		return auth.DecisionDeny, fmt.Sprintf("gardener-node-agent can only %s pods with field selector %q", attrs.GetVerb(), "spec.nodeName="+node), nil
+	}
+
+	pod := &corev1.Pod{}
+	if err := a.targetClient.Get(ctx, client.ObjectKey{Name: attrs.GetName(), Namespace: attrs.GetNamespace()}, pod); err != nil {
+		return auth.DecisionDeny, "", fmt.Errorf("error getting pod %q: %w", client.ObjectKey{Name: attrs.GetName(), Namespace: attrs.GetNamespace()}, err)
+	}
+
+	if pod.Spec.NodeName != node {
+		log.Info("Denying authorization because pod is not running on the node of the machine", "pod", client.ObjectKeyFromObject(pod), "machineName", machineName)
+		return auth.DecisionDeny, fmt.Sprintf("gardener-node-agent can only access pods running on the node of machine %q", machineName), nil
+	}
+
+	return auth.DecisionAllow, "", nil
+}
+
 func (a *authorizer) authorizeSecret(ctx context.Context, log logr.Logger, machineName string, attrs auth.Attributes) (auth.Decision, string, error) {
 	if ok, reason := a.checkSubresource(log, attrs); !ok {
 		return auth.DecisionDeny, reason, nil
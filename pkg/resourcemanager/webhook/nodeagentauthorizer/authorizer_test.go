@@ -15,6 +15,7 @@ import (
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apiserver/pkg/authentication/user"
 	auth "k8s.io/apiserver/pkg/authorization/authorizer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -81,7 +82,10 @@ var _ = Describe("Authorizer", func() {
 			Spec: machinev1alpha1.MachineSpec{
 				NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
 					ObjectMeta: metav1.ObjectMeta{
-						Labels: map[string]string{v1beta1constants.LabelWorkerPoolGardenerNodeAgentSecretName: machineSecretName},
+						Labels: map[string]string{
+							v1beta1constants.LabelWorkerPool:                            "worker-1",
+							v1beta1constants.LabelWorkerPoolGardenerNodeAgentSecretName: machineSecretName,
+						},
 					},
 				},
 			},
@@ -436,6 +440,298 @@ var _ = Describe("Authorizer", func() {
 				Entry("delete", "delete"),
 				Entry("deletecollection", "deletecollection"),
 			)
+
+			DescribeTable("should allow accessing the in-place update leases of the own worker pool", func(verb string) {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            "gardener-node-agent-in-place-update-worker-1-0",
+					Namespace:       "kube-system",
+					APIGroup:        "coordination.k8s.io",
+					Resource:        "leases",
+					ResourceRequest: true,
+					Verb:            verb,
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionAllow))
+				Expect(reason).To(BeEmpty())
+			},
+				Entry("get", "get"),
+				Entry("update", "update"),
+			)
+
+			DescribeTable("should deny accessing the in-place update leases of a different worker pool", func(verb string) {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            "gardener-node-agent-in-place-update-worker-2-0",
+					Namespace:       "kube-system",
+					APIGroup:        "coordination.k8s.io",
+					Resource:        "leases",
+					ResourceRequest: true,
+					Verb:            verb,
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(Equal(fmt.Sprintf("this gardener-node-agent can only access lease \"gardener-node-agent-%s\" in \"kube-system\" namespace", nodeName)))
+			},
+				Entry("get", "get"),
+				Entry("update", "update"),
+			)
+		})
+
+		Context("#Pods", func() {
+			var pod *corev1.Pod
+
+			BeforeEach(func() {
+				pod = &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo-pod",
+						Namespace: "default",
+					},
+					Spec: corev1.PodSpec{NodeName: nodeName},
+				}
+				Expect(targetClient.Create(ctx, pod)).To(Succeed())
+			})
+
+			It("should allow getting a pod running on the node of the gardener-node-agent", func() {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            pod.Name,
+					Namespace:       "default",
+					APIGroup:        "",
+					Resource:        "pods",
+					ResourceRequest: true,
+					Verb:            "get",
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionAllow))
+				Expect(reason).To(BeEmpty())
+			})
+
+			It("should deny getting a pod running on a different node", func() {
+				pod.Spec.NodeName = "other-node"
+				Expect(targetClient.Update(ctx, pod)).To(Succeed())
+
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            pod.Name,
+					Namespace:       "default",
+					APIGroup:        "",
+					Resource:        "pods",
+					ResourceRequest: true,
+					Verb:            "get",
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(Equal(fmt.Sprintf("gardener-node-agent can only access pods running on the node of machine %q", machineName)))
+			})
+
+			It("should deny getting a pod for a machine without a node label", func() {
+				attrs := &auth.AttributesRecord{
+					User:            newNodeAgentUser,
+					Name:            pod.Name,
+					Namespace:       "default",
+					APIGroup:        "",
+					Resource:        "pods",
+					ResourceRequest: true,
+					Verb:            "get",
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(Equal(fmt.Sprintf("gardener-node-agent can only access pods running on the node of machine %q", newMachineName)))
+			})
+
+			DescribeTable("should allow listing pods with a field selector for the node of the gardener-node-agent", func(verb string) {
+				attrs := &auth.AttributesRecord{
+					User:                      nodeAgentUser,
+					Namespace:                 "",
+					APIGroup:                  "",
+					Resource:                  "pods",
+					ResourceRequest:           true,
+					Verb:                      verb,
+					FieldSelectorRequirements: fields.OneTermEqualSelector("spec.nodeName", nodeName).Requirements(),
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionAllow))
+				Expect(reason).To(BeEmpty())
+			},
+				Entry("list", "list"),
+				Entry("watch", "watch"),
+			)
+
+			DescribeTable("should deny listing pods without a field selector for the node of the gardener-node-agent", func(verb string, selector fields.Selector) {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Namespace:       "",
+					APIGroup:        "",
+					Resource:        "pods",
+					ResourceRequest: true,
+					Verb:            verb,
+				}
+				if selector != nil {
+					attrs.FieldSelectorRequirements = selector.Requirements()
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(Equal(fmt.Sprintf("gardener-node-agent can only %s pods with field selector %q", verb, "spec.nodeName="+nodeName)))
+			},
+				Entry("list without field selector", "list", nil),
+				Entry("watch without field selector", "watch", nil),
+				Entry("list with field selector for different node", "list", fields.OneTermEqualSelector("spec.nodeName", "other-node")),
+				Entry("watch with field selector for different node", "watch", fields.OneTermEqualSelector("spec.nodeName", "other-node")),
+				Entry("list with negated field selector", "list", fields.OneTermNotEqualSelector("spec.nodeName", nodeName)),
+				Entry("list with field selector for different field", "list", fields.OneTermEqualSelector("metadata.name", nodeName)),
+			)
+
+			It("should deny listing pods if the field selector cannot be parsed", func() {
+				attrs := &auth.AttributesRecord{
+					User:                    nodeAgentUser,
+					Namespace:               "",
+					APIGroup:                "",
+					Resource:                "pods",
+					ResourceRequest:         true,
+					Verb:                    "list",
+					FieldSelectorParsingErr: fmt.Errorf("fake"),
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(Equal("failed parsing field selector: fake"))
+			})
+
+			DescribeTable("should deny because no allowed verb", func(verb string) {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            pod.Name,
+					Namespace:       "default",
+					APIGroup:        "",
+					Resource:        "pods",
+					ResourceRequest: true,
+					Verb:            verb,
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(ContainSubstring("only the following verbs are allowed for this resource type: [get list watch]"))
+			},
+				Entry("create", "create"),
+				Entry("update", "update"),
+				Entry("patch", "patch"),
+				Entry("delete", "delete"),
+				Entry("deletecollection", "deletecollection"),
+			)
+
+			It("should allow evicting a pod running on the node of the gardener-node-agent", func() {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            pod.Name,
+					Namespace:       "default",
+					APIGroup:        "",
+					Resource:        "pods",
+					Subresource:     "eviction",
+					ResourceRequest: true,
+					Verb:            "create",
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionAllow))
+				Expect(reason).To(BeEmpty())
+			})
+
+			It("should deny evicting a pod running on a different node", func() {
+				pod.Spec.NodeName = "other-node"
+				Expect(targetClient.Update(ctx, pod)).To(Succeed())
+
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            pod.Name,
+					Namespace:       "default",
+					APIGroup:        "",
+					Resource:        "pods",
+					Subresource:     "eviction",
+					ResourceRequest: true,
+					Verb:            "create",
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(Equal(fmt.Sprintf("gardener-node-agent can only access pods running on the node of machine %q", machineName)))
+			})
+
+			It("should deny evicting a pod for a machine without a node label", func() {
+				attrs := &auth.AttributesRecord{
+					User:            newNodeAgentUser,
+					Name:            pod.Name,
+					Namespace:       "default",
+					APIGroup:        "",
+					Resource:        "pods",
+					Subresource:     "eviction",
+					ResourceRequest: true,
+					Verb:            "create",
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(Equal(fmt.Sprintf("gardener-node-agent can only access pods running on the node of machine %q", newMachineName)))
+			})
+
+			It("should return an error when the pod to evict does not exist", func() {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            "non-existing",
+					Namespace:       "default",
+					APIGroup:        "",
+					Resource:        "pods",
+					Subresource:     "eviction",
+					ResourceRequest: true,
+					Verb:            "create",
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).To(MatchError(ContainSubstring("not found")))
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(BeEmpty())
+			})
+
+			DescribeTable("should deny because no allowed subresource", func(subresource string) {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            pod.Name,
+					Namespace:       "default",
+					APIGroup:        "",
+					Resource:        "pods",
+					Subresource:     subresource,
+					ResourceRequest: true,
+					Verb:            "get",
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(ContainSubstring("only the following subresources are allowed for this resource type: [eviction]"))
+			},
+				Entry("log", "log"),
+				Entry("exec", "exec"),
+				Entry("status", "status"),
+			)
 		})
 
 		Context("#Nodes", func() {
@@ -88,6 +88,22 @@ var _ = Describe("Defaults", func() {
 				},
 			}))
 		})
+
+		It("should default the weights of the score plugins", func() {
+			obj.Schedulers.Shoot = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				ScorePlugins: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots},
+					{Name: schedulerconfigv1alpha1.ScorePluginResourceHeadroom, Weight: ptr.To[int32](3)},
+				},
+			}
+
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.ScorePlugins).To(Equal([]schedulerconfigv1alpha1.ScorePlugin{
+				{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots, Weight: ptr.To[int32](1)},
+				{Name: schedulerconfigv1alpha1.ScorePluginResourceHeadroom, Weight: ptr.To[int32](3)},
+			}))
+		})
 	})
 
 	Describe("ServerConfiguration defaulting", func() {
@@ -45,6 +45,28 @@ const (
 // Strategies defines all currently implemented SeedCandidateDeterminationStrategies
 var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance}
 
+const (
+	// ScorePluginLeastShoots is the name of the score plugin preferring seeds which host the fewest shoots.
+	ScorePluginLeastShoots = "LeastShoots"
+	// ScorePluginResourceHeadroom is the name of the score plugin preferring seeds with the most CPU and memory left
+	// after hosting the projected control plane of the shoot.
+	ScorePluginResourceHeadroom = "ResourceHeadroom"
+	// ScorePluginControlPlaneLoad is the name of the score plugin preferring seeds which host the smallest projected
+	// control planes in total.
+	ScorePluginControlPlaneLoad = "ControlPlaneLoad"
+	// ScorePluginRegionDistance is the name of the score plugin preferring seeds with the minimal distance to the shoot's
+	// region.
+	ScorePluginRegionDistance = "RegionDistance"
+	// ScorePluginProjectSpread is the name of the score plugin preferring seeds which host the fewest shoots of the
+	// shoot's project.
+	ScorePluginProjectSpread = "ProjectSpread"
+	// ScorePluginZoneSpread is the name of the score plugin preferring seeds spanning the most availability zones.
+	ScorePluginZoneSpread = "ZoneSpread"
+)
+
+// ScorePlugins defines all currently implemented score plugins.
+var ScorePlugins = []string{ScorePluginLeastShoots, ScorePluginResourceHeadroom, ScorePluginControlPlaneLoad, ScorePluginRegionDistance, ScorePluginProjectSpread, ScorePluginZoneSpread}
+
 // CandidateDeterminationStrategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 type CandidateDeterminationStrategy string
 
@@ -105,6 +127,20 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
+	// ScorePlugins configures the plugins which score the seed candidates that passed all filters. The candidate with
+	// the highest weighted score is chosen. If not set, the LeastShoots plugin is used, i.e., the candidate hosting the
+	// fewest shoots is chosen.
+	// +optional
+	ScorePlugins []ScorePlugin `json:"scorePlugins,omitempty"`
+}
+
+// ScorePlugin configures a plugin scoring seed candidates.
+type ScorePlugin struct {
+	// Name is the name of the plugin.
+	Name string `json:"name"`
+	// Weight is the weight of the plugin's scores. Defaults to 1.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
@@ -5,6 +5,9 @@
 package validation
 
 import (
+	"fmt"
+	"slices"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -51,6 +54,7 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfigv1alpha1
 	if schedulers.Shoot != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
 		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, validateScorePlugins(schedulers.Shoot.ScorePlugins, fldPath.Child("shoot", "scorePlugins"))...)
 	}
 
 	return allErrs
@@ -74,3 +78,30 @@ func validateStrategy(strategy schedulerconfigv1alpha1.CandidateDeterminationStr
 
 	return allErrs
 }
+
+// maxScorePluginWeight is the maximum weight of a score plugin.
+const maxScorePluginWeight = 100
+
+func validateScorePlugins(plugins []schedulerconfigv1alpha1.ScorePlugin, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, plugin := range plugins {
+		idxPath := fldPath.Index(i)
+
+		if !slices.Contains(schedulerconfigv1alpha1.ScorePlugins, plugin.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), plugin.Name, schedulerconfigv1alpha1.ScorePlugins))
+		} else if names.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		names.Insert(plugin.Name)
+
+		if plugin.Weight != nil && (*plugin.Weight < 1 || *plugin.Weight > maxScorePluginWeight) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), *plugin.Weight, fmt.Sprintf("must be in the range of 1 to %d", maxScorePluginWeight)))
+		}
+	}
+
+	return allErrs
+}
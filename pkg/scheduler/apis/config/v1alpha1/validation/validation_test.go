@@ -161,5 +161,44 @@ var _ = Describe("#ValidateConfiguration", func() {
 				"Field": Equal("schedulers.shoot.concurrentSyncs"),
 			}))))
 		})
+
+		It("should allow valid score plugins", func() {
+			conf.Schedulers.Shoot.ScorePlugins = []schedulerconfigv1alpha1.ScorePlugin{
+				{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots},
+				{Name: schedulerconfigv1alpha1.ScorePluginResourceHeadroom, Weight: ptr.To[int32](100)},
+				{Name: schedulerconfigv1alpha1.ScorePluginControlPlaneLoad, Weight: ptr.To[int32](1)},
+				{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance},
+				{Name: schedulerconfigv1alpha1.ScorePluginProjectSpread},
+			}
+
+			Expect(ValidateConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because of invalid score plugins", func() {
+			conf.Schedulers.Shoot.ScorePlugins = []schedulerconfigv1alpha1.ScorePlugin{
+				{Name: "Foo"},
+				{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots, Weight: ptr.To[int32](0)},
+				{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots, Weight: ptr.To[int32](101)},
+			}
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.scorePlugins[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.scorePlugins[1].weight"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("schedulers.shoot.scorePlugins[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.scorePlugins[2].weight"),
+				})),
+			))
+		})
 	})
 })
@@ -16,6 +16,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 )
 
@@ -49,11 +50,12 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 }
 
 // ShootUnassignedPredicate is a predicate that returns true if a shoot is not assigned to a seed
-// and the default scheduler is configured.
+// and the default scheduler is configured. Self-hosted shoots are never assigned to a seed.
 func (r *Reconciler) ShootUnassignedPredicate() predicate.Predicate {
 	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
 		if shoot, ok := obj.(*gardencorev1beta1.Shoot); ok {
 			return shoot.Spec.SeedName == nil &&
+				!v1beta1helper.IsShootSelfHosted(shoot) &&
 				ptr.Deref(shoot.Spec.SchedulerName, v1beta1constants.DefaultSchedulerName) == v1beta1constants.DefaultSchedulerName
 		}
 		return false
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// SchedulingReport explains the scheduling decision for a shoot.
+type SchedulingReport struct {
+	// Shoot is the key of the shoot.
+	Shoot string `json:"shoot"`
+	// Seed is the name of the seed the shoot would be scheduled to. It is empty if no suitable seed was found.
+	Seed *string `json:"seed,omitempty"`
+	// Error is the reason why no suitable seed was found, if any.
+	Error *string `json:"error,omitempty"`
+	// Rejected contains the seeds rejected by the filter plugins.
+	Rejected []SeedRejection `json:"rejected,omitempty"`
+	// Ranking contains the seeds which passed all filter plugins, sorted descending by their scores.
+	Ranking []SeedRanking `json:"ranking,omitempty"`
+}
+
+// SeedRejection describes why a seed was rejected.
+type SeedRejection struct {
+	// Seed is the name of the seed.
+	Seed string `json:"seed"`
+	// Filter is the name of the filter plugin which rejected the seed.
+	Filter string `json:"filter"`
+	// Reason is the reason for rejecting the seed.
+	Reason string `json:"reason"`
+}
+
+// SeedRanking describes the score of a seed which passed all filter plugins.
+type SeedRanking struct {
+	// Seed is the name of the seed.
+	Seed string `json:"seed"`
+	// Score is the weighted sum of the scores of all score plugins.
+	Score int64 `json:"score"`
+	// PluginScores are the (unweighted) scores of the individual score plugins keyed by the plugin names.
+	PluginScores map[string]int64 `json:"pluginScores,omitempty"`
+}
+
+// Explain determines the seed for the given shoot like DetermineSeed, but neither binds nor modifies the shoot. Instead,
+// it returns a report explaining why seeds were rejected and how the remaining seeds were ranked. Errors preventing the
+// scheduling from being performed at all (e.g., failures to read the seeds) are returned, whereas failures to find a
+// suitable seed are contained in the report.
+func (r *Reconciler) Explain(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*SchedulingReport, error) {
+	sc, seeds, plugins, err := r.prepareScheduling(ctx, log, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SchedulingReport{Shoot: client.ObjectKeyFromObject(shoot).String()}
+
+	seedScores, err := schedule(sc, seeds, plugins, report)
+	if err != nil {
+		report.Error = ptr.To(err.Error())
+		return report, nil
+	}
+
+	report.Seed = ptr.To(seedScores[0].Seed.Name)
+	return report, nil
+}
+
+func (r *SchedulingReport) addRejections(filter string, seedNameToReason map[string]string) {
+	if r == nil {
+		return
+	}
+
+	for seedName, reason := range seedNameToReason {
+		r.Rejected = append(r.Rejected, SeedRejection{Seed: seedName, Filter: filter, Reason: reason})
+	}
+
+	slices.SortStableFunc(r.Rejected, func(a, b SeedRejection) int {
+		return strings.Compare(a.Seed, b.Seed)
+	})
+}
+
+func (r *SchedulingReport) setRanking(seedScores []SeedScore) {
+	if r == nil {
+		return
+	}
+
+	r.Ranking = make([]SeedRanking, 0, len(seedScores))
+	for _, seedScore := range seedScores {
+		r.Ranking = append(r.Ranking, SeedRanking{Seed: seedScore.Seed.Name, Score: seedScore.Score, PluginScores: seedScore.PluginScores})
+	}
+}
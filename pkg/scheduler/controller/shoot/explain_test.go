@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+var _ = Describe("Explain", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		fakeGardenClient client.Client
+		reconciler       *Reconciler
+
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	newSeed := func(name string) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: "europe"},
+				Networks: gardencorev1beta1.SeedNetworks{Pods: "10.20.0.0/16", Services: "10.30.0.0/16"},
+				Settings: &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true}},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				Conditions:    []gardencorev1beta1.Condition{{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue}},
+				LastOperation: &gardencorev1beta1.LastOperation{},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		reconciler = &Reconciler{
+			Client: fakeGardenClient,
+			Config: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.SameRegion},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To("cloudprofile"),
+				Region:           "europe",
+				Provider:         gardencorev1beta1.Provider{Type: "foo"},
+			},
+		}
+
+		Expect(fakeGardenClient.Create(ctx, &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}})).To(Succeed())
+	})
+
+	It("should report the rejected seeds and the ranking of the remaining seeds", func() {
+		seed1, seed2, seed3, seed4 := newSeed("seed-1"), newSeed("seed-2"), newSeed("seed-3"), newSeed("seed-4")
+		seed2.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+		seed3.Spec.Provider.Region = "asia"
+		seed4.Spec.Provider.Type = "bar"
+
+		for _, seed := range []*gardencorev1beta1.Seed{seed1, seed2, seed3, seed4, newSeed("seed-5")} {
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+		}
+		Expect(fakeGardenClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "garden-foo"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed-1")},
+		})).To(Succeed())
+
+		report, err := reconciler.Explain(ctx, log, shoot)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(report).To(Equal(&SchedulingReport{
+			Shoot: "garden-foo/shoot",
+			Seed:  ptr.To("seed-5"),
+			Rejected: []SeedRejection{
+				{Seed: "seed-2", Filter: "Candidates", Reason: "shoot does not tolerate the seed's taints"},
+				{Seed: "seed-3", Filter: "Strategy", Reason: "seed is not a candidate according to the strategy 'SameRegion'"},
+				{Seed: "seed-4", Filter: "Provider", Reason: `seed provider "bar" does not match for shoot provider "foo"`},
+			},
+			Ranking: []SeedRanking{
+				{Seed: "seed-5", Score: 100, PluginScores: map[string]int64{"LeastShoots": 100}},
+				{Seed: "seed-1", Score: 0, PluginScores: map[string]int64{"LeastShoots": 0}},
+			},
+		}))
+	})
+
+	It("should not account for the explained shoot itself", func() {
+		Expect(fakeGardenClient.Create(ctx, newSeed("seed-1"))).To(Succeed())
+		Expect(fakeGardenClient.Create(ctx, newSeed("seed-2"))).To(Succeed())
+
+		shoot.Spec.SeedName = ptr.To("seed-1")
+		Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+
+		report, err := reconciler.Explain(ctx, log, shoot)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Seed).To(Equal(ptr.To("seed-1")))
+		Expect(report.Ranking).To(HaveEach(HaveField("Score", int64(100))))
+	})
+
+	It("should report the error and reject all seeds if no seed is suitable", func() {
+		Expect(fakeGardenClient.Create(ctx, newSeed("seed-1"))).To(Succeed())
+		shoot.Spec.Region = "asia"
+
+		report, err := reconciler.Explain(ctx, log, shoot)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(report.Seed).To(BeNil())
+		Expect(report.Error).To(Equal(ptr.To("no matching seed candidate found for Configuration (Cloud Profile 'cloudprofile', Region 'asia', SeedDeterminationStrategy 'SameRegion')")))
+		Expect(report.Rejected).To(ConsistOf(SeedRejection{Seed: "seed-1", Filter: "Strategy", Reason: *report.Error}))
+		Expect(report.Ranking).To(BeEmpty())
+	})
+
+	It("should return an error if the cloud profile does not exist", func() {
+		shoot.Spec.CloudProfileName = ptr.To("does-not-exist")
+
+		_, err := reconciler.Explain(ctx, log, shoot)
+		Expect(err).To(HaveOccurred())
+	})
+})
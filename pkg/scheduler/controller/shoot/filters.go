@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// filterPlugins returns the filter plugins in the order in which they are run.
+func filterPlugins() []FilterPlugin {
+	return []FilterPlugin{
+		&usableFilter{},
+		&seedSelectorFilter{kind: "CloudProfile"},
+		&seedSelectorFilter{kind: "Shoot"},
+		&providerFilter{},
+		&zonesFilter{},
+		&accessRestrictionsFilter{},
+		&candidatesFilter{},
+		&strategyFilter{},
+	}
+}
+
+// partitionSeeds splits the given seeds into those for which rejectReason returns an empty reason and the reasons for
+// rejecting the others keyed by the seed names.
+func partitionSeeds(seeds []gardencorev1beta1.Seed, rejectReason func(*gardencorev1beta1.Seed) string) ([]gardencorev1beta1.Seed, map[string]string) {
+	var (
+		accepted []gardencorev1beta1.Seed
+		rejected = make(map[string]string)
+	)
+
+	for _, seed := range seeds {
+		if reason := rejectReason(&seed); reason != "" {
+			rejected[seed.Name] = reason
+			continue
+		}
+		accepted = append(accepted, seed)
+	}
+
+	return accepted, rejected
+}
+
+// usableFilter filters seeds which are deleting, invisible or not ready.
+type usableFilter struct{}
+
+func (f *usableFilter) Name() string {
+	return "Usable"
+}
+
+func (f *usableFilter) Filter(_ *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]string, error) {
+	accepted, rejected := partitionSeeds(seeds, func(seed *gardencorev1beta1.Seed) string {
+		switch {
+		case seed.DeletionTimestamp != nil:
+			return "seed is being deleted"
+		case !seed.Spec.Settings.Scheduling.Visible:
+			return "seed is not visible for scheduling"
+		case !verifySeedReadiness(seed):
+			return "seed is not ready"
+		}
+		return ""
+	})
+
+	if len(accepted) == 0 {
+		return nil, rejected, fmt.Errorf("none of the %d seeds is valid for scheduling (not deleting, visible and ready)", len(seeds))
+	}
+	return accepted, rejected, nil
+}
+
+// seedSelectorFilter filters seeds which do not match the seed selector of the CloudProfile or the Shoot.
+type seedSelectorFilter struct {
+	kind string
+}
+
+func (f *seedSelectorFilter) Name() string {
+	return f.kind + "SeedSelector"
+}
+
+func (f *seedSelectorFilter) Filter(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]string, error) {
+	seedSelector := sc.Shoot.Spec.SeedSelector
+	if f.kind == "CloudProfile" {
+		seedSelector = sc.CloudProfile.Spec.SeedSelector
+	}
+
+	if seedSelector == nil {
+		return seeds, nil, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&seedSelector.LabelSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("label selector conversion failed: %v for seedSelector: %w", seedSelector.LabelSelector, err)
+	}
+
+	accepted, rejected := partitionSeeds(seeds, func(seed *gardencorev1beta1.Seed) string {
+		if !selector.Matches(labels.Set(seed.Labels)) {
+			return fmt.Sprintf("seed does not match the seed selector of the %s (selector: '%s')", f.kind, selector.String())
+		}
+		return ""
+	})
+
+	if len(accepted) == 0 {
+		return nil, rejected, fmt.Errorf("none out of the %d seeds has the matching labels required by seed selector of '%s' (selector: '%s')", len(seeds), f.kind, selector.String())
+	}
+	return accepted, rejected, nil
+}
+
+// providerFilter filters seeds whose provider type is not allowed for the shoot.
+type providerFilter struct{}
+
+func (f *providerFilter) Name() string {
+	return "Provider"
+}
+
+func (f *providerFilter) Filter(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]string, error) {
+	var possibleProviders []string
+	if sc.CloudProfile.Spec.SeedSelector != nil {
+		possibleProviders = sc.CloudProfile.Spec.SeedSelector.ProviderTypes
+	}
+
+	accepted, rejected := partitionSeeds(seeds, func(seed *gardencorev1beta1.Seed) string {
+		if !matchProvider(seed.Spec.Provider.Type, sc.Shoot.Spec.Provider.Type, possibleProviders) {
+			return fmt.Sprintf("seed provider %q does not match for shoot provider %q", seed.Spec.Provider.Type, sc.Shoot.Spec.Provider.Type)
+		}
+		return ""
+	})
+
+	if len(accepted) == 0 {
+		return nil, rejected, fmt.Errorf("none out of the %d seeds has a matching provider for %q", len(seeds), sc.Shoot.Spec.Provider.Type)
+	}
+	return accepted, rejected, nil
+}
+
+// zonesFilter filters seeds with less than three zones in case the shoot's failure tolerance type is 'zone'.
+type zonesFilter struct{}
+
+func (f *zonesFilter) Name() string {
+	return "Zones"
+}
+
+func (f *zonesFilter) Filter(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]string, error) {
+	if !v1beta1helper.IsMultiZonalShootControlPlane(sc.Shoot) {
+		return seeds, nil, nil
+	}
+
+	accepted, rejected := partitionSeeds(seeds, func(seed *gardencorev1beta1.Seed) string {
+		if len(seed.Spec.Provider.Zones) < 3 {
+			return fmt.Sprintf("seed has %d zone(s) but at least 3 are required for failure tolerance type 'zone'", len(seed.Spec.Provider.Zones))
+		}
+		return ""
+	})
+
+	if len(accepted) == 0 {
+		return nil, rejected, fmt.Errorf("none of the %d seeds has at least 3 zones for hosting a shoot control plane with failure tolerance type 'zone'", len(seeds))
+	}
+	return accepted, rejected, nil
+}
+
+// accessRestrictionsFilter filters seeds which do not support the access restrictions configured in the shoot.
+type accessRestrictionsFilter struct{}
+
+func (f *accessRestrictionsFilter) Name() string {
+	return "AccessRestrictions"
+}
+
+func (f *accessRestrictionsFilter) Filter(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]string, error) {
+	accepted, rejected := partitionSeeds(seeds, func(seed *gardencorev1beta1.Seed) string {
+		if !v1beta1helper.AccessRestrictionsAreSupported(seed.Spec.AccessRestrictions, sc.Shoot.Spec.AccessRestrictions) {
+			return "seed does not support the access restrictions configured in the shoot specification"
+		}
+		return ""
+	})
+
+	if len(accepted) == 0 {
+		return nil, rejected, fmt.Errorf("none of the %d seeds supports the access restrictions configured in the shoot specification", len(seeds))
+	}
+	return accepted, rejected, nil
+}
+
+// candidatesFilter filters seeds whose networks overlap with the shoot's networks, whose taints are not tolerated by
+// the shoot or which do not have available capacity for shoots.
+type candidatesFilter struct{}
+
+func (f *candidatesFilter) Name() string {
+	return "Candidates"
+}
+
+func (f *candidatesFilter) Filter(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]string, error) {
+	accepted, rejected := partitionSeeds(seeds, func(seed *gardencorev1beta1.Seed) string {
+		if sc.Shoot.Spec.Networking != nil {
+			if disjointed, err := networksAreDisjointed(seed, sc.Shoot); !disjointed {
+				return err.Error()
+			}
+		}
+
+		if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, sc.Shoot.Spec.Tolerations) {
+			return "shoot does not tolerate the seed's taints"
+		}
+
+		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(len(sc.ShootsOnSeed(seed.Name))) >= allocatableShoots.Value() {
+			return "seed does not have available capacity for shoots"
+		}
+
+		return ""
+	})
+
+	if len(accepted) == 0 {
+		return nil, rejected, fmt.Errorf("0/%d seed cluster candidate(s) are eligible for scheduling: %v", len(seeds), reasonsToString(rejected))
+	}
+	return accepted, rejected, nil
+}
+
+// strategyFilter filters seeds which are not selected by the configured candidate determination strategy.
+type strategyFilter struct{}
+
+func (f *strategyFilter) Name() string {
+	return "Strategy"
+}
+
+func (f *strategyFilter) Filter(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]string, error) {
+	candidates, err := applyStrategy(sc.Log, sc.Shoot, seeds, sc.Strategy, sc.RegionConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accepted, rejected := partitionSeeds(seeds, func(seed *gardencorev1beta1.Seed) string {
+		if !slices.ContainsFunc(candidates, func(candidate gardencorev1beta1.Seed) bool { return candidate.Name == seed.Name }) {
+			return fmt.Sprintf("seed is not a candidate according to the strategy '%s'", sc.Strategy)
+		}
+		return ""
+	})
+
+	return accepted, rejected, nil
+}
+
+func reasonsToString(seedNameToReason map[string]string) string {
+	sortedSeeds := maps.Keys(seedNameToReason)
+	slices.Sort(sortedSeeds)
+
+	res := "{"
+	for _, seed := range sortedSeeds {
+		res += fmt.Sprintf("%s => %s, ", seed, seedNameToReason[seed])
+	}
+	res = strings.TrimSuffix(res, ", ") + "}"
+	return res
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("Filters", func() {
+	var (
+		sc    *SchedulingContext
+		seeds []gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		sc = &SchedulingContext{
+			Shoot:        &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Type: "aws"}}},
+			CloudProfile: &gardencorev1beta1.CloudProfile{},
+		}
+
+		seeds = []gardencorev1beta1.Seed{
+			{ObjectMeta: metav1.ObjectMeta{Name: "seed-1"}, Spec: gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{Type: "aws"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "seed-2"}, Spec: gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{Type: "gcp"}}},
+		}
+	})
+
+	It("should return the accepted seeds and the reasons for the rejected seeds", func() {
+		accepted, rejected, err := (&providerFilter{}).Filter(sc, seeds)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(accepted).To(HaveLen(1))
+		Expect(accepted[0].Name).To(Equal("seed-1"))
+		Expect(rejected).To(Equal(map[string]string{"seed-2": `seed provider "gcp" does not match for shoot provider "aws"`}))
+	})
+
+	It("should return an error and the reasons if all seeds are rejected", func() {
+		seeds[0].Spec.Settings = &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: false}}
+		seeds[1].Spec.Settings = &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true}}
+
+		accepted, rejected, err := (&usableFilter{}).Filter(sc, seeds)
+		Expect(err).To(MatchError("none of the 2 seeds is valid for scheduling (not deleting, visible and ready)"))
+		Expect(accepted).To(BeEmpty())
+		Expect(rejected).To(Equal(map[string]string{
+			"seed-1": "seed is not visible for scheduling",
+			"seed-2": "seed is not ready",
+		}))
+	})
+
+	It("should not reject any seeds if the filter does not apply", func() {
+		accepted, rejected, err := (&zonesFilter{}).Filter(sc, seeds)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(accepted).To(Equal(seeds))
+		Expect(rejected).To(BeEmpty())
+	})
+})
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+// MaxScore is the maximum score a score plugin assigns to a seed.
+const MaxScore int64 = 100
+
+// Plugin is a plugin of the scheduling framework.
+type Plugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+}
+
+// FilterPlugin filters seeds which are not suitable for hosting the control plane of a shoot.
+type FilterPlugin interface {
+	Plugin
+	// Filter returns the seeds which are suitable for the shoot and the reasons for rejecting the other seeds keyed by
+	// the seed names. It returns an error if none of the seeds is suitable.
+	Filter(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]string, error)
+}
+
+// ScorePlugin scores the seeds which passed all filters.
+type ScorePlugin interface {
+	Plugin
+	// Score returns the scores of the given seeds keyed by the seed names. Scores are in the range of 0 to MaxScore,
+	// higher scores are better.
+	Score(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error)
+}
+
+// SchedulingContext contains the information required by the plugins for scheduling a shoot.
+type SchedulingContext struct {
+	// Log is the logger.
+	Log logr.Logger
+	// Shoot is the shoot to be scheduled.
+	Shoot *gardencorev1beta1.Shoot
+	// CloudProfile is the cloud profile referenced by the shoot.
+	CloudProfile *gardencorev1beta1.CloudProfile
+	// Shoots are all shoots in the system.
+	Shoots []*gardencorev1beta1.Shoot
+	// RegionConfig is the region config for the cloud profile, if any.
+	RegionConfig *corev1.ConfigMap
+	// Strategy is the configured candidate determination strategy.
+	Strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy
+
+	shootsBySeed map[string][]*gardencorev1beta1.Shoot
+}
+
+// ShootsOnSeed returns the shoots whose control planes are hosted by the seed with the given name. Shoots which are
+// currently migrated count for both the source and the destination seed.
+func (sc *SchedulingContext) ShootsOnSeed(seedName string) []*gardencorev1beta1.Shoot {
+	if sc.shootsBySeed == nil {
+		sc.shootsBySeed = make(map[string][]*gardencorev1beta1.Shoot)
+
+		for _, shoot := range sc.Shoots {
+			var (
+				specSeed   = ptr.Deref(shoot.Spec.SeedName, "")
+				statusSeed = ptr.Deref(shoot.Status.SeedName, "")
+			)
+
+			if specSeed != "" {
+				sc.shootsBySeed[specSeed] = append(sc.shootsBySeed[specSeed], shoot)
+			}
+			if statusSeed != "" && specSeed != statusSeed {
+				sc.shootsBySeed[statusSeed] = append(sc.shootsBySeed[statusSeed], shoot)
+			}
+		}
+	}
+
+	return sc.shootsBySeed[seedName]
+}
+
+// weightedScorePlugin is a score plugin with its configured weight.
+type weightedScorePlugin struct {
+	ScorePlugin
+	weight int64
+}
+
+// SeedScore is the score of a seed candidate.
+type SeedScore struct {
+	// Seed is the seed candidate.
+	Seed *gardencorev1beta1.Seed
+	// Score is the weighted sum of the scores of all score plugins.
+	Score int64
+	// PluginScores are the (unweighted) scores of the individual score plugins keyed by the plugin names.
+	PluginScores map[string]int64
+}
+
+// scorePlugins returns the configured score plugins. If none are configured, the LeastShoots plugin is used.
+func scorePlugins(config []schedulerconfigv1alpha1.ScorePlugin) ([]weightedScorePlugin, error) {
+	if len(config) == 0 {
+		config = []schedulerconfigv1alpha1.ScorePlugin{{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots}}
+	}
+
+	plugins := make([]weightedScorePlugin, 0, len(config))
+	for _, pluginConfig := range config {
+		plugin, err := newScorePlugin(pluginConfig.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		plugins = append(plugins, weightedScorePlugin{ScorePlugin: plugin, weight: int64(ptr.Deref(pluginConfig.Weight, 1))})
+	}
+
+	return plugins, nil
+}
+
+// schedule runs the filter plugins and scores the remaining seeds with the given score plugins. The returned scores
+// are sorted descending, i.e., the first seed is the best one. If a report is given, the rejected seeds and the
+// ranking are recorded in it.
+func schedule(sc *SchedulingContext, seeds []gardencorev1beta1.Seed, plugins []weightedScorePlugin, report *SchedulingReport) ([]SeedScore, error) {
+	filteredSeeds, err := runFilterPlugins(sc, filterPlugins(), seeds, report)
+	if err != nil {
+		return nil, err
+	}
+
+	seedScores, err := runScorePlugins(sc, plugins, filteredSeeds)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, seedScore := range seedScores {
+		sc.Log.V(1).Info("Scored seed candidate", "seed", seedScore.Seed.Name, "score", seedScore.Score, "pluginScores", seedScore.PluginScores)
+	}
+	report.setRanking(seedScores)
+
+	return seedScores, nil
+}
+
+// runFilterPlugins runs the given filter plugins one after another and returns the seeds which passed all filters.
+func runFilterPlugins(sc *SchedulingContext, plugins []FilterPlugin, seeds []gardencorev1beta1.Seed, report *SchedulingReport) ([]gardencorev1beta1.Seed, error) {
+	for _, plugin := range plugins {
+		filteredSeeds, rejected, err := plugin.Filter(sc, seeds)
+		if err != nil {
+			// Seeds without a dedicated reason are rejected because of the error, e.g., if the strategy failed.
+			rejected = maps.Clone(rejected)
+			if rejected == nil {
+				rejected = make(map[string]string, len(seeds))
+			}
+			for _, seed := range seeds {
+				if _, ok := rejected[seed.Name]; !ok {
+					rejected[seed.Name] = err.Error()
+				}
+			}
+			report.addRejections(plugin.Name(), rejected)
+			return nil, err
+		}
+
+		report.addRejections(plugin.Name(), rejected)
+		seeds = filteredSeeds
+	}
+
+	return seeds, nil
+}
+
+// runScorePlugins scores the given seeds with the given score plugins. The returned scores are sorted descending by
+// the weighted score. Seeds with equal scores keep their given order.
+func runScorePlugins(sc *SchedulingContext, plugins []weightedScorePlugin, seeds []gardencorev1beta1.Seed) ([]SeedScore, error) {
+	seedScores := make([]SeedScore, 0, len(seeds))
+	for i := range seeds {
+		seedScores = append(seedScores, SeedScore{Seed: &seeds[i], PluginScores: make(map[string]int64, len(plugins))})
+	}
+
+	for _, plugin := range plugins {
+		scores, err := plugin.Score(sc, seeds)
+		if err != nil {
+			return nil, fmt.Errorf("failed running score plugin %s: %w", plugin.Name(), err)
+		}
+
+		for i := range seedScores {
+			score := scores[seedScores[i].Seed.Name]
+			seedScores[i].PluginScores[plugin.Name()] = score
+			seedScores[i].Score += plugin.weight * score
+		}
+	}
+
+	slices.SortStableFunc(seedScores, func(a, b SeedScore) int {
+		switch {
+		case a.Score > b.Score:
+			return -1
+		case a.Score < b.Score:
+			return 1
+		}
+		return 0
+	})
+
+	return seedScores, nil
+}
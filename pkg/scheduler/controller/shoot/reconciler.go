@@ -6,18 +6,15 @@ package shoot
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math"
 	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
-	"golang.org/x/exp/maps"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -68,6 +65,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	if v1beta1helper.IsShootSelfHosted(shoot) {
+		log.Info("Ignoring shoot because it is self-hosted")
+		return reconcile.Result{}, nil
+	}
+
 	// If no Seed is referenced, we try to determine an adequate one.
 	seed, err := r.DetermineSeed(ctx, log, shoot)
 	if err != nil {
@@ -123,59 +125,57 @@ func (r *Reconciler) DetermineSeed(
 	*gardencorev1beta1.Seed,
 	error,
 ) {
+	sc, seeds, plugins, err := r.prepareScheduling(ctx, log, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	seedScores, err := schedule(sc, seeds, plugins, nil)
+	if err != nil {
+		return nil, err
+	}
+	return seedScores[0].Seed, nil
+}
+
+// prepareScheduling reads all information required for scheduling the given shoot and returns the scheduling context,
+// all seeds and the configured score plugins.
+func (r *Reconciler) prepareScheduling(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*SchedulingContext, []gardencorev1beta1.Seed, []weightedScorePlugin, error) {
 	seedList := &gardencorev1beta1.SeedList{}
 	if err := r.Client.List(ctx, seedList); err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 	sl := &gardencorev1beta1.ShootList{}
 	if err := r.Client.List(ctx, sl); err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
-	shootList := v1beta1helper.ConvertShootList(sl.Items)
+	// The shoot to be scheduled must not account for the usage of the seeds (relevant if an existing shoot is explained).
+	shootList := slices.DeleteFunc(v1beta1helper.ConvertShootList(sl.Items), func(s *gardencorev1beta1.Shoot) bool {
+		return s.Namespace == shoot.Namespace && s.Name == shoot.Name
+	})
 
 	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 	regionConfig, err := r.getRegionConfigMap(ctx, log, cloudProfile)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
+	plugins, err := scorePlugins(r.Config.ScorePlugins)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsForAccessRestrictions(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
-	if err != nil {
-		return nil, err
-	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+
+	return &SchedulingContext{
+		Log:          log,
+		Shoot:        shoot,
+		CloudProfile: cloudProfile,
+		Shoots:       shootList,
+		RegionConfig: regionConfig,
+		Strategy:     r.Config.Strategy,
+	}, seedList.Items, plugins, nil
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
@@ -206,99 +206,6 @@ func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cl
 	return regionConfig, nil
 }
 
-func isUsableSeed(seed *gardencorev1beta1.Seed) bool {
-	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
-}
-
-func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
-	var matchingSeeds []gardencorev1beta1.Seed
-
-	for _, seed := range seedList {
-		if isUsableSeed(&seed) {
-			matchingSeeds = append(matchingSeeds, seed)
-		}
-	}
-
-	if len(matchingSeeds) == 0 {
-		return nil, fmt.Errorf("none of the %d seeds is valid for scheduling (not deleting, visible and ready)", len(seedList))
-	}
-	return matchingSeeds, nil
-}
-
-func filterSeedsMatchingLabelSelector(seedList []gardencorev1beta1.Seed, seedSelector *gardencorev1beta1.SeedSelector, kind string) ([]gardencorev1beta1.Seed, error) {
-	if seedSelector == nil {
-		return seedList, nil
-	}
-	selector, err := metav1.LabelSelectorAsSelector(&seedSelector.LabelSelector)
-	if err != nil {
-		return nil, fmt.Errorf("label selector conversion failed: %v for seedSelector: %w", seedSelector.LabelSelector, err)
-	}
-
-	var matchingSeeds []gardencorev1beta1.Seed
-	for _, seed := range seedList {
-		if selector.Matches(labels.Set(seed.Labels)) {
-			matchingSeeds = append(matchingSeeds, seed)
-		}
-	}
-
-	if len(matchingSeeds) == 0 {
-		return nil, fmt.Errorf("none out of the %d seeds has the matching labels required by seed selector of '%s' (selector: '%s')", len(seedList), kind, selector.String())
-	}
-	return matchingSeeds, nil
-}
-
-func filterSeedsMatchingProviders(cloudProfile *gardencorev1beta1.CloudProfile, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
-	var possibleProviders []string
-	if cloudProfile.Spec.SeedSelector != nil {
-		possibleProviders = cloudProfile.Spec.SeedSelector.ProviderTypes
-	}
-
-	var matchingSeeds []gardencorev1beta1.Seed
-	for _, seed := range seedList {
-		if matchProvider(seed.Spec.Provider.Type, shoot.Spec.Provider.Type, possibleProviders) {
-			matchingSeeds = append(matchingSeeds, seed)
-		}
-	}
-
-	if len(matchingSeeds) == 0 {
-		return nil, fmt.Errorf("none out of the %d seeds has a matching provider for %q", len(seedList), shoot.Spec.Provider.Type)
-	}
-	return matchingSeeds, nil
-}
-
-// filterSeedsForZonalShootControlPlanes filters seeds with at least three zones in case the shoot's failure tolerance
-// type is 'zone'.
-func filterSeedsForZonalShootControlPlanes(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) ([]gardencorev1beta1.Seed, error) {
-	if v1beta1helper.IsMultiZonalShootControlPlane(shoot) {
-		var seedsWithAtLeastThreeZones []gardencorev1beta1.Seed
-		for _, seed := range seedList {
-			if len(seed.Spec.Provider.Zones) >= 3 {
-				seedsWithAtLeastThreeZones = append(seedsWithAtLeastThreeZones, seed)
-			}
-		}
-		if len(seedsWithAtLeastThreeZones) == 0 {
-			return nil, fmt.Errorf("none of the %d seeds has at least 3 zones for hosting a shoot control plane with failure tolerance type 'zone'", len(seedList))
-		}
-		return seedsWithAtLeastThreeZones, nil
-	}
-	return seedList, nil
-}
-
-// filterSeedsForAccessRestrictions filters seeds which do not support the access restrictions configured in the shoot.
-func filterSeedsForAccessRestrictions(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) ([]gardencorev1beta1.Seed, error) {
-	var seedsSupportingAccessRestrictions []gardencorev1beta1.Seed
-	for _, seed := range seedList {
-		if v1beta1helper.AccessRestrictionsAreSupported(seed.Spec.AccessRestrictions, shoot.Spec.AccessRestrictions) {
-			seedsSupportingAccessRestrictions = append(seedsSupportingAccessRestrictions, seed)
-		}
-	}
-
-	if len(seedsSupportingAccessRestrictions) == 0 {
-		return nil, fmt.Errorf("none of the %d seeds supports the access restrictions configured in the shoot specification", len(seedList))
-	}
-	return seedsSupportingAccessRestrictions, nil
-}
-
 func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
 	var candidates []gardencorev1beta1.Seed
 
@@ -329,58 +236,6 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 	return candidates, nil
 }
 
-func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
-	var (
-		candidates    []gardencorev1beta1.Seed
-		seedNameToErr = make(map[string]error)
-		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
-	)
-
-	for _, seed := range seedList {
-		if shoot.Spec.Networking != nil {
-			if disjointed, err := networksAreDisjointed(&seed, shoot); !disjointed {
-				seedNameToErr[seed.Name] = err
-				continue
-			}
-		}
-
-		if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
-			seedNameToErr[seed.Name] = errors.New("shoot does not tolerate the seed's taints")
-			continue
-		}
-
-		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
-			seedNameToErr[seed.Name] = errors.New("seed does not have available capacity for shoots")
-			continue
-		}
-
-		candidates = append(candidates, seed)
-	}
-
-	if candidates == nil {
-		return nil, fmt.Errorf("0/%d seed cluster candidate(s) are eligible for scheduling: %v", len(seedList), errorMapToString(seedNameToErr))
-	}
-	return candidates, nil
-}
-
-// getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
-func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
-	var (
-		bestCandidate gardencorev1beta1.Seed
-		min           *int
-		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
-	)
-
-	for _, seed := range seedList {
-		if numberOfManagedShoots := seedUsage[seed.Name]; min == nil || numberOfManagedShoots < *min {
-			bestCandidate = seed
-			min = &numberOfManagedShoots
-		}
-	}
-
-	return &bestCandidate, nil
-}
-
 func matchProvider(seedProviderType, shootProviderType string, enabledProviderTypes []string) bool {
 	if len(enabledProviderTypes) == 0 {
 		return seedProviderType == shootProviderType
@@ -432,21 +287,15 @@ func determineCandidatesWithMinimalDistanceStrategy(log logr.Logger, shoot *gard
 func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
 	var candidates []gardencorev1beta1.Seed
 
-	if regionConfig == nil || regionConfig.Data[shoot.Spec.Region] == "" {
+	regionConfigData, err := regionConfigDistances(shoot, regionConfig)
+	if err != nil {
+		return nil, err
+	}
+	if regionConfigData == nil {
 		log.Info("Region ConfigMap not provided or Shoot region not available", "region", shoot.Spec.Region)
 		return candidates, nil
 	}
 
-	regionConfigData := make(map[string]int)
-	if err := yaml.Unmarshal([]byte(regionConfig.Data[shoot.Spec.Region]), &regionConfigData); err != nil {
-		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shoot.Spec.Region, err)
-	}
-
-	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
-	if _, ok := regionConfigData[shoot.Spec.Region]; !ok {
-		regionConfigData[shoot.Spec.Region] = 0
-	}
-
 	minDistance := math.MaxInt32
 	for _, seed := range seeds {
 		dist, ok := regionConfigData[seed.Spec.Provider.Region]
@@ -469,6 +318,26 @@ func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed
 	return candidates, nil
 }
 
+// regionConfigDistances returns the distances of the regions to the shoot's region configured in the given region
+// config. It returns nil if the region config is not provided or does not contain the shoot's region.
+func regionConfigDistances(shoot *gardencorev1beta1.Shoot, regionConfig *corev1.ConfigMap) (map[string]int, error) {
+	if regionConfig == nil || regionConfig.Data[shoot.Spec.Region] == "" {
+		return nil, nil
+	}
+
+	regionConfigData := make(map[string]int)
+	if err := yaml.Unmarshal([]byte(regionConfig.Data[shoot.Spec.Region]), &regionConfigData); err != nil {
+		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shoot.Spec.Region, err)
+	}
+
+	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
+	if _, ok := regionConfigData[shoot.Spec.Region]; !ok {
+		regionConfigData[shoot.Spec.Region] = 0
+	}
+
+	return regionConfigData, nil
+}
+
 func levenshteinMinimalDistance(seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.Seed {
 	var (
 		minDistance   = 1000
@@ -547,18 +416,6 @@ func networksAreDisjointed(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta
 	return len(errorMessages) == 0, fmt.Errorf("invalid networks: %s", errorMessages)
 }
 
-func errorMapToString(seedNameToErr map[string]error) string {
-	sortedSeeds := maps.Keys(seedNameToErr)
-	slices.Sort(sortedSeeds)
-
-	res := "{"
-	for _, seed := range sortedSeeds {
-		res += fmt.Sprintf("%s => %s, ", seed, seedNameToErr[seed].Error())
-	}
-	res = strings.TrimSuffix(res, ", ") + "}"
-	return res
-}
-
 func verifySeedReadiness(seed *gardencorev1beta1.Seed) bool {
 	if seed.Status.LastOperation == nil {
 		return false
@@ -478,6 +478,41 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
 
+		It("should pick candidate according to the configured score plugins", func() {
+			reconciler.Config = reconciler.Config.DeepCopy()
+			reconciler.Config.ScorePlugins = []schedulerconfigv1alpha1.ScorePlugin{
+				{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots, Weight: ptr.To[int32](1)},
+				{Name: schedulerconfigv1alpha1.ScorePluginResourceHeadroom, Weight: ptr.To[int32](3)},
+			}
+
+			seed.Status.Allocatable = corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100"),
+				corev1.ResourceMemory: resource.MustParse("400Gi"),
+			}
+
+			secondSeed := seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+			secondSeed.Status.Allocatable = corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("6Gi"),
+			}
+
+			secondShoot := shootBase.DeepCopy()
+			secondShoot.Name = "shoot-2"
+			// first seed hosts more shoots than seed-2 but has much more headroom -> expect first seed to be selected
+			secondShoot.Spec.SeedName = &seed.Name
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondShoot)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
 		It("should find seed cluster that matches the seed selector of the CloudProfile and is from another region", func() {
 			newCloudProfile := cloudProfile.DeepCopy()
 			newCloudProfile.Name = "cloudprofile2"
@@ -0,0 +1,299 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+var (
+	// controlPlaneBaseResources are the resources projected for a shoot control plane independent of the shoot size.
+	controlPlaneBaseResources = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("1"),
+		corev1.ResourceMemory: resource.MustParse("3Gi"),
+	}
+	// controlPlaneResourcesPerNode are the resources projected for a shoot control plane per (maximum) node of the shoot.
+	controlPlaneResourcesPerNode = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("20m"),
+		corev1.ResourceMemory: resource.MustParse("64Mi"),
+	}
+	// controlPlaneResourceNames are the resource names considered for the projected size of shoot control planes.
+	controlPlaneResourceNames = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+)
+
+// newScorePlugin returns the score plugin with the given name.
+func newScorePlugin(name string) (ScorePlugin, error) {
+	switch name {
+	case schedulerconfigv1alpha1.ScorePluginLeastShoots:
+		return &leastShootsScorer{}, nil
+	case schedulerconfigv1alpha1.ScorePluginResourceHeadroom:
+		return &resourceHeadroomScorer{}, nil
+	case schedulerconfigv1alpha1.ScorePluginControlPlaneLoad:
+		return &controlPlaneLoadScorer{}, nil
+	case schedulerconfigv1alpha1.ScorePluginRegionDistance:
+		return &regionDistanceScorer{}, nil
+	case schedulerconfigv1alpha1.ScorePluginProjectSpread:
+		return &projectSpreadScorer{}, nil
+	case schedulerconfigv1alpha1.ScorePluginZoneSpread:
+		return &zoneSpreadScorer{}, nil
+	}
+	return nil, fmt.Errorf("unknown score plugin %q, supported plugins are: %v", name, schedulerconfigv1alpha1.ScorePlugins)
+}
+
+// projectedControlPlaneResources returns the projected CPU and memory consumption of the control plane of the given
+// shoot. It grows with the maximum number of nodes of the shoot and doubles for highly available control planes.
+func projectedControlPlaneResources(shoot *gardencorev1beta1.Shoot) corev1.ResourceList {
+	var maxNodes int64
+	for _, worker := range shoot.Spec.Provider.Workers {
+		maxNodes += int64(worker.Maximum)
+	}
+
+	resources := make(corev1.ResourceList, len(controlPlaneResourceNames))
+	for _, name := range controlPlaneResourceNames {
+		quantity := controlPlaneBaseResources[name].DeepCopy()
+		perNode := controlPlaneResourcesPerNode[name]
+		quantity.Add(*resource.NewMilliQuantity(perNode.MilliValue()*maxNodes, perNode.Format))
+
+		if v1beta1helper.IsHAControlPlaneConfigured(shoot) {
+			quantity.Add(quantity.DeepCopy())
+		}
+
+		resources[name] = quantity
+	}
+
+	return resources
+}
+
+// projectedSeedUsage returns the summed projected resources of the control planes hosted by the given seed.
+func projectedSeedUsage(sc *SchedulingContext, seedName string) corev1.ResourceList {
+	usage := make(corev1.ResourceList, len(controlPlaneResourceNames))
+	for _, name := range controlPlaneResourceNames {
+		usage[name] = resource.Quantity{}
+	}
+
+	for _, shoot := range sc.ShootsOnSeed(seedName) {
+		for name, quantity := range projectedControlPlaneResources(shoot) {
+			sum := usage[name]
+			sum.Add(quantity)
+			usage[name] = sum
+		}
+	}
+
+	return usage
+}
+
+// scoreLowerIsBetter maps the given values to scores between 0 and MaxScore. The lowest value gets MaxScore, the
+// highest value gets 0. If all values are equal, all seeds get MaxScore.
+func scoreLowerIsBetter(values map[string]float64) map[string]int64 {
+	minValue, maxValue := math.Inf(1), math.Inf(-1)
+	for _, value := range values {
+		minValue = math.Min(minValue, value)
+		maxValue = math.Max(maxValue, value)
+	}
+
+	scores := make(map[string]int64, len(values))
+	for seedName, value := range values {
+		if maxValue == minValue {
+			scores[seedName] = MaxScore
+			continue
+		}
+		scores[seedName] = int64(math.Round(float64(MaxScore) * (maxValue - value) / (maxValue - minValue)))
+	}
+
+	return scores
+}
+
+// leastShootsScorer prefers seeds hosting fewer shoots.
+type leastShootsScorer struct{}
+
+func (s *leastShootsScorer) Name() string {
+	return schedulerconfigv1alpha1.ScorePluginLeastShoots
+}
+
+func (s *leastShootsScorer) Score(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	values := make(map[string]float64, len(seeds))
+	for _, seed := range seeds {
+		values[seed.Name] = float64(len(sc.ShootsOnSeed(seed.Name)))
+	}
+	return scoreLowerIsBetter(values), nil
+}
+
+// resourceHeadroomScorer prefers seeds with more allocatable CPU and memory left after adding the projected control
+// plane of the shoot. Seeds which do not report their CPU and memory capacity get the lowest score.
+type resourceHeadroomScorer struct{}
+
+func (s *resourceHeadroomScorer) Name() string {
+	return schedulerconfigv1alpha1.ScorePluginResourceHeadroom
+}
+
+func (s *resourceHeadroomScorer) Score(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var (
+		scores    = make(map[string]int64, len(seeds))
+		requested = projectedControlPlaneResources(sc.Shoot)
+	)
+
+	for _, seed := range seeds {
+		var (
+			usage     = projectedSeedUsage(sc, seed.Name)
+			headroom  float64
+			resources int
+		)
+
+		for _, name := range controlPlaneResourceNames {
+			allocatable, ok := seed.Status.Allocatable[name]
+			if !ok {
+				if allocatable, ok = seed.Status.Capacity[name]; !ok {
+					continue
+				}
+			}
+			if allocatable.IsZero() {
+				continue
+			}
+
+			used := usage[name]
+			used.Add(requested[name])
+
+			var (
+				allocatableValue = allocatable.AsApproximateFloat64()
+				usedValue        = used.AsApproximateFloat64()
+			)
+
+			headroom += math.Max(0, math.Min(1, (allocatableValue-usedValue)/allocatableValue))
+			resources++
+		}
+
+		if resources > 0 {
+			scores[seed.Name] = int64(math.Round(float64(MaxScore) * headroom / float64(resources)))
+		}
+	}
+
+	return scores, nil
+}
+
+// controlPlaneLoadScorer prefers seeds hosting smaller control planes in total, based on the projected control plane
+// sizes of the shoots on the seeds.
+type controlPlaneLoadScorer struct{}
+
+func (s *controlPlaneLoadScorer) Name() string {
+	return schedulerconfigv1alpha1.ScorePluginControlPlaneLoad
+}
+
+func (s *controlPlaneLoadScorer) Score(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	valuesByResource := make(map[corev1.ResourceName]map[string]float64, len(controlPlaneResourceNames))
+	for _, name := range controlPlaneResourceNames {
+		valuesByResource[name] = make(map[string]float64, len(seeds))
+	}
+
+	for _, seed := range seeds {
+		usage := projectedSeedUsage(sc, seed.Name)
+		for _, name := range controlPlaneResourceNames {
+			used := usage[name]
+			valuesByResource[name][seed.Name] = used.AsApproximateFloat64()
+		}
+	}
+
+	scores := make(map[string]int64, len(seeds))
+	for _, name := range controlPlaneResourceNames {
+		for seedName, score := range scoreLowerIsBetter(valuesByResource[name]) {
+			scores[seedName] += score
+		}
+	}
+	for seedName := range scores {
+		scores[seedName] /= int64(len(controlPlaneResourceNames))
+	}
+
+	return scores, nil
+}
+
+// regionDistanceScorer prefers seeds closer to the shoot's region. The distances are taken from the region config if
+// it contains the shoot's region, otherwise they are computed based on the region names.
+type regionDistanceScorer struct{}
+
+func (s *regionDistanceScorer) Name() string {
+	return schedulerconfigv1alpha1.ScorePluginRegionDistance
+}
+
+func (s *regionDistanceScorer) Score(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	regionConfigData, err := regionConfigDistances(sc.Shoot, sc.RegionConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]float64, len(seeds))
+
+	if regionConfigData != nil {
+		// Seeds whose region is not contained in the region config are considered farther away than all other seeds.
+		var maxDistance int
+		for _, dist := range regionConfigData {
+			maxDistance = max(maxDistance, dist)
+		}
+
+		for _, seed := range seeds {
+			dist, ok := regionConfigData[seed.Spec.Provider.Region]
+			if !ok {
+				dist = maxDistance + 1
+			}
+			values[seed.Name] = float64(dist)
+		}
+
+		return scoreLowerIsBetter(values), nil
+	}
+
+	for _, seed := range seeds {
+		dist := distance(seed.Spec.Provider.Region, sc.Shoot.Spec.Region)
+		if seed.Spec.Provider.Type != sc.Shoot.Spec.Provider.Type {
+			dist += 2
+		}
+		values[seed.Name] = float64(dist)
+	}
+
+	return scoreLowerIsBetter(values), nil
+}
+
+// projectSpreadScorer prefers seeds hosting fewer shoots of the shoot's project.
+type projectSpreadScorer struct{}
+
+func (s *projectSpreadScorer) Name() string {
+	return schedulerconfigv1alpha1.ScorePluginProjectSpread
+}
+
+func (s *projectSpreadScorer) Score(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	values := make(map[string]float64, len(seeds))
+	for _, seed := range seeds {
+		var count int
+		for _, shoot := range sc.ShootsOnSeed(seed.Name) {
+			if shoot.Namespace == sc.Shoot.Namespace {
+				count++
+			}
+		}
+		values[seed.Name] = float64(count)
+	}
+	return scoreLowerIsBetter(values), nil
+}
+
+// zoneSpreadScorer prefers seeds spanning more availability zones, i.e., seeds which allow spreading the control plane
+// of the shoot across more zones.
+type zoneSpreadScorer struct{}
+
+func (s *zoneSpreadScorer) Name() string {
+	return schedulerconfigv1alpha1.ScorePluginZoneSpread
+}
+
+func (s *zoneSpreadScorer) Score(_ *SchedulingContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	values := make(map[string]float64, len(seeds))
+	for _, seed := range seeds {
+		values[seed.Name] = -float64(len(sets.New(seed.Spec.Provider.Zones...)))
+	}
+	return scoreLowerIsBetter(values), nil
+}
@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+var _ = Describe("Scorers", func() {
+	var (
+		sc    *SchedulingContext
+		shoot *gardencorev1beta1.Shoot
+		seeds []gardencorev1beta1.Seed
+	)
+
+	newSeed := func(name, providerType, region string, allocatable corev1.ResourceList) gardencorev1beta1.Seed {
+		return gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{Type: providerType, Region: region}},
+			Status:     gardencorev1beta1.SeedStatus{Allocatable: allocatable},
+		}
+	}
+
+	newShoot := func(namespace, seedName string, maxNodes int32) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName: ptr.To(seedName),
+				Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{Name: "worker", Maximum: maxNodes}}},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Region:   "eu-west-1",
+				Provider: gardencorev1beta1.Provider{Type: "aws"},
+			},
+		}
+
+		seeds = []gardencorev1beta1.Seed{
+			newSeed("seed-1", "aws", "eu-west-1", corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("20"), corev1.ResourceMemory: resource.MustParse("60Gi")}),
+			newSeed("seed-2", "aws", "eu-central-1", corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10"), corev1.ResourceMemory: resource.MustParse("30Gi")}),
+			newSeed("seed-3", "gcp", "us-east-1", nil),
+		}
+
+		sc = &SchedulingContext{
+			Log:   logr.Discard(),
+			Shoot: shoot,
+			Shoots: []*gardencorev1beta1.Shoot{
+				newShoot("garden-foo", "seed-1", 100),
+				newShoot("garden-foo", "seed-1", 0),
+				newShoot("garden-bar", "seed-2", 0),
+			},
+		}
+	})
+
+	Describe("#projectedControlPlaneResources", func() {
+		It("should return the base resources for a shoot without nodes", func() {
+			resources := projectedControlPlaneResources(shoot)
+			Expect(resources.Cpu().Cmp(resource.MustParse("1"))).To(BeZero())
+			Expect(resources.Memory().Cmp(resource.MustParse("3Gi"))).To(BeZero())
+		})
+
+		It("should add the resources per maximum node of all workers", func() {
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Maximum: 50}, {Maximum: 50}}
+
+			resources := projectedControlPlaneResources(shoot)
+			Expect(resources.Cpu().Cmp(resource.MustParse("3"))).To(BeZero())
+			Expect(resources.Memory().Cmp(resource.MustParse("9472Mi"))).To(BeZero())
+		})
+
+		It("should double the resources for highly available control planes", func() {
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Maximum: 100}}
+			shoot.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{HighAvailability: &gardencorev1beta1.HighAvailability{}}
+
+			resources := projectedControlPlaneResources(shoot)
+			Expect(resources.Cpu().Cmp(resource.MustParse("6"))).To(BeZero())
+			Expect(resources.Memory().Cmp(resource.MustParse("18944Mi"))).To(BeZero())
+		})
+	})
+
+	Describe("#newScorePlugin", func() {
+		It("should return all supported plugins", func() {
+			for _, name := range schedulerconfigv1alpha1.ScorePlugins {
+				plugin, err := newScorePlugin(name)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(plugin.Name()).To(Equal(name))
+			}
+		})
+
+		It("should fail for an unknown plugin", func() {
+			_, err := newScorePlugin("Foo")
+			Expect(err).To(MatchError(ContainSubstring(`unknown score plugin "Foo"`)))
+		})
+	})
+
+	Describe("LeastShoots", func() {
+		It("should prefer seeds with fewer shoots", func() {
+			Expect((&leastShootsScorer{}).Score(sc, seeds)).To(Equal(map[string]int64{"seed-1": 0, "seed-2": 50, "seed-3": 100}))
+		})
+	})
+
+	Describe("ResourceHeadroom", func() {
+		It("should prefer seeds with more headroom after adding the shoot's control plane", func() {
+			// seed-1: cpu (20 - 3 - 1 - 1) / 20 = 0.75, memory (60Gi - 9.25Gi - 3Gi - 3Gi) / 60Gi ≈ 0.75
+			// seed-2: cpu (10 - 1 - 1) / 10 = 0.8, memory (30Gi - 3Gi - 3Gi) / 30Gi = 0.8
+			// seed-3: no allocatable resources
+			Expect((&resourceHeadroomScorer{}).Score(sc, seeds)).To(Equal(map[string]int64{"seed-1": 75, "seed-2": 80}))
+		})
+
+		It("should fall back to the capacity and clamp overcommitted seeds", func() {
+			seeds[2].Status.Capacity = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}
+
+			Expect((&resourceHeadroomScorer{}).Score(sc, seeds)).To(HaveKeyWithValue("seed-3", int64(0)))
+		})
+	})
+
+	Describe("ControlPlaneLoad", func() {
+		It("should prefer seeds hosting smaller control planes", func() {
+			Expect((&controlPlaneLoadScorer{}).Score(sc, seeds)).To(Equal(map[string]int64{"seed-1": 0, "seed-2": 75, "seed-3": 100}))
+		})
+	})
+
+	Describe("RegionDistance", func() {
+		It("should prefer seeds closer to the shoot's region based on the region names", func() {
+			Expect((&regionDistanceScorer{}).Score(sc, seeds)).To(Equal(map[string]int64{"seed-1": 100, "seed-2": 75, "seed-3": 0}))
+		})
+
+		It("should prefer seeds closer to the shoot's region based on the region config", func() {
+			sc.RegionConfig = &corev1.ConfigMap{Data: map[string]string{"eu-west-1": "us-east-1: 10\neu-central-1: 20"}}
+
+			Expect((&regionDistanceScorer{}).Score(sc, seeds)).To(Equal(map[string]int64{"seed-1": 100, "seed-2": 0, "seed-3": 50}))
+		})
+
+		It("should consider seeds missing in the region config farthest away", func() {
+			sc.RegionConfig = &corev1.ConfigMap{Data: map[string]string{"eu-west-1": "eu-central-1: 5"}}
+
+			Expect((&regionDistanceScorer{}).Score(sc, seeds)).To(Equal(map[string]int64{"seed-1": 100, "seed-2": 17, "seed-3": 0}))
+		})
+
+		It("should fail for an invalid region config", func() {
+			sc.RegionConfig = &corev1.ConfigMap{Data: map[string]string{"eu-west-1": "foo"}}
+
+			_, err := (&regionDistanceScorer{}).Score(sc, seeds)
+			Expect(err).To(MatchError(ContainSubstring("Wrong format in region ConfigMap")))
+		})
+	})
+
+	Describe("ProjectSpread", func() {
+		It("should prefer seeds hosting fewer shoots of the same project", func() {
+			Expect((&projectSpreadScorer{}).Score(sc, seeds)).To(Equal(map[string]int64{"seed-1": 0, "seed-2": 100, "seed-3": 100}))
+		})
+	})
+
+	Describe("ZoneSpread", func() {
+		It("should prefer seeds spanning more zones", func() {
+			seeds[0].Spec.Provider.Zones = []string{"a", "b", "c"}
+			seeds[1].Spec.Provider.Zones = []string{"a", "a"}
+
+			Expect((&zoneSpreadScorer{}).Score(sc, seeds)).To(Equal(map[string]int64{"seed-1": 100, "seed-2": 33, "seed-3": 0}))
+		})
+
+		It("should score all seeds equally if they span the same number of zones", func() {
+			Expect((&zoneSpreadScorer{}).Score(sc, seeds)).To(Equal(map[string]int64{"seed-1": 100, "seed-2": 100, "seed-3": 100}))
+		})
+	})
+
+	Describe("#runScorePlugins", func() {
+		It("should rank the seeds by their weighted scores", func() {
+			plugins, err := scorePlugins([]schedulerconfigv1alpha1.ScorePlugin{
+				{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: ptr.To[int32](1)},
+				{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots, Weight: ptr.To[int32](3)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			seedScores, err := runScorePlugins(sc, plugins, seeds)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(seedScores).To(HaveLen(3))
+			Expect(seedScores[0].Seed.Name).To(Equal("seed-3"))
+			Expect(seedScores[0].Score).To(Equal(int64(0 + 3*100)))
+			Expect(seedScores[1].Seed.Name).To(Equal("seed-2"))
+			Expect(seedScores[1].Score).To(Equal(int64(75 + 3*50)))
+			Expect(seedScores[1].PluginScores).To(Equal(map[string]int64{"RegionDistance": 75, "LeastShoots": 50}))
+			Expect(seedScores[2].Seed.Name).To(Equal("seed-1"))
+		})
+
+		It("should use the LeastShoots plugin by default and keep the order of seeds with equal scores", func() {
+			plugins, err := scorePlugins(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			sc.Shoots = nil
+			seedScores, err := runScorePlugins(sc, plugins, seeds)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(seedScores[0].Seed.Name).To(Equal("seed-1"))
+			Expect(seedScores[1].Seed.Name).To(Equal("seed-2"))
+			Expect(seedScores[2].Seed.Name).To(Equal("seed-3"))
+		})
+	})
+})
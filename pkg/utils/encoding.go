@@ -5,6 +5,8 @@
 package utils
 
 import (
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/x509"
@@ -12,6 +14,7 @@ import (
 	"encoding/hex"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"slices"
 	"strconv"
 
@@ -50,6 +53,32 @@ func EncodePrivateKeyInPKCS8(key *rsa.PrivateKey) ([]byte, error) {
 	}), nil
 }
 
+// EncodeECPrivateKey takes an ECDSA private key object, encodes it to the SEC 1 PEM format, and returns it as a byte
+// slice.
+func EncodeECPrivateKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	bytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "EC PRIVATE KEY",
+		Bytes: bytes,
+	}), nil
+}
+
+// EncodePrivateKeyInPKCS8PEM takes a private key object of any supported algorithm (RSA, ECDSA, Ed25519), encodes it
+// to the PKCS8 format with the generic 'PRIVATE KEY' PEM block type, and returns it as a byte slice.
+func EncodePrivateKeyInPKCS8PEM(key crypto.Signer) ([]byte, error) {
+	bytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: bytes,
+	}), nil
+}
+
 // DecodeRSAPrivateKeyFromPKCS8 takes a byte slice, decodes it from the PKCS8 format, tries to convert it
 // to an rsa.PrivateKey object, and returns it. In case an error occurs, it returns the error.
 func DecodeRSAPrivateKeyFromPKCS8(bytes []byte) (*rsa.PrivateKey, error) {
@@ -78,6 +107,39 @@ func DecodePrivateKey(bytes []byte) (*rsa.PrivateKey, error) {
 	return x509.ParsePKCS1PrivateKey(block.Bytes)
 }
 
+// DecodePrivateKeySigner takes a byte slice, decodes it from the PEM format, and returns the contained private key of
+// any supported algorithm (RSA, ECDSA, Ed25519). It supports PKCS1 ('RSA PRIVATE KEY'), SEC 1 ('EC PRIVATE KEY'), and
+// PKCS8 ('PRIVATE KEY' and, for backwards-compatibility, 'RSA PRIVATE KEY') encoded keys.
+func DecodePrivateKeySigner(bytes []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(bytes)
+	if block == nil {
+		return nil, errors.New("could not decode the PEM-encoded private key")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return key, nil
+		}
+		// EncodePrivateKeyInPKCS8 uses the 'RSA PRIVATE KEY' block type for PKCS8 encoded keys.
+		return DecodeRSAPrivateKeyFromPKCS8(bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q for private key", block.Type)
+	}
+}
+
 // EncodeCertificate takes a certificate as a byte slice, encodes it to the PEM format, and returns
 // it as byte slice.
 func EncodeCertificate(certificate []byte) []byte {
@@ -5,6 +5,11 @@
 package utils_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
 	"errors"
 	"strings"
@@ -27,6 +32,49 @@ var _ = Describe("Encoding", func() {
 		})
 	})
 
+	Describe("#DecodePrivateKeySigner", func() {
+		It("should decode PKCS1 and PKCS8 encoded RSA private keys", func() {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(DecodePrivateKeySigner(EncodePrivateKey(key))).To(Equal(key))
+
+			pkcs8, err := EncodePrivateKeyInPKCS8(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodePrivateKeySigner(pkcs8)).To(Equal(key))
+		})
+
+		It("should decode SEC 1 and PKCS8 encoded ECDSA private keys", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			sec1, err := EncodeECPrivateKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodePrivateKeySigner(sec1)).To(Equal(key))
+
+			pkcs8, err := EncodePrivateKeyInPKCS8PEM(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodePrivateKeySigner(pkcs8)).To(Equal(key))
+		})
+
+		It("should decode PKCS8 encoded Ed25519 private keys", func() {
+			_, key, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			pkcs8, err := EncodePrivateKeyInPKCS8PEM(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodePrivateKeySigner(pkcs8)).To(Equal(key))
+		})
+
+		It("should fail for unsupported PEM blocks", func() {
+			_, err := DecodePrivateKeySigner([]byte("-----BEGIN CERTIFICATE-----\nZm9v\n-----END CERTIFICATE-----\n"))
+			Expect(err).To(MatchError(`unsupported PEM block type "CERTIFICATE" for private key`))
+
+			_, err = DecodePrivateKeySigner([]byte("foo"))
+			Expect(err).To(MatchError("could not decode the PEM-encoded private key"))
+		})
+	})
+
 	DescribeTable("#ComputeGardenNamespace",
 		func(data []byte, csrMatcher func(*x509.CertificateRequest), errMatcher gomegatypes.GomegaMatcher) {
 			csr, err := DecodeCertificateRequest(data)
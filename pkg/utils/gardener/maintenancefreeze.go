@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// ActiveMaintenanceFreeze returns the MaintenanceFreeze and its window which are active for the given Shoot at the
+// given point in time. If multiple freezes are active, the one whose window ends last is returned. If no freeze is
+// active, nil is returned.
+func ActiveMaintenanceFreeze(ctx context.Context, reader client.Reader, shoot *gardencorev1beta1.Shoot, now time.Time) (*gardencorev1beta1.MaintenanceFreeze, *gardencorev1beta1.MaintenanceFreezeWindow, error) {
+	maintenanceFreezeList := &gardencorev1beta1.MaintenanceFreezeList{}
+	if err := reader.List(ctx, maintenanceFreezeList); err != nil {
+		return nil, nil, fmt.Errorf("failed listing maintenance freezes: %w", err)
+	}
+
+	var (
+		activeFreeze *gardencorev1beta1.MaintenanceFreeze
+		activeWindow *gardencorev1beta1.MaintenanceFreezeWindow
+
+		project        *gardencorev1beta1.Project
+		projectFetched bool
+	)
+
+	for i := range maintenanceFreezeList.Items {
+		maintenanceFreeze := &maintenanceFreezeList.Items[i]
+
+		window := activeMaintenanceFreezeWindow(maintenanceFreeze.Spec.Windows, now)
+		if window == nil || (activeWindow != nil && !window.End.After(activeWindow.End.Time)) {
+			continue
+		}
+
+		if matches, err := selectorMatches(maintenanceFreeze.Spec.ShootSelector, shoot.Labels); err != nil {
+			return nil, nil, fmt.Errorf("invalid shoot selector of maintenance freeze %s: %w", maintenanceFreeze.Name, err)
+		} else if !matches {
+			continue
+		}
+
+		if maintenanceFreeze.Spec.ProjectSelector != nil {
+			if !projectFetched {
+				var err error
+				if project, err = ProjectForNamespaceFromReader(ctx, reader, shoot.Namespace); err != nil && !apierrors.IsNotFound(err) {
+					return nil, nil, fmt.Errorf("failed getting project for namespace %s: %w", shoot.Namespace, err)
+				}
+				projectFetched = true
+			}
+
+			if project == nil {
+				continue
+			}
+			if matches, err := selectorMatches(maintenanceFreeze.Spec.ProjectSelector, project.Labels); err != nil {
+				return nil, nil, fmt.Errorf("invalid project selector of maintenance freeze %s: %w", maintenanceFreeze.Name, err)
+			} else if !matches {
+				continue
+			}
+		}
+
+		activeFreeze, activeWindow = maintenanceFreeze, window
+	}
+
+	return activeFreeze, activeWindow, nil
+}
+
+// activeMaintenanceFreezeWindow returns the window which is active at the given point in time. Windows include their
+// begin but exclude their end. If multiple windows are active, the one ending last is returned.
+func activeMaintenanceFreezeWindow(windows []gardencorev1beta1.MaintenanceFreezeWindow, now time.Time) *gardencorev1beta1.MaintenanceFreezeWindow {
+	var active *gardencorev1beta1.MaintenanceFreezeWindow
+
+	for i := range windows {
+		window := &windows[i]
+		if now.Before(window.Begin.Time) || !now.Before(window.End.Time) {
+			continue
+		}
+		if active == nil || window.End.After(active.End.Time) {
+			active = window
+		}
+	}
+
+	return active
+}
+
+func selectorMatches(labelSelector *metav1.LabelSelector, objectLabels map[string]string) (bool, error) {
+	if labelSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(objectLabels)), nil
+}
+
+// MaintenanceFreezeMessage returns a human-readable description of the given active maintenance freeze window which
+// can be used in events and logs.
+func MaintenanceFreezeMessage(maintenanceFreeze *gardencorev1beta1.MaintenanceFreeze, window *gardencorev1beta1.MaintenanceFreezeWindow) string {
+	message := fmt.Sprintf("maintenance freeze %q is active until %s", maintenanceFreeze.Name, window.End.UTC().Format(time.RFC3339))
+	if window.Reason != nil && *window.Reason != "" {
+		message += fmt.Sprintf(" (reason: %s)", *window.Reason)
+	}
+	return message
+}
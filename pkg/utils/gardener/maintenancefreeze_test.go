@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("MaintenanceFreeze", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+
+		now   = time.Date(2026, 12, 24, 12, 0, 0, 0, time.UTC)
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	window := func(begin, end time.Time) gardencorev1beta1.MaintenanceFreezeWindow {
+		return gardencorev1beta1.MaintenanceFreezeWindow{Begin: metav1.NewTime(begin), End: metav1.NewTime(end)}
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, gardencore.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			Build()
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shoot",
+				Namespace: "garden-dev",
+				Labels:    map[string]string{"environment": "production"},
+			},
+		}
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev", Labels: map[string]string{"cost-center": "1234"}},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-dev")},
+		})).To(Succeed())
+	})
+
+	Describe("#ActiveMaintenanceFreeze", func() {
+		It("should return nil if there are no maintenance freezes", func() {
+			freeze, window, err := ActiveMaintenanceFreeze(ctx, fakeClient, shoot, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(BeNil())
+			Expect(window).To(BeNil())
+		})
+
+		It("should ignore freezes without active window", func() {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.MaintenanceFreeze{
+				ObjectMeta: metav1.ObjectMeta{Name: "past-and-future"},
+				Spec: gardencorev1beta1.MaintenanceFreezeSpec{Windows: []gardencorev1beta1.MaintenanceFreezeWindow{
+					window(now.Add(-48*time.Hour), now),
+					window(now.Add(time.Second), now.Add(48*time.Hour)),
+				}},
+			})).To(Succeed())
+
+			freeze, _, err := ActiveMaintenanceFreeze(ctx, fakeClient, shoot, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(BeNil())
+		})
+
+		It("should return the active freeze applying to all shoots", func() {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.MaintenanceFreeze{
+				ObjectMeta: metav1.ObjectMeta{Name: "holidays"},
+				Spec: gardencorev1beta1.MaintenanceFreezeSpec{Windows: []gardencorev1beta1.MaintenanceFreezeWindow{
+					window(now, now.Add(time.Hour)),
+				}},
+			})).To(Succeed())
+
+			freeze, window, err := ActiveMaintenanceFreeze(ctx, fakeClient, shoot, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze.Name).To(Equal("holidays"))
+			Expect(window.End.Time).To(BeTemporally("==", now.Add(time.Hour)))
+		})
+
+		It("should respect the shoot and project selectors", func() {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.MaintenanceFreeze{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-shoots"},
+				Spec: gardencorev1beta1.MaintenanceFreezeSpec{
+					Windows:       []gardencorev1beta1.MaintenanceFreezeWindow{window(now.Add(-time.Hour), now.Add(72*time.Hour))},
+					ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"environment": "development"}},
+				},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.MaintenanceFreeze{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-projects"},
+				Spec: gardencorev1beta1.MaintenanceFreezeSpec{
+					Windows:         []gardencorev1beta1.MaintenanceFreezeWindow{window(now.Add(-time.Hour), now.Add(72*time.Hour))},
+					ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"cost-center": "5678"}},
+				},
+			})).To(Succeed())
+
+			freeze, _, err := ActiveMaintenanceFreeze(ctx, fakeClient, shoot, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(BeNil())
+
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.MaintenanceFreeze{
+				ObjectMeta: metav1.ObjectMeta{Name: "production"},
+				Spec: gardencorev1beta1.MaintenanceFreezeSpec{
+					Windows:         []gardencorev1beta1.MaintenanceFreezeWindow{window(now.Add(-time.Hour), now.Add(time.Hour))},
+					ShootSelector:   &metav1.LabelSelector{MatchLabels: map[string]string{"environment": "production"}},
+					ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"cost-center": "1234"}},
+				},
+			})).To(Succeed())
+
+			freeze, _, err = ActiveMaintenanceFreeze(ctx, fakeClient, shoot, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze.Name).To(Equal("production"))
+		})
+
+		It("should return the freeze whose active window ends last", func() {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.MaintenanceFreeze{
+				ObjectMeta: metav1.ObjectMeta{Name: "short"},
+				Spec: gardencorev1beta1.MaintenanceFreezeSpec{Windows: []gardencorev1beta1.MaintenanceFreezeWindow{
+					window(now.Add(-time.Hour), now.Add(time.Hour)),
+				}},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.MaintenanceFreeze{
+				ObjectMeta: metav1.ObjectMeta{Name: "long"},
+				Spec: gardencorev1beta1.MaintenanceFreezeSpec{Windows: []gardencorev1beta1.MaintenanceFreezeWindow{
+					window(now.Add(-time.Hour), now.Add(2*time.Hour)),
+					window(now.Add(-2*time.Hour), now.Add(3*time.Hour)),
+				}},
+			})).To(Succeed())
+
+			freeze, window, err := ActiveMaintenanceFreeze(ctx, fakeClient, shoot, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze.Name).To(Equal("long"))
+			Expect(window.End.Time).To(BeTemporally("==", now.Add(3*time.Hour)))
+		})
+	})
+
+	Describe("#MaintenanceFreezeMessage", func() {
+		It("should return the message without reason", func() {
+			Expect(MaintenanceFreezeMessage(
+				&gardencorev1beta1.MaintenanceFreeze{ObjectMeta: metav1.ObjectMeta{Name: "holidays"}},
+				&gardencorev1beta1.MaintenanceFreezeWindow{End: metav1.NewTime(now)},
+			)).To(Equal(`maintenance freeze "holidays" is active until 2026-12-24T12:00:00Z`))
+		})
+
+		It("should return the message with reason", func() {
+			Expect(MaintenanceFreezeMessage(
+				&gardencorev1beta1.MaintenanceFreeze{ObjectMeta: metav1.ObjectMeta{Name: "holidays"}},
+				&gardencorev1beta1.MaintenanceFreezeWindow{End: metav1.NewTime(now), Reason: ptr.To("Christmas")},
+			)).To(Equal(`maintenance freeze "holidays" is active until 2026-12-24T12:00:00Z (reason: Christmas)`))
+		})
+	})
+})
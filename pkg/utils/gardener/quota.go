@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// QuotaMetrics are the metrics which can be constrained by Quotas.
+var QuotaMetrics = []corev1.ResourceName{
+	core.QuotaMetricCPU,
+	core.QuotaMetricGPU,
+	core.QuotaMetricMemory,
+	core.QuotaMetricStorageStandard,
+	core.QuotaMetricStoragePremium,
+	core.QuotaMetricLoadbalancer,
+	core.QuotaMetricShoots,
+	core.QuotaMetricNodes,
+	core.QuotaMetricControlPlanesHighAvailability,
+	core.QuotaMetricCostUnits,
+}
+
+// ShootQuotaResources returns the amount of the resources constrained by Quotas which the given Shoot allocates. The
+// amounts are computed based on the maximum sizes of the worker pools and the machine types in the given CloudProfile
+// spec.
+func ShootQuotaResources(shoot *gardencorev1beta1.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) (corev1.ResourceList, error) {
+	var (
+		countLB   int64 = 1
+		countHA   int64
+		resources = corev1.ResourceList{core.QuotaMetricShoots: *resource.NewQuantity(1, resource.DecimalSI)}
+	)
+
+	for _, worker := range shootQuotaWorkers(shoot, cloudProfileSpec) {
+		var (
+			machineType *gardencorev1beta1.MachineType
+			volumeType  *gardencorev1beta1.VolumeType
+		)
+
+		// Get the proper machineType
+		for _, e := range cloudProfileSpec.MachineTypes {
+			element := e
+			if element.Name == worker.Machine.Type {
+				machineType = &element
+				break
+			}
+		}
+		if machineType == nil {
+			return nil, fmt.Errorf("machineType %s not found in CloudProfile", worker.Machine.Type)
+		}
+
+		if worker.Volume != nil {
+			if machineType.Storage != nil {
+				volumeType = &gardencorev1beta1.VolumeType{
+					Class: machineType.Storage.Class,
+				}
+			} else {
+				// Get the proper VolumeType
+				for _, e := range cloudProfileSpec.VolumeTypes {
+					element := e
+					if worker.Volume.Type != nil && element.Name == *worker.Volume.Type {
+						volumeType = &element
+						break
+					}
+				}
+			}
+		}
+		if volumeType == nil {
+			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile", worker.Machine.Type)
+		}
+
+		// For now we always use the max. amount of resources for quota calculation
+		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
+		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
+		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
+		resources[core.QuotaMetricNodes] = sumQuantity(resources[core.QuotaMetricNodes], *resource.NewQuantity(int64(worker.Maximum), resource.DecimalSI))
+		resources[core.QuotaMetricCostUnits] = sumQuantity(resources[core.QuotaMetricCostUnits], multiplyQuantity(ptr.Deref(machineType.CostUnits, resource.Quantity{}), worker.Maximum))
+
+		size, _ := resource.ParseQuantity("0Gi")
+		if worker.Volume != nil {
+			var err error
+			size, err = resource.ParseQuantity(worker.Volume.VolumeSize)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		switch volumeType.Class {
+		case gardencorev1beta1.VolumeClassStandard:
+			resources[core.QuotaMetricStorageStandard] = sumQuantity(resources[core.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
+		case gardencorev1beta1.VolumeClassPremium:
+			resources[core.QuotaMetricStoragePremium] = sumQuantity(resources[core.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
+		default:
+			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
+		}
+	}
+
+	if v1beta1helper.NginxIngressEnabled(shoot.Spec.Addons) {
+		countLB++
+	}
+	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+
+	if v1beta1helper.IsHAControlPlaneConfigured(shoot) {
+		countHA++
+	}
+	resources[core.QuotaMetricControlPlanesHighAvailability] = *resource.NewQuantity(countHA, resource.DecimalSI)
+
+	return resources, nil
+}
+
+// shootQuotaWorkers returns the worker pools of the given Shoot. Worker pools without volume configuration get the
+// root volume of their machine type.
+func shootQuotaWorkers(shoot *gardencorev1beta1.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) []gardencorev1beta1.Worker {
+	workers := make([]gardencorev1beta1.Worker, 0, len(shoot.Spec.Provider.Workers))
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		workerCopy := worker.DeepCopy()
+
+		if worker.Volume == nil {
+			for _, machineType := range cloudProfileSpec.MachineTypes {
+				if worker.Machine.Type == machineType.Name && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
+					workerCopy.Volume = &gardencorev1beta1.Volume{
+						Type:       &machineType.Storage.Type,
+						VolumeSize: machineType.Storage.StorageSize.String(),
+					}
+				}
+			}
+		}
+
+		workers = append(workers, *workerCopy)
+	}
+
+	return workers
+}
+
+// SumQuotaResources adds the given resource lists for all metrics which can be constrained by Quotas.
+func SumQuotaResources(resourceLists ...corev1.ResourceList) corev1.ResourceList {
+	sum := make(corev1.ResourceList, len(QuotaMetrics))
+	for _, metric := range QuotaMetrics {
+		quantity := resource.Quantity{}
+		for _, resources := range resourceLists {
+			quantity.Add(resources[metric])
+		}
+		sum[metric] = quantity
+	}
+	return sum
+}
+
+func sumQuantity(values ...resource.Quantity) resource.Quantity {
+	res := resource.Quantity{}
+	for _, v := range values {
+		res.Add(v)
+	}
+	return res
+}
+
+func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
+	res := resource.Quantity{}
+	for i := 0; i < int(multiplier); i++ {
+		res.Add(quantity)
+	}
+	return res
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("Quota", func() {
+	var (
+		cloudProfileSpec *gardencorev1beta1.CloudProfileSpec
+		shoot            *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		cloudProfileSpec = &gardencorev1beta1.CloudProfileSpec{
+			MachineTypes: []gardencorev1beta1.MachineType{
+				{
+					Name:      "small",
+					CPU:       resource.MustParse("2"),
+					GPU:       resource.MustParse("0"),
+					Memory:    resource.MustParse("8Gi"),
+					CostUnits: ptr.To(resource.MustParse("1.5")),
+				},
+				{
+					Name:   "large",
+					CPU:    resource.MustParse("8"),
+					GPU:    resource.MustParse("1"),
+					Memory: resource.MustParse("32Gi"),
+					Storage: &gardencorev1beta1.MachineTypeStorage{
+						Class:       gardencorev1beta1.VolumeClassPremium,
+						Type:        "ssd",
+						StorageSize: ptr.To(resource.MustParse("100Gi")),
+					},
+				},
+			},
+			VolumeTypes: []gardencorev1beta1.VolumeType{
+				{Name: "standard", Class: gardencorev1beta1.VolumeClassStandard},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{
+						{
+							Name:    "small",
+							Machine: gardencorev1beta1.Machine{Type: "small"},
+							Maximum: 3,
+							Volume:  &gardencorev1beta1.Volume{Type: ptr.To("standard"), VolumeSize: "20Gi"},
+						},
+						{
+							Name:    "large",
+							Machine: gardencorev1beta1.Machine{Type: "large"},
+							Maximum: 2,
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ShootQuotaResources", func() {
+		It("should compute the resources allocated by the shoot", func() {
+			resources, err := ShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(quantityStrings(resources)).To(Equal(map[corev1.ResourceName]string{
+				"cpu":                            "22",
+				"gpu":                            "2",
+				"memory":                         "88Gi",
+				"storage.standard":               "60Gi",
+				"storage.premium":                "200Gi",
+				"loadbalancer":                   "1",
+				"shoots":                         "1",
+				"nodes":                          "5",
+				"controlplanes.highavailability": "0",
+				"costunits":                      "4500m",
+			}))
+		})
+
+		It("should count highly available control planes and nginx ingress load balancers", func() {
+			shoot.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{HighAvailability: &gardencorev1beta1.HighAvailability{}}
+			shoot.Spec.Addons = &gardencorev1beta1.Addons{NginxIngress: &gardencorev1beta1.NginxIngress{Addon: gardencorev1beta1.Addon{Enabled: true}}}
+
+			resources, err := ShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(quantityStrings(resources)).To(And(
+				HaveKeyWithValue(corev1.ResourceName("controlplanes.highavailability"), "1"),
+				HaveKeyWithValue(corev1.ResourceName("loadbalancer"), "2"),
+			))
+		})
+
+		It("should fail if the machine type is unknown", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+
+			_, err := ShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).To(MatchError("machineType unknown not found in CloudProfile"))
+		})
+	})
+
+	Describe("#SumQuotaResources", func() {
+		It("should add the resources of all quota metrics", func() {
+			Expect(quantityStrings(SumQuotaResources(
+				corev1.ResourceList{"cpu": resource.MustParse("2"), "costunits": resource.MustParse("1.5"), "foo": resource.MustParse("1")},
+				corev1.ResourceList{"cpu": resource.MustParse("4"), "shoots": resource.MustParse("1")},
+			))).To(Equal(map[corev1.ResourceName]string{
+				"cpu":                            "6",
+				"gpu":                            "0",
+				"memory":                         "0",
+				"storage.standard":               "0",
+				"storage.premium":                "0",
+				"loadbalancer":                   "0",
+				"shoots":                         "1",
+				"nodes":                          "0",
+				"controlplanes.highavailability": "0",
+				"costunits":                      "1500m",
+			}))
+		})
+	})
+})
+
+func quantityStrings(resources corev1.ResourceList) map[corev1.ResourceName]string {
+	out := make(map[corev1.ResourceName]string, len(resources))
+	for name, quantity := range resources {
+		out[name] = quantity.String()
+	}
+	return out
+}
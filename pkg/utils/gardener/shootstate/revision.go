@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// RevisionName returns the name of the ShootState revision of the shoot with the given name for the given timestamp.
+func RevisionName(shootName string, timestamp time.Time) string {
+	return shootName + v1beta1constants.ShootStateRevisionNameInfix + strconv.FormatInt(timestamp.Unix(), 10)
+}
+
+// IsRevision returns whether the given ShootState is a revision of another ShootState.
+func IsRevision(shootState *gardencorev1beta1.ShootState) bool {
+	shootName, ok := shootState.Labels[v1beta1constants.LabelShootName]
+	return ok && strings.HasPrefix(shootState.Name, shootName+v1beta1constants.ShootStateRevisionNameInfix)
+}
+
+// Timestamp returns the time at which the given ShootState was persisted.
+func Timestamp(shootState *gardencorev1beta1.ShootState) (time.Time, bool) {
+	timestamp, err := time.Parse(time.RFC3339, shootState.Annotations[v1beta1constants.GardenerTimestamp])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return timestamp, true
+}
+
+// ListRevisions returns the ShootState revisions of the shoot with the given namespace and name, ordered from the
+// newest to the oldest.
+func ListRevisions(ctx context.Context, reader client.Reader, namespace, shootName string) ([]gardencorev1beta1.ShootState, error) {
+	shootStateList := &gardencorev1beta1.ShootStateList{}
+	if err := reader.List(ctx, shootStateList, client.InNamespace(namespace), client.MatchingLabels{v1beta1constants.LabelShootName: shootName}); err != nil {
+		return nil, fmt.Errorf("failed listing ShootState revisions: %w", err)
+	}
+
+	revisions := slices.DeleteFunc(shootStateList.Items, func(shootState gardencorev1beta1.ShootState) bool {
+		return !IsRevision(&shootState)
+	})
+
+	slices.SortStableFunc(revisions, func(a, b gardencorev1beta1.ShootState) int {
+		timestampA, _ := Timestamp(&a)
+		timestampB, _ := Timestamp(&b)
+		return timestampB.Compare(timestampA)
+	})
+
+	return revisions, nil
+}
+
+// CreateRevision stores a copy of the given ShootState as a revision. It returns the revision and whether it was
+// newly created.
+func CreateRevision(ctx context.Context, c client.Client, shootState *gardencorev1beta1.ShootState) (*gardencorev1beta1.ShootState, bool, error) {
+	timestamp, ok := Timestamp(shootState)
+	if !ok {
+		return nil, false, fmt.Errorf("ShootState %s has no valid %s annotation", client.ObjectKeyFromObject(shootState), v1beta1constants.GardenerTimestamp)
+	}
+
+	revision := &gardencorev1beta1.ShootState{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RevisionName(shootState.Name, timestamp),
+			Namespace: shootState.Namespace,
+			Labels:    map[string]string{v1beta1constants.LabelShootName: shootState.Name},
+			Annotations: map[string]string{
+				v1beta1constants.GardenerTimestamp: timestamp.UTC().Format(time.RFC3339),
+			},
+		},
+		Spec: *shootState.Spec.DeepCopy(),
+	}
+
+	if err := c.Create(ctx, revision); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return revision, false, nil
+		}
+		return nil, false, fmt.Errorf("failed creating ShootState revision %s: %w", client.ObjectKeyFromObject(revision), err)
+	}
+
+	return revision, true, nil
+}
+
+// DeleteRevision deletes the given ShootState revision.
+func DeleteRevision(ctx context.Context, c client.Client, revision *gardencorev1beta1.ShootState) error {
+	if err := gardenerutils.ConfirmDeletion(ctx, c, revision); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	return client.IgnoreNotFound(c.Delete(ctx, revision))
+}
+
+// RestoreFromRevision replaces the secrets and extension states in the spec of the given ShootState with the ones
+// persisted in the given revision. Other Gardener data, e.g. the state of the machines, is kept.
+func RestoreFromRevision(shootState, revision *gardencorev1beta1.ShootState) {
+	spec := revision.Spec.DeepCopy()
+
+	var gardenerData []gardencorev1beta1.GardenerResourceData
+	for _, data := range shootState.Spec.Gardener {
+		if data.Type != v1beta1constants.DataTypeSecret {
+			gardenerData = append(gardenerData, data)
+		}
+	}
+	for _, data := range spec.Gardener {
+		if data.Type == v1beta1constants.DataTypeSecret {
+			gardenerData = append(gardenerData, data)
+		}
+	}
+
+	shootState.Spec.Gardener = gardenerData
+	shootState.Spec.Extensions = spec.Extensions
+	shootState.Spec.Resources = spec.Resources
+	metav1.SetMetaDataAnnotation(&shootState.ObjectMeta, v1beta1constants.AnnotationShootStateRestoredFromRevision, revision.Name)
+}
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Revision", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeGardenClient client.Client
+		shootState       *gardencorev1beta1.ShootState
+	)
+
+	BeforeEach(func() {
+		fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		shootState = &gardencorev1beta1.ShootState{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "my-shoot",
+				Namespace:   "garden-my-project",
+				Annotations: map[string]string{"gardener.cloud/timestamp": "2025-01-01T00:00:00Z"},
+			},
+			Spec: gardencorev1beta1.ShootStateSpec{
+				Gardener: []gardencorev1beta1.GardenerResourceData{
+					{Name: "ca", Type: "secret", Data: runtime.RawExtension{Raw: []byte(`{"new":"ca"}`)}},
+					{Name: "kube-apiserver", Type: "secret", Data: runtime.RawExtension{Raw: []byte(`{"new":"kube-apiserver"}`)}},
+					{Name: "machine-state", Type: "machine-state", Data: runtime.RawExtension{Raw: []byte(`{"new":"machines"}`)}},
+				},
+				Extensions: []gardencorev1beta1.ExtensionResourceState{
+					{Kind: "Infrastructure", State: &runtime.RawExtension{Raw: []byte(`{"new":"infrastructure"}`)}},
+				},
+			},
+		}
+	})
+
+	Describe("#RevisionName", func() {
+		It("should return the name of the revision", func() {
+			Expect(RevisionName("my-shoot", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))).To(Equal("my-shoot--rev-1735689600"))
+		})
+	})
+
+	Describe("#IsRevision", func() {
+		It("should return true for revisions", func() {
+			Expect(IsRevision(&gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{
+				Name:   "my-shoot--rev-1735689600",
+				Labels: map[string]string{"shoot.gardener.cloud/name": "my-shoot"},
+			}})).To(BeTrue())
+		})
+
+		It("should return false for ShootStates of shoots", func() {
+			Expect(IsRevision(shootState)).To(BeFalse())
+		})
+
+		It("should return false if the name does not match the label", func() {
+			Expect(IsRevision(&gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{
+				Name:   "other-shoot--rev-1735689600",
+				Labels: map[string]string{"shoot.gardener.cloud/name": "my-shoot"},
+			}})).To(BeFalse())
+		})
+	})
+
+	Describe("#CreateRevision", func() {
+		It("should create a revision of the ShootState", func() {
+			revision, created, err := CreateRevision(ctx, fakeGardenClient, shootState)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(revision), revision)).To(Succeed())
+			Expect(revision.Name).To(Equal("my-shoot--rev-1735689600"))
+			Expect(revision.Labels).To(Equal(map[string]string{"shoot.gardener.cloud/name": "my-shoot"}))
+			Expect(revision.Annotations).To(Equal(map[string]string{"gardener.cloud/timestamp": "2025-01-01T00:00:00Z"}))
+			Expect(revision.Spec).To(Equal(shootState.Spec))
+		})
+
+		It("should not fail if the revision already exists", func() {
+			_, _, err := CreateRevision(ctx, fakeGardenClient, shootState)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, created, err := CreateRevision(ctx, fakeGardenClient, shootState)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeFalse())
+		})
+
+		It("should fail if the ShootState has no timestamp", func() {
+			delete(shootState.Annotations, "gardener.cloud/timestamp")
+
+			_, _, err := CreateRevision(ctx, fakeGardenClient, shootState)
+			Expect(err).To(MatchError(ContainSubstring("has no valid gardener.cloud/timestamp annotation")))
+		})
+	})
+
+	Describe("#ListRevisions", func() {
+		It("should list the revisions from the newest to the oldest", func() {
+			Expect(fakeGardenClient.Create(ctx, shootState.DeepCopy())).To(Succeed())
+
+			for _, timestamp := range []string{"2025-01-01T01:00:00Z", "2025-01-01T02:00:00Z", "2025-01-01T00:00:00Z"} {
+				shootState.Annotations["gardener.cloud/timestamp"] = timestamp
+				_, _, err := CreateRevision(ctx, fakeGardenClient, shootState)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			otherShootState := shootState.DeepCopy()
+			otherShootState.Name = "my-shoot-2"
+			_, _, err := CreateRevision(ctx, fakeGardenClient, otherShootState)
+			Expect(err).NotTo(HaveOccurred())
+
+			revisions, err := ListRevisions(ctx, fakeGardenClient, "garden-my-project", "my-shoot")
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, revision := range revisions {
+				names = append(names, revision.Name)
+			}
+			Expect(names).To(Equal([]string{"my-shoot--rev-1735696800", "my-shoot--rev-1735693200", "my-shoot--rev-1735689600"}))
+		})
+	})
+
+	Describe("#DeleteRevision", func() {
+		It("should confirm the deletion and delete the revision", func() {
+			revision, _, err := CreateRevision(ctx, fakeGardenClient, shootState)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(DeleteRevision(ctx, fakeGardenClient, revision)).To(Succeed())
+			Expect(revision.Annotations).To(HaveKeyWithValue("confirmation.gardener.cloud/deletion", "true"))
+			Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(revision), revision)).To(BeNotFoundError())
+		})
+
+		It("should not fail if the revision does not exist", func() {
+			Expect(DeleteRevision(ctx, fakeGardenClient, &gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{Name: "my-shoot--rev-1", Namespace: "garden-my-project"}})).To(Succeed())
+		})
+	})
+
+	Describe("#RestoreFromRevision", func() {
+		It("should restore the secrets and extension states but keep the other data", func() {
+			revision := &gardencorev1beta1.ShootState{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-shoot--rev-1700000000"},
+				Spec: gardencorev1beta1.ShootStateSpec{
+					Gardener: []gardencorev1beta1.GardenerResourceData{
+						{Name: "ca", Type: "secret", Data: runtime.RawExtension{Raw: []byte(`{"old":"ca"}`)}},
+						{Name: "machine-state", Type: "machine-state", Data: runtime.RawExtension{Raw: []byte(`{"old":"machines"}`)}},
+					},
+					Extensions: []gardencorev1beta1.ExtensionResourceState{
+						{Kind: "Infrastructure", State: &runtime.RawExtension{Raw: []byte(`{"old":"infrastructure"}`)}},
+					},
+				},
+			}
+
+			RestoreFromRevision(shootState, revision)
+
+			Expect(shootState.Annotations).To(HaveKeyWithValue("shootstate.gardener.cloud/restored-from-revision", "my-shoot--rev-1700000000"))
+			Expect(shootState.Spec.Gardener).To(Equal([]gardencorev1beta1.GardenerResourceData{
+				{Name: "machine-state", Type: "machine-state", Data: runtime.RawExtension{Raw: []byte(`{"new":"machines"}`)}},
+				{Name: "ca", Type: "secret", Data: runtime.RawExtension{Raw: []byte(`{"old":"ca"}`)}},
+			}))
+			Expect(shootState.Spec.Extensions).To(Equal(revision.Spec.Extensions))
+			Expect(shootState.Spec.Resources).To(BeEmpty())
+		})
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -31,8 +32,8 @@ import (
 )
 
 // Deploy deploys the ShootState resource with the effective state for the given shoot into the garden
-// cluster.
-func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot, overwriteSpec bool) error {
+// cluster. The data of secrets which was sealed by the given storage backend is persisted in its unsealed form.
+func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot, overwriteSpec bool, storageBackend secretsmanager.StorageBackend) error {
 	shootState := &gardencorev1beta1.ShootState{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      shoot.Name,
@@ -40,7 +41,7 @@ func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient cli
 		},
 	}
 
-	spec, err := computeSpec(ctx, seedClient, shoot.Status.TechnicalID)
+	spec, err := computeSpec(ctx, seedClient, shoot.Status.TechnicalID, storageBackend)
 	if err != nil {
 		return fmt.Errorf("failed computing spec of ShootState for shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
 	}
@@ -95,8 +96,8 @@ func Delete(ctx context.Context, gardenClient client.Client, shoot *gardencorev1
 	return client.IgnoreNotFound(gardenClient.Delete(ctx, shootState))
 }
 
-func computeSpec(ctx context.Context, seedClient client.Client, seedNamespace string) (*gardencorev1beta1.ShootStateSpec, error) {
-	gardener, err := computeGardenerData(ctx, seedClient, seedNamespace)
+func computeSpec(ctx context.Context, seedClient client.Client, seedNamespace string, storageBackend secretsmanager.StorageBackend) (*gardencorev1beta1.ShootStateSpec, error) {
+	gardener, err := computeGardenerData(ctx, seedClient, seedNamespace, storageBackend)
 	if err != nil {
 		return nil, fmt.Errorf("failed computing Gardener data: %w", err)
 	}
@@ -117,11 +118,12 @@ func computeGardenerData(
 	ctx context.Context,
 	seedClient client.Client,
 	seedNamespace string,
+	storageBackend secretsmanager.StorageBackend,
 ) (
 	[]gardencorev1beta1.GardenerResourceData,
 	error,
 ) {
-	secretsToPersist, err := computeSecretsToPersist(ctx, seedClient, seedNamespace)
+	secretsToPersist, err := computeSecretsToPersist(ctx, seedClient, seedNamespace, storageBackend)
 	if err != nil {
 		return nil, err
 	}
@@ -156,6 +158,7 @@ func computeSecretsToPersist(
 	ctx context.Context,
 	seedClient client.Client,
 	seedNamespace string,
+	storageBackend secretsmanager.StorageBackend,
 ) (
 	[]gardencorev1beta1.GardenerResourceData,
 	error,
@@ -170,14 +173,24 @@ func computeSecretsToPersist(
 	dataList := make([]gardencorev1beta1.GardenerResourceData, 0, len(secretList.Items))
 
 	for _, secret := range secretList.Items {
-		dataJSON, err := json.Marshal(secret.Data)
+		// The ShootState must be usable without the storage backend (e.g., when the shoot is migrated to another seed),
+		// hence the data is persisted in its unsealed form and without the storage backend label.
+		data, err := secretsmanager.UnsealedData(ctx, storageBackend, &secret)
+		if err != nil {
+			return nil, err
+		}
+
+		dataJSON, err := json.Marshal(data)
 		if err != nil {
 			return nil, fmt.Errorf("failed marshalling secret data to JSON for secret %s: %w", client.ObjectKeyFromObject(&secret), err)
 		}
 
+		labels := maps.Clone(secret.Labels)
+		delete(labels, secretsmanager.LabelKeyStorageBackend)
+
 		dataList = append(dataList, gardencorev1beta1.GardenerResourceData{
 			Name:   secret.Name,
-			Labels: secret.Labels,
+			Labels: labels,
 			Type:   v1beta1constants.DataTypeSecret,
 			Data:   runtime.RawExtension{Raw: dataJSON},
 		})
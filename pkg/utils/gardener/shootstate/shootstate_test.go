@@ -67,7 +67,7 @@ var _ = Describe("ShootState", func() {
 
 	Describe("#Deploy", func() {
 		It("should deploy an empty ShootState when there is nothing to persist", func() {
-			Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+			Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, nil)).To(Succeed())
 			Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
 			Expect(shootState.Spec).To(Equal(gardencorev1beta1.ShootStateSpec{}))
 			Expect(shootState.Annotations).To(HaveKeyWithValue("gardener.cloud/timestamp", fakeClock.Now().UTC().Format(time.RFC3339)))
@@ -209,16 +209,16 @@ var _ = Describe("ShootState", func() {
 			})
 
 			It("should compute the expected spec for both gardener and extensions data and overwrite the spec", func() {
-				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, nil)).To(Succeed())
 				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
 				Expect(shootState.Spec).To(Equal(expectedSpec))
 			})
 
 			It("should compute expected spec for both gardener and extension data and overwrite the spec with no longer existing machine resources", func() {
-				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, nil)).To(Succeed())
 
 				cleanupMachineObjectsFunc(ctx)
-				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, nil)).To(Succeed())
 				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
 
 				gardenerResourceData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
@@ -229,7 +229,7 @@ var _ = Describe("ShootState", func() {
 			})
 
 			It("should compute the expected spec for both gardener and extensions data and keep existing data in the spec", func() {
-				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, false)).To(Succeed())
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, false, nil)).To(Succeed())
 				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
 
 				expectedSpec.Gardener = append(existingGardenerData, expectedSpec.Gardener...)
@@ -239,10 +239,10 @@ var _ = Describe("ShootState", func() {
 			})
 
 			It("should compute the expected spec for both gardener and extension data and keep existing data in the spec if machine resources were deleted", func() {
-				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, false)).To(Succeed())
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, false, nil)).To(Succeed())
 
 				cleanupMachineObjectsFunc(ctx)
-				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, false)).To(Succeed())
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, false, nil)).To(Succeed())
 				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
 
 				expectedSpec.Gardener = append(existingGardenerData, expectedSpec.Gardener...)
@@ -251,6 +251,30 @@ var _ = Describe("ShootState", func() {
 				Expect(shootState.Spec).To(Equal(expectedSpec))
 			})
 		})
+
+		Context("with sealed secrets", func() {
+			BeforeEach(func() {
+				secret := newSecret("secret1", seedNamespace, true, true)
+				metav1.SetMetaDataLabel(&secret.ObjectMeta, "storage-backend", "fake")
+				secret.Data = map[string][]byte{"sealed": secret.Data["secret1"]}
+				Expect(fakeSeedClient.Create(ctx, secret)).To(Succeed())
+			})
+
+			It("should persist the unsealed data without the storage backend label", func() {
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, &fakeStorageBackend{})).To(Succeed())
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+				Expect(shootState.Spec.Gardener).To(ConsistOf(gardencorev1beta1.GardenerResourceData{
+					Name:   "secret1",
+					Type:   "secret",
+					Data:   runtime.RawExtension{Raw: []byte(`{"secret1":"c29tZS1kYXRh"}`)},
+					Labels: map[string]string{"managed-by": "secrets-manager", "persist": "true"},
+				}))
+			})
+
+			It("should fail if the storage backend is not configured", func() {
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, nil)).To(MatchError(ContainSubstring(`sealed by storage backend "fake" which is not configured`)))
+			})
+		})
 	})
 
 	Describe("#Delete", func() {
@@ -266,6 +290,21 @@ var _ = Describe("ShootState", func() {
 	})
 })
 
+// fakeStorageBackend unseals the data by moving the value of the 'sealed' key to the 'secret1' key.
+type fakeStorageBackend struct{}
+
+func (f *fakeStorageBackend) Name() string {
+	return "fake"
+}
+
+func (f *fakeStorageBackend) Seal(_ context.Context, data map[string][]byte) (map[string][]byte, error) {
+	return map[string][]byte{"sealed": data["secret1"]}, nil
+}
+
+func (f *fakeStorageBackend) Unseal(_ context.Context, data map[string][]byte) (map[string][]byte, error) {
+	return map[string][]byte{"secret1": data["sealed"]}, nil
+}
+
 func newSecret(name, namespace string, withPersistLabel bool, withManagedByLabel bool) *corev1.Secret {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -6,7 +6,12 @@ package bootstraptoken
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -70,3 +75,30 @@ func ComputeBootstrapToken(ctx context.Context, c client.Client, tokenID, descri
 func FromSecretData(data map[string][]byte) string {
 	return bootstraptokenutil.TokenFromIDAndSecret(string(data[bootstraptokenapi.BootstrapTokenIDKey]), string(data[bootstraptokenapi.BootstrapTokenSecretKey]))
 }
+
+// CACertificateHashPrefix is the prefix of CA certificate hashes computed by CACertificateHash.
+const CACertificateHashPrefix = "sha256:"
+
+// CACertificateHash computes the hash of the subject public key info of the given CA certificate. Together with a
+// bootstrap token, it can be used by joining nodes to verify the CA of the cluster they discovered.
+func CACertificateHash(caCert *x509.Certificate) string {
+	sum := sha256.Sum256(caCert.RawSubjectPublicKeyInfo)
+	return CACertificateHashPrefix + hex.EncodeToString(sum[:])
+}
+
+// VerifyCACertificateHash verifies that the given CA certificate matches at least one of the given hashes.
+func VerifyCACertificateHash(caCert *x509.Certificate, hashes ...string) error {
+	actual := CACertificateHash(caCert)
+
+	for _, hash := range hashes {
+		if !strings.HasPrefix(hash, CACertificateHashPrefix) {
+			return fmt.Errorf("unsupported CA certificate hash format %q, must start with %q", hash, CACertificateHashPrefix)
+		}
+
+		if strings.EqualFold(hash, actual) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("CA certificate with hash %q does not match any of the expected hashes", actual)
+}
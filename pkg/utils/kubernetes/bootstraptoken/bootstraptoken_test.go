@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bootstraptoken_test
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("BootstrapToken", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+	})
+
+	Describe("#ComputeBootstrapToken", func() {
+		It("should create a new bootstrap token secret and keep the token stable", func() {
+			secret, err := ComputeBootstrapToken(ctx, fakeClient, "abcdef", "some description", time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Name).To(Equal("bootstrap-token-abcdef"))
+			Expect(secret.Type).To(Equal(bootstraptokenapi.SecretTypeBootstrapToken))
+			Expect(secret.Data).To(HaveKeyWithValue(bootstraptokenapi.BootstrapTokenDescriptionKey, []byte("some description")))
+
+			token := FromSecretData(secret.Data)
+			Expect(token).To(MatchRegexp(`^abcdef\.[a-z0-9]{16}$`))
+
+			secret, err = ComputeBootstrapToken(ctx, fakeClient, "abcdef", "some description", time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(FromSecretData(secret.Data)).To(Equal(token))
+		})
+	})
+
+	Describe("#CACertificateHash", func() {
+		var caCert, otherCACert *x509.Certificate
+
+		generateCA := func() *x509.Certificate {
+			ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			cert, err := utils.DecodeCertificate(ca.CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+			return cert
+		}
+
+		BeforeEach(func() {
+			caCert = generateCA()
+			otherCACert = generateCA()
+		})
+
+		It("should compute a stable hash with the expected prefix", func() {
+			hash := CACertificateHash(caCert)
+			Expect(hash).To(MatchRegexp(`^sha256:[a-f0-9]{64}$`))
+			Expect(CACertificateHash(caCert)).To(Equal(hash))
+			Expect(CACertificateHash(otherCACert)).NotTo(Equal(hash))
+		})
+
+		It("should successfully verify a matching hash", func() {
+			Expect(VerifyCACertificateHash(caCert, CACertificateHash(otherCACert), CACertificateHash(caCert))).To(Succeed())
+		})
+
+		It("should fail verifying a non-matching hash", func() {
+			Expect(VerifyCACertificateHash(caCert, CACertificateHash(otherCACert))).To(MatchError(ContainSubstring("does not match any of the expected hashes")))
+		})
+
+		It("should fail verifying a hash with unsupported format", func() {
+			Expect(VerifyCACertificateHash(caCert, "md5:foo")).To(MatchError(ContainSubstring("unsupported CA certificate hash format")))
+		})
+	})
+})
@@ -115,6 +115,18 @@ func (m *ManagedResource) DeletePersistentVolumeClaims(v bool) *ManagedResource
 	return m
 }
 
+// WithApplyMode sets the ApplyMode field.
+func (m *ManagedResource) WithApplyMode(mode resourcesv1alpha1.ApplyMode) *ManagedResource {
+	m.resource.Spec.ApplyMode = &mode
+	return m
+}
+
+// ForceConflicts sets the ForceConflicts field.
+func (m *ManagedResource) ForceConflicts(v bool) *ManagedResource {
+	m.resource.Spec.ForceConflicts = &v
+	return m
+}
+
 // Reconcile creates or updates the ManagedResource as well as marks all referenced secrets as garbage collectable.
 func (m *ManagedResource) Reconcile(ctx context.Context) error {
 	resource := &resourcesv1alpha1.ManagedResource{
@@ -277,6 +277,7 @@ var _ = Describe("Resource Manager", func() {
 				forceOverwriteLabels         = true
 				keepObjects                  = true
 				deletePersistentVolumeClaims = true
+				forceConflicts               = true
 			)
 
 			secrets := []*corev1.Secret{secret1, secret2, secret3}
@@ -297,6 +298,8 @@ var _ = Describe("Resource Manager", func() {
 					ForceOverwriteLabels(forceOverwriteLabels).
 					KeepObjects(keepObjects).
 					DeletePersistentVolumeClaims(deletePersistentVolumeClaims).
+					WithApplyMode(resourcesv1alpha1.ApplyModeServerSideApply).
+					ForceConflicts(forceConflicts).
 					Reconcile(ctx),
 			).To(Succeed())
 
@@ -319,6 +322,8 @@ var _ = Describe("Resource Manager", func() {
 					ForceOverwriteLabels:         ptr.To(forceOverwriteLabels),
 					KeepObjects:                  ptr.To(keepObjects),
 					DeletePersistentVolumeClaims: ptr.To(deletePersistentVolumeClaims),
+					ApplyMode:                    ptr.To(resourcesv1alpha1.ApplyModeServerSideApply),
+					ForceConflicts:               ptr.To(forceConflicts),
 				},
 			}
 
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// dockerConfigJSON is the content of secrets of type `kubernetes.io/dockerconfigjson`.
+type dockerConfigJSON struct {
+	Auths map[string]authn.AuthConfig `json:"auths"`
+}
+
+func (r *HelmRegistry) authenticator(ctx context.Context, secretName, registry string) (authn.Authenticator, error) {
+	secret, err := r.readSecret(ctx, secretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading pull secret: %w", err)
+	}
+	return authenticatorFromSecret(secret, registry)
+}
+
+func (r *HelmRegistry) readSecret(ctx context.Context, name string) (*corev1.Secret, error) {
+	if r.client == nil {
+		return nil, fmt.Errorf("cannot read secret %s/%s because no client is configured", r.namespace, name)
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: r.namespace, Name: name}, secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// authenticatorFromSecret returns an authenticator for the given registry based on the credentials in the given
+// secret. The secret must either contain a docker config JSON with credentials for the registry or a username and a
+// password.
+func authenticatorFromSecret(secret *corev1.Secret, registry string) (authn.Authenticator, error) {
+	if data, ok := secret.Data[corev1.DockerConfigJsonKey]; ok {
+		config := &dockerConfigJSON{}
+		if err := json.Unmarshal(data, config); err != nil {
+			return nil, fmt.Errorf("failed parsing docker config JSON of pull secret %s: %w", client.ObjectKeyFromObject(secret), err)
+		}
+
+		for host, authConfig := range config.Auths {
+			if registryHost(host) == registry {
+				return authn.FromConfig(authConfig), nil
+			}
+		}
+		return nil, fmt.Errorf("docker config JSON of pull secret %s does not contain credentials for registry %s", client.ObjectKeyFromObject(secret), registry)
+	}
+
+	username, password := secret.Data[corev1.BasicAuthUsernameKey], secret.Data[corev1.BasicAuthPasswordKey]
+	if len(username) == 0 || len(password) == 0 {
+		return nil, fmt.Errorf("pull secret %s must either contain the %q key or the %q and %q keys", client.ObjectKeyFromObject(secret), corev1.DockerConfigJsonKey, corev1.BasicAuthUsernameKey, corev1.BasicAuthPasswordKey)
+	}
+	return authn.FromConfig(authn.AuthConfig{Username: string(username), Password: string(password)}), nil
+}
+
+// registryHost returns the host of the registry for the keys of the docker config JSON, which might also be URLs like
+// `https://index.docker.io/v1/`.
+func registryHost(key string) string {
+	host := strings.TrimPrefix(strings.TrimPrefix(key, "https://"), "http://")
+	host, _, _ = strings.Cut(host, "/")
+	return host
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"github.com/google/go-containerregistry/pkg/authn"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("#authenticatorFromSecret", func() {
+	var secret *corev1.Secret
+
+	BeforeEach(func() {
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: "garden"}}
+	})
+
+	DescribeTable("docker config JSON",
+		func(host string) {
+			secret.Data = map[string][]byte{".dockerconfigjson": []byte(`{"auths":{"` + host + `":{"username":"foo","password":"bar"}}}`)}
+
+			auth, err := authenticatorFromSecret(secret, "registry.example.com")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(auth.Authorization()).To(And(HaveField("Username", "foo"), HaveField("Password", "bar")))
+		},
+
+		Entry("host", "registry.example.com"),
+		Entry("URL", "https://registry.example.com/v1/"),
+	)
+
+	It("should decode the auth field of the docker config JSON", func() {
+		secret.Data = map[string][]byte{".dockerconfigjson": []byte(`{"auths":{"registry.example.com":{"auth":"Zm9vOmJhcg=="}}}`)}
+
+		auth, err := authenticatorFromSecret(secret, "registry.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(auth.Authorization()).To(And(HaveField("Username", "foo"), HaveField("Password", "bar")))
+	})
+
+	It("should fail if the docker config JSON does not contain credentials for the registry", func() {
+		secret.Data = map[string][]byte{".dockerconfigjson": []byte(`{"auths":{"other.example.com":{"username":"foo","password":"bar"}}}`)}
+
+		_, err := authenticatorFromSecret(secret, "registry.example.com")
+		Expect(err).To(MatchError("docker config JSON of pull secret garden/pull-secret does not contain credentials for registry registry.example.com"))
+	})
+
+	It("should fail if the docker config JSON is invalid", func() {
+		secret.Data = map[string][]byte{".dockerconfigjson": []byte(`{`)}
+
+		_, err := authenticatorFromSecret(secret, "registry.example.com")
+		Expect(err).To(MatchError(ContainSubstring("failed parsing docker config JSON of pull secret garden/pull-secret")))
+	})
+
+	It("should return basic auth credentials", func() {
+		secret.Data = map[string][]byte{"username": []byte("foo"), "password": []byte("bar")}
+
+		auth, err := authenticatorFromSecret(secret, "registry.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(auth.Authorization()).To(Equal(&authn.AuthConfig{Username: "foo", Password: "bar"}))
+	})
+
+	It("should fail if the secret does not contain credentials", func() {
+		secret.Data = map[string][]byte{"username": []byte("foo")}
+
+		_, err := authenticatorFromSecret(secret, "registry.example.com")
+		Expect(err).To(MatchError(ContainSubstring("must either contain the \".dockerconfigjson\" key or the \"username\" and \"password\" keys")))
+	})
+})
@@ -4,36 +4,379 @@
 
 package oci
 
-import "sync"
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
 
-var defaultCache = newCache()
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// DefaultCacheMaxSizeBytes is the default maximum total size of the Helm charts kept in memory.
+	DefaultCacheMaxSizeBytes int64 = 128 << 20
+	// DefaultCacheDirectoryMaxSizeBytes is the default maximum total size of the Helm charts kept in the on-disk store.
+	DefaultCacheDirectoryMaxSizeBytes int64 = 1 << 30
+)
+
+// CacheOptions configures the cache for the Helm charts pulled by all HelmRegistries of the process.
+type CacheOptions struct {
+	// MaxSizeBytes is the maximum total size of the charts kept in memory. Once it is exceeded, the least recently used
+	// charts are evicted.
+	MaxSizeBytes int64
+	// Directory is the path of an optional on-disk store for the charts. If set, all pulled charts are stored in this
+	// directory, hence charts evicted from memory or pulled before a restart of the process don't need to be pulled
+	// again.
+	Directory string
+	// DirectoryMaxSizeBytes is the maximum total size of the charts kept in the on-disk store. Once it is exceeded, the
+	// least recently used charts are removed from the directory. It is only relevant if Directory is set.
+	DirectoryMaxSizeBytes int64
+	// TagTTL is the duration for which the digest resolved for a tag is cached. Within this duration, no request is sent
+	// to the registry for resolving the tag again. If it is zero, tags are resolved on every pull.
+	TagTTL time.Duration
+}
+
+var (
+	defaultCacheMu  sync.RWMutex
+	defaultCache    cacher = newCache(DefaultCacheMaxSizeBytes, nil)
+	defaultTagCache *tagCache
+)
+
+// ConfigureCache replaces the cache used by all HelmRegistries created afterwards with a new cache based on the given
+// options.
+func ConfigureCache(opts CacheOptions) error {
+	if opts.MaxSizeBytes <= 0 {
+		return fmt.Errorf("maximum cache size must be positive, got %d", opts.MaxSizeBytes)
+	}
+	if opts.TagTTL < 0 {
+		return fmt.Errorf("tag TTL must not be negative, got %s", opts.TagTTL)
+	}
+
+	var store *diskStore
+	if opts.Directory != "" {
+		if opts.DirectoryMaxSizeBytes <= 0 {
+			return fmt.Errorf("maximum directory size must be positive, got %d", opts.DirectoryMaxSizeBytes)
+		}
+
+		var err error
+		if store, err = newDiskStore(opts.Directory, opts.DirectoryMaxSizeBytes); err != nil {
+			return err
+		}
+	}
+
+	var tags *tagCache
+	if opts.TagTTL > 0 {
+		tags = newTagCache(clock.RealClock{}, opts.TagTTL)
+	}
+
+	defaultCacheMu.Lock()
+	defer defaultCacheMu.Unlock()
+	defaultCache = newCache(opts.MaxSizeBytes, store)
+	defaultTagCache = tags
+	return nil
+}
+
+func currentCaches() (cacher, *tagCache) {
+	defaultCacheMu.RLock()
+	defer defaultCacheMu.RUnlock()
+	return defaultCache, defaultTagCache
+}
 
 type cacher interface {
 	Get(key string) ([]byte, bool)
 	Set(key string, blob []byte)
 }
 
-func newCache() *cache {
+func newCache(maxSize int64, store *diskStore) *cache {
 	return &cache{
-		items: map[string][]byte{},
+		maxSize: maxSize,
+		lru:     list.New(),
+		items:   map[string]*list.Element{},
+		store:   store,
 	}
 }
 
-// cache is a basic key-value cache i.e. a map protected by a mutex. Items are never removed from the cache.
+// cache is a size-bounded LRU cache for blobs keyed by the digest reference of their artifact. If the total size of the blobs exceeds the
+// maximum size, the least recently used blobs are evicted. If a disk store is configured, all blobs are additionally
+// written to it and blobs not found in memory are looked up there.
 type cache struct {
-	mu    sync.RWMutex
-	items map[string][]byte
+	mu      sync.Mutex
+	maxSize int64
+	size    int64
+	// lru contains the cache entries ordered by their last usage, the most recently used entry is at the front.
+	lru   *list.List
+	items map[string]*list.Element
+	store *diskStore
+}
+
+type cacheEntry struct {
+	key  string
+	blob []byte
 }
 
 func (c *cache) Get(key string) ([]byte, bool) {
-	c.mu.RLock()
-	blob, found := c.items[key]
-	c.mu.RUnlock()
-	return blob, found
+	c.mu.Lock()
+	if elem, found := c.items[key]; found {
+		c.lru.MoveToFront(elem)
+		c.mu.Unlock()
+		recordCacheHit(cacheStoreMemory)
+		return elem.Value.(*cacheEntry).blob, true
+	}
+	c.mu.Unlock()
+
+	if c.store != nil {
+		if blob, found := c.store.Get(key); found {
+			c.add(key, blob)
+			recordCacheHit(cacheStoreDisk)
+			return blob, true
+		}
+	}
+
+	recordCacheMiss()
+	return nil, false
 }
 
 func (c *cache) Set(key string, blob []byte) {
+	if c.store != nil {
+		c.store.Set(key, blob)
+	}
+	c.add(key, blob)
+}
+
+func (c *cache) add(key string, blob []byte) {
 	c.mu.Lock()
-	c.items[key] = blob
-	c.mu.Unlock()
+	defer c.mu.Unlock()
+
+	if elem, found := c.items[key]; found {
+		c.size -= int64(len(elem.Value.(*cacheEntry).blob))
+		c.lru.Remove(elem)
+		delete(c.items, key)
+	}
+
+	// blobs exceeding the maximum size on their own would evict all other entries without being kept themselves
+	if int64(len(blob)) > c.maxSize {
+		return
+	}
+
+	c.items[key] = c.lru.PushFront(&cacheEntry{key: key, blob: blob})
+	c.size += int64(len(blob))
+
+	for c.size > c.maxSize {
+		oldest := c.lru.Back()
+		entry := oldest.Value.(*cacheEntry)
+		c.lru.Remove(oldest)
+		delete(c.items, entry.key)
+		c.size -= int64(len(entry.blob))
+	}
+}
+
+// diskStore is a size-bounded store for blobs on disk. The blobs are stored content-addressed, i.e., a blob with digest
+// `sha256:<hex>` is stored in the file `<directory>/blobs/sha256/<hex>`, and their digest is verified when they are read.
+// The keys are mapped to the digests of their blobs by the files in `<directory>/refs`. If the total size of the blobs
+// exceeds the maximum size, the least recently used blobs are removed. Errors are ignored as the store is only used for
+// caching.
+type diskStore struct {
+	directory string
+	maxSize   int64
+	mu        sync.Mutex
+}
+
+func newDiskStore(directory string, maxSize int64) (*diskStore, error) {
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, fmt.Errorf("failed creating cache directory %s: %w", directory, err)
+	}
+	return &diskStore{directory: directory, maxSize: maxSize}, nil
+}
+
+func (s *diskStore) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	refPath := s.refPath(key)
+	ref, err := os.ReadFile(refPath) // #nosec G304 -- path is derived from the hash of the key
+	if err != nil {
+		return nil, false
+	}
+	digest, err := gcrv1.NewHash(string(ref))
+	if err != nil {
+		_ = os.Remove(refPath)
+		return nil, false
+	}
+
+	blobPath := s.blobPath(digest)
+	blob, err := os.ReadFile(blobPath) // #nosec G304 -- path is derived from a validated digest
+	if err != nil {
+		_ = os.Remove(refPath)
+		return nil, false
+	}
+
+	// the blob might have been modified or only partially written to disk, hence it is only used if it still matches
+	// its digest
+	if actual, _, err := gcrv1.SHA256(bytes.NewReader(blob)); err != nil || actual != digest {
+		_ = os.Remove(blobPath)
+		_ = os.Remove(refPath)
+		return nil, false
+	}
+
+	// the modification time of the blobs is used for determining the least recently used blobs on eviction
+	now := time.Now()
+	_ = os.Chtimes(blobPath, now, now)
+	return blob, true
+}
+
+func (s *diskStore) Set(key string, blob []byte) {
+	// blobs exceeding the maximum size on their own would evict all other blobs without being kept themselves
+	if int64(len(blob)) > s.maxSize {
+		return
+	}
+
+	digest, _, err := gcrv1.SHA256(bytes.NewReader(blob))
+	if err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blobPath := s.blobPath(digest)
+	if _, err := os.Stat(blobPath); err == nil {
+		now := time.Now()
+		_ = os.Chtimes(blobPath, now, now)
+	} else if err := writeFileAtomically(blobPath, blob); err != nil {
+		return
+	}
+	if err := writeFileAtomically(s.refPath(key), []byte(digest.String())); err != nil {
+		return
+	}
+
+	s.evict()
+}
+
+// evict removes the least recently used blobs until their total size does not exceed the maximum size anymore. The refs
+// pointing to removed blobs are removed when they are read the next time.
+func (s *diskStore) evict() {
+	type blobFile struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+
+	var (
+		blobs []blobFile
+		size  int64
+	)
+
+	_ = filepath.WalkDir(filepath.Join(s.directory, "blobs"), func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil
+		}
+		blobs = append(blobs, blobFile{path: path, size: info.Size(), modTime: info.ModTime()})
+		size += info.Size()
+		return nil
+	})
+
+	if size <= s.maxSize {
+		return
+	}
+
+	slices.SortFunc(blobs, func(a, b blobFile) int {
+		return a.modTime.Compare(b.modTime)
+	})
+	for _, blob := range blobs {
+		if size <= s.maxSize {
+			return
+		}
+		if err := os.Remove(blob.path); err == nil {
+			size -= blob.size
+		}
+	}
+}
+
+// writeFileAtomically writes to a temporary file first and renames it afterwards to never expose partially written
+// blobs.
+func writeFileAtomically(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+func (s *diskStore) blobPath(digest gcrv1.Hash) string {
+	return filepath.Join(s.directory, "blobs", digest.Algorithm, digest.Hex)
+}
+
+func (s *diskStore) refPath(key string) string {
+	// hashing the key ensures that it cannot be used for escaping the directory
+	return filepath.Join(s.directory, "refs", fmt.Sprintf("%x", sha256.Sum256([]byte(key))))
+}
+
+func newTagCache(clock clock.PassiveClock, ttl time.Duration) *tagCache {
+	return &tagCache{
+		clock:   clock,
+		ttl:     ttl,
+		digests: map[string]tagCacheEntry{},
+	}
+}
+
+// tagCache caches the digests resolved for tags for a limited duration.
+type tagCache struct {
+	clock   clock.PassiveClock
+	ttl     time.Duration
+	mu      sync.Mutex
+	digests map[string]tagCacheEntry
+}
+
+type tagCacheEntry struct {
+	digest  string
+	expires time.Time
+}
+
+func (c *tagCache) Get(tag string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, found := c.digests[tag]
+	if !found || !c.clock.Now().Before(entry.expires) {
+		return "", false
+	}
+	return entry.digest, true
+}
+
+func (c *tagCache) Set(tag, digest string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	for t, entry := range c.digests {
+		if !now.Before(entry.expires) {
+			delete(c.digests, t)
+		}
+	}
+	c.digests[tag] = tagCacheEntry{digest: digest, expires: now.Add(c.ttl)}
 }
@@ -5,15 +5,27 @@
 package oci
 
 import (
+	"os"
+	"path/filepath"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	testclock "k8s.io/utils/clock/testing"
 )
 
 var _ = Describe("cache", func() {
+	const (
+		digest1 = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+		digest2 = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+		digest3 = "sha256:3333333333333333333333333333333333333333333333333333333333333333"
+	)
+
 	It("should store and retrieve values", func() {
 		key := "foo"
 		data := []byte("bar")
-		c := newCache()
+		c := newCache(DefaultCacheMaxSizeBytes, nil)
 
 		_, found := c.Get(key)
 		Expect(found).To(BeFalse())
@@ -24,4 +36,226 @@ var _ = Describe("cache", func() {
 		Expect(found).To(BeTrue())
 		Expect(out).To(Equal(data))
 	})
+
+	It("should evict the least recently used values if the maximum size is exceeded", func() {
+		c := newCache(6, nil)
+
+		c.Set(digest1, []byte("foo"))
+		c.Set(digest2, []byte("bar"))
+		_, found := c.Get(digest1)
+		Expect(found).To(BeTrue())
+
+		c.Set(digest3, []byte("baz"))
+		Expect(c.size).To(Equal(int64(6)))
+
+		_, found = c.Get(digest1)
+		Expect(found).To(BeTrue())
+		_, found = c.Get(digest2)
+		Expect(found).To(BeFalse())
+		_, found = c.Get(digest3)
+		Expect(found).To(BeTrue())
+	})
+
+	It("should replace existing values", func() {
+		c := newCache(6, nil)
+
+		c.Set(digest1, []byte("foo"))
+		c.Set(digest1, []byte("foobar"))
+		Expect(c.size).To(Equal(int64(6)))
+
+		out, found := c.Get(digest1)
+		Expect(found).To(BeTrue())
+		Expect(out).To(Equal([]byte("foobar")))
+	})
+
+	It("should not keep values exceeding the maximum size in memory", func() {
+		c := newCache(2, nil)
+
+		c.Set(digest1, []byte("foo"))
+		Expect(c.size).To(BeZero())
+
+		_, found := c.Get(digest1)
+		Expect(found).To(BeFalse())
+	})
+
+	It("should record cache hits and misses", func() {
+		c := newCache(DefaultCacheMaxSizeBytes, nil)
+		hits, misses := testutil.ToFloat64(cacheHits.WithLabelValues(cacheStoreMemory)), testutil.ToFloat64(cacheMisses)
+
+		c.Get(digest1)
+		c.Set(digest1, []byte("foo"))
+		c.Get(digest1)
+
+		Expect(testutil.ToFloat64(cacheHits.WithLabelValues(cacheStoreMemory))).To(Equal(hits + 1))
+		Expect(testutil.ToFloat64(cacheMisses)).To(Equal(misses + 1))
+	})
+
+	Context("with disk store", func() {
+		const (
+			// sha256 digests of "foo" and "bar"
+			fooDigest = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+			barDigest = "fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9"
+		)
+
+		var (
+			directory string
+			store     *diskStore
+		)
+
+		BeforeEach(func() {
+			directory = filepath.Join(GinkgoT().TempDir(), "charts")
+
+			var err error
+			store, err = newDiskStore(directory, 1024)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should store the values content-addressed on disk", func() {
+			c := newCache(DefaultCacheMaxSizeBytes, store)
+			c.Set(digest1, []byte("foo"))
+
+			Expect(os.ReadFile(filepath.Join(directory, "blobs", "sha256", fooDigest))).To(Equal([]byte("foo")))
+			Expect(os.ReadFile(store.refPath(digest1))).To(Equal([]byte("sha256:" + fooDigest)))
+		})
+
+		It("should retrieve values evicted from memory from disk", func() {
+			c := newCache(3, store)
+			c.Set(digest1, []byte("foo"))
+			c.Set(digest2, []byte("bar"))
+			hits := testutil.ToFloat64(cacheHits.WithLabelValues(cacheStoreDisk))
+
+			out, found := c.Get(digest1)
+			Expect(found).To(BeTrue())
+			Expect(out).To(Equal([]byte("foo")))
+			Expect(testutil.ToFloat64(cacheHits.WithLabelValues(cacheStoreDisk))).To(Equal(hits + 1))
+
+			// the value is kept in memory again
+			_, found = c.items[digest1]
+			Expect(found).To(BeTrue())
+		})
+
+		It("should retrieve values stored by a previous cache", func() {
+			newCache(DefaultCacheMaxSizeBytes, store).Set(digest1, []byte("foo"))
+
+			out, found := newCache(DefaultCacheMaxSizeBytes, store).Get(digest1)
+			Expect(found).To(BeTrue())
+			Expect(out).To(Equal([]byte("foo")))
+		})
+
+		It("should not escape the directory with arbitrary keys", func() {
+			store.Set("../../foo", []byte("foo"))
+
+			Expect(filepath.Join(directory, "..", "..", "foo")).NotTo(BeAnExistingFile())
+			out, found := store.Get("../../foo")
+			Expect(found).To(BeTrue())
+			Expect(out).To(Equal([]byte("foo")))
+		})
+
+		It("should not return blobs not matching their digest anymore", func() {
+			store.Set(digest1, []byte("foo"))
+			blobPath := filepath.Join(directory, "blobs", "sha256", fooDigest)
+			Expect(os.WriteFile(blobPath, []byte("bar"), 0600)).To(Succeed())
+
+			_, found := store.Get(digest1)
+			Expect(found).To(BeFalse())
+			Expect(blobPath).NotTo(BeAnExistingFile())
+			Expect(store.refPath(digest1)).NotTo(BeAnExistingFile())
+		})
+
+		It("should remove the least recently used blobs if the maximum size is exceeded", func() {
+			store.maxSize = 6
+
+			store.Set(digest1, []byte("foo"))
+			store.Set(digest2, []byte("bar"))
+			// make sure that the blob of digest1 is used more recently than the one of digest2 independent of the
+			// resolution of the modification times
+			past := time.Now().Add(-time.Hour)
+			Expect(os.Chtimes(filepath.Join(directory, "blobs", "sha256", barDigest), past, past)).To(Succeed())
+			_, found := store.Get(digest1)
+			Expect(found).To(BeTrue())
+
+			store.Set(digest3, []byte("baz"))
+
+			_, found = store.Get(digest1)
+			Expect(found).To(BeTrue())
+			_, found = store.Get(digest2)
+			Expect(found).To(BeFalse())
+			_, found = store.Get(digest3)
+			Expect(found).To(BeTrue())
+		})
+
+		It("should not store blobs exceeding the maximum size", func() {
+			store.maxSize = 2
+
+			store.Set(digest1, []byte("foo"))
+
+			_, found := store.Get(digest1)
+			Expect(found).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("tagCache", func() {
+	It("should return the digests until the TTL expired", func() {
+		fakeClock := testclock.NewFakePassiveClock(time.Now())
+		c := newTagCache(fakeClock, time.Minute)
+
+		_, found := c.Get("foo:1.0.0")
+		Expect(found).To(BeFalse())
+
+		c.Set("foo:1.0.0", "sha256:foo")
+		fakeClock.SetTime(fakeClock.Now().Add(59 * time.Second))
+		digest, found := c.Get("foo:1.0.0")
+		Expect(found).To(BeTrue())
+		Expect(digest).To(Equal("sha256:foo"))
+
+		fakeClock.SetTime(fakeClock.Now().Add(time.Second))
+		_, found = c.Get("foo:1.0.0")
+		Expect(found).To(BeFalse())
+	})
+
+	It("should remove expired digests", func() {
+		fakeClock := testclock.NewFakePassiveClock(time.Now())
+		c := newTagCache(fakeClock, time.Minute)
+
+		c.Set("foo:1.0.0", "sha256:foo")
+		fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+		c.Set("bar:1.0.0", "sha256:bar")
+
+		Expect(c.digests).To(HaveLen(1))
+		Expect(c.digests).To(HaveKey("bar:1.0.0"))
+	})
+})
+
+var _ = Describe("#ConfigureCache", func() {
+	AfterEach(func() {
+		Expect(ConfigureCache(CacheOptions{MaxSizeBytes: DefaultCacheMaxSizeBytes})).To(Succeed())
+	})
+
+	It("should configure the cache used by new registries", func() {
+		directory := filepath.Join(GinkgoT().TempDir(), "charts")
+		Expect(ConfigureCache(CacheOptions{MaxSizeBytes: 1024, Directory: directory, DirectoryMaxSizeBytes: 2048, TagTTL: time.Minute})).To(Succeed())
+		Expect(directory).To(BeADirectory())
+
+		hr, err := NewHelmRegistry(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hr.cache).To(BeAssignableToTypeOf(&cache{}))
+		Expect(hr.cache.(*cache).maxSize).To(Equal(int64(1024)))
+		Expect(hr.cache.(*cache).store).To(Equal(&diskStore{directory: directory, maxSize: 2048}))
+		Expect(hr.tags.ttl).To(Equal(time.Minute))
+	})
+
+	It("should not cache tags if the TTL is zero", func() {
+		Expect(ConfigureCache(CacheOptions{MaxSizeBytes: 1024})).To(Succeed())
+
+		hr, err := NewHelmRegistry(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hr.tags).To(BeNil())
+	})
+
+	It("should fail for invalid options", func() {
+		Expect(ConfigureCache(CacheOptions{})).To(MatchError("maximum cache size must be positive, got 0"))
+		Expect(ConfigureCache(CacheOptions{MaxSizeBytes: 1, TagTTL: -time.Second})).To(MatchError("tag TTL must not be negative, got -1s"))
+		Expect(ConfigureCache(CacheOptions{MaxSizeBytes: 1, Directory: "/tmp/charts"})).To(MatchError("maximum directory size must be positive, got 0"))
+	})
 })
@@ -14,8 +14,11 @@ import (
 	"github.com/google/go-containerregistry/pkg/name"
 	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"k8s.io/utils/lru"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
 
 const (
@@ -32,17 +35,33 @@ type Interface interface {
 
 // HelmRegistry can pull OCI Helm Charts.
 type HelmRegistry struct {
-	cache cacher
+	client    client.Reader
+	namespace string
+	cache     cacher
+	// tags caches the digests resolved for tags, it is nil if tags are resolved on every pull.
+	tags *tagCache
+	// verified caches the successful signature verifications, it is nil if signatures are verified on every pull.
+	verified *lru.Cache
 }
 
-// NewHelmRegistry creates a new HelmRegistry.
-func NewHelmRegistry() (*HelmRegistry, error) {
+// NewHelmRegistry creates a new HelmRegistry. The given client is used for reading the secrets referenced by the
+// OCIRepositories (pull secrets and public keys for verifying signatures) from the garden namespace. It can be nil if no
+// secrets are referenced. The pulled charts are stored in the cache shared by all HelmRegistries, see ConfigureCache.
+func NewHelmRegistry(c client.Reader) (*HelmRegistry, error) {
+	cache, tags := currentCaches()
 	return &HelmRegistry{
-		cache: defaultCache,
+		client:    c,
+		namespace: v1beta1constants.GardenNamespace,
+		cache:     cache,
+		tags:      tags,
+		verified:  verifiedSignatures,
 	}, nil
 }
 
-// Pull from the repository and return the compressed archive.
+// Pull from the repository and return the compressed archive. If the repository references a pull secret, it is used
+// for authenticating against the registry. If the repository configures a verification, the signature of the artifact
+// is verified before the archive is returned, no matter whether the archive is found in the cache. Only successful
+// verifications are cached, see verifySignature.
 func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository) ([]byte, error) {
 	ref, err := buildRef(oci)
 	if err != nil {
@@ -52,17 +71,34 @@ func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository
 		remote.WithContext(ctx),
 	}
 
-	key, err := cacheKeyFromRef(ref, remoteOpts...)
+	if oci.PullSecretRef != nil {
+		auth, err := r.authenticator(ctx, oci.PullSecretRef.Name, ref.Context().RegistryStr())
+		if err != nil {
+			return nil, err
+		}
+		remoteOpts = append(remoteOpts, remote.WithAuth(auth))
+	}
+
+	digest, err := r.resolveDigest(ref, remoteOpts...)
 	if err != nil {
 		return nil, err
 	}
-	if key != "" {
-		if blob, found := r.cache.Get(key); found {
-			return blob, nil
+
+	if oci.Verification != nil {
+		if err := r.verifySignature(ctx, oci.Verification, digest, remoteOpts...); err != nil {
+			return nil, fmt.Errorf("failed to verify signature of artifact %s: %w", digest, err)
 		}
 	}
 
-	img, err := remote.Image(ref, remoteOpts...)
+	// the key contains the repository as well, so that artifacts with the same digest are never served from the cache
+	// for another repository than the one they were pulled from, e.g., if their pull secrets differ
+	key := digest.Name()
+	if blob, found := r.cache.Get(key); found {
+		return blob, nil
+	}
+
+	// pull the artifact by digest to ensure that the verified artifact is pulled even if the tag was moved meanwhile
+	img, err := remote.Image(digest, remoteOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to pull artifact %s: %w", ref, err)
 	}
@@ -70,13 +106,6 @@ func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository
 	if err != nil {
 		return nil, err
 	}
-
-	// construct cache key based on digest of the pulled artifact
-	digest, err := img.Digest()
-	if err != nil {
-		return nil, err
-	}
-	key = ref.Context().Digest(digest.String()).Name()
 	r.cache.Set(key, blob)
 
 	return blob, nil
@@ -97,25 +126,42 @@ func buildRef(oci *gardencorev1.OCIRepository) (name.Reference, error) {
 	return name.ParseReference(ref, opts...)
 }
 
-// cacheKeyFromRef returns "repo@sha256:digest". If the ref is not a digest, the remote repository is queried to
-// retrieve the digest pointed to by the ref.
-func cacheKeyFromRef(ref name.Reference, opts ...remote.Option) (string, error) {
+// resolveDigest returns the digest reference "repo@sha256:digest" for the given ref. If the ref is not a digest, the
+// remote repository is queried to retrieve the digest pointed to by the ref unless it was resolved within the TTL of
+// the tag cache.
+func (r *HelmRegistry) resolveDigest(ref name.Reference, opts ...remote.Option) (name.Digest, error) {
 	if ref, ok := ref.(name.Digest); ok {
-		return ref.Name(), nil
+		return ref, nil
 	}
 
-	var digest gcrv1.Hash
+	if r.tags != nil {
+		if digest, found := r.tags.Get(ref.Name()); found {
+			return ref.Context().Digest(digest), nil
+		}
+	}
+
+	digest, err := remoteDigest(ref, opts...)
+	if err != nil {
+		return name.Digest{}, err
+	}
+
+	if r.tags != nil {
+		r.tags.Set(ref.Name(), digest.String())
+	}
+	return ref.Context().Digest(digest.String()), nil
+}
+
+func remoteDigest(ref name.Reference, opts ...remote.Option) (gcrv1.Hash, error) {
 	desc, hErr := remote.Head(ref, opts...)
 	if hErr == nil {
-		digest = desc.Digest
-	} else {
-		rd, gErr := remote.Get(ref, opts...)
-		if gErr != nil {
-			return "", fmt.Errorf("failed get manifest from remote trying to determine digest: %w", errors.Join(gErr, hErr))
-		}
-		digest = rd.Descriptor.Digest
+		return desc.Digest, nil
+	}
+
+	rd, gErr := remote.Get(ref, opts...)
+	if gErr != nil {
+		return gcrv1.Hash{}, fmt.Errorf("failed get manifest from remote trying to determine digest: %w", errors.Join(gErr, hErr))
 	}
-	return ref.Context().Digest(digest.String()).Name(), nil
+	return rd.Descriptor.Digest, nil
 }
 
 func extractHelmLayer(image gcrv1.Image) ([]byte, error) {
@@ -6,14 +6,24 @@ package oci
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"time"
 
 	_ "github.com/distribution/distribution/v3/registry/storage/driver/inmemory"
 	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 )
@@ -27,7 +37,7 @@ var _ = Describe("helmregistry", func() {
 
 	BeforeEach(func() {
 		ctx = context.Background()
-		rc = &recordingCache{cache: newCache()}
+		rc = &recordingCache{cache: newCache(DefaultCacheMaxSizeBytes, nil)}
 		hr = &HelmRegistry{cache: rc}
 	})
 
@@ -101,6 +111,152 @@ var _ = Describe("helmregistry", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(rc.cacheHits).To(Equal(1))
 	})
+
+	It("should not use the cache for the same digest in another repository", func() {
+		_, err := hr.Pull(ctx, &gardencorev1.OCIRepository{
+			Repository: ptr.To(registryAddress + "/charts/example"),
+			Digest:     ptr.To(exampleChartDigest),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		repository := registryAddress + "/charts/copy-" + utilrand.String(8)
+		digest := copyChart(repository)
+		Expect(digest).To(Equal(exampleChartDigest))
+
+		_, err = hr.Pull(ctx, &gardencorev1.OCIRepository{
+			Repository: ptr.To(repository),
+			Digest:     ptr.To(digest),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rc.cacheHits).To(Equal(0))
+	})
+
+	Context("with tag cache", func() {
+		var (
+			fakeClock  *testclock.FakePassiveClock
+			repository string
+			digest     string
+			ref        name.Reference
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakePassiveClock(time.Now())
+			hr.tags = newTagCache(fakeClock, time.Minute)
+
+			repository = registryAddress + "/charts/tagged-" + utilrand.String(8)
+			digest = copyChart(repository)
+
+			var err error
+			ref, err = name.ParseReference(repository + ":0.1.0")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not resolve the tag again until the TTL expired", func() {
+			resolved, err := hr.resolveDigest(ref)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved.DigestStr()).To(Equal(digest))
+
+			// move the tag to another artifact
+			Expect(remote.Write(ref, empty.Image)).To(Succeed())
+			otherDigest, err := empty.Image.Digest()
+			Expect(err).NotTo(HaveOccurred())
+
+			resolved, err = hr.resolveDigest(ref)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved.DigestStr()).To(Equal(digest))
+
+			fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+			resolved, err = hr.resolveDigest(ref)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved.DigestStr()).To(Equal(otherDigest.String()))
+		})
+
+		It("should pull the chart of the cached digest", func() {
+			oci := &gardencorev1.OCIRepository{Repository: ptr.To(repository), Tag: ptr.To("0.1.0")}
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+
+			// move the tag to an artifact without Helm chart
+			Expect(remote.Write(ref, empty.Image)).To(Succeed())
+
+			out, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+			Expect(rc.cacheHits).To(Equal(1))
+
+			fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+			_, err = hr.Pull(ctx, oci)
+			Expect(err).To(MatchError("no layers found"))
+		})
+	})
+
+	Context("with pull secret", func() {
+		var (
+			fakeClient client.Client
+			oci        *gardencorev1.OCIRepository
+			secret     *corev1.Secret
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().Build()
+			hr = &HelmRegistry{client: fakeClient, namespace: "garden", cache: rc}
+
+			oci = &gardencorev1.OCIRepository{
+				Repository:    ptr.To(authRegistryAddress + "/charts/example"),
+				Tag:           ptr.To("0.1.0"),
+				PullSecretRef: &corev1.LocalObjectReference{Name: "pull-secret"},
+			}
+			secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: "garden"}}
+		})
+
+		It("should fail to pull the chart without credentials", func() {
+			oci.PullSecretRef = nil
+
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).To(MatchError(ContainSubstring("failed get manifest from remote")))
+		})
+
+		It("should fail if the pull secret does not exist", func() {
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).To(MatchError(ContainSubstring("failed reading pull secret")))
+		})
+
+		It("should fail if no client is configured", func() {
+			hr.client = nil
+
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).To(MatchError(ContainSubstring("no client is configured")))
+		})
+
+		It("should pull the chart with basic auth credentials", func() {
+			secret.Type = corev1.SecretTypeBasicAuth
+			secret.Data = map[string][]byte{"username": []byte(registryUsername), "password": []byte(registryPassword)}
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+
+			out, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+		})
+
+		It("should pull the chart with docker config JSON credentials", func() {
+			auth := base64.StdEncoding.EncodeToString([]byte(registryUsername + ":" + registryPassword))
+			secret.Type = corev1.SecretTypeDockerConfigJson
+			secret.Data = map[string][]byte{".dockerconfigjson": []byte(`{"auths":{"` + authRegistryAddress + `":{"auth":"` + auth + `"}}}`)}
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+
+			out, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+		})
+
+		It("should fail to pull the chart with wrong credentials", func() {
+			secret.Data = map[string][]byte{"username": []byte(registryUsername), "password": []byte("wrong")}
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).To(MatchError(ContainSubstring("failed get manifest from remote")))
+		})
+	})
 })
 
 type recordingCache struct {
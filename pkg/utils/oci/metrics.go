@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	metricsNamespace = "oci"
+
+	cacheStoreMemory = "memory"
+	cacheStoreDisk   = "disk"
+)
+
+var (
+	registerOnce = make(chan struct{})
+
+	cacheHits   *prometheus.CounterVec
+	cacheMisses prometheus.Counter
+)
+
+// RegisterMetrics registers the metrics for the OCI library on the passed registry.
+// This function can only be called once.
+// If this function is not called, no metrics are collected in this package.
+func RegisterMetrics(r prometheus.Registerer) {
+	close(registerOnce) // Metrics can only be registered once on a registry.
+
+	factory := promauto.With(r)
+
+	cacheHits = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "chart_cache_hits_total",
+			Help:      "Number of Helm charts found in the cache. The value of the label 'store' can either be 'memory' or 'disk'.",
+		},
+		[]string{
+			"store",
+		},
+	)
+
+	cacheMisses = factory.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "chart_cache_misses_total",
+			Help:      "Number of Helm charts not found in the cache, i.e., pulled from the registry.",
+		},
+	)
+}
+
+func recordCacheHit(store string) {
+	if cacheHits != nil {
+		cacheHits.WithLabelValues(store).Inc()
+	}
+}
+
+func recordCacheMiss() {
+	if cacheMisses != nil {
+		cacheMisses.Inc()
+	}
+}
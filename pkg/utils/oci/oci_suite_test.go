@@ -7,13 +7,20 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/distribution/distribution/v3/configuration"
 	"github.com/distribution/distribution/v3/registry"
+	_ "github.com/distribution/distribution/v3/registry/auth/htpasswd"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/crypto/bcrypt"
 	helmregistry "helm.sh/helm/v3/pkg/registry"
 
 	"github.com/gardener/gardener/pkg/utils/test/port"
@@ -24,10 +31,16 @@ func TestOCI(t *testing.T) {
 	RunSpecs(t, "Utils OCI Suite")
 }
 
+const (
+	registryUsername = "gardener"
+	registryPassword = "secret"
+)
+
 var (
-	registryAddress    string
-	exampleChartDigest string
-	rawChart           []byte
+	registryAddress     string
+	authRegistryAddress string
+	exampleChartDigest  string
+	rawChart            []byte
 )
 
 var _ = BeforeSuite(func() {
@@ -35,8 +48,17 @@ var _ = BeforeSuite(func() {
 	ctx, cancel := context.WithCancel(context.Background())
 	DeferCleanup(cancel)
 
+	RegisterMetrics(prometheus.NewRegistry())
+
 	var err error
-	registryAddress, err = startTestRegistry(ctx)
+	registryAddress, err = startTestRegistry(ctx, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(registryPassword), bcrypt.DefaultCost)
+	Expect(err).NotTo(HaveOccurred())
+	htpasswdPath := filepath.Join(GinkgoT().TempDir(), "htpasswd")
+	Expect(os.WriteFile(htpasswdPath, []byte(registryUsername+":"+string(passwordHash)+"\n"), 0600)).To(Succeed())
+	authRegistryAddress, err = startTestRegistry(ctx, configuration.Auth{"htpasswd": {"realm": "test", "path": htpasswdPath}})
 	Expect(err).NotTo(HaveOccurred())
 
 	c, err := helmregistry.NewClient()
@@ -46,11 +68,21 @@ var _ = BeforeSuite(func() {
 	res, err := c.Push(rawChart, fmt.Sprintf("%s/charts/example:0.1.0", registryAddress))
 	Expect(err).NotTo(HaveOccurred())
 	exampleChartDigest = res.Manifest.Digest
+
+	// copy the chart to the registry requiring authentication
+	src, err := name.ParseReference(fmt.Sprintf("%s/charts/example:0.1.0", registryAddress))
+	Expect(err).NotTo(HaveOccurred())
+	dst, err := name.ParseReference(fmt.Sprintf("%s/charts/example:0.1.0", authRegistryAddress))
+	Expect(err).NotTo(HaveOccurred())
+	img, err := remote.Image(src)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(remote.Write(dst, img, remote.WithAuth(&authn.Basic{Username: registryUsername, Password: registryPassword}))).To(Succeed())
 })
 
-func startTestRegistry(ctx context.Context) (string, error) {
+func startTestRegistry(ctx context.Context, auth configuration.Auth) (string, error) {
 	config := &configuration.Configuration{}
 	config.Storage = map[string]configuration.Parameters{"inmemory": map[string]interface{}{}}
+	config.Auth = auth
 
 	port, host, err := port.SuggestPort("")
 	if err != nil {
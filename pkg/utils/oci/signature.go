@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/lru"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+)
+
+const (
+	// mediaTypeCosignSimpleSigning is the media type of the layers of cosign signature artifacts.
+	mediaTypeCosignSimpleSigning = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// annotationCosignSignature is the annotation of the layers of cosign signature artifacts containing the
+	// base64-encoded signature of the layer's payload.
+	annotationCosignSignature = "dev.cosignproject.cosign/signature"
+	// cosignSignatureType is the type of the payload of cosign signatures.
+	cosignSignatureType = "cosign container image signature"
+
+	// verifiedSignaturesCacheSize is the maximum number of successful signature verifications kept in the cache.
+	verifiedSignaturesCacheSize = 1024
+)
+
+// verifiedSignatures caches the successful signature verifications of all HelmRegistries of the process.
+var verifiedSignatures = lru.New(verifiedSignaturesCacheSize)
+
+// simpleSigningPayload is the payload signed by cosign, see
+// https://github.com/containers/image/blob/main/docs/containers-signature.5.md#json-data-format.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// verifySignature verifies that the artifact with the given digest is signed by one of the public keys referenced in
+// the given verification config. The signatures are expected to be stored like cosign does, i.e., as layers of the
+// artifact tagged `sha256-<digest>.sig` in the same repository. Successful verifications are cached per digest and
+// version of the secret containing the public keys, i.e., the signatures are only fetched again if the keys change.
+func (r *HelmRegistry) verifySignature(ctx context.Context, verification *gardencorev1.OCIVerification, digest name.Digest, opts ...remote.Option) error {
+	secret, err := r.readSecret(ctx, verification.PublicKeysSecretRef.Name)
+	if err != nil {
+		return fmt.Errorf("failed reading secret with public keys: %w", err)
+	}
+
+	cacheKey := strings.Join([]string{digest.Name(), string(secret.UID), secret.ResourceVersion}, "|")
+	if r.verified != nil {
+		if _, found := r.verified.Get(cacheKey); found {
+			return nil
+		}
+	}
+
+	publicKeys, err := publicKeysFromSecret(secret)
+	if err != nil {
+		return err
+	}
+	if err := verifySignatures(publicKeys, digest, opts...); err != nil {
+		return err
+	}
+
+	if r.verified != nil {
+		r.verified.Add(cacheKey, struct{}{})
+	}
+	return nil
+}
+
+// verifySignatures fetches the signatures of the artifact with the given digest and verifies that one of them was
+// created by one of the given public keys.
+func verifySignatures(publicKeys []crypto.PublicKey, digest name.Digest, opts ...remote.Option) error {
+	signatureRef := digest.Context().Tag(strings.Replace(digest.DigestStr(), ":", "-", 1) + ".sig")
+	signatureImage, err := remote.Image(signatureRef, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to pull signatures %s: %w", signatureRef, err)
+	}
+	manifest, err := signatureImage.Manifest()
+	if err != nil {
+		return fmt.Errorf("failed to read manifest of signatures %s: %w", signatureRef, err)
+	}
+
+	var errs []error
+	for _, layer := range manifest.Layers {
+		encodedSignature, ok := layer.Annotations[annotationCosignSignature]
+		if string(layer.MediaType) != mediaTypeCosignSimpleSigning || !ok {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed decoding signature of layer %s: %w", layer.Digest, err))
+			continue
+		}
+
+		l, err := signatureImage.LayerByDigest(layer.Digest)
+		if err != nil {
+			return fmt.Errorf("failed to read layer %s of signatures %s: %w", layer.Digest, signatureRef, err)
+		}
+		payload, err := readLayer(l)
+		if err != nil {
+			return fmt.Errorf("failed to read layer %s of signatures %s: %w", layer.Digest, signatureRef, err)
+		}
+
+		if err := verifyPayload(payload, digest.DigestStr()); err != nil {
+			errs = append(errs, fmt.Errorf("payload of layer %s is invalid: %w", layer.Digest, err))
+			continue
+		}
+		if slices.ContainsFunc(publicKeys, func(publicKey crypto.PublicKey) bool {
+			return verifyPayloadSignature(publicKey, payload, signature)
+		}) {
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("signature of layer %s was not created by any of the public keys", layer.Digest))
+	}
+
+	if len(errs) == 0 {
+		return fmt.Errorf("no signatures found in %s", signatureRef)
+	}
+	return errors.Join(errs...)
+}
+
+// publicKeysFromSecret returns all PEM-encoded public keys contained in the data of the given secret.
+func publicKeysFromSecret(secret *corev1.Secret) ([]crypto.PublicKey, error) {
+	var publicKeys []crypto.PublicKey
+	for key, data := range secret.Data {
+		for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+			publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed parsing public key %q of secret %s: %w", key, client.ObjectKeyFromObject(secret), err)
+			}
+			publicKeys = append(publicKeys, publicKey)
+		}
+	}
+
+	if len(publicKeys) == 0 {
+		return nil, fmt.Errorf("secret %s does not contain any PEM-encoded public keys", client.ObjectKeyFromObject(secret))
+	}
+	return publicKeys, nil
+}
+
+func verifyPayload(payload []byte, digest string) error {
+	p := &simpleSigningPayload{}
+	if err := json.Unmarshal(payload, p); err != nil {
+		return fmt.Errorf("failed parsing payload: %w", err)
+	}
+
+	if p.Critical.Type != cosignSignatureType {
+		return fmt.Errorf("unsupported signature type %q", p.Critical.Type)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("payload is signing digest %s instead of %s", p.Critical.Image.DockerManifestDigest, digest)
+	}
+	return nil
+}
+
+func verifyPayloadSignature(publicKey crypto.PublicKey, payload, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, hash[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, payload, signature)
+	}
+	return false
+}
+
+func readLayer(layer gcrv1.Layer) ([]byte, error) {
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/lru"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+)
+
+var _ = Describe("Signature verification", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		hr         *HelmRegistry
+
+		privateKey *ecdsa.PrivateKey
+		repository string
+		digest     string
+		oci        *gardencorev1.OCIRepository
+	)
+
+	BeforeEach(func() {
+		var err error
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeClient = fakeclient.NewClientBuilder().Build()
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "public-keys", Namespace: "garden"},
+			Data:       map[string][]byte{"cosign.pub": encodePublicKey(privateKey.Public())},
+		})).To(Succeed())
+		hr = &HelmRegistry{client: fakeClient, namespace: "garden", cache: newCache(DefaultCacheMaxSizeBytes, nil)}
+
+		// use a dedicated repository per test to isolate the signatures
+		repository = registryAddress + "/charts/signed-" + utilrand.String(8)
+		digest = copyChart(repository)
+
+		oci = &gardencorev1.OCIRepository{
+			Repository:   ptr.To(repository),
+			Tag:          ptr.To("0.1.0"),
+			Verification: &gardencorev1.OCIVerification{PublicKeysSecretRef: corev1.LocalObjectReference{Name: "public-keys"}},
+		}
+	})
+
+	It("should pull the chart if it is signed by one of the public keys", func() {
+		pushSignature(repository, digest, digest, privateKey)
+
+		out, err := hr.Pull(ctx, oci)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(rawChart))
+	})
+
+	It("should fail if the chart is not signed", func() {
+		_, err := hr.Pull(ctx, oci)
+		Expect(err).To(MatchError(ContainSubstring("failed to pull signatures")))
+	})
+
+	It("should fail if the chart is signed by another key", func() {
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		pushSignature(repository, digest, digest, otherKey)
+
+		_, err = hr.Pull(ctx, oci)
+		Expect(err).To(MatchError(ContainSubstring("was not created by any of the public keys")))
+	})
+
+	It("should fail if the signature is for another artifact", func() {
+		pushSignature(repository, digest, "sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a", privateKey)
+
+		_, err := hr.Pull(ctx, oci)
+		Expect(err).To(MatchError(ContainSubstring("payload is signing digest sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a instead of " + digest)))
+	})
+
+	It("should verify the signature even if the chart is cached", func() {
+		_, err := hr.Pull(ctx, &gardencorev1.OCIRepository{Repository: oci.Repository, Tag: oci.Tag})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = hr.Pull(ctx, oci)
+		Expect(err).To(MatchError(ContainSubstring("failed to pull signatures")))
+	})
+
+	Context("with verification cache", func() {
+		BeforeEach(func() {
+			hr.verified = lru.New(10)
+		})
+
+		It("should not fetch the signatures again after a successful verification", func() {
+			pushSignature(repository, digest, digest, privateKey)
+
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+
+			// replace the signatures by an artifact without signatures to ensure that they are not fetched again
+			signatureRef, err := name.ParseReference(repository + ":" + strings.Replace(digest, ":", "-", 1) + ".sig")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(remote.Write(signatureRef, empty.Image)).To(Succeed())
+
+			out, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+		})
+
+		It("should verify the signature again if the public keys change", func() {
+			pushSignature(repository, digest, digest, privateKey)
+
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.Update(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "public-keys", Namespace: "garden"},
+				Data:       map[string][]byte{"cosign.pub": encodePublicKey(otherKey.Public())},
+			})).To(Succeed())
+
+			_, err = hr.Pull(ctx, oci)
+			Expect(err).To(MatchError(ContainSubstring("was not created by any of the public keys")))
+		})
+
+		It("should not cache failed verifications", func() {
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).To(MatchError(ContainSubstring("failed to pull signatures")))
+
+			pushSignature(repository, digest, digest, privateKey)
+
+			_, err = hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	It("should fail if the secret does not contain public keys", func() {
+		Expect(fakeClient.Update(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "public-keys", Namespace: "garden"},
+			Data:       map[string][]byte{"foo": []byte("bar")},
+		})).To(Succeed())
+
+		_, err := hr.Pull(ctx, oci)
+		Expect(err).To(MatchError(ContainSubstring("secret garden/public-keys does not contain any PEM-encoded public keys")))
+	})
+
+	Describe("#verifyPayloadSignature", func() {
+		payload := []byte("payload")
+		hash := sha256.Sum256(payload)
+
+		It("should verify ECDSA signatures", func() {
+			signature, err := ecdsa.SignASN1(rand.Reader, privateKey, hash[:])
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(verifyPayloadSignature(privateKey.Public(), payload, signature)).To(BeTrue())
+			Expect(verifyPayloadSignature(privateKey.Public(), []byte("other"), signature)).To(BeFalse())
+		})
+
+		It("should verify RSA signatures", func() {
+			rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			signature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, hash[:])
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(verifyPayloadSignature(rsaKey.Public(), payload, signature)).To(BeTrue())
+			Expect(verifyPayloadSignature(rsaKey.Public(), []byte("other"), signature)).To(BeFalse())
+		})
+
+		It("should verify Ed25519 signatures", func() {
+			publicKey, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			signature := ed25519.Sign(ed25519Key, payload)
+
+			Expect(verifyPayloadSignature(publicKey, payload, signature)).To(BeTrue())
+			Expect(verifyPayloadSignature(publicKey, []byte("other"), signature)).To(BeFalse())
+		})
+	})
+})
+
+func encodePublicKey(publicKey crypto.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	Expect(err).NotTo(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+// copyChart copies the example chart to the given repository and returns its digest.
+func copyChart(repository string) string {
+	src, err := name.ParseReference(fmt.Sprintf("%s/charts/example:0.1.0", registryAddress))
+	Expect(err).NotTo(HaveOccurred())
+	dst, err := name.ParseReference(repository + ":0.1.0")
+	Expect(err).NotTo(HaveOccurred())
+
+	img, err := remote.Image(src)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(remote.Write(dst, img)).To(Succeed())
+
+	digest, err := img.Digest()
+	Expect(err).NotTo(HaveOccurred())
+	return digest.String()
+}
+
+// pushSignature pushes a signature for the artifact with the given digest like cosign does. The signed payload refers
+// to the given payload digest.
+func pushSignature(repository, digest, payloadDigest string, privateKey *ecdsa.PrivateKey) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, repository, payloadDigest))
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, privateKey, hash[:])
+	Expect(err).NotTo(HaveOccurred())
+
+	img, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       static.NewLayer(payload, types.MediaType(mediaTypeCosignSimpleSigning)),
+		Annotations: map[string]string{annotationCosignSignature: base64.StdEncoding.EncodeToString(signature)},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	ref, err := name.ParseReference(repository + ":" + strings.Replace(digest, ":", "-", 1) + ".sig")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(remote.Write(ref, img)).To(Succeed())
+}
@@ -5,10 +5,15 @@
 package secrets
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"fmt"
 	"math/big"
 	"net"
 	"os"
@@ -48,13 +53,31 @@ const (
 	PKCS8
 )
 
+// KeyAlgorithm is a string alias for private key algorithms.
+type KeyAlgorithm string
+
+const (
+	// KeyAlgorithmRSA2048 indicates a 2048-bit RSA private key.
+	KeyAlgorithmRSA2048 KeyAlgorithm = "RSA-2048"
+	// KeyAlgorithmRSA3072 indicates a 3072-bit RSA private key. It is the default if no key algorithm is specified.
+	KeyAlgorithmRSA3072 KeyAlgorithm = "RSA-3072"
+	// KeyAlgorithmRSA4096 indicates a 4096-bit RSA private key.
+	KeyAlgorithmRSA4096 KeyAlgorithm = "RSA-4096"
+	// KeyAlgorithmECDSAP256 indicates an ECDSA private key on the NIST P-256 curve.
+	KeyAlgorithmECDSAP256 KeyAlgorithm = "ECDSA-P256"
+	// KeyAlgorithmECDSAP384 indicates an ECDSA private key on the NIST P-384 curve.
+	KeyAlgorithmECDSAP384 KeyAlgorithm = "ECDSA-P384"
+	// KeyAlgorithmEd25519 indicates an Ed25519 private key. Ed25519 keys are always encoded in the PKCS8 format.
+	KeyAlgorithmEd25519 KeyAlgorithm = "Ed25519"
+)
+
 const (
 	// allowedClockSkew is the offset to allow with regards to certificate creation/usage difference.
 	allowedClockSkew = 1 * time.Minute
 )
 
 // CertificateSecretConfig contains the specification a to-be-generated CA, server, or client certificate.
-// It always contains a 3072-bit RSA private key.
+// The algorithm of its private key is determined by KeyAlgorithm (defaults to a 3072-bit RSA private key).
 type CertificateSecretConfig struct {
 	Name string
 
@@ -66,6 +89,9 @@ type CertificateSecretConfig struct {
 	CertType  CertType
 	SigningCA *Certificate
 	PKCS      int
+	// KeyAlgorithm is the algorithm of the private key. If it is empty, KeyAlgorithmRSA3072 is used. When used with the
+	// secrets manager, changing the key algorithm changes the config checksum and hence triggers a rotation.
+	KeyAlgorithm KeyAlgorithm
 
 	Validity                          *time.Duration
 	SkipPublishingCACertificate       bool
@@ -82,7 +108,7 @@ type Certificate struct {
 	SkipPublishingCACertificate       bool
 	IncludeCACertificateInServerChain bool
 
-	PrivateKey    *rsa.PrivateKey
+	PrivateKey    crypto.Signer
 	PrivateKeyPEM []byte
 
 	Certificate    *x509.Certificate
@@ -111,13 +137,13 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 
 	// If no cert type is given then we only return a certificate object that contains the CA.
 	if s.CertType != "" {
-		privateKey, err := GenerateKey(rand.Reader, 3072)
+		privateKey, err := generatePrivateKey(s.KeyAlgorithm)
 		if err != nil {
 			return nil, err
 		}
 
 		var (
-			certificate       = s.generateCertificateTemplate()
+			certificate       = s.generateCertificateTemplate(privateKey)
 			certificateSigner = certificate
 			privateKeySigner  = privateKey
 		)
@@ -132,15 +158,9 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 			return nil, err
 		}
 
-		var pk []byte
-		if s.PKCS == PKCS1 {
-			pk = utils.EncodePrivateKey(privateKey)
-		} else if s.PKCS == PKCS8 {
-			pk, err = utils.EncodePrivateKeyInPKCS8(privateKey)
-
-			if err != nil {
-				return nil, err
-			}
+		pk, err := encodePrivateKey(privateKey, s.PKCS)
+		if err != nil {
+			return nil, err
 		}
 
 		certificateObj.PrivateKey = privateKey
@@ -184,7 +204,7 @@ func (c *Certificate) SecretData() map[string][]byte {
 // LoadCertificate takes a byte slice representation of a certificate and the corresponding private key, and returns its de-serialized private
 // key, certificate template and PEM certificate which can be used to sign other x509 certificates.
 func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certificate, error) {
-	privateKey, err := utils.DecodePrivateKey(privateKeyPEM)
+	privateKey, err := utils.DecodePrivateKeySigner(privateKeyPEM)
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +228,7 @@ func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certif
 // common name, organization, SANs (DNS names and IP addresses). It can create a server or a client certificate
 // or both, depending on the <certType> value. If <isCACert> is true, then a CA certificate is being created.
 // The certificates a valid for 10 years.
-func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificate {
+func (s *CertificateSecretConfig) generateCertificateTemplate(privateKey crypto.Signer) *x509.Certificate {
 	now := Clock.Now()
 
 	expiration := now.AddDate(10, 0, 0) // + 10 years
@@ -236,6 +256,11 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 		}
 	)
 
+	// Key encipherment is only applicable for RSA keys, see https://datatracker.ietf.org/doc/html/rfc8813#section-3.
+	if _, ok := privateKey.(*rsa.PrivateKey); !ok {
+		template.KeyUsage &^= x509.KeyUsageKeyEncipherment
+	}
+
 	switch s.CertType {
 	case CACert:
 		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
@@ -253,14 +278,55 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 // SignCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
 // the first. It also requires the corresponding private keys of both certificates. The created certificate
 // is returned as byte slice.
-func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.PrivateKey, certificateTemplateSigner *x509.Certificate, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+func signCertificate(certificateTemplate *x509.Certificate, privateKey crypto.Signer, certificateTemplateSigner *x509.Certificate, privateKeySigner crypto.Signer) ([]byte, error) {
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, privateKey.Public(), privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
 	return utils.EncodeCertificate(certificate), nil
 }
 
+// generatePrivateKey generates a private key for the given algorithm.
+func generatePrivateKey(algorithm KeyAlgorithm) (crypto.Signer, error) {
+	switch algorithm {
+	case "", KeyAlgorithmRSA3072:
+		return GenerateKey(rand.Reader, 3072)
+	case KeyAlgorithmRSA2048:
+		return GenerateKey(rand.Reader, 2048)
+	case KeyAlgorithmRSA4096:
+		return GenerateKey(rand.Reader, 4096)
+	case KeyAlgorithmECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyAlgorithmECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case KeyAlgorithmEd25519:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		return privateKey, err
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm %q", algorithm)
+	}
+}
+
+// encodePrivateKey encodes the given private key to the PEM format. For RSA keys, the given PKCS format is used. ECDSA
+// keys are encoded in the SEC 1 format for PKCS1 (there is no PKCS1 format for them), and Ed25519 keys are always
+// encoded in the PKCS8 format.
+func encodePrivateKey(privateKey crypto.Signer, pkcs int) ([]byte, error) {
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		if pkcs == PKCS8 {
+			return utils.EncodePrivateKeyInPKCS8(key)
+		}
+		return utils.EncodePrivateKey(key), nil
+	case *ecdsa.PrivateKey:
+		if pkcs == PKCS8 {
+			return utils.EncodePrivateKeyInPKCS8PEM(key)
+		}
+		return utils.EncodeECPrivateKey(key)
+	default:
+		return utils.EncodePrivateKeyInPKCS8PEM(key)
+	}
+}
+
 // TemporaryDirectoryForSelfGeneratedTLSCertificatesPattern is a constant for the pattern used when creating a temporary
 // directory for self-generated certificates.
 const TemporaryDirectoryForSelfGeneratedTLSCertificatesPattern = "self-generated-server-certificates-"
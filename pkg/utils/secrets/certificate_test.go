@@ -5,6 +5,11 @@
 package secrets_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -37,6 +42,59 @@ var _ = Describe("Certificate Secrets", func() {
 				Expect(certificate.Certificate).NotTo(BeNil())
 				Expect(certificate.CA).To(BeNil())
 			})
+
+			DescribeTable("should generate a private key with the configured algorithm",
+				func(keyAlgorithm KeyAlgorithm, pkcs int, expectedPrivateKey any, expectedPEMType string, expectedKeyUsage x509.KeyUsage) {
+					certificateConfig.KeyAlgorithm = keyAlgorithm
+					certificateConfig.PKCS = pkcs
+
+					certificate, err := certificateConfig.GenerateCertificate()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(certificate.PrivateKey).To(BeAssignableToTypeOf(expectedPrivateKey))
+					Expect(string(certificate.PrivateKeyPEM)).To(HavePrefix("-----BEGIN " + expectedPEMType + "-----"))
+					Expect(certificate.Certificate.KeyUsage).To(Equal(expectedKeyUsage))
+
+					loadedCertificate, err := LoadCertificate("ca", certificate.PrivateKeyPEM, certificate.CertificatePEM)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(loadedCertificate.PrivateKey).To(Equal(certificate.PrivateKey))
+				},
+
+				Entry("default", KeyAlgorithm(""), PKCS1, &rsa.PrivateKey{}, "RSA PRIVATE KEY", x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment|x509.KeyUsageCertSign|x509.KeyUsageCRLSign),
+				Entry("RSA-2048 (PKCS8)", KeyAlgorithmRSA2048, PKCS8, &rsa.PrivateKey{}, "RSA PRIVATE KEY", x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment|x509.KeyUsageCertSign|x509.KeyUsageCRLSign),
+				Entry("ECDSA-P256", KeyAlgorithmECDSAP256, PKCS1, &ecdsa.PrivateKey{}, "EC PRIVATE KEY", x509.KeyUsageDigitalSignature|x509.KeyUsageCertSign|x509.KeyUsageCRLSign),
+				Entry("ECDSA-P384 (PKCS8)", KeyAlgorithmECDSAP384, PKCS8, &ecdsa.PrivateKey{}, "PRIVATE KEY", x509.KeyUsageDigitalSignature|x509.KeyUsageCertSign|x509.KeyUsageCRLSign),
+				Entry("Ed25519", KeyAlgorithmEd25519, PKCS1, ed25519.PrivateKey{}, "PRIVATE KEY", x509.KeyUsageDigitalSignature|x509.KeyUsageCertSign|x509.KeyUsageCRLSign),
+			)
+
+			It("should sign a certificate with a CA using a different key algorithm", func() {
+				certificateConfig.KeyAlgorithm = KeyAlgorithmECDSAP256
+				ca, err := certificateConfig.GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				certificate, err := (&CertificateSecretConfig{
+					Name:       "server",
+					CommonName: "server",
+					CertType:   ServerCert,
+					SigningCA:  ca,
+				}).GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(certificate.PrivateKey).To(BeAssignableToTypeOf(&rsa.PrivateKey{}))
+
+				loadedCA, err := LoadCertificate("ca", ca.PrivateKeyPEM, ca.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				loadedCertificate, err := LoadCertificate("server", certificate.PrivateKeyPEM, certificate.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(loadedCertificate.Certificate.CheckSignatureFrom(loadedCA.Certificate)).To(Succeed())
+			})
+
+			It("should fail for an unsupported key algorithm", func() {
+				certificateConfig.KeyAlgorithm = "DSA"
+
+				_, err := certificateConfig.Generate()
+				Expect(err).To(MatchError(`unsupported key algorithm "DSA"`))
+			})
 		})
 	})
 
@@ -7,6 +7,7 @@ package manager
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strconv"
 	"strings"
 	"time"
@@ -54,10 +55,17 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 			return nil, fmt.Errorf("failed reading secret %s for config %s: %w", client.ObjectKeyFromObject(secret), config.GetName(), err)
 		}
 
-		secret, err = m.generateAndCreate(ctx, config, objectMeta)
+		secret, err = m.generateAndCreate(ctx, config, objectMeta, options.Seal)
 		if err != nil {
 			return nil, fmt.Errorf("failed generating and creating new secret %s for config %s: %w", client.ObjectKey{Name: objectMeta.Name, Namespace: objectMeta.Namespace}, config.GetName(), err)
 		}
+	} else if err := m.unseal(ctx, secret); err != nil {
+		return nil, fmt.Errorf("failed reading secret %s for config %s: %w", client.ObjectKeyFromObject(secret), config.GetName(), err)
+	}
+
+	// The data of the secret cannot change since it is immutable, hence the storage backend label must be kept.
+	if backendName, ok := secret.Labels[LabelKeyStorageBackend]; ok {
+		desiredLabels[LabelKeyStorageBackend] = backendName
 	}
 
 	if err := m.maintainLifetimeLabels(config, secret, desiredLabels, options.Validity, options.RenewAfterValidityPercentage); err != nil {
@@ -89,7 +97,7 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 	return secret, nil
 }
 
-func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta) (*corev1.Secret, error) {
+func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta, seal bool) (*corev1.Secret, error) {
 	// Use secret name as common name to make sure the x509 subject names in the CA certificates are always unique.
 	if certConfig := certificateSecretConfig(config); certConfig != nil && certConfig.CertType == secretsutils.CACert {
 		certConfig.CommonName = objectMeta.Name
@@ -106,6 +114,19 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 	}
 
 	secret := Secret(objectMeta, dataMap)
+
+	sealed := m.mustSeal(seal)
+	if sealed {
+		sealedData, err := m.seal(ctx, dataMap)
+		if err != nil {
+			return nil, err
+		}
+
+		secret.Data = sealedData
+		secret.Type = corev1.SecretTypeOpaque
+		metav1.SetMetaDataLabel(&secret.ObjectMeta, LabelKeyStorageBackend, m.storageBackend.Name())
+	}
+
 	if err := m.client.Create(ctx, secret); err != nil {
 		if !apierrors.IsAlreadyExists(err) {
 			return nil, fmt.Errorf("failed creating new secret: %w", err)
@@ -114,6 +135,13 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		if err := m.client.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
 			return nil, fmt.Errorf("failed reading existing secret: %w", err)
 		}
+
+		if err := m.unseal(ctx, secret); err != nil {
+			return nil, err
+		}
+	} else if sealed {
+		m.rememberUnsealedData(secret.Name, dataMap)
+		secret.Data = maps.Clone(dataMap)
 	}
 
 	m.logger.Info("Generated new secret", "configName", config.GetName(), "secretName", secret.Name)
@@ -131,6 +159,9 @@ func (m *manager) keepExistingSecretsIfNeeded(ctx context.Context, configName st
 	}
 
 	if len(existingSecrets.Items) == 1 {
+		if err := m.unseal(ctx, &existingSecrets.Items[0]); err != nil {
+			return nil, err
+		}
 		return existingSecrets.Items[0].Data, nil
 	}
 
@@ -190,6 +221,10 @@ func (m *manager) storeOldSecrets(ctx context.Context, name, currentSecretName s
 		return nil
 	}
 
+	if err := m.unseal(ctx, oldSecret); err != nil {
+		return err
+	}
+
 	return m.addToStore(oldSecret.Labels[LabelKeyName], oldSecret, old)
 }
 
@@ -355,7 +390,13 @@ func (m *manager) reconcileSecret(ctx context.Context, secret *corev1.Secret, la
 		return nil
 	}
 
-	return m.client.Patch(ctx, secret, patch)
+	if err := m.client.Patch(ctx, secret, patch); err != nil {
+		return err
+	}
+
+	// The response of the patch request contains the data in the form in which it is stored in the system, hence it must
+	// be unsealed again.
+	return m.unseal(ctx, secret)
 }
 
 // GenerateOption is some configuration that modifies options for a Generate request.
@@ -365,6 +406,9 @@ type GenerateOption func(Interface, secretsutils.ConfigInterface, *GenerateOptio
 type GenerateOptions struct {
 	// Persist specifies whether the 'persist=true' label should be added to the secret resources.
 	Persist bool
+	// Seal specifies whether the data of the secret should be sealed by the storage backend (if configured). Only secrets
+	// whose data is exclusively read via the secrets manager may be sealed, i.e., they must not be mounted into pods.
+	Seal bool
 	// RotationStrategy specifies how the secret should be rotated in case it needs to get rotated.
 	RotationStrategy rotationStrategy
 	// IgnoreOldSecrets specifies whether old secrets should be dropped.
@@ -499,6 +543,14 @@ func Persist() GenerateOption {
 	}
 }
 
+// Seal returns a function which sets the 'Seal' field to true.
+func Seal() GenerateOption {
+	return func(_ Interface, _ secretsutils.ConfigInterface, options *GenerateOptions) error {
+		options.Seal = true
+		return nil
+	}
+}
+
 // Rotate returns a function which sets the 'RotationStrategy' field to the specified value.
 func Rotate(strategy rotationStrategy) GenerateOption {
 	return func(_ Interface, _ secretsutils.ConfigInterface, options *GenerateOptions) error {
@@ -6,6 +6,7 @@ package manager
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"sort"
 	"strconv"
@@ -447,6 +448,24 @@ var _ = Describe("Generate", func() {
 				Expect(secret.Name).To(Equal(name))
 			})
 
+			It("should generate a new CA secret when the key algorithm changes", func() {
+				By("Generate new secret")
+				secret, err := m.Generate(ctx, config)
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, secret)
+
+				By("Change key algorithm and generate new secret")
+				config.KeyAlgorithm = secretsutils.KeyAlgorithmECDSAP256
+				newSecret, err := m.Generate(ctx, config)
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, newSecret)
+				Expect(newSecret.Name).NotTo(Equal(secret.Name))
+
+				cert, err := secretsutils.LoadCertificate("", newSecret.Data["ca.key"], newSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.Certificate.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+			})
+
 			It("should rotate a CA secret and add old and new to the corresponding bundle", func() {
 				By("Generate new secret")
 				secret, err := m.Generate(ctx, config)
@@ -642,6 +661,30 @@ var _ = Describe("Generate", func() {
 				))
 			})
 
+			It("should sign a server cert with a CA using a different key algorithm", func() {
+				caConfig.KeyAlgorithm = secretsutils.KeyAlgorithmEd25519
+				serverConfig.KeyAlgorithm = secretsutils.KeyAlgorithmECDSAP384
+
+				By("Generate new CA secret")
+				caSecret, err := m.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, caSecret)
+
+				By("Generate new server secret")
+				serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, serverSecret)
+
+				By("Verify server certificate")
+				ca, err := secretsutils.LoadCertificate("", caSecret.Data["ca.key"], caSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				server, err := secretsutils.LoadCertificate("", serverSecret.Data["tls.key"], serverSecret.Data["tls.crt"])
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(server.Certificate.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+				Expect(server.Certificate.CheckSignatureFrom(ca.Certificate)).To(Succeed())
+			})
+
 			It("should keep the same server cert even when the CA rotates", func() {
 				By("Generate new CA secret")
 				caSecret, err := m.Generate(ctx, caConfig)
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	kmsservice "k8s.io/kms/pkg/service"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// keySize is the size of the AES-256 key encryption key.
+const keySize = 32
+
+// fileService is a key management service whose key encryption key is stored in a local file. It must only be used for
+// tests.
+type fileService struct {
+	aead  cipher.AEAD
+	keyID string
+}
+
+var _ kmsservice.Service = &fileService{}
+
+// NewFileService returns a key management service which encrypts with the key stored in the given file. If the file
+// does not exist, a new key is generated and written to it. It must only be used for tests.
+func NewFileService(keyFile string) (kmsservice.Service, error) {
+	key, err := os.ReadFile(keyFile)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed reading key file %s: %w", keyFile, err)
+		}
+
+		key = make([]byte, keySize)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed generating key: %w", err)
+		}
+
+		if err := os.WriteFile(keyFile, key, 0600); err != nil {
+			return nil, fmt.Errorf("failed writing key file %s: %w", keyFile, err)
+		}
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating cipher from key file %s: %w", keyFile, err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileService{aead: aead, keyID: utils.ComputeSHA256Hex(key)[:16]}, nil
+}
+
+func (f *fileService) Encrypt(_ context.Context, _ string, data []byte) (*kmsservice.EncryptResponse, error) {
+	nonce := make([]byte, f.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return &kmsservice.EncryptResponse{
+		Ciphertext: f.aead.Seal(nonce, nonce, data, nil),
+		KeyID:      f.keyID,
+	}, nil
+}
+
+func (f *fileService) Decrypt(_ context.Context, _ string, req *kmsservice.DecryptRequest) ([]byte, error) {
+	if req.KeyID != f.keyID {
+		return nil, fmt.Errorf("unknown key ID %q", req.KeyID)
+	}
+
+	if len(req.Ciphertext) < f.aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+
+	return f.aead.Open(nil, req.Ciphertext[:f.aead.NonceSize()], req.Ciphertext[f.aead.NonceSize():], nil)
+}
+
+func (f *fileService) Status(_ context.Context) (*kmsservice.StatusResponse, error) {
+	return &kmsservice.StatusResponse{
+		Version: "v2",
+		Healthz: "ok",
+		KeyID:   f.keyID,
+	}, nil
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	kmsservice "k8s.io/kms/pkg/service"
+
+	. "github.com/gardener/gardener/pkg/utils/secrets/manager/kms/fake"
+)
+
+var _ = Describe("Fake", func() {
+	var (
+		ctx     = context.Background()
+		keyFile string
+	)
+
+	BeforeEach(func() {
+		keyFile = filepath.Join(GinkgoT().TempDir(), "key")
+	})
+
+	Describe("#NewFileService", func() {
+		It("should generate a key if the file does not exist", func() {
+			_, err := NewFileService(keyFile)
+			Expect(err).NotTo(HaveOccurred())
+
+			key, err := os.ReadFile(keyFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(key).To(HaveLen(32))
+		})
+
+		It("should fail if the file does not contain a valid key", func() {
+			Expect(os.WriteFile(keyFile, []byte("foo"), 0600)).To(Succeed())
+
+			_, err := NewFileService(keyFile)
+			Expect(err).To(MatchError(ContainSubstring("failed creating cipher")))
+		})
+
+		It("should encrypt and decrypt data", func() {
+			service, err := NewFileService(keyFile)
+			Expect(err).NotTo(HaveOccurred())
+
+			status, err := service.Status(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Healthz).To(Equal("ok"))
+
+			response, err := service.Encrypt(ctx, "uid", []byte("foo"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.KeyID).To(Equal(status.KeyID))
+			Expect(response.Ciphertext).NotTo(ContainSubstring("foo"))
+
+			plaintext, err := service.Decrypt(ctx, "uid", &kmsservice.DecryptRequest{Ciphertext: response.Ciphertext, KeyID: response.KeyID})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plaintext).To(Equal([]byte("foo")))
+		})
+
+		It("should fail decrypting data for an unknown key ID", func() {
+			service, err := NewFileService(keyFile)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = service.Decrypt(ctx, "uid", &kmsservice.DecryptRequest{Ciphertext: []byte("foo"), KeyID: "bar"})
+			Expect(err).To(MatchError(ContainSubstring(`unknown key ID "bar"`)))
+		})
+	})
+})
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apiserver/pkg/storage/value/encrypt/envelope/kmsv2"
+	kmsservice "k8s.io/kms/pkg/service"
+
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// BackendName is the name of the storage backend which envelope-encrypts secret data with a key management service.
+	BackendName = "kms"
+	// DataKeyEnvelope is the key in the data of sealed secrets which contains the envelope.
+	DataKeyEnvelope = "envelope"
+
+	providerName = "secrets-manager"
+	// dataEncryptionKeySize is the size of the AES-256 keys which are used to encrypt the secret data.
+	dataEncryptionKeySize = 32
+)
+
+// envelope is the stored form of secret data. The data is encrypted with a data encryption key (DEK) which is unique
+// per secret. The DEK itself is encrypted with the key encryption key (KEK) of the key management service.
+type envelope struct {
+	// KeyID is the ID of the KEK which was used to encrypt the DEK.
+	KeyID string `json:"keyID"`
+	// EncryptedDEK is the DEK encrypted with the KEK.
+	EncryptedDEK []byte `json:"encryptedDEK"`
+	// Annotations are the annotations returned by the key management service when the DEK was encrypted.
+	Annotations map[string][]byte `json:"annotations,omitempty"`
+	// Ciphertext is the secret data encrypted with the DEK.
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+type envelopeBackend struct {
+	service kmsservice.Service
+}
+
+var _ secretsmanager.StorageBackend = &envelopeBackend{}
+
+// NewEnvelopeBackend returns a storage backend which envelope-encrypts secret data with the given key management
+// service.
+func NewEnvelopeBackend(service kmsservice.Service) secretsmanager.StorageBackend {
+	return &envelopeBackend{service: service}
+}
+
+// NewGRPCBackend returns a storage backend which envelope-encrypts secret data with the KMS plugin listening at the
+// given endpoint (e.g., 'unix:///var/run/kms.sock'). The plugin must implement the KMSv2 gRPC protocol. The connection
+// is closed when the given context is cancelled.
+func NewGRPCBackend(ctx context.Context, endpoint string, callTimeout time.Duration) (secretsmanager.StorageBackend, error) {
+	service, err := kmsv2.NewGRPCService(ctx, endpoint, providerName, callTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed connecting to KMS plugin at %s: %w", endpoint, err)
+	}
+
+	status, err := service.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed checking status of KMS plugin at %s: %w", endpoint, err)
+	}
+
+	if status.Healthz != "ok" {
+		return nil, fmt.Errorf("KMS plugin at %s is not healthy: %s", endpoint, status.Healthz)
+	}
+
+	return NewEnvelopeBackend(service), nil
+}
+
+func (e *envelopeBackend) Name() string {
+	return BackendName
+}
+
+func (e *envelopeBackend) Seal(ctx context.Context, data map[string][]byte) (map[string][]byte, error) {
+	plaintext, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling data: %w", err)
+	}
+
+	dek := make([]byte, dataEncryptionKeySize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, fmt.Errorf("failed generating data encryption key: %w", err)
+	}
+
+	ciphertext, err := encrypt(dek, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := e.service.Encrypt(ctx, string(uuid.NewUUID()), dek)
+	if err != nil {
+		return nil, fmt.Errorf("failed encrypting data encryption key: %w", err)
+	}
+
+	if response.KeyID == "" {
+		return nil, fmt.Errorf("key management service returned an empty key ID")
+	}
+
+	raw, err := json.Marshal(envelope{
+		KeyID:        response.KeyID,
+		EncryptedDEK: response.Ciphertext,
+		Annotations:  response.Annotations,
+		Ciphertext:   ciphertext,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling envelope: %w", err)
+	}
+
+	return map[string][]byte{DataKeyEnvelope: raw}, nil
+}
+
+func (e *envelopeBackend) Unseal(ctx context.Context, data map[string][]byte) (map[string][]byte, error) {
+	raw, ok := data[DataKeyEnvelope]
+	if !ok {
+		return nil, fmt.Errorf("data does not contain key %q", DataKeyEnvelope)
+	}
+
+	var env envelope
+	if err := json.Unmarshal(raw, &env); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling envelope: %w", err)
+	}
+
+	dek, err := e.service.Decrypt(ctx, string(uuid.NewUUID()), &kmsservice.DecryptRequest{
+		Ciphertext:  env.EncryptedDEK,
+		KeyID:       env.KeyID,
+		Annotations: env.Annotations,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed decrypting data encryption key with key ID %q: %w", env.KeyID, err)
+	}
+
+	plaintext, err := decrypt(dek, env.Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	var unsealedData map[string][]byte
+	if err := json.Unmarshal(plaintext, &unsealedData); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling data: %w", err)
+	}
+
+	return unsealedData, nil
+}
+
+// encrypt encrypts the given plaintext with AES-GCM. The random nonce is prepended to the returned ciphertext.
+func encrypt(key, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed generating nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// decrypt decrypts the given ciphertext which was encrypted with encrypt.
+func decrypt(key, ciphertext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+
+	plaintext, err := aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed decrypting data: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kms_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	kmsservice "k8s.io/kms/pkg/service"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	. "github.com/gardener/gardener/pkg/utils/secrets/manager/kms"
+	"github.com/gardener/gardener/pkg/utils/secrets/manager/kms/fake"
+)
+
+var _ = Describe("KMS", func() {
+	var (
+		ctx = context.Background()
+
+		keyFile string
+		service kmsservice.Service
+		backend secretsmanager.StorageBackend
+		data    map[string][]byte
+	)
+
+	BeforeEach(func() {
+		keyFile = filepath.Join(GinkgoT().TempDir(), "key")
+
+		var err error
+		service, err = fake.NewFileService(keyFile)
+		Expect(err).NotTo(HaveOccurred())
+
+		backend = NewEnvelopeBackend(service)
+		data = map[string][]byte{"foo": []byte("bar"), "baz": []byte("qux")}
+	})
+
+	Describe("#NewEnvelopeBackend", func() {
+		It("should return the backend name", func() {
+			Expect(backend.Name()).To(Equal("kms"))
+		})
+
+		It("should seal and unseal the data", func() {
+			sealedData, err := backend.Seal(ctx, data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sealedData).To(HaveLen(1))
+			Expect(sealedData).To(HaveKey("envelope"))
+			Expect(string(sealedData["envelope"])).NotTo(ContainSubstring("bar"))
+
+			unsealedData, err := backend.Unseal(ctx, sealedData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unsealedData).To(Equal(data))
+		})
+
+		It("should use a different data encryption key for every seal operation", func() {
+			sealedData1, err := backend.Seal(ctx, data)
+			Expect(err).NotTo(HaveOccurred())
+			sealedData2, err := backend.Seal(ctx, data)
+			Expect(err).NotTo(HaveOccurred())
+
+			var envelope1, envelope2 map[string]any
+			Expect(json.Unmarshal(sealedData1["envelope"], &envelope1)).To(Succeed())
+			Expect(json.Unmarshal(sealedData2["envelope"], &envelope2)).To(Succeed())
+
+			Expect(envelope1["keyID"]).To(Equal(envelope2["keyID"]))
+			Expect(envelope1["encryptedDEK"]).NotTo(Equal(envelope2["encryptedDEK"]))
+			Expect(envelope1["ciphertext"]).NotTo(Equal(envelope2["ciphertext"]))
+		})
+
+		It("should unseal the data with a new service instance using the same key file", func() {
+			sealedData, err := backend.Seal(ctx, data)
+			Expect(err).NotTo(HaveOccurred())
+
+			service2, err := fake.NewFileService(keyFile)
+			Expect(err).NotTo(HaveOccurred())
+
+			unsealedData, err := NewEnvelopeBackend(service2).Unseal(ctx, sealedData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unsealedData).To(Equal(data))
+		})
+
+		It("should fail unsealing the data with a different key", func() {
+			sealedData, err := backend.Seal(ctx, data)
+			Expect(err).NotTo(HaveOccurred())
+
+			service2, err := fake.NewFileService(filepath.Join(GinkgoT().TempDir(), "other-key"))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = NewEnvelopeBackend(service2).Unseal(ctx, sealedData)
+			Expect(err).To(MatchError(ContainSubstring("failed decrypting data encryption key")))
+		})
+
+		It("should fail unsealing data without envelope", func() {
+			_, err := backend.Unseal(ctx, data)
+			Expect(err).To(MatchError(ContainSubstring(`data does not contain key "envelope"`)))
+		})
+
+		It("should fail unsealing tampered data", func() {
+			sealedData, err := backend.Seal(ctx, data)
+			Expect(err).NotTo(HaveOccurred())
+
+			var env map[string]any
+			Expect(json.Unmarshal(sealedData["envelope"], &env)).To(Succeed())
+			env["ciphertext"] = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+			sealedData["envelope"], err = json.Marshal(env)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = backend.Unseal(ctx, sealedData)
+			Expect(err).To(MatchError(ContainSubstring("failed decrypting data")))
+		})
+	})
+
+	Describe("#NewGRPCBackend", func() {
+		var endpoint string
+
+		BeforeEach(func() {
+			// The path of unix sockets is limited to ~100 characters, hence the temporary directory of ginkgo cannot be used.
+			dir, err := os.MkdirTemp("", "kms-")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() { Expect(os.RemoveAll(dir)).To(Succeed()) })
+
+			socket := filepath.Join(dir, "kms.sock")
+			endpoint = "unix://" + socket
+
+			server := kmsservice.NewGRPCService(socket, 10*time.Second, service)
+			go func() {
+				defer GinkgoRecover()
+				_ = server.ListenAndServe()
+			}()
+			DeferCleanup(server.Shutdown)
+
+			Eventually(func() error {
+				_, err := os.Stat(socket)
+				return err
+			}).Should(Succeed())
+		})
+
+		It("should seal and unseal the data via the KMS plugin", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			DeferCleanup(cancel)
+
+			backend, err := NewGRPCBackend(ctx, endpoint, 10*time.Second)
+			Expect(err).NotTo(HaveOccurred())
+
+			sealedData, err := backend.Seal(ctx, data)
+			Expect(err).NotTo(HaveOccurred())
+
+			unsealedData, err := NewEnvelopeBackend(service).Unseal(ctx, sealedData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unsealedData).To(Equal(data))
+		})
+
+		It("should fail for an invalid endpoint", func() {
+			_, err := NewGRPCBackend(ctx, "tcp://localhost:1234", time.Second)
+			Expect(err).To(MatchError(ContainSubstring("failed connecting to KMS plugin")))
+		})
+	})
+
+	Describe("secrets manager integration", func() {
+		It("should store the CA private key envelope-encrypted and decrypt it on load", func() {
+			var (
+				namespace  = "shoot--foo--bar"
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+				fakeClock  = testclock.NewFakeClock(time.Now())
+			)
+
+			sm, err := secretsmanager.New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, "test", secretsmanager.Config{StorageBackend: backend})
+			Expect(err).NotTo(HaveOccurred())
+
+			secret, err := sm.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Data).To(HaveKey("ca.key"))
+
+			storedSecret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), storedSecret)).To(Succeed())
+			Expect(storedSecret.Data).To(HaveKey("envelope"))
+
+			sm2, err := secretsmanager.New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, "test", secretsmanager.Config{StorageBackend: backend})
+			Expect(err).NotTo(HaveOccurred())
+
+			secret2, err := sm2.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret2.Data).To(Equal(secret.Data))
+		})
+	})
+})
@@ -58,6 +58,9 @@ const (
 	// LabelKeyUseDataForName is a constant for a key of a label on a Secret describing that its data should be used
 	// instead of generating a fresh secret with the same name.
 	LabelKeyUseDataForName = "secrets-manager-use-data-for-name"
+	// LabelKeyStorageBackend is a constant for a key of a label on a Secret describing the name of the storage backend
+	// which has sealed its data.
+	LabelKeyStorageBackend = "storage-backend"
 
 	// LabelValueTrue is a constant for a value of a label on a Secret describing the value 'true'.
 	LabelValueTrue = "true"
@@ -77,6 +80,8 @@ type (
 		namespace                   string
 		identity                    string
 		lastRotationInitiationTimes nameToUnixTime
+		storageBackend              StorageBackend
+		unsealedData                map[string]map[string][]byte
 	}
 
 	nameToUnixTime map[string]string
@@ -100,6 +105,9 @@ type (
 		// SecretNamesToTimes is a map whose keys are secret names and whose values are the last rotation initiation
 		// times.
 		SecretNamesToTimes map[string]time.Time
+		// StorageBackend is used to seal the data of CA secrets and of secrets which get persisted before they are stored
+		// in the system. If not set, the data is stored in plain text.
+		StorageBackend StorageBackend
 	}
 )
 
@@ -134,6 +142,8 @@ func New(
 		namespace:                   namespace,
 		identity:                    identity,
 		lastRotationInitiationTimes: make(nameToUnixTime),
+		storageBackend:              rotation.StorageBackend,
+		unsealedData:                make(map[string]map[string][]byte),
 	}
 
 	if err := m.initialize(ctx, rotation); err != nil {
@@ -159,6 +169,14 @@ func (m *manager) initialize(ctx context.Context, rotation Config) error {
 
 	nameToNewestSecret := make(map[string]corev1.Secret, len(secretList.Items))
 
+	// Unseal the data of all secrets sealed by the storage backend so that it is available for the checks below and for
+	// subsequent Generate calls.
+	for i := range secretList.Items {
+		if err := m.unseal(ctx, &secretList.Items[i]); err != nil {
+			return err
+		}
+	}
+
 	// Find the newest secret in system for the respective secret names. Read their existing
 	// last-rotation-initiation-time labels and store them in our internal map.
 	for _, secret := range secretList.Items {
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StorageBackend seals the data of secrets before it is stored in the system and unseals it after it was read, e.g.,
+// by envelope-encrypting it with an external key management service.
+type StorageBackend interface {
+	// Name returns the name of the storage backend. It is added as label to all secrets whose data was sealed by the
+	// backend. It must be a valid label value.
+	Name() string
+	// Seal transforms the given secret data into the form in which it gets stored in the system.
+	Seal(ctx context.Context, data map[string][]byte) (map[string][]byte, error)
+	// Unseal restores the original secret data from the form in which it was stored in the system.
+	Unseal(ctx context.Context, data map[string][]byte) (map[string][]byte, error)
+}
+
+// mustSeal returns whether the data of a secret must be sealed before it is stored in the system. This is only the
+// case if a storage backend is configured and the secret was generated with the Seal option.
+func (m *manager) mustSeal(seal bool) bool {
+	return m.storageBackend != nil && seal
+}
+
+// seal returns the form of the given data which is stored in the system.
+func (m *manager) seal(ctx context.Context, data map[string][]byte) (map[string][]byte, error) {
+	sealedData, err := m.storageBackend.Seal(ctx, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed sealing data with storage backend %q: %w", m.storageBackend.Name(), err)
+	}
+	return sealedData, nil
+}
+
+// rememberUnsealedData caches the unsealed data of the secret with the given name.
+func (m *manager) rememberUnsealedData(secretName string, data map[string][]byte) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.unsealedData[secretName] = data
+}
+
+// unseal replaces the data of the given secret with its unsealed form in case it was sealed by the storage backend.
+// Unsealed data is cached, i.e., the storage backend is only called once per secret.
+func (m *manager) unseal(ctx context.Context, secret *corev1.Secret) error {
+	if _, ok := secret.Labels[LabelKeyStorageBackend]; !ok {
+		return nil
+	}
+
+	m.lock.Lock()
+	data, ok := m.unsealedData[secret.Name]
+	m.lock.Unlock()
+
+	if !ok {
+		var err error
+		if data, err = UnsealedData(ctx, m.storageBackend, secret); err != nil {
+			return err
+		}
+
+		m.rememberUnsealedData(secret.Name, data)
+	}
+
+	secret.Data = maps.Clone(data)
+	return nil
+}
+
+// UnsealedData returns the unsealed data of the given secret. In case the secret was not sealed by a storage backend,
+// its data is returned as is. It fails if the secret was sealed by a storage backend other than the given one.
+func UnsealedData(ctx context.Context, storageBackend StorageBackend, secret *corev1.Secret) (map[string][]byte, error) {
+	backendName, ok := secret.Labels[LabelKeyStorageBackend]
+	if !ok {
+		return secret.Data, nil
+	}
+
+	if storageBackend == nil || storageBackend.Name() != backendName {
+		return nil, fmt.Errorf("data of secret %s was sealed by storage backend %q which is not configured", client.ObjectKeyFromObject(secret), backendName)
+	}
+
+	data, err := storageBackend.Unseal(ctx, secret.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed unsealing data of secret %s with storage backend %q: %w", client.ObjectKeyFromObject(secret), backendName, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,257 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("Storage", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+		identity  = "test"
+
+		m          *manager
+		fakeClient client.Client
+		fakeClock  = testclock.NewFakeClock(time.Time{})
+		backend    *fakeStorageBackend
+
+		caConfig        *secretsutils.CertificateSecretConfig
+		basicAuthConfig *secretsutils.BasicAuthSecretConfig
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		backend = &fakeStorageBackend{}
+
+		mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{StorageBackend: backend})
+		Expect(err).NotTo(HaveOccurred())
+		m = mgr.(*manager)
+
+		caConfig = &secretsutils.CertificateSecretConfig{
+			Name:       "ca",
+			CommonName: "ca",
+			CertType:   secretsutils.CACert,
+		}
+		basicAuthConfig = &secretsutils.BasicAuthSecretConfig{
+			Name:           "basic-auth",
+			Format:         secretsutils.BasicAuthFormatNormal,
+			Username:       "foo",
+			PasswordLength: 3,
+		}
+	})
+
+	Describe("#Generate", func() {
+		It("should seal the data of secrets generated with the Seal option", func() {
+			secret, err := m.Generate(ctx, caConfig, Seal())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Labels).To(HaveKeyWithValue("storage-backend", "fake"))
+			Expect(secret.Data).To(HaveKey("ca.key"))
+
+			storedSecret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), storedSecret)).To(Succeed())
+			Expect(storedSecret.Labels).To(HaveKeyWithValue("storage-backend", "fake"))
+			Expect(storedSecret.Type).To(Equal(corev1.SecretTypeOpaque))
+			Expect(storedSecret.Data).To(Equal(backend.seal(secret.Data)))
+
+			Expect(backend.sealCalls).To(Equal(1))
+			Expect(backend.unsealCalls).To(BeZero())
+		})
+
+		It("should not seal the data of CA secrets without the Seal option", func() {
+			secret, err := m.Generate(ctx, caConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Labels).NotTo(HaveKey("storage-backend"))
+			Expect(secret.Data).To(HaveKey("ca.key"))
+
+			expectSecretWasCreated(ctx, fakeClient, secret)
+			Expect(backend.sealCalls).To(BeZero())
+		})
+
+		It("should not seal the data of persisted secrets without the Seal option", func() {
+			secret, err := m.Generate(ctx, basicAuthConfig, Persist())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Labels).NotTo(HaveKey("storage-backend"))
+
+			expectSecretWasCreated(ctx, fakeClient, secret)
+			Expect(backend.sealCalls).To(BeZero())
+		})
+
+		It("should not seal the data if no storage backend is configured", func() {
+			mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{})
+			Expect(err).NotTo(HaveOccurred())
+
+			secret, err := mgr.Generate(ctx, basicAuthConfig, Seal())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Labels).NotTo(HaveKey("storage-backend"))
+
+			expectSecretWasCreated(ctx, fakeClient, secret)
+		})
+
+		It("should return the unsealed data of existing secrets and keep the storage backend label", func() {
+			secret, err := m.Generate(ctx, caConfig, Seal())
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Create new manager instance")
+			mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{StorageBackend: backend})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backend.unsealCalls).To(Equal(1))
+
+			// The common name of the given config is overwritten by Generate, hence a fresh config is needed.
+			secret2, err := mgr.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}, Seal())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret2.Data).To(Equal(secret.Data))
+			Expect(secret2.Labels).To(HaveKeyWithValue("storage-backend", "fake"))
+
+			storedSecret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), storedSecret)).To(Succeed())
+			Expect(storedSecret.Labels).To(HaveKeyWithValue("storage-backend", "fake"))
+
+			By("Ensure data was unsealed only once")
+			Expect(backend.unsealCalls).To(Equal(1))
+		})
+
+		It("should unseal the data of old secrets", func() {
+			secret, err := m.Generate(ctx, caConfig, Seal(), Rotate(KeepOld))
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Rotate secret")
+			mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{
+				StorageBackend:     &fakeStorageBackend{},
+				SecretNamesToTimes: map[string]time.Time{caConfig.Name: fakeClock.Now().Add(time.Hour)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			m = mgr.(*manager)
+
+			newSecret, err := m.Generate(ctx, caConfig, Seal(), Rotate(KeepOld))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(newSecret.Name).NotTo(Equal(secret.Name))
+
+			secretInfos, found := m.getFromStore(caConfig.Name)
+			Expect(found).To(BeTrue())
+			Expect(secretInfos.old).NotTo(BeNil())
+			Expect(secretInfos.old.obj.Data).To(Equal(secret.Data))
+		})
+	})
+
+	Describe("#New", func() {
+		It("should fail if a secret was sealed by a storage backend which is not configured", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret1",
+					Namespace: namespace,
+					Labels: map[string]string{
+						"name":             "secret1",
+						"managed-by":       "secrets-manager",
+						"manager-identity": identity,
+						"storage-backend":  "other",
+					},
+				},
+			})).To(Succeed())
+
+			_, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{})
+			Expect(err).To(MatchError(ContainSubstring(`sealed by storage backend "other" which is not configured`)))
+		})
+
+		It("should fail if the data of a secret cannot be unsealed", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret1",
+					Namespace: namespace,
+					Labels: map[string]string{
+						"name":             "secret1",
+						"managed-by":       "secrets-manager",
+						"manager-identity": identity,
+						"storage-backend":  "fake",
+					},
+				},
+				Data: map[string][]byte{"foo": []byte("bar")},
+			})).To(Succeed())
+
+			_, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{StorageBackend: backend})
+			Expect(err).To(MatchError(ContainSubstring("failed unsealing data of secret")))
+		})
+	})
+
+	Describe("#UnsealedData", func() {
+		var secret *corev1.Secret
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret1", Namespace: namespace},
+				Data:       map[string][]byte{"foo": []byte("bar")},
+			}
+		})
+
+		It("should return the data of secrets which were not sealed", func() {
+			Expect(UnsealedData(ctx, nil, secret)).To(Equal(map[string][]byte{"foo": []byte("bar")}))
+		})
+
+		It("should return the unsealed data of sealed secrets", func() {
+			secret.Labels = map[string]string{"storage-backend": "fake"}
+			secret.Data = backend.seal(secret.Data)
+
+			Expect(UnsealedData(ctx, backend, secret)).To(Equal(map[string][]byte{"foo": []byte("bar")}))
+			Expect(backend.unsealCalls).To(Equal(1))
+		})
+
+		It("should fail if the secret was sealed by another storage backend", func() {
+			secret.Labels = map[string]string{"storage-backend": "other"}
+
+			_, err := UnsealedData(ctx, backend, secret)
+			Expect(err).To(MatchError(ContainSubstring(`sealed by storage backend "other" which is not configured`)))
+		})
+	})
+})
+
+// fakeStorageBackend seals the data by serializing it into a single data key.
+type fakeStorageBackend struct {
+	sealCalls, unsealCalls int
+}
+
+func (f *fakeStorageBackend) Name() string {
+	return "fake"
+}
+
+func (f *fakeStorageBackend) Seal(_ context.Context, data map[string][]byte) (map[string][]byte, error) {
+	f.sealCalls++
+	return f.seal(data), nil
+}
+
+func (f *fakeStorageBackend) Unseal(_ context.Context, data map[string][]byte) (map[string][]byte, error) {
+	f.unsealCalls++
+
+	if _, ok := data["sealed"]; !ok {
+		return nil, fmt.Errorf("data is not sealed")
+	}
+
+	var unsealedData map[string][]byte
+	if err := json.Unmarshal(data["sealed"], &unsealedData); err != nil {
+		return nil, err
+	}
+	return unsealedData, nil
+}
+
+func (f *fakeStorageBackend) seal(data map[string][]byte) map[string][]byte {
+	raw, err := json.Marshal(data)
+	Expect(err).NotTo(HaveOccurred())
+	return map[string][]byte{"sealed": raw}
+}
@@ -381,6 +381,76 @@ var _ = Describe("quotavalidator", func() {
 			})
 		})
 
+		Context("tests for resource-based quota metrics", func() {
+			It("should fail because other shoots exhaust the shoot count", func() {
+				quotaProject.Spec.Metrics = corev1.ResourceList{core.QuotaMetricShoots: resource.MustParse("1")}
+
+				shoot2 := *versionedShootBase.DeepCopy()
+				shoot2.Name = "test-shoot-2"
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(&shoot2)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(MatchError(ContainSubstring("Unable to allocate further shoots")))
+			})
+
+			It("should pass because the node count is sufficient", func() {
+				quotaProject.Spec.Metrics = corev1.ResourceList{core.QuotaMetricNodes: resource.MustParse("2")}
+				quotaSecret.Spec.Metrics = corev1.ResourceList{}
+				shoot.Spec.Provider.Workers = workersBase2
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should fail because the maximum node count of the worker pools exceeds the quota", func() {
+				quotaProject.Spec.Metrics = corev1.ResourceList{core.QuotaMetricNodes: resource.MustParse("2")}
+				quotaSecret.Spec.Metrics = corev1.ResourceList{}
+				shoot.Spec.Provider.Workers[0].Maximum = 3
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(MatchError(ContainSubstring("Unable to allocate further nodes")))
+			})
+
+			It("should fail because a highly available control plane is requested on update", func() {
+				quotaProject.Spec.Metrics = corev1.ResourceList{core.QuotaMetricControlPlanesHighAvailability: resource.MustParse("0")}
+				oldShoot = *shoot.DeepCopy()
+				shoot.Spec.ControlPlane = &core.ControlPlane{HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone}}}
+
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(MatchError(ContainSubstring("Unable to allocate further controlplanes.highavailability")))
+			})
+
+			It("should pass because the cost units are sufficient", func() {
+				cloudProfile.Spec.MachineTypes[0].CostUnits = ptr.To(resource.MustParse("1.5"))
+				quotaProject.Spec.Metrics = corev1.ResourceList{core.QuotaMetricCostUnits: resource.MustParse("3")}
+				quotaSecret.Spec.Metrics = corev1.ResourceList{}
+				shoot.Spec.Provider.Workers = workersBase2
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should fail because the cost units of the machine types exceed the quota", func() {
+				cloudProfile.Spec.MachineTypes[0].CostUnits = ptr.To(resource.MustParse("1.5"))
+				quotaProject.Spec.Metrics = corev1.ResourceList{core.QuotaMetricCostUnits: resource.MustParse("3")}
+				quotaSecret.Spec.Metrics = corev1.ResourceList{}
+				shoot.Spec.Provider.Workers[0].Maximum = 3
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(MatchError(ContainSubstring("Unable to allocate further costunits")))
+			})
+		})
+
 		Context("tests for Quota validation corner cases", func() {
 			It("should pass because shoot is intended to get deleted", func() {
 				var now metav1.Time
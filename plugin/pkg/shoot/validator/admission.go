@@ -308,6 +308,9 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 	if err := validationContext.validateProjectMembership(a); err != nil {
 		return err
 	}
+	if err := validationContext.validateSelfHosted(a); err != nil {
+		return err
+	}
 	if err := validationContext.validateScheduling(ctx, a, v.authorizer, v.shootLister, v.seedLister); err != nil {
 		return err
 	}
@@ -601,6 +604,27 @@ func authorize(ctx context.Context, a admission.Attributes, auth authorizer.Auth
 	return nil
 }
 
+// validateSelfHosted ensures that shoots cannot be marked as self-hosted (see 'gardenadm connect') after their creation
+// and vice versa, and that self-hosted shoots are never scheduled to a seed. Otherwise, gardenlets of seeds would start
+// managing the control planes of self-hosted shoots or the other way round.
+func (c *validationContext) validateSelfHosted(a admission.Attributes) error {
+	if a.GetOperation() == admission.Delete {
+		return nil
+	}
+
+	if a.GetOperation() == admission.Update {
+		if oldValue, newValue := c.oldShoot.Labels[v1beta1constants.LabelShootSelfHosted], c.shoot.Labels[v1beta1constants.LabelShootSelfHosted]; oldValue != newValue {
+			return admission.NewForbidden(a, fmt.Errorf("label %s is immutable", v1beta1constants.LabelShootSelfHosted))
+		}
+	}
+
+	if selfHosted, _ := strconv.ParseBool(c.shoot.Labels[v1beta1constants.LabelShootSelfHosted]); selfHosted && c.shoot.Spec.SeedName != nil {
+		return admission.NewForbidden(a, fmt.Errorf("self-hosted shoots cannot be scheduled to a seed"))
+	}
+
+	return nil
+}
+
 func (c *validationContext) validateDeletion(a admission.Attributes) error {
 	if a.GetOperation() == admission.Delete {
 		if isShootInMigrationOrRestorePhase(c.shoot) {
@@ -5,14 +5,10 @@
 package hightouch
 
 import (
-	"context"
-	"io"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	"github.com/onsi/gomega/gbytes"
-	"github.com/onsi/gomega/gexec"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/uuid"
@@ -43,40 +39,7 @@ var _ = Describe("gardenadm high-touch scenario tests", Label("gardenadm", "high
 		}).Should(Succeed())
 	}, NodeTimeout(time.Minute))
 
-	Describe("Single-node control plane", Ordered, Label("single"), func() {
-		It("should initialize as control plane node", func(ctx SpecContext) {
-			stdOut, _ := execute(ctx, 0,
-				"gardenadm", "init",
-			)
-
-			Eventually(ctx, stdOut).Should(gbytes.Say("not implemented"))
-		}, SpecTimeout(time.Minute))
-
-		It("should join as worker node", func(ctx SpecContext) {
-			stdOut, _ := execute(ctx, 1,
-				"gardenadm", "join",
-			)
-
-			Eventually(ctx, stdOut).Should(gbytes.Say("not implemented either"))
-		}, SpecTimeout(time.Minute))
-	})
+	// TODO: Add tests for 'gardenadm init' and 'gardenadm join' (as worker and as control plane node) once the
+	//  configuration resources of a local shoot are provided to the machine pods. Until then, these commands are
+	//  covered by unit tests only.
 })
-
-// nolint:unparam
-func execute(ctx context.Context, ordinal int, command ...string) (*gbytes.Buffer, *gbytes.Buffer) {
-	GinkgoHelper()
-	var stdOutBuffer, stdErrBuffer = gbytes.NewBuffer(), gbytes.NewBuffer()
-
-	Expect(RuntimeClient.PodExecutor().ExecuteWithStreams(
-		ctx,
-		namespace,
-		machinePodName(ordinal),
-		ContainerName,
-		nil,
-		io.MultiWriter(stdOutBuffer, gexec.NewPrefixedWriter("[out] ", GinkgoWriter)),
-		io.MultiWriter(stdErrBuffer, gexec.NewPrefixedWriter("[err] ", GinkgoWriter)),
-		command...,
-	)).To(Succeed())
-
-	return stdOutBuffer, stdErrBuffer
-}
@@ -4,15 +4,7 @@
 
 package hightouch
 
-import (
-	"strconv"
-)
-
 const (
 	namespace       = "gardenadm-high-touch"
 	statefulSetName = "machine"
 )
-
-func machinePodName(ordinal int) string {
-	return statefulSetName + "-" + strconv.Itoa(ordinal)
-}
@@ -19,7 +19,9 @@ var _ = Describe("gardenadm medium-touch scenario tests", Label("gardenadm", "me
 
 	Describe("Prepare infrastructure and machines", Ordered, func() {
 		It("should bootstrap the machine pods", func(SpecContext) {
-			Eventually(RunAndWait("bootstrap")).Should(gbytes.Say("not implemented as well"))
+			// TODO: Run 'gardenadm bootstrap' with the configuration resources of a local shoot once they are rendered to the
+			//  medium-touch directory.
+			Eventually(RunAndWait("bootstrap", "--help")).Should(gbytes.Say("Bootstrap the infrastructure for an Autonomous Shoot Cluster"))
 		}, SpecTimeout(time.Minute))
 	})
 })
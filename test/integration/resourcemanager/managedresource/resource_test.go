@@ -1056,6 +1056,86 @@ var _ = Describe("ManagedResource controller tests", func() {
 		})
 	})
 
+	Describe("Server-side apply", func() {
+		BeforeEach(func() {
+			managedResource.Spec.ApplyMode = ptr.To(resourcesv1alpha1.ApplyModeServerSideApply)
+		})
+
+		JustBeforeEach(func() {
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.Conditions
+			}).Should(
+				ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+			)
+		})
+
+		It("should apply the resources with the field manager of the ManagedResource", func() {
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"abc": "xyz"}))
+			Expect(configMap.Annotations).To(HaveKeyWithValue("resources.gardener.cloud/origin", testNamespace.Name+"/"+resourceName))
+			Expect(configMap.ManagedFields).To(ContainElement(And(
+				HaveField("Manager", "gardener-resource-manager:"+testNamespace.Name+"/"+resourceName),
+				HaveField("Operation", metav1.ManagedFieldsOperationApply),
+			)))
+		})
+
+		It("should not revert fields managed by other field managers", func() {
+			patch := client.MergeFrom(configMap.DeepCopy())
+			configMap.Data["foo"] = "bar"
+			Expect(testClient.Patch(ctx, configMap, patch)).To(Succeed())
+
+			patch = client.MergeFrom(managedResource.DeepCopy())
+			managedResource.Spec.InjectLabels = map[string]string{"foo": "bar"}
+			Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+				return configMap.Labels
+			}).Should(HaveKeyWithValue("foo", "bar"))
+
+			Expect(configMap.Data).To(Equal(map[string]string{"abc": "xyz", "foo": "bar"}))
+		})
+
+		It("should report conflicts and take over the conflicting fields if configured", func() {
+			patch := client.MergeFrom(configMap.DeepCopy())
+			configMap.Data["abc"] = "foo"
+			Expect(testClient.Patch(ctx, configMap, patch, client.FieldOwner("test"))).To(Succeed())
+
+			By("Trigger reconciliation")
+			patch = client.MergeFrom(managedResource.DeepCopy())
+			managedResource.Spec.InjectLabels = map[string]string{"foo": "bar"}
+			Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.Conditions
+			}).Should(
+				ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionFalse), WithReason(resourcesv1alpha1.ConditionApplyConflict), WithMessageSubstrings(`conflict with "test"`)),
+			)
+
+			Consistently(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+				return configMap.Data
+			}).Should(HaveKeyWithValue("abc", "foo"))
+
+			By("Force conflicts")
+			patch = client.MergeFrom(managedResource.DeepCopy())
+			managedResource.Spec.ForceConflicts = ptr.To(true)
+			Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.Conditions
+			}).Should(
+				ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+			)
+
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("abc", "xyz"))
+		})
+	})
+
 	Describe("Immutable resources", func() {
 		BeforeEach(func() {
 			configMap.Immutable = ptr.To(true)